@@ -538,6 +538,14 @@ func executeStrategyFromConfig(c *cli.Context) error {
 			Path: defaultConfig.DataSettings.CSVData.FullPath,
 		}
 	}
+	if defaultConfig.DataSettings.ParquetData != nil {
+		dataSettings.ParquetData = &btrpc.ParquetData{
+			StartDate:        timestamppb.New(defaultConfig.DataSettings.ParquetData.StartDate),
+			EndDate:          timestamppb.New(defaultConfig.DataSettings.ParquetData.EndDate),
+			Path:             defaultConfig.DataSettings.ParquetData.Path,
+			InclusiveEndDate: defaultConfig.DataSettings.ParquetData.InclusiveEndDate,
+		}
+	}
 	if defaultConfig.DataSettings.DatabaseData != nil {
 		dbConnectionDetails := &btrpc.DatabaseConnectionDetails{
 			Host:     defaultConfig.DataSettings.DatabaseData.Config.Host,
@@ -145,6 +145,11 @@ func (c *Config) validateDate() error {
 			return err
 		}
 	}
+	if c.DataSettings.ParquetData != nil {
+		if err := gctcommon.StartEndTimeCheck(c.DataSettings.ParquetData.StartDate, c.DataSettings.ParquetData.EndDate); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -333,4 +338,12 @@ func (c *Config) PrintSetting() {
 		log.Infof(common.Config, "Start date: %v", c.DataSettings.DatabaseData.StartDate.Format(time.DateTime))
 		log.Infof(common.Config, "End date: %v", c.DataSettings.DatabaseData.EndDate.Format(time.DateTime))
 	}
+	if c.DataSettings.ParquetData != nil {
+		log.Infoln(common.Config, common.CMDColours.H2+"------------------Parquet Settings---------------------------"+common.CMDColours.Default)
+		log.Infof(common.Config, "Data type: %v", c.DataSettings.DataType)
+		log.Infof(common.Config, "Interval: %v", c.DataSettings.Interval)
+		log.Infof(common.Config, "Parquet directory: %v", c.DataSettings.ParquetData.Path)
+		log.Infof(common.Config, "Start date: %v", c.DataSettings.ParquetData.StartDate.Format(time.DateTime))
+		log.Infof(common.Config, "End date: %v", c.DataSettings.ParquetData.EndDate.Format(time.DateTime))
+	}
 }
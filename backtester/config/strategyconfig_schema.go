@@ -0,0 +1,40 @@
+package config
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/encoding/jsonschema"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var strategyConfigSchema = sync.OnceValues(func() (*jsonschema.Schema, error) {
+	r := gctconfig.NewSchemaReflector()
+	r.Overrides[reflect.TypeFor[kline.Interval]()] = gctconfig.SupportedIntervalSchema()
+	s, err := r.Reflect(&Config{})
+	if err != nil {
+		return nil, err
+	}
+	s.Title = "GoCryptoTrader backtester strategy config"
+	if ds, ok := s.Defs["config.DataSettings"]; ok {
+		ds.Properties["data-type"].Enum = []any{common.CandleStr, common.TradeStr}
+	}
+	return s, nil
+})
+
+// Schema returns the JSON Schema of strategy config files
+func Schema() (*jsonschema.Schema, error) {
+	return strategyConfigSchema()
+}
+
+// ValidateSchema checks strategy config JSON against the strategy config schema
+// and returns every mismatch found
+func ValidateSchema(data []byte) (jsonschema.ValidationErrors, error) {
+	s, err := Schema()
+	if err != nil {
+		return nil, err
+	}
+	return s.Validate(data)
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+	s, err := Schema()
+	require.NoError(t, err, "Schema must not error")
+	require.Contains(t, s.Defs, "config.DataSettings", "DataSettings must be defined")
+	assert.Equal(t, []any{common.CandleStr, common.TradeStr}, s.Defs["config.DataSettings"].Properties["data-type"].Enum)
+}
+
+func TestValidateSchema(t *testing.T) {
+	t.Parallel()
+	examples, err := filepath.Glob(filepath.Join("strategyexamples", "*.strat"))
+	require.NoError(t, err, "Glob must not error")
+	require.NotEmpty(t, examples, "strategy examples must exist")
+	for _, example := range examples {
+		data, err := os.ReadFile(example)
+		require.NoError(t, err, "ReadFile must not error")
+		errs, err := ValidateSchema(data)
+		require.NoError(t, err, "ValidateSchema must not error")
+		assert.Emptyf(t, errs, "%s should be valid", example)
+	}
+
+	errs, err := ValidateSchema([]byte(`{"data-settings":{"interval":"10ms","data-type":"tick"}}`))
+	require.NoError(t, err, "ValidateSchema must not error")
+	require.Len(t, errs, 2, "ValidateSchema must report every error")
+	assert.Equal(t, "/data-settings/data-type", errs[0].Path)
+	assert.Equal(t, "/data-settings/interval", errs[1].Path)
+}
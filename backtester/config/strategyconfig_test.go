@@ -96,6 +96,15 @@ func TestValidateDate(t *testing.T) {
 	c.DataSettings.APIData.EndDate = c.DataSettings.APIData.StartDate.Add(time.Minute)
 	err = c.validateDate()
 	assert.NoError(t, err)
+
+	c.DataSettings.ParquetData = &ParquetData{}
+	err = c.validateDate()
+	assert.ErrorIs(t, err, gctcommon.ErrDateUnset)
+
+	c.DataSettings.ParquetData.StartDate = time.Now()
+	c.DataSettings.ParquetData.EndDate = c.DataSettings.ParquetData.StartDate.Add(time.Minute)
+	err = c.validateDate()
+	assert.NoError(t, err)
 }
 
 func TestValidateCurrencySettings(t *testing.T) {
@@ -950,6 +959,68 @@ func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForGCTScriptAPICandles(t *testing.T) {
+	if !saveConfig {
+		t.Skip("saveConfig false, skipping")
+	}
+	cfg := Config{
+		Nickname: "ExampleStrategyGCTScriptAPICandles",
+		Goal:     "To demonstrate running a gctscript as a strategy using API candle data",
+		StrategySettings: StrategySettings{
+			Name: "gctscript",
+			CustomSettings: map[string]any{
+				"script":  filepath.Join("config", "strategyexamples", "gctscript-sma.gct"),
+				"timeout": "10s",
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "gctscript-api-candles.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateConfigForDCACSVCandles(t *testing.T) {
 	if !saveConfig {
 		t.Skip("saveConfig false, skipping")
@@ -50,6 +50,7 @@ type DataSettings struct {
 	DatabaseData            *DatabaseData  `json:"database-data,omitempty"`
 	LiveData                *LiveData      `json:"live-data,omitempty"`
 	CSVData                 *CSVData       `json:"csv-data,omitempty"`
+	ParquetData             *ParquetData   `json:"parquet-data,omitempty"`
 }
 
 // FundingSettings contains funding details for individual currencies
@@ -179,6 +180,14 @@ type CSVData struct {
 	FullPath string `json:"full-path"`
 }
 
+// ParquetData defines all fields to configure parquet file based data
+type ParquetData struct {
+	StartDate        time.Time `json:"start-date"`
+	EndDate          time.Time `json:"end-date"`
+	Path             string    `json:"path"`
+	InclusiveEndDate bool      `json:"inclusive-end-date"`
+}
+
 // DatabaseData defines all fields to configure database based data
 type DatabaseData struct {
 	StartDate        time.Time       `json:"start-date"`
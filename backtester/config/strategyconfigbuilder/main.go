@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -36,6 +37,7 @@ var dataOptions = []string{
 	"API",
 	"CSV",
 	"Database",
+	"Parquet",
 	"Live",
 }
 
@@ -104,6 +106,13 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	validationErrs, err := config.ValidateSchema(resp)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := range validationErrs {
+		log.Printf("Strategy config schema error: %v", validationErrs[i])
+	}
 	fmt.Println("Write strategy config to file? If no, the output will be on screen y/n")
 	yn := quickParse(reader)
 	if yn == y || yn == yes {
@@ -165,8 +174,12 @@ func parseStatisticsSettings(cfg *config.Config, reader *bufio.Reader) error {
 
 func parseDataSettings(cfg *config.Config, reader *bufio.Reader) error {
 	var err error
-	fmt.Println("Will you be using \"candle\" or \"trade\" data?")
+	dataTypes := schemaEnum("config.DataSettings", "data-type")
+	fmt.Printf("Will you be using \"%s\" data?\n", strings.Join(dataTypes, "\" or \""))
 	cfg.DataSettings.DataType = quickParse(reader)
+	if len(dataTypes) > 0 && !slices.Contains(dataTypes, cfg.DataSettings.DataType) {
+		return fmt.Errorf("unrecognised data type %q", cfg.DataSettings.DataType)
+	}
 	if cfg.DataSettings.DataType == common.TradeStr {
 		fmt.Println("Trade data will be converted into candles")
 	}
@@ -189,6 +202,8 @@ func parseDataSettings(cfg *config.Config, reader *bufio.Reader) error {
 		err = parseDatabase(reader, cfg)
 	case "CSV":
 		parseCSV(reader, cfg)
+	case "Parquet":
+		err = parseParquet(reader, cfg)
 	case "Live":
 		parseLive(reader, cfg)
 	}
@@ -367,6 +382,42 @@ func parseCSV(reader *bufio.Reader, cfg *config.Config) {
 	cfg.DataSettings.CSVData.FullPath = quickParse(reader)
 }
 
+func parseParquet(reader *bufio.Reader, cfg *config.Config) error {
+	cfg.DataSettings.ParquetData = &config.ParquetData{}
+	var startDate, endDate, inclusive string
+	var err error
+	defaultStart := time.Now().Add(-time.Hour * 24 * 365)
+	defaultEnd := time.Now()
+	fmt.Printf("What is the start date? Leave blank for \"%v\"\n", defaultStart.Format(time.DateTime))
+	startDate = quickParse(reader)
+	if startDate != "" {
+		cfg.DataSettings.ParquetData.StartDate, err = time.Parse(time.DateTime, startDate)
+		if err != nil {
+			return err
+		}
+	} else {
+		cfg.DataSettings.ParquetData.StartDate = defaultStart
+	}
+
+	fmt.Printf("What is the end date? Leave blank for \"%v\"\n", defaultEnd.Format(time.DateTime))
+	endDate = quickParse(reader)
+	if endDate != "" {
+		cfg.DataSettings.ParquetData.EndDate, err = time.Parse(time.DateTime, endDate)
+		if err != nil {
+			return err
+		}
+	} else {
+		cfg.DataSettings.ParquetData.EndDate = defaultEnd
+	}
+	fmt.Println("Is the end date inclusive? y/n")
+	inclusive = quickParse(reader)
+	cfg.DataSettings.ParquetData.InclusiveEndDate = inclusive == y || inclusive == yes
+
+	fmt.Println("What is the parquet directory? Leave blank to use the default GoCryptoTrader data directory")
+	cfg.DataSettings.ParquetData.Path = quickParse(reader)
+	return nil
+}
+
 func parseDatabase(reader *bufio.Reader, cfg *config.Config) error {
 	cfg.DataSettings.DatabaseData = &config.DatabaseData{}
 	var input string
@@ -495,7 +546,7 @@ func parseLive(reader *bufio.Reader, cfg *config.Config) {
 func parseDataChoice(reader *bufio.Reader, multiCurrency bool) (string, error) {
 	if multiCurrency {
 		// live trading does not support multiple currencies
-		dataOptions = dataOptions[:3]
+		dataOptions = dataOptions[:len(dataOptions)-1]
 	}
 	for i := range dataOptions {
 		fmt.Printf("%v. %s\n", i+1, dataOptions[i])
@@ -744,6 +795,26 @@ func minMaxParse(buySell string, reader *bufio.Reader) (config.MinMax, error) {
 	return resp, nil
 }
 
+// schemaEnum returns the allowed values of a property of a strategy config
+// schema definition, so prompts can offer them
+func schemaEnum(def, property string) []string {
+	s, err := config.Schema()
+	if err != nil {
+		log.Println(err)
+		return nil
+	}
+	d, ok := s.Defs[def]
+	if !ok || d.Properties[property] == nil {
+		return nil
+	}
+	enum := d.Properties[property].Enum
+	values := make([]string, 0, len(enum))
+	for i := range enum {
+		values = append(values, fmt.Sprint(enum[i]))
+	}
+	return values
+}
+
 func quickParse(reader *bufio.Reader) string {
 	customSettingField, err := reader.ReadString('\n')
 	if err != nil {
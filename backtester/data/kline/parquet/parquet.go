@@ -0,0 +1,58 @@
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/parquetstore"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var errNoUSDData = errors.New("could not retrieve USD parquet data")
+
+// LoadData reads the daily partitioned parquet files exported by GoCryptoTrader
+// within dir, converting trade data into candles where required
+func LoadData(startDate, endDate time.Time, interval time.Duration, dir, exchangeName string, dataType int64, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
+	resp := kline.NewDataFromKline()
+	switch dataType {
+	case common.DataCandle:
+		klineItem, err := parquetstore.ReadCandles(dir, exchangeName, fPair, a, gctkline.Interval(interval), startDate, endDate)
+		if err != nil {
+			if isUSDTrackingPair {
+				return nil, fmt.Errorf("%w for %v %v %v. Please export USD candle pair data to parquet or set `disable-usd-tracking` to `true` in your config. %v", errNoUSDData, exchangeName, a, fPair, err)
+			}
+			return nil, fmt.Errorf("could not retrieve parquet candle data for %v %v %v, %v", exchangeName, a, fPair, err)
+		}
+		resp.Item = klineItem
+	case common.DataTrade:
+		trades, err := parquetstore.ReadTrades(dir, exchangeName, fPair, a, startDate, endDate)
+		if err != nil {
+			if isUSDTrackingPair {
+				return nil, fmt.Errorf("%w for %v %v %v. Please export USD pair trade data to parquet or set `disable-usd-tracking` to `true` in your config. %v", errNoUSDData, exchangeName, a, fPair, err)
+			}
+			return nil, fmt.Errorf("could not retrieve parquet trade data for %v %v %v, %v", exchangeName, a, fPair, err)
+		}
+		resp.Item, err = trade.ConvertTradesToCandles(gctkline.Interval(interval), trades...)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert parquet trade data for %v %v %v, %v", exchangeName, a, fPair, err)
+		}
+	default:
+		if isUSDTrackingPair {
+			return nil, fmt.Errorf("%w for %v %v %v. Please export USD pair data to parquet or set `disable-usd-tracking` to `true` in your config", errNoUSDData, exchangeName, a, fPair)
+		}
+		return nil, fmt.Errorf("could not retrieve parquet data for %v %v %v, %w", exchangeName, a, fPair, common.ErrInvalidDataType)
+	}
+	resp.Item.Exchange = strings.ToLower(exchangeName)
+	resp.Item.Pair = fPair
+	resp.Item.Asset = a
+	resp.Item.Interval = gctkline.Interval(interval)
+
+	return resp, nil
+}
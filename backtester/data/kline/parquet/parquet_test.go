@@ -0,0 +1,79 @@
+package parquet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/parquetstore"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const testExchange = "binance"
+
+var testStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestLoadDataCandles(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	p := currency.NewBTCUSDT()
+	item := &gctkline.Item{Exchange: testExchange, Pair: p, Asset: asset.Spot, Interval: gctkline.FifteenMin}
+	for i := range 4 {
+		item.Candles = append(item.Candles, gctkline.Candle{
+			Time:  testStart.Add(time.Duration(i) * gctkline.FifteenMin.Duration()),
+			Open:  1337,
+			High:  1338,
+			Low:   1336,
+			Close: 1337,
+		})
+	}
+	_, err := parquetstore.WriteCandles(dir, item, false)
+	require.NoError(t, err, "WriteCandles must not error")
+
+	resp, err := LoadData(testStart, testStart.Add(time.Hour), gctkline.FifteenMin.Duration(), dir, testExchange, common.DataCandle, p, asset.Spot, false)
+	require.NoError(t, err, "LoadData must not error")
+	assert.Len(t, resp.Item.Candles, 4, "LoadData should load all candles")
+
+	_, err = LoadData(testStart, testStart.Add(time.Hour), gctkline.FifteenMin.Duration(), dir, testExchange, common.DataCandle, currency.NewBTCUSD(), asset.Spot, true)
+	assert.ErrorIs(t, err, errNoUSDData)
+}
+
+func TestLoadDataTrades(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	p := currency.NewBTCUSDT()
+	trades := make([]trade.Data, 10)
+	for i := range trades {
+		trades[i] = trade.Data{
+			Exchange:     testExchange,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+			Side:         order.Buy,
+			Price:        float64(1337 + i),
+			Amount:       1,
+			Timestamp:    testStart.Add(time.Duration(i) * 5 * time.Minute),
+		}
+	}
+	_, err := parquetstore.WriteTrades(dir, false, trades...)
+	require.NoError(t, err, "WriteTrades must not error")
+
+	resp, err := LoadData(testStart, testStart.Add(time.Hour), gctkline.FifteenMin.Duration(), dir, testExchange, common.DataTrade, p, asset.Spot, false)
+	require.NoError(t, err, "LoadData must not error")
+	assert.Len(t, resp.Item.Candles, 4, "LoadData should convert trades into candles")
+}
+
+func TestLoadDataInvalid(t *testing.T) {
+	t.Parallel()
+	p := currency.NewBTCUSDT()
+	_, err := LoadData(testStart, testStart.Add(time.Hour), gctkline.FifteenMin.Duration(), t.TempDir(), testExchange, -1, p, asset.Spot, false)
+	assert.ErrorIs(t, err, common.ErrInvalidDataType)
+
+	_, err = LoadData(testStart, testStart.Add(time.Hour), gctkline.FifteenMin.Duration(), t.TempDir(), testExchange, -1, p, asset.Spot, true)
+	assert.ErrorIs(t, err, errNoUSDData)
+}
@@ -37,6 +37,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/parquetstore"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -234,6 +235,54 @@ func TestLoadDataCSV(t *testing.T) {
 	}
 }
 
+func TestLoadDataParquet(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{
+		Reports: &report.Data{},
+	}
+	cp := currency.NewBTCUSDT()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	dir := t.TempDir()
+	item := &gctkline.Item{Exchange: testExchange, Pair: cp, Asset: asset.Spot, Interval: gctkline.OneHour}
+	for i := range 4 {
+		item.Candles = append(item.Candles, gctkline.Candle{
+			Time:   start.Add(time.Duration(i) * time.Hour),
+			Open:   1337,
+			High:   1338,
+			Low:    1336,
+			Close:  1337,
+			Volume: 1,
+		})
+	}
+	_, err := parquetstore.WriteCandles(dir, item, false)
+	require.NoError(t, err, "WriteCandles must not error")
+
+	cfg := &config.Config{
+		DataSettings: config.DataSettings{
+			DataType: common.CandleStr,
+			Interval: gctkline.OneHour,
+			ParquetData: &config.ParquetData{
+				StartDate: start,
+				EndDate:   start.Add(4 * time.Hour),
+				Path:      dir,
+			},
+			CSVData: &config.CSVData{},
+		},
+	}
+	em := engine.ExchangeManager{}
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false)
+	assert.ErrorIs(t, err, errAmbiguousDataSource)
+
+	cfg.DataSettings.CSVData = nil
+	resp, err := bt.loadData(cfg, exch, cp, asset.Spot, false)
+	require.NoError(t, err, "loadData must not error")
+	assert.Len(t, resp.Item.Candles, 4, "loadData should load all parquet candles")
+}
+
 func TestLoadDataDatabase(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
@@ -224,6 +224,8 @@ func (s *GRPCServer) ExecuteStrategyFromFile(_ context.Context, request *btrpc.E
 			cfg.DataSettings.DatabaseData.StartDate = startTime
 		} else if cfg.DataSettings.APIData != nil {
 			cfg.DataSettings.APIData.StartDate = startTime
+		} else if cfg.DataSettings.ParquetData != nil {
+			cfg.DataSettings.ParquetData.StartDate = startTime
 		}
 	}
 	if endTime := request.EndTimeOverride.AsTime(); endTime.Unix() != 0 && !endTime.IsZero() {
@@ -231,6 +233,8 @@ func (s *GRPCServer) ExecuteStrategyFromFile(_ context.Context, request *btrpc.E
 			cfg.DataSettings.DatabaseData.EndDate = endTime
 		} else if cfg.DataSettings.APIData != nil {
 			cfg.DataSettings.APIData.EndDate = endTime
+		} else if cfg.DataSettings.ParquetData != nil {
+			cfg.DataSettings.ParquetData.EndDate = endTime
 		}
 	}
 	if err := cfg.Validate(); err != nil {
@@ -573,6 +577,15 @@ func (s *GRPCServer) ExecuteStrategyFromConfig(_ context.Context, request *btrpc
 			FullPath: request.Config.DataSettings.CsvData.Path,
 		}
 	}
+	var parquetData *config.ParquetData
+	if request.Config.DataSettings.ParquetData != nil {
+		parquetData = &config.ParquetData{
+			StartDate:        request.Config.DataSettings.ParquetData.StartDate.AsTime(),
+			EndDate:          request.Config.DataSettings.ParquetData.EndDate.AsTime(),
+			Path:             request.Config.DataSettings.ParquetData.Path,
+			InclusiveEndDate: request.Config.DataSettings.ParquetData.InclusiveEndDate,
+		}
+	}
 
 	cfg := &config.Config{
 		Nickname: request.Config.Nickname,
@@ -595,6 +608,7 @@ func (s *GRPCServer) ExecuteStrategyFromConfig(_ context.Context, request *btrpc
 			DatabaseData: dbData,
 			LiveData:     liveData,
 			CSVData:      csvData,
+			ParquetData:  parquetData,
 		},
 		PortfolioSettings: config.PortfolioSettings{
 			Leverage: config.Leverage{
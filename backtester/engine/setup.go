@@ -17,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/database"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/parquet"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
@@ -690,18 +691,22 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		return nil, engine.ErrExchangeNotFound
 	}
 	b := exch.GetBase()
-	if cfg.DataSettings.DatabaseData == nil &&
-		cfg.DataSettings.LiveData == nil &&
-		cfg.DataSettings.APIData == nil &&
-		cfg.DataSettings.CSVData == nil {
-		return nil, errNoDataSource
+	var sources int
+	for _, set := range []bool{
+		cfg.DataSettings.DatabaseData != nil,
+		cfg.DataSettings.LiveData != nil,
+		cfg.DataSettings.APIData != nil,
+		cfg.DataSettings.CSVData != nil,
+		cfg.DataSettings.ParquetData != nil,
+	} {
+		if set {
+			sources++
+		}
 	}
-	if (cfg.DataSettings.APIData != nil && cfg.DataSettings.DatabaseData != nil) ||
-		(cfg.DataSettings.APIData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.APIData != nil && cfg.DataSettings.CSVData != nil) ||
-		(cfg.DataSettings.DatabaseData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.CSVData != nil && cfg.DataSettings.LiveData != nil) ||
-		(cfg.DataSettings.CSVData != nil && cfg.DataSettings.DatabaseData != nil) {
+	switch {
+	case sources == 0:
+		return nil, errNoDataSource
+	case sources > 1:
 		return nil, errAmbiguousDataSource
 	}
 
@@ -806,6 +811,50 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 			return nil, err
 		}
 
+		summary := resp.RangeHolder.DataSummary(false)
+		if len(summary) > 0 {
+			log.Warnf(common.Setup, "%v", summary)
+		}
+	case cfg.DataSettings.ParquetData != nil:
+		if cfg.DataSettings.Interval <= 0 {
+			return nil, errIntervalUnset
+		}
+		if cfg.DataSettings.ParquetData.InclusiveEndDate {
+			cfg.DataSettings.ParquetData.EndDate = cfg.DataSettings.ParquetData.EndDate.Add(cfg.DataSettings.Interval.Duration())
+		}
+		if cfg.DataSettings.ParquetData.Path == "" {
+			cfg.DataSettings.ParquetData.Path = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "parquet")
+		}
+		resp, err = parquet.LoadData(
+			cfg.DataSettings.ParquetData.StartDate,
+			cfg.DataSettings.ParquetData.EndDate,
+			cfg.DataSettings.Interval.Duration(),
+			cfg.DataSettings.ParquetData.Path,
+			exch.GetName(),
+			dataType,
+			fPair,
+			a,
+			isUSDTrackingPair)
+		if err != nil {
+			return nil, fmt.Errorf("%v. Please ensure data has been exported to %v", err, cfg.DataSettings.ParquetData.Path)
+		}
+
+		resp.Item.RemoveDuplicates()
+		resp.Item.SortCandlesByTimestamp(false)
+		resp.RangeHolder, err = gctkline.CalculateCandleDateRanges(
+			cfg.DataSettings.ParquetData.StartDate,
+			cfg.DataSettings.ParquetData.EndDate,
+			cfg.DataSettings.Interval,
+			0,
+		)
+		if err != nil {
+			return nil, err
+		}
+		err = resp.RangeHolder.SetHasDataFromCandles(resp.Item.Candles)
+		if err != nil {
+			return nil, err
+		}
+
 		summary := resp.RangeHolder.DataSummary(false)
 		if len(summary) > 0 {
 			log.Warnf(common.Setup, "%v", summary)
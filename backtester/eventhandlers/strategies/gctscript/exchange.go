@@ -0,0 +1,648 @@
+package gctscript
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const backtestSubAccount = "backtest"
+
+// NewExchange returns a backtest exchange with no data loaded
+func NewExchange() *Exchange {
+	return &Exchange{state: make(map[string]string)}
+}
+
+// begin sets the data and funding available to a script run, after updating
+// the orders signalled by the previous run with the portfolio's fills
+func (e *Exchange) begin(d []data.Handler, funds funding.IFundingTransferer, p portfolio.Handler) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.fillSignalled(p)
+	e.data = d
+	e.funds = funds
+}
+
+// end returns the open orders which the current candles trigger, to be netted
+// into signals. Market orders trigger on the candle they are submitted on and
+// limit orders once a candle's high or low reaches their price. The returned
+// orders have their remaining amount and execution price
+func (e *Exchange) end() []order.Detail {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.signalled = nil
+	for _, ev := range e.events("") {
+		for i := range e.orders {
+			o := &e.orders[i]
+			if !isOpen(o) || !strings.EqualFold(o.Exchange, ev.GetExchange()) || o.AssetType != ev.GetAssetType() || !o.Pair.Equal(ev.Pair()) {
+				continue
+			}
+			price, triggered := executionPrice(o, ev)
+			if !triggered {
+				continue
+			}
+			signalled := o.Copy()
+			signalled.Exchange = ev.GetExchange()
+			signalled.Amount = o.RemainingAmount
+			signalled.Price = price
+			signalled.Date = ev.GetTime()
+			e.signalled = append(e.signalled, signalled)
+		}
+	}
+	e.data = nil
+	e.funds = nil
+	return slices.Clone(e.signalled)
+}
+
+// fillSignalled updates the orders signalled by the previous run with the
+// portfolio's fills at the signal time. Orders netted into the same signal
+// share its fill price and are filled in proportion to the amount filled, an
+// order is rejected when nothing was filled. Orders which net to zero, or
+// were signalled without a portfolio, are filled at their execution price
+func (e *Exchange) fillSignalled(p portfolio.Handler) {
+	for len(e.signalled) > 0 {
+		first := e.signalled[0]
+		var group, rest []order.Detail
+		var net float64
+		for i := range e.signalled {
+			if e.signalled[i].Exchange != first.Exchange || e.signalled[i].AssetType != first.AssetType || !e.signalled[i].Pair.Equal(first.Pair) {
+				rest = append(rest, e.signalled[i])
+				continue
+			}
+			group = append(group, e.signalled[i])
+			if e.signalled[i].Side.IsShort() {
+				net -= e.signalled[i].Amount
+			} else {
+				net += e.signalled[i].Amount
+			}
+		}
+		e.signalled = rest
+
+		ratio, price := 1.0, 0.0
+		if p != nil && net != 0 {
+			var filled, cost float64
+			snap, err := p.GetLatestComplianceSnapshot(first.Exchange, first.AssetType, first.Pair)
+			if err == nil {
+				for i := range snap.Orders {
+					if o := snap.Orders[i].Order; o != nil && o.Date.Equal(first.Date) {
+						filled += o.Amount
+						cost += o.Amount * o.Price
+					}
+				}
+			}
+			ratio = min(filled/math.Abs(net), 1)
+			if filled > 0 {
+				price = cost / filled
+			}
+		}
+		for i := range group {
+			e.fill(&group[i], ratio, price)
+		}
+	}
+}
+
+// fill executes the ratio of a signalled order's amount at price, or at its
+// execution price when price is zero
+func (e *Exchange) fill(signalled *order.Detail, ratio, price float64) {
+	for i := range e.orders {
+		o := &e.orders[i]
+		if o.OrderID != signalled.OrderID {
+			continue
+		}
+		o.LastUpdated = signalled.Date
+		executed := signalled.Amount * ratio
+		if executed <= 0 {
+			o.Status = order.Rejected
+			return
+		}
+		if price == 0 {
+			price = signalled.Price
+		}
+		o.AverageExecutedPrice = (o.AverageExecutedPrice*o.ExecutedAmount + price*executed) / (o.ExecutedAmount + executed)
+		o.ExecutedAmount += executed
+		o.RemainingAmount -= executed
+		o.Status = order.PartiallyFilled
+		if ratio >= 1 {
+			o.RemainingAmount = 0
+			o.Status = order.Filled
+		}
+		return
+	}
+}
+
+// executionPrice returns the price an open order executes at on the candle
+// and whether the candle triggers it. Limit orders execute at their price or
+// better once the candle's high or low reaches it, other orders execute at
+// the close price
+func executionPrice(o *order.Detail, ev data.Event) (float64, bool) {
+	closePrice := ev.GetClosePrice().InexactFloat64()
+	if o.Type != order.Limit {
+		return closePrice, true
+	}
+	if o.Side.IsShort() {
+		if ev.GetHighPrice().InexactFloat64() < o.Price {
+			return 0, false
+		}
+		return max(o.Price, closePrice), true
+	}
+	if ev.GetLowPrice().InexactFloat64() > o.Price {
+		return 0, false
+	}
+	return min(o.Price, closePrice), true
+}
+
+// isOpen returns whether the order can still be triggered or cancelled
+func isOpen(o *order.Detail) bool {
+	return o.Status == order.New || o.Status == order.PartiallyFilled
+}
+
+// now returns the close time of the current candle, replacing times.now so
+// scripts which request data relative to the current time see backtest time
+func (e *Exchange) now(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 0 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	for i := range e.data {
+		latest, err := e.data[i].Latest()
+		if err != nil || latest == nil {
+			continue
+		}
+		return &tengo.Time{Value: latest.GetTime().Add(latest.GetInterval().Duration())}, nil
+	}
+	return &tengo.Time{Value: time.Now()}, nil
+}
+
+// latest returns the data handler and current event for an exchange, asset
+// and pair
+func (e *Exchange) latest(exch string, a asset.Item, pair currency.Pair) (data.Handler, data.Event, error) {
+	for i := range e.data {
+		latest, err := e.data[i].Latest()
+		if err != nil {
+			return nil, nil, err
+		}
+		if latest != nil && strings.EqualFold(latest.GetExchange(), exch) && latest.GetAssetType() == a && latest.Pair().Equal(pair) {
+			return e.data[i], latest, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("%w: %s %s %s", errNoBacktestData, exch, a, pair)
+}
+
+// events returns the current event of each data handler for an exchange
+func (e *Exchange) events(exch string) []data.Event {
+	var resp []data.Event
+	for i := range e.data {
+		latest, err := e.data[i].Latest()
+		if err != nil || latest == nil {
+			continue
+		}
+		if exch == "" || strings.EqualFold(latest.GetExchange(), exch) {
+			resp = append(resp, latest)
+		}
+	}
+	return resp
+}
+
+// findOrder returns a submitted order by exchange and order ID
+func (e *Exchange) findOrder(exch, orderID string) (*order.Detail, error) {
+	for i := range e.orders {
+		if e.orders[i].OrderID == orderID && strings.EqualFold(e.orders[i].Exchange, exch) {
+			return &e.orders[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", errOrderNotFound, orderID)
+}
+
+// findOpenOrder returns an open order by exchange and order ID
+func (e *Exchange) findOpenOrder(exch, orderID string) (*order.Detail, error) {
+	o, err := e.findOrder(exch, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !isOpen(o) {
+		return nil, fmt.Errorf("%w: %s %s", errOrderNotOpen, orderID, o.Status)
+	}
+	return o, nil
+}
+
+// filterOrders returns copies of the orders for an exchange which match the
+// request
+func filterOrders(orders []order.Detail, exch string, req *order.MultiOrderRequest, open bool) order.FilteredOrders {
+	var resp order.FilteredOrders
+	for i := range orders {
+		if (open && !isOpen(&orders[i])) ||
+			!strings.EqualFold(orders[i].Exchange, exch) ||
+			(req.AssetType != asset.Empty && orders[i].AssetType != req.AssetType) ||
+			(len(req.Pairs) > 0 && !req.Pairs.Contains(orders[i].Pair, true)) ||
+			(!req.StartTime.IsZero() && orders[i].Date.Before(req.StartTime)) {
+			continue
+		}
+		resp = append(resp, orders[i].Copy())
+	}
+	return resp
+}
+
+// Exchanges returns the exchanges in the backtest data
+func (e *Exchange) Exchanges(bool) []string {
+	e.m.Lock()
+	defer e.m.Unlock()
+	var resp []string
+	for _, ev := range e.events("") {
+		if !slices.Contains(resp, ev.GetExchange()) {
+			resp = append(resp, ev.GetExchange())
+		}
+	}
+	return resp
+}
+
+// IsEnabled returns whether the exchange is in the backtest data
+func (e *Exchange) IsEnabled(exch string) bool {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return len(e.events(exch)) > 0
+}
+
+// Orderbook is not supported as backtests only have candle data
+func (e *Exchange) Orderbook(context.Context, string, currency.Pair, asset.Item) (*orderbook.Book, error) {
+	return nil, fmt.Errorf("%w: orderbook", gctcommon.ErrFunctionNotSupported)
+}
+
+// Ticker returns a ticker built from the current candle
+func (e *Exchange) Ticker(_ context.Context, exch string, pair currency.Pair, a asset.Item) (*ticker.Price, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	_, latest, err := e.latest(exch, a, pair)
+	if err != nil {
+		return nil, err
+	}
+	closePrice := latest.GetClosePrice().InexactFloat64()
+	return &ticker.Price{
+		Last:         closePrice,
+		High:         latest.GetHighPrice().InexactFloat64(),
+		Low:          latest.GetLowPrice().InexactFloat64(),
+		Bid:          closePrice,
+		Ask:          closePrice,
+		Volume:       latest.GetVolume().InexactFloat64(),
+		Open:         latest.GetOpenPrice().InexactFloat64(),
+		Close:        closePrice,
+		Pair:         latest.Pair(),
+		ExchangeName: latest.GetExchange(),
+		AssetType:    latest.GetAssetType(),
+		LastUpdated:  latest.GetTime(),
+	}, nil
+}
+
+// Pairs returns the pairs in the backtest data for an exchange and asset
+func (e *Exchange) Pairs(exch string, _ bool, a asset.Item) (*currency.Pairs, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	var resp currency.Pairs
+	for _, ev := range e.events(exch) {
+		if ev.GetAssetType() == a {
+			resp = resp.Add(ev.Pair())
+		}
+	}
+	return &resp, nil
+}
+
+// QueryOrder returns an order submitted by the script
+func (e *Exchange) QueryOrder(_ context.Context, exch, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	o, err := e.findOrder(exch, orderID)
+	if err != nil {
+		return nil, err
+	}
+	resp := o.Copy()
+	return &resp, nil
+}
+
+// SubmitOrder opens an order on the current candle. Open orders are turned
+// into signals once a candle triggers them and are filled from the
+// backtester's fills
+func (e *Exchange) SubmitOrder(_ context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	if s == nil {
+		return nil, fmt.Errorf("%w: order submit", gctcommon.ErrNilPointer)
+	}
+	if s.Amount <= 0 {
+		return nil, errInvalidAmount
+	}
+	if s.Type == order.Limit && s.Price <= 0 {
+		return nil, errInvalidLimitPrice
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	_, latest, err := e.latest(s.Exchange, s.AssetType, s.Pair)
+	if err != nil {
+		return nil, err
+	}
+
+	e.nextID++
+	orderID := strconv.FormatInt(e.nextID, 10)
+	resp, err := s.DeriveSubmitResponse(orderID)
+	if err != nil {
+		return nil, err
+	}
+	submittedAt := latest.GetTime()
+	resp.Status = order.New
+	resp.Date = submittedAt
+	resp.LastUpdated = submittedAt
+	e.orders = append(e.orders, order.Detail{
+		Exchange:        s.Exchange,
+		OrderID:         orderID,
+		ClientID:        s.ClientID,
+		ClientOrderID:   s.ClientOrderID,
+		Pair:            s.Pair,
+		AssetType:       s.AssetType,
+		Side:            s.Side,
+		Type:            s.Type,
+		Price:           s.Price,
+		Amount:          s.Amount,
+		RemainingAmount: s.Amount,
+		Status:          order.New,
+		Date:            submittedAt,
+		LastUpdated:     submittedAt,
+	})
+	return resp, nil
+}
+
+// CancelOrder cancels an open order
+func (e *Exchange) CancelOrder(_ context.Context, exch, orderID string, _ currency.Pair, _ asset.Item) (bool, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	o, err := e.findOpenOrder(exch, orderID)
+	if err != nil {
+		return false, err
+	}
+	o.Status = order.Cancelled
+	return true, nil
+}
+
+// AccountBalances returns the available backtest funding for an exchange and
+// asset
+func (e *Exchange) AccountBalances(_ context.Context, exch string, a asset.Item) (accounts.SubAccounts, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	if e.funds == nil {
+		return nil, errNoFunding
+	}
+	sub := accounts.NewSubAccount(a, backtestSubAccount)
+	for _, ev := range e.events(exch) {
+		if ev.GetAssetType() != a {
+			continue
+		}
+		funds, err := e.funds.GetFundingForEvent(ev)
+		if err != nil {
+			return nil, err
+		}
+		if a.IsFutures() {
+			collateral, err := funds.FundReader().GetCollateralReader()
+			if err != nil {
+				return nil, err
+			}
+			available := collateral.AvailableFunds().InexactFloat64()
+			sub.Balances.Set(collateral.CollateralCurrency(), accounts.Balance{Total: available, Free: available})
+			continue
+		}
+		pair, err := funds.FundReader().GetPairReader()
+		if err != nil {
+			return nil, err
+		}
+		baseAvailable := pair.BaseAvailable().InexactFloat64()
+		quoteAvailable := pair.QuoteAvailable().InexactFloat64()
+		sub.Balances.Set(ev.Pair().Base, accounts.Balance{Total: baseAvailable, Free: baseAvailable})
+		sub.Balances.Set(ev.Pair().Quote, accounts.Balance{Total: quoteAvailable, Free: quoteAvailable})
+	}
+	if len(sub.Balances) == 0 {
+		return nil, fmt.Errorf("%w: %s %s", errNoBacktestData, exch, a)
+	}
+	return accounts.SubAccounts{sub}, nil
+}
+
+// DepositAddress is not supported in backtests
+func (e *Exchange) DepositAddress(string, string, currency.Code) (*deposit.Address, error) {
+	return nil, fmt.Errorf("%w: deposit address", gctcommon.ErrFunctionNotSupported)
+}
+
+// WithdrawalFiatFunds is not supported in backtests
+func (e *Exchange) WithdrawalFiatFunds(context.Context, string, *withdraw.Request) (string, error) {
+	return "", fmt.Errorf("%w: withdrawals", gctcommon.ErrFunctionNotSupported)
+}
+
+// WithdrawalCryptoFunds is not supported in backtests
+func (e *Exchange) WithdrawalCryptoFunds(context.Context, *withdraw.Request) (string, error) {
+	return "", fmt.Errorf("%w: withdrawals", gctcommon.ErrFunctionNotSupported)
+}
+
+// OHLCV returns the backtest candles between start and end up to and
+// including the current candle. The interval must match the backtest data
+func (e *Exchange) OHLCV(_ context.Context, exch string, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	d, latest, err := e.latest(exch, a, pair)
+	if err != nil {
+		return nil, err
+	}
+	if latest.GetInterval() != interval {
+		return nil, fmt.Errorf("%w: requested %s, data is %s", errIntervalMismatch, interval, latest.GetInterval())
+	}
+	history, err := d.History()
+	if err != nil {
+		return nil, err
+	}
+	resp := &kline.Item{
+		Exchange:       latest.GetExchange(),
+		Pair:           latest.Pair(),
+		UnderlyingPair: latest.GetUnderlyingPair(),
+		Asset:          a,
+		Interval:       interval,
+	}
+	for i := range history {
+		t := history[i].GetTime()
+		if t.Before(start) || !t.Before(end) {
+			continue
+		}
+		resp.Candles = append(resp.Candles, kline.Candle{
+			Time:   t,
+			Open:   history[i].GetOpenPrice().InexactFloat64(),
+			High:   history[i].GetHighPrice().InexactFloat64(),
+			Low:    history[i].GetLowPrice().InexactFloat64(),
+			Close:  history[i].GetClosePrice().InexactFloat64(),
+			Volume: history[i].GetVolume().InexactFloat64(),
+		})
+	}
+	return resp, nil
+}
+
+// ModifyOrder changes the price or amount of an open order. The amount
+// cannot be reduced below the amount already executed
+func (e *Exchange) ModifyOrder(_ context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	if mod == nil {
+		return nil, fmt.Errorf("%w: order modify", gctcommon.ErrNilPointer)
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	o, err := e.findOpenOrder(mod.Exchange, mod.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	if mod.Amount < 0 || (mod.Amount > 0 && mod.Amount <= o.ExecutedAmount) {
+		return nil, errInvalidAmount
+	}
+	if mod.Price < 0 || (o.Type == order.Limit && mod.Price == 0 && o.Price == 0) {
+		return nil, errInvalidLimitPrice
+	}
+	if mod.Price > 0 {
+		o.Price = mod.Price
+	}
+	if mod.Amount > 0 {
+		o.Amount = mod.Amount
+		o.RemainingAmount = mod.Amount - o.ExecutedAmount
+	}
+	resp, err := mod.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.Price = o.Price
+	resp.Amount = o.Amount
+	return resp, nil
+}
+
+// CancelBatchOrders cancels each open order, returning why an order could
+// not be cancelled
+func (e *Exchange) CancelBatchOrders(_ context.Context, exch string, cancels []order.Cancel) (*order.CancelBatchResponse, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	resp := &order.CancelBatchResponse{Status: make(map[string]string, len(cancels))}
+	for i := range cancels {
+		o, err := e.findOpenOrder(exch, cancels[i].OrderID)
+		if err != nil {
+			resp.Status[cancels[i].OrderID] = err.Error()
+			continue
+		}
+		o.Status = order.Cancelled
+		resp.Status[cancels[i].OrderID] = order.Cancelled.String()
+	}
+	return resp, nil
+}
+
+// CancelAllOrders cancels the open orders for an exchange, limited to the
+// cancel's asset and pair when set
+func (e *Exchange) CancelAllOrders(_ context.Context, exch string, c *order.Cancel) (order.CancelAllResponse, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	for i := range e.orders {
+		o := &e.orders[i]
+		if !isOpen(o) || !strings.EqualFold(o.Exchange, exch) ||
+			(c != nil && c.AssetType != asset.Empty && o.AssetType != c.AssetType) ||
+			(c != nil && !c.Pair.IsEmpty() && !o.Pair.Equal(c.Pair)) {
+			continue
+		}
+		o.Status = order.Cancelled
+		resp.Status[o.OrderID] = order.Cancelled.String()
+	}
+	return resp, nil
+}
+
+// ActiveOrders returns the script's open orders
+func (e *Exchange) ActiveOrders(_ context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: multi order request", gctcommon.ErrNilPointer)
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	return filterOrders(e.orders, exch, req, true), nil
+}
+
+// OrderHistory returns the orders submitted by the script
+func (e *Exchange) OrderHistory(_ context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: multi order request", gctcommon.ErrNilPointer)
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	return filterOrders(e.orders, exch, req, false), nil
+}
+
+// HistoricTrades is not supported as backtests only have candle data
+func (e *Exchange) HistoricTrades(context.Context, string, currency.Pair, asset.Item, time.Time, time.Time) ([]trade.Data, error) {
+	return nil, fmt.Errorf("%w: historic trades", gctcommon.ErrFunctionNotSupported)
+}
+
+// FuturesPositions is not supported in backtests, use the portfolio results
+func (e *Exchange) FuturesPositions(context.Context, string, *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	return nil, fmt.Errorf("%w: futures positions", gctcommon.ErrFunctionNotSupported)
+}
+
+// SetLeverage is not supported in backtests, leverage is set in the strategy
+// config
+func (e *Exchange) SetLeverage(context.Context, string, asset.Item, currency.Pair, margin.Type, float64, order.Side) error {
+	return fmt.Errorf("%w: set leverage", gctcommon.ErrFunctionNotSupported)
+}
+
+// LatestFundingRates is not supported as backtests only have candle data
+func (e *Exchange) LatestFundingRates(context.Context, string, *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	return nil, fmt.Errorf("%w: funding rates", gctcommon.ErrFunctionNotSupported)
+}
+
+// OpenInterest is not supported as backtests only have candle data
+func (e *Exchange) OpenInterest(context.Context, string, ...key.PairAsset) ([]futures.OpenInterest, error) {
+	return nil, fmt.Errorf("%w: open interest", gctcommon.ErrFunctionNotSupported)
+}
+
+// SubscribeAccountBalances is not supported in backtests
+func (e *Exchange) SubscribeAccountBalances(string) (dispatch.Pipe, error) {
+	return dispatch.Pipe{}, fmt.Errorf("%w: balance subscriptions", gctcommon.ErrFunctionNotSupported)
+}
+
+// GetState returns a value stored by the script during the backtest
+func (e *Exchange) GetState(_ context.Context, scriptName, k string) (value string, found bool, err error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	value, found = e.state[scriptName+"/"+k]
+	return value, found, nil
+}
+
+// SetState stores a value for the script in memory, so backtests do not
+// change the state of live scripts
+func (e *Exchange) SetState(_ context.Context, scriptName, k, value string) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.state[scriptName+"/"+k] = value
+	return nil
+}
+
+// DeleteState removes a value stored by the script
+func (e *Exchange) DeleteState(_ context.Context, scriptName, k string) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	delete(e.state, scriptName+"/"+k)
+	return nil
+}
@@ -0,0 +1,240 @@
+package gctscript
+
+import (
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// testExchangeWithData returns an exchange with daily candles up to the
+// second candle
+func testExchangeWithData(t *testing.T) *Exchange {
+	t.Helper()
+	d := testData(t, 1, 2, 3)
+	for range 2 {
+		_, err := d.Next()
+		require.NoError(t, err, "Next must not error")
+	}
+	e := NewExchange()
+	e.begin([]data.Handler{d}, nil, nil)
+	return e
+}
+
+func TestExchangeMarketData(t *testing.T) {
+	t.Parallel()
+	e := testExchangeWithData(t)
+	p := currency.NewBTCUSDT()
+
+	assert.Equal(t, []string{testExchange}, e.Exchanges(true))
+	assert.True(t, e.IsEnabled("Binance"), "IsEnabled should match the exchange name case insensitively")
+	assert.False(t, e.IsEnabled("kraken"))
+
+	pairs, err := e.Pairs(testExchange, true, asset.Spot)
+	require.NoError(t, err, "Pairs must not error")
+	assert.Equal(t, currency.Pairs{p}, *pairs)
+
+	tick, err := e.Ticker(t.Context(), testExchange, p, asset.Spot)
+	require.NoError(t, err, "Ticker must not error")
+	assert.Equal(t, 2.0, tick.Last, "Ticker should return the current close price")
+	_, err = e.Ticker(t.Context(), testExchange, currency.NewBTCUSD(), asset.Spot)
+	assert.ErrorIs(t, err, errNoBacktestData)
+
+	_, err = e.OHLCV(t.Context(), testExchange, p, asset.Spot, testStart, testStart.AddDate(1, 0, 0), gctkline.OneHour)
+	assert.ErrorIs(t, err, errIntervalMismatch)
+	item, err := e.OHLCV(t.Context(), testExchange, p, asset.Spot, testStart.AddDate(0, 0, 1), testStart.AddDate(1, 0, 0), gctkline.OneDay)
+	require.NoError(t, err, "OHLCV must not error")
+	require.Len(t, item.Candles, 1, "OHLCV must only return candles in range up to the current candle")
+	assert.Equal(t, 2.0, item.Candles[0].Close)
+
+	now, err := e.now()
+	require.NoError(t, err, "now must not error")
+	assert.Equal(t, &tengo.Time{Value: testStart.AddDate(0, 0, 2)}, now, "now should return the current candle's close time")
+	_, err = e.now(tengo.TrueValue)
+	assert.ErrorIs(t, err, tengo.ErrWrongNumArguments)
+
+	_, err = e.Orderbook(t.Context(), testExchange, p, asset.Spot)
+	assert.ErrorIs(t, err, gctcommon.ErrFunctionNotSupported)
+	_, err = e.HistoricTrades(t.Context(), testExchange, p, asset.Spot, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, gctcommon.ErrFunctionNotSupported)
+}
+
+// testPortfolio returns a compliance snapshot of the backtester's fills
+type testPortfolio struct {
+	portfolio.Handler
+	snapshot compliance.Snapshot
+}
+
+func (p *testPortfolio) GetLatestComplianceSnapshot(string, asset.Item, currency.Pair) (*compliance.Snapshot, error) {
+	return &p.snapshot, nil
+}
+
+func TestExchangeOrders(t *testing.T) {
+	t.Parallel()
+	e := testExchangeWithData(t)
+	p := currency.NewBTCUSDT()
+	submit := &order.Submit{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: order.Buy, Type: order.Market, Amount: 1}
+
+	_, err := e.SubmitOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+	_, err = e.SubmitOrder(t.Context(), &order.Submit{Exchange: testExchange, Pair: p, AssetType: asset.Spot})
+	assert.ErrorIs(t, err, errInvalidAmount)
+	_, err = e.SubmitOrder(t.Context(), &order.Submit{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Type: order.Limit, Amount: 1})
+	assert.ErrorIs(t, err, errInvalidLimitPrice)
+	_, err = e.SubmitOrder(t.Context(), &order.Submit{Exchange: testExchange, Pair: currency.NewBTCUSD(), AssetType: asset.Spot, Amount: 1})
+	assert.ErrorIs(t, err, errNoBacktestData)
+
+	resp, err := e.SubmitOrder(t.Context(), submit)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.New, resp.Status, "SubmitOrder should open the order")
+
+	o, err := e.QueryOrder(t.Context(), testExchange, resp.OrderID, p, asset.Spot)
+	require.NoError(t, err, "QueryOrder must not error")
+	assert.Equal(t, order.New, o.Status, "QueryOrder should return the open order")
+	assert.Zero(t, o.ExecutedAmount, "QueryOrder should return the order unfilled")
+	_, err = e.QueryOrder(t.Context(), testExchange, "1337", p, asset.Spot)
+	assert.ErrorIs(t, err, errOrderNotFound)
+
+	_, err = e.ActiveOrders(t.Context(), testExchange, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+	active, err := e.ActiveOrders(t.Context(), testExchange, &order.MultiOrderRequest{})
+	require.NoError(t, err, "ActiveOrders must not error")
+	assert.Len(t, active, 1, "ActiveOrders should return open orders")
+
+	triggered := e.end()
+	require.Len(t, triggered, 1, "end must return the orders triggered by the current candle")
+	assert.Equal(t, 2.0, triggered[0].Price, "market orders should execute at the close price")
+
+	e.begin(nil, nil, nil)
+	o, err = e.QueryOrder(t.Context(), testExchange, resp.OrderID, p, asset.Spot)
+	require.NoError(t, err, "QueryOrder must not error")
+	assert.Equal(t, order.Filled, o.Status, "begin should fill signalled orders without a portfolio")
+	assert.Equal(t, 2.0, o.AverageExecutedPrice, "begin should fill signalled orders at their execution price")
+
+	_, err = e.CancelOrder(t.Context(), testExchange, resp.OrderID, p, asset.Spot)
+	assert.ErrorIs(t, err, errOrderNotOpen)
+	_, err = e.CancelOrder(t.Context(), testExchange, "1337", p, asset.Spot)
+	assert.ErrorIs(t, err, errOrderNotFound)
+	_, err = e.ModifyOrder(t.Context(), &order.Modify{Exchange: testExchange, OrderID: resp.OrderID})
+	assert.ErrorIs(t, err, errOrderNotOpen)
+	_, err = e.ModifyOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+
+	_, err = e.OrderHistory(t.Context(), testExchange, nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer)
+	history, err := e.OrderHistory(t.Context(), testExchange, &order.MultiOrderRequest{AssetType: asset.Spot, Pairs: currency.Pairs{p}})
+	require.NoError(t, err, "OrderHistory must not error")
+	assert.Len(t, history, 1, "OrderHistory should return submitted orders")
+	history, err = e.OrderHistory(t.Context(), testExchange, &order.MultiOrderRequest{AssetType: asset.Futures})
+	require.NoError(t, err, "OrderHistory must not error")
+	assert.Empty(t, history, "OrderHistory should filter by asset")
+}
+
+func TestExchangeLimitOrders(t *testing.T) {
+	t.Parallel()
+	d := testData(t, 1, 2, 3)
+	for range 2 {
+		_, err := d.Next()
+		require.NoError(t, err, "Next must not error")
+	}
+	e := NewExchange()
+	e.begin([]data.Handler{d}, nil, nil)
+	p := currency.NewBTCUSDT()
+	limit := func(side order.Side, price float64) string {
+		resp, err := e.SubmitOrder(t.Context(), &order.Submit{Exchange: testExchange, Pair: p, AssetType: asset.Spot, Side: side, Type: order.Limit, Price: price, Amount: 1})
+		require.NoError(t, err, "SubmitOrder must not error")
+		return resp.OrderID
+	}
+	buy, sell, cancelled := limit(order.Buy, 1.5), limit(order.Sell, 2.5), limit(order.Sell, 5)
+
+	_, err := e.ModifyOrder(t.Context(), &order.Modify{Exchange: testExchange, OrderID: buy, Amount: -1})
+	assert.ErrorIs(t, err, errInvalidAmount)
+	ok, err := e.CancelOrder(t.Context(), testExchange, cancelled, p, asset.Spot)
+	require.NoError(t, err, "CancelOrder must not error")
+	assert.True(t, ok, "CancelOrder should cancel an open order")
+	assert.Empty(t, e.end(), "limit orders outside the candle's range should not be triggered")
+
+	_, err = d.Next()
+	require.NoError(t, err, "Next must not error")
+	e.begin([]data.Handler{d}, nil, nil)
+	mod, err := e.ModifyOrder(t.Context(), &order.Modify{Exchange: testExchange, OrderID: buy, Price: 4, Amount: 2})
+	require.NoError(t, err, "ModifyOrder must not error")
+	assert.Equal(t, 4.0, mod.Price, "ModifyOrder should return the new price")
+	triggered := e.end()
+	require.Len(t, triggered, 2, "limit orders within the candle's range must be triggered")
+	assert.Equal(t, 3.0, triggered[0].Price, "buy limit orders should execute at the close price when it is lower")
+	assert.Equal(t, 2.0, triggered[0].Amount, "triggered orders should have their remaining amount")
+	assert.Equal(t, 3.0, triggered[1].Price, "sell limit orders should execute at the close price when it is higher")
+
+	e.begin(nil, nil, &testPortfolio{snapshot: compliance.Snapshot{Orders: []compliance.SnapshotOrder{
+		{Order: &order.Detail{Date: triggered[0].Date, Price: 2.9, Amount: 0.5}},
+	}}})
+	o, err := e.QueryOrder(t.Context(), testExchange, buy, p, asset.Spot)
+	require.NoError(t, err, "QueryOrder must not error")
+	assert.Equal(t, order.PartiallyFilled, o.Status, "orders should be filled in proportion to the backtester's fill")
+	assert.Equal(t, 1.0, o.ExecutedAmount, "orders should be filled in proportion to the backtester's fill")
+	assert.Equal(t, 1.0, o.RemainingAmount, "partially filled orders should have a remaining amount")
+	assert.Equal(t, 2.9, o.AverageExecutedPrice, "orders should be filled at the backtester's fill price")
+
+	active, err := e.ActiveOrders(t.Context(), testExchange, &order.MultiOrderRequest{})
+	require.NoError(t, err, "ActiveOrders must not error")
+	assert.Len(t, active, 2, "partially filled orders should remain active")
+	all, err := e.CancelAllOrders(t.Context(), testExchange, &order.Cancel{AssetType: asset.Spot})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.Len(t, all.Status, 2, "CancelAllOrders should cancel every open order")
+	batch, err := e.CancelBatchOrders(t.Context(), testExchange, []order.Cancel{{OrderID: sell}, {OrderID: "1337"}})
+	require.NoError(t, err, "CancelBatchOrders must not error")
+	assert.Contains(t, batch.Status[sell], errOrderNotOpen.Error())
+	assert.Contains(t, batch.Status["1337"], errOrderNotFound.Error())
+}
+
+func TestExchangeRejectedOrders(t *testing.T) {
+	t.Parallel()
+	e := testExchangeWithData(t)
+	resp, err := e.SubmitOrder(t.Context(), &order.Submit{Exchange: testExchange, Pair: currency.NewBTCUSDT(), AssetType: asset.Spot, Side: order.Sell, Type: order.Market, Amount: 1})
+	require.NoError(t, err, "SubmitOrder must not error")
+	require.Len(t, e.end(), 1, "end must return the triggered order")
+	e.begin(nil, nil, &testPortfolio{})
+	o, err := e.QueryOrder(t.Context(), testExchange, resp.OrderID, currency.NewBTCUSDT(), asset.Spot)
+	require.NoError(t, err, "QueryOrder must not error")
+	assert.Equal(t, order.Rejected, o.Status, "orders should be rejected when the backtester did not fill them")
+}
+
+func TestExchangeAccountBalances(t *testing.T) {
+	t.Parallel()
+	e := testExchangeWithData(t)
+	_, err := e.AccountBalances(t.Context(), testExchange, asset.Spot)
+	assert.ErrorIs(t, err, errNoFunding)
+	_, err = e.WithdrawalCryptoFunds(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrFunctionNotSupported)
+	_, err = e.DepositAddress(testExchange, "", currency.BTC)
+	assert.ErrorIs(t, err, gctcommon.ErrFunctionNotSupported)
+}
+
+func TestExchangeState(t *testing.T) {
+	t.Parallel()
+	e := NewExchange()
+	ctx := t.Context()
+	require.NoError(t, e.SetState(ctx, "script.gct", "key", "1"))
+	v, found, err := e.GetState(ctx, "script.gct", "key")
+	require.NoError(t, err)
+	assert.True(t, found, "GetState should find the stored key")
+	assert.Equal(t, "1", v)
+	_, found, err = e.GetState(ctx, "other.gct", "key")
+	require.NoError(t, err)
+	assert.False(t, found, "GetState should scope keys by script")
+	require.NoError(t, e.DeleteState(ctx, "script.gct", "key"))
+	_, found, err = e.GetState(ctx, "script.gct", "key")
+	require.NoError(t, err)
+	assert.False(t, found, "GetState should not find a deleted key")
+}
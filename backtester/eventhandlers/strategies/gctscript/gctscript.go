@@ -0,0 +1,245 @@
+package gctscript
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/stdlib"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/loader"
+)
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return description
+}
+
+// OnSignal runs the script with the data event and returns a signal for the
+// script's orders which the current candle triggers
+func (s *Strategy) OnSignal(d data.Handler, f funding.IFundingTransferer, p portfolio.Handler) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	orders, err := s.run([]data.Handler{d}, f, p)
+	if err != nil {
+		return nil, err
+	}
+	return s.signal(d, orders)
+}
+
+// SupportsSimultaneousProcessing highlights whether the strategy can handle multiple currency calculation
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals runs the script once with every data event, allowing
+// the script to trade any of the currencies in a single run
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) ([]signal.Event, error) {
+	if len(d) == 0 {
+		return nil, base.ErrNoDataToProcess
+	}
+	orders, err := s.run(d, f, p)
+	if err != nil {
+		return nil, err
+	}
+	var resp []signal.Event
+	var errs error
+	for i := range d {
+		sigEvent, err := s.signal(d[i], orders)
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+		} else {
+			resp = append(resp, sigEvent)
+		}
+	}
+	return resp, errs
+}
+
+// SetCustomSettings sets the script to run and its timeout
+func (s *Strategy) SetCustomSettings(customSettings map[string]any) error {
+	for k, v := range customSettings {
+		switch k {
+		case scriptKey:
+			scriptPath, ok := v.(string)
+			if !ok || scriptPath == "" {
+				return fmt.Errorf("%w provided script value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.scriptPath = scriptPath
+		case timeoutKey:
+			timeoutStr, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%w provided timeout value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			timeout, err := time.ParseDuration(timeoutStr)
+			if err != nil || timeout <= 0 {
+				return fmt.Errorf("%w provided timeout value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.timeout = timeout
+		default:
+			return fmt.Errorf("%w unrecognised custom setting key %v with value %v. Cannot apply", base.ErrInvalidCustomSettings, k, v)
+		}
+	}
+	if s.scriptPath == "" {
+		return nil
+	}
+	return s.load()
+}
+
+// SetDefaults sets the custom settings to their default values
+func (s *Strategy) SetDefaults() {
+	s.timeout = defaultTimeout
+}
+
+// load compiles the script with the gctscript modules served by a backtest
+// exchange. times.now returns the current candle's close time
+func (s *Strategy) load() error {
+	code, err := os.ReadFile(s.scriptPath)
+	if err != nil {
+		return fmt.Errorf("%w could not read script: %w", base.ErrInvalidCustomSettings, err)
+	}
+	s.exchange = NewExchange()
+
+	name := filepath.Base(s.scriptPath)
+	scriptCtx := &gct.Context{}
+	scriptCtx.Value = map[string]tengo.Object{
+		"script": &tengo.String{Value: strings.TrimSuffix(name, filepath.Ext(name)) + "-backtest"},
+		"name":   &tengo.String{Value: name},
+	}
+	script := tengo.NewScript(code)
+	if err = script.Add("ctx", scriptCtx); err != nil {
+		return err
+	}
+	imports := loader.GetModuleMap()
+	times := maps.Clone(stdlib.BuiltinModules["times"])
+	times["now"] = &tengo.UserFunction{Name: "now", Value: s.exchange.now}
+	imports.AddBuiltinModule("times", times)
+	script.SetImports(imports)
+
+	s.compiled, err = script.Compile()
+	if err != nil {
+		return fmt.Errorf("%w could not compile script %s: %w", base.ErrInvalidCustomSettings, s.scriptPath, err)
+	}
+	return nil
+}
+
+// run executes the script with the data events and returns the orders which
+// the current candles trigger. The portfolio's fills of the previous run's
+// orders are applied first. Script runs are serialised while the backtest
+// exchange is set as the gctscript module wrapper
+func (s *Strategy) run(d []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) ([]order.Detail, error) {
+	if s.compiled == nil || s.exchange == nil {
+		return nil, errNoScript
+	}
+	timeout := s.timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	wrapperMtx.Lock()
+	defer wrapperMtx.Unlock()
+	previous := modules.Wrapper
+	modules.SetModuleWrapper(s.exchange)
+	defer modules.SetModuleWrapper(previous)
+
+	s.exchange.begin(d, f, p)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := s.compiled.RunContext(ctx)
+	orders := s.exchange.end()
+	if err != nil {
+		return nil, fmt.Errorf("script %s: %w", s.scriptPath, err)
+	}
+	return orders, nil
+}
+
+// signal nets the triggered orders for the data event's exchange, asset and
+// pair into a single signal, priced at the amount weighted execution price of
+// the orders on its side
+func (s *Strategy) signal(d data.Handler, orders []order.Detail) (signal.Event, error) {
+	es, err := s.GetBaseData(d)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := d.Latest()
+	if err != nil {
+		return nil, err
+	}
+	es.SetPrice(latest.GetClosePrice())
+
+	hasDataAtTime, err := d.HasDataAtTime(latest.GetTime())
+	if err != nil {
+		return nil, err
+	}
+	if !hasDataAtTime {
+		es.SetDirection(order.MissingData)
+		es.AppendReasonf("missing data at %v, cannot perform any actions", latest.GetTime())
+		return &es, nil
+	}
+
+	var long, longCost, short, shortCost decimal.Decimal
+	var count int
+	for i := range orders {
+		if !strings.EqualFold(orders[i].Exchange, latest.GetExchange()) ||
+			orders[i].AssetType != latest.GetAssetType() ||
+			!orders[i].Pair.Equal(latest.Pair()) {
+			continue
+		}
+		count++
+		amount := decimal.NewFromFloat(orders[i].Amount)
+		cost := amount.Mul(decimal.NewFromFloat(orders[i].Price))
+		if orders[i].Side.IsShort() {
+			short, shortCost = short.Add(amount), shortCost.Add(cost)
+		} else {
+			long, longCost = long.Add(amount), longCost.Add(cost)
+		}
+	}
+	net := long.Sub(short)
+
+	switch {
+	case count == 0:
+		es.SetDirection(order.DoNothing)
+		es.AppendReason("script submitted no orders")
+	case net.IsZero():
+		es.SetDirection(order.DoNothing)
+		es.AppendReasonf("script submitted %v orders which net to zero", count)
+	default:
+		side, amount, cost := order.Buy, long, longCost
+		if net.IsNegative() {
+			side, amount, cost = order.Sell, short, shortCost
+		}
+		if cost.IsPositive() {
+			es.SetPrice(cost.Div(amount))
+		}
+		if latest.GetAssetType().IsFutures() {
+			if side, err = side.Position(); err != nil {
+				return nil, err
+			}
+		}
+		es.SetDirection(side)
+		es.SetAmount(net.Abs())
+		es.AppendReasonf("script submitted %v orders", count)
+	}
+	return &es, nil
+}
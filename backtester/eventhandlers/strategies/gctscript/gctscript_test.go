@@ -0,0 +1,215 @@
+package gctscript
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+const (
+	testExchange = "binance"
+	// testScript buys when the latest close is higher than the previous close
+	testScript = `exch := import("exchange")
+t := import("times")
+ohlcvData := exch.ohlcv(ctx, "binance", "BTC-USDT", "-", "spot", t.add(t.now(), -t.hour*48), t.now(), "1d")
+if !is_error(ohlcvData) && len(ohlcvData.candles) == 2 && ohlcvData.candles[1][4] > ohlcvData.candles[0][4] {
+	exch.ordersubmit(ctx, "binance", "BTC-USDT", "-", "MARKET", "BUY", 0, 1, "", "spot")
+}`
+)
+
+var testStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testData returns daily candles with the close prices
+func testData(t *testing.T, closes ...float64) *kline.DataFromKline {
+	t.Helper()
+	item := &gctkline.Item{
+		Exchange: testExchange,
+		Pair:     currency.NewBTCUSDT(),
+		Asset:    asset.Spot,
+		Interval: gctkline.OneDay,
+	}
+	for i, c := range closes {
+		item.Candles = append(item.Candles, gctkline.Candle{
+			Time:   testStart.AddDate(0, 0, i),
+			Open:   c,
+			High:   c,
+			Low:    c,
+			Close:  c,
+			Volume: 1,
+		})
+	}
+	ranger, err := gctkline.CalculateCandleDateRanges(testStart, testStart.AddDate(0, 0, len(closes)), gctkline.OneDay, 100000)
+	require.NoError(t, err, "CalculateCandleDateRanges must not error")
+	require.NoError(t, ranger.SetHasDataFromCandles(item.Candles), "SetHasDataFromCandles must not error")
+	d := &kline.DataFromKline{Item: item, Base: &data.Base{}, RangeHolder: ranger}
+	require.NoError(t, d.Load(), "Load must not error")
+	return d
+}
+
+// testStrategy returns a strategy running the script
+func testStrategy(t *testing.T, script string) *Strategy {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.gct")
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o600), "WriteFile must not error")
+	s := &Strategy{}
+	s.SetDefaults()
+	require.NoError(t, s.SetCustomSettings(map[string]any{scriptKey: path}), "SetCustomSettings must not error")
+	return s
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, Name, (&Strategy{}).Name())
+}
+
+func TestDescription(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, description, (&Strategy{}).Description())
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	assert.True(t, (&Strategy{}).SupportsSimultaneousProcessing())
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	s.SetDefaults()
+	assert.Equal(t, defaultTimeout, s.timeout, "SetDefaults should set the timeout")
+	assert.NoError(t, s.SetCustomSettings(nil))
+
+	assert.ErrorIs(t, s.SetCustomSettings(map[string]any{scriptKey: 1.0}), base.ErrInvalidCustomSettings)
+	assert.ErrorIs(t, s.SetCustomSettings(map[string]any{timeoutKey: 1.0}), base.ErrInvalidCustomSettings)
+	assert.ErrorIs(t, s.SetCustomSettings(map[string]any{timeoutKey: "-1s"}), base.ErrInvalidCustomSettings)
+	assert.ErrorIs(t, s.SetCustomSettings(map[string]any{"lol": 1.0}), base.ErrInvalidCustomSettings)
+	assert.ErrorIs(t, s.SetCustomSettings(map[string]any{scriptKey: filepath.Join(t.TempDir(), "missing.gct")}), base.ErrInvalidCustomSettings)
+
+	broken := filepath.Join(t.TempDir(), "broken.gct")
+	require.NoError(t, os.WriteFile(broken, []byte("x := "), 0o600), "WriteFile must not error")
+	assert.ErrorIs(t, s.SetCustomSettings(map[string]any{scriptKey: broken}), base.ErrInvalidCustomSettings)
+
+	s = testStrategy(t, testScript)
+	require.NoError(t, s.SetCustomSettings(map[string]any{timeoutKey: "5s"}))
+	assert.Equal(t, 5*time.Second, s.timeout, "SetCustomSettings should set the timeout")
+	assert.NotNil(t, s.compiled, "SetCustomSettings should compile the script")
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	_, err := s.OnSignal(nil, nil, nil)
+	assert.ErrorIs(t, err, common.ErrNilEvent)
+
+	d := testData(t, 1, 2, 1)
+	_, err = d.Next()
+	require.NoError(t, err, "Next must not error")
+	_, err = s.OnSignal(d, nil, nil)
+	assert.ErrorIs(t, err, errNoScript)
+
+	s = testStrategy(t, testScript)
+	wrapperMtx.Lock()
+	previous := modules.Wrapper
+	wrapperMtx.Unlock()
+	for i, expected := range []order.Side{order.DoNothing, order.Buy, order.DoNothing} {
+		if i > 0 {
+			_, err = d.Next()
+			require.NoError(t, err, "Next must not error")
+		}
+		resp, err := s.OnSignal(d, nil, nil)
+		require.NoError(t, err, "OnSignal must not error")
+		assert.Equal(t, expected, resp.GetDirection(), "OnSignal should return the script's order direction")
+		if expected == order.Buy {
+			assert.Equal(t, decimal.NewFromInt(1), resp.GetAmount(), "OnSignal should set the script's order amount")
+		}
+	}
+	wrapperMtx.Lock()
+	assert.Equal(t, previous, modules.Wrapper, "OnSignal should restore the module wrapper")
+	wrapperMtx.Unlock()
+	assert.Len(t, s.exchange.orders, 1, "OnSignal should record submitted orders")
+
+	s = testStrategy(t, `x := import("exchange").notafunction()`)
+	_, err = s.OnSignal(d, nil, nil)
+	assert.Error(t, err, "OnSignal should error when the script fails")
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s := testStrategy(t, testScript)
+	_, err := s.OnSimultaneousSignals(nil, nil, nil)
+	assert.ErrorIs(t, err, base.ErrNoDataToProcess)
+
+	d := testData(t, 1, 2)
+	for range 2 {
+		_, err = d.Next()
+		require.NoError(t, err, "Next must not error")
+	}
+	resp, err := s.OnSimultaneousSignals([]data.Handler{d}, nil, nil)
+	require.NoError(t, err, "OnSimultaneousSignals must not error")
+	require.Len(t, resp, 1, "OnSimultaneousSignals must return a signal for each data handler")
+	assert.Equal(t, order.Buy, resp[0].GetDirection())
+}
+
+func TestSignal(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	d := testData(t, 1)
+	_, err := d.Next()
+	require.NoError(t, err, "Next must not error")
+
+	buy := order.Detail{Exchange: "Binance", AssetType: asset.Spot, Pair: currency.NewBTCUSDT(), Side: order.Buy, Amount: 2}
+	sell := buy
+	sell.Side = order.Sell
+	other := buy
+	other.Pair = currency.NewBTCUSD()
+
+	resp, err := s.signal(d, []order.Detail{other})
+	require.NoError(t, err, "signal must not error")
+	assert.Equal(t, order.DoNothing, resp.GetDirection(), "signal should ignore orders for other pairs")
+
+	resp, err = s.signal(d, []order.Detail{buy, sell})
+	require.NoError(t, err, "signal must not error")
+	assert.Equal(t, order.DoNothing, resp.GetDirection(), "signal should do nothing when orders net to zero")
+
+	sell.Amount = 3
+	resp, err = s.signal(d, []order.Detail{buy, sell})
+	require.NoError(t, err, "signal must not error")
+	assert.Equal(t, order.Sell, resp.GetDirection(), "signal should net orders")
+	assert.Equal(t, decimal.NewFromInt(1), resp.GetAmount(), "signal should net order amounts")
+
+	buy.Price = 2
+	limitBuy := buy
+	limitBuy.Price, limitBuy.Amount = 5, 3
+	resp, err = s.signal(d, []order.Detail{buy, limitBuy, sell})
+	require.NoError(t, err, "signal must not error")
+	assert.Equal(t, order.Buy, resp.GetDirection(), "signal should net orders")
+	assert.True(t, decimal.NewFromFloat(3.8).Equal(resp.GetClosePrice()), "signal should be priced at the weighted execution price of its side")
+}
+
+func TestExampleScript(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	s.SetDefaults()
+	require.NoError(t, s.SetCustomSettings(map[string]any{scriptKey: filepath.Join("..", "..", "..", "config", "strategyexamples", "gctscript-sma.gct")}), "SetCustomSettings must not error")
+
+	d := testData(t, 1, 2, 3)
+	_, err := d.Next()
+	require.NoError(t, err, "Next must not error")
+	resp, err := s.OnSignal(d, nil, nil)
+	require.NoError(t, err, "OnSignal must not error")
+	assert.Equal(t, order.DoNothing, resp.GetDirection(), "OnSignal should do nothing without enough candles")
+}
@@ -0,0 +1,64 @@
+package gctscript
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// Name is the strategy name
+	Name        = "gctscript"
+	scriptKey   = "script"
+	timeoutKey  = "timeout"
+	description = `Runs a gctscript file on every candle. The script's exchange module calls are served from the backtest data and its orders become buy or sell signals, allowing a script to be backtested before it is deployed live`
+
+	defaultTimeout = 30 * time.Second
+)
+
+var (
+	errNoScript          = errors.New("no script loaded, set the script path in the strategy custom settings")
+	errNoBacktestData    = errors.New("no backtest data for exchange, asset and pair")
+	errNoFunding         = errors.New("no backtest funding available")
+	errIntervalMismatch  = errors.New("requested interval does not match backtest data interval")
+	errInvalidAmount     = errors.New("backtest orders require an amount")
+	errOrderNotFound     = errors.New("backtest order not found")
+	errInvalidLimitPrice = errors.New("backtest limit orders require a price")
+	errOrderNotOpen      = errors.New("backtest order is not open")
+
+	// wrapperMtx serialises script runs as the gctscript modules use a
+	// single global wrapper
+	wrapperMtx sync.Mutex
+)
+
+// Strategy is an implementation of the Handler interface which runs a
+// gctscript file
+type Strategy struct {
+	base.Strategy
+	scriptPath string
+	timeout    time.Duration
+	compiled   *tengo.Compiled
+	exchange   *Exchange
+}
+
+// Exchange is a backtest implementation of modules.GCTExchange. Market data
+// is served from the backtest data up to the current candle. Submitted orders
+// stay open until a candle triggers them, then are turned into signals and
+// filled from the backtester's fills
+type Exchange struct {
+	m     sync.Mutex
+	data  []data.Handler
+	funds funding.IFundingTransferer
+	// orders holds every order submitted by the script
+	orders []order.Detail
+	// signalled holds the triggered orders awaiting fills from the last run
+	signalled []order.Detail
+	state     map[string]string
+	nextID    int64
+}
@@ -6,23 +6,33 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 
 	"github.com/buger/jsonparser"
+	backtesterconfig "github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/config/versions"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/encoding/jsonschema"
 )
 
-var commands = []string{"upgrade", "downgrade", "encrypt", "decrypt"}
+var commands = []string{"upgrade", "downgrade", "encrypt", "decrypt", "validate", "schema"}
+
+// Config file types
+const (
+	typeConfig   = "config"
+	typeStrategy = "strategy"
+)
 
 func main() {
 	fmt.Println("GoCryptoTrader: config-helper tool")
 
 	defaultCfgFile := config.DefaultFilePath()
 
-	var in, out, keyStr string
+	var in, out, keyStr, fileType string
 	var inplace bool
 	var version uint
 
@@ -33,6 +43,7 @@ func main() {
 	fs.BoolVar(&inplace, "edit", false, "Edit; Save result to the original file")
 	fs.StringVar(&keyStr, "key", "", "The key to use for AES encryption")
 	fs.UintVar(&version, "version", 0, "The version to downgrade to")
+	fs.StringVar(&fileType, "type", "", "The file type to validate or generate a schema for; config or strategy. Defaults to strategy for .strat files")
 
 	cmd, args := parseCommand(os.Args[1:])
 	if cmd == "" {
@@ -44,6 +55,27 @@ func main() {
 		fatal(err.Error())
 	}
 
+	switch fileType {
+	case "":
+		fileType = typeConfig
+		if filepath.Ext(in) == ".strat" {
+			fileType = typeStrategy
+		}
+	case typeConfig, typeStrategy:
+	default:
+		fmt.Fprintln(os.Stderr, "Error: type must be config or strategy")
+		usage(fs)
+		os.Exit(3)
+	}
+
+	if cmd == "schema" {
+		if out == "[in].out" {
+			out = fileType + ".schema.json"
+		}
+		writeSchema(fileType, out)
+		return
+	}
+
 	if inplace {
 		out = in
 	} else if out == "[in].out" {
@@ -62,6 +94,10 @@ func main() {
 		fatal("Error: File is already decrypted")
 	}
 
+	if fileType == typeStrategy && cmd != "validate" {
+		fatal("Error: " + cmd + " only supports config files")
+	}
+
 	if len(key) == 0 && (isEncrypted || cmd == "encrypt") {
 		if key, err = config.PromptForConfigKey(cmd == "encrypt"); err != nil {
 			fatal(err.Error())
@@ -75,6 +111,9 @@ func main() {
 	}
 
 	switch cmd {
+	case "validate":
+		validate(fileType, data)
+		return
 	case "decrypt":
 		if data, err = jsonparser.Set(data, []byte("-1"), "encryptConfig"); err != nil {
 			fatal("Unable to decrypt config data; Error: " + err.Error())
@@ -112,6 +151,55 @@ func main() {
 	fmt.Println("Success! File written to " + out)
 }
 
+// validate prints every schema validation error in the file with its JSON path
+// and exits 1 if there are any. Config files are upgraded to the latest version
+// first
+func validate(fileType string, data []byte) {
+	var errs jsonschema.ValidationErrors
+	var err error
+	if fileType == typeStrategy {
+		errs, err = backtesterconfig.ValidateSchema(data)
+	} else {
+		if data, err = versions.Manager.Deploy(context.Background(), data, versions.UseLatestVersion); err != nil {
+			fatal("Unable to upgrade config; Error: " + err.Error())
+		}
+		errs, err = config.Validate(data)
+	}
+	if err != nil {
+		fatal("Unable to validate " + fileType + "; Error: " + err.Error())
+	}
+	for _, e := range errs {
+		fmt.Fprintln(os.Stderr, e.Error())
+	}
+	if len(errs) > 0 {
+		fmt.Fprintf(os.Stderr, "%d validation errors found\n", len(errs))
+		os.Exit(1)
+	}
+	fmt.Println("Success! No validation errors found")
+}
+
+// writeSchema writes the JSON Schema of the file type to out
+func writeSchema(fileType, out string) {
+	var s *jsonschema.Schema
+	var err error
+	if fileType == typeStrategy {
+		s, err = backtesterconfig.Schema()
+	} else {
+		s, err = config.Schema()
+	}
+	if err != nil {
+		fatal("Unable to generate schema; Error: " + err.Error())
+	}
+	data, err := json.MarshalIndent(s, "", " ")
+	if err != nil {
+		fatal("Unable to encode schema; Error: " + err.Error())
+	}
+	if err := file.Write(out, data); err != nil {
+		fatal("Unable to write output file `" + out + "`; Error: " + err.Error())
+	}
+	fmt.Println("Success! Schema written to " + out)
+}
+
 func readFile(in string) []byte {
 	fileData, err := os.ReadFile(in)
 	if err != nil {
@@ -159,6 +247,8 @@ The commands are:
 	decrypt 	decrypt infile and write to outfile
 	upgrade 	upgrade the version of a decrypted config file
 	downgrade 	downgrade the version of a decrypted config file to a specific version
+	validate 	check infile against its schema and report every error with its JSON path
+	schema  	write the JSON Schema of the config or strategy file type to outfile
 
 The arguments are:`)
 	fs.PrintDefaults()
@@ -91,6 +91,11 @@ func TestNewExchangeAndSaveConfig(t *testing.T) {
 	cfgData, err := os.ReadFile(exchangeConfigPath)
 	require.NoError(t, err, "os.ReadFile must not error")
 
+	t.Cleanup(func() {
+		if err := os.RemoveAll(config.HistoryDir(exchangeConfigPath)); err != nil {
+			t.Errorf("RemoveAll failed: %s, manual deletion of config history directory required", err)
+		}
+	})
 	err = saveConfig(testExchangeDir, cfg, exchCfg)
 	require.NoError(t, err, "saveConfig must not error")
 
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errAPITokenNameRequired = errors.New("an API token name is required")
+
+var apiTokenCommand = &cli.Command{
+	Name:      "apitoken",
+	Usage:     "manages scoped API tokens for the gRPC server",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "create",
+			Usage:     "creates an API token, the secret is only shown once",
+			ArgsUsage: "<name> <role> <expiry>",
+			Action:    createAPIToken,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "name",
+					Usage: "a name to identify the token",
+				},
+				&cli.StringFlag{
+					Name:  "role",
+					Usage: "the token role: readonly, trader, withdrawer or admin",
+					Value: "readonly",
+				},
+				&cli.StringFlag{
+					Name:  "expiry",
+					Usage: "how long the token is valid for e.g. 720h",
+					Value: "720h",
+				},
+				&cli.StringFlag{
+					Name:  "exchanges",
+					Usage: "optional - comma separated exchanges the token is restricted to",
+				},
+				&cli.StringFlag{
+					Name:  "pairs",
+					Usage: "optional - comma separated currency pairs the token is restricted to",
+				},
+			},
+		},
+		{
+			Name:   "list",
+			Usage:  "lists unexpired API tokens",
+			Action: getAPITokens,
+		},
+		{
+			Name:      "revoke",
+			Usage:     "revokes an API token",
+			ArgsUsage: "<id>",
+			Action:    revokeAPIToken,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the ID of the token to revoke",
+				},
+			},
+		},
+	},
+}
+
+func createAPIToken(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var name string
+	if c.IsSet("name") {
+		name = c.String("name")
+	} else {
+		name = c.Args().First()
+	}
+	if name == "" {
+		return errAPITokenNameRequired
+	}
+
+	role := c.String("role")
+	if !c.IsSet("role") && c.Args().Get(1) != "" {
+		role = c.Args().Get(1)
+	}
+
+	expiry := c.String("expiry")
+	if !c.IsSet("expiry") && c.Args().Get(2) != "" {
+		expiry = c.Args().Get(2)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.CreateAPIToken(c.Context, &gctrpc.CreateAPITokenRequest{
+		Name:      name,
+		Role:      role,
+		Exchanges: splitList(c.String("exchanges")),
+		Pairs:     splitList(c.String("pairs")),
+		Expiry:    expiry,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getAPITokens(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetAPITokens(c.Context, &gctrpc.GetAPITokensRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func revokeAPIToken(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RevokeAPIToken(c.Context, &gctrpc.RevokeAPITokenRequest{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+// splitList splits a comma separated flag value, ignoring empty entries
+func splitList(s string) []string {
+	var list []string
+	for v := range strings.SplitSeq(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"errors"
 	"fmt"
 	"io"
@@ -575,6 +576,11 @@ func getTickers(c *cli.Context) error {
 	return nil
 }
 
+var accountFlag = &cli.StringFlag{
+	Name:  "account",
+	Usage: "optional - the configured API account name to use instead of the exchange's default credentials",
+}
+
 var getAccountBalancesCommand = &cli.Command{
 	Name:      "getaccountbalances",
 	Usage:     "gets the exchange account balances",
@@ -591,6 +597,7 @@ var getAccountBalancesCommand = &cli.Command{
 			Usage:    "the asset type to get the account balances for",
 			Required: true,
 		},
+		accountFlag,
 	},
 }
 
@@ -627,6 +634,7 @@ func getAccountBalances(c *cli.Context) error {
 		&gctrpc.GetAccountBalancesRequest{
 			Exchange:  exchange,
 			AssetType: assetType,
+			Account:   c.String("account"),
 		},
 	)
 	if err != nil {
@@ -651,6 +659,7 @@ var getAccountBalancesStreamCommand = &cli.Command{
 			Name:  "asset",
 			Usage: "the asset type to get the account balances stream for",
 		},
+		accountFlag,
 	},
 }
 
@@ -686,7 +695,7 @@ func getAccountBalancesStream(c *cli.Context) error {
 
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
 	result, err := client.GetAccountBalancesStream(c.Context,
-		&gctrpc.GetAccountBalancesRequest{Exchange: exchangeName, AssetType: assetType})
+		&gctrpc.GetAccountBalancesRequest{Exchange: exchangeName, AssetType: assetType, Account: c.String("account")})
 	if err != nil {
 		return err
 	}
@@ -722,6 +731,7 @@ var updateAccountBalancesCommand = &cli.Command{
 			Name:  "asset",
 			Usage: "the asset type to get the account balances for",
 		},
+		accountFlag,
 	},
 }
 
@@ -759,6 +769,7 @@ func updateAccountBalances(c *cli.Context) error {
 		&gctrpc.GetAccountBalancesRequest{
 			Exchange:  exchange,
 			AssetType: assetType,
+			Account:   c.String("account"),
 		},
 	)
 	if err != nil {
@@ -788,7 +799,7 @@ func getConfig(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
+	fmt.Println(string(result.Data))
 	return nil
 }
 
@@ -1096,6 +1107,7 @@ var getOrdersCommand = &cli.Command{
 			Value:       time.Now().Format(time.DateTime),
 			Destination: &endTime,
 		},
+		accountFlag,
 	},
 }
 
@@ -1182,6 +1194,7 @@ func getOrders(c *cli.Context) error {
 		},
 		StartDate: s.Format(common.SimpleTimeFormatWithTimezone),
 		EndDate:   e.Format(common.SimpleTimeFormatWithTimezone),
+		Account:   c.String("account"),
 	})
 	if err != nil {
 		return err
@@ -1209,6 +1222,7 @@ var getManagedOrdersCommand = &cli.Command{
 			Name:  "pair",
 			Usage: "the currency pair to get orders for",
 		},
+		accountFlag,
 	},
 }
 
@@ -1268,6 +1282,7 @@ func getManagedOrders(c *cli.Context) error {
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
+		Account: c.String("account"),
 	})
 	if err != nil {
 		return err
@@ -1299,6 +1314,7 @@ var getOrderCommand = &cli.Command{
 			Name:  "order_id",
 			Usage: "the order id to retrieve",
 		},
+		accountFlag,
 	},
 }
 
@@ -1362,7 +1378,8 @@ func getOrder(c *cli.Context) error {
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		Asset: assetType,
+		Asset:   assetType,
+		Account: c.String("account"),
 	})
 	if err != nil {
 		return err
@@ -1415,6 +1432,11 @@ var submitOrderCommand = &cli.Command{
 			Usage:    "required asset type",
 			Required: false,
 		},
+		&cli.StringFlag{
+			Name:  "strategy",
+			Usage: "optional strategy name used to group the order in transaction cost analysis",
+		},
+		accountFlag,
 	},
 }
 
@@ -1547,6 +1569,8 @@ func submitOrder(c *cli.Context) error {
 		Price:     price,
 		ClientId:  clientID,
 		AssetType: assetType,
+		Strategy:  c.String("strategy"),
+		Account:   c.String("account"),
 	})
 	if err != nil {
 		return err
@@ -1691,6 +1715,7 @@ var cancelOrderCommand = &cli.Command{
 			Name:  "side",
 			Usage: "the order side",
 		},
+		accountFlag,
 	},
 }
 
@@ -1782,6 +1807,7 @@ func cancelOrder(c *cli.Context) error {
 		},
 		AssetType: assetType,
 		Side:      orderSide,
+		Account:   c.String("account"),
 	})
 	if err != nil {
 		return err
@@ -1821,6 +1847,7 @@ var cancelBatchOrdersCommand = &cli.Command{
 			Name:  "side",
 			Usage: "the order side",
 		},
+		accountFlag,
 	},
 }
 
@@ -1912,6 +1939,7 @@ func cancelBatchOrders(c *cli.Context) error {
 		},
 		AssetType: assetType,
 		Side:      orderSide,
+		Account:   c.String("account"),
 	})
 	if err != nil {
 		return err
@@ -1931,6 +1959,7 @@ var cancelAllOrdersCommand = &cli.Command{
 			Name:  "exchange",
 			Usage: "the exchange to cancel all orders on",
 		},
+		accountFlag,
 	},
 }
 
@@ -1964,6 +1993,7 @@ var modifyOrderCommand = &cli.Command{
 			Name:  "amount",
 			Usage: "new order amount",
 		},
+		accountFlag,
 	},
 }
 
@@ -1984,6 +2014,7 @@ func cancelAllOrders(c *cli.Context) error {
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
 	result, err := client.CancelAllOrders(c.Context, &gctrpc.CancelAllOrdersRequest{
 		Exchange: exchangeName,
+		Account:  c.String("account"),
 	})
 	if err != nil {
 		return err
@@ -2070,9 +2101,10 @@ func modifyOrder(c *cli.Context) error {
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		Asset:  assetType,
-		Price:  price,
-		Amount: amount,
+		Asset:   assetType,
+		Price:   price,
+		Amount:  amount,
+		Account: c.String("account"),
 	})
 	if err != nil {
 		return err
@@ -2549,6 +2581,7 @@ var withdrawCryptocurrencyFundsCommand = &cli.Command{
 			Name:  "chain",
 			Usage: "chain to use for the withdrawal",
 		},
+		accountFlag,
 	},
 }
 
@@ -2632,6 +2665,7 @@ func withdrawCryptocurrencyFunds(c *cli.Context) error {
 			Fee:         fee,
 			Description: description,
 			Chain:       chain,
+			Account:     c.String("account"),
 		},
 	)
 	if err != nil {
@@ -2667,6 +2701,7 @@ var withdrawFiatFundsCommand = &cli.Command{
 			Name:  "description",
 			Usage: "description to submit with request",
 		},
+		accountFlag,
 	},
 }
 
@@ -2725,6 +2760,7 @@ func withdrawFiatFunds(c *cli.Context) error {
 			Amount:        amount,
 			Description:   description,
 			BankAccountId: bankAccountID,
+			Account:       c.String("account"),
 		},
 	)
 	if err != nil {
@@ -3423,9 +3459,28 @@ var (
 						Usage:       "the directory of the script file",
 						Destination: &path,
 					},
+					&cli.BoolFlag{
+						Name:  "debug",
+						Usage: "runs the script once and returns a trace of its module calls",
+					},
+					&cli.StringSliceFlag{
+						Name:  "breakpoint",
+						Usage: "halts a debugged script when it calls the module function, e.g. exchange.ordersubmit",
+					},
 				},
 				Action: gctScriptExecute,
 			},
+			{
+				Name:  "repl",
+				Usage: "interactively evaluate script snippets against the running engine",
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "dryrun",
+						Usage: "uses the validator wrapper instead of the live exchanges",
+					},
+				},
+				Action: gctScriptREPL,
+			},
 			{
 				Name:  "query",
 				Usage: "query running virtual machine",
@@ -3594,6 +3649,8 @@ func gctScriptExecute(c *cli.Context) error {
 				Name: filename,
 				Path: path,
 			},
+			Debug:       c.Bool("debug"),
+			Breakpoints: c.StringSlice("breakpoint"),
 		})
 	if err != nil {
 		return err
@@ -3604,6 +3661,57 @@ func gctScriptExecute(c *cli.Context) error {
 	return nil
 }
 
+func gctScriptREPL(c *cli.Context) error {
+	// the session lasts until it is exited so the request timeout is not applied
+	ignoreTimeout = true
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+
+	stream, err := client.GCTScriptREPL(c.Context)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stream.CloseSend(); err != nil {
+			fmt.Println(err)
+		}
+	}()
+
+	dryRun := c.Bool("dryrun")
+	fmt.Println("Enter script statements to evaluate them, exit to quit")
+	scanner := bufio.NewScanner(os.Stdin)
+	for {
+		fmt.Print(">> ")
+		if !scanner.Scan() {
+			return scanner.Err()
+		}
+		code := strings.TrimSpace(scanner.Text())
+		switch code {
+		case "":
+			continue
+		case "exit", "quit":
+			return nil
+		}
+		if err := stream.Send(&gctrpc.GCTScriptREPLRequest{Code: code, DryRun: dryRun}); err != nil {
+			return err
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if resp.Output != "" {
+			fmt.Println(resp.Output)
+		}
+		if resp.Error != "" {
+			fmt.Println("error:", resp.Error)
+		}
+	}
+}
+
 func gctScriptStatus(c *cli.Context) error {
 	conn, cancel, err := setupClient(c)
 	if err != nil {
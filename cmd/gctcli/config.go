@@ -0,0 +1,252 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var configCommand = &cli.Command{
+	Name:      "config",
+	Usage:     "manages the running engine config",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "reload",
+			Usage:     "validates a config file and applies its changes to the running engine, rolling back on failure",
+			ArgsUsage: "<path>",
+			Action:    reloadConfig,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "path",
+					Usage: "optional - the config file to load, the file the engine was started with is used when unset",
+				},
+				&cli.BoolFlag{
+					Name:  "dryrun",
+					Usage: "reports the changes which would be applied without applying them",
+				},
+			},
+		},
+		{
+			Name:      "patch",
+			Usage:     "applies an RFC 6902 JSON patch to the running config and saves it as a new config version",
+			ArgsUsage: "<patch>",
+			Action:    patchConfig,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "patch",
+					Usage: "the JSON patch, e.g. '[{\"op\":\"replace\",\"path\":\"/orderManager/verbose\",\"value\":true}]'",
+				},
+				&cli.StringFlag{
+					Name:  "file",
+					Usage: "optional - reads the JSON patch from a file instead",
+				},
+				&cli.BoolFlag{
+					Name:  "dryrun",
+					Usage: "reports the changes which would be applied without applying or saving them",
+				},
+			},
+		},
+		{
+			Name:   "history",
+			Usage:  "lists the saved config versions",
+			Action: getConfigHistory,
+		},
+		{
+			Name:      "diff",
+			Usage:     "shows the JSON patch between two config versions, version 0 being the running config",
+			ArgsUsage: "<from> <to>",
+			Action:    diffConfig,
+			Flags: []cli.Flag{
+				&cli.Uint64Flag{
+					Name:  "from",
+					Usage: "the config version to compare from",
+				},
+				&cli.Uint64Flag{
+					Name:  "to",
+					Usage: "optional - the config version to compare to, the running config when unset",
+				},
+			},
+		},
+		{
+			Name:      "rollback",
+			Usage:     "applies a saved config version to the running engine and saves it as a new config version",
+			ArgsUsage: "<version>",
+			Action:    rollbackConfig,
+			Flags: []cli.Flag{
+				&cli.Uint64Flag{
+					Name:  "version",
+					Usage: "the config version to roll back to",
+				},
+				&cli.BoolFlag{
+					Name:  "dryrun",
+					Usage: "reports the changes which would be applied without applying or saving them",
+				},
+			},
+		},
+	},
+}
+
+func reloadConfig(c *cli.Context) error {
+	var path string
+	if c.IsSet("path") {
+		path = c.String("path")
+	} else {
+		path = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ReloadConfig(c.Context, &gctrpc.ReloadConfigRequest{
+		ConfigPath: path,
+		DryRun:     c.Bool("dryrun"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func patchConfig(c *cli.Context) error {
+	var patch string
+	switch {
+	case c.IsSet("file"):
+		data, err := os.ReadFile(c.String("file"))
+		if err != nil {
+			return err
+		}
+		patch = string(data)
+	case c.IsSet("patch"):
+		patch = c.String("patch")
+	default:
+		patch = c.Args().First()
+	}
+	if patch == "" {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.PatchConfig(c.Context, &gctrpc.PatchConfigRequest{
+		Patch:  patch,
+		DryRun: c.Bool("dryrun"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getConfigHistory(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetConfigHistory(c.Context, &gctrpc.GetConfigHistoryRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func diffConfig(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	from, err := configVersionArg(c, "from", 0)
+	if err != nil {
+		return err
+	}
+	to, err := configVersionArg(c, "to", 1)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.DiffConfig(c.Context, &gctrpc.DiffConfigRequest{
+		FromVersion: from,
+		ToVersion:   to,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(result.Patch)
+	return nil
+}
+
+func rollbackConfig(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+	version, err := configVersionArg(c, "version", 0)
+	if err != nil {
+		return err
+	}
+	if version == 0 {
+		return errors.New("a config version must be specified")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RollbackConfig(c.Context, &gctrpc.RollbackConfigRequest{
+		Version: version,
+		DryRun:  c.Bool("dryrun"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+// configVersionArg returns a config version from the named flag or the
+// positional argument at index, 0 when neither is set
+func configVersionArg(c *cli.Context, name string, index int) (uint64, error) {
+	if c.IsSet(name) {
+		return c.Uint64(name), nil
+	}
+	if index >= c.NArg() {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(c.Args().Get(index), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s config version: %w", name, err)
+	}
+	return v, nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var credentialsCommand = &cli.Command{
+	Name:      "credentials",
+	Usage:     "manages exchange credentials held by the credential provider",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "unlock",
+			Usage:     "unlocks an exchange account's keystore credentials, prompting for the passphrase",
+			ArgsUsage: "<exchange> <account>",
+			Action:    unlockCredentials,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to unlock credentials for",
+				},
+				&cli.StringFlag{
+					Name:  "account",
+					Usage: "optional - the named account, the default account is used when unset",
+				},
+			},
+		},
+		{
+			Name:      "reload",
+			Usage:     "discards cached credentials so rotated credentials are fetched from the credential provider",
+			ArgsUsage: "<exchange>",
+			Action:    reloadCredentials,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "optional - the exchange to reload, all exchanges using the provider are reloaded when unset",
+				},
+			},
+		},
+	},
+}
+
+func unlockCredentials(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var account string
+	if c.IsSet("account") {
+		account = c.String("account")
+	} else {
+		account = c.Args().Get(1)
+	}
+
+	passphrase, err := config.PromptForSecret("Please enter keystore passphrase: ")
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.UnlockCredentials(c.Context, &gctrpc.UnlockCredentialsRequest{
+		Exchange:   exchangeName,
+		Account:    account,
+		Passphrase: string(passphrase),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func reloadCredentials(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ReloadCredentials(c.Context, &gctrpc.ReloadCredentialsRequest{
+		Exchange: exchangeName,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -147,6 +147,30 @@ var dataHistoryJobCommands = &cli.Command{
 			Flags:  append(baseJobSubCommands, secondaryValidationJobSubCommands...),
 			Action: upsertDataHistoryJob,
 		},
+		{
+			Name:   "savefundingrates",
+			Usage:  "will fetch funding rate history for a futures contract from an exchange and save it to the database",
+			Flags:  append(baseJobSubCommands, dataHandlingJobSubCommands...),
+			Action: upsertDataHistoryJob,
+		},
+		{
+			Name:   "saveopeninterest",
+			Usage:  "will snapshot a futures contract's open interest once per interval and save it to the database",
+			Flags:  append(baseJobSubCommands, overwriteDataFlag),
+			Action: upsertDataHistoryJob,
+		},
+		{
+			Name:   "savemarkpricecandles",
+			Usage:  "will fetch mark price candle data for a futures contract from an exchange and save it to the database",
+			Flags:  append(baseJobSubCommands, dataHandlingJobSubCommands...),
+			Action: upsertDataHistoryJob,
+		},
+		{
+			Name:   "saveindexpricecandles",
+			Usage:  "will fetch index price candle data for a futures contract from an exchange and save it to the database",
+			Flags:  append(baseJobSubCommands, dataHandlingJobSubCommands...),
+			Action: upsertDataHistoryJob,
+		},
 	},
 }
 
@@ -461,6 +485,14 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		dataType = 4
 	case "secondaryvalidatecandles":
 		dataType = 5
+	case "savefundingrates":
+		dataType = 6
+	case "saveopeninterest":
+		dataType = 7
+	case "savemarkpricecandles":
+		dataType = 8
+	case "saveindexpricecandles":
+		dataType = 9
 	default:
 		return errors.New("unrecognised command, cannot set data type")
 	}
@@ -469,7 +501,7 @@ func upsertDataHistoryJob(c *cli.Context) error {
 	var overwriteExistingData bool
 
 	switch dataType {
-	case 0, 1:
+	case 0, 1, 6, 7, 8, 9:
 		if c.IsSet("overwrite_existing_data") {
 			overwriteExistingData = c.Bool("overwrite_existing_data")
 		}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var dataQualityCommand = &cli.Command{
+	Name:      "dataquality",
+	Usage:     "scans saved candles and trades for gaps, outliers and cross exchange deviations",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "run",
+			Usage:     "scans saved data and returns the report",
+			ArgsUsage: "<exchange> <pair> <asset> <datatype> <start> <end>",
+			Action:    runDataQualityCheck,
+			Flags: append(dataQualityFlags,
+				&cli.StringFlag{
+					Name:    "datatype",
+					Aliases: []string{"dt"},
+					Usage:   "candles or trades",
+					Value:   "candles",
+				},
+				&cli.Int64Flag{
+					Name:        "interval",
+					Aliases:     []string{"i"},
+					Usage:       klineMessage + " trades are scanned as candles of this interval",
+					Value:       3600,
+					Destination: &candleGranularity,
+				},
+				&cli.StringSliceFlag{
+					Name:    "compare",
+					Aliases: []string{"c"},
+					Usage:   "exchanges whose saved candles are compared against for deviations",
+				},
+			),
+		},
+		{
+			Name:      "reports",
+			Usage:     "returns saved reports which were created between start and end",
+			ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+			Action:    getDataQualityReports,
+			Flags:     dataQualityFlags,
+		},
+	},
+}
+
+var dataQualityFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:    "exchange",
+		Aliases: []string{"e"},
+		Usage:   "the exchange of the saved data",
+	},
+	&cli.StringFlag{
+		Name:    "pair",
+		Aliases: []string{"p"},
+		Usage:   "the currency pair of the saved data",
+	},
+	&cli.StringFlag{
+		Name:    "asset",
+		Aliases: []string{"a"},
+		Usage:   "the asset type of the currency pair",
+	},
+	&cli.StringFlag{
+		Name:        "start",
+		Usage:       "<start>",
+		Value:       time.Now().AddDate(0, 0, -1).Truncate(time.Hour).Format(time.DateTime),
+		Destination: &startTime,
+	},
+	&cli.StringFlag{
+		Name:        "end",
+		Usage:       "<end>",
+		Value:       time.Now().Truncate(time.Hour).Format(time.DateTime),
+		Destination: &endTime,
+	},
+}
+
+func runDataQualityCheck(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, p, assetType, err := dataQualityTarget(c)
+	if err != nil {
+		return err
+	}
+
+	dataType := c.String("datatype")
+	if !c.IsSet("datatype") && c.Args().Get(3) != "" {
+		dataType = c.Args().Get(3)
+	}
+
+	s, e, err := dataQualityRange(c, 4)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RunDataQualityCheck(c.Context, &gctrpc.RunDataQualityCheckRequest{
+		Exchange:            exchangeName,
+		Pair:                p,
+		AssetType:           assetType,
+		DataType:            dataType,
+		Interval:            int64(time.Duration(candleGranularity) * time.Second),
+		Start:               s,
+		End:                 e,
+		ComparisonExchanges: c.StringSlice("compare"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getDataQualityReports(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, p, assetType, err := dataQualityTarget(c)
+	if err != nil {
+		return err
+	}
+
+	s, e, err := dataQualityRange(c, 3)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetDataQualityReports(c.Context, &gctrpc.GetDataQualityReportsRequest{
+		Exchange:  exchangeName,
+		Pair:      p,
+		AssetType: assetType,
+		Start:     s,
+		End:       e,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func dataQualityTarget(c *cli.Context) (exchangeName string, pair *gctrpc.CurrencyPair, assetType string, err error) {
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return "", nil, "", errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if !validAsset(assetType) {
+		return "", nil, "", errInvalidAsset
+	}
+
+	return exchangeName, &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, assetType, nil
+}
+
+// dataQualityRange parses the start and end flags, falling back to positional
+// arguments starting at startArg
+func dataQualityRange(c *cli.Context, startArg int) (start, end string, err error) {
+	if !c.IsSet("start") {
+		if c.Args().Get(startArg) != "" {
+			startTime = c.Args().Get(startArg)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(startArg+1) != "" {
+			endTime = c.Args().Get(startArg + 1)
+		}
+	}
+
+	s, err := time.ParseInLocation(time.DateTime, startTime, time.Local)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.ParseInLocation(time.DateTime, endTime, time.Local)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return "", "", common.ErrStartAfterEnd
+	}
+	return s.Format(common.SimpleTimeFormatWithTimezone), e.Format(common.SimpleTimeFormatWithTimezone), nil
+}
@@ -512,6 +512,18 @@ var futuresCommands = &cli.Command{
 				},
 			},
 		},
+		{
+			Name:    "getrisk",
+			Aliases: []string{"risk"},
+			Usage:   "gets the latest aggregated futures risk across all exchanges from the futures risk manager",
+			Action:  getFuturesRisk,
+		},
+		{
+			Name:    "getriskstream",
+			Aliases: []string{"riskstream"},
+			Usage:   "streams aggregated futures risk across all exchanges as it is calculated by the futures risk manager",
+			Action:  getFuturesRiskStream,
+		},
 	},
 }
 
@@ -1710,3 +1722,48 @@ func getOpenInterest(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+func getFuturesRisk(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetFuturesRisk(c.Context, &gctrpc.GetFuturesRiskRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getFuturesRiskStream(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetFuturesRiskStream(c.Context, &gctrpc.GetFuturesRiskRequest{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		jsonOutput(resp)
+	}
+}
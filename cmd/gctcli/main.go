@@ -25,6 +25,7 @@ var (
 	host          string
 	username      string
 	password      string
+	apiToken      string
 	pairDelimiter string
 	certPath      string
 	timeout       time.Duration
@@ -49,12 +50,17 @@ func setupClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
 		return nil, nil, err
 	}
 
+	var perRPCCreds credentials.PerRPCCredentials = auth.BasicAuth{
+		Username: username,
+		Password: password,
+	}
+	if apiToken != "" {
+		perRPCCreds = auth.BearerToken{Token: apiToken}
+	}
+
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
-			Username: username,
-			Password: password,
-		}),
+		grpc.WithPerRPCCredentials(perRPCCreds),
 	}
 
 	var cancel context.CancelFunc
@@ -97,6 +103,12 @@ func main() {
 			Usage:       "the gRPC password",
 			Destination: &password,
 		},
+		&cli.StringFlag{
+			Name:        "rpctoken",
+			Usage:       "a gRPC API token, used instead of the gRPC username and password when set",
+			EnvVars:     []string{"GCT_RPC_TOKEN"},
+			Destination: &apiToken,
+		},
 		&cli.StringFlag{
 			Name:        "delimiter",
 			Value:       "-",
@@ -212,6 +224,8 @@ func main() {
 		gctScriptCommand,
 		websocketManagerCommand,
 		tradeCommand,
+		marketDataCommand,
+		dataQualityCommand,
 		dataHistoryCommands,
 		currencyStateManagementCommand,
 		futuresCommands,
@@ -220,6 +234,11 @@ func main() {
 		getMarginRatesHistoryCommand,
 		orderbookCommand,
 		getCurrencyTradeURLCommand,
+		transactionCostAnalysisCommand,
+		transferCommand,
+		credentialsCommand,
+		apiTokenCommand,
+		configCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var marketDataCommand = &cli.Command{
+	Name:      "marketdata",
+	Usage:     "exports and imports saved market data as daily partitioned parquet files",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "export",
+			Usage:     "writes saved candles, trades or funding rates from the database to parquet files",
+			ArgsUsage: "<exchange> <pair> <asset> <datatype> <start> <end>",
+			Action:    exportMarketData,
+			Flags:     marketDataFlags,
+		},
+		{
+			Name:      "import",
+			Usage:     "saves candles, trades or funding rates from parquet files to the database",
+			ArgsUsage: "<exchange> <pair> <asset> <datatype> <start> <end>",
+			Action:    importMarketData,
+			Flags:     marketDataFlags,
+		},
+	},
+}
+
+var marketDataFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:    "exchange",
+		Aliases: []string{"e"},
+		Usage:   "the exchange of the market data",
+	},
+	&cli.StringFlag{
+		Name:    "pair",
+		Aliases: []string{"p"},
+		Usage:   "the currency pair of the market data",
+	},
+	&cli.StringFlag{
+		Name:    "asset",
+		Aliases: []string{"a"},
+		Usage:   "the asset type of the currency pair",
+	},
+	&cli.StringFlag{
+		Name:    "datatype",
+		Aliases: []string{"dt"},
+		Usage:   "candles, trades or fundingrates",
+		Value:   "candles",
+	},
+	&cli.StringFlag{
+		Name:        "start",
+		Usage:       "<start>",
+		Value:       time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(time.DateTime),
+		Destination: &startTime,
+	},
+	&cli.StringFlag{
+		Name:        "end",
+		Usage:       "<end>",
+		Value:       time.Now().Truncate(time.Hour).Format(time.DateTime),
+		Destination: &endTime,
+	},
+	&cli.Int64Flag{
+		Name:        "interval",
+		Aliases:     []string{"i"},
+		Usage:       klineMessage + " only used for candles",
+		Value:       3600,
+		Destination: &candleGranularity,
+	},
+	&cli.StringFlag{
+		Name:    "directory",
+		Aliases: []string{"d"},
+		Usage:   "the parquet directory, relative to the parquet folder within the bot's data directory",
+	},
+	&cli.BoolFlag{
+		Name:  "overwrite",
+		Usage: "replaces existing data at the same timestamps",
+	},
+}
+
+func exportMarketData(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := marketDataTransferRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ExportMarketData(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func importMarketData(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := marketDataTransferRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ImportMarketData(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func marketDataTransferRequest(c *cli.Context) (*gctrpc.MarketDataTransferRequest, error) {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return nil, errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if !validAsset(assetType) {
+		return nil, errInvalidAsset
+	}
+
+	dataType := c.String("datatype")
+	if !c.IsSet("datatype") && c.Args().Get(3) != "" {
+		dataType = c.Args().Get(3)
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(4) != "" {
+			startTime = c.Args().Get(4)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(5) != "" {
+			endTime = c.Args().Get(5)
+		}
+	}
+
+	s, err := time.ParseInLocation(time.DateTime, startTime, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.ParseInLocation(time.DateTime, endTime, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return nil, common.ErrStartAfterEnd
+	}
+
+	return &gctrpc.MarketDataTransferRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType: assetType,
+		Start:     s.Format(common.SimpleTimeFormatWithTimezone),
+		End:       e.Format(common.SimpleTimeFormatWithTimezone),
+		DataType:  dataType,
+		Interval:  int64(time.Duration(candleGranularity) * time.Second),
+		Directory: c.String("directory"),
+		Overwrite: c.Bool("overwrite"),
+	}, nil
+}
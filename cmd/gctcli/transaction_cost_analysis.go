@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var transactionCostAnalysisFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "optional - the exchange to filter orders by",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "optional - the asset type to filter orders by",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "optional - the currency pair to filter orders by",
+	},
+	&cli.StringFlag{
+		Name:  "strategy",
+		Usage: "optional - the strategy name to filter orders by",
+	},
+	&cli.StringFlag{
+		Name:  "start",
+		Usage: "optional - the start date to filter orders by",
+	},
+	&cli.StringFlag{
+		Name:  "end",
+		Usage: "optional - the end date to filter orders by",
+	},
+}
+
+var transactionCostAnalysisCommand = &cli.Command{
+	Name:      "tca",
+	Usage:     "transaction cost analysis for orders submitted through the order manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "get",
+			Usage:  "gets per order transaction costs aggregated per exchange, pair and strategy",
+			Action: getTransactionCostAnalysis,
+			Flags:  transactionCostAnalysisFlags,
+		},
+		{
+			Name:   "report",
+			Usage:  "generates an HTML transaction cost analysis report in the engine's data directory",
+			Action: getTransactionCostAnalysis,
+			Flags:  append(transactionCostAnalysisFlags, &cli.BoolFlag{Name: "report", Hidden: true, Value: true}),
+		},
+	},
+}
+
+func getTransactionCostAnalysis(c *cli.Context) error {
+	req := &gctrpc.GetTransactionCostAnalysisRequest{
+		Exchange:       c.String("exchange"),
+		Strategy:       c.String("strategy"),
+		GenerateReport: c.Bool("report"),
+	}
+
+	if c.IsSet("asset") {
+		req.Asset = strings.ToLower(c.String("asset"))
+		if !validAsset(req.Asset) {
+			return errInvalidAsset
+		}
+	}
+
+	if c.IsSet("pair") {
+		if !validPair(c.String("pair")) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(c.String("pair"), pairDelimiter)
+		if err != nil {
+			return err
+		}
+		req.Pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	var s, e time.Time
+	var err error
+	if c.IsSet("start") {
+		s, err = time.ParseInLocation(time.DateTime, c.String("start"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for start: %v", err)
+		}
+		req.StartDate = s.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if c.IsSet("end") {
+		e, err = time.ParseInLocation(time.DateTime, c.String("end"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for end: %v", err)
+		}
+		req.EndDate = e.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if !s.IsZero() && !e.IsZero() && e.Before(s) {
+		return common.ErrStartAfterEnd
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetTransactionCostAnalysis(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
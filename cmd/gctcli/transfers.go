@@ -0,0 +1,151 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var errTransferAmountRequired = errors.New("transfer amount must be greater than zero")
+
+var transferCommand = &cli.Command{
+	Name:      "transfer",
+	Usage:     "moves funds between exchanges and tracks them until deposited",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "create",
+			Usage:     "withdraws funds from the source exchange to the destination exchange via the cheapest available chain",
+			ArgsUsage: "<source> <destination> <currency> <amount> <chain>",
+			Action:    createTransfer,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "source",
+					Usage: "the exchange to withdraw from",
+				},
+				&cli.StringFlag{
+					Name:  "destination",
+					Usage: "the exchange to deposit to",
+				},
+				&cli.StringFlag{
+					Name:  "currency",
+					Usage: "the cryptocurrency to transfer",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount to transfer",
+				},
+				&cli.StringFlag{
+					Name:  "chain",
+					Usage: "optional - restricts the transfer to a specific chain",
+				},
+			},
+		},
+		{
+			Name:   "get",
+			Usage:  "gets transfers and their current status",
+			Action: getTransfers,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "optional - the transfer ID",
+				},
+				&cli.StringFlag{
+					Name:  "status",
+					Usage: "optional - the transfer status to filter by e.g. withdrawing, depositing, completed, failed",
+				},
+			},
+		},
+	},
+}
+
+func createTransfer(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var source string
+	if c.IsSet("source") {
+		source = c.String("source")
+	} else {
+		source = c.Args().First()
+	}
+
+	var destination string
+	if c.IsSet("destination") {
+		destination = c.String("destination")
+	} else {
+		destination = c.Args().Get(1)
+	}
+
+	var curr string
+	if c.IsSet("currency") {
+		curr = c.String("currency")
+	} else {
+		curr = c.Args().Get(2)
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+	if amount <= 0 {
+		return errTransferAmountRequired
+	}
+
+	var chain string
+	if c.IsSet("chain") {
+		chain = c.String("chain")
+	} else {
+		chain = c.Args().Get(4)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.CreateTransfer(c.Context, &gctrpc.CreateTransferRequest{
+		Source:      source,
+		Destination: destination,
+		Currency:    curr,
+		Amount:      amount,
+		Chain:       chain,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getTransfers(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetTransfers(c.Context, &gctrpc.GetTransfersRequest{
+		Id:     c.String("id"),
+		Status: c.String("status"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
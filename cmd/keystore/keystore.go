@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
+)
+
+var commands = []string{"set", "remove", "list", "verify"}
+
+func main() {
+	fmt.Println("GoCryptoTrader: keystore-helper tool")
+
+	var path, exchangeName, account, subAccount, pemFile string
+
+	fs := flag.NewFlagSet("keystore", flag.ExitOnError)
+	fs.Usage = func() { usage(fs) }
+	fs.StringVar(&path, "path", filepath.Join(common.GetDefaultDataDir(runtime.GOOS), secrets.DefaultKeystoreFile), "The keystore file")
+	fs.StringVar(&exchangeName, "exchange", "", "The exchange name")
+	fs.StringVar(&account, "account", "", "The named account, the default account is used when unset")
+	fs.StringVar(&subAccount, "subaccount", "", "The sub account to store with the credentials")
+	fs.StringVar(&pemFile, "pemfile", "", "A PEM key file to store with the credentials")
+
+	cmd, args := parseCommand(os.Args[1:])
+	if cmd == "" {
+		usage(fs)
+		os.Exit(2)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		fatal(err.Error())
+	}
+
+	k, err := secrets.NewKeystore(path)
+	if err != nil {
+		fatal(err.Error())
+	}
+
+	if cmd == "list" {
+		keys, err := k.Keys()
+		if err != nil {
+			fatal("Unable to read keystore; Error: " + err.Error())
+		}
+		for _, key := range keys {
+			fmt.Println(key)
+		}
+		return
+	}
+
+	if exchangeName == "" {
+		fatal("Error: exchange is required")
+	}
+	keyPath := secrets.Path(exchangeName, account)
+
+	switch cmd {
+	case "remove":
+		if err := k.Remove(exchangeName, account); err != nil {
+			fatal("Unable to remove " + keyPath + "; Error: " + err.Error())
+		}
+		fmt.Println("Success! Removed " + keyPath)
+	case "verify":
+		if err := k.Unlock(exchangeName, account, prompt("Passphrase: ")); err != nil {
+			fatal("Unable to unlock " + keyPath + "; Error: " + err.Error())
+		}
+		fmt.Println("Success! Passphrase unlocks " + keyPath)
+	case "set":
+		s := &secrets.Secret{
+			Key:           string(prompt("API key: ")),
+			Secret:        string(prompt("API secret: ")),
+			ClientID:      string(prompt("Client ID (optional): ")),
+			OTPSecret:     string(prompt("OTP secret (optional): ")),
+			PIN:           string(prompt("Withdrawal PIN (optional): ")),
+			TradePassword: string(prompt("Trade password (optional): ")),
+			SubAccount:    subAccount,
+		}
+		if pemFile != "" {
+			pem, err := os.ReadFile(pemFile)
+			if err != nil {
+				fatal("Unable to read PEM file " + pemFile + "; Error: " + err.Error())
+			}
+			s.PEMKey = string(pem)
+		}
+		passphrase := prompt("Passphrase: ")
+		if !bytes.Equal(passphrase, prompt("Re-enter passphrase: ")) {
+			fatal("Error: passphrases did not match")
+		}
+		if err := k.Set(exchangeName, account, passphrase, s); err != nil {
+			fatal("Unable to store " + keyPath + "; Error: " + err.Error())
+		}
+		fmt.Println("Success! Stored " + keyPath + " in " + path)
+	}
+}
+
+func prompt(msg string) []byte {
+	resp, err := config.PromptForSecret(msg)
+	if err != nil {
+		fatal(err.Error())
+	}
+	return resp
+}
+
+func fatal(msg string) {
+	fmt.Fprintln(os.Stderr, msg)
+	os.Exit(2)
+}
+
+// parseCommand will return the single non-flag parameter from os.Args, and return the remaining args
+// If none is provided, too many, usage() will be called and exit 1
+func parseCommand(a []string) (cmd string, args []string) {
+	cmds, rem := []string{}, []string{}
+	for _, s := range a {
+		if slices.Contains(commands, s) {
+			cmds = append(cmds, s)
+		} else {
+			rem = append(rem, s)
+		}
+	}
+	switch len(cmds) {
+	case 0:
+		fmt.Fprintln(os.Stderr, "No command provided")
+	case 1:
+		return cmds[0], rem
+	default:
+		fmt.Fprintln(os.Stderr, "Too many commands provided: "+strings.Join(cmds, ", "))
+	}
+	return "", nil
+}
+
+// usage prints command usage
+func usage(fs *flag.FlagSet) {
+	fmt.Fprintln(os.Stderr, `
+Usage:
+keystore [arguments] <command>
+
+The commands are:
+	set 	encrypt and store credentials for an exchange account, replacing any existing credentials
+	remove 	remove the credentials for an exchange account
+	list 	list the exchange accounts stored in the keystore
+	verify 	check a passphrase unlocks the credentials for an exchange account
+
+The arguments are:`)
+	fs.PrintDefaults()
+}
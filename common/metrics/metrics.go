@@ -0,0 +1,315 @@
+// Package metrics provides a lightweight metrics registry which is exported in
+// the Prometheus text exposition format
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	nameRegexp  = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]Collector)}
+}
+
+// Register adds collectors to the registry. Metric names must be unique
+func (r *Registry) Register(collectors ...Collector) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	for _, c := range collectors {
+		if c == nil {
+			return errNilCollector
+		}
+		d := c.desc()
+		if err := d.validate(); err != nil {
+			return err
+		}
+		if _, ok := r.collectors[d.name]; ok {
+			return fmt.Errorf("%w: %q", errDuplicateCollector, d.name)
+		}
+		r.collectors[d.name] = c
+	}
+	return nil
+}
+
+// Unregister removes a collector from the registry
+func (r *Registry) Unregister(c Collector) {
+	r.m.Lock()
+	delete(r.collectors, c.desc().name)
+	r.m.Unlock()
+}
+
+// Write writes all registered metrics sorted by name
+func (r *Registry) Write(w io.Writer) error {
+	r.m.RLock()
+	names := make([]string, 0, len(r.collectors))
+	for name := range r.collectors {
+		names = append(names, name)
+	}
+	collectors := make([]Collector, len(names))
+	sort.Strings(names)
+	for i := range names {
+		collectors[i] = r.collectors[names[i]]
+	}
+	r.m.RUnlock()
+
+	bw := bufio.NewWriter(w)
+	for _, c := range collectors {
+		d := c.desc()
+		fmt.Fprintf(bw, "# HELP %s %s\n", d.name, escapeHelp(d.help))
+		fmt.Fprintf(bw, "# TYPE %s %s\n", d.name, d.metricType)
+		c.collect(func(s sample) {
+			bw.WriteString(d.name + s.suffix)
+			writeLabels(bw, d.labels, s.labelValues, s.extraLabel)
+			bw.WriteByte(' ')
+			bw.WriteString(formatFloat(s.value))
+			bw.WriteByte('\n')
+		})
+	}
+	return bw.Flush()
+}
+
+// Handler returns an HTTP handler which serves the registry's metrics
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		if err := r.Write(w); err != nil {
+			log.Errorf(log.Global, "Metrics: unable to write metrics: %s", err)
+		}
+	})
+}
+
+// NewCounterVec returns a counter partitioned by the label names
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return &CounterVec{descriptor: descriptor{name: name, help: help, metricType: typeCounter, labels: labels}}
+}
+
+// Inc increments the counter for the label values by 1
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds a non-negative value to the counter for the label values. Calls with
+// the wrong number of label values or a negative value are ignored
+func (c *CounterVec) Add(v float64, labelValues ...string) {
+	if v < 0 || len(labelValues) != len(c.labels) {
+		return
+	}
+	c.update(labelValues, 0, func(s *seriesValue) { s.value += v })
+}
+
+func (c *CounterVec) desc() *descriptor { return &c.descriptor }
+
+func (c *CounterVec) collect(emit func(sample)) {
+	c.each(func(s *seriesValue) {
+		emit(sample{suffix: "_total", labelValues: s.labelValues, value: s.value})
+	})
+}
+
+// NewGaugeVec returns a gauge partitioned by the label names
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{descriptor: descriptor{name: name, help: help, metricType: typeGauge, labels: labels}}
+}
+
+// Set sets the gauge for the label values. Calls with the wrong number of label
+// values are ignored
+func (g *GaugeVec) Set(v float64, labelValues ...string) {
+	if len(labelValues) != len(g.labels) {
+		return
+	}
+	g.update(labelValues, 0, func(s *seriesValue) { s.value = v })
+}
+
+// Add adds a value, which may be negative, to the gauge for the label values
+func (g *GaugeVec) Add(v float64, labelValues ...string) {
+	if len(labelValues) != len(g.labels) {
+		return
+	}
+	g.update(labelValues, 0, func(s *seriesValue) { s.value += v })
+}
+
+// Delete removes the gauge for the label values
+func (g *GaugeVec) Delete(labelValues ...string) {
+	g.m.Lock()
+	delete(g.values, seriesKey(labelValues))
+	g.m.Unlock()
+}
+
+func (g *GaugeVec) desc() *descriptor { return &g.descriptor }
+
+func (g *GaugeVec) collect(emit func(sample)) {
+	g.each(func(s *seriesValue) {
+		emit(sample{labelValues: s.labelValues, value: s.value})
+	})
+}
+
+// NewHistogramVec returns a histogram partitioned by the label names. Buckets
+// are the upper bounds of each bucket, DefaultBuckets are used when none are
+// supplied
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = slices.Clone(buckets)
+	slices.Sort(buckets)
+	return &HistogramVec{descriptor: descriptor{name: name, help: help, metricType: typeHistogram, labels: labels}, buckets: buckets}
+}
+
+// Observe adds an observation for the label values. Calls with the wrong number
+// of label values are ignored
+func (h *HistogramVec) Observe(v float64, labelValues ...string) {
+	if len(labelValues) != len(h.labels) {
+		return
+	}
+	h.update(labelValues, len(h.buckets), func(s *seriesValue) {
+		if i := sort.SearchFloat64s(h.buckets, v); i < len(h.buckets) {
+			s.counts[i]++
+		}
+		s.sum += v
+		s.count++
+	})
+}
+
+func (h *HistogramVec) desc() *descriptor { return &h.descriptor }
+
+func (h *HistogramVec) collect(emit func(sample)) {
+	h.each(func(s *seriesValue) {
+		var cumulative uint64
+		for i, upper := range h.buckets {
+			cumulative += s.counts[i]
+			emit(sample{suffix: "_bucket", labelValues: s.labelValues, extraLabel: [2]string{"le", formatFloat(upper)}, value: float64(cumulative)})
+		}
+		emit(sample{suffix: "_bucket", labelValues: s.labelValues, extraLabel: [2]string{"le", "+Inf"}, value: float64(s.count)})
+		emit(sample{suffix: "_sum", labelValues: s.labelValues, value: s.sum})
+		emit(sample{suffix: "_count", labelValues: s.labelValues, value: float64(s.count)})
+	})
+}
+
+// NewGaugeFunc returns a gauge which calls fn when collected. fn emits a value
+// for each set of label values
+func NewGaugeFunc(name, help string, labels []string, fn func(emit func(value float64, labelValues ...string))) *GaugeFunc {
+	return &GaugeFunc{descriptor: descriptor{name: name, help: help, metricType: typeGauge, labels: labels}, fn: fn}
+}
+
+func (g *GaugeFunc) desc() *descriptor { return &g.descriptor }
+
+func (g *GaugeFunc) collect(emit func(sample)) {
+	if g.fn == nil {
+		return
+	}
+	g.fn(func(value float64, labelValues ...string) {
+		if len(labelValues) != len(g.labels) {
+			return
+		}
+		emit(sample{labelValues: slices.Clone(labelValues), value: value})
+	})
+}
+
+func (d *descriptor) validate() error {
+	if !nameRegexp.MatchString(d.name) {
+		return fmt.Errorf("%w: %q", errInvalidName, d.name)
+	}
+	for _, l := range d.labels {
+		if !labelRegexp.MatchString(l) || strings.HasPrefix(l, "__") || l == "le" {
+			return fmt.Errorf("%w: %q", errInvalidLabelName, l)
+		}
+	}
+	return nil
+}
+
+func (s *series) update(labelValues []string, buckets int, fn func(*seriesValue)) {
+	k := seriesKey(labelValues)
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.values == nil {
+		s.values = make(map[string]*seriesValue)
+	}
+	v, ok := s.values[k]
+	if !ok {
+		v = &seriesValue{labelValues: slices.Clone(labelValues)}
+		if buckets > 0 {
+			v.counts = make([]uint64, buckets)
+		}
+		s.values[k] = v
+	}
+	fn(v)
+}
+
+// each calls fn for copies of each series sorted by label values
+func (s *series) each(fn func(*seriesValue)) {
+	s.m.Lock()
+	keys := make([]string, 0, len(s.values))
+	for k := range s.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]seriesValue, len(keys))
+	for i := range keys {
+		v := s.values[keys[i]]
+		values[i] = seriesValue{labelValues: v.labelValues, value: v.value, counts: slices.Clone(v.counts), sum: v.sum, count: v.count}
+	}
+	s.m.Unlock()
+	for i := range values {
+		fn(&values[i])
+	}
+}
+
+func seriesKey(labelValues []string) string {
+	return strings.Join(labelValues, "\xff")
+}
+
+func writeLabels(w *bufio.Writer, names, values []string, extra [2]string) {
+	if len(names) == 0 && extra[0] == "" {
+		return
+	}
+	w.WriteByte('{')
+	for i := range names {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		w.WriteString(names[i] + `="` + escapeLabelValue(values[i]) + `"`)
+	}
+	if extra[0] != "" {
+		if len(names) > 0 {
+			w.WriteByte(',')
+		}
+		w.WriteString(extra[0] + `="` + extra[1] + `"`)
+	}
+	w.WriteByte('}')
+}
+
+var (
+	helpReplacer  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+func escapeHelp(s string) string { return helpReplacer.Replace(s) }
+
+func escapeLabelValue(s string) string { return labelReplacer.Replace(s) }
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+	r := NewRegistry()
+	require.NoError(t, r.Register(NewCounterVec("gct_requests", "Requests", "exchange")))
+	assert.ErrorIs(t, r.Register(NewGaugeVec("gct_requests", "Duplicate")), errDuplicateCollector)
+	assert.ErrorIs(t, r.Register(NewGaugeVec("gct-requests", "Invalid")), errInvalidName)
+	assert.ErrorIs(t, r.Register(NewGaugeVec("gct_valid", "Invalid label", "bad-label")), errInvalidLabelName)
+	assert.ErrorIs(t, r.Register(NewHistogramVec("gct_latency", "Reserved label", nil, "le")), errInvalidLabelName)
+	assert.ErrorIs(t, r.Register(nil), errNilCollector)
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+	r := NewRegistry()
+	c := NewCounterVec("gct_requests", "HTTP requests\nsent", "exchange", "method")
+	g := NewGaugeVec("gct_connected", "Connection state", "exchange")
+	h := NewHistogramVec("gct_latency_seconds", "Latency", []float64{1, 0.1}, "exchange")
+	f := NewGaugeFunc("gct_vms", "VMs", nil, func(emit func(float64, ...string)) {
+		emit(3)
+		emit(4, "ignored")
+	})
+	require.NoError(t, r.Register(c, g, h, f))
+
+	c.Inc("Binance", "GET")
+	c.Add(2, "Binance", "GET")
+	c.Add(-1, "Binance", "GET")
+	c.Inc("Bit\"stamp", "POST")
+	c.Inc("missing label")
+	g.Set(1, "Binance")
+	g.Set(1, "Kraken")
+	g.Add(-1, "Kraken")
+	g.Set(1, "Bitstamp")
+	g.Delete("Bitstamp")
+	h.Observe(0.05, "Binance")
+	h.Observe(0.5, "Binance")
+	h.Observe(5, "Binance")
+
+	var sb strings.Builder
+	require.NoError(t, r.Write(&sb))
+	assert.Equal(t, `# HELP gct_connected Connection state
+# TYPE gct_connected gauge
+gct_connected{exchange="Binance"} 1
+gct_connected{exchange="Kraken"} 0
+# HELP gct_latency_seconds Latency
+# TYPE gct_latency_seconds histogram
+gct_latency_seconds_bucket{exchange="Binance",le="0.1"} 1
+gct_latency_seconds_bucket{exchange="Binance",le="1"} 2
+gct_latency_seconds_bucket{exchange="Binance",le="+Inf"} 3
+gct_latency_seconds_sum{exchange="Binance"} 5.55
+gct_latency_seconds_count{exchange="Binance"} 3
+# HELP gct_requests HTTP requests\nsent
+# TYPE gct_requests counter
+gct_requests_total{exchange="Binance",method="GET"} 3
+gct_requests_total{exchange="Bit\"stamp",method="POST"} 1
+# HELP gct_vms VMs
+# TYPE gct_vms gauge
+gct_vms 3
+`, sb.String())
+
+	r.Unregister(f)
+	sb.Reset()
+	require.NoError(t, r.Write(&sb))
+	assert.NotContains(t, sb.String(), "gct_vms", "unregistered metrics should not be written")
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+	r := NewRegistry()
+	g := NewGaugeVec("gct_up", "Up")
+	require.NoError(t, r.Register(g))
+	g.Set(1)
+	rec := httptest.NewRecorder()
+	r.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, ContentType, rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), "gct_up 1\n")
+}
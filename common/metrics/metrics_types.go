@@ -0,0 +1,94 @@
+package metrics
+
+import (
+	"errors"
+	"sync"
+)
+
+// Metric types as written in the exposition format
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+
+	// ContentType is the content type of the Prometheus text exposition format
+	ContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// DefaultBuckets are histogram buckets suitable for request latencies in
+// seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+var (
+	errInvalidName        = errors.New("invalid metric name")
+	errInvalidLabelName   = errors.New("invalid label name")
+	errDuplicateCollector = errors.New("metric already registered")
+	errNilCollector       = errors.New("collector is nil")
+)
+
+// Collector is a metric family which can be registered and written
+type Collector interface {
+	desc() *descriptor
+	collect(emit func(sample))
+}
+
+// Registry holds registered collectors and writes them in the Prometheus text
+// exposition format, which is also accepted by OpenMetrics scrapers
+type Registry struct {
+	m          sync.RWMutex
+	collectors map[string]Collector
+}
+
+type descriptor struct {
+	name       string
+	help       string
+	metricType string
+	labels     []string
+}
+
+type sample struct {
+	suffix      string
+	labelValues []string
+	extraLabel  [2]string
+	value       float64
+}
+
+// series stores per label value state for a metric vector
+type series struct {
+	m      sync.Mutex
+	values map[string]*seriesValue
+}
+
+type seriesValue struct {
+	labelValues []string
+	value       float64
+	// histogram state
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// CounterVec is a monotonically increasing value partitioned by labels
+type CounterVec struct {
+	descriptor
+	series
+}
+
+// GaugeVec is a value which can go up and down partitioned by labels
+type GaugeVec struct {
+	descriptor
+	series
+}
+
+// HistogramVec samples observations into buckets partitioned by labels
+type HistogramVec struct {
+	descriptor
+	series
+	buckets []float64
+}
+
+// GaugeFunc is a gauge whose values are read from a function when collected
+type GaugeFunc struct {
+	descriptor
+	fn func(emit func(value float64, labelValues ...string))
+}
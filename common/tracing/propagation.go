@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var traceContext propagation.TraceContext
+
+// metadataCarrier adapts gRPC metadata for use with a propagator
+type metadataCarrier metadata.MD
+
+// Get returns the first value of a metadata key
+func (m metadataCarrier) Get(key string) string {
+	if v := metadata.MD(m).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+// Set sets a metadata key
+func (m metadataCarrier) Set(key, value string) {
+	metadata.MD(m).Set(key, value)
+}
+
+// Keys returns the metadata keys
+func (m metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// UnaryServerInterceptor starts a server span for each unary gRPC call, using
+// any traceparent in the incoming metadata as the parent
+func UnaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, span := startServerSpan(ctx, info.FullMethod)
+	resp, err := handler(ctx, req)
+	endServerSpan(span, err)
+	return resp, err
+}
+
+// StreamServerInterceptor starts a server span for each streaming gRPC call,
+// using any traceparent in the incoming metadata as the parent
+func StreamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+	err := handler(srv, &tracedServerStream{ServerStream: ss, ctx: ctx})
+	endServerSpan(span, err)
+	return err
+}
+
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context holding the server span
+func (t *tracedServerStream) Context() context.Context {
+	return t.ctx
+}
+
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = traceContext.Extract(ctx, metadataCarrier(md))
+	}
+	name := strings.TrimPrefix(fullMethod, "/")
+	service, method, _ := strings.Cut(name, "/")
+	return Start(ctx, name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", service),
+		attribute.String("rpc.method", method),
+	))
+}
+
+func endServerSpan(span trace.Span, err error) {
+	span.SetAttributes(attribute.Int64("rpc.grpc.status_code", int64(status.Code(err))))
+	RecordError(span, err)
+	span.End()
+}
@@ -0,0 +1,37 @@
+// Package tracing starts OpenTelemetry spans using the global tracer provider
+// and propagates the W3C trace context across gRPC calls. Tracing is disabled
+// until a tracer provider is set with otel.SetTracerProvider
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// DefaultServiceName is the service name used when none is configured
+	DefaultServiceName = "gocryptotrader"
+	// TraceParentHeader is the W3C trace context header and gRPC metadata key
+	TraceParentHeader = "traceparent"
+
+	instrumentationScope = "github.com/thrasher-corp/gocryptotrader"
+)
+
+// Start starts a span as a child of any span in ctx using the global tracer
+// provider. A non-recording span is returned when tracing is disabled
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationScope).Start(ctx, name, opts...)
+}
+
+// RecordError records err as an exception event and sets the span status to
+// error. A nil error is ignored
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
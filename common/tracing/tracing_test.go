@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// setTestProvider sets a global tracer provider which records spans in memory
+func setTestProvider(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		assert.NoError(t, tp.Shutdown(context.Background()), "Shutdown should not error")
+	})
+	return exp
+}
+
+// TestStart sets the global tracer provider so must not run in parallel
+func TestStart(t *testing.T) {
+	_, span := Start(t.Context(), "disabled")
+	assert.False(t, span.IsRecording(), "spans should not be recorded without a tracer provider")
+	span.End()
+
+	exp := setTestProvider(t)
+	ctx, parent := Start(t.Context(), "parent", trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attribute.String("exchange", "Bitstamp")))
+	_, child := Start(ctx, "child")
+	RecordError(child, nil)
+	RecordError(child, errors.New("rate limited"))
+	child.End()
+	parent.End()
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 2, "must export both spans")
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, parent.SpanContext().TraceID(), spans[0].SpanContext.TraceID(), "child should share the parent trace ID")
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent.SpanID(), "child parent should be the parent span")
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, "rate limited", spans[0].Status.Description)
+	require.Len(t, spans[0].Events, 1, "RecordError must add a single exception event")
+	assert.Equal(t, "exception", spans[0].Events[0].Name)
+	assert.Equal(t, trace.SpanKindServer, spans[1].SpanKind)
+	assert.False(t, spans[1].Parent.IsValid(), "root span should not have a parent")
+}
+
+// TestServerInterceptors sets the global tracer provider so must not run in
+// parallel
+func TestServerInterceptors(t *testing.T) {
+	exp := setTestProvider(t)
+
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(TraceParentHeader, traceParent))
+	info := &grpc.UnaryServerInfo{FullMethod: "/gctrpc.GoCryptoTraderService/SubmitOrder"}
+	_, err := UnaryServerInterceptor(ctx, nil, info, func(ctx context.Context, _ any) (any, error) {
+		_, s := Start(ctx, "OrderManager.Submit")
+		s.End()
+		return nil, errors.New("rejected")
+	})
+	require.Error(t, err, "UnaryServerInterceptor must return the handler error")
+
+	spans := exp.GetSpans()
+	require.Len(t, spans, 2, "must export the handler and server spans")
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID(), "handler span should be a child of the server span")
+	assert.Equal(t, "gctrpc.GoCryptoTraderService/SubmitOrder", spans[1].Name)
+	assert.Equal(t, trace.SpanKindServer, spans[1].SpanKind)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[1].SpanContext.TraceID().String(), "server span should join the remote trace")
+	assert.Equal(t, "00f067aa0ba902b7", spans[1].Parent.SpanID().String(), "server span should use the remote parent")
+	assert.True(t, spans[1].Parent.IsRemote(), "server span parent should be remote")
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+
+	exp.Reset()
+	var streamCtx context.Context
+	err = StreamServerInterceptor(nil, &testServerStream{ctx: t.Context()}, &grpc.StreamServerInfo{FullMethod: "/gctrpc.GoCryptoTraderService/GetTickerStream"}, func(_ any, ss grpc.ServerStream) error {
+		streamCtx = ss.Context()
+		return nil
+	})
+	require.NoError(t, err, "StreamServerInterceptor must not error")
+	spans = exp.GetSpans()
+	require.Len(t, spans, 1, "must export the stream server span")
+	assert.Equal(t, spans[0].SpanContext.SpanID(), trace.SpanContextFromContext(streamCtx).SpanID(), "stream context should hold the server span")
+	assert.Equal(t, codes.Unset, spans[0].Status.Code)
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (t *testServerStream) Context() context.Context { return t.ctx }
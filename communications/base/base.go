@@ -1,9 +1,22 @@
 package base
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
 	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
+// ErrUnexpectedHTTPStatus is returned when a relayer's HTTP endpoint responds
+// with a non 2xx status code
+var ErrUnexpectedHTTPStatus = errors.New("unexpected HTTP status")
+
 // Base enforces standard variables across communication packages
 type Base struct {
 	Name           string
@@ -11,12 +24,29 @@ type Base struct {
 	Verbose        bool
 	Connected      bool
 	ServiceStarted time.Time
+
+	commandsMtx sync.RWMutex
+	commands    CommandHandler
 }
 
-// Event is a generalise event type
+// CommandHandler executes commands received from authorised chat users and
+// returns the reply to send back
+type CommandHandler interface {
+	HandleCommand(ctx context.Context, sender, command string) string
+	Help() string
+}
+
+// Event is a generalised notification. Events which only set Type are
+// categorised by their type.
 type Event struct {
-	Type    string
-	Message string
+	Type     string
+	Message  string
+	Category Category
+	Severity Severity
+	Exchange string
+	Pair     string
+	Payload  map[string]any
+	Time     time.Time
 }
 
 // CommsStatus stores the status of a comms relayer
@@ -60,6 +90,11 @@ type CommunicationsConfig struct {
 	SMSGlobalConfig SMSGlobalConfig `json:"smsGlobal"`
 	SMTPConfig      SMTPConfig      `json:"smtp"`
 	TelegramConfig  TelegramConfig  `json:"telegram"`
+	WebhookConfig   WebhookConfig   `json:"webhook"`
+	DiscordConfig   DiscordConfig   `json:"discord"`
+	MatrixConfig    MatrixConfig    `json:"matrix"`
+
+	Notifications NotificationConfig `json:"notifications"`
 }
 
 // IsAnyEnabled returns whether any comms relayers
@@ -68,7 +103,10 @@ func (c *CommunicationsConfig) IsAnyEnabled() bool {
 	if c.SMSGlobalConfig.Enabled ||
 		c.SMTPConfig.Enabled ||
 		c.SlackConfig.Enabled ||
-		c.TelegramConfig.Enabled {
+		c.TelegramConfig.Enabled ||
+		c.WebhookConfig.Enabled ||
+		c.DiscordConfig.Enabled ||
+		c.MatrixConfig.Enabled {
 		return true
 	}
 	return false
@@ -76,11 +114,12 @@ func (c *CommunicationsConfig) IsAnyEnabled() bool {
 
 // SlackConfig holds all variables to start and run the Slack package
 type SlackConfig struct {
-	Name              string `json:"name"`
-	Enabled           bool   `json:"enabled"`
-	Verbose           bool   `json:"verbose"`
-	TargetChannel     string `json:"targetChannel"`
-	VerificationToken string `json:"verificationToken"`
+	Name              string   `json:"name"`
+	Enabled           bool     `json:"enabled"`
+	Verbose           bool     `json:"verbose"`
+	TargetChannel     string   `json:"targetChannel"`
+	VerificationToken string   `json:"verificationToken"`
+	AuthorisedClients []string `json:"authorisedClients,omitempty"`
 }
 
 // SMSContact stores the SMS contact info
@@ -123,3 +162,81 @@ type TelegramConfig struct {
 	VerificationToken string           `json:"verificationToken"`
 	AuthorisedClients map[string]int64 `json:"authorisedClients"`
 }
+
+// WebhookConfig holds all variables to start and run the Webhook package
+type WebhookConfig struct {
+	Name            string            `json:"name"`
+	Enabled         bool              `json:"enabled"`
+	Verbose         bool              `json:"verbose"`
+	URL             string            `json:"url"`
+	Method          string            `json:"method,omitempty"`
+	Headers         map[string]string `json:"headers,omitempty"`
+	BodyTemplate    string            `json:"bodyTemplate,omitempty"`
+	Secret          string            `json:"secret,omitempty"`
+	SignatureHeader string            `json:"signatureHeader,omitempty"`
+}
+
+// DiscordConfig holds all variables to start and run the Discord package
+type DiscordConfig struct {
+	Name       string `json:"name"`
+	Enabled    bool   `json:"enabled"`
+	Verbose    bool   `json:"verbose"`
+	WebhookURL string `json:"webhookURL"`
+	Username   string `json:"username,omitempty"`
+}
+
+// MatrixConfig holds all variables to start and run the Matrix package
+type MatrixConfig struct {
+	Name        string `json:"name"`
+	Enabled     bool   `json:"enabled"`
+	Verbose     bool   `json:"verbose"`
+	HomeServer  string `json:"homeServer"`
+	AccessToken string `json:"accessToken"`
+	RoomID      string `json:"roomID"`
+}
+
+// SetCommandHandler sets the handler for commands not handled by the
+// communication package itself
+func (b *Base) SetCommandHandler(h CommandHandler) {
+	b.commandsMtx.Lock()
+	b.commands = h
+	b.commandsMtx.Unlock()
+}
+
+// GetCommandHandler returns the command handler or nil if one isn't set
+func (b *Base) GetCommandHandler() CommandHandler {
+	b.commandsMtx.RLock()
+	defer b.commandsMtx.RUnlock()
+	return b.commands
+}
+
+// SendHTTPRequest sends a request with the supplied body to a relayer's HTTP
+// endpoint and returns the response body, erroring on non 2xx status codes
+func SendHTTPRequest(ctx context.Context, client *http.Client, method, url string, headers map[string]string, body []byte, verbose bool) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	if verbose {
+		log.Debugf(log.CommunicationMgr, "Communications: %s request body: %s", method, body)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	contents, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if verbose {
+		log.Debugf(log.CommunicationMgr, "Communications: %s response %d: %s", method, resp.StatusCode, contents)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("%w %d: %s", ErrUnexpectedHTTPStatus, resp.StatusCode, contents)
+	}
+	return contents, nil
+}
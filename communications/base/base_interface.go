@@ -21,6 +21,12 @@ type ICommunicate interface {
 	SetServiceStarted(time.Time)
 }
 
+// Commander is implemented by communication packages which accept commands
+// from authorised users
+type Commander interface {
+	SetCommandHandler(CommandHandler)
+}
+
 // Setup sets up communication variables and initiates a connection to the
 // communication mediums
 func (c IComm) Setup() {
@@ -50,6 +56,16 @@ func (c IComm) PushEvent(event Event) {
 	}
 }
 
+// SetCommandHandler sets the command handler on all communication packages
+// which accept commands
+func (c IComm) SetCommandHandler(h CommandHandler) {
+	for i := range c {
+		if cmd, ok := c[i].(Commander); ok {
+			cmd.SetCommandHandler(h)
+		}
+	}
+}
+
 // GetStatus returns the status of the comms relayers
 func (c IComm) GetStatus() map[string]CommsStatus {
 	result := make(map[string]CommsStatus)
@@ -1,8 +1,11 @@
 package base
 
 import (
+	"context"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 var b Base
@@ -151,3 +154,29 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+type testCommandHandler struct{}
+
+func (testCommandHandler) HandleCommand(_ context.Context, _, _ string) string { return "" }
+func (testCommandHandler) Help() string                                        { return "" }
+
+type commandProvider struct {
+	CommunicationProvider
+	handler CommandHandler
+}
+
+func (p *commandProvider) SetCommandHandler(h CommandHandler) {
+	p.handler = h
+}
+
+func TestSetCommandHandler(t *testing.T) {
+	var b Base
+	assert.Nil(t, b.GetCommandHandler(), "GetCommandHandler should return nil when unset")
+	b.SetCommandHandler(testCommandHandler{})
+	assert.Equal(t, testCommandHandler{}, b.GetCommandHandler(), "GetCommandHandler should return the handler set")
+
+	p := &commandProvider{}
+	ic := IComm{p, &CommunicationProvider{}}
+	ic.SetCommandHandler(testCommandHandler{})
+	assert.Equal(t, testCommandHandler{}, p.handler, "SetCommandHandler should set the handler on commanders")
+}
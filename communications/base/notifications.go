@@ -0,0 +1,271 @@
+package base
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// String returns the config name of the severity
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	case SeverityCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseSeverity returns the severity for a config name, an empty name is info
+func ParseSeverity(s string) (Severity, error) {
+	if s == "" {
+		return SeverityInfo, nil
+	}
+	for sev := SeverityInfo; sev <= SeverityCritical; sev++ {
+		if strings.EqualFold(s, sev.String()) {
+			return sev, nil
+		}
+	}
+	return 0, fmt.Errorf("%w %q", errInvalidSeverity, s)
+}
+
+// Validate checks the route's severity and that its relayers are in the
+// supplied list of relayer names
+func (r *NotificationRoute) Validate(relayers []string) error {
+	if len(r.Relayers) == 0 {
+		return errRouteRelayersEmpty
+	}
+	if _, err := ParseSeverity(r.MinSeverity); err != nil {
+		return err
+	}
+	for _, name := range r.Relayers {
+		if !containsFold(relayers, name) {
+			return fmt.Errorf("%w %q", errUnknownRelayer, name)
+		}
+	}
+	return nil
+}
+
+// Validate checks the limit values
+func (l NotificationLimit) Validate() error {
+	if l.MaxPerMinute < 0 || l.DedupeWindow < 0 {
+		return errInvalidLimit
+	}
+	return nil
+}
+
+// Validate checks the routes, limits and templates against the supplied list
+// of relayer names
+func (n *NotificationConfig) Validate(relayers []string) error {
+	var errs error
+	for i := range n.Routes {
+		if err := n.Routes[i].Validate(relayers); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("route #%d: %w", i+1, err))
+		}
+	}
+	for name, l := range n.Limits {
+		if !containsFold(relayers, name) {
+			errs = common.AppendError(errs, fmt.Errorf("limit: %w %q", errUnknownRelayer, name))
+		}
+		if err := l.Validate(); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("limit %q: %w", name, err))
+		}
+	}
+	for category, text := range n.Templates {
+		if _, err := parseNotificationTemplate(category, text); err != nil {
+			errs = common.AppendError(errs, err)
+		}
+	}
+	return errs
+}
+
+// NewNotifier returns a notifier for the relayers using the supplied config
+func NewNotifier(comms IComm, cfg *NotificationConfig) (*Notifier, error) {
+	n := &Notifier{
+		comms:     comms,
+		templates: make(map[Category]*template.Template),
+		limiters:  make(map[string]*relayerLimiter),
+	}
+	if cfg == nil {
+		return n, nil
+	}
+	names := make([]string, len(comms))
+	for i := range comms {
+		names[i] = comms[i].GetName()
+	}
+	if err := cfg.Validate(names); err != nil {
+		return nil, err
+	}
+	for i := range cfg.Routes {
+		sev, err := ParseSeverity(cfg.Routes[i].MinSeverity)
+		if err != nil {
+			return nil, err
+		}
+		r := notificationRoute{
+			exchanges:   cfg.Routes[i].Exchanges,
+			minSeverity: sev,
+			relayers:    cfg.Routes[i].Relayers,
+		}
+		for _, c := range cfg.Routes[i].Categories {
+			r.categories = append(r.categories, Category(strings.ToLower(c)))
+		}
+		n.routes = append(n.routes, r)
+	}
+	for name, l := range cfg.Limits {
+		n.limiters[strings.ToLower(name)] = &relayerLimiter{NotificationLimit: l, recent: make(map[string]time.Time)}
+	}
+	for category, text := range cfg.Templates {
+		tmpl, err := parseNotificationTemplate(category, text)
+		if err != nil {
+			return nil, err
+		}
+		n.templates[Category(strings.ToLower(category))] = tmpl
+	}
+	return n, nil
+}
+
+// Notify formats a notification and pushes it to the relayers selected by the
+// routes, subject to each relayer's limits
+func (n *Notifier) Notify(e Event) {
+	e.normalise()
+	e.Message = n.render(&e)
+	relayers, routed := n.route(&e)
+	if routed && len(relayers) == 0 {
+		return
+	}
+	for i := range n.comms {
+		if !n.comms[i].IsEnabled() || !n.comms[i].IsConnected() {
+			continue
+		}
+		name := n.comms[i].GetName()
+		if routed && !containsFold(relayers, name) {
+			continue
+		}
+		if !n.allow(name, &e, e.Time) {
+			log.Debugf(log.CommunicationMgr, "Communications: %s notification suppressed for %s by rate limit or deduplication", e.Category, name)
+			continue
+		}
+		if err := n.comms[i].PushEvent(e); err != nil {
+			log.Errorf(log.CommunicationMgr, "Communications error - PushEvent() in package %s with %v. Err %s", name, e, err)
+		}
+	}
+}
+
+// route returns the relayers of the first route matching the event and
+// whether routing applies
+func (n *Notifier) route(e *Event) (relayers []string, routed bool) {
+	if len(n.routes) == 0 {
+		return nil, false
+	}
+	for i := range n.routes {
+		r := &n.routes[i]
+		if e.Severity < r.minSeverity {
+			continue
+		}
+		if len(r.categories) > 0 && !slices.Contains(r.categories, e.Category) {
+			continue
+		}
+		if len(r.exchanges) > 0 && !containsFold(r.exchanges, e.Exchange) {
+			continue
+		}
+		return r.relayers, true
+	}
+	return nil, true
+}
+
+// render returns the event message formatted by its category's template
+func (n *Notifier) render(e *Event) string {
+	tmpl, ok := n.templates[e.Category]
+	if !ok {
+		return e.Message
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, e); err != nil {
+		log.Errorf(log.CommunicationMgr, "Communications: unable to render %s notification template: %s", e.Category, err)
+		return e.Message
+	}
+	return sb.String()
+}
+
+// allow returns whether the relayer's limits permit sending the event and
+// records it as sent when they do
+func (n *Notifier) allow(relayer string, e *Event, now time.Time) bool {
+	l, ok := n.limiters[strings.ToLower(relayer)]
+	if !ok {
+		return true
+	}
+	n.m.Lock()
+	defer n.m.Unlock()
+	key := string(e.Category) + "|" + e.Severity.String() + "|" + e.Exchange + "|" + e.Pair + "|" + e.Message
+	if l.DedupeWindow > 0 {
+		for k, t := range l.recent {
+			if now.Sub(t) >= l.DedupeWindow {
+				delete(l.recent, k)
+			}
+		}
+		if _, ok := l.recent[key]; ok {
+			return false
+		}
+	}
+	if l.MaxPerMinute > 0 {
+		cutoff := now.Add(-time.Minute)
+		l.sent = slices.DeleteFunc(l.sent, func(t time.Time) bool { return !t.After(cutoff) })
+		if len(l.sent) >= l.MaxPerMinute {
+			return false
+		}
+		l.sent = append(l.sent, now)
+	}
+	if l.DedupeWindow > 0 {
+		l.recent[key] = now
+	}
+	return true
+}
+
+// normalise populates the category, type and time for events which only set
+// a type
+func (e *Event) normalise() {
+	if e.Category == "" {
+		e.Category = Category(strings.ToLower(e.Type))
+	}
+	if e.Category == "" {
+		e.Category = CategoryGeneral
+	}
+	if e.Type == "" {
+		e.Type = string(e.Category)
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+}
+
+// ValidateNotificationTemplate checks a category's template parses
+func ValidateNotificationTemplate(category, text string) error {
+	_, err := parseNotificationTemplate(category, text)
+	return err
+}
+
+func parseNotificationTemplate(category, text string) (*template.Template, error) {
+	if category == "" {
+		return nil, errTemplateCategoryUnset
+	}
+	tmpl, err := template.New(category).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("template %q: %w", category, err)
+	}
+	return tmpl, nil
+}
+
+func containsFold(list []string, s string) bool {
+	return slices.ContainsFunc(list, func(v string) bool { return strings.EqualFold(v, s) })
+}
@@ -0,0 +1,134 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingProvider struct {
+	CommunicationProvider
+	name   string
+	events []Event
+}
+
+func (p *recordingProvider) GetName() string { return p.name }
+
+func (p *recordingProvider) PushEvent(e Event) error {
+	p.events = append(p.events, e)
+	return nil
+}
+
+func newRecordingProviders(names ...string) (IComm, []*recordingProvider) {
+	var ic IComm
+	providers := make([]*recordingProvider, len(names))
+	for i, name := range names {
+		providers[i] = &recordingProvider{name: name, CommunicationProvider: CommunicationProvider{isEnabled: true, isConnected: true}}
+		ic = append(ic, providers[i])
+	}
+	return ic, providers
+}
+
+func TestParseSeverity(t *testing.T) {
+	t.Parallel()
+	for s := SeverityInfo; s <= SeverityCritical; s++ {
+		got, err := ParseSeverity(s.String())
+		require.NoError(t, err)
+		assert.Equal(t, s, got)
+	}
+	s, err := ParseSeverity("")
+	require.NoError(t, err)
+	assert.Equal(t, SeverityInfo, s, "empty severity should be info")
+	_, err = ParseSeverity("meh")
+	assert.ErrorIs(t, err, errInvalidSeverity)
+}
+
+func TestNotificationConfigValidate(t *testing.T) {
+	t.Parallel()
+	relayers := []string{"Slack", "Telegram"}
+	assert.NoError(t, (&NotificationConfig{
+		Routes:    []NotificationRoute{{Categories: []string{"fill"}, Relayers: []string{"slack"}}},
+		Limits:    map[string]NotificationLimit{"Telegram": {MaxPerMinute: 5}},
+		Templates: map[string]string{"fill": "{{.Exchange}} filled"},
+	}).Validate(relayers))
+
+	assert.ErrorIs(t, (&NotificationConfig{Routes: []NotificationRoute{{}}}).Validate(relayers), errRouteRelayersEmpty)
+	assert.ErrorIs(t, (&NotificationConfig{Routes: []NotificationRoute{{Relayers: []string{"Pager"}}}}).Validate(relayers), errUnknownRelayer)
+	assert.ErrorIs(t, (&NotificationConfig{Routes: []NotificationRoute{{MinSeverity: "loud", Relayers: []string{"Slack"}}}}).Validate(relayers), errInvalidSeverity)
+	assert.ErrorIs(t, (&NotificationConfig{Limits: map[string]NotificationLimit{"Slack": {MaxPerMinute: -1}}}).Validate(relayers), errInvalidLimit)
+	assert.Error(t, (&NotificationConfig{Templates: map[string]string{"fill": "{{.Exchange"}}).Validate(relayers), "Validate should error on an invalid template")
+}
+
+func TestNotifierRouting(t *testing.T) {
+	t.Parallel()
+	ic, p := newRecordingProviders("Slack", "Telegram", "SMTP")
+	n, err := NewNotifier(ic, &NotificationConfig{
+		Routes: []NotificationRoute{
+			{Categories: []string{"withdrawal"}, Relayers: []string{"SMTP", "Telegram"}},
+			{Categories: []string{"fill"}, Relayers: []string{"Slack"}},
+			{MinSeverity: "critical", Relayers: []string{"Telegram"}},
+			{Exchanges: []string{"Bitstamp"}, Relayers: []string{"SMTP"}},
+		},
+	})
+	require.NoError(t, err)
+
+	n.Notify(Event{Category: CategoryWithdrawal, Message: "withdrawn"})
+	n.Notify(Event{Category: CategoryFill, Message: "filled"})
+	n.Notify(Event{Type: "connectivity", Severity: SeverityCritical, Message: "offline"})
+	n.Notify(Event{Category: CategoryOrder, Exchange: "bitstamp", Message: "submitted"})
+	n.Notify(Event{Category: CategoryOrder, Exchange: "Binance", Message: "unrouted"})
+
+	require.Len(t, p[0].events, 1, "Slack must only receive fills")
+	assert.Equal(t, "filled", p[0].events[0].Message)
+	require.Len(t, p[1].events, 2, "Telegram must receive withdrawals and critical notifications")
+	assert.Equal(t, CategoryConnectivity, p[1].events[1].Category, "category should be derived from the type")
+	require.Len(t, p[2].events, 2, "SMTP must receive withdrawals and Bitstamp notifications")
+	assert.Equal(t, "submitted", p[2].events[1].Message)
+
+	n, err = NewNotifier(ic, nil)
+	require.NoError(t, err)
+	n.Notify(Event{Message: "broadcast"})
+	for i := range p {
+		assert.Equal(t, "broadcast", p[i].events[len(p[i].events)-1].Message, "notifications should be broadcast without routes")
+	}
+}
+
+func TestNotifierLimits(t *testing.T) {
+	t.Parallel()
+	ic, p := newRecordingProviders("Slack", "Telegram")
+	n, err := NewNotifier(ic, &NotificationConfig{
+		Limits: map[string]NotificationLimit{
+			"slack":    {MaxPerMinute: 2},
+			"Telegram": {DedupeWindow: time.Minute},
+		},
+	})
+	require.NoError(t, err)
+	for _, msg := range []string{"a", "b", "c", "a"} {
+		n.Notify(Event{Category: CategoryOrder, Message: msg})
+	}
+	assert.Len(t, p[0].events, 2, "Slack should be rate limited")
+	assert.Len(t, p[1].events, 3, "Telegram should deduplicate identical notifications")
+
+	l := n.limiters["telegram"]
+	for k := range l.recent {
+		l.recent[k] = time.Now().Add(-2 * time.Minute)
+	}
+	n.Notify(Event{Category: CategoryOrder, Message: "a"})
+	assert.Len(t, p[1].events, 4, "notifications should be sent again after the deduplication window")
+}
+
+func TestNotifierTemplates(t *testing.T) {
+	t.Parallel()
+	ic, p := newRecordingProviders("Slack")
+	n, err := NewNotifier(ic, &NotificationConfig{
+		Templates: map[string]string{"fill": "[{{.Severity}}] {{.Exchange}} {{.Pair}} filled {{index .Payload \"amount\"}}"},
+	})
+	require.NoError(t, err)
+	n.Notify(Event{Category: CategoryFill, Exchange: "Bitstamp", Pair: "BTC-USD", Payload: map[string]any{"amount": 1.5}, Message: "raw"})
+	n.Notify(Event{Category: CategoryOrder, Message: "raw"})
+	require.Len(t, p[0].events, 2)
+	assert.Equal(t, "[info] Bitstamp BTC-USD filled 1.5", p[0].events[0].Message)
+	assert.Equal(t, "raw", p[0].events[1].Message, "categories without a template should not be changed")
+}
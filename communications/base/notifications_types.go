@@ -0,0 +1,91 @@
+package base
+
+import (
+	"errors"
+	"sync"
+	"text/template"
+	"time"
+)
+
+// Category groups notifications so they can be routed and templated
+type Category string
+
+// Notification categories
+const (
+	CategoryGeneral      Category = "general"
+	CategoryOrder        Category = "order"
+	CategoryFill         Category = "fill"
+	CategoryWithdrawal   Category = "withdrawal"
+	CategoryTransfer     Category = "transfer"
+	CategoryEvent        Category = "event"
+	CategoryConnectivity Category = "connectivity"
+	CategoryRisk         Category = "risk"
+)
+
+// Severity is the importance of a notification
+type Severity uint8
+
+// Notification severities
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+	SeverityCritical
+)
+
+var (
+	errInvalidSeverity       = errors.New("invalid severity")
+	errRouteRelayersEmpty    = errors.New("route must specify at least one relayer")
+	errUnknownRelayer        = errors.New("unknown relayer")
+	errInvalidLimit          = errors.New("rate limit and deduplication window cannot be negative")
+	errTemplateCategoryUnset = errors.New("template category cannot be empty")
+)
+
+// NotificationConfig configures how notifications are routed, limited and
+// formatted. Without routes every notification is sent to every relayer.
+type NotificationConfig struct {
+	Routes    []NotificationRoute          `json:"routes,omitempty"`
+	Limits    map[string]NotificationLimit `json:"limits,omitempty"`
+	Templates map[string]string            `json:"templates,omitempty"`
+}
+
+// NotificationRoute sends matching notifications to relayers. Routes are
+// evaluated in order and the first matching route is used. Empty categories or
+// exchanges match everything.
+type NotificationRoute struct {
+	Categories  []string `json:"categories,omitempty"`
+	Exchanges   []string `json:"exchanges,omitempty"`
+	MinSeverity string   `json:"minSeverity,omitempty"`
+	Relayers    []string `json:"relayers"`
+}
+
+// NotificationLimit limits how often a relayer is sent notifications
+type NotificationLimit struct {
+	// MaxPerMinute is the maximum notifications sent per minute, 0 is unlimited
+	MaxPerMinute int `json:"maxPerMinute,omitempty"`
+	// DedupeWindow suppresses identical notifications sent within the window
+	DedupeWindow time.Duration `json:"dedupeWindow,omitempty"`
+}
+
+// Notifier routes, limits and formats notifications for the communication
+// relayers
+type Notifier struct {
+	comms     IComm
+	routes    []notificationRoute
+	templates map[Category]*template.Template
+	limiters  map[string]*relayerLimiter
+	m         sync.Mutex
+}
+
+type notificationRoute struct {
+	categories  []Category
+	exchanges   []string
+	minSeverity Severity
+	relayers    []string
+}
+
+type relayerLimiter struct {
+	NotificationLimit
+	sent   []time.Time
+	recent map[string]time.Time
+}
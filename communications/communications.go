@@ -4,15 +4,20 @@ import (
 	"errors"
 
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/communications/discord"
+	"github.com/thrasher-corp/gocryptotrader/communications/matrix"
 	"github.com/thrasher-corp/gocryptotrader/communications/slack"
 	"github.com/thrasher-corp/gocryptotrader/communications/smsglobal"
 	"github.com/thrasher-corp/gocryptotrader/communications/smtpservice"
 	"github.com/thrasher-corp/gocryptotrader/communications/telegram"
+	"github.com/thrasher-corp/gocryptotrader/communications/webhook"
+	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 // Communications is the overarching type across the communications packages
 type Communications struct {
 	base.IComm
+	notifier *base.Notifier
 }
 
 // ErrNoRelayersEnabled returns when no communication relayers are enabled
@@ -49,6 +54,36 @@ func NewComm(cfg *base.CommunicationsConfig) (*Communications, error) {
 		comm.IComm = append(comm.IComm, Slack)
 	}
 
+	if cfg.WebhookConfig.Enabled {
+		Webhook := new(webhook.Webhook)
+		Webhook.Setup(cfg)
+		comm.IComm = append(comm.IComm, Webhook)
+	}
+
+	if cfg.DiscordConfig.Enabled {
+		Discord := new(discord.Discord)
+		Discord.Setup(cfg)
+		comm.IComm = append(comm.IComm, Discord)
+	}
+
+	if cfg.MatrixConfig.Enabled {
+		Matrix := new(matrix.Matrix)
+		Matrix.Setup(cfg)
+		comm.IComm = append(comm.IComm, Matrix)
+	}
+
+	var err error
+	comm.notifier, err = base.NewNotifier(comm.IComm, &cfg.Notifications)
+	if err != nil {
+		log.Errorf(log.CommunicationMgr, "Communications: invalid notification config, sending all notifications to all relayers. Err: %s", err)
+		comm.notifier, _ = base.NewNotifier(comm.IComm, nil)
+	}
+
 	comm.Setup()
 	return &comm, nil
 }
+
+// PushEvent routes a notification to the communication relayers
+func (c *Communications) PushEvent(event base.Event) {
+	c.notifier.Notify(event)
+}
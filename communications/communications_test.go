@@ -16,13 +16,16 @@ func TestNewComm(t *testing.T) {
 	cfg.SMSGlobalConfig.Enabled = true
 	cfg.SMTPConfig.Enabled = true
 	cfg.SlackConfig.Enabled = true
+	cfg.WebhookConfig.Enabled = true
+	cfg.DiscordConfig.Enabled = true
+	cfg.MatrixConfig.Enabled = true
 	communications, err := NewComm(&cfg)
 	if err != nil {
 		t.Error("Unexpected result")
 	}
 
-	if len(communications.IComm) != 4 {
-		t.Errorf("communications NewComm, expected len 4, got len %d",
+	if len(communications.IComm) != 7 {
+		t.Errorf("communications NewComm, expected len 7, got len %d",
 			len(communications.IComm))
 	}
 }
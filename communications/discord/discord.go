@@ -0,0 +1,60 @@
+// Package discord sends events to a Discord channel using an incoming webhook.
+// Discord is a chat platform organised into servers and channels
+package discord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Setup takes in a Discord configuration and sets the webhook URL and the
+// username messages are posted as
+func (d *Discord) Setup(cfg *base.CommunicationsConfig) {
+	d.Name = cfg.DiscordConfig.Name
+	d.Enabled = cfg.DiscordConfig.Enabled
+	d.Verbose = cfg.DiscordConfig.Verbose
+	d.WebhookURL = cfg.DiscordConfig.WebhookURL
+	d.Username = cfg.DiscordConfig.Username
+}
+
+// Connect validates the webhook URL. Webhooks are stateless so no connection
+// is held open
+func (d *Discord) Connect() error {
+	u, err := url.Parse(d.WebhookURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return errWebhookURLInvalid
+	}
+	if d.client == nil {
+		d.client = common.NewHTTPClientWithTimeout(defaultTimeout)
+	}
+	d.Connected = true
+	return nil
+}
+
+// PushEvent posts an event to the Discord channel
+func (d *Discord) PushEvent(event base.Event) error {
+	if !d.Connected {
+		return errNotConnected
+	}
+	return d.SendMessage(fmt.Sprintf("**%s** [%s] %s", event.Type, event.Severity, event.Message))
+}
+
+// SendMessage posts a message to the Discord channel, truncating it to the
+// maximum length Discord accepts
+func (d *Discord) SendMessage(message string) error {
+	if r := []rune(message); len(r) > maxContentLength {
+		message = string(r[:maxContentLength-3]) + "..."
+	}
+	body, err := json.Marshal(&WebhookMessage{Content: message, Username: d.Username})
+	if err != nil {
+		return err
+	}
+	_, err = base.SendHTTPRequest(context.TODO(), d.client, http.MethodPost, d.WebhookURL, map[string]string{"Content-Type": "application/json"}, body, d.Verbose)
+	return err
+}
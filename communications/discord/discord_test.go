@@ -0,0 +1,76 @@
+package discord
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+func newTestServer(t *testing.T, status int) (srv *httptest.Server, messages chan WebhookMessage) {
+	t.Helper()
+	messages = make(chan WebhookMessage, 1)
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "method should be POST")
+		assert.Equal(t, "/api/webhooks/1/token", r.URL.Path, "path should be the webhook path")
+		var m WebhookMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&m), "Decode should not error")
+		messages <- m
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, messages
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	var d Discord
+	d.Setup(&base.CommunicationsConfig{DiscordConfig: base.DiscordConfig{
+		Name:       "Discord",
+		Enabled:    true,
+		WebhookURL: "https://discord.com/api/webhooks/1/token",
+		Username:   "GCT",
+	}})
+	assert.Equal(t, "Discord", d.GetName())
+	assert.True(t, d.IsEnabled())
+	assert.Equal(t, "https://discord.com/api/webhooks/1/token", d.WebhookURL)
+	assert.Equal(t, "GCT", d.Username)
+}
+
+func TestConnect(t *testing.T) {
+	t.Parallel()
+	d := &Discord{}
+	assert.ErrorIs(t, d.Connect(), errWebhookURLInvalid)
+	d.WebhookURL = "https://discord.com/api/webhooks/1/token"
+	require.NoError(t, d.Connect())
+	assert.True(t, d.IsConnected())
+}
+
+func TestPushEvent(t *testing.T) {
+	t.Parallel()
+	srv, messages := newTestServer(t, http.StatusNoContent)
+	d := &Discord{WebhookURL: srv.URL + "/api/webhooks/1/token", Username: "GCT"}
+	assert.ErrorIs(t, d.PushEvent(base.Event{}), errNotConnected)
+	require.NoError(t, d.Connect())
+	require.NoError(t, d.PushEvent(base.Event{Type: "fill", Severity: base.SeverityWarning, Message: "filled"}))
+	assert.Equal(t, WebhookMessage{Content: "**fill** [warning] filled", Username: "GCT"}, <-messages)
+
+	require.NoError(t, d.SendMessage(strings.Repeat("a", maxContentLength+10)))
+	m := <-messages
+	assert.Len(t, m.Content, maxContentLength, "long messages should be truncated")
+	assert.True(t, strings.HasSuffix(m.Content, "..."), "truncated messages should end with an ellipsis")
+}
+
+func TestPushEventStatus(t *testing.T) {
+	t.Parallel()
+	srv, messages := newTestServer(t, http.StatusTooManyRequests)
+	d := &Discord{WebhookURL: srv.URL + "/api/webhooks/1/token"}
+	require.NoError(t, d.Connect())
+	assert.ErrorIs(t, d.PushEvent(base.Event{Message: "test"}), base.ErrUnexpectedHTTPStatus)
+	<-messages
+}
@@ -0,0 +1,35 @@
+package discord
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+)
+
+const (
+	// maxContentLength is the maximum message length accepted by Discord
+	maxContentLength = 2000
+	defaultTimeout   = 15 * time.Second
+)
+
+var (
+	errWebhookURLInvalid = errors.New("discord webhook URL must be an absolute https URL")
+	errNotConnected      = errors.New("discord not connected")
+)
+
+// Discord sends events to a Discord channel via an incoming webhook
+type Discord struct {
+	base.Base
+	WebhookURL string
+	Username   string
+
+	client *http.Client
+}
+
+// WebhookMessage is the body of a Discord webhook execution
+type WebhookMessage struct {
+	Content  string `json:"content"`
+	Username string `json:"username,omitempty"`
+}
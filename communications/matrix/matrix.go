@@ -0,0 +1,90 @@
+// Package matrix sends events to a room on a Matrix home server. Matrix is an
+// open standard for decentralised real time communication
+package matrix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Setup takes in a Matrix configuration and sets the home server, access
+// token and target room
+func (m *Matrix) Setup(cfg *base.CommunicationsConfig) {
+	m.Name = cfg.MatrixConfig.Name
+	m.Enabled = cfg.MatrixConfig.Enabled
+	m.Verbose = cfg.MatrixConfig.Verbose
+	m.HomeServer = strings.TrimSuffix(cfg.MatrixConfig.HomeServer, "/")
+	m.AccessToken = cfg.MatrixConfig.AccessToken
+	m.RoomID = cfg.MatrixConfig.RoomID
+}
+
+// Connect verifies the access token against the home server
+func (m *Matrix) Connect() error {
+	u, err := url.Parse(m.HomeServer)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return errHomeServerInvalid
+	}
+	if m.AccessToken == "" {
+		return errAccessTokenEmpty
+	}
+	if m.RoomID == "" {
+		return errRoomIDEmpty
+	}
+	if m.client == nil {
+		m.client = common.NewHTTPClientWithTimeout(defaultTimeout)
+	}
+	resp, err := base.SendHTTPRequest(context.TODO(), m.client, http.MethodGet, m.HomeServer+whoAmIPath, m.headers(), nil, m.Verbose)
+	if err != nil {
+		return err
+	}
+	var who WhoAmIResponse
+	if err := json.Unmarshal(resp, &who); err != nil {
+		return err
+	}
+	m.UserID = who.UserID
+	log.Debugf(log.CommunicationMgr, "Matrix: Connected to %s as %s", m.HomeServer, m.UserID)
+	m.Connected = true
+	return nil
+}
+
+// PushEvent sends an event to the Matrix room
+func (m *Matrix) PushEvent(event base.Event) error {
+	if !m.Connected {
+		return errNotConnected
+	}
+	return m.SendMessage(fmt.Sprintf("%s [%s] %s", event.Type, event.Severity, event.Message))
+}
+
+// SendMessage sends a text message to the Matrix room
+func (m *Matrix) SendMessage(message string) error {
+	body, err := json.Marshal(&RoomMessage{MsgType: "m.text", Body: message})
+	if err != nil {
+		return err
+	}
+	path := m.HomeServer + fmt.Sprintf(sendMessagePath, url.PathEscape(m.RoomID), m.nextTxnID())
+	_, err = base.SendHTTPRequest(context.TODO(), m.client, http.MethodPut, path, m.headers(), body, m.Verbose)
+	return err
+}
+
+// nextTxnID returns a transaction ID unique to this client so the home server
+// can deduplicate retried requests
+func (m *Matrix) nextTxnID() string {
+	return "gct" + strconv.FormatInt(time.Now().UnixNano(), 10) + "." + strconv.FormatUint(m.txnID.Add(1), 10)
+}
+
+func (m *Matrix) headers() map[string]string {
+	return map[string]string{
+		"Authorization": "Bearer " + m.AccessToken,
+		"Content-Type":  "application/json",
+	}
+}
@@ -0,0 +1,97 @@
+package matrix
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const (
+	testToken  = "syt_token"
+	testRoomID = "!abc:example.org"
+)
+
+type sentMessage struct {
+	path    string
+	message RoomMessage
+}
+
+func newTestServer(t *testing.T) (srv *httptest.Server, sent chan sentMessage) {
+	t.Helper()
+	sent = make(chan sentMessage, 2)
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errcode":"M_UNKNOWN_TOKEN"}`))
+			return
+		}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == whoAmIPath:
+			_, _ = w.Write([]byte(`{"user_id":"@gct:example.org"}`))
+		case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/_matrix/client/v3/rooms/"+testRoomID+"/send/m.room.message/"):
+			var m RoomMessage
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&m), "Decode should not error")
+			sent <- sentMessage{path: r.URL.Path, message: m}
+			_, _ = w.Write([]byte(`{"event_id":"$event"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, sent
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	var m Matrix
+	m.Setup(&base.CommunicationsConfig{MatrixConfig: base.MatrixConfig{
+		Name:        "Matrix",
+		Enabled:     true,
+		HomeServer:  "https://matrix.org/",
+		AccessToken: testToken,
+		RoomID:      testRoomID,
+	}})
+	assert.Equal(t, "Matrix", m.GetName())
+	assert.True(t, m.IsEnabled())
+	assert.Equal(t, "https://matrix.org", m.HomeServer, "trailing slash should be trimmed")
+	assert.Equal(t, testToken, m.AccessToken)
+	assert.Equal(t, testRoomID, m.RoomID)
+}
+
+func TestConnect(t *testing.T) {
+	t.Parallel()
+	srv, _ := newTestServer(t)
+	m := &Matrix{}
+	assert.ErrorIs(t, m.Connect(), errHomeServerInvalid)
+	m.HomeServer = srv.URL
+	assert.ErrorIs(t, m.Connect(), errAccessTokenEmpty)
+	m.AccessToken = "wrong"
+	assert.ErrorIs(t, m.Connect(), errRoomIDEmpty)
+	m.RoomID = testRoomID
+	assert.ErrorIs(t, m.Connect(), base.ErrUnexpectedHTTPStatus)
+	assert.False(t, m.IsConnected())
+	m.AccessToken = testToken
+	require.NoError(t, m.Connect())
+	assert.True(t, m.IsConnected())
+	assert.Equal(t, "@gct:example.org", m.UserID)
+}
+
+func TestPushEvent(t *testing.T) {
+	t.Parallel()
+	srv, sent := newTestServer(t)
+	m := &Matrix{HomeServer: srv.URL, AccessToken: testToken, RoomID: testRoomID}
+	assert.ErrorIs(t, m.PushEvent(base.Event{}), errNotConnected)
+	require.NoError(t, m.Connect())
+	require.NoError(t, m.PushEvent(base.Event{Type: "risk", Severity: base.SeverityCritical, Message: "liquidation"}))
+	require.NoError(t, m.PushEvent(base.Event{Type: "risk", Message: "recovered"}))
+	first, second := <-sent, <-sent
+	assert.Equal(t, RoomMessage{MsgType: "m.text", Body: "risk [critical] liquidation"}, first.message)
+	assert.Equal(t, "risk [info] recovered", second.message.Body)
+	assert.NotEqual(t, first.path, second.path, "transaction IDs should be unique")
+}
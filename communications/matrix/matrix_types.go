@@ -0,0 +1,51 @@
+package matrix
+
+import (
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+)
+
+const (
+	whoAmIPath      = "/_matrix/client/v3/account/whoami"
+	sendMessagePath = "/_matrix/client/v3/rooms/%s/send/m.room.message/%s"
+	defaultTimeout  = 15 * time.Second
+)
+
+var (
+	errHomeServerInvalid = errors.New("matrix home server must be an absolute http or https URL")
+	errAccessTokenEmpty  = errors.New("matrix access token cannot be empty")
+	errRoomIDEmpty       = errors.New("matrix room ID cannot be empty")
+	errNotConnected      = errors.New("matrix not connected")
+)
+
+// Matrix sends events to a Matrix room using the client-server API
+type Matrix struct {
+	base.Base
+	HomeServer  string
+	AccessToken string
+	RoomID      string
+	UserID      string
+
+	client *http.Client
+	txnID  atomic.Uint64
+}
+
+// WhoAmIResponse is the response to a whoami request
+type WhoAmIResponse struct {
+	UserID string `json:"user_id"`
+}
+
+// RoomMessage is a text message sent to a room
+type RoomMessage struct {
+	MsgType string `json:"msgtype"`
+	Body    string `json:"body"`
+}
+
+// SendResponse is the response to sending a room event
+type SendResponse struct {
+	EventID string `json:"event_id"`
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -40,6 +41,7 @@ type Slack struct {
 
 	TargetChannel     string
 	VerificationToken string
+	AuthorisedClients []string
 
 	TargetChannelID string
 	Details         Response
@@ -63,6 +65,7 @@ func (s *Slack) Setup(cfg *base.CommunicationsConfig) {
 	s.Verbose = cfg.SlackConfig.Verbose
 	s.TargetChannel = cfg.SlackConfig.TargetChannel
 	s.VerificationToken = cfg.SlackConfig.VerificationToken
+	s.AuthorisedClients = cfg.SlackConfig.AuthorisedClients
 }
 
 // Connect connects to the service
@@ -379,21 +382,36 @@ func (s *Slack) WebsocketSend(eventType, text string) error {
 	return s.WebsocketConn.WriteMessage(gws.TextMessage, data)
 }
 
-// HandleMessage handles incoming messages and/or commands from slack
+// HandleMessage handles incoming messages and/or commands from slack. Commands
+// not handled by the bot are passed to the command handler when sent by an
+// authorised client.
 func (s *Slack) HandleMessage(msg *Message) error {
 	if msg == nil {
 		return errors.New("slack msg is nil")
 	}
 
-	msg.Text = strings.ToLower(msg.Text)
-	switch {
-	case strings.Contains(msg.Text, cmdStatus):
+	text := strings.TrimSpace(msg.Text)
+	cmd, _, _ := strings.Cut(strings.ToLower(text), " ")
+	switch cmd {
+	case cmdStatus:
 		return s.WebsocketSend("message", s.GetStatus())
 
-	case strings.Contains(msg.Text, cmdHelp):
-		return s.WebsocketSend("message", getHelp)
+	case cmdHelp:
+		help := getHelp
+		if h := s.GetCommandHandler(); h != nil {
+			help += "\nTrading commands, prefixed with !:\n" + h.Help()
+		}
+		return s.WebsocketSend("message", help)
 
 	default:
+		if h := s.GetCommandHandler(); h != nil {
+			username := s.GetUsernameByID(msg.User)
+			if !slices.Contains(s.AuthorisedClients, username) {
+				log.Warnf(log.CommunicationMgr, "Slack: Received command from unauthorised user: %s [%s]\n", username, msg.User)
+				return s.WebsocketSend("message", "GoCryptoTrader SlackBot - Unauthorised!")
+			}
+			return s.WebsocketSend("message", h.HandleCommand(context.TODO(), s.Name+":"+username, strings.TrimPrefix(text, "!")))
+		}
 		return s.WebsocketSend("message", "GoCryptoTrader SlackBot - Command Unknown!")
 	}
 }
@@ -192,27 +192,48 @@ func (t *Telegram) InitialConnect() error {
 	return nil
 }
 
-// HandleMessages handles incoming message from the long polling routine
+// HandleMessages handles incoming message from the long polling routine.
+// Commands not handled by the bot are passed to the command handler.
 func (t *Telegram) HandleMessages(text string, chatID int64) error {
 	if t.Verbose {
 		log.Debugf(log.CommunicationMgr, "Telegram: Received message: %s\n", text)
 	}
 
-	switch {
-	case strings.Contains(text, cmdHelp):
-		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply), chatID)
+	cmd, _, _ := strings.Cut(strings.TrimSpace(text), " ")
+	switch cmd {
+	case cmdHelp:
+		reply := fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply)
+		if h := t.GetCommandHandler(); h != nil {
+			reply += "\nTrading commands, prefixed with /:\n" + h.Help()
+		}
+		return t.SendMessage(reply, chatID)
 
-	case strings.Contains(text, cmdStart):
+	case cmdStart:
 		return t.SendMessage(talkRoot+": START COMMANDS HERE", chatID)
 
-	case strings.Contains(text, cmdStatus):
+	case cmdStatus:
 		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, t.GetStatus()), chatID)
 
 	default:
+		if h := t.GetCommandHandler(); h != nil && strings.HasPrefix(cmd, "/") {
+			sender := t.Name + ":" + t.usernameByID(chatID)
+			return t.SendMessage(h.HandleCommand(context.TODO(), sender, strings.TrimPrefix(strings.TrimSpace(text), "/")), chatID)
+		}
 		return t.SendMessage(fmt.Sprintf("Command %s not recognized", text), chatID)
 	}
 }
 
+// usernameByID returns the authorised username for a chat ID, or the ID if
+// it is not found
+func (t *Telegram) usernameByID(chatID int64) string {
+	for username, id := range t.AuthorisedClients {
+		if id == chatID {
+			return username
+		}
+	}
+	return strconv.FormatInt(chatID, 10)
+}
+
 // GetUpdates gets new updates via a long poll connection
 func (t *Telegram) GetUpdates() (GetUpdateResponse, error) {
 	var newUpdates GetUpdateResponse
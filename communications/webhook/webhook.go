@@ -0,0 +1,145 @@
+// Package webhook sends events to a generic HTTP endpoint with a configurable
+// JSON body and optional HMAC signature
+package webhook
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Setup takes in a webhook configuration and sets the endpoint, headers, body
+// template and signing secret
+func (w *Webhook) Setup(cfg *base.CommunicationsConfig) {
+	w.Name = cfg.WebhookConfig.Name
+	w.Enabled = cfg.WebhookConfig.Enabled
+	w.Verbose = cfg.WebhookConfig.Verbose
+	w.URL = cfg.WebhookConfig.URL
+	w.Method = cfg.WebhookConfig.Method
+	w.Headers = cfg.WebhookConfig.Headers
+	w.BodyTemplate = cfg.WebhookConfig.BodyTemplate
+	w.Secret = cfg.WebhookConfig.Secret
+	w.SignatureHeader = cfg.WebhookConfig.SignatureHeader
+}
+
+// Connect validates the endpoint and body template. Webhooks are stateless so
+// no connection is held open
+func (w *Webhook) Connect() error {
+	if err := ValidateURL(w.URL); err != nil {
+		return err
+	}
+	if w.Method == "" {
+		w.Method = http.MethodPost
+	}
+	w.Method = strings.ToUpper(w.Method)
+	if w.Method != http.MethodPost && w.Method != http.MethodPut {
+		return fmt.Errorf("%w: %q", errMethodInvalid, w.Method)
+	}
+	if w.SignatureHeader == "" {
+		w.SignatureHeader = DefaultSignatureHeader
+	}
+	w.body = nil
+	if w.BodyTemplate != "" {
+		tmpl, err := ParseBodyTemplate(w.BodyTemplate)
+		if err != nil {
+			return err
+		}
+		w.body = tmpl
+	}
+	if w.client == nil {
+		w.client = common.NewHTTPClientWithTimeout(defaultTimeout)
+	}
+	w.Connected = true
+	return nil
+}
+
+// PushEvent sends an event to the webhook endpoint
+func (w *Webhook) PushEvent(event base.Event) error {
+	if !w.Connected {
+		return errWebhookNotActive
+	}
+	body, err := w.renderBody(&event)
+	if err != nil {
+		return err
+	}
+	headers := make(map[string]string, len(w.Headers)+2)
+	for k, v := range w.Headers {
+		headers[k] = v
+	}
+	headers["Content-Type"] = "application/json"
+	if w.Secret != "" {
+		sig, err := Sign(body, w.Secret)
+		if err != nil {
+			return err
+		}
+		headers[w.SignatureHeader] = sig
+	}
+	_, err = base.SendHTTPRequest(context.TODO(), w.client, w.Method, w.URL, headers, body, w.Verbose)
+	return err
+}
+
+// renderBody returns the JSON body for an event using the body template when
+// one is configured
+func (w *Webhook) renderBody(event *base.Event) ([]byte, error) {
+	if w.body == nil {
+		return json.Marshal(&Payload{
+			Type:     event.Type,
+			Category: string(event.Category),
+			Severity: event.Severity.String(),
+			Exchange: event.Exchange,
+			Pair:     event.Pair,
+			Message:  event.Message,
+			Data:     event.Payload,
+			Time:     event.Time,
+		})
+	}
+	var sb strings.Builder
+	if err := w.body.Execute(&sb, event); err != nil {
+		return nil, err
+	}
+	body := []byte(sb.String())
+	if !json.Valid(body) {
+		return nil, errBodyInvalidJSON
+	}
+	return body, nil
+}
+
+// Sign returns the signature header value for a body, which is the hex
+// encoded HMAC-SHA256 of the body prefixed with "sha256="
+func Sign(body []byte, secret string) (string, error) {
+	h, err := crypto.GetHMAC(crypto.HashSHA256, body, []byte(secret))
+	if err != nil {
+		return "", err
+	}
+	return "sha256=" + hex.EncodeToString(h), nil
+}
+
+// ValidateURL checks the URL is an absolute http or https URL
+func ValidateURL(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%w: %q", errURLInvalid, u)
+	}
+	return nil
+}
+
+// ParseBodyTemplate parses a JSON body template. The template is executed with
+// the event and the json function encodes a value as JSON, e.g.
+// {"text": {{json .Message}}}
+func ParseBodyTemplate(text string) (*template.Template, error) {
+	return template.New("body").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+}
@@ -0,0 +1,136 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+type request struct {
+	method string
+	header http.Header
+	body   []byte
+}
+
+func newTestServer(t *testing.T, status int) (srv *httptest.Server, requests chan request) {
+	t.Helper()
+	requests = make(chan request, 1)
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err, "ReadAll should not error")
+		requests <- request{method: r.Method, header: r.Header, body: body}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, requests
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	var w Webhook
+	w.Setup(&base.CommunicationsConfig{WebhookConfig: base.WebhookConfig{
+		Name:    "Webhook",
+		Enabled: true,
+		URL:     "https://example.com",
+		Method:  "put",
+		Secret:  "shh",
+	}})
+	assert.Equal(t, "Webhook", w.GetName())
+	assert.True(t, w.IsEnabled())
+	assert.Equal(t, "https://example.com", w.URL)
+	assert.Equal(t, "put", w.Method)
+	assert.Equal(t, "shh", w.Secret)
+}
+
+func TestConnect(t *testing.T) {
+	t.Parallel()
+	w := &Webhook{URL: "ftp://example.com"}
+	assert.ErrorIs(t, w.Connect(), errURLInvalid)
+	w = &Webhook{URL: "https://example.com", Method: "GET"}
+	assert.ErrorIs(t, w.Connect(), errMethodInvalid)
+	w = &Webhook{URL: "https://example.com", BodyTemplate: "{{.Message"}
+	assert.Error(t, w.Connect(), "Connect should error on an invalid body template")
+	w = &Webhook{URL: "https://example.com", Method: "put"}
+	require.NoError(t, w.Connect())
+	assert.True(t, w.IsConnected())
+	assert.Equal(t, http.MethodPut, w.Method)
+	assert.Equal(t, DefaultSignatureHeader, w.SignatureHeader)
+}
+
+func TestPushEvent(t *testing.T) {
+	t.Parallel()
+	srv, requests := newTestServer(t, http.StatusNoContent)
+	w := &Webhook{URL: srv.URL, Headers: map[string]string{"X-Test": "gct"}, Secret: "shh"}
+	assert.ErrorIs(t, w.PushEvent(base.Event{}), errWebhookNotActive)
+	require.NoError(t, w.Connect())
+
+	now := time.Now().UTC().Truncate(time.Second)
+	require.NoError(t, w.PushEvent(base.Event{
+		Type:     "fill",
+		Category: base.CategoryFill,
+		Severity: base.SeverityWarning,
+		Exchange: "Bitstamp",
+		Pair:     "BTC-USD",
+		Message:  "filled",
+		Payload:  map[string]any{"amount": 1.5},
+		Time:     now,
+	}))
+	req := <-requests
+	assert.Equal(t, http.MethodPost, req.method)
+	assert.Equal(t, "gct", req.header.Get("X-Test"))
+	assert.Equal(t, "application/json", req.header.Get("Content-Type"))
+	sig, err := Sign(req.body, "shh")
+	require.NoError(t, err)
+	assert.Equal(t, sig, req.header.Get(DefaultSignatureHeader), "signature header should match the body")
+
+	var p Payload
+	require.NoError(t, json.Unmarshal(req.body, &p))
+	assert.Equal(t, Payload{
+		Type:     "fill",
+		Category: "fill",
+		Severity: "warning",
+		Exchange: "Bitstamp",
+		Pair:     "BTC-USD",
+		Message:  "filled",
+		Data:     map[string]any{"amount": 1.5},
+		Time:     now,
+	}, p)
+}
+
+func TestPushEventTemplate(t *testing.T) {
+	t.Parallel()
+	srv, requests := newTestServer(t, http.StatusOK)
+	w := &Webhook{URL: srv.URL, BodyTemplate: `{"text": {{json .Message}}, "exchange": {{json .Exchange}}}`}
+	require.NoError(t, w.Connect())
+	require.NoError(t, w.PushEvent(base.Event{Message: `quoted "message"`, Exchange: "Bitstamp"}))
+	req := <-requests
+	assert.JSONEq(t, `{"text": "quoted \"message\"", "exchange": "Bitstamp"}`, string(req.body))
+	assert.Empty(t, req.header.Get(DefaultSignatureHeader), "signature header should not be set without a secret")
+
+	w = &Webhook{URL: srv.URL, BodyTemplate: `{"text": {{.Message}}}`}
+	require.NoError(t, w.Connect())
+	assert.ErrorIs(t, w.PushEvent(base.Event{Message: "unquoted"}), errBodyInvalidJSON)
+}
+
+func TestPushEventStatus(t *testing.T) {
+	t.Parallel()
+	srv, requests := newTestServer(t, http.StatusInternalServerError)
+	w := &Webhook{URL: srv.URL}
+	require.NoError(t, w.Connect())
+	assert.ErrorIs(t, w.PushEvent(base.Event{Message: "test"}), base.ErrUnexpectedHTTPStatus)
+	<-requests
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	sig, err := Sign([]byte("hello"), "secret")
+	require.NoError(t, err)
+	assert.Equal(t, "sha256=88aab3ede8d3adf94d26ab90d3bafd4a2083070c3bcce9c014ee04a443847c0b", sig)
+}
@@ -0,0 +1,51 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"text/template"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+)
+
+const (
+	// DefaultSignatureHeader is the header containing the HMAC-SHA256 body
+	// signature when a secret is configured
+	DefaultSignatureHeader = "X-GCT-Signature"
+
+	defaultTimeout = 15 * time.Second
+)
+
+var (
+	errURLInvalid       = errors.New("webhook URL must be an absolute http or https URL")
+	errMethodInvalid    = errors.New("webhook method must be POST or PUT")
+	errBodyInvalidJSON  = errors.New("webhook body template did not render valid JSON")
+	errWebhookNotActive = errors.New("webhook not connected")
+)
+
+// Webhook sends events as JSON to a generic HTTP endpoint
+type Webhook struct {
+	base.Base
+	URL             string
+	Method          string
+	Headers         map[string]string
+	BodyTemplate    string
+	Secret          string
+	SignatureHeader string
+
+	body   *template.Template
+	client *http.Client
+}
+
+// Payload is the default JSON body sent when no body template is configured
+type Payload struct {
+	Type     string         `json:"type"`
+	Category string         `json:"category"`
+	Severity string         `json:"severity"`
+	Exchange string         `json:"exchange,omitempty"`
+	Pair     string         `json:"pair,omitempty"`
+	Message  string         `json:"message"`
+	Data     map[string]any `json:"data,omitempty"`
+	Time     time.Time      `json:"time"`
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config/versions"
 	"github.com/thrasher-corp/gocryptotrader/connchecker"
@@ -24,6 +25,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/encoding/jsonpatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -154,6 +156,7 @@ func (c *Config) PurgeExchangeAPICredentials() {
 		}
 		c.Exchanges[x].API.AuthenticatedSupport = false
 		c.Exchanges[x].API.AuthenticatedWebsocketSupport = false
+		c.Exchanges[x].API.UseCredentialProvider = false
 
 		if c.Exchanges[x].API.CredentialsValidator.RequiresKey {
 			c.Exchanges[x].API.Credentials.Key = DefaultAPIKey
@@ -169,6 +172,7 @@ func (c *Config) PurgeExchangeAPICredentials() {
 
 		c.Exchanges[x].API.Credentials.PEMKey = ""
 		c.Exchanges[x].API.Credentials.OTPSecret = ""
+		c.Exchanges[x].API.Accounts = nil
 	}
 }
 
@@ -298,6 +302,28 @@ func (c *Config) CheckCommunicationsConfig() {
 		}
 	}
 
+	if c.Communications.WebhookConfig.Name == "" {
+		c.Communications.WebhookConfig = base.WebhookConfig{
+			Name: "Webhook",
+			URL:  "https://example.com/webhook",
+		}
+	}
+
+	if c.Communications.DiscordConfig.Name == "" {
+		c.Communications.DiscordConfig = base.DiscordConfig{
+			Name:       "Discord",
+			WebhookURL: "https://discord.com/api/webhooks/id/token",
+		}
+	}
+
+	if c.Communications.MatrixConfig.Name == "" {
+		c.Communications.MatrixConfig = base.MatrixConfig{
+			Name:       "Matrix",
+			HomeServer: "https://matrix.org",
+			RoomID:     "!room:matrix.org",
+		}
+	}
+
 	if c.Communications.TelegramConfig.AuthorisedClients == nil {
 		c.Communications.TelegramConfig.AuthorisedClients = map[string]int64{"user_example": 0}
 	}
@@ -305,7 +331,10 @@ func (c *Config) CheckCommunicationsConfig() {
 	if c.Communications.SlackConfig.Name != "Slack" ||
 		c.Communications.SMSGlobalConfig.Name != "SMSGlobal" ||
 		c.Communications.SMTPConfig.Name != "SMTP" ||
-		c.Communications.TelegramConfig.Name != "Telegram" {
+		c.Communications.TelegramConfig.Name != "Telegram" ||
+		c.Communications.WebhookConfig.Name != "Webhook" ||
+		c.Communications.DiscordConfig.Name != "Discord" ||
+		c.Communications.MatrixConfig.Name != "Matrix" {
 		log.Warnln(log.ConfigMgr, "Communications config name/s not set correctly")
 	}
 	if c.Communications.SlackConfig.Enabled {
@@ -342,6 +371,62 @@ func (c *Config) CheckCommunicationsConfig() {
 			log.Warnln(log.ConfigMgr, "Telegram enabled in config but variable data not set, disabling.")
 		}
 	}
+	if c.Communications.WebhookConfig.Enabled {
+		if c.Communications.WebhookConfig.URL == "" ||
+			c.Communications.WebhookConfig.URL == "https://example.com/webhook" {
+			c.Communications.WebhookConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "Webhook enabled in config but variable data not set, disabling.")
+		}
+	}
+	if c.Communications.DiscordConfig.Enabled {
+		if c.Communications.DiscordConfig.WebhookURL == "" ||
+			c.Communications.DiscordConfig.WebhookURL == "https://discord.com/api/webhooks/id/token" {
+			c.Communications.DiscordConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "Discord enabled in config but variable data not set, disabling.")
+		}
+	}
+	if c.Communications.MatrixConfig.Enabled {
+		if c.Communications.MatrixConfig.HomeServer == "" ||
+			c.Communications.MatrixConfig.AccessToken == "" ||
+			c.Communications.MatrixConfig.RoomID == "" ||
+			c.Communications.MatrixConfig.RoomID == "!room:matrix.org" {
+			c.Communications.MatrixConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "Matrix enabled in config but variable data not set, disabling.")
+		}
+	}
+	c.checkNotificationConfig()
+}
+
+// checkNotificationConfig removes notification routes, limits and templates
+// which are invalid so remaining notifications are delivered as configured
+func (c *Config) checkNotificationConfig() {
+	comms := &c.Communications
+	relayers := []string{
+		comms.SlackConfig.Name, comms.SMSGlobalConfig.Name, comms.SMTPConfig.Name, comms.TelegramConfig.Name,
+		comms.WebhookConfig.Name, comms.DiscordConfig.Name, comms.MatrixConfig.Name,
+	}
+	n := &comms.Notifications
+	routes := n.Routes[:0]
+	for i := range n.Routes {
+		if err := n.Routes[i].Validate(relayers); err != nil {
+			log.Warnf(log.ConfigMgr, warningNotificationRouteInvalid, i+1, err)
+			continue
+		}
+		routes = append(routes, n.Routes[i])
+	}
+	n.Routes = routes
+	for name, l := range n.Limits {
+		if err := l.Validate(); err != nil || !slices.ContainsFunc(relayers, func(r string) bool { return strings.EqualFold(r, name) }) {
+			log.Warnf(log.ConfigMgr, warningNotificationLimitInvalid, name)
+			delete(n.Limits, name)
+		}
+	}
+	for category, text := range n.Templates {
+		if err := base.ValidateNotificationTemplate(category, text); err != nil {
+			log.Warnf(log.ConfigMgr, warningNotificationTemplateInvalid, category, err)
+			delete(n.Templates, category)
+		}
+	}
 }
 
 // GetExchangeAssetTypes returns the exchanges supported asset types
@@ -831,6 +916,67 @@ func (c *Config) UpdateExchangeConfig(e *Exchange) error {
 	return fmt.Errorf("%s %w", e.Name, ErrExchangeNotFound)
 }
 
+// GetAPIAccountCredentials returns the credentials for the named API account,
+// or the default credentials if name is empty
+func (c *Exchange) GetAPIAccountCredentials(name string) (*APICredentialsConfig, error) {
+	if name == "" {
+		return &c.API.Credentials, nil
+	}
+	for i := range c.API.Accounts {
+		if strings.EqualFold(c.API.Accounts[i].Name, name) {
+			return &c.API.Accounts[i].Credentials, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %q %w", c.Name, name, errAPIAccountNotFound)
+}
+
+// checkAPIAccounts removes named API accounts which are unnamed, duplicated
+// or have default/empty credential values
+func (c *Exchange) checkAPIAccounts() {
+	names := make(map[string]struct{}, len(c.API.Accounts))
+	accounts := c.API.Accounts[:0]
+	for i := range c.API.Accounts {
+		a := &c.API.Accounts[i]
+		a.Name = strings.TrimSpace(a.Name)
+		var reason string
+		switch {
+		case a.Name == "":
+			reason = errAPIAccountNameEmpty.Error()
+		case !c.API.UseCredentialProvider && !c.apiAccountCredentialsValid(&a.Credentials):
+			reason = "default/empty APIKey/Secret/ClientID values"
+		default:
+			if _, ok := names[strings.ToLower(a.Name)]; ok {
+				reason = fmt.Sprintf("%s %q", errAPIAccountNameDuplicate, a.Name)
+			}
+		}
+		if reason != "" {
+			log.Warnf(log.ConfigMgr, warningExchangeAPIAccountInvalid, c.Name, i, reason)
+			continue
+		}
+		names[strings.ToLower(a.Name)] = struct{}{}
+		accounts = append(accounts, *a)
+	}
+	c.API.Accounts = accounts
+}
+
+// apiAccountCredentialsValid checks the account credentials against the
+// exchange credentials validator
+func (c *Exchange) apiAccountCredentialsValid(creds *APICredentialsConfig) bool {
+	if c.API.CredentialsValidator == nil {
+		return creds.Key != "" || creds.Secret != "" || creds.ClientID != "" || creds.PEMKey != ""
+	}
+	if c.API.CredentialsValidator.RequiresKey && (creds.Key == "" || creds.Key == DefaultAPIKey) {
+		return false
+	}
+	if c.API.CredentialsValidator.RequiresSecret && (creds.Secret == "" || creds.Secret == DefaultAPISecret) {
+		return false
+	}
+	if c.API.CredentialsValidator.RequiresClientID && (creds.ClientID == "" || creds.ClientID == DefaultAPIClientID) {
+		return false
+	}
+	return true
+}
+
 // CheckExchangeConfigValues returns configuration values for all enabled
 // exchanges
 func (c *Config) CheckExchangeConfigValues() error {
@@ -920,7 +1066,7 @@ func (c *Config) CheckExchangeConfigValues() error {
 			continue
 		}
 		if (e.API.AuthenticatedSupport || e.API.AuthenticatedWebsocketSupport) &&
-			e.API.CredentialsValidator != nil {
+			e.API.CredentialsValidator != nil && !e.API.UseCredentialProvider {
 			var failed bool
 			if e.API.CredentialsValidator.RequiresKey &&
 				(e.API.Credentials.Key == "" || e.API.Credentials.Key == DefaultAPIKey) {
@@ -943,6 +1089,7 @@ func (c *Config) CheckExchangeConfigValues() error {
 				log.Warnf(log.ConfigMgr, warningExchangeAuthAPIDefaultOrEmptyValues, e.Name)
 			}
 		}
+		e.checkAPIAccounts()
 		if !e.Features.Supports.RESTCapabilities.AutoPairUpdates &&
 			!e.Features.Supports.WebsocketCapabilities.AutoPairUpdates {
 			lastUpdated := time.Unix(e.CurrencyPairs.LastUpdated, 0)
@@ -1197,6 +1344,14 @@ func (c *Config) checkGCTScriptConfig() error {
 		c.GCTScript.MaxVirtualMachines = gctscript.DefaultMaxVirtualMachines
 	}
 
+	if c.GCTScript.MaxQueuedEvents == 0 {
+		c.GCTScript.MaxQueuedEvents = gctscript.DefaultMaxQueuedEvents
+	}
+
+	if err := c.GCTScript.ValidatePermissions(); err != nil {
+		return err
+	}
+
 	scriptPath := c.GetDataPath("scripts")
 	err := common.CreateDir(scriptPath)
 	if err != nil {
@@ -1241,6 +1396,12 @@ func (c *Config) checkDatabaseConfig() error {
 		database.DB.DataPath = databaseDir
 	}
 
+	if c.Database.MarketData != nil && c.Database.MarketData.Enabled &&
+		!slices.Contains(database.SupportedMarketDataDrivers, c.Database.MarketData.Driver) {
+		c.Database.MarketData.Enabled = false
+		return fmt.Errorf("unsupported market data driver %v, market data storage disabled", c.Database.MarketData.Driver)
+	}
+
 	return database.DB.SetConfig(&c.Database)
 }
 
@@ -1329,6 +1490,167 @@ func (c *Config) CheckCurrencyStateManager() {
 	}
 }
 
+// CheckFuturesRiskManager ensures the futures risk manager config is valid, or
+// sets default values
+func (c *Config) CheckFuturesRiskManager() {
+	m.Lock()
+	defer m.Unlock()
+	if c.FuturesRiskManager.Delay <= 0 {
+		c.FuturesRiskManager.Delay = defaultFuturesRiskManagerDelay
+	}
+}
+
+// CheckTransferManager ensures the transfer manager config is valid, or sets
+// default values
+func (c *Config) CheckTransferManager() {
+	m.Lock()
+	defer m.Unlock()
+	if c.TransferManager.PollInterval <= 0 {
+		c.TransferManager.PollInterval = defaultTransferManagerPollInterval
+	}
+	if c.TransferManager.Timeout <= 0 {
+		c.TransferManager.Timeout = defaultTransferManagerTimeout
+	}
+}
+
+// CheckDataQualityManager ensures the data quality manager config is valid, or
+// sets default values. Invalid targets are removed
+func (c *Config) CheckDataQualityManager() {
+	m.Lock()
+	defer m.Unlock()
+	dq := &c.DataQualityManager
+	if dq.CheckInterval <= 0 {
+		dq.CheckInterval = defaultDataQualityCheckInterval
+	}
+	if dq.LookbackPeriod <= 0 {
+		dq.LookbackPeriod = defaultDataQualityLookbackPeriod
+	}
+	if dq.SpikeSigma <= 0 {
+		dq.SpikeSigma = defaultDataQualitySpikeSigma
+	}
+	if dq.SpikeWindow <= 1 {
+		dq.SpikeWindow = defaultDataQualitySpikeWindow
+	}
+	if dq.MinZeroVolumeRun <= 0 {
+		dq.MinZeroVolumeRun = defaultDataQualityMinZeroVolumeRun
+	}
+	if dq.MaxDeviationPercentage <= 0 {
+		dq.MaxDeviationPercentage = defaultDataQualityMaxDeviation
+	}
+	dq.Targets = slices.DeleteFunc(dq.Targets, func(t DataQualityTarget) bool {
+		if t.Exchange == "" || t.Pair.IsEmpty() || !t.Asset.IsValid() || t.Interval <= 0 ||
+			(t.DataType != dataQualityCandles && t.DataType != dataQualityTrades) {
+			log.Warnf(log.ConfigMgr, "Data quality target %s %s %s %s %s invalid, removing\n", t.Exchange, t.Asset, t.Pair, t.DataType, t.Interval)
+			return true
+		}
+		return false
+	})
+}
+
+// CheckMetricsExporter sets default values for the metrics exporter config
+func (c *Config) CheckMetricsExporter() {
+	m.Lock()
+	defer m.Unlock()
+	if c.MetricsExporter.ListenAddress == "" {
+		c.MetricsExporter.ListenAddress = defaultMetricsExporterListenAddress
+	}
+	if c.MetricsExporter.Path == "" {
+		c.MetricsExporter.Path = defaultMetricsExporterPath
+	}
+}
+
+// CheckHealthCheck sets default values for the health check config
+func (c *Config) CheckHealthCheck() {
+	m.Lock()
+	defer m.Unlock()
+	if c.HealthCheck.ListenAddress == "" {
+		c.HealthCheck.ListenAddress = defaultHealthCheckListenAddress
+	}
+	if c.HealthCheck.MaxTickerStaleness <= 0 {
+		c.HealthCheck.MaxTickerStaleness = defaultHealthCheckMaxStaleness
+	}
+	if c.HealthCheck.MaxOrderbookStaleness <= 0 {
+		c.HealthCheck.MaxOrderbookStaleness = defaultHealthCheckMaxStaleness
+	}
+}
+
+// CheckTracing ensures the tracing config is valid, or sets default values
+func (c *Config) CheckTracing() {
+	m.Lock()
+	defer m.Unlock()
+	t := &c.Tracing
+	if t.ServiceName == "" {
+		t.ServiceName = tracing.DefaultServiceName
+	}
+	t.Exporter = strings.ToLower(strings.TrimSpace(t.Exporter))
+	switch t.Exporter {
+	case "":
+		t.Exporter = TracingExporterOTLP
+		fallthrough
+	case TracingExporterOTLP:
+		if t.Endpoint == "" {
+			t.Endpoint = defaultTracingOTLPEndpoint
+		}
+	case TracingExporterOTLPGRPC:
+		if t.Endpoint == "" {
+			t.Endpoint = defaultTracingOTLPGRPCEndpoint
+		}
+	case TracingExporterStdout:
+	case TracingExporterFile:
+		if t.FilePath == "" {
+			t.FilePath = defaultTracingFilePath
+		}
+	default:
+		log.Warnf(log.ConfigMgr, "Tracing exporter %q is not supported, disabling tracing\n", t.Exporter)
+		t.Enabled = false
+	}
+	if t.SampleRatio <= 0 || t.SampleRatio > 1 {
+		t.SampleRatio = 1
+	}
+}
+
+// CheckCredentialProvider ensures the credential provider config is valid, or
+// sets default values
+func (c *Config) CheckCredentialProvider() {
+	m.Lock()
+	defer m.Unlock()
+	p := &c.CredentialProvider
+	p.Type = strings.ToLower(strings.TrimSpace(p.Type))
+	switch p.Type {
+	case "":
+		return
+	case CredentialProviderKeystore:
+	case CredentialProviderVault:
+		if p.Vault.Address == "" {
+			log.Warnf(log.ConfigMgr, warningCredentialProviderDisabled, p.Type, errVaultAddressUnset)
+			p.Type = ""
+			return
+		}
+		if p.Vault.Mount == "" {
+			p.Vault.Mount = defaultVaultMount
+		}
+		if p.Vault.PathPrefix == "" {
+			p.Vault.PathPrefix = defaultVaultPathPrefix
+		}
+		if p.Vault.KVVersion != 1 && p.Vault.KVVersion != 2 {
+			p.Vault.KVVersion = defaultVaultKVVersion
+		}
+		if p.Vault.TokenEnvVar == "" {
+			p.Vault.TokenEnvVar = defaultVaultTokenEnvVar
+		}
+		if p.Vault.Timeout <= 0 {
+			p.Vault.Timeout = defaultHTTPTimeout
+		}
+	default:
+		log.Warnf(log.ConfigMgr, warningCredentialProviderDisabled, p.Type, errUnknownCredentialProvider)
+		p.Type = ""
+		return
+	}
+	if p.CacheTTL == 0 {
+		p.CacheTTL = defaultCredentialProviderCacheTTL
+	}
+}
+
 // CheckConnectionMonitorConfig checks and if zero value assigns default values
 func (c *Config) CheckConnectionMonitorConfig() {
 	m.Lock()
@@ -1531,8 +1853,15 @@ func (c *Config) SaveConfigToFile(configPath string) error {
 		writer, err = file.Writer(defaultPath)
 		return writer, err
 	}
-	defer func() { closeWriteCloser(writer) }()
-	return c.Save(provider)
+	err = c.Save(provider)
+	closeWriteCloser(writer)
+	if err != nil {
+		return err
+	}
+	if err := saveHistoryVersion(defaultPath); err != nil {
+		log.Errorf(log.ConfigMgr, "Unable to save config history version: %s", err)
+	}
+	return nil
 }
 
 // Save saves your configuration to the writer as a JSON object with encryption, if configured
@@ -1619,6 +1948,39 @@ func (c *Config) CheckRemoteControlConfig() {
 		log.Warnln(log.ConfigMgr, "gRPC proxy cannot be enabled when gRPC is disabled, disabling gRPC proxy")
 		c.RemoteControl.GRPC.GRPCProxyEnabled = false
 	}
+	c.checkRemoteControlUsers()
+}
+
+// checkRemoteControlUsers removes remote control users which have no
+// username or password, a duplicate username or an invalid role
+func (c *Config) checkRemoteControlUsers() {
+	names := map[string]struct{}{c.RemoteControl.Username: {}}
+	users := c.RemoteControl.Users[:0]
+	for i := range c.RemoteControl.Users {
+		u := &c.RemoteControl.Users[i]
+		u.Username = strings.TrimSpace(u.Username)
+		u.Role = strings.ToLower(strings.TrimSpace(u.Role))
+		var err error
+		switch {
+		case u.Username == "":
+			err = errRemoteControlUsernameEmpty
+		case u.Password == "":
+			err = fmt.Errorf("%s %w", u.Username, errRemoteControlPasswordEmpty)
+		case !slices.Contains([]string{RemoteControlRoleReadOnly, RemoteControlRoleTrader, RemoteControlRoleWithdrawer, RemoteControlRoleAdmin}, u.Role):
+			err = fmt.Errorf("%s %w %q", u.Username, errRemoteControlRoleInvalid, u.Role)
+		default:
+			if _, ok := names[u.Username]; ok {
+				err = fmt.Errorf("%w %q", errRemoteControlUsernameDuplicate, u.Username)
+			}
+		}
+		if err != nil {
+			log.Warnf(log.ConfigMgr, warningRemoteControlUserInvalid, i, err)
+			continue
+		}
+		names[u.Username] = struct{}{}
+		users = append(users, *u)
+	}
+	c.RemoteControl.Users = users
 }
 
 // CheckConfig checks all config settings
@@ -1642,6 +2004,13 @@ func (c *Config) CheckConfig() error {
 	c.CheckConnectionMonitorConfig()
 	c.CheckDataHistoryMonitorConfig()
 	c.CheckCurrencyStateManager()
+	c.CheckFuturesRiskManager()
+	c.CheckTransferManager()
+	c.CheckDataQualityManager()
+	c.CheckMetricsExporter()
+	c.CheckTracing()
+	c.CheckHealthCheck()
+	c.CheckCredentialProvider()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
@@ -1673,6 +2042,111 @@ func (c *Config) LoadConfig(configPath string, dryrun bool) error {
 	return c.CheckConfig()
 }
 
+// Patch applies an RFC 6902 JSON patch to a copy of the config and returns the
+// patched copy, the config itself is not modified. The patched document must
+// decode into the config without unknown fields or mismatched types. The copy
+// keeps the encryption session so it can be saved without prompting for a key
+func (c *Config) Patch(patch []byte) (*Config, error) {
+	p, err := jsonpatch.Decode(patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfigPatch, err)
+	}
+	m.Lock()
+	current, err := json.Marshal(c)
+	m.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	patched, err := p.Apply(current)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfigPatch, err)
+	}
+	newCfg := &Config{
+		EncryptionKeyProvider: c.EncryptionKeyProvider,
+		sessionDK:             c.sessionDK,
+		storedSalt:            c.storedSalt,
+	}
+	d := json.NewDecoder(bytes.NewReader(patched))
+	d.DisallowUnknownFields()
+	if err := d.Decode(newCfg); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfigPatch, err)
+	}
+	return newCfg, nil
+}
+
+// Redacted returns a copy of the config with every credential replaced by
+// RedactedValue, so it can be returned to API clients. Empty credentials are
+// left empty so clients can tell whether one is set
+func (c *Config) Redacted() (*Config, error) {
+	m.Lock()
+	current, err := json.Marshal(c)
+	m.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	r := &Config{}
+	if err := json.Unmarshal(current, r); err != nil {
+		return nil, err
+	}
+
+	redact(&r.RemoteControl.Password)
+	for i := range r.RemoteControl.Users {
+		redact(&r.RemoteControl.Users[i].Password)
+	}
+	redact(&r.Database.Password)
+	if r.Database.MarketData != nil {
+		redact(&r.Database.MarketData.Password)
+	}
+	redactHeaders(r.Tracing.Headers)
+
+	comms := &r.Communications
+	redact(&comms.SlackConfig.VerificationToken)
+	redact(&comms.SMSGlobalConfig.Password)
+	redact(&comms.SMTPConfig.AccountPassword)
+	redact(&comms.TelegramConfig.VerificationToken)
+	redact(&comms.WebhookConfig.Secret)
+	redactHeaders(comms.WebhookConfig.Headers)
+	redact(&comms.DiscordConfig.WebhookURL)
+	redact(&comms.MatrixConfig.AccessToken)
+
+	for i := range r.Exchanges {
+		e := &r.Exchanges[i]
+		e.API.Credentials.redact()
+		for j := range e.API.Accounts {
+			e.API.Accounts[j].Credentials.redact()
+		}
+		for _, v := range []*string{e.APIKey, e.APISecret, e.APIAuthPEMKey, e.ClientID} {
+			if v != nil {
+				redact(v)
+			}
+		}
+	}
+	return r, nil
+}
+
+// redact replaces the credentials with RedactedValue
+func (a *APICredentialsConfig) redact() {
+	for _, v := range []*string{&a.Key, &a.Secret, &a.ClientID, &a.PEMKey, &a.OTPSecret, &a.TradePassword, &a.PIN} {
+		redact(v)
+	}
+}
+
+// redact replaces a non-empty value with RedactedValue
+func redact(v *string) {
+	if *v != "" {
+		*v = RedactedValue
+	}
+}
+
+// redactHeaders replaces the header values, which may hold authentication,
+// with RedactedValue
+func redactHeaders(h map[string]string) {
+	for k, v := range h {
+		redact(&v)
+		h[k] = v
+	}
+}
+
 // UpdateConfig updates the config with a supplied config file
 func (c *Config) UpdateConfig(configPath string, newCfg *Config, dryrun bool) error {
 	err := newCfg.CheckConfig()
@@ -83,6 +83,16 @@ func PromptForConfigKey(confirmKey bool) ([]byte, error) {
 	return nil, fmt.Errorf("%w: %w", errUserInput, io.EOF)
 }
 
+// PromptForSecret asks for a secret value, with echo off if stdin is a
+// terminal
+func PromptForSecret(prompt string) ([]byte, error) {
+	secret, err := getSensitiveInput(prompt)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUserInput, err)
+	}
+	return secret, nil
+}
+
 // getSensitiveInput reads input from stdin, with echo off if stdin is a terminal
 func getSensitiveInput(prompt string) (resp []byte, err error) {
 	fmt.Print(prompt)
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// HistoryDir returns the directory holding the saved versions of the config
+// file at configPath
+func HistoryDir(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), historyDirName)
+}
+
+// GetHistory returns the saved versions of the config file at configPath,
+// oldest first
+func GetHistory(configPath string) ([]HistoryVersion, error) {
+	dir := HistoryDir(configPath)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	versions := make([]HistoryVersion, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		v, ok := parseHistoryFileName(e.Name())
+		if !ok {
+			continue
+		}
+		v.Path = filepath.Join(dir, e.Name())
+		versions = append(versions, v)
+	}
+	slices.SortFunc(versions, func(a, b HistoryVersion) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+	return versions, nil
+}
+
+// GetHistoryVersion returns the saved version of the config file at configPath
+func GetHistoryVersion(configPath string, version uint64) (*HistoryVersion, error) {
+	versions, err := GetHistory(configPath)
+	if err != nil {
+		return nil, err
+	}
+	for i := range versions {
+		if versions[i].Version == version {
+			return &versions[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %d", ErrConfigVersionNotFound, version)
+}
+
+// ReadHistoryVersion reads a saved version of the config file at configPath.
+// Versions encrypted during the current session are decrypted with the
+// session key, older encrypted versions require the encryption key
+func (c *Config) ReadHistoryVersion(configPath string, version uint64) (*Config, error) {
+	v, err := GetHistoryVersion(configPath, version)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(v.Path)
+	if err != nil {
+		return nil, err
+	}
+	newCfg := &Config{EncryptionKeyProvider: c.EncryptionKeyProvider}
+	if d, ok := c.decryptWithSessionKey(data); ok {
+		newCfg.sessionDK, newCfg.storedSalt = c.sessionDK, c.storedSalt
+		data = d
+	}
+	if err := newCfg.readConfig(bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("%w version %d: %w", ErrFailureOpeningConfig, version, err)
+	}
+	return newCfg, nil
+}
+
+// decryptWithSessionKey decrypts data which was encrypted with the session
+// key, it returns false if the data was not
+func (c *Config) decryptWithSessionKey(data []byte) ([]byte, bool) {
+	if len(c.sessionDK) == 0 || len(c.storedSalt) == 0 {
+		return nil, false
+	}
+	header := slices.Concat(encryptionPrefix, c.storedSalt, encryptionVersionPrefix, binary.BigEndian.AppendUint16(nil, encryptionVersion))
+	if !bytes.HasPrefix(data, header) {
+		return nil, false
+	}
+	d, err := decryptAESGCMCiphertext(data[len(header):], c.sessionDK)
+	if err != nil {
+		return nil, false
+	}
+	return d, true
+}
+
+// saveHistoryVersion copies the config file at configPath into its history
+// directory as the next version and removes the oldest versions beyond
+// maxHistoryVersions
+func saveHistoryVersion(configPath string) error {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+	versions, err := GetHistory(configPath)
+	if err != nil {
+		return err
+	}
+	var next uint64 = 1
+	if len(versions) > 0 {
+		next = versions[len(versions)-1].Version + 1
+	}
+	ext := historyFileExt
+	if IsEncrypted(data) {
+		ext = historyEncryptedFileExt
+	}
+	name := fmt.Sprintf("%s%06d-%d%s", historyFilePrefix, next, time.Now().Unix(), ext)
+	w, err := file.Writer(filepath.Join(HistoryDir(configPath), name))
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		closeWriteCloser(w)
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	for i := 0; i < len(versions)+1-maxHistoryVersions; i++ {
+		if err := os.Remove(versions[i].Path); err != nil {
+			log.Errorf(log.ConfigMgr, "Unable to remove config history version %d: %s", versions[i].Version, err)
+		}
+	}
+	return nil
+}
+
+// parseHistoryFileName parses names in the form config-<version>-<unix time>.<ext>
+func parseHistoryFileName(name string) (HistoryVersion, bool) {
+	ext := filepath.Ext(name)
+	if ext != historyFileExt && ext != historyEncryptedFileExt {
+		return HistoryVersion{}, false
+	}
+	var v HistoryVersion
+	var saved int64
+	if n, err := fmt.Sscanf(strings.TrimSuffix(name, ext), historyFilePrefix+"%d-%d", &v.Version, &saved); err != nil || n != 2 {
+		return HistoryVersion{}, false
+	}
+	v.Saved = time.Unix(saved, 0)
+	v.Encrypted = ext == historyEncryptedFileExt
+	return v, true
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveHistoryVersion(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), File)
+	versions, err := GetHistory(path)
+	require.NoError(t, err, "GetHistory must not error")
+	assert.Empty(t, versions, "a missing history directory should return no versions")
+
+	c := &Config{Name: "first", EncryptConfig: fileEncryptionDisabled}
+	require.NoError(t, c.SaveConfigToFile(path), "SaveConfigToFile must not error")
+	c.Name = "second"
+	require.NoError(t, c.SaveConfigToFile(path), "SaveConfigToFile must not error")
+
+	versions, err = GetHistory(path)
+	require.NoError(t, err, "GetHistory must not error")
+	require.Len(t, versions, 2, "each save must add a version")
+	assert.Equal(t, uint64(1), versions[0].Version)
+	assert.Equal(t, uint64(2), versions[1].Version)
+	assert.False(t, versions[1].Encrypted, "an unencrypted config should not be saved encrypted")
+	assert.WithinDuration(t, time.Now(), versions[1].Saved, time.Minute)
+
+	saved, err := os.ReadFile(path)
+	require.NoError(t, err, "ReadFile must not error")
+	latest, err := os.ReadFile(versions[1].Path)
+	require.NoError(t, err, "ReadFile must not error")
+	assert.Equal(t, saved, latest, "the latest version should match the saved config")
+
+	prev, err := c.ReadHistoryVersion(path, 1)
+	require.NoError(t, err, "ReadHistoryVersion must not error")
+	assert.Equal(t, "first", prev.Name)
+
+	_, err = GetHistoryVersion(path, 3)
+	assert.ErrorIs(t, err, ErrConfigVersionNotFound)
+}
+
+func TestSaveHistoryVersionPrunes(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), File)
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0o600), "WriteFile must not error")
+	require.NoError(t, os.MkdirAll(HistoryDir(path), 0o700), "MkdirAll must not error")
+	for i := 1; i <= maxHistoryVersions; i++ {
+		name := filepath.Join(HistoryDir(path), fmt.Sprintf("config-%06d-1%s", i, historyFileExt))
+		require.NoError(t, os.WriteFile(name, []byte("{}"), 0o600), "WriteFile must not error")
+	}
+	require.NoError(t, saveHistoryVersion(path), "saveHistoryVersion must not error")
+
+	versions, err := GetHistory(path)
+	require.NoError(t, err, "GetHistory must not error")
+	require.Len(t, versions, maxHistoryVersions, "history must be pruned to the maximum versions")
+	assert.Equal(t, uint64(2), versions[0].Version, "the oldest version should be removed")
+	assert.Equal(t, uint64(maxHistoryVersions+1), versions[len(versions)-1].Version)
+}
+
+func TestReadHistoryVersionEncrypted(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), EncryptedFile)
+	c := &Config{
+		Name:                  "encrypted",
+		EncryptConfig:         fileEncryptionEnabled,
+		EncryptionKeyProvider: func(bool) ([]byte, error) { return []byte("key"), nil },
+	}
+	require.NoError(t, c.SaveConfigToFile(path), "SaveConfigToFile must not error")
+
+	versions, err := GetHistory(path)
+	require.NoError(t, err, "GetHistory must not error")
+	require.Len(t, versions, 1, "GetHistory must return the saved version")
+	assert.True(t, versions[0].Encrypted, "an encrypted config should be saved encrypted")
+	data, err := os.ReadFile(versions[0].Path)
+	require.NoError(t, err, "ReadFile must not error")
+	assert.True(t, IsEncrypted(data), "the version should be encrypted")
+
+	errNoKey := errors.New("no key")
+	c.EncryptionKeyProvider = func(bool) ([]byte, error) { return nil, errNoKey }
+	v, err := c.ReadHistoryVersion(path, 1)
+	require.NoError(t, err, "ReadHistoryVersion must decrypt with the session key")
+	assert.Equal(t, "encrypted", v.Name)
+	assert.Equal(t, c.sessionDK, v.sessionDK, "the session key should be kept")
+
+	_, err = (&Config{EncryptionKeyProvider: c.EncryptionKeyProvider}).ReadHistoryVersion(path, 1)
+	assert.ErrorIs(t, err, errDecryptFailed, "a version from another session should require the key")
+}
+
+func TestParseHistoryFileName(t *testing.T) {
+	t.Parallel()
+	v, ok := parseHistoryFileName("config-000012-1700000000.dat")
+	require.True(t, ok, "parseHistoryFileName must parse a valid name")
+	assert.Equal(t, HistoryVersion{Version: 12, Saved: time.Unix(1700000000, 0), Encrypted: true}, v)
+	for _, name := range []string{"config-000012-1700000000.txt", "config-a-1.json", "config-1.json", "backup-1-1.json"} {
+		_, ok = parseHistoryFileName(name)
+		assert.Falsef(t, ok, "parseHistoryFileName should not parse %q", name)
+	}
+}
@@ -0,0 +1,138 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/jsonschema"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// durationPattern matches time.ParseDuration durations and the raw interval
+const durationPattern = `^(raw|([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+)$`
+
+var configSchema = sync.OnceValues(func() (*jsonschema.Schema, error) {
+	s, err := NewSchemaReflector().Reflect(&Config{})
+	if err != nil {
+		return nil, err
+	}
+	s.Title = "GoCryptoTrader config"
+	return s, nil
+})
+
+// Schema returns the JSON Schema of the config file
+func Schema() (*jsonschema.Schema, error) {
+	return configSchema()
+}
+
+// Validate checks config file JSON against the config schema and returns every
+// mismatch found. Encrypted config data must be decrypted first
+func Validate(data []byte) (jsonschema.ValidationErrors, error) {
+	s, err := Schema()
+	if err != nil {
+		return nil, err
+	}
+	return s.Validate(data)
+}
+
+// NewSchemaReflector returns a JSON Schema reflector which describes the JSON
+// encodings of the currency, asset, kline interval and order types used by
+// config files
+func NewSchemaReflector() *jsonschema.Reflector {
+	return &jsonschema.Reflector{
+		Overrides: map[reflect.Type]*jsonschema.Schema{
+			reflect.TypeFor[asset.Item]():          assetSchema(),
+			reflect.TypeFor[kline.Interval]():      intervalSchema(),
+			reflect.TypeFor[order.Side]():          orderSideSchema(),
+			reflect.TypeFor[order.TimeInForce]():   {Type: jsonschema.TypeList{jsonschema.TypeString}},
+			reflect.TypeFor[currency.Code]():       {Type: jsonschema.TypeList{jsonschema.TypeString}},
+			reflect.TypeFor[currency.Currencies](): {Type: jsonschema.TypeList{jsonschema.TypeString}},
+			reflect.TypeFor[currency.Role]():       {Type: jsonschema.TypeList{jsonschema.TypeString}},
+			reflect.TypeFor[currency.Pair](): {
+				Type:        jsonschema.TypeList{jsonschema.TypeString},
+				Description: "currency pair, e.g. BTC-USD",
+			},
+			reflect.TypeFor[currency.Pairs](): {
+				Type:        jsonschema.TypeList{jsonschema.TypeString},
+				Description: "comma separated currency pairs",
+			},
+			reflect.TypeFor[decimal.Decimal](): {
+				Type:        jsonschema.TypeList{jsonschema.TypeString, jsonschema.TypeNumber},
+				Description: "decimal number",
+			},
+			reflect.TypeFor[time.Time](): {
+				Type:   jsonschema.TypeList{jsonschema.TypeString},
+				Format: jsonschema.FormatDateTime,
+			},
+		},
+		DefaultEncoded: map[reflect.Type]bool{
+			reflect.TypeFor[currency.PairsManager](): true,
+			reflect.TypeFor[currency.FullStore]():    true,
+		},
+	}
+}
+
+func assetSchema() *jsonschema.Schema {
+	supported := asset.Supported()
+	enum := make([]any, len(supported), len(supported)+1)
+	for i := range supported {
+		enum[i] = supported[i].String()
+	}
+	enum = append(enum, asset.All.String())
+	return &jsonschema.Schema{
+		Type:        jsonschema.TypeList{jsonschema.TypeString},
+		Enum:        enum,
+		Description: "asset type",
+	}
+}
+
+// intervalSchema allows kline intervals as durations, e.g. 1h, or as
+// nanoseconds
+func intervalSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Description: fmt.Sprintf("interval as a duration or nanoseconds, e.g. %q or %d", kline.OneHour.Short(), int64(kline.OneHour)),
+		AnyOf: []*jsonschema.Schema{
+			{Type: jsonschema.TypeList{jsonschema.TypeString}, Pattern: durationPattern},
+			{Type: jsonschema.TypeList{jsonschema.TypeInteger}},
+		},
+	}
+}
+
+// SupportedIntervalSchema returns a schema which only allows the supported
+// kline intervals, as durations or nanoseconds
+func SupportedIntervalSchema() *jsonschema.Schema {
+	var names, nanos []any
+	for _, i := range kline.SupportedIntervals {
+		if !slices.Contains(nanos, any(int64(i))) {
+			names = append(names, i.Short())
+			nanos = append(nanos, int64(i))
+		}
+	}
+	return &jsonschema.Schema{
+		Description: fmt.Sprintf("kline interval as a duration or nanoseconds, e.g. %q or %d", kline.OneHour.Short(), int64(kline.OneHour)),
+		AnyOf: []*jsonschema.Schema{
+			{Type: jsonschema.TypeList{jsonschema.TypeString}, Enum: names},
+			{Type: jsonschema.TypeList{jsonschema.TypeInteger}, Enum: nanos},
+		},
+	}
+}
+
+func orderSideSchema() *jsonschema.Schema {
+	sides := []order.Side{order.Buy, order.Sell, order.Bid, order.Ask, order.Long, order.Short, order.AnySide}
+	enum := make([]any, len(sides))
+	for i := range sides {
+		enum[i] = sides[i].String()
+	}
+	return &jsonschema.Schema{
+		Type:        jsonschema.TypeList{jsonschema.TypeString},
+		Enum:        enum,
+		Description: "order side",
+	}
+}
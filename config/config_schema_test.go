@@ -0,0 +1,51 @@
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+	s, err := Schema()
+	require.NoError(t, err, "Schema must not error")
+	require.Contains(t, s.Defs, "config.Exchange", "Exchange must be defined")
+	assert.Contains(t, s.Defs["config.Exchange"].Properties, "currencyPairs")
+	assert.Contains(t, assetSchema().Enum, asset.Spot.String(), "asset enum should contain the supported assets")
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	data, err := os.ReadFile(TestFile)
+	require.NoError(t, err, "ReadFile must not error")
+	errs, err := Validate(data)
+	require.NoError(t, err, "Validate must not error")
+	assert.Empty(t, errs, "the test config should be valid")
+
+	errs, err = Validate([]byte(`{"name":1,"exchanges":[{"name":"Bitstamp","unknown":true,"features":{"subscriptions":[{"asset":"moon","interval":"1 hour"}]}}]}`))
+	require.NoError(t, err, "Validate must not error")
+	paths := make([]string, len(errs))
+	for i := range errs {
+		paths[i] = errs[i].Path
+	}
+	assert.Equal(t, []string{
+		"/exchanges/0/features/subscriptions/0/asset",
+		"/exchanges/0/features/subscriptions/0/interval",
+		"/exchanges/0/unknown",
+		"/name",
+	}, paths)
+}
+
+func TestSupportedIntervalSchema(t *testing.T) {
+	t.Parallel()
+	s := SupportedIntervalSchema()
+	require.Len(t, s.AnyOf, 2, "SupportedIntervalSchema must allow names and nanoseconds")
+	assert.Contains(t, s.AnyOf[0].Enum, "1h")
+	errs, err := s.Validate([]byte(`"10ms"`))
+	require.NoError(t, err, "Validate must not error")
+	assert.NotEmpty(t, errs, "unsupported intervals should not be valid")
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -502,6 +504,43 @@ func TestCheckCommunicationsConfig(t *testing.T) {
 	if cfg.Communications.TelegramConfig.Enabled {
 		t.Error("CheckCommunicationsConfig TelegramConfig is enabled when it shouldn't be.")
 	}
+
+	cfg.Communications.WebhookConfig.Enabled = true
+	cfg.Communications.DiscordConfig.Enabled = true
+	cfg.Communications.MatrixConfig.Enabled = true
+	cfg.CheckCommunicationsConfig()
+	assert.False(t, cfg.Communications.WebhookConfig.Enabled, "Webhook should be disabled with the example URL")
+	assert.False(t, cfg.Communications.DiscordConfig.Enabled, "Discord should be disabled with the example webhook URL")
+	assert.False(t, cfg.Communications.MatrixConfig.Enabled, "Matrix should be disabled without an access token")
+}
+
+func TestCheckNotificationConfig(t *testing.T) {
+	t.Parallel()
+	cfg := &Config{}
+	cfg.Communications.Notifications = base.NotificationConfig{
+		Routes: []base.NotificationRoute{
+			{Categories: []string{"fill"}, Relayers: []string{"Slack"}},
+			{Relayers: []string{"Pager"}},
+			{MinSeverity: "loud", Relayers: []string{"Telegram"}},
+		},
+		Limits: map[string]base.NotificationLimit{
+			"Telegram": {MaxPerMinute: 10},
+			"Slack":    {MaxPerMinute: -1},
+			"Pager":    {MaxPerMinute: 1},
+		},
+		Templates: map[string]string{
+			"fill":  "{{.Exchange}} filled",
+			"order": "{{.Exchange",
+		},
+	}
+	cfg.CheckCommunicationsConfig()
+	n := cfg.Communications.Notifications
+	require.Len(t, n.Routes, 1, "invalid routes must be removed")
+	assert.Equal(t, []string{"Slack"}, n.Routes[0].Relayers)
+	assert.Len(t, n.Limits, 1, "invalid limits should be removed")
+	assert.Contains(t, n.Limits, "Telegram")
+	assert.Len(t, n.Templates, 1, "invalid templates should be removed")
+	assert.Contains(t, n.Templates, "fill")
 }
 
 func TestGetExchangeAssetTypes(t *testing.T) {
@@ -1495,6 +1534,56 @@ func TestCheckExchangeConfigValues(t *testing.T) {
 	assert.ErrorIs(t, err, errNoEnabledExchanges, "Exchanges without any pairs should be disabled")
 }
 
+func TestCheckAPIAccounts(t *testing.T) {
+	t.Parallel()
+	e := &Exchange{
+		Name: "test",
+		API: APIConfig{
+			CredentialsValidator: &APICredentialsValidatorConfig{RequiresKey: true, RequiresSecret: true},
+			Accounts: []APIAccountConfig{
+				{Name: " arbitrage ", Credentials: APICredentialsConfig{Key: "k1", Secret: "s1"}},
+				{Name: "", Credentials: APICredentialsConfig{Key: "k2", Secret: "s2"}},
+				{Name: "ARBITRAGE", Credentials: APICredentialsConfig{Key: "k3", Secret: "s3"}},
+				{Name: "market-making", Credentials: APICredentialsConfig{Key: DefaultAPIKey, Secret: "s4"}},
+				{Name: "hedging", Credentials: APICredentialsConfig{Key: "k5", Secret: "s5"}},
+			},
+		},
+	}
+	e.checkAPIAccounts()
+	require.Len(t, e.API.Accounts, 2, "invalid accounts must be removed")
+	assert.Equal(t, "arbitrage", e.API.Accounts[0].Name, "account names should be trimmed")
+	assert.Equal(t, "hedging", e.API.Accounts[1].Name)
+
+	e.API.CredentialsValidator = nil
+	e.API.Accounts = []APIAccountConfig{{Name: "empty"}}
+	e.checkAPIAccounts()
+	assert.Empty(t, e.API.Accounts, "accounts without credentials should be removed")
+
+	e.API.UseCredentialProvider = true
+	e.API.Accounts = []APIAccountConfig{{Name: "provided"}}
+	e.checkAPIAccounts()
+	assert.Len(t, e.API.Accounts, 1, "accounts without credentials should be kept when using the credential provider")
+}
+
+func TestGetAPIAccountCredentials(t *testing.T) {
+	t.Parallel()
+	e := &Exchange{
+		Name: "test",
+		API: APIConfig{
+			Credentials: APICredentialsConfig{Key: "default"},
+			Accounts:    []APIAccountConfig{{Name: "arbitrage", Credentials: APICredentialsConfig{Key: "arb", PIN: "1337"}}},
+		},
+	}
+	creds, err := e.GetAPIAccountCredentials("")
+	require.NoError(t, err)
+	assert.Equal(t, "default", creds.Key, "empty account name should return the default credentials")
+	creds, err = e.GetAPIAccountCredentials("Arbitrage")
+	require.NoError(t, err)
+	assert.Equal(t, "1337", creds.PIN)
+	_, err = e.GetAPIAccountCredentials("hedging")
+	assert.ErrorIs(t, err, errAPIAccountNotFound)
+}
+
 func TestReadConfigFromFile(t *testing.T) {
 	cfg := &Config{}
 	err := cfg.ReadConfigFromFile(TestFile, true)
@@ -1818,6 +1907,142 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	assert.Equal(t, connchecker.DefaultDomainList, c.ConnectionMonitor.PublicDomainList)
 }
 
+func TestCheckFuturesRiskManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckFuturesRiskManager()
+	assert.Equal(t, defaultFuturesRiskManagerDelay, c.FuturesRiskManager.Delay)
+
+	c.FuturesRiskManager.Delay = time.Second
+	c.CheckFuturesRiskManager()
+	assert.Equal(t, time.Second, c.FuturesRiskManager.Delay)
+}
+
+func TestCheckTransferManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckTransferManager()
+	assert.Equal(t, defaultTransferManagerPollInterval, c.TransferManager.PollInterval)
+	assert.Equal(t, defaultTransferManagerTimeout, c.TransferManager.Timeout)
+}
+
+func TestCheckMetricsExporter(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckMetricsExporter()
+	assert.Equal(t, defaultMetricsExporterListenAddress, c.MetricsExporter.ListenAddress)
+	assert.Equal(t, defaultMetricsExporterPath, c.MetricsExporter.Path)
+
+	c.MetricsExporter.Path = "/custom"
+	c.CheckMetricsExporter()
+	assert.Equal(t, "/custom", c.MetricsExporter.Path, "Path should not be overwritten")
+}
+
+func TestCheckHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckHealthCheck()
+	assert.Equal(t, defaultHealthCheckListenAddress, c.HealthCheck.ListenAddress)
+	assert.Equal(t, defaultHealthCheckMaxStaleness, c.HealthCheck.MaxTickerStaleness)
+	assert.Equal(t, defaultHealthCheckMaxStaleness, c.HealthCheck.MaxOrderbookStaleness)
+
+	c.HealthCheck.MaxTickerStaleness = time.Second
+	c.CheckHealthCheck()
+	assert.Equal(t, time.Second, c.HealthCheck.MaxTickerStaleness, "MaxTickerStaleness should not be overwritten")
+}
+
+func TestCheckTracing(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckTracing()
+	assert.Equal(t, TracingExporterOTLP, c.Tracing.Exporter, "Exporter should default to otlp")
+	assert.Equal(t, defaultTracingOTLPEndpoint, c.Tracing.Endpoint)
+	assert.Equal(t, "gocryptotrader", c.Tracing.ServiceName)
+	assert.Equal(t, 1.0, c.Tracing.SampleRatio)
+
+	c.Tracing = Tracing{Enabled: true, Exporter: "FILE", SampleRatio: 0.25}
+	c.CheckTracing()
+	assert.Equal(t, TracingExporterFile, c.Tracing.Exporter)
+	assert.Equal(t, defaultTracingFilePath, c.Tracing.FilePath)
+	assert.Empty(t, c.Tracing.Endpoint, "Endpoint should not be defaulted for the file exporter")
+	assert.Equal(t, 0.25, c.Tracing.SampleRatio)
+	assert.True(t, c.Tracing.Enabled)
+
+	c.Tracing = Tracing{Enabled: true, Exporter: "otlpgrpc"}
+	c.CheckTracing()
+	assert.Equal(t, defaultTracingOTLPGRPCEndpoint, c.Tracing.Endpoint, "Endpoint should default to the OTLP/gRPC port")
+
+	c.Tracing = Tracing{Enabled: true, Exporter: "stdout"}
+	c.CheckTracing()
+	assert.True(t, c.Tracing.Enabled, "stdout exporter should be supported")
+	assert.Empty(t, c.Tracing.Endpoint, "Endpoint should not be defaulted for the stdout exporter")
+
+	c.Tracing.Exporter = "zipkin"
+	c.CheckTracing()
+	assert.False(t, c.Tracing.Enabled, "unsupported exporters should disable tracing")
+}
+
+func TestCheckCredentialProvider(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckCredentialProvider()
+	assert.Empty(t, c.CredentialProvider.Type)
+	assert.Zero(t, c.CredentialProvider.CacheTTL, "CacheTTL should not be defaulted when disabled")
+
+	c.CredentialProvider.Type = "bad"
+	c.CheckCredentialProvider()
+	assert.Empty(t, c.CredentialProvider.Type, "unknown providers should be disabled")
+
+	c.CredentialProvider.Type = " Vault "
+	c.CheckCredentialProvider()
+	assert.Empty(t, c.CredentialProvider.Type, "vault should be disabled without an address")
+
+	c.CredentialProvider.Type = CredentialProviderVault
+	c.CredentialProvider.Vault.Address = "http://127.0.0.1:8200"
+	c.CredentialProvider.Vault.KVVersion = 3
+	c.CheckCredentialProvider()
+	assert.Equal(t, CredentialProviderVault, c.CredentialProvider.Type)
+	assert.Equal(t, defaultCredentialProviderCacheTTL, c.CredentialProvider.CacheTTL)
+	assert.Equal(t, defaultVaultMount, c.CredentialProvider.Vault.Mount)
+	assert.Equal(t, defaultVaultPathPrefix, c.CredentialProvider.Vault.PathPrefix)
+	assert.Equal(t, defaultVaultKVVersion, c.CredentialProvider.Vault.KVVersion)
+	assert.Equal(t, defaultVaultTokenEnvVar, c.CredentialProvider.Vault.TokenEnvVar)
+	assert.Equal(t, defaultHTTPTimeout, c.CredentialProvider.Vault.Timeout)
+
+	c.CredentialProvider.Type = CredentialProviderKeystore
+	c.CredentialProvider.CacheTTL = -1
+	c.CheckCredentialProvider()
+	assert.Equal(t, CredentialProviderKeystore, c.CredentialProvider.Type)
+	assert.Equal(t, time.Duration(-1), c.CredentialProvider.CacheTTL, "a negative CacheTTL should be retained")
+}
+
+func TestCheckDataQualityManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.DataQualityManager.Targets = []DataQualityTarget{
+		{Exchange: "Binance", Asset: asset.Spot, Pair: currency.NewBTCUSDT(), DataType: dataQualityCandles, Interval: kline.OneHour},
+		{Exchange: "Binance", Asset: asset.Spot, Pair: currency.NewBTCUSDT(), DataType: "orderbooks", Interval: kline.OneHour},
+		{Exchange: "Binance", Asset: asset.Spot, Pair: currency.NewBTCUSDT(), DataType: dataQualityTrades},
+		{Asset: asset.Spot, Pair: currency.NewBTCUSDT(), DataType: dataQualityTrades, Interval: kline.OneMin},
+	}
+	c.CheckDataQualityManager()
+	assert.Equal(t, defaultDataQualityCheckInterval, c.DataQualityManager.CheckInterval)
+	assert.Equal(t, defaultDataQualityLookbackPeriod, c.DataQualityManager.LookbackPeriod)
+	assert.Equal(t, float64(defaultDataQualitySpikeSigma), c.DataQualityManager.SpikeSigma)
+	assert.Equal(t, int64(defaultDataQualitySpikeWindow), c.DataQualityManager.SpikeWindow)
+	assert.Equal(t, int64(defaultDataQualityMinZeroVolumeRun), c.DataQualityManager.MinZeroVolumeRun)
+	assert.Equal(t, float64(defaultDataQualityMaxDeviation), c.DataQualityManager.MaxDeviationPercentage)
+	require.Len(t, c.DataQualityManager.Targets, 1, "CheckDataQualityManager must remove invalid targets")
+	assert.Equal(t, dataQualityCandles, c.DataQualityManager.Targets[0].DataType)
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
@@ -1877,6 +2102,21 @@ func TestCheckRemoteControlConfig(t *testing.T) {
 	c.CheckRemoteControlConfig()
 	assert.True(t, c.RemoteControl.GRPC.Enabled, "gRPC should be true")
 	assert.True(t, c.RemoteControl.GRPC.GRPCProxyEnabled, "gRPCProxyEnabled should be true when gRPC is enabled")
+
+	c.RemoteControl.Users = []RemoteControlUser{
+		{Username: " dashboard ", Password: "p", Role: " ReadOnly "},
+		{Username: "", Password: "p", Role: RemoteControlRoleTrader},
+		{Username: "nopass", Role: RemoteControlRoleTrader},
+		{Username: "badrole", Password: "p", Role: "superuser"},
+		{Username: "admin", Password: "p", Role: RemoteControlRoleTrader},
+		{Username: "dashboard", Password: "p", Role: RemoteControlRoleTrader},
+		{Username: "trader", Password: "p", Role: RemoteControlRoleTrader, Exchanges: []string{"Binance"}},
+	}
+	c.CheckRemoteControlConfig()
+	require.Len(t, c.RemoteControl.Users, 2, "invalid users must be removed")
+	assert.Equal(t, "dashboard", c.RemoteControl.Users[0].Username, "Username should be trimmed")
+	assert.Equal(t, RemoteControlRoleReadOnly, c.RemoteControl.Users[0].Role, "Role should be normalised")
+	assert.Equal(t, "trader", c.RemoteControl.Users[1].Username)
 }
 
 func TestCheckConfig(t *testing.T) {
@@ -2020,6 +2260,11 @@ func TestCheckGCTScriptConfig(t *testing.T) {
 	if c.GCTScript.MaxVirtualMachines != gctscript.DefaultMaxVirtualMachines {
 		t.Fatal("unexpected value return")
 	}
+
+	assert.Equal(t, gctscript.DefaultMaxQueuedEvents, c.GCTScript.MaxQueuedEvents, "MaxQueuedEvents should default")
+
+	c.GCTScript.ScriptPermissions = map[string]gct.Permissions{"bad": {Capabilities: []gct.Capability{"teleport"}}}
+	assert.ErrorContains(t, c.checkGCTScriptConfig(), "teleport", "checkGCTScriptConfig should error on an unknown capability")
 }
 
 func TestCheckDatabaseConfig(t *testing.T) {
@@ -2047,6 +2292,19 @@ func TestCheckDatabaseConfig(t *testing.T) {
 	if err := c.checkDatabaseConfig(); err != nil {
 		t.Error(err)
 	}
+
+	c.Database.MarketData = &database.MarketDataConfig{Enabled: true, Driver: "influxisthebest"}
+	if err := c.checkDatabaseConfig(); err == nil {
+		t.Error("unexpected result")
+	}
+	if c.Database.MarketData.Enabled {
+		t.Error("unsupported market data driver should be disabled")
+	}
+
+	c.Database.MarketData = &database.MarketDataConfig{Enabled: true, Driver: database.DBTimescaleDB}
+	if err := c.checkDatabaseConfig(); err != nil {
+		t.Error(err)
+	}
 }
 
 func TestCheckNTPConfig(t *testing.T) {
@@ -2335,3 +2593,77 @@ func TestCheckSyncManagerConfig(t *testing.T) {
 		t.Errorf("received %v expected %v", c.SyncManagerConfig.NumWorkers, DefaultSyncerWorkers)
 	}
 }
+
+func TestPatch(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	require.NoError(t, c.LoadConfig(TestFile, true), "LoadConfig must not error")
+	c.sessionDK, c.storedSalt = []byte("dk"), []byte("salt")
+
+	newCfg, err := c.Patch([]byte(`[{"op":"replace","path":"/name","value":"patched"},{"op":"add","path":"/orderManager/verbose","value":true}]`))
+	require.NoError(t, err, "Patch must not error")
+	assert.Equal(t, "patched", newCfg.Name)
+	assert.True(t, newCfg.OrderManager.Verbose, "the patched value should be set")
+	assert.NotEqual(t, "patched", c.Name, "the config should not be modified")
+	assert.Equal(t, len(c.Exchanges), len(newCfg.Exchanges), "unpatched sections should be kept")
+	assert.Equal(t, c.sessionDK, newCfg.sessionDK, "the encryption session should be kept")
+
+	_, err = c.Patch([]byte(`[{"op":"add","path":"/unknownField","value":1}]`))
+	assert.ErrorIs(t, err, ErrInvalidConfigPatch, "unknown fields should be rejected")
+	_, err = c.Patch([]byte(`[{"op":"replace","path":"/name","value":1}]`))
+	assert.ErrorIs(t, err, ErrInvalidConfigPatch, "mismatched types should be rejected")
+	_, err = c.Patch([]byte(`[{"op":"remove","path":"/missing"}]`))
+	assert.ErrorIs(t, err, ErrInvalidConfigPatch, "failed operations should be rejected")
+	_, err = c.Patch([]byte(`{}`))
+	assert.ErrorIs(t, err, ErrInvalidConfigPatch, "invalid patches should be rejected")
+}
+
+func TestRedacted(t *testing.T) {
+	t.Parallel()
+	key := "legacyKey"
+	c := &Config{
+		Name: "test",
+		RemoteControl: RemoteControlConfig{
+			Username: "admin",
+			Password: "grpcPassword",
+			Users:    []RemoteControlUser{{Username: "viewer", Password: "userPassword"}},
+		},
+		Tracing: Tracing{Headers: map[string]string{"Authorization": "Bearer tracingToken"}},
+		Exchanges: []Exchange{{
+			Name:   "Binance",
+			APIKey: &key,
+			API: APIConfig{
+				Credentials: APICredentialsConfig{Key: "apiKey", Secret: "apiSecret", Subaccount: "main", OTPSecret: "otp", PIN: "1234", TradePassword: "tradePassword"},
+				Accounts:    []APIAccountConfig{{Name: "hedge", Credentials: APICredentialsConfig{Key: "hedgeKey", Secret: "hedgeSecret"}}},
+			},
+		}},
+	}
+	c.Database.Password = "dbPassword"
+	c.Communications.TelegramConfig.VerificationToken = "telegramToken"
+	c.Communications.WebhookConfig.Secret = "webhookSecret"
+	c.Communications.WebhookConfig.Headers = map[string]string{"X-Token": "webhookHeader"}
+	c.Communications.DiscordConfig.WebhookURL = "https://discord.com/api/webhooks/1"
+
+	r, err := c.Redacted()
+	require.NoError(t, err, "Redacted must not error")
+	assert.Equal(t, "test", r.Name)
+	assert.Equal(t, "admin", r.RemoteControl.Username, "usernames should not be redacted")
+	assert.Equal(t, RedactedValue, r.RemoteControl.Password)
+	assert.Equal(t, RedactedValue, r.RemoteControl.Users[0].Password)
+	assert.Equal(t, RedactedValue, r.Tracing.Headers["Authorization"])
+	assert.Equal(t, RedactedValue, r.Database.Password)
+	assert.Equal(t, RedactedValue, r.Communications.TelegramConfig.VerificationToken)
+	assert.Equal(t, RedactedValue, r.Communications.WebhookConfig.Secret)
+	assert.Equal(t, RedactedValue, r.Communications.WebhookConfig.Headers["X-Token"])
+	assert.Equal(t, RedactedValue, r.Communications.DiscordConfig.WebhookURL)
+	assert.Empty(t, r.Communications.MatrixConfig.AccessToken, "empty credentials should not be redacted")
+	creds := r.Exchanges[0].API.Credentials
+	assert.Equal(t, APICredentialsConfig{Key: RedactedValue, Secret: RedactedValue, Subaccount: "main", OTPSecret: RedactedValue, PIN: RedactedValue, TradePassword: RedactedValue}, creds)
+	assert.Equal(t, RedactedValue, r.Exchanges[0].API.Accounts[0].Credentials.Secret)
+	assert.Equal(t, RedactedValue, *r.Exchanges[0].APIKey)
+
+	assert.Equal(t, "grpcPassword", c.RemoteControl.Password, "the config should not be modified")
+	assert.Equal(t, "apiSecret", c.Exchanges[0].API.Credentials.Secret, "the config should not be modified")
+	assert.Equal(t, "legacyKey", key, "the config should not be modified")
+	assert.Equal(t, "Bearer tracingToken", c.Tracing.Headers["Authorization"], "the config should not be modified")
+}
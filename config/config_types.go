@@ -8,6 +8,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
@@ -38,7 +40,35 @@ const (
 	DefaultAPIClientID                   = "ClientID"
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
+	defaultFuturesRiskManagerDelay       = time.Minute
+	defaultTransferManagerPollInterval   = time.Minute
+	defaultTransferManagerTimeout        = time.Hour * 24
+	defaultDataQualityCheckInterval      = time.Hour
+	defaultDataQualityLookbackPeriod     = time.Hour * 24
+	defaultDataQualitySpikeSigma         = 6
+	defaultDataQualitySpikeWindow        = 30
+	defaultDataQualityMinZeroVolumeRun   = 5
+	defaultDataQualityMaxDeviation       = 1
+	dataQualityCandles                   = "candles"
+	dataQualityTrades                    = "trades"
+	defaultCredentialProviderCacheTTL    = time.Minute
+	defaultMetricsExporterListenAddress  = "localhost:9464"
+	defaultMetricsExporterPath           = "/metrics"
+	defaultTracingOTLPEndpoint           = "http://localhost:4318/v1/traces"
+	defaultTracingOTLPGRPCEndpoint       = "http://localhost:4317"
+	defaultTracingFilePath               = "traces.jsonl"
+	defaultHealthCheckListenAddress      = "localhost:9055"
+	defaultHealthCheckMaxStaleness       = 5 * time.Minute
+	defaultVaultMount                    = "secret"
+	defaultVaultPathPrefix               = "gocryptotrader"
+	defaultVaultTokenEnvVar              = "VAULT_TOKEN"
+	defaultVaultKVVersion                = 2
 	defaultMaxJobsPerCycle               = 5
+	historyDirName                       = "config_history"
+	historyFilePrefix                    = "config-"
+	historyFileExt                       = ".json"
+	historyEncryptedFileExt              = ".dat"
+	maxHistoryVersions                   = 100
 	// DefaultSyncerWorkers limits the number of sync workers
 	DefaultSyncerWorkers = 15
 	// DefaultSyncerTimeoutREST the default time to switch from REST to websocket protocols without a response
@@ -58,8 +88,14 @@ const (
 
 // Constants here hold some messages
 const (
+	warningCredentialProviderDisabled          = "credential provider %q disabled: %v"
 	warningExchangeAuthAPIDefaultOrEmptyValues = "exchange %s authenticated API support disabled due to default/empty APIKey/Secret/ClientID values"
 	warningPairsLastUpdatedThresholdExceeded   = "exchange %s last manual update of available currency pairs has exceeded %d days. Manual update required!"
+	warningExchangeAPIAccountInvalid           = "exchange %s API account #%d removed: %s"
+	warningRemoteControlUserInvalid            = "remote control user #%d removed: %s"
+	warningNotificationRouteInvalid            = "notification route #%d removed: %s"
+	warningNotificationLimitInvalid            = "notification limit for relayer %q removed: invalid relayer or values"
+	warningNotificationTemplateInvalid         = "notification template %q removed: %s"
 )
 
 // Constants here define unset default values displayed in the config.json
@@ -72,12 +108,16 @@ const (
 	DefaultUnsetAccountPlan       = "accountPlan"
 	DefaultGRPCUsername           = "admin"
 	DefaultGRPCPassword           = "Password"
+	// RedactedValue replaces credentials in a config returned by Redacted
+	RedactedValue = "[redacted]"
 )
 
 // Public errors exported by this package
 var (
-	ErrExchangeNotFound     = errors.New("exchange not found")
-	ErrFailureOpeningConfig = errors.New("fatal error opening file")
+	ErrExchangeNotFound      = errors.New("exchange not found")
+	ErrFailureOpeningConfig  = errors.New("fatal error opening file")
+	ErrConfigVersionNotFound = errors.New("config version not found")
+	ErrInvalidConfigPatch    = errors.New("invalid config patch")
 )
 
 var (
@@ -86,6 +126,18 @@ var (
 
 	errNoEnabledExchanges   = errors.New("no exchanges enabled")
 	errCheckingConfigValues = errors.New("fatal error checking config values")
+
+	errAPIAccountNameEmpty     = errors.New("API account name cannot be empty")
+	errAPIAccountNameDuplicate = errors.New("duplicate API account name")
+	errAPIAccountNotFound      = errors.New("API account not found")
+
+	errUnknownCredentialProvider = errors.New("unknown credential provider type")
+	errVaultAddressUnset         = errors.New("vault address unset")
+
+	errRemoteControlUsernameEmpty     = errors.New("username cannot be empty")
+	errRemoteControlPasswordEmpty     = errors.New("password cannot be empty")
+	errRemoteControlUsernameDuplicate = errors.New("duplicate username")
+	errRemoteControlRoleInvalid       = errors.New("invalid role")
 )
 
 // Config is the overarching object that holds all the information for
@@ -102,8 +154,15 @@ type Config struct {
 	ConnectionMonitor    ConnectionMonitorConfig   `json:"connectionMonitor"`
 	OrderManager         OrderManager              `json:"orderManager"`
 	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
+	DataQualityManager   DataQualityManager        `json:"dataQualityManager"`
 	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
+	FuturesRiskManager   FuturesRiskManager        `json:"futuresRiskManager"`
+	TransferManager      TransferManager           `json:"transferManager"`
+	CredentialProvider   CredentialProvider        `json:"credentialProvider"`
 	Profiler             Profiler                  `json:"profiler"`
+	MetricsExporter      MetricsExporter           `json:"metricsExporter"`
+	Tracing              Tracing                   `json:"tracing"`
+	HealthCheck          HealthCheck               `json:"healthCheck"`
 	NTPClient            NTPClientConfig           `json:"ntpclient"`
 	GCTScript            gctscript.Config          `json:"gctscript"`
 	Currency             currency.Config           `json:"currencyConfig"`
@@ -124,6 +183,14 @@ type Config struct {
 	EncryptionKeyProvider EncryptionKeyProvider `json:"-"`
 }
 
+// HistoryVersion describes a saved version of the config file
+type HistoryVersion struct {
+	Version   uint64
+	Saved     time.Time
+	Encrypted bool
+	Path      string
+}
+
 // EncryptionKeyProvider is a function config can use to prompt the user for an encryption key
 type EncryptionKeyProvider func(confirmKey bool) ([]byte, error)
 
@@ -135,6 +202,9 @@ type OrderManager struct {
 	FuturesTrackingSeekDuration   time.Duration `json:"futuresTrackingSeekDuration"`
 	RespectOrderHistoryLimits     bool          `json:"respectOrderHistoryLimits"`
 	CancelOrdersOnShutdown        bool          `json:"cancelOrdersOnShutdown"`
+	// TransactionCostPostTradeDelay is how long after an order's last fill
+	// the mid price used to measure realised spread is sampled
+	TransactionCostPostTradeDelay time.Duration `json:"transactionCostPostTradeDelay,omitempty"`
 }
 
 // DataHistoryManager holds all information required for the data history manager
@@ -146,6 +216,46 @@ type DataHistoryManager struct {
 	Verbose             bool          `json:"verbose"`
 }
 
+// DataQualityManager defines a set of configuration options for the data
+// quality manager which scans saved candles and trades for issues
+type DataQualityManager struct {
+	Enabled       bool          `json:"enabled"`
+	Verbose       bool          `json:"verbose"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	// LookbackPeriod is the range of saved data scanned on each check, ending
+	// at the time of the check
+	LookbackPeriod time.Duration `json:"lookbackPeriod"`
+	// SpikeSigma is the number of standard deviations a close to close return
+	// can move from the mean of the previous SpikeWindow returns before it is
+	// flagged as a price spike
+	SpikeSigma  float64 `json:"spikeSigma"`
+	SpikeWindow int64   `json:"spikeWindow"`
+	// MinZeroVolumeRun is the number of consecutive zero volume candles
+	// required before they are flagged
+	MinZeroVolumeRun int64 `json:"minZeroVolumeRun"`
+	// MaxDeviationPercentage is the percentage a close can differ from the same
+	// candle on a comparison exchange before it is flagged
+	MaxDeviationPercentage float64 `json:"maxDeviationPercentage"`
+	// ScheduleRepairJobs upserts data history jobs to refetch gaps and invalid
+	// candles when the data history manager is running
+	ScheduleRepairJobs bool                `json:"scheduleRepairJobs"`
+	Targets            []DataQualityTarget `json:"targets,omitempty"`
+}
+
+// DataQualityTarget is saved market data scanned by the data quality manager
+type DataQualityTarget struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+	// DataType is either candles or trades, trades are scanned as candles of
+	// the target interval
+	DataType string         `json:"dataType"`
+	Interval kline.Interval `json:"interval"`
+	// ComparisonExchanges are exchanges whose saved candles of the same pair,
+	// asset and interval are compared against for deviations
+	ComparisonExchanges []string `json:"comparisonExchanges,omitempty"`
+}
+
 // CurrencyStateManager defines a set of configuration options for the currency
 // state manager
 type CurrencyStateManager struct {
@@ -153,6 +263,95 @@ type CurrencyStateManager struct {
 	Delay   time.Duration `json:"delay"`
 }
 
+// FuturesRiskManager defines a set of configuration options for the futures
+// risk manager. A zero threshold disables its alert.
+type FuturesRiskManager struct {
+	Enabled bool          `json:"enabled"`
+	Verbose bool          `json:"verbose"`
+	Delay   time.Duration `json:"delay"`
+	// MaxMarginRatio alerts when used collateral divided by total collateral
+	// for an exchange account exceeds this ratio e.g. 0.8
+	MaxMarginRatio float64 `json:"maxMarginRatio"`
+	// MinLiquidationDistance alerts when the distance between a position's
+	// mark price and its estimated liquidation price, as a ratio of the mark
+	// price, falls below this value e.g. 0.1
+	MinLiquidationDistance float64 `json:"minLiquidationDistance"`
+	// MaxConcentration alerts when a single underlying accounts for more than
+	// this ratio of gross notional across all exchanges e.g. 0.5
+	MaxConcentration float64 `json:"maxConcentration"`
+	// MaxNetDeltaNotional alerts when the absolute net notional exposure of an
+	// underlying across all exchanges exceeds this value
+	MaxNetDeltaNotional float64 `json:"maxNetDeltaNotional"`
+}
+
+// Credential provider types
+const (
+	CredentialProviderKeystore = "keystore"
+	CredentialProviderVault    = "vault"
+)
+
+// CredentialProvider defines the external store exchange API credentials are
+// fetched from when an exchange has useCredentialProvider set. Credentials
+// fetched from a provider are never written to the config file.
+type CredentialProvider struct {
+	// Type is either keystore or vault, an empty type disables the provider
+	Type string `json:"type"`
+	// CacheTTL is how long fetched credentials are held in memory before
+	// being fetched again, allowing credentials to be rotated at runtime. A
+	// negative value fetches credentials on every request.
+	CacheTTL time.Duration              `json:"cacheTTL"`
+	Keystore KeystoreCredentialProvider `json:"keystore"`
+	Vault    VaultCredentialProvider    `json:"vault"`
+}
+
+// KeystoreCredentialProvider defines the local encrypted keystore settings
+type KeystoreCredentialProvider struct {
+	// Path defaults to keystore.json within the data directory
+	Path string `json:"path"`
+	// PassphraseEnvVar is an optional environment variable holding the
+	// passphrase used to unlock keys on startup, otherwise each key is
+	// prompted for
+	PassphraseEnvVar string `json:"passphraseEnvVar,omitempty"`
+}
+
+// VaultCredentialProvider defines the settings for a HashiCorp Vault
+// compatible KV secrets engine
+type VaultCredentialProvider struct {
+	Address    string `json:"address"`
+	Namespace  string `json:"namespace,omitempty"`
+	Mount      string `json:"mount"`
+	PathPrefix string `json:"pathPrefix"`
+	// KVVersion is the KV secrets engine version, either 1 or 2
+	KVVersion int `json:"kvVersion"`
+	// TokenEnvVar is the environment variable the Vault token is read from,
+	// the token itself is never stored in config
+	TokenEnvVar string        `json:"tokenEnvVar"`
+	Timeout     time.Duration `json:"timeout"`
+}
+
+// TransferManager defines a set of configuration options for the inter-exchange
+// transfer manager
+type TransferManager struct {
+	Enabled      bool          `json:"enabled"`
+	Verbose      bool          `json:"verbose"`
+	PollInterval time.Duration `json:"pollInterval"`
+	// Timeout is the maximum duration a transfer can take from withdrawal
+	// submission to deposit before it is marked as failed
+	Timeout time.Duration `json:"timeout"`
+	// ChainFees are the estimated withdrawal fees used to select the cheapest
+	// transfer chain. Chains without a fee estimate are ranked last.
+	ChainFees []TransferChainFee `json:"chainFees,omitempty"`
+}
+
+// TransferChainFee is an estimated withdrawal fee for a currency on a chain
+// from an exchange
+type TransferChainFee struct {
+	Exchange string        `json:"exchange"`
+	Currency currency.Code `json:"currency"`
+	Chain    string        `json:"chain"`
+	Fee      float64       `json:"fee"`
+}
+
 // SyncManagerConfig stores the currency pair synchronization manager config
 type SyncManagerConfig struct {
 	Enabled                 bool                 `json:"enabled"`
@@ -224,6 +423,49 @@ type Profiler struct {
 	BlockProfileRate     int    `json:"block_profile_rate"`
 }
 
+// MetricsExporter defines the configuration for serving engine and exchange
+// health metrics in the Prometheus text exposition format
+type MetricsExporter struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+	Path          string `json:"path"`
+}
+
+// HealthCheck defines the configuration for the HTTP liveness and readiness
+// endpoints
+type HealthCheck struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+	// MaxTickerStaleness and MaxOrderbookStaleness are the longest time a
+	// synced pair can go without an update before readiness fails
+	MaxTickerStaleness    time.Duration `json:"maxTickerStaleness"`
+	MaxOrderbookStaleness time.Duration `json:"maxOrderbookStaleness"`
+}
+
+// Tracing exporter types
+const (
+	TracingExporterOTLP     = "otlp"
+	TracingExporterOTLPGRPC = "otlpgrpc"
+	TracingExporterStdout   = "stdout"
+	TracingExporterFile     = "file"
+)
+
+// Tracing defines the configuration for recording OpenTelemetry spans across
+// gRPC calls, the order manager and exchange requests
+type Tracing struct {
+	Enabled     bool   `json:"enabled"`
+	ServiceName string `json:"serviceName"`
+	// Exporter is otlp or otlpgrpc, which send spans to an OTLP/HTTP or
+	// OTLP/gRPC endpoint, stdout, which writes them to stdout, or file, which
+	// appends them to a local file as JSON
+	Exporter string            `json:"exporter"`
+	Endpoint string            `json:"endpoint"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	// FilePath is relative to the data directory unless absolute
+	FilePath    string  `json:"filePath"`
+	SampleRatio float64 `json:"sampleRatio"`
+}
+
 // NTPClientConfig defines a network time protocol configuration to allow for
 // positive and negative differences
 type NTPClientConfig struct {
@@ -245,9 +487,32 @@ type GRPCConfig struct {
 
 // RemoteControlConfig stores the RPC services config
 type RemoteControlConfig struct {
-	Username string     `json:"username"`
-	Password string     `json:"password"`
-	GRPC     GRPCConfig `json:"gRPC"`
+	// Username and Password are always granted the admin role
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// Users are additional gRPC users granted access by role
+	Users []RemoteControlUser `json:"users,omitempty"`
+	GRPC  GRPCConfig          `json:"gRPC"`
+}
+
+// Remote control roles, each role is granted the access of those before it
+const (
+	RemoteControlRoleReadOnly   = "readonly"
+	RemoteControlRoleTrader     = "trader"
+	RemoteControlRoleWithdrawer = "withdrawer"
+	RemoteControlRoleAdmin      = "admin"
+)
+
+// RemoteControlUser defines a gRPC user and the access they are granted
+type RemoteControlUser struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	// Role is one of readonly, trader, withdrawer or admin
+	Role string `json:"role"`
+	// Exchanges optionally restricts the user to the listed exchanges
+	Exchanges []string `json:"exchanges,omitempty"`
+	// Pairs optionally restricts the user to the listed currency pairs
+	Pairs []string `json:"pairs,omitempty"`
 }
 
 // Post holds the bot configuration data
@@ -313,6 +578,13 @@ type APICredentialsConfig struct {
 	PIN           string `json:"pin,omitempty"`
 }
 
+// APIAccountConfig stores a named set of API credentials for an exchange,
+// allowing orders, balances and withdrawals to be segregated by account
+type APIAccountConfig struct {
+	Name        string               `json:"name"`
+	Credentials APICredentialsConfig `json:"credentials"`
+}
+
 // APICredentialsValidatorConfig stores the API credentials validator settings
 type APICredentialsValidatorConfig struct {
 	// For Huobi (optional)
@@ -330,10 +602,14 @@ type APIConfig struct {
 	AuthenticatedWebsocketSupport bool `json:"authenticatedWebsocketApiSupport"`
 	PEMKeySupport                 bool `json:"pemKeySupport,omitempty"`
 
-	Credentials          APICredentialsConfig           `json:"credentials"`
-	CredentialsValidator *APICredentialsValidatorConfig `json:"credentialsValidator,omitempty"`
-	OldEndPoints         *APIEndpointsConfig            `json:"endpoints,omitempty"`
-	Endpoints            map[string]string              `json:"urlEndpoints"`
+	Credentials APICredentialsConfig `json:"credentials"`
+	Accounts    []APIAccountConfig   `json:"accounts,omitempty"`
+	// UseCredentialProvider fetches the default and named account credentials
+	// from the configured credential provider instead of this config
+	UseCredentialProvider bool                           `json:"useCredentialProvider,omitempty"`
+	CredentialsValidator  *APICredentialsValidatorConfig `json:"credentialsValidator,omitempty"`
+	OldEndPoints          *APIEndpointsConfig            `json:"endpoints,omitempty"`
+	Endpoints             map[string]string              `json:"urlEndpoints"`
 }
 
 // Orderbook stores the orderbook configuration variables
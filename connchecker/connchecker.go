@@ -74,6 +74,7 @@ type Checker struct {
 	shutdown      chan struct{}
 	wg            sync.WaitGroup
 	connected     bool
+	statusChange  func(connected bool)
 	mu            sync.Mutex
 }
 
@@ -134,36 +135,48 @@ func (c *Checker) initialCheck() error {
 // iterating over a set list of dns ip and popular domains
 func (c *Checker) connectionTest() {
 	for i := range c.DNSList {
-		err := c.CheckDNS(c.DNSList[i])
-		if err == nil {
-			c.mu.Lock()
-			if !c.connected {
-				log.Debugln(log.Global, ConnRe)
-				c.connected = true
-			}
-			c.mu.Unlock()
+		if err := c.CheckDNS(c.DNSList[i]); err == nil {
+			c.setConnected(true)
 			return
 		}
 	}
 
 	for i := range c.DomainList {
-		err := c.CheckHost(c.DomainList[i])
-		if err == nil {
-			c.mu.Lock()
-			if !c.connected {
-				log.Debugln(log.Global, ConnRe)
-				c.connected = true
-			}
-			c.mu.Unlock()
+		if err := c.CheckHost(c.DomainList[i]); err == nil {
+			c.setConnected(true)
 			return
 		}
 	}
 
+	c.setConnected(false)
+}
+
+// setConnected updates the connectivity status, logging and calling the
+// status change handler when it changes
+func (c *Checker) setConnected(connected bool) {
 	c.mu.Lock()
-	if c.connected {
+	if c.connected == connected {
+		c.mu.Unlock()
+		return
+	}
+	c.connected = connected
+	fn := c.statusChange
+	c.mu.Unlock()
+	if connected {
+		log.Debugln(log.Global, ConnRe)
+	} else {
 		log.Warnln(log.Global, ConnLost)
-		c.connected = false
 	}
+	if fn != nil {
+		fn(connected)
+	}
+}
+
+// SetStatusChangeHandler sets a function which is called when connectivity is
+// lost or re-established
+func (c *Checker) SetStatusChangeHandler(fn func(connected bool)) {
+	c.mu.Lock()
+	c.statusChange = fn
 	c.mu.Unlock()
 }
 
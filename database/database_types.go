@@ -25,6 +25,15 @@ type Config struct {
 	Verbose                   bool   `json:"verbose"`
 	Driver                    string `json:"driver"`
 	drivers.ConnectionDetails `json:"connectionDetails"`
+	MarketData                *MarketDataConfig `json:"marketData,omitempty"`
+}
+
+// MarketDataConfig holds the optional time-series database used to store
+// candle and trade data instead of the main database
+type MarketDataConfig struct {
+	Enabled                   bool   `json:"enabled"`
+	Driver                    string `json:"driver"`
+	drivers.ConnectionDetails `json:"connectionDetails"`
 }
 
 var (
@@ -38,6 +47,8 @@ var (
 	ErrDatabaseSupportDisabled = errors.New("database support is disabled")
 	// SupportedDrivers slice of supported database driver types
 	SupportedDrivers = []string{DBSQLite, DBSQLite3, DBPostgreSQL}
+	// SupportedMarketDataDrivers slice of supported market data driver types
+	SupportedMarketDataDrivers = []string{DBTimescaleDB}
 	// ErrFailedToConnect for when a database fails to connect
 	ErrFailedToConnect = errors.New("database failed to connect")
 	// ErrDatabaseNotConnected for when a database is not connected
@@ -59,6 +70,8 @@ const (
 	DBSQLite3 = "sqlite3"
 	// DBPostgreSQL const string for PostgreSQL across code base
 	DBPostgreSQL = "postgres"
+	// DBTimescaleDB const string for the TimescaleDB market data driver
+	DBTimescaleDB = "timescaledb"
 	// DBInvalidDriver const string for invalid driver
 	DBInvalidDriver = "invalid driver"
 )
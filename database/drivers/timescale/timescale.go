@@ -0,0 +1,131 @@
+package timescale
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	// import go libpq driver package
+	_ "github.com/lib/pq"
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var errUnsupportedDriver = errors.New("unsupported market data driver")
+
+// schema creates the market data hypertables and a continuous aggregate which
+// rolls trades up into one minute candles. Every statement is idempotent so it
+// is applied on each connect
+const schema = `
+CREATE EXTENSION IF NOT EXISTS timescaledb;
+
+CREATE TABLE IF NOT EXISTS market_candle
+(
+    exchange_name_id  UUID             NOT NULL,
+    base              VARCHAR(30)      NOT NULL,
+    quote             VARCHAR(30)      NOT NULL,
+    interval          BIGINT           NOT NULL,
+    asset             VARCHAR          NOT NULL,
+    timestamp         TIMESTAMPTZ      NOT NULL,
+    open              DOUBLE PRECISION NOT NULL,
+    high              DOUBLE PRECISION NOT NULL,
+    low               DOUBLE PRECISION NOT NULL,
+    close             DOUBLE PRECISION NOT NULL,
+    volume            DOUBLE PRECISION NOT NULL,
+    source_job_id     UUID,
+    validation_job_id UUID,
+    validation_issues TEXT,
+    PRIMARY KEY (exchange_name_id, base, quote, interval, asset, timestamp)
+);
+SELECT create_hypertable('market_candle', 'timestamp', chunk_time_interval => INTERVAL '7 days', if_not_exists => TRUE);
+
+CREATE TABLE IF NOT EXISTS market_trade
+(
+    id               UUID             NOT NULL,
+    tid              VARCHAR,
+    exchange_name_id UUID             NOT NULL,
+    base             VARCHAR(30)      NOT NULL,
+    quote            VARCHAR(30)      NOT NULL,
+    asset            VARCHAR          NOT NULL,
+    price            DOUBLE PRECISION NOT NULL,
+    amount           DOUBLE PRECISION NOT NULL,
+    side             VARCHAR,
+    timestamp        TIMESTAMPTZ      NOT NULL,
+    PRIMARY KEY (id, timestamp)
+);
+SELECT create_hypertable('market_trade', 'timestamp', chunk_time_interval => INTERVAL '1 day', if_not_exists => TRUE);
+CREATE UNIQUE INDEX IF NOT EXISTS market_trade_unique_tid ON market_trade (exchange_name_id, tid, timestamp);
+CREATE UNIQUE INDEX IF NOT EXISTS market_trade_unique_no_id ON market_trade (exchange_name_id, base, quote, asset, price, amount, timestamp);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS market_trade_candle_1m
+WITH (timescaledb.continuous, timescaledb.materialized_only = false) AS
+SELECT exchange_name_id,
+       base,
+       quote,
+       asset,
+       time_bucket(INTERVAL '1 minute', timestamp) AS bucket,
+       first(price, timestamp)                     AS open,
+       max(price)                                  AS high,
+       min(price)                                  AS low,
+       last(price, timestamp)                      AS close,
+       sum(amount)                                 AS volume
+FROM market_trade
+GROUP BY exchange_name_id, base, quote, asset, bucket
+WITH NO DATA;
+SELECT add_continuous_aggregate_policy('market_trade_candle_1m',
+    start_offset => INTERVAL '3 days',
+    end_offset => INTERVAL '1 minute',
+    schedule_interval => INTERVAL '1 minute',
+    if_not_exists => TRUE);
+`
+
+// Connect opens a connection to a TimescaleDB database and creates the market
+// data schema if it does not exist
+func Connect(cfg *database.MarketDataConfig) (*sql.DB, error) {
+	if cfg == nil {
+		return nil, database.ErrNilConfig
+	}
+	if !cfg.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	if cfg.Driver != database.DBTimescaleDB {
+		return nil, fmt.Errorf("%w: %q", errUnsupportedDriver, cfg.Driver)
+	}
+	if cfg.SSLMode == "" {
+		cfg.SSLMode = "disable"
+	}
+
+	host := net.JoinHostPort(cfg.Host, strconv.FormatUint(uint64(cfg.Port), 10))
+	configDSN := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=%s",
+		cfg.Username,
+		cfg.Password,
+		host,
+		cfg.Database,
+		cfg.SSLMode)
+
+	db, err := sql.Open(database.DBPostgreSQL, configDSN)
+	if err != nil {
+		return nil, err
+	}
+	if err := Setup(context.TODO(), db); err != nil {
+		if errClose := db.Close(); errClose != nil {
+			err = errors.Join(err, errClose)
+		}
+		return nil, err
+	}
+	return db, nil
+}
+
+// Setup verifies the connection and applies the market data schema
+func Setup(ctx context.Context, db *sql.DB) error {
+	if db == nil {
+		return database.ErrNilInstance
+	}
+	if err := db.PingContext(ctx); err != nil {
+		return err
+	}
+	_, err := db.ExecContext(ctx, schema)
+	return err
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS script_state
+(
+    id uuid   PRIMARY KEY DEFAULT gen_random_uuid(),
+    script_name varchar not null,
+    key varchar not null,
+    value text not null,
+    created_at TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc'),
+    updated_at TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc'),
+    CONSTRAINT script_state_uniq UNIQUE (script_name, key)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE script_state;
@@ -0,0 +1,51 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS funding_rate
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    rate DOUBLE PRECISION NOT NULL,
+    payment DOUBLE PRECISION NOT NULL,
+    source_job_id uuid REFERENCES datahistoryjob(id),
+    CONSTRAINT funding_rate_uniq UNIQUE (exchange_name_id, base, quote, asset, timestamp)
+);
+
+CREATE TABLE IF NOT EXISTS open_interest
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    open_interest DOUBLE PRECISION NOT NULL,
+    source_job_id uuid REFERENCES datahistoryjob(id),
+    CONSTRAINT open_interest_uniq UNIQUE (exchange_name_id, base, quote, asset, timestamp)
+);
+
+CREATE TABLE IF NOT EXISTS price_candle
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    price_type varchar NOT NULL,
+    interval bigint NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    open DOUBLE PRECISION NOT NULL,
+    high DOUBLE PRECISION NOT NULL,
+    low DOUBLE PRECISION NOT NULL,
+    close DOUBLE PRECISION NOT NULL,
+    source_job_id uuid REFERENCES datahistoryjob(id),
+    CONSTRAINT price_candle_uniq UNIQUE (exchange_name_id, base, quote, asset, price_type, interval, timestamp)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE price_candle;
+DROP TABLE open_interest;
+DROP TABLE funding_rate;
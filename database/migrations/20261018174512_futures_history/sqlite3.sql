@@ -0,0 +1,57 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS funding_rate
+(
+    id text not null primary key,
+    exchange_name_id text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    timestamp timestamp NOT NULL,
+    rate real NOT NULL,
+    payment real NOT NULL,
+    source_job_id text,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    FOREIGN KEY(source_job_id) REFERENCES datahistoryjob(id),
+    UNIQUE(exchange_name_id, base, quote, asset, timestamp)
+);
+
+CREATE TABLE IF NOT EXISTS open_interest
+(
+    id text not null primary key,
+    exchange_name_id text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    timestamp timestamp NOT NULL,
+    open_interest real NOT NULL,
+    source_job_id text,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    FOREIGN KEY(source_job_id) REFERENCES datahistoryjob(id),
+    UNIQUE(exchange_name_id, base, quote, asset, timestamp)
+);
+
+CREATE TABLE IF NOT EXISTS price_candle
+(
+    id text not null primary key,
+    exchange_name_id text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    price_type text NOT NULL,
+    interval integer NOT NULL,
+    timestamp timestamp NOT NULL,
+    open real NOT NULL,
+    high real NOT NULL,
+    low real NOT NULL,
+    close real NOT NULL,
+    source_job_id text,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    FOREIGN KEY(source_job_id) REFERENCES datahistoryjob(id),
+    UNIQUE(exchange_name_id, base, quote, asset, price_type, interval, timestamp)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE price_candle;
+DROP TABLE open_interest;
+DROP TABLE funding_rate;
@@ -0,0 +1,35 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS data_quality_report
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    data_type varchar NOT NULL,
+    interval bigint NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    records bigint NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS data_quality_report_lookup ON data_quality_report (exchange_name_id, base, quote, asset, created_at);
+
+CREATE TABLE IF NOT EXISTS data_quality_issue
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    report_id uuid REFERENCES data_quality_report(id) ON DELETE CASCADE NOT NULL,
+    issue_type varchar NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    comparison_exchange varchar,
+    description text NOT NULL,
+    repair_job_id uuid REFERENCES datahistoryjob(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS data_quality_issue_report ON data_quality_issue (report_id);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE data_quality_issue;
+DROP TABLE data_quality_report;
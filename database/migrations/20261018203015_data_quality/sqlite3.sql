@@ -0,0 +1,38 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS data_quality_report
+(
+    id text not null primary key,
+    exchange_name_id text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    data_type text NOT NULL,
+    interval integer NOT NULL,
+    start_time timestamp NOT NULL,
+    end_time timestamp NOT NULL,
+    records integer NOT NULL,
+    created_at timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS data_quality_report_lookup ON data_quality_report (exchange_name_id, base, quote, asset, created_at);
+
+CREATE TABLE IF NOT EXISTS data_quality_issue
+(
+    id text not null primary key,
+    report_id text NOT NULL,
+    issue_type text NOT NULL,
+    start_time timestamp NOT NULL,
+    end_time timestamp NOT NULL,
+    value real NOT NULL,
+    comparison_exchange text,
+    description text NOT NULL,
+    repair_job_id text,
+    FOREIGN KEY(report_id) REFERENCES data_quality_report(id) ON DELETE CASCADE,
+    FOREIGN KEY(repair_job_id) REFERENCES datahistoryjob(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS data_quality_issue_report ON data_quality_issue (report_id);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE data_quality_issue;
+DROP TABLE data_quality_report;
@@ -0,0 +1,1001 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDataQualityIssues(t *testing.T) {
+	t.Parallel()
+
+	query := DataQualityIssues()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDataQualityIssuesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDataQualityIssuesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := DataQualityIssues().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDataQualityIssuesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DataQualityIssueSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDataQualityIssuesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DataQualityIssueExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if DataQualityIssue exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DataQualityIssueExists to return true, but got false.")
+	}
+}
+
+func testDataQualityIssuesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	dataQualityIssueFound, err := FindDataQualityIssue(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if dataQualityIssueFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDataQualityIssuesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = DataQualityIssues().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDataQualityIssuesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := DataQualityIssues().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDataQualityIssuesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	dataQualityIssueOne := &DataQualityIssue{}
+	dataQualityIssueTwo := &DataQualityIssue{}
+	if err = randomize.Struct(seed, dataQualityIssueOne, dataQualityIssueDBTypes, false, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dataQualityIssueTwo, dataQualityIssueDBTypes, false, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dataQualityIssueOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dataQualityIssueTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DataQualityIssues().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDataQualityIssuesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	dataQualityIssueOne := &DataQualityIssue{}
+	dataQualityIssueTwo := &DataQualityIssue{}
+	if err = randomize.Struct(seed, dataQualityIssueOne, dataQualityIssueDBTypes, false, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dataQualityIssueTwo, dataQualityIssueDBTypes, false, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dataQualityIssueOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dataQualityIssueTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func dataQualityIssueBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityIssue) error {
+	*o = DataQualityIssue{}
+	return nil
+}
+
+func dataQualityIssueAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityIssue) error {
+	*o = DataQualityIssue{}
+	return nil
+}
+
+func dataQualityIssueAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityIssue) error {
+	*o = DataQualityIssue{}
+	return nil
+}
+
+func dataQualityIssueBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityIssue) error {
+	*o = DataQualityIssue{}
+	return nil
+}
+
+func dataQualityIssueAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityIssue) error {
+	*o = DataQualityIssue{}
+	return nil
+}
+
+func dataQualityIssueBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityIssue) error {
+	*o = DataQualityIssue{}
+	return nil
+}
+
+func dataQualityIssueAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityIssue) error {
+	*o = DataQualityIssue{}
+	return nil
+}
+
+func dataQualityIssueBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityIssue) error {
+	*o = DataQualityIssue{}
+	return nil
+}
+
+func dataQualityIssueAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityIssue) error {
+	*o = DataQualityIssue{}
+	return nil
+}
+
+func testDataQualityIssuesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &DataQualityIssue{}
+	o := &DataQualityIssue{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue object: %s", err)
+	}
+
+	AddDataQualityIssueHook(boil.BeforeInsertHook, dataQualityIssueBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	dataQualityIssueBeforeInsertHooks = []DataQualityIssueHook{}
+
+	AddDataQualityIssueHook(boil.AfterInsertHook, dataQualityIssueAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	dataQualityIssueAfterInsertHooks = []DataQualityIssueHook{}
+
+	AddDataQualityIssueHook(boil.AfterSelectHook, dataQualityIssueAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	dataQualityIssueAfterSelectHooks = []DataQualityIssueHook{}
+
+	AddDataQualityIssueHook(boil.BeforeUpdateHook, dataQualityIssueBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	dataQualityIssueBeforeUpdateHooks = []DataQualityIssueHook{}
+
+	AddDataQualityIssueHook(boil.AfterUpdateHook, dataQualityIssueAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	dataQualityIssueAfterUpdateHooks = []DataQualityIssueHook{}
+
+	AddDataQualityIssueHook(boil.BeforeDeleteHook, dataQualityIssueBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	dataQualityIssueBeforeDeleteHooks = []DataQualityIssueHook{}
+
+	AddDataQualityIssueHook(boil.AfterDeleteHook, dataQualityIssueAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	dataQualityIssueAfterDeleteHooks = []DataQualityIssueHook{}
+
+	AddDataQualityIssueHook(boil.BeforeUpsertHook, dataQualityIssueBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	dataQualityIssueBeforeUpsertHooks = []DataQualityIssueHook{}
+
+	AddDataQualityIssueHook(boil.AfterUpsertHook, dataQualityIssueAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	dataQualityIssueAfterUpsertHooks = []DataQualityIssueHook{}
+}
+
+func testDataQualityIssuesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDataQualityIssuesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(dataQualityIssueColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDataQualityIssueToOneDatahistoryjobUsingRepairJob(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local DataQualityIssue
+	var foreign Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, datahistoryjobDBTypes, false, datahistoryjobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Datahistoryjob struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.RepairJobID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.RepairJob().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := DataQualityIssueSlice{&local}
+	if err = local.L.LoadRepairJob(ctx, tx, false, (*[]*DataQualityIssue)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RepairJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.RepairJob = nil
+	if err = local.L.LoadRepairJob(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.RepairJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testDataQualityIssueToOneDataQualityReportUsingReport(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local DataQualityIssue
+	var foreign DataQualityReport
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, dataQualityIssueDBTypes, false, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, dataQualityReportDBTypes, false, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ReportID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.Report().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := DataQualityIssueSlice{&local}
+	if err = local.L.LoadReport(ctx, tx, false, (*[]*DataQualityIssue)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Report == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.Report = nil
+	if err = local.L.LoadReport(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.Report == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testDataQualityIssueToOneSetOpDatahistoryjobUsingRepairJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DataQualityIssue
+	var b, c Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, dataQualityIssueDBTypes, false, strmangle.SetComplement(dataQualityIssuePrimaryKeyColumns, dataQualityIssueColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Datahistoryjob{&b, &c} {
+		err = a.SetRepairJob(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.RepairJob != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.RepairJobDataQualityIssues[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.RepairJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.RepairJobID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.RepairJobID))
+		reflect.Indirect(reflect.ValueOf(&a.RepairJobID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.RepairJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.RepairJobID, x.ID)
+		}
+	}
+}
+
+func testDataQualityIssueToOneRemoveOpDatahistoryjobUsingRepairJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DataQualityIssue
+	var b Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, dataQualityIssueDBTypes, false, strmangle.SetComplement(dataQualityIssuePrimaryKeyColumns, dataQualityIssueColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetRepairJob(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveRepairJob(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.RepairJob().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.RepairJob != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.RepairJobID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.RepairJobDataQualityIssues) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testDataQualityIssueToOneSetOpDataQualityReportUsingReport(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DataQualityIssue
+	var b, c DataQualityReport
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, dataQualityIssueDBTypes, false, strmangle.SetComplement(dataQualityIssuePrimaryKeyColumns, dataQualityIssueColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, dataQualityReportDBTypes, false, strmangle.SetComplement(dataQualityReportPrimaryKeyColumns, dataQualityReportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dataQualityReportDBTypes, false, strmangle.SetComplement(dataQualityReportPrimaryKeyColumns, dataQualityReportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*DataQualityReport{&b, &c} {
+		err = a.SetReport(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.Report != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ReportDataQualityIssues[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ReportID != x.ID {
+			t.Error("foreign key was wrong value", a.ReportID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ReportID))
+		reflect.Indirect(reflect.ValueOf(&a.ReportID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ReportID != x.ID {
+			t.Error("foreign key was wrong value", a.ReportID, x.ID)
+		}
+	}
+}
+
+func testDataQualityIssuesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDataQualityIssuesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DataQualityIssueSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDataQualityIssuesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DataQualityIssues().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	dataQualityIssueDBTypes = map[string]string{`ID`: `uuid`, `ReportID`: `uuid`, `IssueType`: `character varying`, `StartTime`: `timestamp with time zone`, `EndTime`: `timestamp with time zone`, `Value`: `double precision`, `ComparisonExchange`: `character varying`, `Description`: `text`, `RepairJobID`: `uuid`}
+	_                       = bytes.MinRead
+)
+
+func testDataQualityIssuesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(dataQualityIssuePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(dataQualityIssueAllColumns) == len(dataQualityIssuePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssuePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDataQualityIssuesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(dataQualityIssueAllColumns) == len(dataQualityIssuePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityIssue{}
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dataQualityIssueDBTypes, true, dataQualityIssuePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(dataQualityIssueAllColumns, dataQualityIssuePrimaryKeyColumns) {
+		fields = dataQualityIssueAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			dataQualityIssueAllColumns,
+			dataQualityIssuePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DataQualityIssueSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testDataQualityIssuesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(dataQualityIssueAllColumns) == len(dataQualityIssuePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := DataQualityIssue{}
+	if err = randomize.Struct(seed, &o, dataQualityIssueDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DataQualityIssue: %s", err)
+	}
+
+	count, err := DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, dataQualityIssueDBTypes, false, dataQualityIssuePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DataQualityIssue struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert DataQualityIssue: %s", err)
+	}
+
+	count, err = DataQualityIssues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
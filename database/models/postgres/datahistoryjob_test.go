@@ -648,6 +648,83 @@ func testDatahistoryjobToManyValidationJobCandles(t *testing.T) {
 	}
 }
 
+func testDatahistoryjobToManyRepairJobDataQualityIssues(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c DataQualityIssue
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, true, datahistoryjobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Datahistoryjob struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, dataQualityIssueDBTypes, false, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dataQualityIssueDBTypes, false, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.RepairJobID, a.ID)
+	queries.Assign(&c.RepairJobID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.RepairJobDataQualityIssues().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.RepairJobID, b.RepairJobID) {
+			bFound = true
+		}
+		if queries.Equal(v.RepairJobID, c.RepairJobID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := DatahistoryjobSlice{&a}
+	if err = a.L.LoadRepairJobDataQualityIssues(ctx, tx, false, (*[]*Datahistoryjob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RepairJobDataQualityIssues); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.RepairJobDataQualityIssues = nil
+	if err = a.L.LoadRepairJobDataQualityIssues(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.RepairJobDataQualityIssues); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testDatahistoryjobToManyPrerequisiteJobDatahistoryjobs(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -894,30 +971,33 @@ func testDatahistoryjobToManyJobDatahistoryjobresults(t *testing.T) {
 	}
 }
 
-func testDatahistoryjobToManyAddOpSourceJobCandles(t *testing.T) {
+func testDatahistoryjobToManySourceJobFundingRates(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Candle
+	var b, c FundingRate
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Candle{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, true, datahistoryjobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Datahistoryjob struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+
+	if err = randomize.Struct(seed, &b, fundingRateDBTypes, false, fundingRateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, fundingRateDBTypes, false, fundingRateColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SourceJobID, a.ID)
+	queries.Assign(&c.SourceJobID, a.ID)
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -925,227 +1005,204 @@ func testDatahistoryjobToManyAddOpSourceJobCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Candle{
-		{&b, &c},
-		{&d, &e},
+	check, err := a.SourceJobFundingRates().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSourceJobCandles(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if !queries.Equal(a.ID, first.SourceJobID) {
-			t.Error("foreign key was wrong value", a.ID, first.SourceJobID)
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SourceJobID, b.SourceJobID) {
+			bFound = true
 		}
-		if !queries.Equal(a.ID, second.SourceJobID) {
-			t.Error("foreign key was wrong value", a.ID, second.SourceJobID)
+		if queries.Equal(v.SourceJobID, c.SourceJobID) {
+			cFound = true
 		}
+	}
 
-		if first.R.SourceJob != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.SourceJob != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
 
-		if a.R.SourceJobCandles[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.SourceJobCandles[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
+	slice := DatahistoryjobSlice{&a}
+	if err = a.L.LoadSourceJobFundingRates(ctx, tx, false, (*[]*Datahistoryjob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SourceJobFundingRates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
 
-		count, err := a.SourceJobCandles().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
+	a.R.SourceJobFundingRates = nil
+	if err = a.L.LoadSourceJobFundingRates(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SourceJobFundingRates); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
 
-func testDatahistoryjobToManySetOpSourceJobCandles(t *testing.T) {
+func testDatahistoryjobToManySourceJobOpenInterests(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Candle
+	var b, c OpenInterest
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Candle{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, true, datahistoryjobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Datahistoryjob struct: %s", err)
 	}
 
-	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+
+	if err = randomize.Struct(seed, &b, openInterestDBTypes, false, openInterestColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err = randomize.Struct(seed, &c, openInterestDBTypes, false, openInterestColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
-	err = a.SetSourceJobCandles(ctx, tx, false, &b, &c)
-	if err != nil {
+	queries.Assign(&b.SourceJobID, a.ID)
+	queries.Assign(&c.SourceJobID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	count, err := a.SourceJobCandles().Count(ctx, tx)
-	if err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
 
-	err = a.SetSourceJobCandles(ctx, tx, true, &d, &e)
+	check, err := a.SourceJobOpenInterests().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.SourceJobCandles().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SourceJobID, b.SourceJobID) {
+			bFound = true
+		}
+		if queries.Equal(v.SourceJobID, c.SourceJobID) {
+			cFound = true
+		}
 	}
 
-	if !queries.IsValuerNil(b.SourceJobID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.SourceJobID) {
-		t.Error("want c's foreign key value to be nil")
-	}
-	if !queries.Equal(a.ID, d.SourceJobID) {
-		t.Error("foreign key was wrong value", a.ID, d.SourceJobID)
+	if !bFound {
+		t.Error("expected to find b")
 	}
-	if !queries.Equal(a.ID, e.SourceJobID) {
-		t.Error("foreign key was wrong value", a.ID, e.SourceJobID)
+	if !cFound {
+		t.Error("expected to find c")
 	}
 
-	if b.R.SourceJob != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
+	slice := DatahistoryjobSlice{&a}
+	if err = a.L.LoadSourceJobOpenInterests(ctx, tx, false, (*[]*Datahistoryjob)(&slice), nil); err != nil {
+		t.Fatal(err)
 	}
-	if c.R.SourceJob != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
+	if got := len(a.R.SourceJobOpenInterests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
 	}
-	if d.R.SourceJob != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+
+	a.R.SourceJobOpenInterests = nil
+	if err = a.L.LoadSourceJobOpenInterests(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
 	}
-	if e.R.SourceJob != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+	if got := len(a.R.SourceJobOpenInterests); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	if a.R.SourceJobCandles[0] != &d {
-		t.Error("relationship struct slice not set to correct value")
-	}
-	if a.R.SourceJobCandles[1] != &e {
-		t.Error("relationship struct slice not set to correct value")
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
 
-func testDatahistoryjobToManyRemoveOpSourceJobCandles(t *testing.T) {
+func testDatahistoryjobToManySourceJobPriceCandles(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Candle
+	var b, c PriceCandle
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Candle{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, true, datahistoryjobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Datahistoryjob struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	err = a.AddSourceJobCandles(ctx, tx, true, foreigners...)
-	if err != nil {
+	if err = randomize.Struct(seed, &b, priceCandleDBTypes, false, priceCandleColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-
-	count, err := a.SourceJobCandles().Count(ctx, tx)
-	if err != nil {
+	if err = randomize.Struct(seed, &c, priceCandleDBTypes, false, priceCandleColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if count != 4 {
-		t.Error("count was wrong:", count)
-	}
 
-	err = a.RemoveSourceJobCandles(ctx, tx, foreigners[:2]...)
-	if err != nil {
+	queries.Assign(&b.SourceJobID, a.ID)
+	queries.Assign(&c.SourceJobID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.SourceJobCandles().Count(ctx, tx)
+	check, err := a.SourceJobPriceCandles().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
 
-	if !queries.IsValuerNil(b.SourceJobID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.SourceJobID) {
-		t.Error("want c's foreign key value to be nil")
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SourceJobID, b.SourceJobID) {
+			bFound = true
+		}
+		if queries.Equal(v.SourceJobID, c.SourceJobID) {
+			cFound = true
+		}
 	}
 
-	if b.R.SourceJob != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
+	if !bFound {
+		t.Error("expected to find b")
 	}
-	if c.R.SourceJob != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
+	if !cFound {
+		t.Error("expected to find c")
 	}
-	if d.R.SourceJob != &a {
-		t.Error("relationship to a should have been preserved")
+
+	slice := DatahistoryjobSlice{&a}
+	if err = a.L.LoadSourceJobPriceCandles(ctx, tx, false, (*[]*Datahistoryjob)(&slice), nil); err != nil {
+		t.Fatal(err)
 	}
-	if e.R.SourceJob != &a {
-		t.Error("relationship to a should have been preserved")
+	if got := len(a.R.SourceJobPriceCandles); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	if len(a.R.SourceJobCandles) != 2 {
-		t.Error("should have preserved two relationships")
+	a.R.SourceJobPriceCandles = nil
+	if err = a.L.LoadSourceJobPriceCandles(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
 	}
-
-	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.SourceJobCandles[1] != &d {
-		t.Error("relationship to d should have been preserved")
+	if got := len(a.R.SourceJobPriceCandles); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
 	}
-	if a.R.SourceJobCandles[0] != &e {
-		t.Error("relationship to e should have been preserved")
+
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
 
-func testDatahistoryjobToManyAddOpValidationJobCandles(t *testing.T) {
+func testDatahistoryjobToManyAddOpSourceJobCandles(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1182,7 +1239,7 @@ func testDatahistoryjobToManyAddOpValidationJobCandles(t *testing.T) {
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddValidationJobCandles(ctx, tx, i != 0, x...)
+		err = a.AddSourceJobCandles(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1190,28 +1247,28 @@ func testDatahistoryjobToManyAddOpValidationJobCandles(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.ValidationJobID) {
-			t.Error("foreign key was wrong value", a.ID, first.ValidationJobID)
+		if !queries.Equal(a.ID, first.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.SourceJobID)
 		}
-		if !queries.Equal(a.ID, second.ValidationJobID) {
-			t.Error("foreign key was wrong value", a.ID, second.ValidationJobID)
+		if !queries.Equal(a.ID, second.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.SourceJobID)
 		}
 
-		if first.R.ValidationJob != &a {
+		if first.R.SourceJob != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.ValidationJob != &a {
+		if second.R.SourceJob != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.ValidationJobCandles[i*2] != first {
+		if a.R.SourceJobCandles[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.ValidationJobCandles[i*2+1] != second {
+		if a.R.SourceJobCandles[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.ValidationJobCandles().Count(ctx, tx)
+		count, err := a.SourceJobCandles().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1221,7 +1278,7 @@ func testDatahistoryjobToManyAddOpValidationJobCandles(t *testing.T) {
 	}
 }
 
-func testDatahistoryjobToManySetOpValidationJobCandles(t *testing.T) {
+func testDatahistoryjobToManySetOpSourceJobCandles(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1252,12 +1309,12 @@ func testDatahistoryjobToManySetOpValidationJobCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetValidationJobCandles(ctx, tx, false, &b, &c)
+	err = a.SetSourceJobCandles(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.ValidationJobCandles().Count(ctx, tx)
+	count, err := a.SourceJobCandles().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1265,12 +1322,12 @@ func testDatahistoryjobToManySetOpValidationJobCandles(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetValidationJobCandles(ctx, tx, true, &d, &e)
+	err = a.SetSourceJobCandles(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.ValidationJobCandles().Count(ctx, tx)
+	count, err = a.SourceJobCandles().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1278,41 +1335,41 @@ func testDatahistoryjobToManySetOpValidationJobCandles(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.ValidationJobID) {
+	if !queries.IsValuerNil(b.SourceJobID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.ValidationJobID) {
+	if !queries.IsValuerNil(c.SourceJobID) {
 		t.Error("want c's foreign key value to be nil")
 	}
-	if !queries.Equal(a.ID, d.ValidationJobID) {
-		t.Error("foreign key was wrong value", a.ID, d.ValidationJobID)
+	if !queries.Equal(a.ID, d.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.SourceJobID)
 	}
-	if !queries.Equal(a.ID, e.ValidationJobID) {
-		t.Error("foreign key was wrong value", a.ID, e.ValidationJobID)
+	if !queries.Equal(a.ID, e.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.SourceJobID)
 	}
 
-	if b.R.ValidationJob != nil {
+	if b.R.SourceJob != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.ValidationJob != nil {
+	if c.R.SourceJob != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.ValidationJob != &a {
+	if d.R.SourceJob != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
-	if e.R.ValidationJob != &a {
+	if e.R.SourceJob != &a {
 		t.Error("relationship was not added properly to the foreign struct")
 	}
 
-	if a.R.ValidationJobCandles[0] != &d {
+	if a.R.SourceJobCandles[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.ValidationJobCandles[1] != &e {
+	if a.R.SourceJobCandles[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testDatahistoryjobToManyRemoveOpValidationJobCandles(t *testing.T) {
+func testDatahistoryjobToManyRemoveOpSourceJobCandles(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1337,12 +1394,12 @@ func testDatahistoryjobToManyRemoveOpValidationJobCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddValidationJobCandles(ctx, tx, true, foreigners...)
+	err = a.AddSourceJobCandles(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.ValidationJobCandles().Count(ctx, tx)
+	count, err := a.SourceJobCandles().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1350,12 +1407,12 @@ func testDatahistoryjobToManyRemoveOpValidationJobCandles(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveValidationJobCandles(ctx, tx, foreigners[:2]...)
+	err = a.RemoveSourceJobCandles(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.ValidationJobCandles().Count(ctx, tx)
+	count, err = a.SourceJobCandles().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1363,40 +1420,40 @@ func testDatahistoryjobToManyRemoveOpValidationJobCandles(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if !queries.IsValuerNil(b.ValidationJobID) {
+	if !queries.IsValuerNil(b.SourceJobID) {
 		t.Error("want b's foreign key value to be nil")
 	}
-	if !queries.IsValuerNil(c.ValidationJobID) {
+	if !queries.IsValuerNil(c.SourceJobID) {
 		t.Error("want c's foreign key value to be nil")
 	}
 
-	if b.R.ValidationJob != nil {
+	if b.R.SourceJob != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if c.R.ValidationJob != nil {
+	if c.R.SourceJob != nil {
 		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if d.R.ValidationJob != &a {
+	if d.R.SourceJob != &a {
 		t.Error("relationship to a should have been preserved")
 	}
-	if e.R.ValidationJob != &a {
+	if e.R.SourceJob != &a {
 		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.ValidationJobCandles) != 2 {
+	if len(a.R.SourceJobCandles) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.ValidationJobCandles[1] != &d {
+	if a.R.SourceJobCandles[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.ValidationJobCandles[0] != &e {
+	if a.R.SourceJobCandles[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
+func testDatahistoryjobToManyAddOpValidationJobCandles(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1404,15 +1461,15 @@ func testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e Candle
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*Candle{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1427,13 +1484,13 @@ func testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Datahistoryjob{
+	foreignersSplitByInsertion := [][]*Candle{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddPrerequisiteJobDatahistoryjobs(ctx, tx, i != 0, x...)
+		err = a.AddValidationJobCandles(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1441,21 +1498,28 @@ func testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if first.R.JobDatahistoryjobs[0] != &a {
-			t.Error("relationship was not added properly to the slice")
+		if !queries.Equal(a.ID, first.ValidationJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.ValidationJobID)
 		}
-		if second.R.JobDatahistoryjobs[0] != &a {
-			t.Error("relationship was not added properly to the slice")
+		if !queries.Equal(a.ID, second.ValidationJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.ValidationJobID)
 		}
 
-		if a.R.PrerequisiteJobDatahistoryjobs[i*2] != first {
+		if first.R.ValidationJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ValidationJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ValidationJobCandles[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.PrerequisiteJobDatahistoryjobs[i*2+1] != second {
+		if a.R.ValidationJobCandles[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
+		count, err := a.ValidationJobCandles().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1465,7 +1529,7 @@ func testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
 	}
 }
 
-func testDatahistoryjobToManySetOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
+func testDatahistoryjobToManySetOpValidationJobCandles(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1473,15 +1537,15 @@ func testDatahistoryjobToManySetOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e Candle
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*Candle{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1496,12 +1560,12 @@ func testDatahistoryjobToManySetOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetPrerequisiteJobDatahistoryjobs(ctx, tx, false, &b, &c)
+	err = a.SetValidationJobCandles(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
+	count, err := a.ValidationJobCandles().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1509,12 +1573,12 @@ func testDatahistoryjobToManySetOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetPrerequisiteJobDatahistoryjobs(ctx, tx, true, &d, &e)
+	err = a.SetValidationJobCandles(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
+	count, err = a.ValidationJobCandles().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1522,32 +1586,41 @@ func testDatahistoryjobToManySetOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	// The following checks cannot be implemented since we have no handle
-	// to these when we call Set(). Leaving them here as wishful thinking
-	// and to let people know there's dragons.
-	//
-	// if len(b.R.JobDatahistoryjobs) != 0 {
-	// 	t.Error("relationship was not removed properly from the slice")
-	// }
-	// if len(c.R.JobDatahistoryjobs) != 0 {
-	// 	t.Error("relationship was not removed properly from the slice")
-	// }
-	if d.R.JobDatahistoryjobs[0] != &a {
-		t.Error("relationship was not added properly to the slice")
+	if !queries.IsValuerNil(b.ValidationJobID) {
+		t.Error("want b's foreign key value to be nil")
 	}
-	if e.R.JobDatahistoryjobs[0] != &a {
-		t.Error("relationship was not added properly to the slice")
+	if !queries.IsValuerNil(c.ValidationJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.ValidationJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.ValidationJobID)
+	}
+	if !queries.Equal(a.ID, e.ValidationJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.ValidationJobID)
 	}
 
-	if a.R.PrerequisiteJobDatahistoryjobs[0] != &d {
+	if b.R.ValidationJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.ValidationJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ValidationJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.ValidationJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.ValidationJobCandles[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.PrerequisiteJobDatahistoryjobs[1] != &e {
+	if a.R.ValidationJobCandles[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testDatahistoryjobToManyRemoveOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
+func testDatahistoryjobToManyRemoveOpValidationJobCandles(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1555,15 +1628,15 @@ func testDatahistoryjobToManyRemoveOpPrerequisiteJobDatahistoryjobs(t *testing.T
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e Candle
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*Candle{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1572,12 +1645,12 @@ func testDatahistoryjobToManyRemoveOpPrerequisiteJobDatahistoryjobs(t *testing.T
 		t.Fatal(err)
 	}
 
-	err = a.AddPrerequisiteJobDatahistoryjobs(ctx, tx, true, foreigners...)
+	err = a.AddValidationJobCandles(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
+	count, err := a.ValidationJobCandles().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1585,12 +1658,12 @@ func testDatahistoryjobToManyRemoveOpPrerequisiteJobDatahistoryjobs(t *testing.T
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemovePrerequisiteJobDatahistoryjobs(ctx, tx, foreigners[:2]...)
+	err = a.RemoveValidationJobCandles(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
+	count, err = a.ValidationJobCandles().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1598,33 +1671,40 @@ func testDatahistoryjobToManyRemoveOpPrerequisiteJobDatahistoryjobs(t *testing.T
 		t.Error("count was wrong:", count)
 	}
 
-	if len(b.R.JobDatahistoryjobs) != 0 {
-		t.Error("relationship was not removed properly from the slice")
-	}
-	if len(c.R.JobDatahistoryjobs) != 0 {
-		t.Error("relationship was not removed properly from the slice")
-	}
-	if d.R.JobDatahistoryjobs[0] != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+	if !queries.IsValuerNil(b.ValidationJobID) {
+		t.Error("want b's foreign key value to be nil")
 	}
-	if e.R.JobDatahistoryjobs[0] != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+	if !queries.IsValuerNil(c.ValidationJobID) {
+		t.Error("want c's foreign key value to be nil")
 	}
 
-	if len(a.R.PrerequisiteJobDatahistoryjobs) != 2 {
-		t.Error("should have preserved two relationships")
+	if b.R.ValidationJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
 	}
-
-	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.PrerequisiteJobDatahistoryjobs[1] != &d {
+	if c.R.ValidationJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.ValidationJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.ValidationJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.ValidationJobCandles) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.ValidationJobCandles[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.PrerequisiteJobDatahistoryjobs[0] != &e {
+	if a.R.ValidationJobCandles[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testDatahistoryjobToManyAddOpJobDatahistoryjobs(t *testing.T) {
+func testDatahistoryjobToManyAddOpRepairJobDataQualityIssues(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1632,15 +1712,15 @@ func testDatahistoryjobToManyAddOpJobDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e DataQualityIssue
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*DataQualityIssue{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, dataQualityIssueDBTypes, false, strmangle.SetComplement(dataQualityIssuePrimaryKeyColumns, dataQualityIssueColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1655,13 +1735,13 @@ func testDatahistoryjobToManyAddOpJobDatahistoryjobs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Datahistoryjob{
+	foreignersSplitByInsertion := [][]*DataQualityIssue{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddJobDatahistoryjobs(ctx, tx, i != 0, x...)
+		err = a.AddRepairJobDataQualityIssues(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1669,21 +1749,28 @@ func testDatahistoryjobToManyAddOpJobDatahistoryjobs(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if first.R.PrerequisiteJobDatahistoryjobs[0] != &a {
-			t.Error("relationship was not added properly to the slice")
+		if !queries.Equal(a.ID, first.RepairJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.RepairJobID)
 		}
-		if second.R.PrerequisiteJobDatahistoryjobs[0] != &a {
-			t.Error("relationship was not added properly to the slice")
+		if !queries.Equal(a.ID, second.RepairJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.RepairJobID)
 		}
 
-		if a.R.JobDatahistoryjobs[i*2] != first {
+		if first.R.RepairJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.RepairJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.RepairJobDataQualityIssues[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.JobDatahistoryjobs[i*2+1] != second {
+		if a.R.RepairJobDataQualityIssues[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.JobDatahistoryjobs().Count(ctx, tx)
+		count, err := a.RepairJobDataQualityIssues().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1693,7 +1780,7 @@ func testDatahistoryjobToManyAddOpJobDatahistoryjobs(t *testing.T) {
 	}
 }
 
-func testDatahistoryjobToManySetOpJobDatahistoryjobs(t *testing.T) {
+func testDatahistoryjobToManySetOpRepairJobDataQualityIssues(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1701,15 +1788,15 @@ func testDatahistoryjobToManySetOpJobDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e DataQualityIssue
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*DataQualityIssue{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, dataQualityIssueDBTypes, false, strmangle.SetComplement(dataQualityIssuePrimaryKeyColumns, dataQualityIssueColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1724,12 +1811,12 @@ func testDatahistoryjobToManySetOpJobDatahistoryjobs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetJobDatahistoryjobs(ctx, tx, false, &b, &c)
+	err = a.SetRepairJobDataQualityIssues(ctx, tx, false, &b, &c)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.JobDatahistoryjobs().Count(ctx, tx)
+	count, err := a.RepairJobDataQualityIssues().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1737,12 +1824,12 @@ func testDatahistoryjobToManySetOpJobDatahistoryjobs(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.SetJobDatahistoryjobs(ctx, tx, true, &d, &e)
+	err = a.SetRepairJobDataQualityIssues(ctx, tx, true, &d, &e)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.JobDatahistoryjobs().Count(ctx, tx)
+	count, err = a.RepairJobDataQualityIssues().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1750,32 +1837,41 @@ func testDatahistoryjobToManySetOpJobDatahistoryjobs(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	// The following checks cannot be implemented since we have no handle
-	// to these when we call Set(). Leaving them here as wishful thinking
-	// and to let people know there's dragons.
-	//
-	// if len(b.R.PrerequisiteJobDatahistoryjobs) != 0 {
-	// 	t.Error("relationship was not removed properly from the slice")
-	// }
-	// if len(c.R.PrerequisiteJobDatahistoryjobs) != 0 {
-	// 	t.Error("relationship was not removed properly from the slice")
-	// }
-	if d.R.PrerequisiteJobDatahistoryjobs[0] != &a {
-		t.Error("relationship was not added properly to the slice")
+	if !queries.IsValuerNil(b.RepairJobID) {
+		t.Error("want b's foreign key value to be nil")
 	}
-	if e.R.PrerequisiteJobDatahistoryjobs[0] != &a {
-		t.Error("relationship was not added properly to the slice")
+	if !queries.IsValuerNil(c.RepairJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.RepairJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.RepairJobID)
+	}
+	if !queries.Equal(a.ID, e.RepairJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.RepairJobID)
 	}
 
-	if a.R.JobDatahistoryjobs[0] != &d {
+	if b.R.RepairJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.RepairJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RepairJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.RepairJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.RepairJobDataQualityIssues[0] != &d {
 		t.Error("relationship struct slice not set to correct value")
 	}
-	if a.R.JobDatahistoryjobs[1] != &e {
+	if a.R.RepairJobDataQualityIssues[1] != &e {
 		t.Error("relationship struct slice not set to correct value")
 	}
 }
 
-func testDatahistoryjobToManyRemoveOpJobDatahistoryjobs(t *testing.T) {
+func testDatahistoryjobToManyRemoveOpRepairJobDataQualityIssues(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1783,15 +1879,15 @@ func testDatahistoryjobToManyRemoveOpJobDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e DataQualityIssue
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*DataQualityIssue{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, dataQualityIssueDBTypes, false, strmangle.SetComplement(dataQualityIssuePrimaryKeyColumns, dataQualityIssueColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1800,12 +1896,12 @@ func testDatahistoryjobToManyRemoveOpJobDatahistoryjobs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.AddJobDatahistoryjobs(ctx, tx, true, foreigners...)
+	err = a.AddRepairJobDataQualityIssues(ctx, tx, true, foreigners...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err := a.JobDatahistoryjobs().Count(ctx, tx)
+	count, err := a.RepairJobDataQualityIssues().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1813,12 +1909,12 @@ func testDatahistoryjobToManyRemoveOpJobDatahistoryjobs(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	err = a.RemoveJobDatahistoryjobs(ctx, tx, foreigners[:2]...)
+	err = a.RemoveRepairJobDataQualityIssues(ctx, tx, foreigners[:2]...)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	count, err = a.JobDatahistoryjobs().Count(ctx, tx)
+	count, err = a.RepairJobDataQualityIssues().Count(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1826,33 +1922,40 @@ func testDatahistoryjobToManyRemoveOpJobDatahistoryjobs(t *testing.T) {
 		t.Error("count was wrong:", count)
 	}
 
-	if len(b.R.PrerequisiteJobDatahistoryjobs) != 0 {
-		t.Error("relationship was not removed properly from the slice")
+	if !queries.IsValuerNil(b.RepairJobID) {
+		t.Error("want b's foreign key value to be nil")
 	}
-	if len(c.R.PrerequisiteJobDatahistoryjobs) != 0 {
-		t.Error("relationship was not removed properly from the slice")
+	if !queries.IsValuerNil(c.RepairJobID) {
+		t.Error("want c's foreign key value to be nil")
 	}
-	if d.R.PrerequisiteJobDatahistoryjobs[0] != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+
+	if b.R.RepairJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
 	}
-	if e.R.PrerequisiteJobDatahistoryjobs[0] != &a {
-		t.Error("relationship was not added properly to the foreign struct")
+	if c.R.RepairJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.RepairJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.RepairJob != &a {
+		t.Error("relationship to a should have been preserved")
 	}
 
-	if len(a.R.JobDatahistoryjobs) != 2 {
+	if len(a.R.RepairJobDataQualityIssues) != 2 {
 		t.Error("should have preserved two relationships")
 	}
 
 	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.JobDatahistoryjobs[1] != &d {
+	if a.R.RepairJobDataQualityIssues[1] != &d {
 		t.Error("relationship to d should have been preserved")
 	}
-	if a.R.JobDatahistoryjobs[0] != &e {
+	if a.R.RepairJobDataQualityIssues[0] != &e {
 		t.Error("relationship to e should have been preserved")
 	}
 }
 
-func testDatahistoryjobToManyAddOpJobDatahistoryjobresults(t *testing.T) {
+func testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1860,15 +1963,15 @@ func testDatahistoryjobToManyAddOpJobDatahistoryjobresults(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Datahistoryjob
-	var b, c, d, e Datahistoryjobresult
+	var b, c, d, e Datahistoryjob
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjobresult{&b, &c, &d, &e}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobresultDBTypes, false, strmangle.SetComplement(datahistoryjobresultPrimaryKeyColumns, datahistoryjobresultColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1883,13 +1986,13 @@ func testDatahistoryjobToManyAddOpJobDatahistoryjobresults(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Datahistoryjobresult{
+	foreignersSplitByInsertion := [][]*Datahistoryjob{
 		{&b, &c},
 		{&d, &e},
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddJobDatahistoryjobresults(ctx, tx, i != 0, x...)
+		err = a.AddPrerequisiteJobDatahistoryjobs(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1897,28 +2000,21 @@ func testDatahistoryjobToManyAddOpJobDatahistoryjobresults(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if a.ID != first.JobID {
-			t.Error("foreign key was wrong value", a.ID, first.JobID)
-		}
-		if a.ID != second.JobID {
-			t.Error("foreign key was wrong value", a.ID, second.JobID)
-		}
-
-		if first.R.Job != &a {
-			t.Error("relationship was not added properly to the foreign slice")
+		if first.R.JobDatahistoryjobs[0] != &a {
+			t.Error("relationship was not added properly to the slice")
 		}
-		if second.R.Job != &a {
-			t.Error("relationship was not added properly to the foreign slice")
+		if second.R.JobDatahistoryjobs[0] != &a {
+			t.Error("relationship was not added properly to the slice")
 		}
 
-		if a.R.JobDatahistoryjobresults[i*2] != first {
+		if a.R.PrerequisiteJobDatahistoryjobs[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.JobDatahistoryjobresults[i*2+1] != second {
+		if a.R.PrerequisiteJobDatahistoryjobs[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.JobDatahistoryjobresults().Count(ctx, tx)
+		count, err := a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1927,6 +2023,1222 @@ func testDatahistoryjobToManyAddOpJobDatahistoryjobresults(t *testing.T) {
 		}
 	}
 }
+
+func testDatahistoryjobToManySetOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetPrerequisiteJobDatahistoryjobs(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetPrerequisiteJobDatahistoryjobs(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	// The following checks cannot be implemented since we have no handle
+	// to these when we call Set(). Leaving them here as wishful thinking
+	// and to let people know there's dragons.
+	//
+	// if len(b.R.JobDatahistoryjobs) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	// if len(c.R.JobDatahistoryjobs) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	if d.R.JobDatahistoryjobs[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+	if e.R.JobDatahistoryjobs[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+
+	if a.R.PrerequisiteJobDatahistoryjobs[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.PrerequisiteJobDatahistoryjobs[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testDatahistoryjobToManyRemoveOpPrerequisiteJobDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddPrerequisiteJobDatahistoryjobs(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemovePrerequisiteJobDatahistoryjobs(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.PrerequisiteJobDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if len(b.R.JobDatahistoryjobs) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if len(c.R.JobDatahistoryjobs) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if d.R.JobDatahistoryjobs[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.JobDatahistoryjobs[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if len(a.R.PrerequisiteJobDatahistoryjobs) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.PrerequisiteJobDatahistoryjobs[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.PrerequisiteJobDatahistoryjobs[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testDatahistoryjobToManyAddOpJobDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Datahistoryjob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddJobDatahistoryjobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if first.R.PrerequisiteJobDatahistoryjobs[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+		if second.R.PrerequisiteJobDatahistoryjobs[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+
+		if a.R.JobDatahistoryjobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.JobDatahistoryjobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.JobDatahistoryjobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testDatahistoryjobToManySetOpJobDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetJobDatahistoryjobs(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.JobDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetJobDatahistoryjobs(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.JobDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	// The following checks cannot be implemented since we have no handle
+	// to these when we call Set(). Leaving them here as wishful thinking
+	// and to let people know there's dragons.
+	//
+	// if len(b.R.PrerequisiteJobDatahistoryjobs) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	// if len(c.R.PrerequisiteJobDatahistoryjobs) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	if d.R.PrerequisiteJobDatahistoryjobs[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+	if e.R.PrerequisiteJobDatahistoryjobs[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+
+	if a.R.JobDatahistoryjobs[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.JobDatahistoryjobs[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testDatahistoryjobToManyRemoveOpJobDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddJobDatahistoryjobs(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.JobDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveJobDatahistoryjobs(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.JobDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if len(b.R.PrerequisiteJobDatahistoryjobs) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if len(c.R.PrerequisiteJobDatahistoryjobs) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if d.R.PrerequisiteJobDatahistoryjobs[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.PrerequisiteJobDatahistoryjobs[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if len(a.R.JobDatahistoryjobs) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.JobDatahistoryjobs[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.JobDatahistoryjobs[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testDatahistoryjobToManyAddOpJobDatahistoryjobresults(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e Datahistoryjobresult
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjobresult{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobresultDBTypes, false, strmangle.SetComplement(datahistoryjobresultPrimaryKeyColumns, datahistoryjobresultColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Datahistoryjobresult{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddJobDatahistoryjobresults(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.JobID {
+			t.Error("foreign key was wrong value", a.ID, first.JobID)
+		}
+		if a.ID != second.JobID {
+			t.Error("foreign key was wrong value", a.ID, second.JobID)
+		}
+
+		if first.R.Job != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Job != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.JobDatahistoryjobresults[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.JobDatahistoryjobresults[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.JobDatahistoryjobresults().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testDatahistoryjobToManyAddOpSourceJobFundingRates(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e FundingRate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FundingRate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, fundingRateDBTypes, false, strmangle.SetComplement(fundingRatePrimaryKeyColumns, fundingRateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*FundingRate{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSourceJobFundingRates(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.SourceJobID)
+		}
+		if !queries.Equal(a.ID, second.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.SourceJobID)
+		}
+
+		if first.R.SourceJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SourceJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SourceJobFundingRates[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SourceJobFundingRates[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SourceJobFundingRates().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testDatahistoryjobToManySetOpSourceJobFundingRates(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e FundingRate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FundingRate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, fundingRateDBTypes, false, strmangle.SetComplement(fundingRatePrimaryKeyColumns, fundingRateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSourceJobFundingRates(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SourceJobFundingRates().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSourceJobFundingRates(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SourceJobFundingRates().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SourceJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SourceJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.SourceJobID)
+	}
+	if !queries.Equal(a.ID, e.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.SourceJobID)
+	}
+
+	if b.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SourceJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.SourceJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SourceJobFundingRates[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SourceJobFundingRates[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testDatahistoryjobToManyRemoveOpSourceJobFundingRates(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e FundingRate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FundingRate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, fundingRateDBTypes, false, strmangle.SetComplement(fundingRatePrimaryKeyColumns, fundingRateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSourceJobFundingRates(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SourceJobFundingRates().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSourceJobFundingRates(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SourceJobFundingRates().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SourceJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SourceJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SourceJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.SourceJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SourceJobFundingRates) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SourceJobFundingRates[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SourceJobFundingRates[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testDatahistoryjobToManyAddOpSourceJobOpenInterests(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e OpenInterest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OpenInterest{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, openInterestDBTypes, false, strmangle.SetComplement(openInterestPrimaryKeyColumns, openInterestColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OpenInterest{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSourceJobOpenInterests(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.SourceJobID)
+		}
+		if !queries.Equal(a.ID, second.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.SourceJobID)
+		}
+
+		if first.R.SourceJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SourceJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SourceJobOpenInterests[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SourceJobOpenInterests[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SourceJobOpenInterests().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testDatahistoryjobToManySetOpSourceJobOpenInterests(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e OpenInterest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OpenInterest{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, openInterestDBTypes, false, strmangle.SetComplement(openInterestPrimaryKeyColumns, openInterestColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSourceJobOpenInterests(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SourceJobOpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSourceJobOpenInterests(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SourceJobOpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SourceJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SourceJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.SourceJobID)
+	}
+	if !queries.Equal(a.ID, e.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.SourceJobID)
+	}
+
+	if b.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SourceJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.SourceJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SourceJobOpenInterests[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SourceJobOpenInterests[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testDatahistoryjobToManyRemoveOpSourceJobOpenInterests(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e OpenInterest
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OpenInterest{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, openInterestDBTypes, false, strmangle.SetComplement(openInterestPrimaryKeyColumns, openInterestColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSourceJobOpenInterests(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SourceJobOpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSourceJobOpenInterests(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SourceJobOpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SourceJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SourceJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SourceJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.SourceJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SourceJobOpenInterests) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SourceJobOpenInterests[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SourceJobOpenInterests[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testDatahistoryjobToManyAddOpSourceJobPriceCandles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e PriceCandle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PriceCandle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, priceCandleDBTypes, false, strmangle.SetComplement(priceCandlePrimaryKeyColumns, priceCandleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*PriceCandle{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSourceJobPriceCandles(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.SourceJobID)
+		}
+		if !queries.Equal(a.ID, second.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.SourceJobID)
+		}
+
+		if first.R.SourceJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SourceJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SourceJobPriceCandles[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SourceJobPriceCandles[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SourceJobPriceCandles().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testDatahistoryjobToManySetOpSourceJobPriceCandles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e PriceCandle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PriceCandle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, priceCandleDBTypes, false, strmangle.SetComplement(priceCandlePrimaryKeyColumns, priceCandleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSourceJobPriceCandles(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SourceJobPriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSourceJobPriceCandles(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SourceJobPriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SourceJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SourceJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.SourceJobID)
+	}
+	if !queries.Equal(a.ID, e.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.SourceJobID)
+	}
+
+	if b.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SourceJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.SourceJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SourceJobPriceCandles[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SourceJobPriceCandles[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testDatahistoryjobToManyRemoveOpSourceJobPriceCandles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e PriceCandle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*PriceCandle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, priceCandleDBTypes, false, strmangle.SetComplement(priceCandlePrimaryKeyColumns, priceCandleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSourceJobPriceCandles(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SourceJobPriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSourceJobPriceCandles(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SourceJobPriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SourceJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SourceJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SourceJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.SourceJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SourceJobPriceCandles) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SourceJobPriceCandles[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SourceJobPriceCandles[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testDatahistoryjobToOneExchangeUsingExchangeName(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -51,14 +51,22 @@ var ExchangeWhere = struct {
 // ExchangeRels is where relationship names are stored.
 var ExchangeRels = struct {
 	ExchangeNameCandles              string
+	ExchangeNameDataQualityReports   string
 	ExchangeNameDatahistoryjobs      string
 	SecondaryExchangeDatahistoryjobs string
+	ExchangeNameFundingRates         string
+	ExchangeNameOpenInterests        string
+	ExchangeNamePriceCandles         string
 	ExchangeNameTrades               string
 	ExchangeNameWithdrawalHistories  string
 }{
 	ExchangeNameCandles:              "ExchangeNameCandles",
+	ExchangeNameDataQualityReports:   "ExchangeNameDataQualityReports",
 	ExchangeNameDatahistoryjobs:      "ExchangeNameDatahistoryjobs",
 	SecondaryExchangeDatahistoryjobs: "SecondaryExchangeDatahistoryjobs",
+	ExchangeNameFundingRates:         "ExchangeNameFundingRates",
+	ExchangeNameOpenInterests:        "ExchangeNameOpenInterests",
+	ExchangeNamePriceCandles:         "ExchangeNamePriceCandles",
 	ExchangeNameTrades:               "ExchangeNameTrades",
 	ExchangeNameWithdrawalHistories:  "ExchangeNameWithdrawalHistories",
 }
@@ -66,8 +74,12 @@ var ExchangeRels = struct {
 // exchangeR is where relationships are stored.
 type exchangeR struct {
 	ExchangeNameCandles              CandleSlice
+	ExchangeNameDataQualityReports   DataQualityReportSlice
 	ExchangeNameDatahistoryjobs      DatahistoryjobSlice
 	SecondaryExchangeDatahistoryjobs DatahistoryjobSlice
+	ExchangeNameFundingRates         FundingRateSlice
+	ExchangeNameOpenInterests        OpenInterestSlice
+	ExchangeNamePriceCandles         PriceCandleSlice
 	ExchangeNameTrades               TradeSlice
 	ExchangeNameWithdrawalHistories  WithdrawalHistorySlice
 }
@@ -383,6 +395,27 @@ func (o *Exchange) ExchangeNameCandles(mods ...qm.QueryMod) candleQuery {
 	return query
 }
 
+// ExchangeNameDataQualityReports retrieves all the data_quality_report's DataQualityReports with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameDataQualityReports(mods ...qm.QueryMod) dataQualityReportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"data_quality_report\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := DataQualityReports(queryMods...)
+	queries.SetFrom(query.Query, "\"data_quality_report\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"data_quality_report\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameDatahistoryjobs retrieves all the datahistoryjob's Datahistoryjobs with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameDatahistoryjobs(mods ...qm.QueryMod) datahistoryjobQuery {
 	var queryMods []qm.QueryMod
@@ -425,6 +458,69 @@ func (o *Exchange) SecondaryExchangeDatahistoryjobs(mods ...qm.QueryMod) datahis
 	return query
 }
 
+// ExchangeNameFundingRates retrieves all the funding_rate's FundingRates with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameFundingRates(mods ...qm.QueryMod) fundingRateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"funding_rate\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := FundingRates(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_rate\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"funding_rate\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameOpenInterests retrieves all the open_interest's OpenInterests with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameOpenInterests(mods ...qm.QueryMod) openInterestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"open_interest\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := OpenInterests(queryMods...)
+	queries.SetFrom(query.Query, "\"open_interest\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"open_interest\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNamePriceCandles retrieves all the price_candle's PriceCandles with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNamePriceCandles(mods ...qm.QueryMod) priceCandleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"price_candle\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := PriceCandles(queryMods...)
+	queries.SetFrom(query.Query, "\"price_candle\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"price_candle\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameTrades retrieves all the trade's Trades with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameTrades(mods ...qm.QueryMod) tradeQuery {
 	var queryMods []qm.QueryMod
@@ -453,23 +549,403 @@ func (o *Exchange) ExchangeNameWithdrawalHistories(mods ...qm.QueryMod) withdraw
 		queryMods = append(queryMods, mods...)
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"withdrawal_history\".\"exchange_name_id\"=?", o.ID),
-	)
-
-	query := WithdrawalHistories(queryMods...)
-	queries.SetFrom(query.Query, "\"withdrawal_history\"")
-
-	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"withdrawal_history\".*"})
+	queryMods = append(queryMods,
+		qm.Where("\"withdrawal_history\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := WithdrawalHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"withdrawal_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"withdrawal_history\".*"})
+	}
+
+	return query
+}
+
+// LoadExchangeNameCandles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameCandles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`candle`), qm.WhereIn(`candle.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load candle")
+	}
+
+	var resultSlice []*Candle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice candle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on candle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for candle")
+	}
+
+	if len(candleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameCandles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &candleR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameCandles = append(local.R.ExchangeNameCandles, foreign)
+				if foreign.R == nil {
+					foreign.R = &candleR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameDataQualityReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameDataQualityReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`data_quality_report`), qm.WhereIn(`data_quality_report.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_quality_report")
+	}
+
+	var resultSlice []*DataQualityReport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_quality_report")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_quality_report")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_quality_report")
+	}
+
+	if len(dataQualityReportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameDataQualityReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataQualityReportR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameDataQualityReports = append(local.R.ExchangeNameDataQualityReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataQualityReportR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameDatahistoryjobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load datahistoryjob")
+	}
+
+	var resultSlice []*Datahistoryjob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+	}
+
+	if len(datahistoryjobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameDatahistoryjobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &datahistoryjobR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameDatahistoryjobs = append(local.R.ExchangeNameDatahistoryjobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &datahistoryjobR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSecondaryExchangeDatahistoryjobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.secondary_exchange_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load datahistoryjob")
+	}
+
+	var resultSlice []*Datahistoryjob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+	}
+
+	if len(datahistoryjobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SecondaryExchangeDatahistoryjobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &datahistoryjobR{}
+			}
+			foreign.R.SecondaryExchange = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SecondaryExchangeID) {
+				local.R.SecondaryExchangeDatahistoryjobs = append(local.R.SecondaryExchangeDatahistoryjobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &datahistoryjobR{}
+				}
+				foreign.R.SecondaryExchange = local
+				break
+			}
+		}
 	}
 
-	return query
+	return nil
 }
 
-// LoadExchangeNameCandles allows an eager lookup of values, cached into the
+// LoadExchangeNameFundingRates allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameCandles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameFundingRates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -506,29 +982,29 @@ func (exchangeL) LoadExchangeNameCandles(ctx context.Context, e boil.ContextExec
 		return nil
 	}
 
-	query := NewQuery(qm.From(`candle`), qm.WhereIn(`candle.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`funding_rate`), qm.WhereIn(`funding_rate.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load candle")
+		return errors.Wrap(err, "failed to eager load funding_rate")
 	}
 
-	var resultSlice []*Candle
+	var resultSlice []*FundingRate
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice candle")
+		return errors.Wrap(err, "failed to bind eager loaded slice funding_rate")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on candle")
+		return errors.Wrap(err, "failed to close results in eager load on funding_rate")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for candle")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_rate")
 	}
 
-	if len(candleAfterSelectHooks) != 0 {
+	if len(fundingRateAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -536,10 +1012,10 @@ func (exchangeL) LoadExchangeNameCandles(ctx context.Context, e boil.ContextExec
 		}
 	}
 	if singular {
-		object.R.ExchangeNameCandles = resultSlice
+		object.R.ExchangeNameFundingRates = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &candleR{}
+				foreign.R = &fundingRateR{}
 			}
 			foreign.R.ExchangeName = object
 		}
@@ -549,9 +1025,9 @@ func (exchangeL) LoadExchangeNameCandles(ctx context.Context, e boil.ContextExec
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameCandles = append(local.R.ExchangeNameCandles, foreign)
+				local.R.ExchangeNameFundingRates = append(local.R.ExchangeNameFundingRates, foreign)
 				if foreign.R == nil {
-					foreign.R = &candleR{}
+					foreign.R = &fundingRateR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -562,9 +1038,9 @@ func (exchangeL) LoadExchangeNameCandles(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
-// LoadExchangeNameDatahistoryjobs allows an eager lookup of values, cached into the
+// LoadExchangeNameOpenInterests allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameOpenInterests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -601,29 +1077,29 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 		return nil
 	}
 
-	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`open_interest`), qm.WhereIn(`open_interest.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load datahistoryjob")
+		return errors.Wrap(err, "failed to eager load open_interest")
 	}
 
-	var resultSlice []*Datahistoryjob
+	var resultSlice []*OpenInterest
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+		return errors.Wrap(err, "failed to bind eager loaded slice open_interest")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+		return errors.Wrap(err, "failed to close results in eager load on open_interest")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for open_interest")
 	}
 
-	if len(datahistoryjobAfterSelectHooks) != 0 {
+	if len(openInterestAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -631,10 +1107,10 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 		}
 	}
 	if singular {
-		object.R.ExchangeNameDatahistoryjobs = resultSlice
+		object.R.ExchangeNameOpenInterests = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &datahistoryjobR{}
+				foreign.R = &openInterestR{}
 			}
 			foreign.R.ExchangeName = object
 		}
@@ -644,9 +1120,9 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameDatahistoryjobs = append(local.R.ExchangeNameDatahistoryjobs, foreign)
+				local.R.ExchangeNameOpenInterests = append(local.R.ExchangeNameOpenInterests, foreign)
 				if foreign.R == nil {
-					foreign.R = &datahistoryjobR{}
+					foreign.R = &openInterestR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -657,9 +1133,9 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 	return nil
 }
 
-// LoadSecondaryExchangeDatahistoryjobs allows an eager lookup of values, cached into the
+// LoadExchangeNamePriceCandles allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNamePriceCandles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -683,7 +1159,7 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -696,29 +1172,29 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 		return nil
 	}
 
-	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.secondary_exchange_id in ?`, args...))
+	query := NewQuery(qm.From(`price_candle`), qm.WhereIn(`price_candle.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load datahistoryjob")
+		return errors.Wrap(err, "failed to eager load price_candle")
 	}
 
-	var resultSlice []*Datahistoryjob
+	var resultSlice []*PriceCandle
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+		return errors.Wrap(err, "failed to bind eager loaded slice price_candle")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+		return errors.Wrap(err, "failed to close results in eager load on price_candle")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for price_candle")
 	}
 
-	if len(datahistoryjobAfterSelectHooks) != 0 {
+	if len(priceCandleAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -726,24 +1202,24 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 		}
 	}
 	if singular {
-		object.R.SecondaryExchangeDatahistoryjobs = resultSlice
+		object.R.ExchangeNamePriceCandles = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &datahistoryjobR{}
+				foreign.R = &priceCandleR{}
 			}
-			foreign.R.SecondaryExchange = object
+			foreign.R.ExchangeName = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.SecondaryExchangeID) {
-				local.R.SecondaryExchangeDatahistoryjobs = append(local.R.SecondaryExchangeDatahistoryjobs, foreign)
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNamePriceCandles = append(local.R.ExchangeNamePriceCandles, foreign)
 				if foreign.R == nil {
-					foreign.R = &datahistoryjobR{}
+					foreign.R = &priceCandleR{}
 				}
-				foreign.R.SecondaryExchange = local
+				foreign.R.ExchangeName = local
 				break
 			}
 		}
@@ -995,6 +1471,59 @@ func (o *Exchange) AddExchangeNameCandles(ctx context.Context, exec boil.Context
 	return nil
 }
 
+// AddExchangeNameDataQualityReports adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameDataQualityReports.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameDataQualityReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataQualityReport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"data_quality_report\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dataQualityReportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameDataQualityReports: related,
+		}
+	} else {
+		o.R.ExchangeNameDataQualityReports = append(o.R.ExchangeNameDataQualityReports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataQualityReportR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameDatahistoryjobs adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameDatahistoryjobs.
@@ -1171,6 +1700,165 @@ func (o *Exchange) RemoveSecondaryExchangeDatahistoryjobs(ctx context.Context, e
 	return nil
 }
 
+// AddExchangeNameFundingRates adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameFundingRates.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameFundingRates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingRate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"funding_rate\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fundingRatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFundingRates: related,
+		}
+	} else {
+		o.R.ExchangeNameFundingRates = append(o.R.ExchangeNameFundingRates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fundingRateR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameOpenInterests adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameOpenInterests.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameOpenInterests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OpenInterest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"open_interest\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, openInterestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameOpenInterests: related,
+		}
+	} else {
+		o.R.ExchangeNameOpenInterests = append(o.R.ExchangeNameOpenInterests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &openInterestR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNamePriceCandles adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNamePriceCandles.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNamePriceCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PriceCandle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"price_candle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, priceCandlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNamePriceCandles: related,
+		}
+	} else {
+		o.R.ExchangeNamePriceCandles = append(o.R.ExchangeNamePriceCandles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &priceCandleR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameTrades adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameTrades.
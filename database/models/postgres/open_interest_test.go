@@ -0,0 +1,1000 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOpenInterests(t *testing.T) {
+	t.Parallel()
+
+	query := OpenInterests()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOpenInterestsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOpenInterestsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OpenInterests().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOpenInterestsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OpenInterestSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOpenInterestsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OpenInterestExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if OpenInterest exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OpenInterestExists to return true, but got false.")
+	}
+}
+
+func testOpenInterestsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	openInterestFound, err := FindOpenInterest(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if openInterestFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOpenInterestsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OpenInterests().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOpenInterestsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OpenInterests().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOpenInterestsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	openInterestOne := &OpenInterest{}
+	openInterestTwo := &OpenInterest{}
+	if err = randomize.Struct(seed, openInterestOne, openInterestDBTypes, false, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+	if err = randomize.Struct(seed, openInterestTwo, openInterestDBTypes, false, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = openInterestOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = openInterestTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OpenInterests().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOpenInterestsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	openInterestOne := &OpenInterest{}
+	openInterestTwo := &OpenInterest{}
+	if err = randomize.Struct(seed, openInterestOne, openInterestDBTypes, false, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+	if err = randomize.Struct(seed, openInterestTwo, openInterestDBTypes, false, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = openInterestOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = openInterestTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func openInterestBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OpenInterest) error {
+	*o = OpenInterest{}
+	return nil
+}
+
+func openInterestAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OpenInterest) error {
+	*o = OpenInterest{}
+	return nil
+}
+
+func openInterestAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OpenInterest) error {
+	*o = OpenInterest{}
+	return nil
+}
+
+func openInterestBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OpenInterest) error {
+	*o = OpenInterest{}
+	return nil
+}
+
+func openInterestAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OpenInterest) error {
+	*o = OpenInterest{}
+	return nil
+}
+
+func openInterestBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OpenInterest) error {
+	*o = OpenInterest{}
+	return nil
+}
+
+func openInterestAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OpenInterest) error {
+	*o = OpenInterest{}
+	return nil
+}
+
+func openInterestBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OpenInterest) error {
+	*o = OpenInterest{}
+	return nil
+}
+
+func openInterestAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OpenInterest) error {
+	*o = OpenInterest{}
+	return nil
+}
+
+func testOpenInterestsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OpenInterest{}
+	o := &OpenInterest{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, openInterestDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OpenInterest object: %s", err)
+	}
+
+	AddOpenInterestHook(boil.BeforeInsertHook, openInterestBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	openInterestBeforeInsertHooks = []OpenInterestHook{}
+
+	AddOpenInterestHook(boil.AfterInsertHook, openInterestAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	openInterestAfterInsertHooks = []OpenInterestHook{}
+
+	AddOpenInterestHook(boil.AfterSelectHook, openInterestAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	openInterestAfterSelectHooks = []OpenInterestHook{}
+
+	AddOpenInterestHook(boil.BeforeUpdateHook, openInterestBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	openInterestBeforeUpdateHooks = []OpenInterestHook{}
+
+	AddOpenInterestHook(boil.AfterUpdateHook, openInterestAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	openInterestAfterUpdateHooks = []OpenInterestHook{}
+
+	AddOpenInterestHook(boil.BeforeDeleteHook, openInterestBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	openInterestBeforeDeleteHooks = []OpenInterestHook{}
+
+	AddOpenInterestHook(boil.AfterDeleteHook, openInterestAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	openInterestAfterDeleteHooks = []OpenInterestHook{}
+
+	AddOpenInterestHook(boil.BeforeUpsertHook, openInterestBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	openInterestBeforeUpsertHooks = []OpenInterestHook{}
+
+	AddOpenInterestHook(boil.AfterUpsertHook, openInterestAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	openInterestAfterUpsertHooks = []OpenInterestHook{}
+}
+
+func testOpenInterestsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOpenInterestsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(openInterestColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOpenInterestToOneExchangeUsingExchangeName(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OpenInterest
+	var foreign Exchange
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, openInterestDBTypes, false, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, exchangeDBTypes, false, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ExchangeNameID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ExchangeName().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OpenInterestSlice{&local}
+	if err = local.L.LoadExchangeName(ctx, tx, false, (*[]*OpenInterest)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ExchangeName = nil
+	if err = local.L.LoadExchangeName(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOpenInterestToOneDatahistoryjobUsingSourceJob(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OpenInterest
+	var foreign Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, datahistoryjobDBTypes, false, datahistoryjobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Datahistoryjob struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.SourceJobID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.SourceJob().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := OpenInterestSlice{&local}
+	if err = local.L.LoadSourceJob(ctx, tx, false, (*[]*OpenInterest)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.SourceJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.SourceJob = nil
+	if err = local.L.LoadSourceJob(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.SourceJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testOpenInterestToOneSetOpExchangeUsingExchangeName(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OpenInterest
+	var b, c Exchange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, openInterestDBTypes, false, strmangle.SetComplement(openInterestPrimaryKeyColumns, openInterestColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Exchange{&b, &c} {
+		err = a.SetExchangeName(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ExchangeName != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExchangeNameOpenInterests[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ExchangeNameID))
+		reflect.Indirect(reflect.ValueOf(&a.ExchangeNameID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID, x.ID)
+		}
+	}
+}
+func testOpenInterestToOneSetOpDatahistoryjobUsingSourceJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OpenInterest
+	var b, c Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, openInterestDBTypes, false, strmangle.SetComplement(openInterestPrimaryKeyColumns, openInterestColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Datahistoryjob{&b, &c} {
+		err = a.SetSourceJob(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.SourceJob != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SourceJobOpenInterests[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.SourceJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.SourceJobID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SourceJobID))
+		reflect.Indirect(reflect.ValueOf(&a.SourceJobID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.SourceJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.SourceJobID, x.ID)
+		}
+	}
+}
+
+func testOpenInterestToOneRemoveOpDatahistoryjobUsingSourceJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OpenInterest
+	var b Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, openInterestDBTypes, false, strmangle.SetComplement(openInterestPrimaryKeyColumns, openInterestColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetSourceJob(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveSourceJob(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.SourceJob().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.SourceJob != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.SourceJobID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.SourceJobOpenInterests) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testOpenInterestsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOpenInterestsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OpenInterestSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOpenInterestsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OpenInterests().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	openInterestDBTypes = map[string]string{`ID`: `uuid`, `ExchangeNameID`: `uuid`, `Base`: `character varying`, `Quote`: `character varying`, `Asset`: `character varying`, `Timestamp`: `timestamp with time zone`, `OpenInterest`: `double precision`, `SourceJobID`: `uuid`}
+	_                   = bytes.MinRead
+)
+
+func testOpenInterestsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(openInterestPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(openInterestAllColumns) == len(openInterestPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOpenInterestsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(openInterestAllColumns) == len(openInterestPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OpenInterest{}
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, openInterestDBTypes, true, openInterestPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(openInterestAllColumns, openInterestPrimaryKeyColumns) {
+		fields = openInterestAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			openInterestAllColumns,
+			openInterestPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OpenInterestSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOpenInterestsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(openInterestAllColumns) == len(openInterestPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OpenInterest{}
+	if err = randomize.Struct(seed, &o, openInterestDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OpenInterest: %s", err)
+	}
+
+	count, err := OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, openInterestDBTypes, false, openInterestPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OpenInterest struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OpenInterest: %s", err)
+	}
+
+	count, err = OpenInterests().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1000 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPriceCandles(t *testing.T) {
+	t.Parallel()
+
+	query := PriceCandles()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPriceCandlesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPriceCandlesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PriceCandles().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPriceCandlesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PriceCandleSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPriceCandlesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PriceCandleExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PriceCandle exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PriceCandleExists to return true, but got false.")
+	}
+}
+
+func testPriceCandlesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	priceCandleFound, err := FindPriceCandle(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if priceCandleFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPriceCandlesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PriceCandles().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPriceCandlesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PriceCandles().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPriceCandlesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	priceCandleOne := &PriceCandle{}
+	priceCandleTwo := &PriceCandle{}
+	if err = randomize.Struct(seed, priceCandleOne, priceCandleDBTypes, false, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+	if err = randomize.Struct(seed, priceCandleTwo, priceCandleDBTypes, false, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = priceCandleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = priceCandleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PriceCandles().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPriceCandlesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	priceCandleOne := &PriceCandle{}
+	priceCandleTwo := &PriceCandle{}
+	if err = randomize.Struct(seed, priceCandleOne, priceCandleDBTypes, false, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+	if err = randomize.Struct(seed, priceCandleTwo, priceCandleDBTypes, false, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = priceCandleOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = priceCandleTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func priceCandleBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceCandle) error {
+	*o = PriceCandle{}
+	return nil
+}
+
+func priceCandleAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceCandle) error {
+	*o = PriceCandle{}
+	return nil
+}
+
+func priceCandleAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PriceCandle) error {
+	*o = PriceCandle{}
+	return nil
+}
+
+func priceCandleBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PriceCandle) error {
+	*o = PriceCandle{}
+	return nil
+}
+
+func priceCandleAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PriceCandle) error {
+	*o = PriceCandle{}
+	return nil
+}
+
+func priceCandleBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PriceCandle) error {
+	*o = PriceCandle{}
+	return nil
+}
+
+func priceCandleAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PriceCandle) error {
+	*o = PriceCandle{}
+	return nil
+}
+
+func priceCandleBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceCandle) error {
+	*o = PriceCandle{}
+	return nil
+}
+
+func priceCandleAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceCandle) error {
+	*o = PriceCandle{}
+	return nil
+}
+
+func testPriceCandlesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PriceCandle{}
+	o := &PriceCandle{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PriceCandle object: %s", err)
+	}
+
+	AddPriceCandleHook(boil.BeforeInsertHook, priceCandleBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	priceCandleBeforeInsertHooks = []PriceCandleHook{}
+
+	AddPriceCandleHook(boil.AfterInsertHook, priceCandleAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	priceCandleAfterInsertHooks = []PriceCandleHook{}
+
+	AddPriceCandleHook(boil.AfterSelectHook, priceCandleAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	priceCandleAfterSelectHooks = []PriceCandleHook{}
+
+	AddPriceCandleHook(boil.BeforeUpdateHook, priceCandleBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	priceCandleBeforeUpdateHooks = []PriceCandleHook{}
+
+	AddPriceCandleHook(boil.AfterUpdateHook, priceCandleAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	priceCandleAfterUpdateHooks = []PriceCandleHook{}
+
+	AddPriceCandleHook(boil.BeforeDeleteHook, priceCandleBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	priceCandleBeforeDeleteHooks = []PriceCandleHook{}
+
+	AddPriceCandleHook(boil.AfterDeleteHook, priceCandleAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	priceCandleAfterDeleteHooks = []PriceCandleHook{}
+
+	AddPriceCandleHook(boil.BeforeUpsertHook, priceCandleBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	priceCandleBeforeUpsertHooks = []PriceCandleHook{}
+
+	AddPriceCandleHook(boil.AfterUpsertHook, priceCandleAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	priceCandleAfterUpsertHooks = []PriceCandleHook{}
+}
+
+func testPriceCandlesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPriceCandlesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(priceCandleColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPriceCandleToOneExchangeUsingExchangeName(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PriceCandle
+	var foreign Exchange
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, priceCandleDBTypes, false, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, exchangeDBTypes, false, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ExchangeNameID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ExchangeName().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PriceCandleSlice{&local}
+	if err = local.L.LoadExchangeName(ctx, tx, false, (*[]*PriceCandle)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ExchangeName = nil
+	if err = local.L.LoadExchangeName(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPriceCandleToOneDatahistoryjobUsingSourceJob(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local PriceCandle
+	var foreign Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, datahistoryjobDBTypes, false, datahistoryjobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Datahistoryjob struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.SourceJobID, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.SourceJob().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := PriceCandleSlice{&local}
+	if err = local.L.LoadSourceJob(ctx, tx, false, (*[]*PriceCandle)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.SourceJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.SourceJob = nil
+	if err = local.L.LoadSourceJob(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.SourceJob == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testPriceCandleToOneSetOpExchangeUsingExchangeName(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PriceCandle
+	var b, c Exchange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, priceCandleDBTypes, false, strmangle.SetComplement(priceCandlePrimaryKeyColumns, priceCandleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Exchange{&b, &c} {
+		err = a.SetExchangeName(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ExchangeName != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExchangeNamePriceCandles[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ExchangeNameID))
+		reflect.Indirect(reflect.ValueOf(&a.ExchangeNameID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID, x.ID)
+		}
+	}
+}
+func testPriceCandleToOneSetOpDatahistoryjobUsingSourceJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PriceCandle
+	var b, c Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, priceCandleDBTypes, false, strmangle.SetComplement(priceCandlePrimaryKeyColumns, priceCandleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Datahistoryjob{&b, &c} {
+		err = a.SetSourceJob(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.SourceJob != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.SourceJobPriceCandles[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.SourceJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.SourceJobID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.SourceJobID))
+		reflect.Indirect(reflect.ValueOf(&a.SourceJobID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.SourceJobID, x.ID) {
+			t.Error("foreign key was wrong value", a.SourceJobID, x.ID)
+		}
+	}
+}
+
+func testPriceCandleToOneRemoveOpDatahistoryjobUsingSourceJob(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a PriceCandle
+	var b Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, priceCandleDBTypes, false, strmangle.SetComplement(priceCandlePrimaryKeyColumns, priceCandleColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetSourceJob(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveSourceJob(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.SourceJob().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.SourceJob != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.SourceJobID) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.SourceJobPriceCandles) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
+func testPriceCandlesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPriceCandlesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PriceCandleSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPriceCandlesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PriceCandles().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	priceCandleDBTypes = map[string]string{`ID`: `uuid`, `ExchangeNameID`: `uuid`, `Base`: `character varying`, `Quote`: `character varying`, `Asset`: `character varying`, `PriceType`: `character varying`, `Interval`: `bigint`, `Timestamp`: `timestamp with time zone`, `Open`: `double precision`, `High`: `double precision`, `Low`: `double precision`, `Close`: `double precision`, `SourceJobID`: `uuid`}
+	_                  = bytes.MinRead
+)
+
+func testPriceCandlesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(priceCandlePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(priceCandleAllColumns) == len(priceCandlePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandlePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPriceCandlesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(priceCandleAllColumns) == len(priceCandlePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceCandle{}
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandleColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, priceCandleDBTypes, true, priceCandlePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(priceCandleAllColumns, priceCandlePrimaryKeyColumns) {
+		fields = priceCandleAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			priceCandleAllColumns,
+			priceCandlePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PriceCandleSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPriceCandlesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(priceCandleAllColumns) == len(priceCandlePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PriceCandle{}
+	if err = randomize.Struct(seed, &o, priceCandleDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PriceCandle: %s", err)
+	}
+
+	count, err := PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, priceCandleDBTypes, false, priceCandlePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PriceCandle struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PriceCandle: %s", err)
+	}
+
+	count, err = PriceCandles().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testScriptStates(t *testing.T) {
+	t.Parallel()
+
+	query := ScriptStates()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testScriptStatesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testScriptStatesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := ScriptStates().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testScriptStatesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ScriptStateSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testScriptStatesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := ScriptStateExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if ScriptState exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected ScriptStateExists to return true, but got false.")
+	}
+}
+
+func testScriptStatesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	scriptStateFound, err := FindScriptState(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if scriptStateFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testScriptStatesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = ScriptStates().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testScriptStatesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := ScriptStates().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testScriptStatesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	scriptStateOne := &ScriptState{}
+	scriptStateTwo := &ScriptState{}
+	if err = randomize.Struct(seed, scriptStateOne, scriptStateDBTypes, false, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+	if err = randomize.Struct(seed, scriptStateTwo, scriptStateDBTypes, false, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = scriptStateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = scriptStateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ScriptStates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testScriptStatesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	scriptStateOne := &ScriptState{}
+	scriptStateTwo := &ScriptState{}
+	if err = randomize.Struct(seed, scriptStateOne, scriptStateDBTypes, false, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+	if err = randomize.Struct(seed, scriptStateTwo, scriptStateDBTypes, false, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = scriptStateOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = scriptStateTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func scriptStateBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *ScriptState) error {
+	*o = ScriptState{}
+	return nil
+}
+
+func scriptStateAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *ScriptState) error {
+	*o = ScriptState{}
+	return nil
+}
+
+func scriptStateAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *ScriptState) error {
+	*o = ScriptState{}
+	return nil
+}
+
+func scriptStateBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ScriptState) error {
+	*o = ScriptState{}
+	return nil
+}
+
+func scriptStateAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *ScriptState) error {
+	*o = ScriptState{}
+	return nil
+}
+
+func scriptStateBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ScriptState) error {
+	*o = ScriptState{}
+	return nil
+}
+
+func scriptStateAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *ScriptState) error {
+	*o = ScriptState{}
+	return nil
+}
+
+func scriptStateBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ScriptState) error {
+	*o = ScriptState{}
+	return nil
+}
+
+func scriptStateAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *ScriptState) error {
+	*o = ScriptState{}
+	return nil
+}
+
+func testScriptStatesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &ScriptState{}
+	o := &ScriptState{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize ScriptState object: %s", err)
+	}
+
+	AddScriptStateHook(boil.BeforeInsertHook, scriptStateBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	scriptStateBeforeInsertHooks = []ScriptStateHook{}
+
+	AddScriptStateHook(boil.AfterInsertHook, scriptStateAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	scriptStateAfterInsertHooks = []ScriptStateHook{}
+
+	AddScriptStateHook(boil.AfterSelectHook, scriptStateAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	scriptStateAfterSelectHooks = []ScriptStateHook{}
+
+	AddScriptStateHook(boil.BeforeUpdateHook, scriptStateBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	scriptStateBeforeUpdateHooks = []ScriptStateHook{}
+
+	AddScriptStateHook(boil.AfterUpdateHook, scriptStateAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	scriptStateAfterUpdateHooks = []ScriptStateHook{}
+
+	AddScriptStateHook(boil.BeforeDeleteHook, scriptStateBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	scriptStateBeforeDeleteHooks = []ScriptStateHook{}
+
+	AddScriptStateHook(boil.AfterDeleteHook, scriptStateAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	scriptStateAfterDeleteHooks = []ScriptStateHook{}
+
+	AddScriptStateHook(boil.BeforeUpsertHook, scriptStateBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	scriptStateBeforeUpsertHooks = []ScriptStateHook{}
+
+	AddScriptStateHook(boil.AfterUpsertHook, scriptStateAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	scriptStateAfterUpsertHooks = []ScriptStateHook{}
+}
+
+func testScriptStatesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testScriptStatesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(scriptStateColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testScriptStatesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testScriptStatesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := ScriptStateSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testScriptStatesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := ScriptStates().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	scriptStateDBTypes = map[string]string{`ID`: `uuid`, `ScriptName`: `character varying`, `Key`: `character varying`, `Value`: `text`, `CreatedAt`: `timestamp without time zone`, `UpdatedAt`: `timestamp without time zone`}
+	_                  = bytes.MinRead
+)
+
+func testScriptStatesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(scriptStatePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(scriptStateAllColumns) == len(scriptStatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testScriptStatesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(scriptStateAllColumns) == len(scriptStatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &ScriptState{}
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStateColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, scriptStateDBTypes, true, scriptStatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(scriptStateAllColumns, scriptStatePrimaryKeyColumns) {
+		fields = scriptStateAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			scriptStateAllColumns,
+			scriptStatePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := ScriptStateSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testScriptStatesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(scriptStateAllColumns) == len(scriptStatePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := ScriptState{}
+	if err = randomize.Struct(seed, &o, scriptStateDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ScriptState: %s", err)
+	}
+
+	count, err := ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, scriptStateDBTypes, false, scriptStatePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize ScriptState struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert ScriptState: %s", err)
+	}
+
+	count, err = ScriptStates().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -14,11 +14,17 @@ import "testing"
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
 	t.Run("Candles", testCandles)
+	t.Run("DataQualityIssues", testDataQualityIssues)
+	t.Run("DataQualityReports", testDataQualityReports)
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
 	t.Run("Exchanges", testExchanges)
+	t.Run("FundingRates", testFundingRates)
+	t.Run("OpenInterests", testOpenInterests)
+	t.Run("PriceCandles", testPriceCandles)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("ScriptStates", testScriptStates)
 	t.Run("Trades", testTrades)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptos)
 	t.Run("WithdrawalFiats", testWithdrawalFiats)
@@ -28,11 +34,17 @@ func TestParent(t *testing.T) {
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
 	t.Run("Candles", testCandlesDelete)
+	t.Run("DataQualityIssues", testDataQualityIssuesDelete)
+	t.Run("DataQualityReports", testDataQualityReportsDelete)
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("FundingRates", testFundingRatesDelete)
+	t.Run("OpenInterests", testOpenInterestsDelete)
+	t.Run("PriceCandles", testPriceCandlesDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("ScriptStates", testScriptStatesDelete)
 	t.Run("Trades", testTradesDelete)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosDelete)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsDelete)
@@ -42,11 +54,17 @@ func TestDelete(t *testing.T) {
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
 	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("DataQualityIssues", testDataQualityIssuesQueryDeleteAll)
+	t.Run("DataQualityReports", testDataQualityReportsQueryDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("FundingRates", testFundingRatesQueryDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsQueryDeleteAll)
+	t.Run("PriceCandles", testPriceCandlesQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("ScriptStates", testScriptStatesQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosQueryDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsQueryDeleteAll)
@@ -56,11 +74,17 @@ func TestQueryDeleteAll(t *testing.T) {
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
 	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("DataQualityIssues", testDataQualityIssuesSliceDeleteAll)
+	t.Run("DataQualityReports", testDataQualityReportsSliceDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("FundingRates", testFundingRatesSliceDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsSliceDeleteAll)
+	t.Run("PriceCandles", testPriceCandlesSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("ScriptStates", testScriptStatesSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceDeleteAll)
@@ -70,11 +94,17 @@ func TestSliceDeleteAll(t *testing.T) {
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
 	t.Run("Candles", testCandlesExists)
+	t.Run("DataQualityIssues", testDataQualityIssuesExists)
+	t.Run("DataQualityReports", testDataQualityReportsExists)
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("FundingRates", testFundingRatesExists)
+	t.Run("OpenInterests", testOpenInterestsExists)
+	t.Run("PriceCandles", testPriceCandlesExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("ScriptStates", testScriptStatesExists)
 	t.Run("Trades", testTradesExists)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosExists)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsExists)
@@ -84,11 +114,17 @@ func TestExists(t *testing.T) {
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
 	t.Run("Candles", testCandlesFind)
+	t.Run("DataQualityIssues", testDataQualityIssuesFind)
+	t.Run("DataQualityReports", testDataQualityReportsFind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("FundingRates", testFundingRatesFind)
+	t.Run("OpenInterests", testOpenInterestsFind)
+	t.Run("PriceCandles", testPriceCandlesFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("ScriptStates", testScriptStatesFind)
 	t.Run("Trades", testTradesFind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosFind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsFind)
@@ -98,11 +134,17 @@ func TestFind(t *testing.T) {
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
 	t.Run("Candles", testCandlesBind)
+	t.Run("DataQualityIssues", testDataQualityIssuesBind)
+	t.Run("DataQualityReports", testDataQualityReportsBind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("FundingRates", testFundingRatesBind)
+	t.Run("OpenInterests", testOpenInterestsBind)
+	t.Run("PriceCandles", testPriceCandlesBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("ScriptStates", testScriptStatesBind)
 	t.Run("Trades", testTradesBind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosBind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsBind)
@@ -112,11 +154,17 @@ func TestBind(t *testing.T) {
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
 	t.Run("Candles", testCandlesOne)
+	t.Run("DataQualityIssues", testDataQualityIssuesOne)
+	t.Run("DataQualityReports", testDataQualityReportsOne)
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("FundingRates", testFundingRatesOne)
+	t.Run("OpenInterests", testOpenInterestsOne)
+	t.Run("PriceCandles", testPriceCandlesOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("ScriptStates", testScriptStatesOne)
 	t.Run("Trades", testTradesOne)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosOne)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsOne)
@@ -126,11 +174,17 @@ func TestOne(t *testing.T) {
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
 	t.Run("Candles", testCandlesAll)
+	t.Run("DataQualityIssues", testDataQualityIssuesAll)
+	t.Run("DataQualityReports", testDataQualityReportsAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("FundingRates", testFundingRatesAll)
+	t.Run("OpenInterests", testOpenInterestsAll)
+	t.Run("PriceCandles", testPriceCandlesAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("ScriptStates", testScriptStatesAll)
 	t.Run("Trades", testTradesAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsAll)
@@ -140,11 +194,17 @@ func TestAll(t *testing.T) {
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
 	t.Run("Candles", testCandlesCount)
+	t.Run("DataQualityIssues", testDataQualityIssuesCount)
+	t.Run("DataQualityReports", testDataQualityReportsCount)
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("FundingRates", testFundingRatesCount)
+	t.Run("OpenInterests", testOpenInterestsCount)
+	t.Run("PriceCandles", testPriceCandlesCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("ScriptStates", testScriptStatesCount)
 	t.Run("Trades", testTradesCount)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosCount)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsCount)
@@ -154,11 +214,17 @@ func TestCount(t *testing.T) {
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
 	t.Run("Candles", testCandlesHooks)
+	t.Run("DataQualityIssues", testDataQualityIssuesHooks)
+	t.Run("DataQualityReports", testDataQualityReportsHooks)
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("FundingRates", testFundingRatesHooks)
+	t.Run("OpenInterests", testOpenInterestsHooks)
+	t.Run("PriceCandles", testPriceCandlesHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("ScriptStates", testScriptStatesHooks)
 	t.Run("Trades", testTradesHooks)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosHooks)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsHooks)
@@ -170,16 +236,28 @@ func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
 	t.Run("Candles", testCandlesInsert)
 	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("DataQualityIssues", testDataQualityIssuesInsert)
+	t.Run("DataQualityIssues", testDataQualityIssuesInsertWhitelist)
+	t.Run("DataQualityReports", testDataQualityReportsInsert)
+	t.Run("DataQualityReports", testDataQualityReportsInsertWhitelist)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("FundingRates", testFundingRatesInsert)
+	t.Run("FundingRates", testFundingRatesInsertWhitelist)
+	t.Run("OpenInterests", testOpenInterestsInsert)
+	t.Run("OpenInterests", testOpenInterestsInsertWhitelist)
+	t.Run("PriceCandles", testPriceCandlesInsert)
+	t.Run("PriceCandles", testPriceCandlesInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("ScriptStates", testScriptStatesInsert)
+	t.Run("ScriptStates", testScriptStatesInsertWhitelist)
 	t.Run("Trades", testTradesInsert)
 	t.Run("Trades", testTradesInsertWhitelist)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsert)
@@ -196,9 +274,18 @@ func TestToOne(t *testing.T) {
 	t.Run("CandleToDatahistoryjobUsingValidationJob", testCandleToOneDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJob", testCandleToOneDatahistoryjobUsingSourceJob)
 	t.Run("CandleToExchangeUsingExchangeName", testCandleToOneExchangeUsingExchangeName)
+	t.Run("DataQualityIssueToDatahistoryjobUsingRepairJob", testDataQualityIssueToOneDatahistoryjobUsingRepairJob)
+	t.Run("DataQualityIssueToDataQualityReportUsingReport", testDataQualityIssueToOneDataQualityReportUsingReport)
+	t.Run("DataQualityReportToExchangeUsingExchangeName", testDataQualityReportToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchange", testDatahistoryjobToOneExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("FundingRateToDatahistoryjobUsingSourceJob", testFundingRateToOneDatahistoryjobUsingSourceJob)
+	t.Run("FundingRateToExchangeUsingExchangeName", testFundingRateToOneExchangeUsingExchangeName)
+	t.Run("OpenInterestToDatahistoryjobUsingSourceJob", testOpenInterestToOneDatahistoryjobUsingSourceJob)
+	t.Run("OpenInterestToExchangeUsingExchangeName", testOpenInterestToOneExchangeUsingExchangeName)
+	t.Run("PriceCandleToDatahistoryjobUsingSourceJob", testPriceCandleToOneDatahistoryjobUsingSourceJob)
+	t.Run("PriceCandleToExchangeUsingExchangeName", testPriceCandleToOneExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
@@ -210,17 +297,26 @@ func TestToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToFundingRateUsingExchangeNameFundingRate", testExchangeOneToOneFundingRateUsingExchangeNameFundingRate)
+	t.Run("ExchangeToOpenInterestUsingExchangeNameOpenInterest", testExchangeOneToOneOpenInterestUsingExchangeNameOpenInterest)
+	t.Run("ExchangeToPriceCandleUsingExchangeNamePriceCandle", testExchangeOneToOnePriceCandleUsingExchangeNamePriceCandle)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneTradeUsingExchangeNameTrade)
 }
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("DataQualityReportToReportDataQualityIssues", testDataQualityReportToManyReportDataQualityIssues)
 	t.Run("DatahistoryjobToValidationJobCandles", testDatahistoryjobToManyValidationJobCandles)
 	t.Run("DatahistoryjobToSourceJobCandles", testDatahistoryjobToManySourceJobCandles)
+	t.Run("DatahistoryjobToRepairJobDataQualityIssues", testDatahistoryjobToManyRepairJobDataQualityIssues)
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyJobDatahistoryjobresults)
+	t.Run("DatahistoryjobToSourceJobFundingRates", testDatahistoryjobToManySourceJobFundingRates)
+	t.Run("DatahistoryjobToSourceJobOpenInterests", testDatahistoryjobToManySourceJobOpenInterests)
+	t.Run("DatahistoryjobToSourceJobPriceCandles", testDatahistoryjobToManySourceJobPriceCandles)
+	t.Run("ExchangeToExchangeNameDataQualityReports", testExchangeToManyExchangeNameDataQualityReports)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySecondaryExchangeDatahistoryjobs)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
@@ -235,9 +331,18 @@ func TestToOneSet(t *testing.T) {
 	t.Run("CandleToDatahistoryjobUsingValidationJobCandles", testCandleToOneSetOpDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJobCandles", testCandleToOneSetOpDatahistoryjobUsingSourceJob)
 	t.Run("CandleToExchangeUsingExchangeNameCandle", testCandleToOneSetOpExchangeUsingExchangeName)
+	t.Run("DataQualityIssueToDatahistoryjobUsingRepairJobDataQualityIssues", testDataQualityIssueToOneSetOpDatahistoryjobUsingRepairJob)
+	t.Run("DataQualityIssueToDataQualityReportUsingReportDataQualityIssues", testDataQualityIssueToOneSetOpDataQualityReportUsingReport)
+	t.Run("DataQualityReportToExchangeUsingExchangeNameDataQualityReports", testDataQualityReportToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("FundingRateToDatahistoryjobUsingSourceJobFundingRates", testFundingRateToOneSetOpDatahistoryjobUsingSourceJob)
+	t.Run("FundingRateToExchangeUsingExchangeNameFundingRate", testFundingRateToOneSetOpExchangeUsingExchangeName)
+	t.Run("OpenInterestToDatahistoryjobUsingSourceJobOpenInterests", testOpenInterestToOneSetOpDatahistoryjobUsingSourceJob)
+	t.Run("OpenInterestToExchangeUsingExchangeNameOpenInterest", testOpenInterestToOneSetOpExchangeUsingExchangeName)
+	t.Run("PriceCandleToDatahistoryjobUsingSourceJobPriceCandles", testPriceCandleToOneSetOpDatahistoryjobUsingSourceJob)
+	t.Run("PriceCandleToExchangeUsingExchangeNamePriceCandle", testPriceCandleToOneSetOpExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
@@ -250,13 +355,20 @@ func TestToOneSet(t *testing.T) {
 func TestToOneRemove(t *testing.T) {
 	t.Run("CandleToDatahistoryjobUsingValidationJobCandles", testCandleToOneRemoveOpDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJobCandles", testCandleToOneRemoveOpDatahistoryjobUsingSourceJob)
+	t.Run("DataQualityIssueToDatahistoryjobUsingRepairJobDataQualityIssues", testDataQualityIssueToOneRemoveOpDatahistoryjobUsingRepairJob)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneRemoveOpExchangeUsingSecondaryExchange)
+	t.Run("FundingRateToDatahistoryjobUsingSourceJobFundingRates", testFundingRateToOneRemoveOpDatahistoryjobUsingSourceJob)
+	t.Run("OpenInterestToDatahistoryjobUsingSourceJobOpenInterests", testOpenInterestToOneRemoveOpDatahistoryjobUsingSourceJob)
+	t.Run("PriceCandleToDatahistoryjobUsingSourceJobPriceCandles", testPriceCandleToOneRemoveOpDatahistoryjobUsingSourceJob)
 }
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneSetOpCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToFundingRateUsingExchangeNameFundingRate", testExchangeOneToOneSetOpFundingRateUsingExchangeNameFundingRate)
+	t.Run("ExchangeToOpenInterestUsingExchangeNameOpenInterest", testExchangeOneToOneSetOpOpenInterestUsingExchangeNameOpenInterest)
+	t.Run("ExchangeToPriceCandleUsingExchangeNamePriceCandle", testExchangeOneToOneSetOpPriceCandleUsingExchangeNamePriceCandle)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneSetOpTradeUsingExchangeNameTrade)
 }
 
@@ -267,11 +379,17 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("DataQualityReportToReportDataQualityIssues", testDataQualityReportToManyAddOpReportDataQualityIssues)
 	t.Run("DatahistoryjobToValidationJobCandles", testDatahistoryjobToManyAddOpValidationJobCandles)
 	t.Run("DatahistoryjobToSourceJobCandles", testDatahistoryjobToManyAddOpSourceJobCandles)
+	t.Run("DatahistoryjobToRepairJobDataQualityIssues", testDatahistoryjobToManyAddOpRepairJobDataQualityIssues)
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyAddOpJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyAddOpJobDatahistoryjobresults)
+	t.Run("DatahistoryjobToSourceJobFundingRates", testDatahistoryjobToManyAddOpSourceJobFundingRates)
+	t.Run("DatahistoryjobToSourceJobOpenInterests", testDatahistoryjobToManyAddOpSourceJobOpenInterests)
+	t.Run("DatahistoryjobToSourceJobPriceCandles", testDatahistoryjobToManyAddOpSourceJobPriceCandles)
+	t.Run("ExchangeToExchangeNameDataQualityReports", testExchangeToManyAddOpExchangeNameDataQualityReports)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
@@ -285,8 +403,12 @@ func TestToManyAdd(t *testing.T) {
 func TestToManySet(t *testing.T) {
 	t.Run("DatahistoryjobToValidationJobCandles", testDatahistoryjobToManySetOpValidationJobCandles)
 	t.Run("DatahistoryjobToSourceJobCandles", testDatahistoryjobToManySetOpSourceJobCandles)
+	t.Run("DatahistoryjobToRepairJobDataQualityIssues", testDatahistoryjobToManySetOpRepairJobDataQualityIssues)
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManySetOpPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManySetOpJobDatahistoryjobs)
+	t.Run("DatahistoryjobToSourceJobFundingRates", testDatahistoryjobToManySetOpSourceJobFundingRates)
+	t.Run("DatahistoryjobToSourceJobOpenInterests", testDatahistoryjobToManySetOpSourceJobOpenInterests)
+	t.Run("DatahistoryjobToSourceJobPriceCandles", testDatahistoryjobToManySetOpSourceJobPriceCandles)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySetOpSecondaryExchangeDatahistoryjobs)
 }
 
@@ -295,19 +417,29 @@ func TestToManySet(t *testing.T) {
 func TestToManyRemove(t *testing.T) {
 	t.Run("DatahistoryjobToValidationJobCandles", testDatahistoryjobToManyRemoveOpValidationJobCandles)
 	t.Run("DatahistoryjobToSourceJobCandles", testDatahistoryjobToManyRemoveOpSourceJobCandles)
+	t.Run("DatahistoryjobToRepairJobDataQualityIssues", testDatahistoryjobToManyRemoveOpRepairJobDataQualityIssues)
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyRemoveOpPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyRemoveOpJobDatahistoryjobs)
+	t.Run("DatahistoryjobToSourceJobFundingRates", testDatahistoryjobToManyRemoveOpSourceJobFundingRates)
+	t.Run("DatahistoryjobToSourceJobOpenInterests", testDatahistoryjobToManyRemoveOpSourceJobOpenInterests)
+	t.Run("DatahistoryjobToSourceJobPriceCandles", testDatahistoryjobToManyRemoveOpSourceJobPriceCandles)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs)
 }
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
 	t.Run("Candles", testCandlesReload)
+	t.Run("DataQualityIssues", testDataQualityIssuesReload)
+	t.Run("DataQualityReports", testDataQualityReportsReload)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("FundingRates", testFundingRatesReload)
+	t.Run("OpenInterests", testOpenInterestsReload)
+	t.Run("PriceCandles", testPriceCandlesReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("ScriptStates", testScriptStatesReload)
 	t.Run("Trades", testTradesReload)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReload)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReload)
@@ -317,11 +449,17 @@ func TestReload(t *testing.T) {
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
 	t.Run("Candles", testCandlesReloadAll)
+	t.Run("DataQualityIssues", testDataQualityIssuesReloadAll)
+	t.Run("DataQualityReports", testDataQualityReportsReloadAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("FundingRates", testFundingRatesReloadAll)
+	t.Run("OpenInterests", testOpenInterestsReloadAll)
+	t.Run("PriceCandles", testPriceCandlesReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("ScriptStates", testScriptStatesReloadAll)
 	t.Run("Trades", testTradesReloadAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReloadAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReloadAll)
@@ -331,11 +469,17 @@ func TestReloadAll(t *testing.T) {
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
 	t.Run("Candles", testCandlesSelect)
+	t.Run("DataQualityIssues", testDataQualityIssuesSelect)
+	t.Run("DataQualityReports", testDataQualityReportsSelect)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("FundingRates", testFundingRatesSelect)
+	t.Run("OpenInterests", testOpenInterestsSelect)
+	t.Run("PriceCandles", testPriceCandlesSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("ScriptStates", testScriptStatesSelect)
 	t.Run("Trades", testTradesSelect)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSelect)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSelect)
@@ -345,11 +489,17 @@ func TestSelect(t *testing.T) {
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
 	t.Run("Candles", testCandlesUpdate)
+	t.Run("DataQualityIssues", testDataQualityIssuesUpdate)
+	t.Run("DataQualityReports", testDataQualityReportsUpdate)
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("FundingRates", testFundingRatesUpdate)
+	t.Run("OpenInterests", testOpenInterestsUpdate)
+	t.Run("PriceCandles", testPriceCandlesUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("ScriptStates", testScriptStatesUpdate)
 	t.Run("Trades", testTradesUpdate)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpdate)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsUpdate)
@@ -359,11 +509,17 @@ func TestUpdate(t *testing.T) {
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
 	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("DataQualityIssues", testDataQualityIssuesSliceUpdateAll)
+	t.Run("DataQualityReports", testDataQualityReportsSliceUpdateAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("FundingRates", testFundingRatesSliceUpdateAll)
+	t.Run("OpenInterests", testOpenInterestsSliceUpdateAll)
+	t.Run("PriceCandles", testPriceCandlesSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("ScriptStates", testScriptStatesSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceUpdateAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceUpdateAll)
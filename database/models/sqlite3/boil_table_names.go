@@ -6,12 +6,18 @@ package sqlite3
 var TableNames = struct {
 	AuditEvent              string
 	Candle                  string
+	DataQualityIssue        string
+	DataQualityReport       string
 	Datahistoryjob          string
 	Datahistoryjobrelations string
 	Datahistoryjobresult    string
 	Exchange                string
+	FundingRate             string
+	OpenInterest            string
+	PriceCandle             string
 	Script                  string
 	ScriptExecution         string
+	ScriptState             string
 	Trade                   string
 	WithdrawalCrypto        string
 	WithdrawalFiat          string
@@ -19,12 +25,18 @@ var TableNames = struct {
 }{
 	AuditEvent:              "audit_event",
 	Candle:                  "candle",
+	DataQualityIssue:        "data_quality_issue",
+	DataQualityReport:       "data_quality_report",
 	Datahistoryjob:          "datahistoryjob",
 	Datahistoryjobrelations: "datahistoryjobrelations",
 	Datahistoryjobresult:    "datahistoryjobresult",
 	Exchange:                "exchange",
+	FundingRate:             "funding_rate",
+	OpenInterest:            "open_interest",
+	PriceCandle:             "price_candle",
 	Script:                  "script",
 	ScriptExecution:         "script_execution",
+	ScriptState:             "script_state",
 	Trade:                   "trade",
 	WithdrawalCrypto:        "withdrawal_crypto",
 	WithdrawalFiat:          "withdrawal_fiat",
@@ -0,0 +1,1167 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// DataQualityIssue is an object representing the database table.
+type DataQualityIssue struct {
+	ID                 string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ReportID           string      `boil:"report_id" json:"report_id" toml:"report_id" yaml:"report_id"`
+	IssueType          string      `boil:"issue_type" json:"issue_type" toml:"issue_type" yaml:"issue_type"`
+	StartTime          string      `boil:"start_time" json:"start_time" toml:"start_time" yaml:"start_time"`
+	EndTime            string      `boil:"end_time" json:"end_time" toml:"end_time" yaml:"end_time"`
+	Value              float64     `boil:"value" json:"value" toml:"value" yaml:"value"`
+	ComparisonExchange null.String `boil:"comparison_exchange" json:"comparison_exchange,omitempty" toml:"comparison_exchange" yaml:"comparison_exchange,omitempty"`
+	Description        string      `boil:"description" json:"description" toml:"description" yaml:"description"`
+	RepairJobID        null.String `boil:"repair_job_id" json:"repair_job_id,omitempty" toml:"repair_job_id" yaml:"repair_job_id,omitempty"`
+
+	R *dataQualityIssueR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataQualityIssueL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataQualityIssueColumns = struct {
+	ID                 string
+	ReportID           string
+	IssueType          string
+	StartTime          string
+	EndTime            string
+	Value              string
+	ComparisonExchange string
+	Description        string
+	RepairJobID        string
+}{
+	ID:                 "id",
+	ReportID:           "report_id",
+	IssueType:          "issue_type",
+	StartTime:          "start_time",
+	EndTime:            "end_time",
+	Value:              "value",
+	ComparisonExchange: "comparison_exchange",
+	Description:        "description",
+	RepairJobID:        "repair_job_id",
+}
+
+// Generated where
+
+var DataQualityIssueWhere = struct {
+	ID                 whereHelperstring
+	ReportID           whereHelperstring
+	IssueType          whereHelperstring
+	StartTime          whereHelperstring
+	EndTime            whereHelperstring
+	Value              whereHelperfloat64
+	ComparisonExchange whereHelpernull_String
+	Description        whereHelperstring
+	RepairJobID        whereHelpernull_String
+}{
+	ID:                 whereHelperstring{field: "\"data_quality_issue\".\"id\""},
+	ReportID:           whereHelperstring{field: "\"data_quality_issue\".\"report_id\""},
+	IssueType:          whereHelperstring{field: "\"data_quality_issue\".\"issue_type\""},
+	StartTime:          whereHelperstring{field: "\"data_quality_issue\".\"start_time\""},
+	EndTime:            whereHelperstring{field: "\"data_quality_issue\".\"end_time\""},
+	Value:              whereHelperfloat64{field: "\"data_quality_issue\".\"value\""},
+	ComparisonExchange: whereHelpernull_String{field: "\"data_quality_issue\".\"comparison_exchange\""},
+	Description:        whereHelperstring{field: "\"data_quality_issue\".\"description\""},
+	RepairJobID:        whereHelpernull_String{field: "\"data_quality_issue\".\"repair_job_id\""},
+}
+
+// DataQualityIssueRels is where relationship names are stored.
+var DataQualityIssueRels = struct {
+	RepairJob string
+	Report    string
+}{
+	RepairJob: "RepairJob",
+	Report:    "Report",
+}
+
+// dataQualityIssueR is where relationships are stored.
+type dataQualityIssueR struct {
+	RepairJob *Datahistoryjob
+	Report    *DataQualityReport
+}
+
+// NewStruct creates a new relationship struct
+func (*dataQualityIssueR) NewStruct() *dataQualityIssueR {
+	return &dataQualityIssueR{}
+}
+
+// dataQualityIssueL is where Load methods for each relationship are stored.
+type dataQualityIssueL struct{}
+
+var (
+	dataQualityIssueAllColumns            = []string{"id", "report_id", "issue_type", "start_time", "end_time", "value", "comparison_exchange", "description", "repair_job_id"}
+	dataQualityIssueColumnsWithoutDefault = []string{"id", "report_id", "issue_type", "start_time", "end_time", "value", "comparison_exchange", "description", "repair_job_id"}
+	dataQualityIssueColumnsWithDefault    = []string{}
+	dataQualityIssuePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// DataQualityIssueSlice is an alias for a slice of pointers to DataQualityIssue.
+	// This should generally be used opposed to []DataQualityIssue.
+	DataQualityIssueSlice []*DataQualityIssue
+	// DataQualityIssueHook is the signature for custom DataQualityIssue hook methods
+	DataQualityIssueHook func(context.Context, boil.ContextExecutor, *DataQualityIssue) error
+
+	dataQualityIssueQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataQualityIssueType                 = reflect.TypeOf(&DataQualityIssue{})
+	dataQualityIssueMapping              = queries.MakeStructMapping(dataQualityIssueType)
+	dataQualityIssuePrimaryKeyMapping, _ = queries.BindMapping(dataQualityIssueType, dataQualityIssueMapping, dataQualityIssuePrimaryKeyColumns)
+	dataQualityIssueInsertCacheMut       sync.RWMutex
+	dataQualityIssueInsertCache          = make(map[string]insertCache)
+	dataQualityIssueUpdateCacheMut       sync.RWMutex
+	dataQualityIssueUpdateCache          = make(map[string]updateCache)
+	dataQualityIssueUpsertCacheMut       sync.RWMutex
+	dataQualityIssueUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataQualityIssueBeforeInsertHooks []DataQualityIssueHook
+var dataQualityIssueBeforeUpdateHooks []DataQualityIssueHook
+var dataQualityIssueBeforeDeleteHooks []DataQualityIssueHook
+var dataQualityIssueBeforeUpsertHooks []DataQualityIssueHook
+
+var dataQualityIssueAfterInsertHooks []DataQualityIssueHook
+var dataQualityIssueAfterSelectHooks []DataQualityIssueHook
+var dataQualityIssueAfterUpdateHooks []DataQualityIssueHook
+var dataQualityIssueAfterDeleteHooks []DataQualityIssueHook
+var dataQualityIssueAfterUpsertHooks []DataQualityIssueHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataQualityIssue) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityIssueBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataQualityIssue) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityIssueBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataQualityIssue) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityIssueBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataQualityIssue) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityIssueBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataQualityIssue) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityIssueAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataQualityIssue) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityIssueAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataQualityIssue) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityIssueAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataQualityIssue) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityIssueAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataQualityIssue) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityIssueAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataQualityIssueHook registers your hook function for all future operations.
+func AddDataQualityIssueHook(hookPoint boil.HookPoint, dataQualityIssueHook DataQualityIssueHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		dataQualityIssueBeforeInsertHooks = append(dataQualityIssueBeforeInsertHooks, dataQualityIssueHook)
+	case boil.BeforeUpdateHook:
+		dataQualityIssueBeforeUpdateHooks = append(dataQualityIssueBeforeUpdateHooks, dataQualityIssueHook)
+	case boil.BeforeDeleteHook:
+		dataQualityIssueBeforeDeleteHooks = append(dataQualityIssueBeforeDeleteHooks, dataQualityIssueHook)
+	case boil.BeforeUpsertHook:
+		dataQualityIssueBeforeUpsertHooks = append(dataQualityIssueBeforeUpsertHooks, dataQualityIssueHook)
+	case boil.AfterInsertHook:
+		dataQualityIssueAfterInsertHooks = append(dataQualityIssueAfterInsertHooks, dataQualityIssueHook)
+	case boil.AfterSelectHook:
+		dataQualityIssueAfterSelectHooks = append(dataQualityIssueAfterSelectHooks, dataQualityIssueHook)
+	case boil.AfterUpdateHook:
+		dataQualityIssueAfterUpdateHooks = append(dataQualityIssueAfterUpdateHooks, dataQualityIssueHook)
+	case boil.AfterDeleteHook:
+		dataQualityIssueAfterDeleteHooks = append(dataQualityIssueAfterDeleteHooks, dataQualityIssueHook)
+	case boil.AfterUpsertHook:
+		dataQualityIssueAfterUpsertHooks = append(dataQualityIssueAfterUpsertHooks, dataQualityIssueHook)
+	}
+}
+
+// One returns a single dataQualityIssue record from the query.
+func (q dataQualityIssueQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataQualityIssue, error) {
+	o := &DataQualityIssue{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for data_quality_issue")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataQualityIssue records from the query.
+func (q dataQualityIssueQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataQualityIssueSlice, error) {
+	var o []*DataQualityIssue
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to DataQualityIssue slice")
+	}
+
+	if len(dataQualityIssueAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataQualityIssue records in the query.
+func (q dataQualityIssueQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count data_quality_issue rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataQualityIssueQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if data_quality_issue exists")
+	}
+
+	return count > 0, nil
+}
+
+// RepairJob pointed to by the foreign key.
+func (o *DataQualityIssue) RepairJob(mods ...qm.QueryMod) datahistoryjobQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RepairJobID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Datahistoryjobs(queryMods...)
+	queries.SetFrom(query.Query, "\"datahistoryjob\"")
+
+	return query
+}
+
+// Report pointed to by the foreign key.
+func (o *DataQualityIssue) Report(mods ...qm.QueryMod) dataQualityReportQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ReportID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := DataQualityReports(queryMods...)
+	queries.SetFrom(query.Query, "\"data_quality_report\"")
+
+	return query
+}
+
+// LoadRepairJob allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataQualityIssueL) LoadRepairJob(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataQualityIssue interface{}, mods queries.Applicator) error {
+	var slice []*DataQualityIssue
+	var object *DataQualityIssue
+
+	if singular {
+		object = maybeDataQualityIssue.(*DataQualityIssue)
+	} else {
+		slice = *maybeDataQualityIssue.(*[]*DataQualityIssue)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dataQualityIssueR{}
+		}
+		if !queries.IsNil(object.RepairJobID) {
+			args = append(args, object.RepairJobID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataQualityIssueR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.RepairJobID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.RepairJobID) {
+				args = append(args, obj.RepairJobID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Datahistoryjob")
+	}
+
+	var resultSlice []*Datahistoryjob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Datahistoryjob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for datahistoryjob")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+	}
+
+	if len(dataQualityIssueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.RepairJob = foreign
+		if foreign.R == nil {
+			foreign.R = &datahistoryjobR{}
+		}
+		foreign.R.RepairJobDataQualityIssues = append(foreign.R.RepairJobDataQualityIssues, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RepairJobID, foreign.ID) {
+				local.R.RepairJob = foreign
+				if foreign.R == nil {
+					foreign.R = &datahistoryjobR{}
+				}
+				foreign.R.RepairJobDataQualityIssues = append(foreign.R.RepairJobDataQualityIssues, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReport allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataQualityIssueL) LoadReport(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataQualityIssue interface{}, mods queries.Applicator) error {
+	var slice []*DataQualityIssue
+	var object *DataQualityIssue
+
+	if singular {
+		object = maybeDataQualityIssue.(*DataQualityIssue)
+	} else {
+		slice = *maybeDataQualityIssue.(*[]*DataQualityIssue)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dataQualityIssueR{}
+		}
+		args = append(args, object.ReportID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataQualityIssueR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ReportID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ReportID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`data_quality_report`), qm.WhereIn(`data_quality_report.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load DataQualityReport")
+	}
+
+	var resultSlice []*DataQualityReport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice DataQualityReport")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for data_quality_report")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_quality_report")
+	}
+
+	if len(dataQualityIssueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Report = foreign
+		if foreign.R == nil {
+			foreign.R = &dataQualityReportR{}
+		}
+		foreign.R.ReportDataQualityIssues = append(foreign.R.ReportDataQualityIssues, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ReportID == foreign.ID {
+				local.R.Report = foreign
+				if foreign.R == nil {
+					foreign.R = &dataQualityReportR{}
+				}
+				foreign.R.ReportDataQualityIssues = append(foreign.R.ReportDataQualityIssues, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRepairJob of the dataQualityIssue to the related item.
+// Sets o.R.RepairJob to related.
+// Adds o to related.R.RepairJobDataQualityIssues.
+func (o *DataQualityIssue) SetRepairJob(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Datahistoryjob) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"data_quality_issue\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"repair_job_id"}),
+		strmangle.WhereClause("\"", "\"", 0, dataQualityIssuePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RepairJobID, related.ID)
+	if o.R == nil {
+		o.R = &dataQualityIssueR{
+			RepairJob: related,
+		}
+	} else {
+		o.R.RepairJob = related
+	}
+
+	if related.R == nil {
+		related.R = &datahistoryjobR{
+			RepairJobDataQualityIssues: DataQualityIssueSlice{o},
+		}
+	} else {
+		related.R.RepairJobDataQualityIssues = append(related.R.RepairJobDataQualityIssues, o)
+	}
+
+	return nil
+}
+
+// RemoveRepairJob relationship.
+// Sets o.R.RepairJob to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *DataQualityIssue) RemoveRepairJob(ctx context.Context, exec boil.ContextExecutor, related *Datahistoryjob) error {
+	var err error
+
+	queries.SetScanner(&o.RepairJobID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("repair_job_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.RepairJob = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.RepairJobDataQualityIssues {
+		if queries.Equal(o.RepairJobID, ri.RepairJobID) {
+			continue
+		}
+
+		ln := len(related.R.RepairJobDataQualityIssues)
+		if ln > 1 && i < ln-1 {
+			related.R.RepairJobDataQualityIssues[i] = related.R.RepairJobDataQualityIssues[ln-1]
+		}
+		related.R.RepairJobDataQualityIssues = related.R.RepairJobDataQualityIssues[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetReport of the dataQualityIssue to the related item.
+// Sets o.R.Report to related.
+// Adds o to related.R.ReportDataQualityIssues.
+func (o *DataQualityIssue) SetReport(ctx context.Context, exec boil.ContextExecutor, insert bool, related *DataQualityReport) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"data_quality_issue\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"report_id"}),
+		strmangle.WhereClause("\"", "\"", 0, dataQualityIssuePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ReportID = related.ID
+	if o.R == nil {
+		o.R = &dataQualityIssueR{
+			Report: related,
+		}
+	} else {
+		o.R.Report = related
+	}
+
+	if related.R == nil {
+		related.R = &dataQualityReportR{
+			ReportDataQualityIssues: DataQualityIssueSlice{o},
+		}
+	} else {
+		related.R.ReportDataQualityIssues = append(related.R.ReportDataQualityIssues, o)
+	}
+
+	return nil
+}
+
+// DataQualityIssues retrieves all the records using an executor.
+func DataQualityIssues(mods ...qm.QueryMod) dataQualityIssueQuery {
+	mods = append(mods, qm.From("\"data_quality_issue\""))
+	return dataQualityIssueQuery{NewQuery(mods...)}
+}
+
+// FindDataQualityIssue retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataQualityIssue(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*DataQualityIssue, error) {
+	dataQualityIssueObj := &DataQualityIssue{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"data_quality_issue\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dataQualityIssueObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from data_quality_issue")
+	}
+
+	return dataQualityIssueObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataQualityIssue) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no data_quality_issue provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataQualityIssueColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataQualityIssueInsertCacheMut.RLock()
+	cache, cached := dataQualityIssueInsertCache[key]
+	dataQualityIssueInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataQualityIssueAllColumns,
+			dataQualityIssueColumnsWithDefault,
+			dataQualityIssueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataQualityIssueType, dataQualityIssueMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataQualityIssueType, dataQualityIssueMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"data_quality_issue\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"data_quality_issue\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"data_quality_issue\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, dataQualityIssuePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into data_quality_issue")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for data_quality_issue")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataQualityIssueInsertCacheMut.Lock()
+		dataQualityIssueInsertCache[key] = cache
+		dataQualityIssueInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataQualityIssue.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataQualityIssue) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataQualityIssueUpdateCacheMut.RLock()
+	cache, cached := dataQualityIssueUpdateCache[key]
+	dataQualityIssueUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataQualityIssueAllColumns,
+			dataQualityIssuePrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update data_quality_issue, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"data_quality_issue\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, dataQualityIssuePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataQualityIssueType, dataQualityIssueMapping, append(wl, dataQualityIssuePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update data_quality_issue row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for data_quality_issue")
+	}
+
+	if !cached {
+		dataQualityIssueUpdateCacheMut.Lock()
+		dataQualityIssueUpdateCache[key] = cache
+		dataQualityIssueUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataQualityIssueQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for data_quality_issue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for data_quality_issue")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataQualityIssueSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataQualityIssuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"data_quality_issue\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataQualityIssuePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in dataQualityIssue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all dataQualityIssue")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single DataQualityIssue record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataQualityIssue) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no DataQualityIssue provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataQualityIssuePrimaryKeyMapping)
+	sql := "DELETE FROM \"data_quality_issue\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from data_quality_issue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for data_quality_issue")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataQualityIssueQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no dataQualityIssueQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from data_quality_issue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for data_quality_issue")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataQualityIssueSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataQualityIssueBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataQualityIssuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"data_quality_issue\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataQualityIssuePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from dataQualityIssue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for data_quality_issue")
+	}
+
+	if len(dataQualityIssueAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataQualityIssue) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataQualityIssue(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataQualityIssueSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataQualityIssueSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataQualityIssuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"data_quality_issue\".* FROM \"data_quality_issue\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataQualityIssuePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in DataQualityIssueSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataQualityIssueExists checks if the DataQualityIssue row exists.
+func DataQualityIssueExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"data_quality_issue\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if data_quality_issue exists")
+	}
+
+	return exists, nil
+}
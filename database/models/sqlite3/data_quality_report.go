@@ -0,0 +1,1148 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// DataQualityReport is an object representing the database table.
+type DataQualityReport struct {
+	ID             string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID string `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Base           string `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote          string `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Asset          string `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	DataType       string `boil:"data_type" json:"data_type" toml:"data_type" yaml:"data_type"`
+	Interval       int64  `boil:"interval" json:"interval" toml:"interval" yaml:"interval"`
+	StartTime      string `boil:"start_time" json:"start_time" toml:"start_time" yaml:"start_time"`
+	EndTime        string `boil:"end_time" json:"end_time" toml:"end_time" yaml:"end_time"`
+	Records        int64  `boil:"records" json:"records" toml:"records" yaml:"records"`
+	CreatedAt      string `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *dataQualityReportR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L dataQualityReportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var DataQualityReportColumns = struct {
+	ID             string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	Asset          string
+	DataType       string
+	Interval       string
+	StartTime      string
+	EndTime        string
+	Records        string
+	CreatedAt      string
+}{
+	ID:             "id",
+	ExchangeNameID: "exchange_name_id",
+	Base:           "base",
+	Quote:          "quote",
+	Asset:          "asset",
+	DataType:       "data_type",
+	Interval:       "interval",
+	StartTime:      "start_time",
+	EndTime:        "end_time",
+	Records:        "records",
+	CreatedAt:      "created_at",
+}
+
+// Generated where
+
+var DataQualityReportWhere = struct {
+	ID             whereHelperstring
+	ExchangeNameID whereHelperstring
+	Base           whereHelperstring
+	Quote          whereHelperstring
+	Asset          whereHelperstring
+	DataType       whereHelperstring
+	Interval       whereHelperint64
+	StartTime      whereHelperstring
+	EndTime        whereHelperstring
+	Records        whereHelperint64
+	CreatedAt      whereHelperstring
+}{
+	ID:             whereHelperstring{field: "\"data_quality_report\".\"id\""},
+	ExchangeNameID: whereHelperstring{field: "\"data_quality_report\".\"exchange_name_id\""},
+	Base:           whereHelperstring{field: "\"data_quality_report\".\"base\""},
+	Quote:          whereHelperstring{field: "\"data_quality_report\".\"quote\""},
+	Asset:          whereHelperstring{field: "\"data_quality_report\".\"asset\""},
+	DataType:       whereHelperstring{field: "\"data_quality_report\".\"data_type\""},
+	Interval:       whereHelperint64{field: "\"data_quality_report\".\"interval\""},
+	StartTime:      whereHelperstring{field: "\"data_quality_report\".\"start_time\""},
+	EndTime:        whereHelperstring{field: "\"data_quality_report\".\"end_time\""},
+	Records:        whereHelperint64{field: "\"data_quality_report\".\"records\""},
+	CreatedAt:      whereHelperstring{field: "\"data_quality_report\".\"created_at\""},
+}
+
+// DataQualityReportRels is where relationship names are stored.
+var DataQualityReportRels = struct {
+	ExchangeName            string
+	ReportDataQualityIssues string
+}{
+	ExchangeName:            "ExchangeName",
+	ReportDataQualityIssues: "ReportDataQualityIssues",
+}
+
+// dataQualityReportR is where relationships are stored.
+type dataQualityReportR struct {
+	ExchangeName            *Exchange
+	ReportDataQualityIssues DataQualityIssueSlice
+}
+
+// NewStruct creates a new relationship struct
+func (*dataQualityReportR) NewStruct() *dataQualityReportR {
+	return &dataQualityReportR{}
+}
+
+// dataQualityReportL is where Load methods for each relationship are stored.
+type dataQualityReportL struct{}
+
+var (
+	dataQualityReportAllColumns            = []string{"id", "exchange_name_id", "base", "quote", "asset", "data_type", "interval", "start_time", "end_time", "records", "created_at"}
+	dataQualityReportColumnsWithoutDefault = []string{"id", "exchange_name_id", "base", "quote", "asset", "data_type", "interval", "start_time", "end_time", "records", "created_at"}
+	dataQualityReportColumnsWithDefault    = []string{}
+	dataQualityReportPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// DataQualityReportSlice is an alias for a slice of pointers to DataQualityReport.
+	// This should generally be used opposed to []DataQualityReport.
+	DataQualityReportSlice []*DataQualityReport
+	// DataQualityReportHook is the signature for custom DataQualityReport hook methods
+	DataQualityReportHook func(context.Context, boil.ContextExecutor, *DataQualityReport) error
+
+	dataQualityReportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	dataQualityReportType                 = reflect.TypeOf(&DataQualityReport{})
+	dataQualityReportMapping              = queries.MakeStructMapping(dataQualityReportType)
+	dataQualityReportPrimaryKeyMapping, _ = queries.BindMapping(dataQualityReportType, dataQualityReportMapping, dataQualityReportPrimaryKeyColumns)
+	dataQualityReportInsertCacheMut       sync.RWMutex
+	dataQualityReportInsertCache          = make(map[string]insertCache)
+	dataQualityReportUpdateCacheMut       sync.RWMutex
+	dataQualityReportUpdateCache          = make(map[string]updateCache)
+	dataQualityReportUpsertCacheMut       sync.RWMutex
+	dataQualityReportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var dataQualityReportBeforeInsertHooks []DataQualityReportHook
+var dataQualityReportBeforeUpdateHooks []DataQualityReportHook
+var dataQualityReportBeforeDeleteHooks []DataQualityReportHook
+var dataQualityReportBeforeUpsertHooks []DataQualityReportHook
+
+var dataQualityReportAfterInsertHooks []DataQualityReportHook
+var dataQualityReportAfterSelectHooks []DataQualityReportHook
+var dataQualityReportAfterUpdateHooks []DataQualityReportHook
+var dataQualityReportAfterDeleteHooks []DataQualityReportHook
+var dataQualityReportAfterUpsertHooks []DataQualityReportHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *DataQualityReport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityReportBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *DataQualityReport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityReportBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *DataQualityReport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityReportBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *DataQualityReport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityReportBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *DataQualityReport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityReportAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *DataQualityReport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityReportAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *DataQualityReport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityReportAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *DataQualityReport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityReportAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *DataQualityReport) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range dataQualityReportAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddDataQualityReportHook registers your hook function for all future operations.
+func AddDataQualityReportHook(hookPoint boil.HookPoint, dataQualityReportHook DataQualityReportHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		dataQualityReportBeforeInsertHooks = append(dataQualityReportBeforeInsertHooks, dataQualityReportHook)
+	case boil.BeforeUpdateHook:
+		dataQualityReportBeforeUpdateHooks = append(dataQualityReportBeforeUpdateHooks, dataQualityReportHook)
+	case boil.BeforeDeleteHook:
+		dataQualityReportBeforeDeleteHooks = append(dataQualityReportBeforeDeleteHooks, dataQualityReportHook)
+	case boil.BeforeUpsertHook:
+		dataQualityReportBeforeUpsertHooks = append(dataQualityReportBeforeUpsertHooks, dataQualityReportHook)
+	case boil.AfterInsertHook:
+		dataQualityReportAfterInsertHooks = append(dataQualityReportAfterInsertHooks, dataQualityReportHook)
+	case boil.AfterSelectHook:
+		dataQualityReportAfterSelectHooks = append(dataQualityReportAfterSelectHooks, dataQualityReportHook)
+	case boil.AfterUpdateHook:
+		dataQualityReportAfterUpdateHooks = append(dataQualityReportAfterUpdateHooks, dataQualityReportHook)
+	case boil.AfterDeleteHook:
+		dataQualityReportAfterDeleteHooks = append(dataQualityReportAfterDeleteHooks, dataQualityReportHook)
+	case boil.AfterUpsertHook:
+		dataQualityReportAfterUpsertHooks = append(dataQualityReportAfterUpsertHooks, dataQualityReportHook)
+	}
+}
+
+// One returns a single dataQualityReport record from the query.
+func (q dataQualityReportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*DataQualityReport, error) {
+	o := &DataQualityReport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for data_quality_report")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all DataQualityReport records from the query.
+func (q dataQualityReportQuery) All(ctx context.Context, exec boil.ContextExecutor) (DataQualityReportSlice, error) {
+	var o []*DataQualityReport
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to DataQualityReport slice")
+	}
+
+	if len(dataQualityReportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all DataQualityReport records in the query.
+func (q dataQualityReportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count data_quality_report rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q dataQualityReportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if data_quality_report exists")
+	}
+
+	return count > 0, nil
+}
+
+// ExchangeName pointed to by the foreign key.
+func (o *DataQualityReport) ExchangeName(mods ...qm.QueryMod) exchangeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ExchangeNameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Exchanges(queryMods...)
+	queries.SetFrom(query.Query, "\"exchange\"")
+
+	return query
+}
+
+// ReportDataQualityIssues retrieves all the data_quality_issue's DataQualityIssues with an executor via report_id column.
+func (o *DataQualityReport) ReportDataQualityIssues(mods ...qm.QueryMod) dataQualityIssueQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"data_quality_issue\".\"report_id\"=?", o.ID),
+	)
+
+	query := DataQualityIssues(queryMods...)
+	queries.SetFrom(query.Query, "\"data_quality_issue\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"data_quality_issue\".*"})
+	}
+
+	return query
+}
+
+// LoadExchangeName allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (dataQualityReportL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataQualityReport interface{}, mods queries.Applicator) error {
+	var slice []*DataQualityReport
+	var object *DataQualityReport
+
+	if singular {
+		object = maybeDataQualityReport.(*DataQualityReport)
+	} else {
+		slice = *maybeDataQualityReport.(*[]*DataQualityReport)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dataQualityReportR{}
+		}
+		args = append(args, object.ExchangeNameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataQualityReportR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ExchangeNameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ExchangeNameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`exchange`), qm.WhereIn(`exchange.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Exchange")
+	}
+
+	var resultSlice []*Exchange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Exchange")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for exchange")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exchange")
+	}
+
+	if len(dataQualityReportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeName = foreign
+		if foreign.R == nil {
+			foreign.R = &exchangeR{}
+		}
+		foreign.R.ExchangeNameDataQualityReports = append(foreign.R.ExchangeNameDataQualityReports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ExchangeNameID == foreign.ID {
+				local.R.ExchangeName = foreign
+				if foreign.R == nil {
+					foreign.R = &exchangeR{}
+				}
+				foreign.R.ExchangeNameDataQualityReports = append(foreign.R.ExchangeNameDataQualityReports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadReportDataQualityIssues allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (dataQualityReportL) LoadReportDataQualityIssues(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDataQualityReport interface{}, mods queries.Applicator) error {
+	var slice []*DataQualityReport
+	var object *DataQualityReport
+
+	if singular {
+		object = maybeDataQualityReport.(*DataQualityReport)
+	} else {
+		slice = *maybeDataQualityReport.(*[]*DataQualityReport)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &dataQualityReportR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &dataQualityReportR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`data_quality_issue`), qm.WhereIn(`data_quality_issue.report_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_quality_issue")
+	}
+
+	var resultSlice []*DataQualityIssue
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_quality_issue")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_quality_issue")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_quality_issue")
+	}
+
+	if len(dataQualityIssueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ReportDataQualityIssues = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataQualityIssueR{}
+			}
+			foreign.R.Report = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ReportID {
+				local.R.ReportDataQualityIssues = append(local.R.ReportDataQualityIssues, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataQualityIssueR{}
+				}
+				foreign.R.Report = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeName of the dataQualityReport to the related item.
+// Sets o.R.ExchangeName to related.
+// Adds o to related.R.ExchangeNameDataQualityReports.
+func (o *DataQualityReport) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"data_quality_report\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+		strmangle.WhereClause("\"", "\"", 0, dataQualityReportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ExchangeNameID = related.ID
+	if o.R == nil {
+		o.R = &dataQualityReportR{
+			ExchangeName: related,
+		}
+	} else {
+		o.R.ExchangeName = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			ExchangeNameDataQualityReports: DataQualityReportSlice{o},
+		}
+	} else {
+		related.R.ExchangeNameDataQualityReports = append(related.R.ExchangeNameDataQualityReports, o)
+	}
+
+	return nil
+}
+
+// AddReportDataQualityIssues adds the given related objects to the existing relationships
+// of the data_quality_report, optionally inserting them as new records.
+// Appends related to o.R.ReportDataQualityIssues.
+// Sets related.R.Report appropriately.
+func (o *DataQualityReport) AddReportDataQualityIssues(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataQualityIssue) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ReportID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"data_quality_issue\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"report_id"}),
+				strmangle.WhereClause("\"", "\"", 0, dataQualityIssuePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ReportID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &dataQualityReportR{
+			ReportDataQualityIssues: related,
+		}
+	} else {
+		o.R.ReportDataQualityIssues = append(o.R.ReportDataQualityIssues, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataQualityIssueR{
+				Report: o,
+			}
+		} else {
+			rel.R.Report = o
+		}
+	}
+	return nil
+}
+
+// DataQualityReports retrieves all the records using an executor.
+func DataQualityReports(mods ...qm.QueryMod) dataQualityReportQuery {
+	mods = append(mods, qm.From("\"data_quality_report\""))
+	return dataQualityReportQuery{NewQuery(mods...)}
+}
+
+// FindDataQualityReport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindDataQualityReport(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*DataQualityReport, error) {
+	dataQualityReportObj := &DataQualityReport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"data_quality_report\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, dataQualityReportObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from data_quality_report")
+	}
+
+	return dataQualityReportObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *DataQualityReport) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no data_quality_report provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(dataQualityReportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	dataQualityReportInsertCacheMut.RLock()
+	cache, cached := dataQualityReportInsertCache[key]
+	dataQualityReportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			dataQualityReportAllColumns,
+			dataQualityReportColumnsWithDefault,
+			dataQualityReportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(dataQualityReportType, dataQualityReportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(dataQualityReportType, dataQualityReportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"data_quality_report\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"data_quality_report\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"data_quality_report\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, dataQualityReportPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into data_quality_report")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for data_quality_report")
+	}
+
+CacheNoHooks:
+	if !cached {
+		dataQualityReportInsertCacheMut.Lock()
+		dataQualityReportInsertCache[key] = cache
+		dataQualityReportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the DataQualityReport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *DataQualityReport) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	dataQualityReportUpdateCacheMut.RLock()
+	cache, cached := dataQualityReportUpdateCache[key]
+	dataQualityReportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			dataQualityReportAllColumns,
+			dataQualityReportPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update data_quality_report, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"data_quality_report\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, dataQualityReportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(dataQualityReportType, dataQualityReportMapping, append(wl, dataQualityReportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update data_quality_report row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for data_quality_report")
+	}
+
+	if !cached {
+		dataQualityReportUpdateCacheMut.Lock()
+		dataQualityReportUpdateCache[key] = cache
+		dataQualityReportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q dataQualityReportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for data_quality_report")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for data_quality_report")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o DataQualityReportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataQualityReportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"data_quality_report\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataQualityReportPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in dataQualityReport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all dataQualityReport")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single DataQualityReport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *DataQualityReport) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no DataQualityReport provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), dataQualityReportPrimaryKeyMapping)
+	sql := "DELETE FROM \"data_quality_report\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from data_quality_report")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for data_quality_report")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q dataQualityReportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no dataQualityReportQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from data_quality_report")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for data_quality_report")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o DataQualityReportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(dataQualityReportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataQualityReportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"data_quality_report\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataQualityReportPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from dataQualityReport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for data_quality_report")
+	}
+
+	if len(dataQualityReportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *DataQualityReport) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindDataQualityReport(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *DataQualityReportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := DataQualityReportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), dataQualityReportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"data_quality_report\".* FROM \"data_quality_report\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, dataQualityReportPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in DataQualityReportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// DataQualityReportExists checks if the DataQualityReport row exists.
+func DataQualityReportExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"data_quality_report\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if data_quality_report exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,946 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testDataQualityReports(t *testing.T) {
+	t.Parallel()
+
+	query := DataQualityReports()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testDataQualityReportsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DataQualityReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDataQualityReportsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := DataQualityReports().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DataQualityReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDataQualityReportsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DataQualityReportSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := DataQualityReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testDataQualityReportsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := DataQualityReportExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if DataQualityReport exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected DataQualityReportExists to return true, but got false.")
+	}
+}
+
+func testDataQualityReportsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	dataQualityReportFound, err := FindDataQualityReport(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if dataQualityReportFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testDataQualityReportsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = DataQualityReports().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDataQualityReportsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := DataQualityReports().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testDataQualityReportsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	dataQualityReportOne := &DataQualityReport{}
+	dataQualityReportTwo := &DataQualityReport{}
+	if err = randomize.Struct(seed, dataQualityReportOne, dataQualityReportDBTypes, false, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dataQualityReportTwo, dataQualityReportDBTypes, false, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dataQualityReportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dataQualityReportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DataQualityReports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testDataQualityReportsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	dataQualityReportOne := &DataQualityReport{}
+	dataQualityReportTwo := &DataQualityReport{}
+	if err = randomize.Struct(seed, dataQualityReportOne, dataQualityReportDBTypes, false, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+	if err = randomize.Struct(seed, dataQualityReportTwo, dataQualityReportDBTypes, false, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = dataQualityReportOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = dataQualityReportTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func dataQualityReportBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityReport) error {
+	*o = DataQualityReport{}
+	return nil
+}
+
+func dataQualityReportAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityReport) error {
+	*o = DataQualityReport{}
+	return nil
+}
+
+func dataQualityReportAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityReport) error {
+	*o = DataQualityReport{}
+	return nil
+}
+
+func dataQualityReportBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityReport) error {
+	*o = DataQualityReport{}
+	return nil
+}
+
+func dataQualityReportAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityReport) error {
+	*o = DataQualityReport{}
+	return nil
+}
+
+func dataQualityReportBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityReport) error {
+	*o = DataQualityReport{}
+	return nil
+}
+
+func dataQualityReportAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityReport) error {
+	*o = DataQualityReport{}
+	return nil
+}
+
+func dataQualityReportBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityReport) error {
+	*o = DataQualityReport{}
+	return nil
+}
+
+func dataQualityReportAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *DataQualityReport) error {
+	*o = DataQualityReport{}
+	return nil
+}
+
+func testDataQualityReportsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &DataQualityReport{}
+	o := &DataQualityReport{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport object: %s", err)
+	}
+
+	AddDataQualityReportHook(boil.BeforeInsertHook, dataQualityReportBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	dataQualityReportBeforeInsertHooks = []DataQualityReportHook{}
+
+	AddDataQualityReportHook(boil.AfterInsertHook, dataQualityReportAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	dataQualityReportAfterInsertHooks = []DataQualityReportHook{}
+
+	AddDataQualityReportHook(boil.AfterSelectHook, dataQualityReportAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	dataQualityReportAfterSelectHooks = []DataQualityReportHook{}
+
+	AddDataQualityReportHook(boil.BeforeUpdateHook, dataQualityReportBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	dataQualityReportBeforeUpdateHooks = []DataQualityReportHook{}
+
+	AddDataQualityReportHook(boil.AfterUpdateHook, dataQualityReportAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	dataQualityReportAfterUpdateHooks = []DataQualityReportHook{}
+
+	AddDataQualityReportHook(boil.BeforeDeleteHook, dataQualityReportBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	dataQualityReportBeforeDeleteHooks = []DataQualityReportHook{}
+
+	AddDataQualityReportHook(boil.AfterDeleteHook, dataQualityReportAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	dataQualityReportAfterDeleteHooks = []DataQualityReportHook{}
+
+	AddDataQualityReportHook(boil.BeforeUpsertHook, dataQualityReportBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	dataQualityReportBeforeUpsertHooks = []DataQualityReportHook{}
+
+	AddDataQualityReportHook(boil.AfterUpsertHook, dataQualityReportAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	dataQualityReportAfterUpsertHooks = []DataQualityReportHook{}
+}
+
+func testDataQualityReportsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDataQualityReportsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(dataQualityReportColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testDataQualityReportToManyReportDataQualityIssues(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DataQualityReport
+	var b, c DataQualityIssue
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, dataQualityIssueDBTypes, false, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dataQualityIssueDBTypes, false, dataQualityIssueColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ReportID = a.ID
+	c.ReportID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ReportDataQualityIssues().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ReportID == b.ReportID {
+			bFound = true
+		}
+		if v.ReportID == c.ReportID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := DataQualityReportSlice{&a}
+	if err = a.L.LoadReportDataQualityIssues(ctx, tx, false, (*[]*DataQualityReport)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReportDataQualityIssues); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ReportDataQualityIssues = nil
+	if err = a.L.LoadReportDataQualityIssues(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ReportDataQualityIssues); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testDataQualityReportToManyAddOpReportDataQualityIssues(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DataQualityReport
+	var b, c, d, e DataQualityIssue
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, dataQualityReportDBTypes, false, strmangle.SetComplement(dataQualityReportPrimaryKeyColumns, dataQualityReportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DataQualityIssue{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dataQualityIssueDBTypes, false, strmangle.SetComplement(dataQualityIssuePrimaryKeyColumns, dataQualityIssueColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*DataQualityIssue{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddReportDataQualityIssues(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ReportID {
+			t.Error("foreign key was wrong value", a.ID, first.ReportID)
+		}
+		if a.ID != second.ReportID {
+			t.Error("foreign key was wrong value", a.ID, second.ReportID)
+		}
+
+		if first.R.Report != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.Report != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ReportDataQualityIssues[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ReportDataQualityIssues[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ReportDataQualityIssues().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testDataQualityReportToOneExchangeUsingExchangeName(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local DataQualityReport
+	var foreign Exchange
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, dataQualityReportDBTypes, false, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, exchangeDBTypes, false, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ExchangeNameID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ExchangeName().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := DataQualityReportSlice{&local}
+	if err = local.L.LoadExchangeName(ctx, tx, false, (*[]*DataQualityReport)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ExchangeName = nil
+	if err = local.L.LoadExchangeName(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ExchangeName == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testDataQualityReportToOneSetOpExchangeUsingExchangeName(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a DataQualityReport
+	var b, c Exchange
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, dataQualityReportDBTypes, false, strmangle.SetComplement(dataQualityReportPrimaryKeyColumns, dataQualityReportColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Exchange{&b, &c} {
+		err = a.SetExchangeName(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ExchangeName != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ExchangeNameDataQualityReports[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ExchangeNameID))
+		reflect.Indirect(reflect.ValueOf(&a.ExchangeNameID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ExchangeNameID != x.ID {
+			t.Error("foreign key was wrong value", a.ExchangeNameID, x.ID)
+		}
+	}
+}
+
+func testDataQualityReportsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDataQualityReportsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := DataQualityReportSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testDataQualityReportsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := DataQualityReports().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	dataQualityReportDBTypes = map[string]string{`ID`: `TEXT`, `ExchangeNameID`: `TEXT`, `Base`: `TEXT`, `Quote`: `TEXT`, `Asset`: `TEXT`, `DataType`: `TEXT`, `Interval`: `INTEGER`, `StartTime`: `TIMESTAMP`, `EndTime`: `TIMESTAMP`, `Records`: `INTEGER`, `CreatedAt`: `TIMESTAMP`}
+	_                        = bytes.MinRead
+)
+
+func testDataQualityReportsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(dataQualityReportPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(dataQualityReportAllColumns) == len(dataQualityReportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testDataQualityReportsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(dataQualityReportAllColumns) == len(dataQualityReportPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &DataQualityReport{}
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := DataQualityReports().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, dataQualityReportDBTypes, true, dataQualityReportPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize DataQualityReport struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(dataQualityReportAllColumns, dataQualityReportPrimaryKeyColumns) {
+		fields = dataQualityReportAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			dataQualityReportAllColumns,
+			dataQualityReportPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := DataQualityReportSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
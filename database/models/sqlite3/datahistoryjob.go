@@ -195,17 +195,25 @@ var DatahistoryjobRels = struct {
 	SecondaryExchange              string
 	ValidationJobCandles           string
 	SourceJobCandles               string
+	RepairJobDataQualityIssues     string
 	PrerequisiteJobDatahistoryjobs string
 	JobDatahistoryjobs             string
 	JobDatahistoryjobresults       string
+	SourceJobFundingRates          string
+	SourceJobOpenInterests         string
+	SourceJobPriceCandles          string
 }{
 	ExchangeName:                   "ExchangeName",
 	SecondaryExchange:              "SecondaryExchange",
 	ValidationJobCandles:           "ValidationJobCandles",
 	SourceJobCandles:               "SourceJobCandles",
+	RepairJobDataQualityIssues:     "RepairJobDataQualityIssues",
 	PrerequisiteJobDatahistoryjobs: "PrerequisiteJobDatahistoryjobs",
 	JobDatahistoryjobs:             "JobDatahistoryjobs",
 	JobDatahistoryjobresults:       "JobDatahistoryjobresults",
+	SourceJobFundingRates:          "SourceJobFundingRates",
+	SourceJobOpenInterests:         "SourceJobOpenInterests",
+	SourceJobPriceCandles:          "SourceJobPriceCandles",
 }
 
 // datahistoryjobR is where relationships are stored.
@@ -214,9 +222,13 @@ type datahistoryjobR struct {
 	SecondaryExchange              *Exchange
 	ValidationJobCandles           CandleSlice
 	SourceJobCandles               CandleSlice
+	RepairJobDataQualityIssues     DataQualityIssueSlice
 	PrerequisiteJobDatahistoryjobs DatahistoryjobSlice
 	JobDatahistoryjobs             DatahistoryjobSlice
 	JobDatahistoryjobresults       DatahistoryjobresultSlice
+	SourceJobFundingRates          FundingRateSlice
+	SourceJobOpenInterests         OpenInterestSlice
+	SourceJobPriceCandles          PriceCandleSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -579,6 +591,27 @@ func (o *Datahistoryjob) SourceJobCandles(mods ...qm.QueryMod) candleQuery {
 	return query
 }
 
+// RepairJobDataQualityIssues retrieves all the data_quality_issue's DataQualityIssues with an executor via repair_job_id column.
+func (o *Datahistoryjob) RepairJobDataQualityIssues(mods ...qm.QueryMod) dataQualityIssueQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"data_quality_issue\".\"repair_job_id\"=?", o.ID),
+	)
+
+	query := DataQualityIssues(queryMods...)
+	queries.SetFrom(query.Query, "\"data_quality_issue\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"data_quality_issue\".*"})
+	}
+
+	return query
+}
+
 // PrerequisiteJobDatahistoryjobs retrieves all the datahistoryjob's Datahistoryjobs with an executor via id column.
 func (o *Datahistoryjob) PrerequisiteJobDatahistoryjobs(mods ...qm.QueryMod) datahistoryjobQuery {
 	var queryMods []qm.QueryMod
@@ -644,6 +677,69 @@ func (o *Datahistoryjob) JobDatahistoryjobresults(mods ...qm.QueryMod) datahisto
 	return query
 }
 
+// SourceJobFundingRates retrieves all the funding_rate's FundingRates with an executor via source_job_id column.
+func (o *Datahistoryjob) SourceJobFundingRates(mods ...qm.QueryMod) fundingRateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"funding_rate\".\"source_job_id\"=?", o.ID),
+	)
+
+	query := FundingRates(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_rate\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"funding_rate\".*"})
+	}
+
+	return query
+}
+
+// SourceJobOpenInterests retrieves all the open_interest's OpenInterests with an executor via source_job_id column.
+func (o *Datahistoryjob) SourceJobOpenInterests(mods ...qm.QueryMod) openInterestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"open_interest\".\"source_job_id\"=?", o.ID),
+	)
+
+	query := OpenInterests(queryMods...)
+	queries.SetFrom(query.Query, "\"open_interest\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"open_interest\".*"})
+	}
+
+	return query
+}
+
+// SourceJobPriceCandles retrieves all the price_candle's PriceCandles with an executor via source_job_id column.
+func (o *Datahistoryjob) SourceJobPriceCandles(mods ...qm.QueryMod) priceCandleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"price_candle\".\"source_job_id\"=?", o.ID),
+	)
+
+	query := PriceCandles(queryMods...)
+	queries.SetFrom(query.Query, "\"price_candle\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"price_candle\".*"})
+	}
+
+	return query
+}
+
 // LoadExchangeName allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (datahistoryjobL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatahistoryjob interface{}, mods queries.Applicator) error {
@@ -1040,6 +1136,101 @@ func (datahistoryjobL) LoadSourceJobCandles(ctx context.Context, e boil.ContextE
 	return nil
 }
 
+// LoadRepairJobDataQualityIssues allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (datahistoryjobL) LoadRepairJobDataQualityIssues(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatahistoryjob interface{}, mods queries.Applicator) error {
+	var slice []*Datahistoryjob
+	var object *Datahistoryjob
+
+	if singular {
+		object = maybeDatahistoryjob.(*Datahistoryjob)
+	} else {
+		slice = *maybeDatahistoryjob.(*[]*Datahistoryjob)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &datahistoryjobR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &datahistoryjobR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`data_quality_issue`), qm.WhereIn(`data_quality_issue.repair_job_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_quality_issue")
+	}
+
+	var resultSlice []*DataQualityIssue
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_quality_issue")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_quality_issue")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_quality_issue")
+	}
+
+	if len(dataQualityIssueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RepairJobDataQualityIssues = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataQualityIssueR{}
+			}
+			foreign.R.RepairJob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.RepairJobID) {
+				local.R.RepairJobDataQualityIssues = append(local.R.RepairJobDataQualityIssues, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataQualityIssueR{}
+				}
+				foreign.R.RepairJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadPrerequisiteJobDatahistoryjobs allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (datahistoryjobL) LoadPrerequisiteJobDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatahistoryjob interface{}, mods queries.Applicator) error {
@@ -1365,99 +1556,384 @@ func (datahistoryjobL) LoadJobDatahistoryjobresults(ctx context.Context, e boil.
 	return nil
 }
 
-// SetExchangeName of the datahistoryjob to the related item.
-// Sets o.R.ExchangeName to related.
-// Adds o to related.R.ExchangeNameDatahistoryjobs.
-func (o *Datahistoryjob) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"datahistoryjob\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
-		strmangle.WhereClause("\"", "\"", 0, datahistoryjobPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
+// LoadSourceJobFundingRates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (datahistoryjobL) LoadSourceJobFundingRates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatahistoryjob interface{}, mods queries.Applicator) error {
+	var slice []*Datahistoryjob
+	var object *Datahistoryjob
 
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if singular {
+		object = maybeDatahistoryjob.(*Datahistoryjob)
+	} else {
+		slice = *maybeDatahistoryjob.(*[]*Datahistoryjob)
 	}
 
-	o.ExchangeNameID = related.ID
-	if o.R == nil {
-		o.R = &datahistoryjobR{
-			ExchangeName: related,
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &datahistoryjobR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		o.R.ExchangeName = related
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &datahistoryjobR{}
+			}
 
-	if related.R == nil {
-		related.R = &exchangeR{
-			ExchangeNameDatahistoryjobs: DatahistoryjobSlice{o},
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
 		}
-	} else {
-		related.R.ExchangeNameDatahistoryjobs = append(related.R.ExchangeNameDatahistoryjobs, o)
 	}
 
-	return nil
-}
+	if len(args) == 0 {
+		return nil
+	}
 
-// SetSecondaryExchange of the datahistoryjob to the related item.
-// Sets o.R.SecondaryExchange to related.
-// Adds o to related.R.SecondaryExchangeDatahistoryjobs.
-func (o *Datahistoryjob) SetSecondaryExchange(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
+	query := NewQuery(qm.From(`funding_rate`), qm.WhereIn(`funding_rate.source_job_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"datahistoryjob\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 0, []string{"secondary_exchange_id"}),
-		strmangle.WhereClause("\"", "\"", 0, datahistoryjobPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load funding_rate")
+	}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
+	var resultSlice []*FundingRate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice funding_rate")
 	}
 
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on funding_rate")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_rate")
 	}
 
-	queries.Assign(&o.SecondaryExchangeID, related.ID)
-	if o.R == nil {
-		o.R = &datahistoryjobR{
-			SecondaryExchange: related,
+	if len(fundingRateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.SecondaryExchange = related
 	}
-
-	if related.R == nil {
-		related.R = &exchangeR{
-			SecondaryExchangeDatahistoryjobs: DatahistoryjobSlice{o},
+	if singular {
+		object.R.SourceJobFundingRates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &fundingRateR{}
+			}
+			foreign.R.SourceJob = object
 		}
-	} else {
-		related.R.SecondaryExchangeDatahistoryjobs = append(related.R.SecondaryExchangeDatahistoryjobs, o)
+		return nil
 	}
 
-	return nil
-}
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SourceJobID) {
+				local.R.SourceJobFundingRates = append(local.R.SourceJobFundingRates, foreign)
+				if foreign.R == nil {
+					foreign.R = &fundingRateR{}
+				}
+				foreign.R.SourceJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSourceJobOpenInterests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (datahistoryjobL) LoadSourceJobOpenInterests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatahistoryjob interface{}, mods queries.Applicator) error {
+	var slice []*Datahistoryjob
+	var object *Datahistoryjob
+
+	if singular {
+		object = maybeDatahistoryjob.(*Datahistoryjob)
+	} else {
+		slice = *maybeDatahistoryjob.(*[]*Datahistoryjob)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &datahistoryjobR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &datahistoryjobR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`open_interest`), qm.WhereIn(`open_interest.source_job_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load open_interest")
+	}
+
+	var resultSlice []*OpenInterest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice open_interest")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on open_interest")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for open_interest")
+	}
+
+	if len(openInterestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SourceJobOpenInterests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &openInterestR{}
+			}
+			foreign.R.SourceJob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SourceJobID) {
+				local.R.SourceJobOpenInterests = append(local.R.SourceJobOpenInterests, foreign)
+				if foreign.R == nil {
+					foreign.R = &openInterestR{}
+				}
+				foreign.R.SourceJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSourceJobPriceCandles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (datahistoryjobL) LoadSourceJobPriceCandles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatahistoryjob interface{}, mods queries.Applicator) error {
+	var slice []*Datahistoryjob
+	var object *Datahistoryjob
+
+	if singular {
+		object = maybeDatahistoryjob.(*Datahistoryjob)
+	} else {
+		slice = *maybeDatahistoryjob.(*[]*Datahistoryjob)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &datahistoryjobR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &datahistoryjobR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`price_candle`), qm.WhereIn(`price_candle.source_job_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load price_candle")
+	}
+
+	var resultSlice []*PriceCandle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice price_candle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on price_candle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for price_candle")
+	}
+
+	if len(priceCandleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SourceJobPriceCandles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &priceCandleR{}
+			}
+			foreign.R.SourceJob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SourceJobID) {
+				local.R.SourceJobPriceCandles = append(local.R.SourceJobPriceCandles, foreign)
+				if foreign.R == nil {
+					foreign.R = &priceCandleR{}
+				}
+				foreign.R.SourceJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetExchangeName of the datahistoryjob to the related item.
+// Sets o.R.ExchangeName to related.
+// Adds o to related.R.ExchangeNameDatahistoryjobs.
+func (o *Datahistoryjob) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"datahistoryjob\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+		strmangle.WhereClause("\"", "\"", 0, datahistoryjobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ExchangeNameID = related.ID
+	if o.R == nil {
+		o.R = &datahistoryjobR{
+			ExchangeName: related,
+		}
+	} else {
+		o.R.ExchangeName = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			ExchangeNameDatahistoryjobs: DatahistoryjobSlice{o},
+		}
+	} else {
+		related.R.ExchangeNameDatahistoryjobs = append(related.R.ExchangeNameDatahistoryjobs, o)
+	}
+
+	return nil
+}
+
+// SetSecondaryExchange of the datahistoryjob to the related item.
+// Sets o.R.SecondaryExchange to related.
+// Adds o to related.R.SecondaryExchangeDatahistoryjobs.
+func (o *Datahistoryjob) SetSecondaryExchange(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"datahistoryjob\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"secondary_exchange_id"}),
+		strmangle.WhereClause("\"", "\"", 0, datahistoryjobPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SecondaryExchangeID, related.ID)
+	if o.R == nil {
+		o.R = &datahistoryjobR{
+			SecondaryExchange: related,
+		}
+	} else {
+		o.R.SecondaryExchange = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			SecondaryExchangeDatahistoryjobs: DatahistoryjobSlice{o},
+		}
+	} else {
+		related.R.SecondaryExchangeDatahistoryjobs = append(related.R.SecondaryExchangeDatahistoryjobs, o)
+	}
+
+	return nil
+}
 
 // RemoveSecondaryExchange relationship.
 // Sets o.R.SecondaryExchange to nil.
@@ -1469,44 +1945,290 @@ func (o *Datahistoryjob) RemoveSecondaryExchange(ctx context.Context, exec boil.
 	if _, err = o.Update(ctx, exec, boil.Whitelist("secondary_exchange_id")); err != nil {
 		return errors.Wrap(err, "failed to update local table")
 	}
-
-	o.R.SecondaryExchange = nil
-	if related == nil || related.R == nil {
+
+	o.R.SecondaryExchange = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SecondaryExchangeDatahistoryjobs {
+		if queries.Equal(o.SecondaryExchangeID, ri.SecondaryExchangeID) {
+			continue
+		}
+
+		ln := len(related.R.SecondaryExchangeDatahistoryjobs)
+		if ln > 1 && i < ln-1 {
+			related.R.SecondaryExchangeDatahistoryjobs[i] = related.R.SecondaryExchangeDatahistoryjobs[ln-1]
+		}
+		related.R.SecondaryExchangeDatahistoryjobs = related.R.SecondaryExchangeDatahistoryjobs[:ln-1]
+		break
+	}
+	return nil
+}
+
+// AddValidationJobCandles adds the given related objects to the existing relationships
+// of the datahistoryjob, optionally inserting them as new records.
+// Appends related to o.R.ValidationJobCandles.
+// Sets related.R.ValidationJob appropriately.
+func (o *Datahistoryjob) AddValidationJobCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ValidationJobID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"candle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"validation_job_id"}),
+				strmangle.WhereClause("\"", "\"", 0, candlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ValidationJobID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &datahistoryjobR{
+			ValidationJobCandles: related,
+		}
+	} else {
+		o.R.ValidationJobCandles = append(o.R.ValidationJobCandles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &candleR{
+				ValidationJob: o,
+			}
+		} else {
+			rel.R.ValidationJob = o
+		}
+	}
+	return nil
+}
+
+// SetValidationJobCandles removes all previously related items of the
+// datahistoryjob replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ValidationJob's ValidationJobCandles accordingly.
+// Replaces o.R.ValidationJobCandles with related.
+// Sets related.R.ValidationJob's ValidationJobCandles accordingly.
+func (o *Datahistoryjob) SetValidationJobCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
+	query := "update \"candle\" set \"validation_job_id\" = null where \"validation_job_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ValidationJobCandles {
+			queries.SetScanner(&rel.ValidationJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ValidationJob = nil
+		}
+
+		o.R.ValidationJobCandles = nil
+	}
+	return o.AddValidationJobCandles(ctx, exec, insert, related...)
+}
+
+// RemoveValidationJobCandles relationships from objects passed in.
+// Removes related items from R.ValidationJobCandles (uses pointer comparison, removal does not keep order)
+// Sets related.R.ValidationJob.
+func (o *Datahistoryjob) RemoveValidationJobCandles(ctx context.Context, exec boil.ContextExecutor, related ...*Candle) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ValidationJobID, nil)
+		if rel.R != nil {
+			rel.R.ValidationJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("validation_job_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ValidationJobCandles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ValidationJobCandles)
+			if ln > 1 && i < ln-1 {
+				o.R.ValidationJobCandles[i] = o.R.ValidationJobCandles[ln-1]
+			}
+			o.R.ValidationJobCandles = o.R.ValidationJobCandles[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSourceJobCandles adds the given related objects to the existing relationships
+// of the datahistoryjob, optionally inserting them as new records.
+// Appends related to o.R.SourceJobCandles.
+// Sets related.R.SourceJob appropriately.
+func (o *Datahistoryjob) AddSourceJobCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SourceJobID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"candle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"source_job_id"}),
+				strmangle.WhereClause("\"", "\"", 0, candlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SourceJobID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &datahistoryjobR{
+			SourceJobCandles: related,
+		}
+	} else {
+		o.R.SourceJobCandles = append(o.R.SourceJobCandles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &candleR{
+				SourceJob: o,
+			}
+		} else {
+			rel.R.SourceJob = o
+		}
+	}
+	return nil
+}
+
+// SetSourceJobCandles removes all previously related items of the
+// datahistoryjob replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SourceJob's SourceJobCandles accordingly.
+// Replaces o.R.SourceJobCandles with related.
+// Sets related.R.SourceJob's SourceJobCandles accordingly.
+func (o *Datahistoryjob) SetSourceJobCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
+	query := "update \"candle\" set \"source_job_id\" = null where \"source_job_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SourceJobCandles {
+			queries.SetScanner(&rel.SourceJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SourceJob = nil
+		}
+
+		o.R.SourceJobCandles = nil
+	}
+	return o.AddSourceJobCandles(ctx, exec, insert, related...)
+}
+
+// RemoveSourceJobCandles relationships from objects passed in.
+// Removes related items from R.SourceJobCandles (uses pointer comparison, removal does not keep order)
+// Sets related.R.SourceJob.
+func (o *Datahistoryjob) RemoveSourceJobCandles(ctx context.Context, exec boil.ContextExecutor, related ...*Candle) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SourceJobID, nil)
+		if rel.R != nil {
+			rel.R.SourceJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("source_job_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for i, ri := range related.R.SecondaryExchangeDatahistoryjobs {
-		if queries.Equal(o.SecondaryExchangeID, ri.SecondaryExchangeID) {
-			continue
-		}
+	for _, rel := range related {
+		for i, ri := range o.R.SourceJobCandles {
+			if rel != ri {
+				continue
+			}
 
-		ln := len(related.R.SecondaryExchangeDatahistoryjobs)
-		if ln > 1 && i < ln-1 {
-			related.R.SecondaryExchangeDatahistoryjobs[i] = related.R.SecondaryExchangeDatahistoryjobs[ln-1]
+			ln := len(o.R.SourceJobCandles)
+			if ln > 1 && i < ln-1 {
+				o.R.SourceJobCandles[i] = o.R.SourceJobCandles[ln-1]
+			}
+			o.R.SourceJobCandles = o.R.SourceJobCandles[:ln-1]
+			break
 		}
-		related.R.SecondaryExchangeDatahistoryjobs = related.R.SecondaryExchangeDatahistoryjobs[:ln-1]
-		break
 	}
+
 	return nil
 }
 
-// AddValidationJobCandles adds the given related objects to the existing relationships
+// AddRepairJobDataQualityIssues adds the given related objects to the existing relationships
 // of the datahistoryjob, optionally inserting them as new records.
-// Appends related to o.R.ValidationJobCandles.
-// Sets related.R.ValidationJob appropriately.
-func (o *Datahistoryjob) AddValidationJobCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
+// Appends related to o.R.RepairJobDataQualityIssues.
+// Sets related.R.RepairJob appropriately.
+func (o *Datahistoryjob) AddRepairJobDataQualityIssues(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataQualityIssue) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.ValidationJobID, o.ID)
+			queries.Assign(&rel.RepairJobID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"candle\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 0, []string{"validation_job_id"}),
-				strmangle.WhereClause("\"", "\"", 0, candlePrimaryKeyColumns),
+				"UPDATE \"data_quality_issue\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"repair_job_id"}),
+				strmangle.WhereClause("\"", "\"", 0, dataQualityIssuePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1515,42 +2237,300 @@ func (o *Datahistoryjob) AddValidationJobCandles(ctx context.Context, exec boil.
 				fmt.Fprintln(boil.DebugWriter, values)
 			}
 
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
-			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.RepairJobID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &datahistoryjobR{
+			RepairJobDataQualityIssues: related,
+		}
+	} else {
+		o.R.RepairJobDataQualityIssues = append(o.R.RepairJobDataQualityIssues, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataQualityIssueR{
+				RepairJob: o,
+			}
+		} else {
+			rel.R.RepairJob = o
+		}
+	}
+	return nil
+}
+
+// SetRepairJobDataQualityIssues removes all previously related items of the
+// datahistoryjob replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.RepairJob's RepairJobDataQualityIssues accordingly.
+// Replaces o.R.RepairJobDataQualityIssues with related.
+// Sets related.R.RepairJob's RepairJobDataQualityIssues accordingly.
+func (o *Datahistoryjob) SetRepairJobDataQualityIssues(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataQualityIssue) error {
+	query := "update \"data_quality_issue\" set \"repair_job_id\" = null where \"repair_job_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.RepairJobDataQualityIssues {
+			queries.SetScanner(&rel.RepairJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.RepairJob = nil
+		}
+
+		o.R.RepairJobDataQualityIssues = nil
+	}
+	return o.AddRepairJobDataQualityIssues(ctx, exec, insert, related...)
+}
+
+// RemoveRepairJobDataQualityIssues relationships from objects passed in.
+// Removes related items from R.RepairJobDataQualityIssues (uses pointer comparison, removal does not keep order)
+// Sets related.R.RepairJob.
+func (o *Datahistoryjob) RemoveRepairJobDataQualityIssues(ctx context.Context, exec boil.ContextExecutor, related ...*DataQualityIssue) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.RepairJobID, nil)
+		if rel.R != nil {
+			rel.R.RepairJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("repair_job_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.RepairJobDataQualityIssues {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.RepairJobDataQualityIssues)
+			if ln > 1 && i < ln-1 {
+				o.R.RepairJobDataQualityIssues[i] = o.R.RepairJobDataQualityIssues[ln-1]
+			}
+			o.R.RepairJobDataQualityIssues = o.R.RepairJobDataQualityIssues[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPrerequisiteJobDatahistoryjobs adds the given related objects to the existing relationships
+// of the datahistoryjob, optionally inserting them as new records.
+// Appends related to o.R.PrerequisiteJobDatahistoryjobs.
+// Sets related.R.JobDatahistoryjobs appropriately.
+func (o *Datahistoryjob) AddPrerequisiteJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"datahistoryjobrelations\" (\"job_id\", \"prerequisite_job_id\") values (?, ?)"
+		values := []interface{}{o.ID, rel.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, query)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &datahistoryjobR{
+			PrerequisiteJobDatahistoryjobs: related,
+		}
+	} else {
+		o.R.PrerequisiteJobDatahistoryjobs = append(o.R.PrerequisiteJobDatahistoryjobs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &datahistoryjobR{
+				JobDatahistoryjobs: DatahistoryjobSlice{o},
+			}
+		} else {
+			rel.R.JobDatahistoryjobs = append(rel.R.JobDatahistoryjobs, o)
+		}
+	}
+	return nil
+}
+
+// SetPrerequisiteJobDatahistoryjobs removes all previously related items of the
+// datahistoryjob replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.JobDatahistoryjobs's PrerequisiteJobDatahistoryjobs accordingly.
+// Replaces o.R.PrerequisiteJobDatahistoryjobs with related.
+// Sets related.R.JobDatahistoryjobs's PrerequisiteJobDatahistoryjobs accordingly.
+func (o *Datahistoryjob) SetPrerequisiteJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+	query := "delete from \"datahistoryjobrelations\" where \"job_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removePrerequisiteJobDatahistoryjobsFromJobDatahistoryjobsSlice(o, related)
+	if o.R != nil {
+		o.R.PrerequisiteJobDatahistoryjobs = nil
+	}
+	return o.AddPrerequisiteJobDatahistoryjobs(ctx, exec, insert, related...)
+}
+
+// RemovePrerequisiteJobDatahistoryjobs relationships from objects passed in.
+// Removes related items from R.PrerequisiteJobDatahistoryjobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.JobDatahistoryjobs.
+func (o *Datahistoryjob) RemovePrerequisiteJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, related ...*Datahistoryjob) error {
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"datahistoryjobrelations\" where \"job_id\" = ? and \"prerequisite_job_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.ID)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removePrerequisiteJobDatahistoryjobsFromJobDatahistoryjobsSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PrerequisiteJobDatahistoryjobs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PrerequisiteJobDatahistoryjobs)
+			if ln > 1 && i < ln-1 {
+				o.R.PrerequisiteJobDatahistoryjobs[i] = o.R.PrerequisiteJobDatahistoryjobs[ln-1]
+			}
+			o.R.PrerequisiteJobDatahistoryjobs = o.R.PrerequisiteJobDatahistoryjobs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removePrerequisiteJobDatahistoryjobsFromJobDatahistoryjobsSlice(o *Datahistoryjob, related []*Datahistoryjob) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.JobDatahistoryjobs {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.JobDatahistoryjobs)
+			if ln > 1 && i < ln-1 {
+				rel.R.JobDatahistoryjobs[i] = rel.R.JobDatahistoryjobs[ln-1]
+			}
+			rel.R.JobDatahistoryjobs = rel.R.JobDatahistoryjobs[:ln-1]
+			break
+		}
+	}
+}
+
+// AddJobDatahistoryjobs adds the given related objects to the existing relationships
+// of the datahistoryjob, optionally inserting them as new records.
+// Appends related to o.R.JobDatahistoryjobs.
+// Sets related.R.PrerequisiteJobDatahistoryjobs appropriately.
+func (o *Datahistoryjob) AddJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"datahistoryjobrelations\" (\"prerequisite_job_id\", \"job_id\") values (?, ?)"
+		values := []interface{}{o.ID, rel.ID}
 
-			queries.Assign(&rel.ValidationJobID, o.ID)
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, query)
+			fmt.Fprintln(boil.DebugWriter, values)
 		}
-	}
 
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
 	if o.R == nil {
 		o.R = &datahistoryjobR{
-			ValidationJobCandles: related,
+			JobDatahistoryjobs: related,
 		}
 	} else {
-		o.R.ValidationJobCandles = append(o.R.ValidationJobCandles, related...)
+		o.R.JobDatahistoryjobs = append(o.R.JobDatahistoryjobs, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &candleR{
-				ValidationJob: o,
+			rel.R = &datahistoryjobR{
+				PrerequisiteJobDatahistoryjobs: DatahistoryjobSlice{o},
 			}
 		} else {
-			rel.R.ValidationJob = o
+			rel.R.PrerequisiteJobDatahistoryjobs = append(rel.R.PrerequisiteJobDatahistoryjobs, o)
 		}
 	}
 	return nil
 }
 
-// SetValidationJobCandles removes all previously related items of the
+// SetJobDatahistoryjobs removes all previously related items of the
 // datahistoryjob replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.ValidationJob's ValidationJobCandles accordingly.
-// Replaces o.R.ValidationJobCandles with related.
-// Sets related.R.ValidationJob's ValidationJobCandles accordingly.
-func (o *Datahistoryjob) SetValidationJobCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
-	query := "update \"candle\" set \"validation_job_id\" = null where \"validation_job_id\" = ?"
+// Sets o.R.PrerequisiteJobDatahistoryjobs's JobDatahistoryjobs accordingly.
+// Replaces o.R.JobDatahistoryjobs with related.
+// Sets related.R.PrerequisiteJobDatahistoryjobs's JobDatahistoryjobs accordingly.
+func (o *Datahistoryjob) SetJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+	query := "delete from \"datahistoryjobrelations\" where \"prerequisite_job_id\" = ?"
 	values := []interface{}{o.ID}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
@@ -1562,50 +2542,52 @@ func (o *Datahistoryjob) SetValidationJobCandles(ctx context.Context, exec boil.
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
+	removeJobDatahistoryjobsFromPrerequisiteJobDatahistoryjobsSlice(o, related)
 	if o.R != nil {
-		for _, rel := range o.R.ValidationJobCandles {
-			queries.SetScanner(&rel.ValidationJobID, nil)
-			if rel.R == nil {
-				continue
-			}
-
-			rel.R.ValidationJob = nil
-		}
-
-		o.R.ValidationJobCandles = nil
+		o.R.JobDatahistoryjobs = nil
 	}
-	return o.AddValidationJobCandles(ctx, exec, insert, related...)
+	return o.AddJobDatahistoryjobs(ctx, exec, insert, related...)
 }
 
-// RemoveValidationJobCandles relationships from objects passed in.
-// Removes related items from R.ValidationJobCandles (uses pointer comparison, removal does not keep order)
-// Sets related.R.ValidationJob.
-func (o *Datahistoryjob) RemoveValidationJobCandles(ctx context.Context, exec boil.ContextExecutor, related ...*Candle) error {
+// RemoveJobDatahistoryjobs relationships from objects passed in.
+// Removes related items from R.JobDatahistoryjobs (uses pointer comparison, removal does not keep order)
+// Sets related.R.PrerequisiteJobDatahistoryjobs.
+func (o *Datahistoryjob) RemoveJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, related ...*Datahistoryjob) error {
 	var err error
+	query := fmt.Sprintf(
+		"delete from \"datahistoryjobrelations\" where \"prerequisite_job_id\" = ? and \"job_id\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
 	for _, rel := range related {
-		queries.SetScanner(&rel.ValidationJobID, nil)
-		if rel.R != nil {
-			rel.R.ValidationJob = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("validation_job_id")); err != nil {
-			return err
-		}
+		values = append(values, rel.ID)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
 	}
+
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeJobDatahistoryjobsFromPrerequisiteJobDatahistoryjobsSlice(o, related)
 	if o.R == nil {
 		return nil
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.ValidationJobCandles {
+		for i, ri := range o.R.JobDatahistoryjobs {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.ValidationJobCandles)
+			ln := len(o.R.JobDatahistoryjobs)
 			if ln > 1 && i < ln-1 {
-				o.R.ValidationJobCandles[i] = o.R.ValidationJobCandles[ln-1]
+				o.R.JobDatahistoryjobs[i] = o.R.JobDatahistoryjobs[ln-1]
 			}
-			o.R.ValidationJobCandles = o.R.ValidationJobCandles[:ln-1]
+			o.R.JobDatahistoryjobs = o.R.JobDatahistoryjobs[:ln-1]
 			break
 		}
 	}
@@ -1613,23 +2595,43 @@ func (o *Datahistoryjob) RemoveValidationJobCandles(ctx context.Context, exec bo
 	return nil
 }
 
-// AddSourceJobCandles adds the given related objects to the existing relationships
+func removeJobDatahistoryjobsFromPrerequisiteJobDatahistoryjobsSlice(o *Datahistoryjob, related []*Datahistoryjob) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.PrerequisiteJobDatahistoryjobs {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.PrerequisiteJobDatahistoryjobs)
+			if ln > 1 && i < ln-1 {
+				rel.R.PrerequisiteJobDatahistoryjobs[i] = rel.R.PrerequisiteJobDatahistoryjobs[ln-1]
+			}
+			rel.R.PrerequisiteJobDatahistoryjobs = rel.R.PrerequisiteJobDatahistoryjobs[:ln-1]
+			break
+		}
+	}
+}
+
+// AddJobDatahistoryjobresults adds the given related objects to the existing relationships
 // of the datahistoryjob, optionally inserting them as new records.
-// Appends related to o.R.SourceJobCandles.
-// Sets related.R.SourceJob appropriately.
-func (o *Datahistoryjob) AddSourceJobCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
+// Appends related to o.R.JobDatahistoryjobresults.
+// Sets related.R.Job appropriately.
+func (o *Datahistoryjob) AddJobDatahistoryjobresults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjobresult) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.SourceJobID, o.ID)
+			rel.JobID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"candle\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 0, []string{"source_job_id"}),
-				strmangle.WhereClause("\"", "\"", 0, candlePrimaryKeyColumns),
+				"UPDATE \"datahistoryjobresult\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"job_id"}),
+				strmangle.WhereClause("\"", "\"", 0, datahistoryjobresultPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1642,156 +2644,91 @@ func (o *Datahistoryjob) AddSourceJobCandles(ctx context.Context, exec boil.Cont
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.SourceJobID, o.ID)
+			rel.JobID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &datahistoryjobR{
-			SourceJobCandles: related,
+			JobDatahistoryjobresults: related,
 		}
 	} else {
-		o.R.SourceJobCandles = append(o.R.SourceJobCandles, related...)
+		o.R.JobDatahistoryjobresults = append(o.R.JobDatahistoryjobresults, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &candleR{
-				SourceJob: o,
+			rel.R = &datahistoryjobresultR{
+				Job: o,
 			}
 		} else {
-			rel.R.SourceJob = o
-		}
-	}
-	return nil
-}
-
-// SetSourceJobCandles removes all previously related items of the
-// datahistoryjob replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.SourceJob's SourceJobCandles accordingly.
-// Replaces o.R.SourceJobCandles with related.
-// Sets related.R.SourceJob's SourceJobCandles accordingly.
-func (o *Datahistoryjob) SetSourceJobCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
-	query := "update \"candle\" set \"source_job_id\" = null where \"source_job_id\" = ?"
-	values := []interface{}{o.ID}
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, query)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	if o.R != nil {
-		for _, rel := range o.R.SourceJobCandles {
-			queries.SetScanner(&rel.SourceJobID, nil)
-			if rel.R == nil {
-				continue
-			}
-
-			rel.R.SourceJob = nil
-		}
-
-		o.R.SourceJobCandles = nil
-	}
-	return o.AddSourceJobCandles(ctx, exec, insert, related...)
-}
-
-// RemoveSourceJobCandles relationships from objects passed in.
-// Removes related items from R.SourceJobCandles (uses pointer comparison, removal does not keep order)
-// Sets related.R.SourceJob.
-func (o *Datahistoryjob) RemoveSourceJobCandles(ctx context.Context, exec boil.ContextExecutor, related ...*Candle) error {
-	var err error
-	for _, rel := range related {
-		queries.SetScanner(&rel.SourceJobID, nil)
-		if rel.R != nil {
-			rel.R.SourceJob = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("source_job_id")); err != nil {
-			return err
-		}
-	}
-	if o.R == nil {
-		return nil
-	}
-
-	for _, rel := range related {
-		for i, ri := range o.R.SourceJobCandles {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.SourceJobCandles)
-			if ln > 1 && i < ln-1 {
-				o.R.SourceJobCandles[i] = o.R.SourceJobCandles[ln-1]
-			}
-			o.R.SourceJobCandles = o.R.SourceJobCandles[:ln-1]
-			break
+			rel.R.Job = o
 		}
 	}
-
 	return nil
 }
 
-// AddPrerequisiteJobDatahistoryjobs adds the given related objects to the existing relationships
+// AddSourceJobFundingRates adds the given related objects to the existing relationships
 // of the datahistoryjob, optionally inserting them as new records.
-// Appends related to o.R.PrerequisiteJobDatahistoryjobs.
-// Sets related.R.JobDatahistoryjobs appropriately.
-func (o *Datahistoryjob) AddPrerequisiteJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+// Appends related to o.R.SourceJobFundingRates.
+// Sets related.R.SourceJob appropriately.
+func (o *Datahistoryjob) AddSourceJobFundingRates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingRate) error {
 	var err error
 	for _, rel := range related {
 		if insert {
+			queries.Assign(&rel.SourceJobID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"funding_rate\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"source_job_id"}),
+				strmangle.WhereClause("\"", "\"", 0, fundingRatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	for _, rel := range related {
-		query := "insert into \"datahistoryjobrelations\" (\"job_id\", \"prerequisite_job_id\") values (?, ?)"
-		values := []interface{}{o.ID, rel.ID}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, query)
-			fmt.Fprintln(boil.DebugWriter, values)
-		}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
+			queries.Assign(&rel.SourceJobID, o.ID)
 		}
 	}
+
 	if o.R == nil {
 		o.R = &datahistoryjobR{
-			PrerequisiteJobDatahistoryjobs: related,
+			SourceJobFundingRates: related,
 		}
 	} else {
-		o.R.PrerequisiteJobDatahistoryjobs = append(o.R.PrerequisiteJobDatahistoryjobs, related...)
+		o.R.SourceJobFundingRates = append(o.R.SourceJobFundingRates, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &datahistoryjobR{
-				JobDatahistoryjobs: DatahistoryjobSlice{o},
+			rel.R = &fundingRateR{
+				SourceJob: o,
 			}
 		} else {
-			rel.R.JobDatahistoryjobs = append(rel.R.JobDatahistoryjobs, o)
+			rel.R.SourceJob = o
 		}
 	}
 	return nil
 }
 
-// SetPrerequisiteJobDatahistoryjobs removes all previously related items of the
+// SetSourceJobFundingRates removes all previously related items of the
 // datahistoryjob replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.JobDatahistoryjobs's PrerequisiteJobDatahistoryjobs accordingly.
-// Replaces o.R.PrerequisiteJobDatahistoryjobs with related.
-// Sets related.R.JobDatahistoryjobs's PrerequisiteJobDatahistoryjobs accordingly.
-func (o *Datahistoryjob) SetPrerequisiteJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
-	query := "delete from \"datahistoryjobrelations\" where \"job_id\" = ?"
+// Sets o.R.SourceJob's SourceJobFundingRates accordingly.
+// Replaces o.R.SourceJobFundingRates with related.
+// Sets related.R.SourceJob's SourceJobFundingRates accordingly.
+func (o *Datahistoryjob) SetSourceJobFundingRates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingRate) error {
+	query := "update \"funding_rate\" set \"source_job_id\" = null where \"source_job_id\" = ?"
 	values := []interface{}{o.ID}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
@@ -1803,52 +2740,50 @@ func (o *Datahistoryjob) SetPrerequisiteJobDatahistoryjobs(ctx context.Context,
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	removePrerequisiteJobDatahistoryjobsFromJobDatahistoryjobsSlice(o, related)
 	if o.R != nil {
-		o.R.PrerequisiteJobDatahistoryjobs = nil
+		for _, rel := range o.R.SourceJobFundingRates {
+			queries.SetScanner(&rel.SourceJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SourceJob = nil
+		}
+
+		o.R.SourceJobFundingRates = nil
 	}
-	return o.AddPrerequisiteJobDatahistoryjobs(ctx, exec, insert, related...)
+	return o.AddSourceJobFundingRates(ctx, exec, insert, related...)
 }
 
-// RemovePrerequisiteJobDatahistoryjobs relationships from objects passed in.
-// Removes related items from R.PrerequisiteJobDatahistoryjobs (uses pointer comparison, removal does not keep order)
-// Sets related.R.JobDatahistoryjobs.
-func (o *Datahistoryjob) RemovePrerequisiteJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, related ...*Datahistoryjob) error {
+// RemoveSourceJobFundingRates relationships from objects passed in.
+// Removes related items from R.SourceJobFundingRates (uses pointer comparison, removal does not keep order)
+// Sets related.R.SourceJob.
+func (o *Datahistoryjob) RemoveSourceJobFundingRates(ctx context.Context, exec boil.ContextExecutor, related ...*FundingRate) error {
 	var err error
-	query := fmt.Sprintf(
-		"delete from \"datahistoryjobrelations\" where \"job_id\" = ? and \"prerequisite_job_id\" in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ID}
 	for _, rel := range related {
-		values = append(values, rel.ID)
-	}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, query)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+		queries.SetScanner(&rel.SourceJobID, nil)
+		if rel.R != nil {
+			rel.R.SourceJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("source_job_id")); err != nil {
+			return err
+		}
 	}
-	removePrerequisiteJobDatahistoryjobsFromJobDatahistoryjobsSlice(o, related)
 	if o.R == nil {
 		return nil
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.PrerequisiteJobDatahistoryjobs {
+		for i, ri := range o.R.SourceJobFundingRates {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.PrerequisiteJobDatahistoryjobs)
+			ln := len(o.R.SourceJobFundingRates)
 			if ln > 1 && i < ln-1 {
-				o.R.PrerequisiteJobDatahistoryjobs[i] = o.R.PrerequisiteJobDatahistoryjobs[ln-1]
+				o.R.SourceJobFundingRates[i] = o.R.SourceJobFundingRates[ln-1]
 			}
-			o.R.PrerequisiteJobDatahistoryjobs = o.R.PrerequisiteJobDatahistoryjobs[:ln-1]
+			o.R.SourceJobFundingRates = o.R.SourceJobFundingRates[:ln-1]
 			break
 		}
 	}
@@ -1856,82 +2791,67 @@ func (o *Datahistoryjob) RemovePrerequisiteJobDatahistoryjobs(ctx context.Contex
 	return nil
 }
 
-func removePrerequisiteJobDatahistoryjobsFromJobDatahistoryjobsSlice(o *Datahistoryjob, related []*Datahistoryjob) {
-	for _, rel := range related {
-		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.JobDatahistoryjobs {
-			if o.ID != ri.ID {
-				continue
-			}
-
-			ln := len(rel.R.JobDatahistoryjobs)
-			if ln > 1 && i < ln-1 {
-				rel.R.JobDatahistoryjobs[i] = rel.R.JobDatahistoryjobs[ln-1]
-			}
-			rel.R.JobDatahistoryjobs = rel.R.JobDatahistoryjobs[:ln-1]
-			break
-		}
-	}
-}
-
-// AddJobDatahistoryjobs adds the given related objects to the existing relationships
+// AddSourceJobOpenInterests adds the given related objects to the existing relationships
 // of the datahistoryjob, optionally inserting them as new records.
-// Appends related to o.R.JobDatahistoryjobs.
-// Sets related.R.PrerequisiteJobDatahistoryjobs appropriately.
-func (o *Datahistoryjob) AddJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
+// Appends related to o.R.SourceJobOpenInterests.
+// Sets related.R.SourceJob appropriately.
+func (o *Datahistoryjob) AddSourceJobOpenInterests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OpenInterest) error {
 	var err error
 	for _, rel := range related {
 		if insert {
+			queries.Assign(&rel.SourceJobID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"open_interest\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"source_job_id"}),
+				strmangle.WhereClause("\"", "\"", 0, openInterestPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	for _, rel := range related {
-		query := "insert into \"datahistoryjobrelations\" (\"prerequisite_job_id\", \"job_id\") values (?, ?)"
-		values := []interface{}{o.ID, rel.ID}
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
 
-		if boil.DebugMode {
-			fmt.Fprintln(boil.DebugWriter, query)
-			fmt.Fprintln(boil.DebugWriter, values)
-		}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-		_, err = exec.ExecContext(ctx, query, values...)
-		if err != nil {
-			return errors.Wrap(err, "failed to insert into join table")
+			queries.Assign(&rel.SourceJobID, o.ID)
 		}
 	}
+
 	if o.R == nil {
 		o.R = &datahistoryjobR{
-			JobDatahistoryjobs: related,
+			SourceJobOpenInterests: related,
 		}
 	} else {
-		o.R.JobDatahistoryjobs = append(o.R.JobDatahistoryjobs, related...)
+		o.R.SourceJobOpenInterests = append(o.R.SourceJobOpenInterests, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &datahistoryjobR{
-				PrerequisiteJobDatahistoryjobs: DatahistoryjobSlice{o},
+			rel.R = &openInterestR{
+				SourceJob: o,
 			}
 		} else {
-			rel.R.PrerequisiteJobDatahistoryjobs = append(rel.R.PrerequisiteJobDatahistoryjobs, o)
+			rel.R.SourceJob = o
 		}
 	}
 	return nil
 }
 
-// SetJobDatahistoryjobs removes all previously related items of the
+// SetSourceJobOpenInterests removes all previously related items of the
 // datahistoryjob replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.PrerequisiteJobDatahistoryjobs's JobDatahistoryjobs accordingly.
-// Replaces o.R.JobDatahistoryjobs with related.
-// Sets related.R.PrerequisiteJobDatahistoryjobs's JobDatahistoryjobs accordingly.
-func (o *Datahistoryjob) SetJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
-	query := "delete from \"datahistoryjobrelations\" where \"prerequisite_job_id\" = ?"
+// Sets o.R.SourceJob's SourceJobOpenInterests accordingly.
+// Replaces o.R.SourceJobOpenInterests with related.
+// Sets related.R.SourceJob's SourceJobOpenInterests accordingly.
+func (o *Datahistoryjob) SetSourceJobOpenInterests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OpenInterest) error {
+	query := "update \"open_interest\" set \"source_job_id\" = null where \"source_job_id\" = ?"
 	values := []interface{}{o.ID}
 	if boil.DebugMode {
 		fmt.Fprintln(boil.DebugWriter, query)
@@ -1943,52 +2863,50 @@ func (o *Datahistoryjob) SetJobDatahistoryjobs(ctx context.Context, exec boil.Co
 		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	removeJobDatahistoryjobsFromPrerequisiteJobDatahistoryjobsSlice(o, related)
 	if o.R != nil {
-		o.R.JobDatahistoryjobs = nil
+		for _, rel := range o.R.SourceJobOpenInterests {
+			queries.SetScanner(&rel.SourceJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SourceJob = nil
+		}
+
+		o.R.SourceJobOpenInterests = nil
 	}
-	return o.AddJobDatahistoryjobs(ctx, exec, insert, related...)
+	return o.AddSourceJobOpenInterests(ctx, exec, insert, related...)
 }
 
-// RemoveJobDatahistoryjobs relationships from objects passed in.
-// Removes related items from R.JobDatahistoryjobs (uses pointer comparison, removal does not keep order)
-// Sets related.R.PrerequisiteJobDatahistoryjobs.
-func (o *Datahistoryjob) RemoveJobDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, related ...*Datahistoryjob) error {
+// RemoveSourceJobOpenInterests relationships from objects passed in.
+// Removes related items from R.SourceJobOpenInterests (uses pointer comparison, removal does not keep order)
+// Sets related.R.SourceJob.
+func (o *Datahistoryjob) RemoveSourceJobOpenInterests(ctx context.Context, exec boil.ContextExecutor, related ...*OpenInterest) error {
 	var err error
-	query := fmt.Sprintf(
-		"delete from \"datahistoryjobrelations\" where \"prerequisite_job_id\" = ? and \"job_id\" in (%s)",
-		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
-	)
-	values := []interface{}{o.ID}
 	for _, rel := range related {
-		values = append(values, rel.ID)
-	}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, query)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-
-	_, err = exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
+		queries.SetScanner(&rel.SourceJobID, nil)
+		if rel.R != nil {
+			rel.R.SourceJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("source_job_id")); err != nil {
+			return err
+		}
 	}
-	removeJobDatahistoryjobsFromPrerequisiteJobDatahistoryjobsSlice(o, related)
 	if o.R == nil {
 		return nil
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.JobDatahistoryjobs {
+		for i, ri := range o.R.SourceJobOpenInterests {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.JobDatahistoryjobs)
+			ln := len(o.R.SourceJobOpenInterests)
 			if ln > 1 && i < ln-1 {
-				o.R.JobDatahistoryjobs[i] = o.R.JobDatahistoryjobs[ln-1]
+				o.R.SourceJobOpenInterests[i] = o.R.SourceJobOpenInterests[ln-1]
 			}
-			o.R.JobDatahistoryjobs = o.R.JobDatahistoryjobs[:ln-1]
+			o.R.SourceJobOpenInterests = o.R.SourceJobOpenInterests[:ln-1]
 			break
 		}
 	}
@@ -1996,43 +2914,23 @@ func (o *Datahistoryjob) RemoveJobDatahistoryjobs(ctx context.Context, exec boil
 	return nil
 }
 
-func removeJobDatahistoryjobsFromPrerequisiteJobDatahistoryjobsSlice(o *Datahistoryjob, related []*Datahistoryjob) {
-	for _, rel := range related {
-		if rel.R == nil {
-			continue
-		}
-		for i, ri := range rel.R.PrerequisiteJobDatahistoryjobs {
-			if o.ID != ri.ID {
-				continue
-			}
-
-			ln := len(rel.R.PrerequisiteJobDatahistoryjobs)
-			if ln > 1 && i < ln-1 {
-				rel.R.PrerequisiteJobDatahistoryjobs[i] = rel.R.PrerequisiteJobDatahistoryjobs[ln-1]
-			}
-			rel.R.PrerequisiteJobDatahistoryjobs = rel.R.PrerequisiteJobDatahistoryjobs[:ln-1]
-			break
-		}
-	}
-}
-
-// AddJobDatahistoryjobresults adds the given related objects to the existing relationships
+// AddSourceJobPriceCandles adds the given related objects to the existing relationships
 // of the datahistoryjob, optionally inserting them as new records.
-// Appends related to o.R.JobDatahistoryjobresults.
-// Sets related.R.Job appropriately.
-func (o *Datahistoryjob) AddJobDatahistoryjobresults(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjobresult) error {
+// Appends related to o.R.SourceJobPriceCandles.
+// Sets related.R.SourceJob appropriately.
+func (o *Datahistoryjob) AddSourceJobPriceCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PriceCandle) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.JobID = o.ID
+			queries.Assign(&rel.SourceJobID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"datahistoryjobresult\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 0, []string{"job_id"}),
-				strmangle.WhereClause("\"", "\"", 0, datahistoryjobresultPrimaryKeyColumns),
+				"UPDATE \"price_candle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"source_job_id"}),
+				strmangle.WhereClause("\"", "\"", 0, priceCandlePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2045,27 +2943,97 @@ func (o *Datahistoryjob) AddJobDatahistoryjobresults(ctx context.Context, exec b
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.JobID = o.ID
+			queries.Assign(&rel.SourceJobID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &datahistoryjobR{
-			JobDatahistoryjobresults: related,
+			SourceJobPriceCandles: related,
 		}
 	} else {
-		o.R.JobDatahistoryjobresults = append(o.R.JobDatahistoryjobresults, related...)
+		o.R.SourceJobPriceCandles = append(o.R.SourceJobPriceCandles, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &datahistoryjobresultR{
-				Job: o,
+			rel.R = &priceCandleR{
+				SourceJob: o,
 			}
 		} else {
-			rel.R.Job = o
+			rel.R.SourceJob = o
+		}
+	}
+	return nil
+}
+
+// SetSourceJobPriceCandles removes all previously related items of the
+// datahistoryjob replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SourceJob's SourceJobPriceCandles accordingly.
+// Replaces o.R.SourceJobPriceCandles with related.
+// Sets related.R.SourceJob's SourceJobPriceCandles accordingly.
+func (o *Datahistoryjob) SetSourceJobPriceCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PriceCandle) error {
+	query := "update \"price_candle\" set \"source_job_id\" = null where \"source_job_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SourceJobPriceCandles {
+			queries.SetScanner(&rel.SourceJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SourceJob = nil
+		}
+
+		o.R.SourceJobPriceCandles = nil
+	}
+	return o.AddSourceJobPriceCandles(ctx, exec, insert, related...)
+}
+
+// RemoveSourceJobPriceCandles relationships from objects passed in.
+// Removes related items from R.SourceJobPriceCandles (uses pointer comparison, removal does not keep order)
+// Sets related.R.SourceJob.
+func (o *Datahistoryjob) RemoveSourceJobPriceCandles(ctx context.Context, exec boil.ContextExecutor, related ...*PriceCandle) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SourceJobID, nil)
+		if rel.R != nil {
+			rel.R.SourceJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("source_job_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SourceJobPriceCandles {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SourceJobPriceCandles)
+			if ln > 1 && i < ln-1 {
+				o.R.SourceJobPriceCandles[i] = o.R.SourceJobPriceCandles[ln-1]
+			}
+			o.R.SourceJobPriceCandles = o.R.SourceJobPriceCandles[:ln-1]
+			break
 		}
 	}
+
 	return nil
 }
 
@@ -50,13 +50,21 @@ var ExchangeWhere = struct {
 // ExchangeRels is where relationship names are stored.
 var ExchangeRels = struct {
 	ExchangeNameCandle               string
+	ExchangeNameFundingRate          string
+	ExchangeNameOpenInterest         string
+	ExchangeNamePriceCandle          string
 	ExchangeNameTrade                string
+	ExchangeNameDataQualityReports   string
 	ExchangeNameDatahistoryjobs      string
 	SecondaryExchangeDatahistoryjobs string
 	ExchangeNameWithdrawalHistories  string
 }{
 	ExchangeNameCandle:               "ExchangeNameCandle",
+	ExchangeNameFundingRate:          "ExchangeNameFundingRate",
+	ExchangeNameOpenInterest:         "ExchangeNameOpenInterest",
+	ExchangeNamePriceCandle:          "ExchangeNamePriceCandle",
 	ExchangeNameTrade:                "ExchangeNameTrade",
+	ExchangeNameDataQualityReports:   "ExchangeNameDataQualityReports",
 	ExchangeNameDatahistoryjobs:      "ExchangeNameDatahistoryjobs",
 	SecondaryExchangeDatahistoryjobs: "SecondaryExchangeDatahistoryjobs",
 	ExchangeNameWithdrawalHistories:  "ExchangeNameWithdrawalHistories",
@@ -65,7 +73,11 @@ var ExchangeRels = struct {
 // exchangeR is where relationships are stored.
 type exchangeR struct {
 	ExchangeNameCandle               *Candle
+	ExchangeNameFundingRate          *FundingRate
+	ExchangeNameOpenInterest         *OpenInterest
+	ExchangeNamePriceCandle          *PriceCandle
 	ExchangeNameTrade                *Trade
+	ExchangeNameDataQualityReports   DataQualityReportSlice
 	ExchangeNameDatahistoryjobs      DatahistoryjobSlice
 	SecondaryExchangeDatahistoryjobs DatahistoryjobSlice
 	ExchangeNameWithdrawalHistories  WithdrawalHistorySlice
@@ -375,6 +387,48 @@ func (o *Exchange) ExchangeNameCandle(mods ...qm.QueryMod) candleQuery {
 	return query
 }
 
+// ExchangeNameFundingRate pointed to by the foreign key.
+func (o *Exchange) ExchangeNameFundingRate(mods ...qm.QueryMod) fundingRateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := FundingRates(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_rate\"")
+
+	return query
+}
+
+// ExchangeNameOpenInterest pointed to by the foreign key.
+func (o *Exchange) ExchangeNameOpenInterest(mods ...qm.QueryMod) openInterestQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := OpenInterests(queryMods...)
+	queries.SetFrom(query.Query, "\"open_interest\"")
+
+	return query
+}
+
+// ExchangeNamePriceCandle pointed to by the foreign key.
+func (o *Exchange) ExchangeNamePriceCandle(mods ...qm.QueryMod) priceCandleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := PriceCandles(queryMods...)
+	queries.SetFrom(query.Query, "\"price_candle\"")
+
+	return query
+}
+
 // ExchangeNameTrade pointed to by the foreign key.
 func (o *Exchange) ExchangeNameTrade(mods ...qm.QueryMod) tradeQuery {
 	queryMods := []qm.QueryMod{
@@ -389,6 +443,27 @@ func (o *Exchange) ExchangeNameTrade(mods ...qm.QueryMod) tradeQuery {
 	return query
 }
 
+// ExchangeNameDataQualityReports retrieves all the data_quality_report's DataQualityReports with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameDataQualityReports(mods ...qm.QueryMod) dataQualityReportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"data_quality_report\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := DataQualityReports(queryMods...)
+	queries.SetFrom(query.Query, "\"data_quality_report\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"data_quality_report\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameDatahistoryjobs retrieves all the datahistoryjob's Datahistoryjobs with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameDatahistoryjobs(mods ...qm.QueryMod) datahistoryjobQuery {
 	var queryMods []qm.QueryMod
@@ -438,23 +513,415 @@ func (o *Exchange) ExchangeNameWithdrawalHistories(mods ...qm.QueryMod) withdraw
 		queryMods = append(queryMods, mods...)
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"withdrawal_history\".\"exchange_name_id\"=?", o.ID),
-	)
-
-	query := WithdrawalHistories(queryMods...)
-	queries.SetFrom(query.Query, "\"withdrawal_history\"")
+	queryMods = append(queryMods,
+		qm.Where("\"withdrawal_history\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := WithdrawalHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"withdrawal_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"withdrawal_history\".*"})
+	}
+
+	return query
+}
+
+// LoadExchangeNameCandle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`candle`), qm.WhereIn(`candle.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Candle")
+	}
+
+	var resultSlice []*Candle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Candle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for candle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for candle")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameCandle = foreign
+		if foreign.R == nil {
+			foreign.R = &candleR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameCandle = foreign
+				if foreign.R == nil {
+					foreign.R = &candleR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameFundingRate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameFundingRate(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`funding_rate`), qm.WhereIn(`funding_rate.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load FundingRate")
+	}
+
+	var resultSlice []*FundingRate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice FundingRate")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for funding_rate")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_rate")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameFundingRate = foreign
+		if foreign.R == nil {
+			foreign.R = &fundingRateR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameFundingRate = foreign
+				if foreign.R == nil {
+					foreign.R = &fundingRateR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameOpenInterest allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameOpenInterest(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`open_interest`), qm.WhereIn(`open_interest.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OpenInterest")
+	}
+
+	var resultSlice []*OpenInterest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OpenInterest")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for open_interest")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for open_interest")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameOpenInterest = foreign
+		if foreign.R == nil {
+			foreign.R = &openInterestR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameOpenInterest = foreign
+				if foreign.R == nil {
+					foreign.R = &openInterestR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNamePriceCandle allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNamePriceCandle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`price_candle`), qm.WhereIn(`price_candle.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PriceCandle")
+	}
+
+	var resultSlice []*PriceCandle
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PriceCandle")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for price_candle")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for price_candle")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNamePriceCandle = foreign
+		if foreign.R == nil {
+			foreign.R = &priceCandleR{}
+		}
+		foreign.R.ExchangeName = object
+	}
 
-	if len(queries.GetSelect(query.Query)) == 0 {
-		queries.SetSelect(query.Query, []string{"\"withdrawal_history\".*"})
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNamePriceCandle = foreign
+				if foreign.R == nil {
+					foreign.R = &priceCandleR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
 	}
 
-	return query
+	return nil
 }
 
-// LoadExchangeNameCandle allows an eager lookup of values, cached into the
+// LoadExchangeNameTrade allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
-func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -491,26 +958,26 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 		return nil
 	}
 
-	query := NewQuery(qm.From(`candle`), qm.WhereIn(`candle.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Candle")
+		return errors.Wrap(err, "failed to eager load Trade")
 	}
 
-	var resultSlice []*Candle
+	var resultSlice []*Trade
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Candle")
+		return errors.Wrap(err, "failed to bind eager loaded slice Trade")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for candle")
+		return errors.Wrap(err, "failed to close results of eager load for trade")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for candle")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
 	}
 
 	if len(exchangeAfterSelectHooks) != 0 {
@@ -527,9 +994,9 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.ExchangeNameCandle = foreign
+		object.R.ExchangeNameTrade = foreign
 		if foreign.R == nil {
-			foreign.R = &candleR{}
+			foreign.R = &tradeR{}
 		}
 		foreign.R.ExchangeName = object
 	}
@@ -537,9 +1004,9 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameCandle = foreign
+				local.R.ExchangeNameTrade = foreign
 				if foreign.R == nil {
-					foreign.R = &candleR{}
+					foreign.R = &tradeR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -550,9 +1017,9 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadExchangeNameTrade allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-1 relationship.
-func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+// LoadExchangeNameDataQualityReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameDataQualityReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -589,55 +1056,52 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 		return nil
 	}
 
-	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`data_quality_report`), qm.WhereIn(`data_quality_report.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load Trade")
+		return errors.Wrap(err, "failed to eager load data_quality_report")
 	}
 
-	var resultSlice []*Trade
+	var resultSlice []*DataQualityReport
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Trade")
+		return errors.Wrap(err, "failed to bind eager loaded slice data_quality_report")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for trade")
+		return errors.Wrap(err, "failed to close results in eager load on data_quality_report")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_quality_report")
 	}
 
-	if len(exchangeAfterSelectHooks) != 0 {
+	if len(dataQualityReportAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
-
-	if len(resultSlice) == 0 {
-		return nil
-	}
-
 	if singular {
-		foreign := resultSlice[0]
-		object.R.ExchangeNameTrade = foreign
-		if foreign.R == nil {
-			foreign.R = &tradeR{}
+		object.R.ExchangeNameDataQualityReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataQualityReportR{}
+			}
+			foreign.R.ExchangeName = object
 		}
-		foreign.R.ExchangeName = object
+		return nil
 	}
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameTrade = foreign
+				local.R.ExchangeNameDataQualityReports = append(local.R.ExchangeNameDataQualityReports, foreign)
 				if foreign.R == nil {
-					foreign.R = &tradeR{}
+					foreign.R = &dataQualityReportR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -984,6 +1448,159 @@ func (o *Exchange) SetExchangeNameCandle(ctx context.Context, exec boil.ContextE
 	return nil
 }
 
+// SetExchangeNameFundingRate of the exchange to the related item.
+// Sets o.R.ExchangeNameFundingRate to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameFundingRate(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FundingRate) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"funding_rate\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, fundingRatePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFundingRate: related,
+		}
+	} else {
+		o.R.ExchangeNameFundingRate = related
+	}
+
+	if related.R == nil {
+		related.R = &fundingRateR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameOpenInterest of the exchange to the related item.
+// Sets o.R.ExchangeNameOpenInterest to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameOpenInterest(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OpenInterest) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"open_interest\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, openInterestPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameOpenInterest: related,
+		}
+	} else {
+		o.R.ExchangeNameOpenInterest = related
+	}
+
+	if related.R == nil {
+		related.R = &openInterestR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNamePriceCandle of the exchange to the related item.
+// Sets o.R.ExchangeNamePriceCandle to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNamePriceCandle(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PriceCandle) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"price_candle\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, priceCandlePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNamePriceCandle: related,
+		}
+	} else {
+		o.R.ExchangeNamePriceCandle = related
+	}
+
+	if related.R == nil {
+		related.R = &priceCandleR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
 // SetExchangeNameTrade of the exchange to the related item.
 // Sets o.R.ExchangeNameTrade to related.
 // Adds o to related.R.ExchangeName.
@@ -1035,6 +1652,59 @@ func (o *Exchange) SetExchangeNameTrade(ctx context.Context, exec boil.ContextEx
 	return nil
 }
 
+// AddExchangeNameDataQualityReports adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameDataQualityReports.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameDataQualityReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataQualityReport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"data_quality_report\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 0, dataQualityReportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameDataQualityReports: related,
+		}
+	} else {
+		o.R.ExchangeNameDataQualityReports = append(o.R.ExchangeNameDataQualityReports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataQualityReportR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameDatahistoryjobs adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameDatahistoryjobs.
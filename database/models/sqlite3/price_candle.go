@@ -0,0 +1,1187 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// PriceCandle is an object representing the database table.
+type PriceCandle struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExchangeNameID string      `boil:"exchange_name_id" json:"exchange_name_id" toml:"exchange_name_id" yaml:"exchange_name_id"`
+	Base           string      `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote          string      `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Asset          string      `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	PriceType      string      `boil:"price_type" json:"price_type" toml:"price_type" yaml:"price_type"`
+	Interval       int64       `boil:"interval" json:"interval" toml:"interval" yaml:"interval"`
+	Timestamp      string      `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+	Open           float64     `boil:"open" json:"open" toml:"open" yaml:"open"`
+	High           float64     `boil:"high" json:"high" toml:"high" yaml:"high"`
+	Low            float64     `boil:"low" json:"low" toml:"low" yaml:"low"`
+	Close          float64     `boil:"close" json:"close" toml:"close" yaml:"close"`
+	SourceJobID    null.String `boil:"source_job_id" json:"source_job_id,omitempty" toml:"source_job_id" yaml:"source_job_id,omitempty"`
+
+	R *priceCandleR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L priceCandleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PriceCandleColumns = struct {
+	ID             string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	Asset          string
+	PriceType      string
+	Interval       string
+	Timestamp      string
+	Open           string
+	High           string
+	Low            string
+	Close          string
+	SourceJobID    string
+}{
+	ID:             "id",
+	ExchangeNameID: "exchange_name_id",
+	Base:           "base",
+	Quote:          "quote",
+	Asset:          "asset",
+	PriceType:      "price_type",
+	Interval:       "interval",
+	Timestamp:      "timestamp",
+	Open:           "open",
+	High:           "high",
+	Low:            "low",
+	Close:          "close",
+	SourceJobID:    "source_job_id",
+}
+
+// Generated where
+
+var PriceCandleWhere = struct {
+	ID             whereHelperstring
+	ExchangeNameID whereHelperstring
+	Base           whereHelperstring
+	Quote          whereHelperstring
+	Asset          whereHelperstring
+	PriceType      whereHelperstring
+	Interval       whereHelperint64
+	Timestamp      whereHelperstring
+	Open           whereHelperfloat64
+	High           whereHelperfloat64
+	Low            whereHelperfloat64
+	Close          whereHelperfloat64
+	SourceJobID    whereHelpernull_String
+}{
+	ID:             whereHelperstring{field: "\"price_candle\".\"id\""},
+	ExchangeNameID: whereHelperstring{field: "\"price_candle\".\"exchange_name_id\""},
+	Base:           whereHelperstring{field: "\"price_candle\".\"base\""},
+	Quote:          whereHelperstring{field: "\"price_candle\".\"quote\""},
+	Asset:          whereHelperstring{field: "\"price_candle\".\"asset\""},
+	PriceType:      whereHelperstring{field: "\"price_candle\".\"price_type\""},
+	Interval:       whereHelperint64{field: "\"price_candle\".\"interval\""},
+	Timestamp:      whereHelperstring{field: "\"price_candle\".\"timestamp\""},
+	Open:           whereHelperfloat64{field: "\"price_candle\".\"open\""},
+	High:           whereHelperfloat64{field: "\"price_candle\".\"high\""},
+	Low:            whereHelperfloat64{field: "\"price_candle\".\"low\""},
+	Close:          whereHelperfloat64{field: "\"price_candle\".\"close\""},
+	SourceJobID:    whereHelpernull_String{field: "\"price_candle\".\"source_job_id\""},
+}
+
+// PriceCandleRels is where relationship names are stored.
+var PriceCandleRels = struct {
+	SourceJob    string
+	ExchangeName string
+}{
+	SourceJob:    "SourceJob",
+	ExchangeName: "ExchangeName",
+}
+
+// priceCandleR is where relationships are stored.
+type priceCandleR struct {
+	SourceJob    *Datahistoryjob
+	ExchangeName *Exchange
+}
+
+// NewStruct creates a new relationship struct
+func (*priceCandleR) NewStruct() *priceCandleR {
+	return &priceCandleR{}
+}
+
+// priceCandleL is where Load methods for each relationship are stored.
+type priceCandleL struct{}
+
+var (
+	priceCandleAllColumns            = []string{"id", "exchange_name_id", "base", "quote", "asset", "price_type", "interval", "timestamp", "open", "high", "low", "close", "source_job_id"}
+	priceCandleColumnsWithoutDefault = []string{"id", "exchange_name_id", "base", "quote", "asset", "price_type", "interval", "timestamp", "open", "high", "low", "close", "source_job_id"}
+	priceCandleColumnsWithDefault    = []string{}
+	priceCandlePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// PriceCandleSlice is an alias for a slice of pointers to PriceCandle.
+	// This should generally be used opposed to []PriceCandle.
+	PriceCandleSlice []*PriceCandle
+	// PriceCandleHook is the signature for custom PriceCandle hook methods
+	PriceCandleHook func(context.Context, boil.ContextExecutor, *PriceCandle) error
+
+	priceCandleQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	priceCandleType                 = reflect.TypeOf(&PriceCandle{})
+	priceCandleMapping              = queries.MakeStructMapping(priceCandleType)
+	priceCandlePrimaryKeyMapping, _ = queries.BindMapping(priceCandleType, priceCandleMapping, priceCandlePrimaryKeyColumns)
+	priceCandleInsertCacheMut       sync.RWMutex
+	priceCandleInsertCache          = make(map[string]insertCache)
+	priceCandleUpdateCacheMut       sync.RWMutex
+	priceCandleUpdateCache          = make(map[string]updateCache)
+	priceCandleUpsertCacheMut       sync.RWMutex
+	priceCandleUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var priceCandleBeforeInsertHooks []PriceCandleHook
+var priceCandleBeforeUpdateHooks []PriceCandleHook
+var priceCandleBeforeDeleteHooks []PriceCandleHook
+var priceCandleBeforeUpsertHooks []PriceCandleHook
+
+var priceCandleAfterInsertHooks []PriceCandleHook
+var priceCandleAfterSelectHooks []PriceCandleHook
+var priceCandleAfterUpdateHooks []PriceCandleHook
+var priceCandleAfterDeleteHooks []PriceCandleHook
+var priceCandleAfterUpsertHooks []PriceCandleHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PriceCandle) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceCandleBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PriceCandle) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceCandleBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PriceCandle) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceCandleBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PriceCandle) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceCandleBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PriceCandle) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceCandleAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PriceCandle) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceCandleAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PriceCandle) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceCandleAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PriceCandle) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceCandleAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PriceCandle) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceCandleAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPriceCandleHook registers your hook function for all future operations.
+func AddPriceCandleHook(hookPoint boil.HookPoint, priceCandleHook PriceCandleHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		priceCandleBeforeInsertHooks = append(priceCandleBeforeInsertHooks, priceCandleHook)
+	case boil.BeforeUpdateHook:
+		priceCandleBeforeUpdateHooks = append(priceCandleBeforeUpdateHooks, priceCandleHook)
+	case boil.BeforeDeleteHook:
+		priceCandleBeforeDeleteHooks = append(priceCandleBeforeDeleteHooks, priceCandleHook)
+	case boil.BeforeUpsertHook:
+		priceCandleBeforeUpsertHooks = append(priceCandleBeforeUpsertHooks, priceCandleHook)
+	case boil.AfterInsertHook:
+		priceCandleAfterInsertHooks = append(priceCandleAfterInsertHooks, priceCandleHook)
+	case boil.AfterSelectHook:
+		priceCandleAfterSelectHooks = append(priceCandleAfterSelectHooks, priceCandleHook)
+	case boil.AfterUpdateHook:
+		priceCandleAfterUpdateHooks = append(priceCandleAfterUpdateHooks, priceCandleHook)
+	case boil.AfterDeleteHook:
+		priceCandleAfterDeleteHooks = append(priceCandleAfterDeleteHooks, priceCandleHook)
+	case boil.AfterUpsertHook:
+		priceCandleAfterUpsertHooks = append(priceCandleAfterUpsertHooks, priceCandleHook)
+	}
+}
+
+// One returns a single priceCandle record from the query.
+func (q priceCandleQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PriceCandle, error) {
+	o := &PriceCandle{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for price_candle")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PriceCandle records from the query.
+func (q priceCandleQuery) All(ctx context.Context, exec boil.ContextExecutor) (PriceCandleSlice, error) {
+	var o []*PriceCandle
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to PriceCandle slice")
+	}
+
+	if len(priceCandleAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PriceCandle records in the query.
+func (q priceCandleQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count price_candle rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q priceCandleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if price_candle exists")
+	}
+
+	return count > 0, nil
+}
+
+// SourceJob pointed to by the foreign key.
+func (o *PriceCandle) SourceJob(mods ...qm.QueryMod) datahistoryjobQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.SourceJobID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Datahistoryjobs(queryMods...)
+	queries.SetFrom(query.Query, "\"datahistoryjob\"")
+
+	return query
+}
+
+// ExchangeName pointed to by the foreign key.
+func (o *PriceCandle) ExchangeName(mods ...qm.QueryMod) exchangeQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ExchangeNameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := Exchanges(queryMods...)
+	queries.SetFrom(query.Query, "\"exchange\"")
+
+	return query
+}
+
+// LoadSourceJob allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (priceCandleL) LoadSourceJob(ctx context.Context, e boil.ContextExecutor, singular bool, maybePriceCandle interface{}, mods queries.Applicator) error {
+	var slice []*PriceCandle
+	var object *PriceCandle
+
+	if singular {
+		object = maybePriceCandle.(*PriceCandle)
+	} else {
+		slice = *maybePriceCandle.(*[]*PriceCandle)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &priceCandleR{}
+		}
+		if !queries.IsNil(object.SourceJobID) {
+			args = append(args, object.SourceJobID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &priceCandleR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.SourceJobID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.SourceJobID) {
+				args = append(args, obj.SourceJobID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Datahistoryjob")
+	}
+
+	var resultSlice []*Datahistoryjob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Datahistoryjob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for datahistoryjob")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+	}
+
+	if len(priceCandleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.SourceJob = foreign
+		if foreign.R == nil {
+			foreign.R = &datahistoryjobR{}
+		}
+		foreign.R.SourceJobPriceCandles = append(foreign.R.SourceJobPriceCandles, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.SourceJobID, foreign.ID) {
+				local.R.SourceJob = foreign
+				if foreign.R == nil {
+					foreign.R = &datahistoryjobR{}
+				}
+				foreign.R.SourceJobPriceCandles = append(foreign.R.SourceJobPriceCandles, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeName allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (priceCandleL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybePriceCandle interface{}, mods queries.Applicator) error {
+	var slice []*PriceCandle
+	var object *PriceCandle
+
+	if singular {
+		object = maybePriceCandle.(*PriceCandle)
+	} else {
+		slice = *maybePriceCandle.(*[]*PriceCandle)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &priceCandleR{}
+		}
+		args = append(args, object.ExchangeNameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &priceCandleR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ExchangeNameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ExchangeNameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`exchange`), qm.WhereIn(`exchange.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Exchange")
+	}
+
+	var resultSlice []*Exchange
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Exchange")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for exchange")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for exchange")
+	}
+
+	if len(priceCandleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeName = foreign
+		if foreign.R == nil {
+			foreign.R = &exchangeR{}
+		}
+		foreign.R.ExchangeNamePriceCandle = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ExchangeNameID == foreign.ID {
+				local.R.ExchangeName = foreign
+				if foreign.R == nil {
+					foreign.R = &exchangeR{}
+				}
+				foreign.R.ExchangeNamePriceCandle = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetSourceJob of the priceCandle to the related item.
+// Sets o.R.SourceJob to related.
+// Adds o to related.R.SourceJobPriceCandles.
+func (o *PriceCandle) SetSourceJob(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Datahistoryjob) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"price_candle\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"source_job_id"}),
+		strmangle.WhereClause("\"", "\"", 0, priceCandlePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.SourceJobID, related.ID)
+	if o.R == nil {
+		o.R = &priceCandleR{
+			SourceJob: related,
+		}
+	} else {
+		o.R.SourceJob = related
+	}
+
+	if related.R == nil {
+		related.R = &datahistoryjobR{
+			SourceJobPriceCandles: PriceCandleSlice{o},
+		}
+	} else {
+		related.R.SourceJobPriceCandles = append(related.R.SourceJobPriceCandles, o)
+	}
+
+	return nil
+}
+
+// RemoveSourceJob relationship.
+// Sets o.R.SourceJob to nil.
+// Removes o from all passed in related items' relationships struct (Optional).
+func (o *PriceCandle) RemoveSourceJob(ctx context.Context, exec boil.ContextExecutor, related *Datahistoryjob) error {
+	var err error
+
+	queries.SetScanner(&o.SourceJobID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("source_job_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.R.SourceJob = nil
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.SourceJobPriceCandles {
+		if queries.Equal(o.SourceJobID, ri.SourceJobID) {
+			continue
+		}
+
+		ln := len(related.R.SourceJobPriceCandles)
+		if ln > 1 && i < ln-1 {
+			related.R.SourceJobPriceCandles[i] = related.R.SourceJobPriceCandles[ln-1]
+		}
+		related.R.SourceJobPriceCandles = related.R.SourceJobPriceCandles[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetExchangeName of the priceCandle to the related item.
+// Sets o.R.ExchangeName to related.
+// Adds o to related.R.ExchangeNamePriceCandle.
+func (o *PriceCandle) SetExchangeName(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Exchange) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"price_candle\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+		strmangle.WhereClause("\"", "\"", 0, priceCandlePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ExchangeNameID = related.ID
+	if o.R == nil {
+		o.R = &priceCandleR{
+			ExchangeName: related,
+		}
+	} else {
+		o.R.ExchangeName = related
+	}
+
+	if related.R == nil {
+		related.R = &exchangeR{
+			ExchangeNamePriceCandle: o,
+		}
+	} else {
+		related.R.ExchangeNamePriceCandle = o
+	}
+
+	return nil
+}
+
+// PriceCandles retrieves all the records using an executor.
+func PriceCandles(mods ...qm.QueryMod) priceCandleQuery {
+	mods = append(mods, qm.From("\"price_candle\""))
+	return priceCandleQuery{NewQuery(mods...)}
+}
+
+// FindPriceCandle retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPriceCandle(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*PriceCandle, error) {
+	priceCandleObj := &PriceCandle{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"price_candle\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, priceCandleObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from price_candle")
+	}
+
+	return priceCandleObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PriceCandle) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no price_candle provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(priceCandleColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	priceCandleInsertCacheMut.RLock()
+	cache, cached := priceCandleInsertCache[key]
+	priceCandleInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			priceCandleAllColumns,
+			priceCandleColumnsWithDefault,
+			priceCandleColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(priceCandleType, priceCandleMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(priceCandleType, priceCandleMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"price_candle\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"price_candle\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"price_candle\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, priceCandlePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into price_candle")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for price_candle")
+	}
+
+CacheNoHooks:
+	if !cached {
+		priceCandleInsertCacheMut.Lock()
+		priceCandleInsertCache[key] = cache
+		priceCandleInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PriceCandle.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PriceCandle) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	priceCandleUpdateCacheMut.RLock()
+	cache, cached := priceCandleUpdateCache[key]
+	priceCandleUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			priceCandleAllColumns,
+			priceCandlePrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update price_candle, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"price_candle\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, priceCandlePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(priceCandleType, priceCandleMapping, append(wl, priceCandlePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update price_candle row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for price_candle")
+	}
+
+	if !cached {
+		priceCandleUpdateCacheMut.Lock()
+		priceCandleUpdateCache[key] = cache
+		priceCandleUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q priceCandleQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for price_candle")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for price_candle")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PriceCandleSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceCandlePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"price_candle\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, priceCandlePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in priceCandle slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all priceCandle")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single PriceCandle record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PriceCandle) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no PriceCandle provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), priceCandlePrimaryKeyMapping)
+	sql := "DELETE FROM \"price_candle\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from price_candle")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for price_candle")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q priceCandleQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no priceCandleQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from price_candle")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for price_candle")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PriceCandleSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(priceCandleBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceCandlePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"price_candle\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, priceCandlePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from priceCandle slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for price_candle")
+	}
+
+	if len(priceCandleAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PriceCandle) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPriceCandle(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PriceCandleSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PriceCandleSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceCandlePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"price_candle\".* FROM \"price_candle\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, priceCandlePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in PriceCandleSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PriceCandleExists checks if the PriceCandle row exists.
+func PriceCandleExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"price_candle\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if price_candle exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,796 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// ScriptState is an object representing the database table.
+type ScriptState struct {
+	ID         int64  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ScriptName string `boil:"script_name" json:"script_name" toml:"script_name" yaml:"script_name"`
+	Key        string `boil:"key" json:"key" toml:"key" yaml:"key"`
+	Value      string `boil:"value" json:"value" toml:"value" yaml:"value"`
+	CreatedAt  string `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  string `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *scriptStateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L scriptStateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ScriptStateColumns = struct {
+	ID         string
+	ScriptName string
+	Key        string
+	Value      string
+	CreatedAt  string
+	UpdatedAt  string
+}{
+	ID:         "id",
+	ScriptName: "script_name",
+	Key:        "key",
+	Value:      "value",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+}
+
+// Generated where
+
+var ScriptStateWhere = struct {
+	ID         whereHelperint64
+	ScriptName whereHelperstring
+	Key        whereHelperstring
+	Value      whereHelperstring
+	CreatedAt  whereHelperstring
+	UpdatedAt  whereHelperstring
+}{
+	ID:         whereHelperint64{field: "\"script_state\".\"id\""},
+	ScriptName: whereHelperstring{field: "\"script_state\".\"script_name\""},
+	Key:        whereHelperstring{field: "\"script_state\".\"key\""},
+	Value:      whereHelperstring{field: "\"script_state\".\"value\""},
+	CreatedAt:  whereHelperstring{field: "\"script_state\".\"created_at\""},
+	UpdatedAt:  whereHelperstring{field: "\"script_state\".\"updated_at\""},
+}
+
+// ScriptStateRels is where relationship names are stored.
+var ScriptStateRels = struct {
+}{}
+
+// scriptStateR is where relationships are stored.
+type scriptStateR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*scriptStateR) NewStruct() *scriptStateR {
+	return &scriptStateR{}
+}
+
+// scriptStateL is where Load methods for each relationship are stored.
+type scriptStateL struct{}
+
+var (
+	scriptStateAllColumns            = []string{"id", "script_name", "key", "value", "created_at", "updated_at"}
+	scriptStateColumnsWithoutDefault = []string{"script_name", "key", "value"}
+	scriptStateColumnsWithDefault    = []string{"id", "created_at", "updated_at"}
+	scriptStatePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// ScriptStateSlice is an alias for a slice of pointers to ScriptState.
+	// This should generally be used opposed to []ScriptState.
+	ScriptStateSlice []*ScriptState
+	// ScriptStateHook is the signature for custom ScriptState hook methods
+	ScriptStateHook func(context.Context, boil.ContextExecutor, *ScriptState) error
+
+	scriptStateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	scriptStateType                 = reflect.TypeOf(&ScriptState{})
+	scriptStateMapping              = queries.MakeStructMapping(scriptStateType)
+	scriptStatePrimaryKeyMapping, _ = queries.BindMapping(scriptStateType, scriptStateMapping, scriptStatePrimaryKeyColumns)
+	scriptStateInsertCacheMut       sync.RWMutex
+	scriptStateInsertCache          = make(map[string]insertCache)
+	scriptStateUpdateCacheMut       sync.RWMutex
+	scriptStateUpdateCache          = make(map[string]updateCache)
+	scriptStateUpsertCacheMut       sync.RWMutex
+	scriptStateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var scriptStateBeforeInsertHooks []ScriptStateHook
+var scriptStateBeforeUpdateHooks []ScriptStateHook
+var scriptStateBeforeDeleteHooks []ScriptStateHook
+var scriptStateBeforeUpsertHooks []ScriptStateHook
+
+var scriptStateAfterInsertHooks []ScriptStateHook
+var scriptStateAfterSelectHooks []ScriptStateHook
+var scriptStateAfterUpdateHooks []ScriptStateHook
+var scriptStateAfterDeleteHooks []ScriptStateHook
+var scriptStateAfterUpsertHooks []ScriptStateHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ScriptState) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptStateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ScriptState) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptStateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ScriptState) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptStateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ScriptState) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptStateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ScriptState) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptStateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ScriptState) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptStateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ScriptState) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptStateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ScriptState) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptStateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ScriptState) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range scriptStateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddScriptStateHook registers your hook function for all future operations.
+func AddScriptStateHook(hookPoint boil.HookPoint, scriptStateHook ScriptStateHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		scriptStateBeforeInsertHooks = append(scriptStateBeforeInsertHooks, scriptStateHook)
+	case boil.BeforeUpdateHook:
+		scriptStateBeforeUpdateHooks = append(scriptStateBeforeUpdateHooks, scriptStateHook)
+	case boil.BeforeDeleteHook:
+		scriptStateBeforeDeleteHooks = append(scriptStateBeforeDeleteHooks, scriptStateHook)
+	case boil.BeforeUpsertHook:
+		scriptStateBeforeUpsertHooks = append(scriptStateBeforeUpsertHooks, scriptStateHook)
+	case boil.AfterInsertHook:
+		scriptStateAfterInsertHooks = append(scriptStateAfterInsertHooks, scriptStateHook)
+	case boil.AfterSelectHook:
+		scriptStateAfterSelectHooks = append(scriptStateAfterSelectHooks, scriptStateHook)
+	case boil.AfterUpdateHook:
+		scriptStateAfterUpdateHooks = append(scriptStateAfterUpdateHooks, scriptStateHook)
+	case boil.AfterDeleteHook:
+		scriptStateAfterDeleteHooks = append(scriptStateAfterDeleteHooks, scriptStateHook)
+	case boil.AfterUpsertHook:
+		scriptStateAfterUpsertHooks = append(scriptStateAfterUpsertHooks, scriptStateHook)
+	}
+}
+
+// One returns a single scriptState record from the query.
+func (q scriptStateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ScriptState, error) {
+	o := &ScriptState{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for script_state")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all ScriptState records from the query.
+func (q scriptStateQuery) All(ctx context.Context, exec boil.ContextExecutor) (ScriptStateSlice, error) {
+	var o []*ScriptState
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to ScriptState slice")
+	}
+
+	if len(scriptStateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all ScriptState records in the query.
+func (q scriptStateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count script_state rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q scriptStateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if script_state exists")
+	}
+
+	return count > 0, nil
+}
+
+// ScriptStates retrieves all the records using an executor.
+func ScriptStates(mods ...qm.QueryMod) scriptStateQuery {
+	mods = append(mods, qm.From("\"script_state\""))
+	return scriptStateQuery{NewQuery(mods...)}
+}
+
+// FindScriptState retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindScriptState(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*ScriptState, error) {
+	scriptStateObj := &ScriptState{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"script_state\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, scriptStateObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from script_state")
+	}
+
+	return scriptStateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ScriptState) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no script_state provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(scriptStateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	scriptStateInsertCacheMut.RLock()
+	cache, cached := scriptStateInsertCache[key]
+	scriptStateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			scriptStateAllColumns,
+			scriptStateColumnsWithDefault,
+			scriptStateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(scriptStateType, scriptStateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(scriptStateType, scriptStateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"script_state\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"script_state\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"script_state\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, scriptStatePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into script_state")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == scriptStateMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for script_state")
+	}
+
+CacheNoHooks:
+	if !cached {
+		scriptStateInsertCacheMut.Lock()
+		scriptStateInsertCache[key] = cache
+		scriptStateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the ScriptState.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ScriptState) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	scriptStateUpdateCacheMut.RLock()
+	cache, cached := scriptStateUpdateCache[key]
+	scriptStateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			scriptStateAllColumns,
+			scriptStatePrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update script_state, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"script_state\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, scriptStatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(scriptStateType, scriptStateMapping, append(wl, scriptStatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update script_state row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for script_state")
+	}
+
+	if !cached {
+		scriptStateUpdateCacheMut.Lock()
+		scriptStateUpdateCache[key] = cache
+		scriptStateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q scriptStateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for script_state")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for script_state")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ScriptStateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scriptStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"script_state\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, scriptStatePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in scriptState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all scriptState")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single ScriptState record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ScriptState) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no ScriptState provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), scriptStatePrimaryKeyMapping)
+	sql := "DELETE FROM \"script_state\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from script_state")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for script_state")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q scriptStateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no scriptStateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from script_state")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for script_state")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ScriptStateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(scriptStateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scriptStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"script_state\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, scriptStatePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from scriptState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for script_state")
+	}
+
+	if len(scriptStateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ScriptState) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindScriptState(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ScriptStateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ScriptStateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), scriptStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"script_state\".* FROM \"script_state\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, scriptStatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in ScriptStateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ScriptStateExists checks if the ScriptState row exists.
+func ScriptStateExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"script_state\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if script_state exists")
+	}
+
+	return exists, nil
+}
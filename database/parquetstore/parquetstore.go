@@ -0,0 +1,344 @@
+package parquetstore
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// WriteCandles stores the candles in daily partitions under dir, returning the
+// number of candles written. Existing candles are only replaced when overwrite
+// is set
+func WriteCandles(dir string, item *kline.Item, overwrite bool) (int, error) {
+	if item == nil {
+		return 0, errNilItem
+	}
+	if len(item.Candles) == 0 {
+		return 0, errNoRows
+	}
+	path, err := partitionPath(dir, item.Exchange, item.Asset, item.Pair)
+	if err != nil {
+		return 0, err
+	}
+	rows := make([]candleRow, len(item.Candles))
+	for i := range item.Candles {
+		rows[i] = candleRow{
+			Timestamp:   item.Candles[i].Time.UnixMicro(),
+			Open:        item.Candles[i].Open,
+			High:        item.Candles[i].High,
+			Low:         item.Candles[i].Low,
+			Close:       item.Candles[i].Close,
+			Volume:      item.Candles[i].Volume,
+			QuoteVolume: item.Candles[i].QuoteVolume,
+		}
+	}
+	return writePartitioned(path, candleFile(item.Interval), rows, candleRowTimestamp, candleRowKey, overwrite)
+}
+
+// ReadCandles returns the candles stored under dir between start and end
+func ReadCandles(dir, exchangeName string, pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error) {
+	path, err := partitionPath(dir, exchangeName, a, pair)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := readPartitioned(path, candleFile(interval), start, end, candleRowTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	item := &kline.Item{
+		Exchange: exchangeName,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+		Candles:  make([]kline.Candle, len(rows)),
+	}
+	for i := range rows {
+		item.Candles[i] = kline.Candle{
+			Time:        time.UnixMicro(rows[i].Timestamp).UTC(),
+			Open:        rows[i].Open,
+			High:        rows[i].High,
+			Low:         rows[i].Low,
+			Close:       rows[i].Close,
+			Volume:      rows[i].Volume,
+			QuoteVolume: rows[i].QuoteVolume,
+		}
+	}
+	return item, nil
+}
+
+// WriteTrades stores the trades in daily partitions under dir, returning the
+// number of trades written. All trades must belong to the same exchange, asset
+// and pair. Existing trades are only replaced when overwrite is set
+func WriteTrades(dir string, overwrite bool, trades ...trade.Data) (int, error) {
+	if len(trades) == 0 {
+		return 0, errNoRows
+	}
+	path, err := partitionPath(dir, trades[0].Exchange, trades[0].AssetType, trades[0].CurrencyPair)
+	if err != nil {
+		return 0, err
+	}
+	rows := make([]tradeRow, len(trades))
+	for i := range trades {
+		if !strings.EqualFold(trades[i].Exchange, trades[0].Exchange) ||
+			trades[i].AssetType != trades[0].AssetType ||
+			!trades[i].CurrencyPair.Equal(trades[0].CurrencyPair) {
+			return 0, fmt.Errorf("%w: %v %v %v", errMixedPartitions, trades[i].Exchange, trades[i].AssetType, trades[i].CurrencyPair)
+		}
+		rows[i] = tradeRow{
+			Timestamp: trades[i].Timestamp.UnixMicro(),
+			TID:       trades[i].TID,
+			Side:      trades[i].Side.String(),
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+		}
+	}
+	return writePartitioned(path, tradesFile, rows, tradeRowTimestamp, tradeRowKey, overwrite)
+}
+
+// ReadTrades returns the trades stored under dir between start and end
+func ReadTrades(dir, exchangeName string, pair currency.Pair, a asset.Item, start, end time.Time) ([]trade.Data, error) {
+	path, err := partitionPath(dir, exchangeName, a, pair)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := readPartitioned(path, tradesFile, start, end, tradeRowTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	trades := make([]trade.Data, len(rows))
+	for i := range rows {
+		side, err := order.StringToOrderSide(rows[i].Side)
+		if err != nil && rows[i].Side != order.UnknownSide.String() {
+			return nil, err
+		}
+		trades[i] = trade.Data{
+			TID:          rows[i].TID,
+			Exchange:     exchangeName,
+			CurrencyPair: pair,
+			AssetType:    a,
+			Side:         side,
+			Price:        rows[i].Price,
+			Amount:       rows[i].Amount,
+			Timestamp:    time.UnixMicro(rows[i].Timestamp).UTC(),
+		}
+	}
+	return trades, nil
+}
+
+// WriteFundingRates stores the funding rates in daily partitions under dir,
+// returning the number of rates written. Existing rates are only replaced when
+// overwrite is set
+func WriteFundingRates(dir string, rates *fundingrate.HistoricalRates, overwrite bool) (int, error) {
+	if rates == nil {
+		return 0, errNilItem
+	}
+	if len(rates.FundingRates) == 0 {
+		return 0, errNoRows
+	}
+	path, err := partitionPath(dir, rates.Exchange, rates.Asset, rates.Pair)
+	if err != nil {
+		return 0, err
+	}
+	rows := make([]fundingRateRow, len(rates.FundingRates))
+	for i := range rates.FundingRates {
+		rows[i] = fundingRateRow{
+			Timestamp: rates.FundingRates[i].Time.UnixMicro(),
+			Rate:      rates.FundingRates[i].Rate.InexactFloat64(),
+			Payment:   rates.FundingRates[i].Payment.InexactFloat64(),
+		}
+	}
+	return writePartitioned(path, fundingRatesFile, rows, fundingRateRowTimestamp, fundingRateRowKey, overwrite)
+}
+
+// ReadFundingRates returns the funding rates stored under dir between start and
+// end
+func ReadFundingRates(dir, exchangeName string, pair currency.Pair, a asset.Item, start, end time.Time) (*fundingrate.HistoricalRates, error) {
+	path, err := partitionPath(dir, exchangeName, a, pair)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := readPartitioned(path, fundingRatesFile, start, end, fundingRateRowTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	rates := &fundingrate.HistoricalRates{
+		Exchange:     exchangeName,
+		Asset:        a,
+		Pair:         pair,
+		StartDate:    start,
+		EndDate:      end,
+		FundingRates: make([]fundingrate.Rate, len(rows)),
+	}
+	for i := range rows {
+		rates.FundingRates[i] = fundingrate.Rate{
+			Time:    time.UnixMicro(rows[i].Timestamp).UTC(),
+			Rate:    decimal.NewFromFloat(rows[i].Rate),
+			Payment: decimal.NewFromFloat(rows[i].Payment),
+		}
+		rates.PaymentSum = rates.PaymentSum.Add(rates.FundingRates[i].Payment)
+	}
+	rates.LatestRate = rates.FundingRates[len(rates.FundingRates)-1]
+	return rates, nil
+}
+
+// partitionPath returns the exchange/asset/pair directory of a data set. Path
+// segments use the key=value form so the partitions can be discovered by
+// common columnar query engines
+func partitionPath(dir, exchangeName string, a asset.Item, pair currency.Pair) (string, error) {
+	if dir == "" {
+		return "", errDirectoryUnset
+	}
+	if exchangeName == "" || !a.IsValid() || pair.IsEmpty() {
+		return "", errInvalidPartition
+	}
+	return filepath.Join(dir,
+		"exchange="+strings.ToLower(exchangeName),
+		"asset="+a.String(),
+		"pair="+pair.Base.Upper().String()+"-"+pair.Quote.Upper().String()), nil
+}
+
+func candleFile(interval kline.Interval) string {
+	return candlesPrefix + interval.Short() + fileExtension
+}
+
+// writePartitioned splits rows by UTC day and merges each day into its
+// partition file
+func writePartitioned[T any](path, fileName string, rows []T, timestamp func(*T) int64, key func(*T) string, overwrite bool) (int, error) {
+	days := make(map[string][]T)
+	for i := range rows {
+		day := time.UnixMicro(timestamp(&rows[i])).UTC().Format(partitionDate)
+		days[day] = append(days[day], rows[i])
+	}
+	var written int
+	for day, dayRows := range days {
+		n, err := mergeFile(filepath.Join(path, "date="+day, fileName), dayRows, timestamp, key, overwrite)
+		if err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
+
+// mergeFile combines rows with those already stored in the file and atomically
+// replaces it, returning the number of rows added or replaced
+func mergeFile[T any](file string, rows []T, timestamp func(*T) int64, key func(*T) string, overwrite bool) (int, error) {
+	merged, err := parquet.ReadFile[T](file)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return 0, fmt.Errorf("%s: %w", file, err)
+	}
+	existing := make(map[string]int, len(merged)+len(rows))
+	for i := range merged {
+		existing[key(&merged[i])] = i
+	}
+	var written int
+	for i := range rows {
+		k := key(&rows[i])
+		if j, ok := existing[k]; ok {
+			if overwrite {
+				merged[j] = rows[i]
+				written++
+			}
+			continue
+		}
+		existing[k] = len(merged)
+		merged = append(merged, rows[i])
+		written++
+	}
+	if written == 0 {
+		return 0, nil
+	}
+	slices.SortStableFunc(merged, func(a, b T) int {
+		return cmp.Compare(timestamp(&a), timestamp(&b))
+	})
+	if err := os.MkdirAll(filepath.Dir(file), 0o770); err != nil {
+		return 0, err
+	}
+	tmp := file + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return 0, err
+	}
+	if err := parquet.Write(f, merged); err != nil {
+		return 0, errors.Join(err, f.Close(), os.Remove(tmp))
+	}
+	if err := f.Close(); err != nil {
+		return 0, errors.Join(err, os.Remove(tmp))
+	}
+	return written, os.Rename(tmp, file)
+}
+
+// readPartitioned reads the daily partition files covering start to end one at
+// a time, returning the rows which fall within the range
+func readPartitioned[T any](path, fileName string, start, end time.Time, timestamp func(*T) int64) ([]T, error) {
+	if !end.After(start) {
+		return nil, fmt.Errorf("%w: start %v end %v", ErrNoDataFound, start, end)
+	}
+	startMicro, endMicro := start.UnixMicro(), end.UnixMicro()
+	var rows []T
+	for day := start.UTC().Truncate(kline.OneDay.Duration()); day.Before(end); day = day.Add(kline.OneDay.Duration()) {
+		file := filepath.Join(path, "date="+day.Format(partitionDate), fileName)
+		dayRows, err := parquet.ReadFile[T](file)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		for i := range dayRows {
+			if ts := timestamp(&dayRows[i]); ts >= startMicro && ts < endMicro {
+				rows = append(rows, dayRows[i])
+			}
+		}
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w: %s %s between %v and %v", ErrNoDataFound, path, fileName, start, end)
+	}
+	return rows, nil
+}
+
+func candleRowTimestamp(r *candleRow) int64 {
+	return r.Timestamp
+}
+
+func tradeRowTimestamp(r *tradeRow) int64 {
+	return r.Timestamp
+}
+
+func fundingRateRowTimestamp(r *fundingRateRow) int64 {
+	return r.Timestamp
+}
+
+func candleRowKey(r *candleRow) string {
+	return strconv.FormatInt(r.Timestamp, 10)
+}
+
+func fundingRateRowKey(r *fundingRateRow) string {
+	return strconv.FormatInt(r.Timestamp, 10)
+}
+
+// tradeRowKey identifies a trade by its exchange trade ID, falling back to its
+// contents when the exchange does not supply one
+func tradeRowKey(r *tradeRow) string {
+	if r.TID != "" {
+		return r.TID
+	}
+	return strconv.FormatInt(r.Timestamp, 10) + r.Side +
+		strconv.FormatFloat(r.Price, 'f', -1, 64) + ":" +
+		strconv.FormatFloat(r.Amount, 'f', -1, 64)
+}
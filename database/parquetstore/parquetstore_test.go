@@ -0,0 +1,151 @@
+package parquetstore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const testExchange = "Binance"
+
+var (
+	testPair  = currency.NewBTCUSDT()
+	testStart = time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)
+)
+
+func TestWriteReadCandles(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	_, err := WriteCandles(dir, nil, false)
+	assert.ErrorIs(t, err, errNilItem)
+
+	item := &kline.Item{Exchange: testExchange, Pair: testPair, Asset: asset.Spot, Interval: kline.OneHour}
+	_, err = WriteCandles(dir, item, false)
+	assert.ErrorIs(t, err, errNoRows)
+
+	for i := range 6 {
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:   testStart.Add(time.Duration(i) * time.Hour),
+			Open:   float64(i),
+			High:   float64(i) + 2,
+			Low:    float64(i) - 1,
+			Close:  float64(i) + 1,
+			Volume: 10,
+		})
+	}
+	_, err = WriteCandles("", item, false)
+	assert.ErrorIs(t, err, errDirectoryUnset)
+
+	n, err := WriteCandles(dir, item, false)
+	require.NoError(t, err, "WriteCandles must not error")
+	assert.Equal(t, 6, n, "WriteCandles should write all candles")
+
+	for _, day := range []string{"2024-01-01", "2024-01-02"} {
+		assert.FileExists(t, filepath.Join(dir, "exchange=binance", "asset=spot", "pair=BTC-USDT", "date="+day, "candles_1h.parquet"))
+	}
+
+	item.Candles[0].Close = 1337
+	n, err = WriteCandles(dir, item, false)
+	require.NoError(t, err, "WriteCandles must not error")
+	assert.Zero(t, n, "WriteCandles should not replace existing candles")
+
+	n, err = WriteCandles(dir, item, true)
+	require.NoError(t, err, "WriteCandles must not error")
+	assert.Equal(t, 6, n, "WriteCandles should replace existing candles")
+
+	got, err := ReadCandles(dir, testExchange, testPair, asset.Spot, kline.OneHour, testStart, testStart.Add(6*time.Hour))
+	require.NoError(t, err, "ReadCandles must not error")
+	require.Len(t, got.Candles, 6, "ReadCandles must return all candles")
+	assert.Equal(t, 1337.0, got.Candles[0].Close, "ReadCandles should return the replaced candle")
+	assert.Equal(t, item.Candles[5], got.Candles[5], "ReadCandles should return the stored candle")
+
+	got, err = ReadCandles(dir, testExchange, testPair, asset.Spot, kline.OneHour, testStart.Add(time.Hour), testStart.Add(3*time.Hour))
+	require.NoError(t, err, "ReadCandles must not error")
+	assert.Len(t, got.Candles, 2, "ReadCandles should only return candles within the range")
+
+	_, err = ReadCandles(dir, testExchange, testPair, asset.Spot, kline.FourHour, testStart, testStart.Add(6*time.Hour))
+	assert.ErrorIs(t, err, ErrNoDataFound)
+
+	_, err = ReadCandles(dir, testExchange, currency.EMPTYPAIR, asset.Spot, kline.OneHour, testStart, testStart.Add(6*time.Hour))
+	assert.ErrorIs(t, err, errInvalidPartition)
+}
+
+func TestWriteReadTrades(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	_, err := WriteTrades(dir, false)
+	assert.ErrorIs(t, err, errNoRows)
+
+	trades := []trade.Data{
+		{TID: "1", Exchange: testExchange, CurrencyPair: testPair, AssetType: asset.Spot, Side: order.Buy, Price: 1, Amount: 1, Timestamp: testStart},
+		{TID: "2", Exchange: testExchange, CurrencyPair: testPair, AssetType: asset.Spot, Side: order.Sell, Price: 2, Amount: 1, Timestamp: testStart.Add(time.Second)},
+		{Exchange: testExchange, CurrencyPair: testPair, AssetType: asset.Spot, Price: 3, Amount: 1, Timestamp: testStart.Add(5 * time.Hour)},
+	}
+	_, err = WriteTrades(dir, false, append(trades, trade.Data{Exchange: testExchange, CurrencyPair: testPair, AssetType: asset.Futures})...)
+	assert.ErrorIs(t, err, errMixedPartitions)
+
+	n, err := WriteTrades(dir, false, trades...)
+	require.NoError(t, err, "WriteTrades must not error")
+	assert.Equal(t, 3, n, "WriteTrades should write all trades")
+
+	n, err = WriteTrades(dir, false, trades...)
+	require.NoError(t, err, "WriteTrades must not error")
+	assert.Zero(t, n, "WriteTrades should not duplicate trades")
+
+	got, err := ReadTrades(dir, testExchange, testPair, asset.Spot, testStart, testStart.Add(time.Hour*24))
+	require.NoError(t, err, "ReadTrades must not error")
+	require.Len(t, got, 3, "ReadTrades must return all trades")
+	assert.Equal(t, trades[1], got[1], "ReadTrades should return the stored trade")
+	assert.Equal(t, order.UnknownSide, got[2].Side, "ReadTrades should retain unknown sides")
+
+	_, err = ReadTrades(dir, testExchange, testPair, asset.Spot, testStart, testStart)
+	assert.ErrorIs(t, err, ErrNoDataFound)
+}
+
+func TestWriteReadFundingRates(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	_, err := WriteFundingRates(dir, nil, false)
+	assert.ErrorIs(t, err, errNilItem)
+
+	rates := &fundingrate.HistoricalRates{Exchange: testExchange, Asset: asset.USDTMarginedFutures, Pair: testPair}
+	_, err = WriteFundingRates(dir, rates, false)
+	assert.ErrorIs(t, err, errNoRows)
+
+	for i := range 3 {
+		rates.FundingRates = append(rates.FundingRates, fundingrate.Rate{
+			Time:    testStart.Add(time.Duration(i) * 8 * time.Hour),
+			Rate:    decimal.NewFromFloat(0.0001),
+			Payment: decimal.NewFromInt(int64(i)),
+		})
+	}
+	n, err := WriteFundingRates(dir, rates, false)
+	require.NoError(t, err, "WriteFundingRates must not error")
+	assert.Equal(t, 3, n, "WriteFundingRates should write all rates")
+
+	got, err := ReadFundingRates(dir, testExchange, testPair, asset.USDTMarginedFutures, testStart, testStart.Add(24*time.Hour))
+	require.NoError(t, err, "ReadFundingRates must not error")
+	require.Len(t, got.FundingRates, 3, "ReadFundingRates must return all rates")
+	assert.True(t, got.FundingRates[0].Rate.Equal(decimal.NewFromFloat(0.0001)), "ReadFundingRates should return the stored rate")
+	assert.True(t, got.PaymentSum.Equal(decimal.NewFromInt(3)), "ReadFundingRates should sum payments")
+	assert.Equal(t, got.FundingRates[2], got.LatestRate, "ReadFundingRates should set the latest rate")
+}
+
+func TestMergeFileCorrupt(t *testing.T) {
+	t.Parallel()
+	file := filepath.Join(t.TempDir(), tradesFile)
+	require.NoError(t, os.WriteFile(file, []byte("not parquet"), 0o600), "WriteFile must not error")
+	_, err := mergeFile(file, []tradeRow{{TID: "1"}}, tradeRowTimestamp, tradeRowKey, false)
+	assert.Error(t, err, "mergeFile should error on a corrupt file")
+}
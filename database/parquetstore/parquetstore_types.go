@@ -0,0 +1,50 @@
+package parquetstore
+
+import "errors"
+
+const (
+	tradesFile       = "trades.parquet"
+	fundingRatesFile = "funding_rates.parquet"
+	candlesPrefix    = "candles_"
+	fileExtension    = ".parquet"
+	partitionDate    = "2006-01-02"
+)
+
+var (
+	// ErrNoDataFound is returned when no parquet data exists for the requested
+	// exchange, asset, pair and range
+	ErrNoDataFound = errors.New("no parquet data found")
+
+	errDirectoryUnset   = errors.New("parquet directory unset")
+	errNilItem          = errors.New("nil item received")
+	errInvalidPartition = errors.New("exchange, asset & pair must be set")
+	errNoRows           = errors.New("no rows provided")
+	errMixedPartitions  = errors.New("trades must share the same exchange, asset & pair")
+)
+
+// candleRow is a single candle as it is stored in a parquet file
+type candleRow struct {
+	Timestamp   int64   `parquet:"timestamp,timestamp(microsecond),delta"`
+	Open        float64 `parquet:"open"`
+	High        float64 `parquet:"high"`
+	Low         float64 `parquet:"low"`
+	Close       float64 `parquet:"close"`
+	Volume      float64 `parquet:"volume"`
+	QuoteVolume float64 `parquet:"quote_volume"`
+}
+
+// tradeRow is a single trade as it is stored in a parquet file
+type tradeRow struct {
+	Timestamp int64   `parquet:"timestamp,timestamp(microsecond),delta"`
+	TID       string  `parquet:"tid"`
+	Side      string  `parquet:"side,dict"`
+	Price     float64 `parquet:"price"`
+	Amount    float64 `parquet:"amount"`
+}
+
+// fundingRateRow is a single funding rate as it is stored in a parquet file
+type fundingRateRow struct {
+	Timestamp int64   `parquet:"timestamp,timestamp(microsecond),delta"`
+	Rate      float64 `parquet:"rate"`
+	Payment   float64 `parquet:"payment"`
+}
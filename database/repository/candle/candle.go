@@ -25,7 +25,7 @@ import (
 )
 
 // Series returns candle data
-func Series(exchangeName, base, quote string, interval int64, asset string, start, end time.Time) (out Item, err error) {
+func (sqlStore) Series(exchangeName, base, quote string, interval int64, asset string, start, end time.Time) (out Item, err error) {
 	if exchangeName == "" || base == "" || quote == "" || asset == "" || interval <= 0 {
 		return out, errInvalidInput
 	}
@@ -100,7 +100,7 @@ func Series(exchangeName, base, quote string, interval int64, asset string, star
 }
 
 // DeleteCandles will delete all existing matching candles
-func DeleteCandles(in *Item) (int64, error) {
+func (sqlStore) DeleteCandles(in *Item) (int64, error) {
 	if database.DB.SQL == nil {
 		return 0, database.ErrDatabaseSupportDisabled
 	}
@@ -180,7 +180,7 @@ func deletePostgres(ctx context.Context, queries []qm.QueryMod) (int64, error) {
 }
 
 // Insert series of candles
-func Insert(in *Item) (uint64, error) {
+func (sqlStore) Insert(in *Item) (uint64, error) {
 	if database.DB.SQL == nil {
 		return 0, database.ErrDatabaseSupportDisabled
 	}
@@ -0,0 +1,44 @@
+package candle
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	storeMtx sync.RWMutex
+	store    Store = sqlStore{}
+)
+
+// SetStore sets the backend used for all candle data, a nil store reverts to
+// the default database store
+func SetStore(s Store) {
+	if s == nil {
+		s = sqlStore{}
+	}
+	storeMtx.Lock()
+	store = s
+	storeMtx.Unlock()
+}
+
+// GetStore returns the backend used for all candle data
+func GetStore() Store {
+	storeMtx.RLock()
+	defer storeMtx.RUnlock()
+	return store
+}
+
+// Series returns candle data
+func Series(exchangeName, base, quote string, interval int64, asset string, start, end time.Time) (Item, error) {
+	return GetStore().Series(exchangeName, base, quote, interval, asset, start, end)
+}
+
+// Insert series of candles
+func Insert(in *Item) (uint64, error) {
+	return GetStore().Insert(in)
+}
+
+// DeleteCandles will delete all existing matching candles
+func DeleteCandles(in *Item) (int64, error) {
+	return GetStore().DeleteCandles(in)
+}
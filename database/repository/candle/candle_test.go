@@ -243,3 +243,30 @@ func genOHCLVData() (out Item, err error) {
 
 	return out, nil
 }
+
+type fakeStore struct {
+	sqlStore
+	inserted int
+}
+
+func (f *fakeStore) Insert(in *Item) (uint64, error) {
+	f.inserted += len(in.Candles)
+	return uint64(len(in.Candles)), nil
+}
+
+func TestSetStore(t *testing.T) {
+	t.Cleanup(func() { SetStore(nil) })
+	assert.IsType(t, sqlStore{}, GetStore(), "GetStore should default to the database store")
+
+	f := &fakeStore{}
+	SetStore(f)
+	assert.Same(t, f, GetStore(), "GetStore should return the set store")
+
+	n, err := Insert(&Item{Candles: make([]Candle, 3)})
+	require.NoError(t, err, "Insert must not error")
+	assert.Equal(t, uint64(3), n, "Insert should return the store's inserted count")
+	assert.Equal(t, 3, f.inserted, "Insert should be routed to the set store")
+
+	SetStore(nil)
+	assert.IsType(t, sqlStore{}, GetStore(), "SetStore should revert to the database store on nil")
+}
@@ -35,3 +35,16 @@ type Candle struct {
 	ValidationJobID  string
 	ValidationIssues string
 }
+
+// Store defines a backend that candle data can be saved to and loaded from.
+// The default store uses the sqlboiler managed database, alternative stores
+// such as a time-series database can be set via SetStore
+type Store interface {
+	Series(exchangeName, base, quote string, interval int64, asset string, start, end time.Time) (Item, error)
+	Insert(in *Item) (uint64, error)
+	DeleteCandles(in *Item) (int64, error)
+}
+
+// sqlStore is the default Store which uses the connected postgres or sqlite3
+// database
+type sqlStore struct{}
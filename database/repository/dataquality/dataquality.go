@@ -0,0 +1,313 @@
+package dataquality
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Insert stores the report and its issues, setting the report ID and created
+// time when unset
+func Insert(ctx context.Context, report *Report) error {
+	if err := checkParams(report); err != nil {
+		return err
+	}
+	exchangeUUID, err := exchange.UUIDByName(report.Exchange)
+	if err != nil {
+		return err
+	}
+	if report.ID == "" {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		report.ID = id.String()
+	}
+	if report.CreatedAt.IsZero() {
+		report.CreatedAt = time.Now()
+	}
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("data quality insert begin transaction: %w", err)
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		err = insertSQLite(ctx, tx, exchangeUUID.String(), report)
+	} else {
+		err = insertPostgres(ctx, tx, exchangeUUID.String(), report)
+	}
+	if err != nil {
+		if errRB := tx.Rollback(); errRB != nil {
+			log.Errorf(log.DatabaseMgr, "data quality insert rollback: %v", errRB)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("data quality insert commit: %w", err)
+	}
+	return nil
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, exchangeID string, report *Report) error {
+	r := &modelSQLite.DataQualityReport{
+		ID:             report.ID,
+		ExchangeNameID: exchangeID,
+		Base:           strings.ToUpper(report.Base),
+		Quote:          strings.ToUpper(report.Quote),
+		Asset:          strings.ToLower(report.Asset),
+		DataType:       report.DataType,
+		Interval:       report.Interval,
+		StartTime:      report.StartDate.UTC().Format(time.RFC3339),
+		EndTime:        report.EndDate.UTC().Format(time.RFC3339),
+		Records:        report.Records,
+		CreatedAt:      report.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if err := r.Insert(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("data quality report insert: %w", err)
+	}
+	for i := range report.Issues {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		issue := &modelSQLite.DataQualityIssue{
+			ID:                 id.String(),
+			ReportID:           report.ID,
+			IssueType:          report.Issues[i].Type,
+			StartTime:          report.Issues[i].StartDate.UTC().Format(time.RFC3339),
+			EndTime:            report.Issues[i].EndDate.UTC().Format(time.RFC3339),
+			Value:              report.Issues[i].Value,
+			ComparisonExchange: null.String{String: report.Issues[i].ComparisonExchange, Valid: report.Issues[i].ComparisonExchange != ""},
+			Description:        report.Issues[i].Description,
+			RepairJobID:        null.String{String: report.Issues[i].RepairJobID, Valid: report.Issues[i].RepairJobID != ""},
+		}
+		if err := issue.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("data quality issue insert: %w", err)
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, exchangeID string, report *Report) error {
+	r := &modelPSQL.DataQualityReport{
+		ID:             report.ID,
+		ExchangeNameID: exchangeID,
+		Base:           strings.ToUpper(report.Base),
+		Quote:          strings.ToUpper(report.Quote),
+		Asset:          strings.ToLower(report.Asset),
+		DataType:       report.DataType,
+		Interval:       report.Interval,
+		StartTime:      report.StartDate.UTC(),
+		EndTime:        report.EndDate.UTC(),
+		Records:        report.Records,
+		CreatedAt:      report.CreatedAt.UTC(),
+	}
+	if err := r.Insert(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("data quality report insert: %w", err)
+	}
+	for i := range report.Issues {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		issue := &modelPSQL.DataQualityIssue{
+			ID:                 id.String(),
+			ReportID:           report.ID,
+			IssueType:          report.Issues[i].Type,
+			StartTime:          report.Issues[i].StartDate.UTC(),
+			EndTime:            report.Issues[i].EndDate.UTC(),
+			Value:              report.Issues[i].Value,
+			ComparisonExchange: null.String{String: report.Issues[i].ComparisonExchange, Valid: report.Issues[i].ComparisonExchange != ""},
+			Description:        report.Issues[i].Description,
+			RepairJobID:        null.String{String: report.Issues[i].RepairJobID, Valid: report.Issues[i].RepairJobID != ""},
+		}
+		if err := issue.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("data quality issue insert: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetByID returns a report and its issues
+func GetByID(ctx context.Context, id string) (*Report, error) {
+	if !database.DB.IsConnected() || database.DB.SQL == nil {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	if id == "" {
+		return nil, errEmptyID
+	}
+	reports, err := getReports(ctx, qm.Where("id = ?", id))
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoReportsFound, id)
+	}
+	return &reports[0], nil
+}
+
+// Series returns the reports and their issues created between start and end
+func Series(ctx context.Context, exchangeName, base, quote, asset string, start, end time.Time) ([]Report, error) {
+	if !database.DB.IsConnected() || database.DB.SQL == nil {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	if exchangeName == "" || base == "" || quote == "" || asset == "" {
+		return nil, errInvalidInput
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	query := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.Where("asset = ?", strings.ToLower(asset)),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		query = append(query, qm.Where("created_at between ? and ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)))
+	} else {
+		query = append(query, qm.Where("created_at between ? and ?", start.UTC(), end.UTC()))
+	}
+	reports, err := getReports(ctx, query...)
+	if err != nil {
+		return nil, err
+	}
+	if len(reports) == 0 {
+		return nil, fmt.Errorf("%w: %s %s %s-%s", ErrNoReportsFound, exchangeName, asset, base, quote)
+	}
+	return reports, nil
+}
+
+// getReports returns the reports matching query with their exchange names and
+// issues loaded
+func getReports(ctx context.Context, query ...qm.QueryMod) ([]Report, error) {
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		return getReportsSQLite(ctx, query)
+	}
+	return getReportsPostgres(ctx, query)
+}
+
+func getReportsSQLite(ctx context.Context, query []qm.QueryMod) ([]Report, error) {
+	query = append(query,
+		qm.Load(modelSQLite.DataQualityReportRels.ExchangeName),
+		qm.Load(modelSQLite.DataQualityReportRels.ReportDataQualityIssues, qm.OrderBy("start_time, issue_type")),
+		qm.OrderBy("created_at"))
+	results, err := modelSQLite.DataQualityReports(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, fmt.Errorf("data quality reports: %w", err)
+	}
+	reports := make([]Report, len(results))
+	for i := range results {
+		r := Report{
+			ID:       results[i].ID,
+			Base:     results[i].Base,
+			Quote:    results[i].Quote,
+			Asset:    results[i].Asset,
+			DataType: results[i].DataType,
+			Interval: results[i].Interval,
+			Records:  results[i].Records,
+		}
+		if results[i].R != nil && results[i].R.ExchangeName != nil {
+			r.Exchange = results[i].R.ExchangeName.Name
+		}
+		if r.StartDate, err = time.Parse(time.RFC3339, results[i].StartTime); err != nil {
+			return nil, fmt.Errorf("data quality reports: %w", err)
+		}
+		if r.EndDate, err = time.Parse(time.RFC3339, results[i].EndTime); err != nil {
+			return nil, fmt.Errorf("data quality reports: %w", err)
+		}
+		if r.CreatedAt, err = time.Parse(time.RFC3339, results[i].CreatedAt); err != nil {
+			return nil, fmt.Errorf("data quality reports: %w", err)
+		}
+		if results[i].R != nil {
+			issues := results[i].R.ReportDataQualityIssues
+			for j := range issues {
+				issue := Issue{
+					Type:               issues[j].IssueType,
+					Value:              issues[j].Value,
+					ComparisonExchange: issues[j].ComparisonExchange.String,
+					Description:        issues[j].Description,
+					RepairJobID:        issues[j].RepairJobID.String,
+				}
+				if issue.StartDate, err = time.Parse(time.RFC3339, issues[j].StartTime); err != nil {
+					return nil, fmt.Errorf("data quality issues: %w", err)
+				}
+				if issue.EndDate, err = time.Parse(time.RFC3339, issues[j].EndTime); err != nil {
+					return nil, fmt.Errorf("data quality issues: %w", err)
+				}
+				r.Issues = append(r.Issues, issue)
+			}
+		}
+		reports[i] = r
+	}
+	return reports, nil
+}
+
+func getReportsPostgres(ctx context.Context, query []qm.QueryMod) ([]Report, error) {
+	query = append(query,
+		qm.Load(modelPSQL.DataQualityReportRels.ExchangeName),
+		qm.Load(modelPSQL.DataQualityReportRels.ReportDataQualityIssues, qm.OrderBy("start_time, issue_type")),
+		qm.OrderBy("created_at"))
+	results, err := modelPSQL.DataQualityReports(query...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, fmt.Errorf("data quality reports: %w", err)
+	}
+	reports := make([]Report, len(results))
+	for i := range results {
+		r := Report{
+			ID:        results[i].ID,
+			Base:      results[i].Base,
+			Quote:     results[i].Quote,
+			Asset:     results[i].Asset,
+			DataType:  results[i].DataType,
+			Interval:  results[i].Interval,
+			StartDate: results[i].StartTime.UTC(),
+			EndDate:   results[i].EndTime.UTC(),
+			Records:   results[i].Records,
+			CreatedAt: results[i].CreatedAt.UTC(),
+		}
+		if results[i].R != nil {
+			if results[i].R.ExchangeName != nil {
+				r.Exchange = results[i].R.ExchangeName.Name
+			}
+			issues := results[i].R.ReportDataQualityIssues
+			for j := range issues {
+				r.Issues = append(r.Issues, Issue{
+					Type:               issues[j].IssueType,
+					StartDate:          issues[j].StartTime.UTC(),
+					EndDate:            issues[j].EndTime.UTC(),
+					Value:              issues[j].Value,
+					ComparisonExchange: issues[j].ComparisonExchange.String,
+					Description:        issues[j].Description,
+					RepairJobID:        issues[j].RepairJobID.String,
+				})
+			}
+		}
+		reports[i] = r
+	}
+	return reports, nil
+}
+
+func checkParams(report *Report) error {
+	if !database.DB.IsConnected() || database.DB.SQL == nil {
+		return database.ErrDatabaseNotConnected
+	}
+	if report == nil || report.Exchange == "" || report.Base == "" || report.Quote == "" || report.Asset == "" || report.DataType == "" {
+		return errInvalidInput
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package dataquality
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestReports(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+		{
+			"Postgres",
+			testhelpers.PostgresTestDatabase,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+			defer func() {
+				assert.NoError(t, testhelpers.CloseDatabase(dbConn), "CloseDatabase should not error")
+			}()
+			exchange.ResetExchangeCache()
+			require.NoError(t, exchange.InsertMany([]exchange.Details{{Name: "one"}}), "InsertMany must not error")
+
+			ctx := t.Context()
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			err = Insert(ctx, &Report{Exchange: "one", Base: "btc", Quote: "usdt", Asset: "spot"})
+			assert.ErrorIs(t, err, errInvalidInput)
+
+			report := &Report{
+				Exchange:  "one",
+				Base:      "btc",
+				Quote:     "usdt",
+				Asset:     "spot",
+				DataType:  "candles",
+				Interval:  int64(time.Hour),
+				StartDate: start,
+				EndDate:   start.Add(time.Hour * 24),
+				Records:   22,
+				CreatedAt: start.Add(time.Hour * 25),
+				Issues: []Issue{
+					{Type: "gap", StartDate: start.Add(time.Hour), EndDate: start.Add(time.Hour * 3), Value: 2, Description: "2 missing candles"},
+					{Type: "deviation", StartDate: start.Add(time.Hour * 5), EndDate: start.Add(time.Hour * 6), Value: 3.5, ComparisonExchange: "two", Description: "close deviates 3.5%"},
+				},
+			}
+			require.NoError(t, Insert(ctx, report), "Insert must not error")
+			assert.NotEmpty(t, report.ID, "Insert should set the report ID")
+
+			got, err := GetByID(ctx, report.ID)
+			require.NoError(t, err, "GetByID must not error")
+			assert.Equal(t, "one", got.Exchange, "GetByID should return the exchange name")
+			assert.Equal(t, "BTC", got.Base)
+			assert.Equal(t, int64(22), got.Records)
+			assert.Equal(t, report.CreatedAt, got.CreatedAt)
+			require.Len(t, got.Issues, 2, "GetByID must return all issues")
+			assert.Equal(t, report.Issues[0], got.Issues[0], "GetByID should return the stored issue")
+			assert.Equal(t, "two", got.Issues[1].ComparisonExchange)
+
+			reports, err := Series(ctx, "one", "BTC", "USDT", "spot", start, start.Add(time.Hour*48))
+			require.NoError(t, err, "Series must not error")
+			require.Len(t, reports, 1, "Series must return the stored report")
+			assert.Len(t, reports[0].Issues, 2, "Series should return report issues")
+
+			_, err = Series(ctx, "one", "BTC", "USDT", "spot", start, start.Add(time.Hour))
+			assert.ErrorIs(t, err, ErrNoReportsFound)
+			_, err = Series(ctx, "one", "", "USDT", "spot", start, start.Add(time.Hour))
+			assert.ErrorIs(t, err, errInvalidInput)
+			_, err = GetByID(ctx, "")
+			assert.ErrorIs(t, err, errEmptyID)
+		})
+	}
+}
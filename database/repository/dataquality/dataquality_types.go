@@ -0,0 +1,43 @@
+package dataquality
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNoReportsFound is returned when no data quality reports are stored for
+	// the requested range
+	ErrNoReportsFound = errors.New("no data quality reports found")
+
+	errInvalidInput = errors.New("exchange, base, quote, asset & data type cannot be empty")
+	errEmptyID      = errors.New("report id cannot be empty")
+)
+
+// Report is a persisted data quality scan of saved market data
+type Report struct {
+	ID        string
+	Exchange  string
+	Base      string
+	Quote     string
+	Asset     string
+	DataType  string
+	Interval  int64
+	StartDate time.Time
+	EndDate   time.Time
+	// Records is the number of candles or trades which were scanned
+	Records   int64
+	CreatedAt time.Time
+	Issues    []Issue
+}
+
+// Issue is a single data quality problem found within a report's range
+type Issue struct {
+	Type               string
+	StartDate          time.Time
+	EndDate            time.Time
+	Value              float64
+	ComparisonExchange string
+	Description        string
+	RepairJobID        string
+}
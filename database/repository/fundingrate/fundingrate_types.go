@@ -0,0 +1,32 @@
+package fundingrate
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNoFundingRatesFound is returned when no funding rates are stored for
+	// the requested range
+	ErrNoFundingRatesFound = errors.New("no funding rates found")
+
+	errInvalidInput   = errors.New("exchange, base, quote & asset cannot be empty")
+	errNoFundingRates = errors.New("no funding rates provided")
+)
+
+// Item holds the funding rates of an exchange's contract
+type Item struct {
+	Exchange    string
+	Base        string
+	Quote       string
+	Asset       string
+	SourceJobID string
+	Rates       []Rate
+}
+
+// Rate is a single funding rate and the payment made for it
+type Rate struct {
+	Timestamp time.Time
+	Rate      float64
+	Payment   float64
+}
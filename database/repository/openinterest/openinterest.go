@@ -0,0 +1,192 @@
+package openinterest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Insert stores the open interest snapshots, returning the number of rows
+// inserted. Existing snapshots are only replaced when overwrite is set
+func Insert(ctx context.Context, item *Item, overwrite bool) (uint64, error) {
+	if err := checkParams(item); err != nil {
+		return 0, err
+	}
+	if len(item.Snapshots) == 0 {
+		return 0, errNoOpenInterest
+	}
+	exchangeUUID, err := exchange.UUIDByName(item.Exchange)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("open interest insert begin transaction: %w", err)
+	}
+	var inserted uint64
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		inserted, err = insertSQLite(ctx, tx, exchangeUUID.String(), item, overwrite)
+	} else {
+		inserted, err = insertPostgres(ctx, tx, exchangeUUID.String(), item, overwrite)
+	}
+	if err != nil {
+		if errRB := tx.Rollback(); errRB != nil {
+			log.Errorf(log.DatabaseMgr, "open interest insert rollback: %v", errRB)
+		}
+		return 0, fmt.Errorf("open interest insert: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("open interest insert commit: %w", err)
+	}
+	return inserted, nil
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, exchangeID string, item *Item, overwrite bool) (uint64, error) {
+	var inserted uint64
+	for i := range item.Snapshots {
+		ts := item.Snapshots[i].Timestamp.UTC().Format(time.RFC3339)
+		r, err := modelSQLite.OpenInterests(append(itemQuery(exchangeID, item), qm.Where("timestamp = ?", ts))...).One(ctx, tx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			id, err := uuid.NewV4()
+			if err != nil {
+				return 0, err
+			}
+			r = &modelSQLite.OpenInterest{
+				ID:             id.String(),
+				ExchangeNameID: exchangeID,
+				Base:           strings.ToUpper(item.Base),
+				Quote:          strings.ToUpper(item.Quote),
+				Asset:          strings.ToLower(item.Asset),
+				Timestamp:      ts,
+				OpenInterest:   item.Snapshots[i].OpenInterest,
+				SourceJobID:    null.String{String: item.SourceJobID, Valid: item.SourceJobID != ""},
+			}
+			if err := r.Insert(ctx, tx, boil.Infer()); err != nil {
+				return 0, err
+			}
+		case err != nil:
+			return 0, err
+		case !overwrite:
+			continue
+		default:
+			r.OpenInterest = item.Snapshots[i].OpenInterest
+			r.SourceJobID = null.String{String: item.SourceJobID, Valid: item.SourceJobID != ""}
+			if _, err := r.Update(ctx, tx, boil.Infer()); err != nil {
+				return 0, err
+			}
+		}
+		inserted++
+	}
+	return inserted, nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, exchangeID string, item *Item, overwrite bool) (uint64, error) {
+	var inserted uint64
+	for i := range item.Snapshots {
+		ts := item.Snapshots[i].Timestamp.UTC()
+		r, err := modelPSQL.OpenInterests(append(itemQuery(exchangeID, item), qm.Where("timestamp = ?", ts))...).One(ctx, tx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			r = &modelPSQL.OpenInterest{
+				ExchangeNameID: exchangeID,
+				Base:           strings.ToUpper(item.Base),
+				Quote:          strings.ToUpper(item.Quote),
+				Asset:          strings.ToLower(item.Asset),
+				Timestamp:      ts,
+				OpenInterest:   item.Snapshots[i].OpenInterest,
+				SourceJobID:    null.String{String: item.SourceJobID, Valid: item.SourceJobID != ""},
+			}
+			if err := r.Insert(ctx, tx, boil.Infer()); err != nil {
+				return 0, err
+			}
+		case err != nil:
+			return 0, err
+		case !overwrite:
+			continue
+		default:
+			r.OpenInterest = item.Snapshots[i].OpenInterest
+			r.SourceJobID = null.String{String: item.SourceJobID, Valid: item.SourceJobID != ""}
+			if _, err := r.Update(ctx, tx, boil.Infer()); err != nil {
+				return 0, err
+			}
+		}
+		inserted++
+	}
+	return inserted, nil
+}
+
+// Series returns the open interest stored between start and end
+func Series(ctx context.Context, exchangeName, base, quote, asset string, start, end time.Time) (*Item, error) {
+	item := &Item{Exchange: exchangeName, Base: base, Quote: quote, Asset: asset}
+	if err := checkParams(item); err != nil {
+		return nil, err
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	query := append(itemQuery(exchangeUUID.String(), item), qm.OrderBy("timestamp"))
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		query = append(query, qm.Where("timestamp between ? and ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)))
+		snapshots, err := modelSQLite.OpenInterests(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, fmt.Errorf("open interest series: %w", err)
+		}
+		for i := range snapshots {
+			ts, err := time.Parse(time.RFC3339, snapshots[i].Timestamp)
+			if err != nil {
+				return nil, fmt.Errorf("open interest series: %w", err)
+			}
+			item.Snapshots = append(item.Snapshots, Snapshot{Timestamp: ts, OpenInterest: snapshots[i].OpenInterest})
+		}
+	} else {
+		query = append(query, qm.Where("timestamp between ? and ?", start.UTC(), end.UTC()))
+		snapshots, err := modelPSQL.OpenInterests(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, fmt.Errorf("open interest series: %w", err)
+		}
+		for i := range snapshots {
+			item.Snapshots = append(item.Snapshots, Snapshot{Timestamp: snapshots[i].Timestamp.UTC(), OpenInterest: snapshots[i].OpenInterest})
+		}
+	}
+	if len(item.Snapshots) == 0 {
+		return nil, fmt.Errorf("%w: %s %s %s-%s", ErrNoOpenInterestFound, exchangeName, asset, base, quote)
+	}
+	return item, nil
+}
+
+// itemQuery returns the query mods matching the contract of item
+func itemQuery(exchangeID string, item *Item) []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeID),
+		qm.Where("base = ?", strings.ToUpper(item.Base)),
+		qm.Where("quote = ?", strings.ToUpper(item.Quote)),
+		qm.Where("asset = ?", strings.ToLower(item.Asset)),
+	}
+}
+
+func checkParams(item *Item) error {
+	if !database.DB.IsConnected() || database.DB.SQL == nil {
+		return database.ErrDatabaseNotConnected
+	}
+	if item == nil || item.Exchange == "" || item.Base == "" || item.Quote == "" || item.Asset == "" {
+		return errInvalidInput
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package openinterest
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestOpenInterest(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+		{
+			"Postgres",
+			testhelpers.PostgresTestDatabase,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+			defer func() {
+				assert.NoError(t, testhelpers.CloseDatabase(dbConn), "CloseDatabase should not error")
+			}()
+			exchange.ResetExchangeCache()
+			require.NoError(t, exchange.InsertMany([]exchange.Details{{Name: "one"}}), "InsertMany must not error")
+
+			ctx := t.Context()
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			item := &Item{Exchange: "one", Base: "btc", Quote: "usdt", Asset: "usdtmarginedfutures"}
+			_, err = Insert(ctx, item, false)
+			assert.ErrorIs(t, err, errNoOpenInterest)
+			for i := range 3 {
+				item.Snapshots = append(item.Snapshots, Snapshot{Timestamp: start.Add(time.Duration(i) * time.Hour * 8), OpenInterest: 1337})
+			}
+			inserted, err := Insert(ctx, item, false)
+			require.NoError(t, err, "Insert must not error")
+			assert.Equal(t, uint64(3), inserted, "Insert should insert every snapshot")
+
+			item.Snapshots[0].OpenInterest = 1338
+			inserted, err = Insert(ctx, item, false)
+			require.NoError(t, err, "Insert must not error")
+			assert.Zero(t, inserted, "Insert should not replace existing snapshots")
+			inserted, err = Insert(ctx, item, true)
+			require.NoError(t, err, "Insert must not error")
+			assert.Equal(t, uint64(3), inserted, "Insert should replace existing snapshots when overwriting")
+
+			resp, err := Series(ctx, "one", "BTC", "USDT", "usdtmarginedfutures", start, start.Add(time.Hour*8))
+			require.NoError(t, err, "Series must not error")
+			require.Len(t, resp.Snapshots, 2, "Series must only return snapshots in range")
+			assert.Equal(t, start, resp.Snapshots[0].Timestamp)
+			assert.Equal(t, 1338.0, resp.Snapshots[0].OpenInterest, "Series should return the replaced snapshot")
+
+			_, err = Series(ctx, "one", "BTC", "USD", "usdtmarginedfutures", start, start.Add(time.Hour))
+			assert.ErrorIs(t, err, ErrNoOpenInterestFound)
+			_, err = Series(ctx, "one", "", "USD", "usdtmarginedfutures", start, start.Add(time.Hour))
+			assert.ErrorIs(t, err, errInvalidInput)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package openinterest
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNoOpenInterestFound is returned when no open interest is stored for
+	// the requested range
+	ErrNoOpenInterestFound = errors.New("no open interest found")
+
+	errInvalidInput   = errors.New("exchange, base, quote & asset cannot be empty")
+	errNoOpenInterest = errors.New("no open interest provided")
+)
+
+// Item holds open interest snapshots of an exchange's contract
+type Item struct {
+	Exchange    string
+	Base        string
+	Quote       string
+	Asset       string
+	SourceJobID string
+	Snapshots   []Snapshot
+}
+
+// Snapshot is the open interest of a contract at a point in time
+type Snapshot struct {
+	Timestamp    time.Time
+	OpenInterest float64
+}
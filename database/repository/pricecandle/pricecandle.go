@@ -0,0 +1,211 @@
+package pricecandle
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Insert stores the price candles, returning the number of rows inserted.
+// Existing candles are only replaced when overwrite is set
+func Insert(ctx context.Context, item *Item, overwrite bool) (uint64, error) {
+	if err := checkParams(item); err != nil {
+		return 0, err
+	}
+	if len(item.Candles) == 0 {
+		return 0, errNoPriceCandles
+	}
+	exchangeUUID, err := exchange.UUIDByName(item.Exchange)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("price candle insert begin transaction: %w", err)
+	}
+	var inserted uint64
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		inserted, err = insertSQLite(ctx, tx, exchangeUUID.String(), item, overwrite)
+	} else {
+		inserted, err = insertPostgres(ctx, tx, exchangeUUID.String(), item, overwrite)
+	}
+	if err != nil {
+		if errRB := tx.Rollback(); errRB != nil {
+			log.Errorf(log.DatabaseMgr, "price candle insert rollback: %v", errRB)
+		}
+		return 0, fmt.Errorf("price candle insert: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("price candle insert commit: %w", err)
+	}
+	return inserted, nil
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, exchangeID string, item *Item, overwrite bool) (uint64, error) {
+	var inserted uint64
+	for i := range item.Candles {
+		ts := item.Candles[i].Timestamp.UTC().Format(time.RFC3339)
+		r, err := modelSQLite.PriceCandles(append(itemQuery(exchangeID, item), qm.Where("timestamp = ?", ts))...).One(ctx, tx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			id, err := uuid.NewV4()
+			if err != nil {
+				return 0, err
+			}
+			r = &modelSQLite.PriceCandle{
+				ID:             id.String(),
+				ExchangeNameID: exchangeID,
+				Base:           strings.ToUpper(item.Base),
+				Quote:          strings.ToUpper(item.Quote),
+				Asset:          strings.ToLower(item.Asset),
+				PriceType:      strings.ToLower(item.PriceType),
+				Interval:       item.Interval,
+				Timestamp:      ts,
+				Open:           item.Candles[i].Open,
+				High:           item.Candles[i].High,
+				Low:            item.Candles[i].Low,
+				Close:          item.Candles[i].Close,
+				SourceJobID:    null.String{String: item.SourceJobID, Valid: item.SourceJobID != ""},
+			}
+			if err := r.Insert(ctx, tx, boil.Infer()); err != nil {
+				return 0, err
+			}
+		case err != nil:
+			return 0, err
+		case !overwrite:
+			continue
+		default:
+			r.Open = item.Candles[i].Open
+			r.High = item.Candles[i].High
+			r.Low = item.Candles[i].Low
+			r.Close = item.Candles[i].Close
+			r.SourceJobID = null.String{String: item.SourceJobID, Valid: item.SourceJobID != ""}
+			if _, err := r.Update(ctx, tx, boil.Infer()); err != nil {
+				return 0, err
+			}
+		}
+		inserted++
+	}
+	return inserted, nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, exchangeID string, item *Item, overwrite bool) (uint64, error) {
+	var inserted uint64
+	for i := range item.Candles {
+		ts := item.Candles[i].Timestamp.UTC()
+		r, err := modelPSQL.PriceCandles(append(itemQuery(exchangeID, item), qm.Where("timestamp = ?", ts))...).One(ctx, tx)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			r = &modelPSQL.PriceCandle{
+				ExchangeNameID: exchangeID,
+				Base:           strings.ToUpper(item.Base),
+				Quote:          strings.ToUpper(item.Quote),
+				Asset:          strings.ToLower(item.Asset),
+				PriceType:      strings.ToLower(item.PriceType),
+				Interval:       item.Interval,
+				Timestamp:      ts,
+				Open:           item.Candles[i].Open,
+				High:           item.Candles[i].High,
+				Low:            item.Candles[i].Low,
+				Close:          item.Candles[i].Close,
+				SourceJobID:    null.String{String: item.SourceJobID, Valid: item.SourceJobID != ""},
+			}
+			if err := r.Insert(ctx, tx, boil.Infer()); err != nil {
+				return 0, err
+			}
+		case err != nil:
+			return 0, err
+		case !overwrite:
+			continue
+		default:
+			r.Open = item.Candles[i].Open
+			r.High = item.Candles[i].High
+			r.Low = item.Candles[i].Low
+			r.Close = item.Candles[i].Close
+			r.SourceJobID = null.String{String: item.SourceJobID, Valid: item.SourceJobID != ""}
+			if _, err := r.Update(ctx, tx, boil.Infer()); err != nil {
+				return 0, err
+			}
+		}
+		inserted++
+	}
+	return inserted, nil
+}
+
+// Series returns the price candles stored between start and end
+func Series(ctx context.Context, exchangeName, base, quote, asset, priceType string, interval int64, start, end time.Time) (*Item, error) {
+	item := &Item{Exchange: exchangeName, Base: base, Quote: quote, Asset: asset, PriceType: priceType, Interval: interval}
+	if err := checkParams(item); err != nil {
+		return nil, err
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	query := append(itemQuery(exchangeUUID.String(), item), qm.OrderBy("timestamp"))
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		query = append(query, qm.Where("timestamp between ? and ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)))
+		candles, err := modelSQLite.PriceCandles(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, fmt.Errorf("price candle series: %w", err)
+		}
+		for i := range candles {
+			ts, err := time.Parse(time.RFC3339, candles[i].Timestamp)
+			if err != nil {
+				return nil, fmt.Errorf("price candle series: %w", err)
+			}
+			item.Candles = append(item.Candles, Candle{Timestamp: ts, Open: candles[i].Open, High: candles[i].High, Low: candles[i].Low, Close: candles[i].Close})
+		}
+	} else {
+		query = append(query, qm.Where("timestamp between ? and ?", start.UTC(), end.UTC()))
+		candles, err := modelPSQL.PriceCandles(query...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, fmt.Errorf("price candle series: %w", err)
+		}
+		for i := range candles {
+			item.Candles = append(item.Candles, Candle{Timestamp: candles[i].Timestamp.UTC(), Open: candles[i].Open, High: candles[i].High, Low: candles[i].Low, Close: candles[i].Close})
+		}
+	}
+	if len(item.Candles) == 0 {
+		return nil, fmt.Errorf("%w: %s %s %s %s-%s", ErrNoPriceCandlesFound, exchangeName, priceType, asset, base, quote)
+	}
+	return item, nil
+}
+
+// itemQuery returns the query mods matching the contract, price type and
+// interval of item
+func itemQuery(exchangeID string, item *Item) []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeID),
+		qm.Where("base = ?", strings.ToUpper(item.Base)),
+		qm.Where("quote = ?", strings.ToUpper(item.Quote)),
+		qm.Where("asset = ?", strings.ToLower(item.Asset)),
+		qm.Where("price_type = ?", strings.ToLower(item.PriceType)),
+		qm.Where("interval = ?", item.Interval),
+	}
+}
+
+func checkParams(item *Item) error {
+	if !database.DB.IsConnected() || database.DB.SQL == nil {
+		return database.ErrDatabaseNotConnected
+	}
+	if item == nil || item.Exchange == "" || item.Base == "" || item.Quote == "" || item.Asset == "" || item.PriceType == "" || item.Interval <= 0 {
+		return errInvalidInput
+	}
+	return nil
+}
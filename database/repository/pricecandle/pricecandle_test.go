@@ -0,0 +1,104 @@
+package pricecandle
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestPriceCandles(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+		{
+			"Postgres",
+			testhelpers.PostgresTestDatabase,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+			defer func() {
+				assert.NoError(t, testhelpers.CloseDatabase(dbConn), "CloseDatabase should not error")
+			}()
+			exchange.ResetExchangeCache()
+			require.NoError(t, exchange.InsertMany([]exchange.Details{{Name: "one"}}), "InsertMany must not error")
+
+			ctx := t.Context()
+			start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			item := &Item{Exchange: "one", Base: "btc", Quote: "usdt", Asset: "usdtmarginedfutures", PriceType: "mark", Interval: 3600}
+			_, err = Insert(ctx, item, false)
+			assert.ErrorIs(t, err, errNoPriceCandles)
+			for i := range 3 {
+				item.Candles = append(item.Candles, Candle{Timestamp: start.Add(time.Duration(i) * time.Hour), Open: 1, High: 2, Low: 0.5, Close: 1.5})
+			}
+			inserted, err := Insert(ctx, item, false)
+			require.NoError(t, err, "Insert must not error")
+			assert.Equal(t, uint64(3), inserted, "Insert should insert every candle")
+
+			item.PriceType = "index"
+			inserted, err = Insert(ctx, item, false)
+			require.NoError(t, err, "Insert must not error")
+			assert.Equal(t, uint64(3), inserted, "Insert should store index candles separately to mark candles")
+
+			item.PriceType = "mark"
+			item.Candles[0].Close = 3
+			inserted, err = Insert(ctx, item, false)
+			require.NoError(t, err, "Insert must not error")
+			assert.Zero(t, inserted, "Insert should not replace existing candles")
+			inserted, err = Insert(ctx, item, true)
+			require.NoError(t, err, "Insert must not error")
+			assert.Equal(t, uint64(3), inserted, "Insert should replace existing candles when overwriting")
+
+			resp, err := Series(ctx, "one", "BTC", "USDT", "usdtmarginedfutures", "mark", 3600, start, start.Add(time.Hour))
+			require.NoError(t, err, "Series must not error")
+			require.Len(t, resp.Candles, 2, "Series must only return candles in range")
+			assert.Equal(t, start, resp.Candles[0].Timestamp)
+			assert.Equal(t, 3.0, resp.Candles[0].Close, "Series should return the replaced candle")
+
+			_, err = Series(ctx, "one", "BTC", "USDT", "usdtmarginedfutures", "mark", 60, start, start.Add(time.Hour))
+			assert.ErrorIs(t, err, ErrNoPriceCandlesFound)
+			_, err = Series(ctx, "one", "BTC", "USDT", "usdtmarginedfutures", "", 3600, start, start.Add(time.Hour))
+			assert.ErrorIs(t, err, errInvalidInput)
+		})
+	}
+}
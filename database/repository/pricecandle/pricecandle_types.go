@@ -0,0 +1,36 @@
+package pricecandle
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrNoPriceCandlesFound is returned when no price candles are stored for
+	// the requested range
+	ErrNoPriceCandlesFound = errors.New("no price candles found")
+
+	errInvalidInput   = errors.New("exchange, base, quote, asset, price type & interval cannot be empty")
+	errNoPriceCandles = errors.New("no price candles provided")
+)
+
+// Item holds mark or index price candles of an exchange's contract
+type Item struct {
+	Exchange    string
+	Base        string
+	Quote       string
+	Asset       string
+	PriceType   string
+	Interval    int64
+	SourceJobID string
+	Candles     []Candle
+}
+
+// Candle is a single price candle. Mark and index prices carry no volume
+type Candle struct {
+	Timestamp time.Time
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+}
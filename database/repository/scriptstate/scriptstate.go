@@ -0,0 +1,147 @@
+package scriptstate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// ErrKeyNotFound is returned when a script has no value stored for a key
+var ErrKeyNotFound = errors.New("script state key not found")
+
+var errEmptyKey = errors.New("script name and key must be set")
+
+// Get returns the value stored for a script's key
+func Get(ctx context.Context, scriptName, key string) (string, error) {
+	if err := checkParams(scriptName, key); err != nil {
+		return "", err
+	}
+	var value string
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		var s *modelSQLite.ScriptState
+		s, err = modelSQLite.ScriptStates(keyQuery(scriptName, key)...).One(ctx, database.DB.SQL)
+		if s != nil {
+			value = s.Value
+		}
+	} else {
+		var s *modelPSQL.ScriptState
+		s, err = modelPSQL.ScriptStates(keyQuery(scriptName, key)...).One(ctx, database.DB.SQL)
+		if s != nil {
+			value = s.Value
+		}
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", fmt.Errorf("%w: %s %s", ErrKeyNotFound, scriptName, key)
+	}
+	if err != nil {
+		return "", fmt.Errorf("script state get %s %s: %w", scriptName, key, err)
+	}
+	return value, nil
+}
+
+// Set stores the value for a script's key, replacing any existing value
+func Set(ctx context.Context, scriptName, key, value string) error {
+	if err := checkParams(scriptName, key); err != nil {
+		return err
+	}
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("script state set %s %s: %w", scriptName, key, err)
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		err = setSQLite(ctx, tx, scriptName, key, value)
+	} else {
+		err = setPostgres(ctx, tx, scriptName, key, value)
+	}
+	if err != nil {
+		if errRB := tx.Rollback(); errRB != nil {
+			log.Errorln(log.DatabaseMgr, errRB)
+		}
+		return fmt.Errorf("script state set %s %s: %w", scriptName, key, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("script state set %s %s: %w", scriptName, key, err)
+	}
+	return nil
+}
+
+// setSQLite updates the existing value for a script's key or inserts a new one
+func setSQLite(ctx context.Context, tx *sql.Tx, scriptName, key, value string) error {
+	updated := time.Now().UTC().Format(time.DateTime)
+	s, err := modelSQLite.ScriptStates(keyQuery(scriptName, key)...).One(ctx, tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		s = &modelSQLite.ScriptState{
+			ScriptName: scriptName,
+			Key:        key,
+			Value:      value,
+			UpdatedAt:  updated,
+		}
+		return s.Insert(ctx, tx, boil.Infer())
+	}
+	if err != nil {
+		return err
+	}
+	s.Value = value
+	s.UpdatedAt = updated
+	_, err = s.Update(ctx, tx, boil.Whitelist(modelSQLite.ScriptStateColumns.Value, modelSQLite.ScriptStateColumns.UpdatedAt))
+	return err
+}
+
+func setPostgres(ctx context.Context, tx *sql.Tx, scriptName, key, value string) error {
+	s := &modelPSQL.ScriptState{
+		ScriptName: scriptName,
+		Key:        key,
+		Value:      value,
+		UpdatedAt:  time.Now().UTC(),
+	}
+	return s.Upsert(ctx, tx, true,
+		[]string{modelPSQL.ScriptStateColumns.ScriptName, modelPSQL.ScriptStateColumns.Key},
+		boil.Whitelist(modelPSQL.ScriptStateColumns.Value, modelPSQL.ScriptStateColumns.UpdatedAt),
+		boil.Infer())
+}
+
+// Delete removes a script's key. Deleting a key which does not exist is not an
+// error
+func Delete(ctx context.Context, scriptName, key string) error {
+	if err := checkParams(scriptName, key); err != nil {
+		return err
+	}
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		_, err = modelSQLite.ScriptStates(keyQuery(scriptName, key)...).DeleteAll(ctx, database.DB.SQL)
+	} else {
+		_, err = modelPSQL.ScriptStates(keyQuery(scriptName, key)...).DeleteAll(ctx, database.DB.SQL)
+	}
+	if err != nil {
+		return fmt.Errorf("script state delete %s %s: %w", scriptName, key, err)
+	}
+	return nil
+}
+
+func keyQuery(scriptName, key string) []qm.QueryMod {
+	return []qm.QueryMod{
+		qm.Where("script_name = ?", scriptName),
+		qm.Where("key = ?", key),
+	}
+}
+
+func checkParams(scriptName, key string) error {
+	if !database.DB.IsConnected() || database.DB.SQL == nil {
+		return database.ErrDatabaseNotConnected
+	}
+	if scriptName == "" || key == "" {
+		return errEmptyKey
+	}
+	return nil
+}
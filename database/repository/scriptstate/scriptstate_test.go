@@ -0,0 +1,86 @@
+package scriptstate
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestScriptState(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+		{
+			"Postgres",
+			testhelpers.PostgresTestDatabase,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+			defer func() {
+				assert.NoError(t, testhelpers.CloseDatabase(dbConn), "CloseDatabase should not error")
+			}()
+
+			ctx := t.Context()
+			_, err = Get(ctx, "test.gct", "counter")
+			assert.ErrorIs(t, err, ErrKeyNotFound, "Get should error on a missing key")
+
+			require.NoError(t, Set(ctx, "test.gct", "counter", "1"), "Set must not error")
+			require.NoError(t, Set(ctx, "test.gct", "counter", "2"), "Set must not error when replacing a value")
+			require.NoError(t, Set(ctx, "other.gct", "counter", "3"), "Set must not error")
+
+			v, err := Get(ctx, "test.gct", "counter")
+			require.NoError(t, err, "Get must not error")
+			assert.Equal(t, "2", v, "Get should return the latest value")
+
+			v, err = Get(ctx, "other.gct", "counter")
+			require.NoError(t, err, "Get must not error")
+			assert.Equal(t, "3", v, "Get should scope values per script")
+
+			require.NoError(t, Delete(ctx, "test.gct", "counter"), "Delete must not error")
+			require.NoError(t, Delete(ctx, "test.gct", "counter"), "Delete must not error on a missing key")
+			_, err = Get(ctx, "test.gct", "counter")
+			assert.ErrorIs(t, err, ErrKeyNotFound, "Get should error on a deleted key")
+
+			assert.ErrorIs(t, Set(ctx, "", "counter", "1"), errEmptyKey, "Set should error without a script name")
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package timescale
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+)
+
+const (
+	selectCandles = `SELECT timestamp, open, high, low, close, volume,
+	COALESCE(source_job_id::text, ''), COALESCE(validation_job_id::text, ''), COALESCE(validation_issues, '')
+	FROM market_candle
+	WHERE exchange_name_id = $1 AND base = $2 AND quote = $3 AND interval = $4 AND asset = $5 AND timestamp BETWEEN $6 AND $7
+	ORDER BY timestamp`
+
+	selectAggregatedCandles = `SELECT time_bucket(make_interval(secs => $4), bucket) AS ts,
+	first(open, bucket), max(high), min(low), last(close, bucket), sum(volume)
+	FROM market_trade_candle_1m
+	WHERE exchange_name_id = $1 AND base = $2 AND quote = $3 AND asset = $5 AND bucket BETWEEN $6 AND $7
+	GROUP BY ts
+	ORDER BY ts`
+
+	insertCandlesPrefix = `INSERT INTO market_candle (exchange_name_id, base, quote, interval, asset, timestamp, open, high, low, close, volume, source_job_id, validation_job_id, validation_issues) VALUES `
+	insertCandlesSuffix = ` ON CONFLICT (exchange_name_id, base, quote, interval, asset, timestamp) DO UPDATE SET
+	open = EXCLUDED.open, high = EXCLUDED.high, low = EXCLUDED.low, close = EXCLUDED.close, volume = EXCLUDED.volume,
+	source_job_id = EXCLUDED.source_job_id, validation_job_id = EXCLUDED.validation_job_id, validation_issues = EXCLUDED.validation_issues`
+	insertCandlesColumns = 14
+
+	deleteCandles = `DELETE FROM market_candle
+	WHERE exchange_name_id = $1 AND base = $2 AND quote = $3 AND interval = $4 AND asset = $5 AND timestamp BETWEEN $6 AND $7`
+)
+
+// NewCandleStore returns a candle store using the supplied TimescaleDB
+// connection
+func NewCandleStore(db *sql.DB) (*CandleStore, error) {
+	if db == nil {
+		return nil, errNilDB
+	}
+	return &CandleStore{db: db}, nil
+}
+
+// Series returns saved candles, when none are saved and the interval is a
+// multiple of one minute, candles are built from the trade continuous aggregate
+func (s *CandleStore) Series(exchangeName, base, quote string, interval int64, asset string, start, end time.Time) (candle.Item, error) {
+	var out candle.Item
+	if exchangeName == "" || base == "" || quote == "" || asset == "" || interval <= 0 {
+		return out, errInvalidInput
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return out, err
+	}
+
+	args := []any{exchangeUUID.String(), strings.ToUpper(base), strings.ToUpper(quote), interval, strings.ToLower(asset), start.UTC(), end.UTC()}
+	out.Candles, err = s.query(selectCandles, true, args...)
+	if err != nil {
+		return out, err
+	}
+	if len(out.Candles) == 0 && interval%60 == 0 {
+		out.Candles, err = s.query(selectAggregatedCandles, false, args...)
+		if err != nil {
+			return out, err
+		}
+	}
+	if len(out.Candles) == 0 {
+		return out, fmt.Errorf("%w: %s %s %s %v %s", candle.ErrNoCandleDataFound, exchangeName, base, quote, interval, asset)
+	}
+
+	out.ExchangeID = exchangeName
+	out.Interval = interval
+	out.Base = base
+	out.Quote = quote
+	out.Asset = asset
+	return out, nil
+}
+
+func (s *CandleStore) query(query string, withJobs bool, args ...any) ([]candle.Candle, error) {
+	rows, err := s.db.QueryContext(context.TODO(), query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candles []candle.Candle
+	for rows.Next() {
+		var c candle.Candle
+		dest := []any{&c.Timestamp, &c.Open, &c.High, &c.Low, &c.Close, &c.Volume}
+		if withJobs {
+			dest = append(dest, &c.SourceJobID, &c.ValidationJobID, &c.ValidationIssues)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		c.Timestamp = c.Timestamp.UTC()
+		candles = append(candles, c)
+	}
+	return candles, rows.Err()
+}
+
+// Insert upserts a series of candles
+func (s *CandleStore) Insert(in *candle.Item) (uint64, error) {
+	if in == nil || len(in.Candles) == 0 {
+		return 0, errNoCandleData
+	}
+	if in.ExchangeID == "" {
+		return 0, errNoExchangeID
+	}
+
+	rows := make([][]any, len(in.Candles))
+	for i := range in.Candles {
+		rows[i] = []any{
+			in.ExchangeID,
+			strings.ToUpper(in.Base),
+			strings.ToUpper(in.Quote),
+			in.Interval,
+			strings.ToLower(in.Asset),
+			in.Candles[i].Timestamp.UTC(),
+			in.Candles[i].Open,
+			in.Candles[i].High,
+			in.Candles[i].Low,
+			in.Candles[i].Close,
+			in.Candles[i].Volume,
+			nullString(in.Candles[i].SourceJobID),
+			nullString(in.Candles[i].ValidationJobID),
+			nullString(in.Candles[i].ValidationIssues),
+		}
+	}
+	inserted, err := insertBatches(context.TODO(), s.db, insertCandlesPrefix, insertCandlesSuffix, insertCandlesColumns, rows)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(inserted), nil //nolint:gosec // rows affected cannot be negative
+}
+
+// DeleteCandles deletes all saved candles matching the item within the range
+// of its first and last candle
+func (s *CandleStore) DeleteCandles(in *candle.Item) (int64, error) {
+	if in == nil || len(in.Candles) == 0 {
+		return 0, errNoCandleData
+	}
+	result, err := s.db.ExecContext(context.TODO(), deleteCandles,
+		in.ExchangeID,
+		strings.ToUpper(in.Base),
+		strings.ToUpper(in.Quote),
+		in.Interval,
+		strings.ToLower(in.Asset),
+		in.Candles[0].Timestamp.UTC(),
+		in.Candles[len(in.Candles)-1].Timestamp.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,66 @@
+package timescale
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// insertBatches writes rows in batches within a single transaction, each
+// statement being prefix followed by the row placeholders and suffix
+func insertBatches(ctx context.Context, db *sql.DB, prefix, suffix string, columns int, rows [][]any) (int64, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for start := 0; start < len(rows); start += insertBatchSize {
+		end := min(start+insertBatchSize, len(rows))
+		var sb strings.Builder
+		sb.WriteString(prefix)
+		args := make([]any, 0, (end-start)*columns)
+		for i := start; i < end; i++ {
+			if i > start {
+				sb.WriteByte(',')
+			}
+			sb.WriteByte('(')
+			for j := range columns {
+				if j > 0 {
+					sb.WriteByte(',')
+				}
+				sb.WriteByte('$')
+				sb.WriteString(strconv.Itoa(len(args) + j + 1))
+			}
+			sb.WriteByte(')')
+			args = append(args, rows[i]...)
+		}
+		sb.WriteString(suffix)
+
+		var result sql.Result
+		result, err = tx.ExecContext(ctx, sb.String(), args...)
+		if err != nil {
+			break
+		}
+		var affected int64
+		affected, err = result.RowsAffected()
+		if err != nil {
+			break
+		}
+		total += affected
+	}
+	if err != nil {
+		if errRB := tx.Rollback(); errRB != nil {
+			log.Errorln(log.DatabaseMgr, errRB)
+		}
+		return 0, err
+	}
+	return total, tx.Commit()
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
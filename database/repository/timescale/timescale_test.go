@@ -0,0 +1,62 @@
+package timescale
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+)
+
+// testDB returns a connection which is never dialled, as sql.Open is lazy
+func testDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("postgres", "postgres://localhost/test?sslmode=disable")
+	require.NoError(t, err, "sql.Open must not error")
+	t.Cleanup(func() { assert.NoError(t, db.Close(), "Close should not error") })
+	return db
+}
+
+func TestNewCandleStore(t *testing.T) {
+	t.Parallel()
+	_, err := NewCandleStore(nil)
+	assert.ErrorIs(t, err, errNilDB)
+
+	s, err := NewCandleStore(testDB(t))
+	require.NoError(t, err, "NewCandleStore must not error")
+	assert.Implements(t, (*candle.Store)(nil), s, "CandleStore should implement candle.Store")
+}
+
+func TestCandleStoreValidation(t *testing.T) {
+	t.Parallel()
+	s, err := NewCandleStore(testDB(t))
+	require.NoError(t, err, "NewCandleStore must not error")
+
+	_, err = s.Series("", "BTC", "USDT", 60, "spot", time.Now(), time.Now())
+	assert.ErrorIs(t, err, errInvalidInput)
+
+	_, err = s.Insert(nil)
+	assert.ErrorIs(t, err, errNoCandleData)
+
+	_, err = s.Insert(&candle.Item{Candles: make([]candle.Candle, 1)})
+	assert.ErrorIs(t, err, errNoExchangeID)
+
+	_, err = s.DeleteCandles(&candle.Item{})
+	assert.ErrorIs(t, err, errNoCandleData)
+}
+
+func TestNewTradeStore(t *testing.T) {
+	t.Parallel()
+	_, err := NewTradeStore(nil)
+	assert.ErrorIs(t, err, errNilDB)
+
+	s, err := NewTradeStore(testDB(t))
+	require.NoError(t, err, "NewTradeStore must not error")
+	assert.Implements(t, (*trade.Store)(nil), s, "TradeStore should implement trade.Store")
+
+	err = s.Insert(trade.Data{TID: "1337"})
+	assert.ErrorIs(t, err, errNoExchangeID)
+}
@@ -0,0 +1,28 @@
+package timescale
+
+import (
+	"database/sql"
+	"errors"
+)
+
+// insertBatchSize is the number of rows written per insert statement, keeping
+// each statement well below the postgres bind parameter limit
+const insertBatchSize = 1000
+
+var (
+	errNilDB        = errors.New("market data database connection is nil")
+	errInvalidInput = errors.New("exchange, base, quote, asset, interval, start & end cannot be empty")
+	errNoCandleData = errors.New("no candle data provided")
+	errNoExchangeID = errors.New("exchange name/uuid not set, cannot insert")
+)
+
+// CandleStore stores candles in a TimescaleDB hypertable and falls back to
+// candles aggregated from stored trades when no candles have been saved
+type CandleStore struct {
+	db *sql.DB
+}
+
+// TradeStore stores trades in a TimescaleDB hypertable
+type TradeStore struct {
+	db *sql.DB
+}
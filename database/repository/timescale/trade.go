@@ -0,0 +1,172 @@
+package timescale
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/lib/pq"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+const (
+	insertTradesPrefix  = `INSERT INTO market_trade (id, tid, exchange_name_id, base, quote, asset, price, amount, side, timestamp) VALUES `
+	insertTradesSuffix  = ` ON CONFLICT DO NOTHING`
+	insertTradesColumns = 10
+
+	selectTradeColumns = `SELECT id, COALESCE(tid, ''), exchange_name_id, base, quote, asset, price, amount, COALESCE(side, ''), timestamp FROM market_trade`
+
+	selectTradeByUUID = selectTradeColumns + ` WHERE id = $1`
+
+	selectTradesInRange = selectTradeColumns + `
+	WHERE exchange_name_id = $1 AND asset = $2 AND base = $3 AND quote = $4 AND timestamp BETWEEN $5 AND $6
+	ORDER BY timestamp`
+
+	selectTradeExists = `SELECT EXISTS (SELECT 1 FROM market_trade
+	WHERE exchange_name_id = $1 AND asset = $2 AND base = $3 AND quote = $4 AND timestamp BETWEEN $5 AND $6)`
+
+	deleteTrades = `DELETE FROM market_trade WHERE id = ANY($1)`
+)
+
+// NewTradeStore returns a trade store using the supplied TimescaleDB
+// connection
+func NewTradeStore(db *sql.DB) (*TradeStore, error) {
+	if db == nil {
+		return nil, errNilDB
+	}
+	return &TradeStore{db: db}, nil
+}
+
+// Insert saves trades, ignoring any which have already been saved
+func (s *TradeStore) Insert(trades ...trade.Data) error {
+	rows := make([][]any, len(trades))
+	for i := range trades {
+		if trades[i].ExchangeNameID == "" {
+			if trades[i].Exchange == "" {
+				return errNoExchangeID
+			}
+			exchangeUUID, err := exchange.UUIDByName(trades[i].Exchange)
+			if err != nil {
+				return err
+			}
+			trades[i].ExchangeNameID = exchangeUUID.String()
+		}
+		if trades[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			trades[i].ID = freshUUID.String()
+		}
+		rows[i] = []any{
+			trades[i].ID,
+			nullString(trades[i].TID),
+			trades[i].ExchangeNameID,
+			strings.ToUpper(trades[i].Base),
+			strings.ToUpper(trades[i].Quote),
+			strings.ToLower(trades[i].AssetType),
+			trades[i].Price,
+			trades[i].Amount,
+			nullString(strings.ToUpper(trades[i].Side)),
+			trades[i].Timestamp.UTC(),
+		}
+	}
+	_, err := insertBatches(context.TODO(), s.db, insertTradesPrefix, insertTradesSuffix, insertTradesColumns, rows)
+	return err
+}
+
+// VerifyTradeInIntervals will query for ONE trade within each kline interval and verify if data exists
+// if it does, it will set the range holder property "HasData" to true
+func (s *TradeStore) VerifyTradeInIntervals(exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+	for i := range irh.Ranges {
+		for j := range irh.Ranges[i].Intervals {
+			err = s.db.QueryRowContext(ctx, selectTradeExists,
+				exchangeUUID.String(),
+				strings.ToLower(assetType),
+				strings.ToUpper(base),
+				strings.ToUpper(quote),
+				irh.Ranges[i].Intervals[j].Start.Time.UTC(),
+				irh.Ranges[i].Intervals[j].End.Time.UTC()).Scan(&irh.Ranges[i].Intervals[j].HasData)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetByUUID returns a trade by its unique ID
+func (s *TradeStore) GetByUUID(u string) (trade.Data, error) {
+	rows, err := s.db.QueryContext(context.TODO(), selectTradeByUUID, u)
+	if err != nil {
+		return trade.Data{}, err
+	}
+	td, err := scanTrades(rows)
+	if err != nil {
+		return trade.Data{}, err
+	}
+	if len(td) == 0 {
+		return trade.Data{}, sql.ErrNoRows
+	}
+	td[0].Exchange = td[0].ExchangeNameID
+	return td[0], nil
+}
+
+// GetInRange returns all trades by an exchange in a date range
+func (s *TradeStore) GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]trade.Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(context.TODO(), selectTradesInRange,
+		exchangeUUID.String(),
+		strings.ToLower(assetType),
+		strings.ToUpper(base),
+		strings.ToUpper(quote),
+		startDate.UTC(),
+		endDate.UTC())
+	if err != nil {
+		return nil, err
+	}
+	td, err := scanTrades(rows)
+	if err != nil {
+		return nil, err
+	}
+	for i := range td {
+		td[i].Exchange = strings.ToLower(exchangeName)
+	}
+	return td, nil
+}
+
+// DeleteTrades removes trades by their unique ID
+func (s *TradeStore) DeleteTrades(trades ...trade.Data) error {
+	tradeIDs := make([]string, len(trades))
+	for i := range trades {
+		tradeIDs[i] = trades[i].ID
+	}
+	_, err := s.db.ExecContext(context.TODO(), deleteTrades, pq.Array(tradeIDs))
+	return err
+}
+
+func scanTrades(rows *sql.Rows) ([]trade.Data, error) {
+	defer rows.Close()
+	var td []trade.Data
+	for rows.Next() {
+		var t trade.Data
+		if err := rows.Scan(&t.ID, &t.TID, &t.ExchangeNameID, &t.Base, &t.Quote, &t.AssetType, &t.Price, &t.Amount, &t.Side, &t.Timestamp); err != nil {
+			return nil, err
+		}
+		t.Timestamp = t.Timestamp.UTC()
+		td = append(td, t)
+	}
+	return td, rows.Err()
+}
@@ -21,7 +21,7 @@ import (
 )
 
 // Insert saves trade data to the database
-func Insert(trades ...Data) error {
+func (sqlStore) Insert(trades ...Data) error {
 	for i := range trades {
 		if trades[i].ExchangeNameID == "" && trades[i].Exchange != "" {
 			exchangeUUID, err := exchange.UUIDByName(trades[i].Exchange)
@@ -64,7 +64,7 @@ func Insert(trades ...Data) error {
 
 // VerifyTradeInIntervals will query for ONE trade within each kline interval and verify if data exists
 // if it does, it will set the range holder property "HasData" to true
-func VerifyTradeInIntervals(exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error {
+func (sqlStore) VerifyTradeInIntervals(exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error {
 	ctx := context.TODO()
 	ctx = boil.SkipTimestamps(ctx)
 
@@ -217,7 +217,7 @@ func insertPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
 }
 
 // GetByUUID returns a trade by its unique ID
-func GetByUUID(u string) (td Data, err error) {
+func (sqlStore) GetByUUID(u string) (td Data, err error) {
 	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
 		td, err = getByUUIDSQLite(u)
 		if err != nil {
@@ -287,7 +287,7 @@ func getByUUIDPostgres(u string) (td Data, err error) {
 }
 
 // GetInRange returns all trades by an exchange in a date range
-func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+func (sqlStore) GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
 	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
 		td, err = getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
 		if err != nil {
@@ -385,7 +385,7 @@ func getInRangePostgres(exchangeName, assetType, base, quote string, startDate,
 }
 
 // DeleteTrades will remove trades from the database using trade.Data
-func DeleteTrades(trades ...Data) error {
+func (sqlStore) DeleteTrades(trades ...Data) error {
 	ctx := context.TODO()
 	ctx = boil.SkipTimestamps(ctx)
 
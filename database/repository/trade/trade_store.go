@@ -0,0 +1,57 @@
+package trade
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var (
+	storeMtx sync.RWMutex
+	store    Store = sqlStore{}
+)
+
+// SetStore sets the backend used for all trade data, a nil store reverts to
+// the default database store
+func SetStore(s Store) {
+	if s == nil {
+		s = sqlStore{}
+	}
+	storeMtx.Lock()
+	store = s
+	storeMtx.Unlock()
+}
+
+// GetStore returns the backend used for all trade data
+func GetStore() Store {
+	storeMtx.RLock()
+	defer storeMtx.RUnlock()
+	return store
+}
+
+// Insert saves trade data to the database
+func Insert(trades ...Data) error {
+	return GetStore().Insert(trades...)
+}
+
+// VerifyTradeInIntervals will query for ONE trade within each kline interval and verify if data exists
+// if it does, it will set the range holder property "HasData" to true
+func VerifyTradeInIntervals(exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error {
+	return GetStore().VerifyTradeInIntervals(exchangeName, assetType, base, quote, irh)
+}
+
+// GetByUUID returns a trade by its unique ID
+func GetByUUID(u string) (Data, error) {
+	return GetStore().GetByUUID(u)
+}
+
+// GetInRange returns all trades by an exchange in a date range
+func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	return GetStore().GetInRange(exchangeName, assetType, base, quote, startDate, endDate)
+}
+
+// DeleteTrades will remove trades from the database using trade.Data
+func DeleteTrades(trades ...Data) error {
+	return GetStore().DeleteTrades(trades...)
+}
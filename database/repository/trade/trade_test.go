@@ -216,3 +216,29 @@ func seedDB() error {
 
 	return nil
 }
+
+type fakeStore struct {
+	sqlStore
+	inserted []Data
+}
+
+func (f *fakeStore) Insert(trades ...Data) error {
+	f.inserted = append(f.inserted, trades...)
+	return nil
+}
+
+func TestSetStore(t *testing.T) {
+	t.Cleanup(func() { SetStore(nil) })
+	assert.IsType(t, sqlStore{}, GetStore(), "GetStore should default to the database store")
+
+	f := &fakeStore{}
+	SetStore(f)
+	assert.Same(t, f, GetStore(), "GetStore should return the set store")
+
+	require.NoError(t, Insert(Data{TID: "1337"}), "Insert must not error")
+	require.Len(t, f.inserted, 1, "Insert must be routed to the set store")
+	assert.Equal(t, "1337", f.inserted[0].TID, "Insert should pass trades to the set store")
+
+	SetStore(nil)
+	assert.IsType(t, sqlStore{}, GetStore(), "SetStore should revert to the database store on nil")
+}
@@ -1,6 +1,10 @@
 package trade
 
-import "time"
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
 
 // Data defines trade data in its simplest
 // db friendly form
@@ -17,3 +21,18 @@ type Data struct {
 	Side           string
 	Timestamp      time.Time
 }
+
+// Store defines a backend that trade data can be saved to and loaded from.
+// The default store uses the sqlboiler managed database, alternative stores
+// such as a time-series database can be set via SetStore
+type Store interface {
+	Insert(trades ...Data) error
+	VerifyTradeInIntervals(exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error
+	GetByUUID(u string) (Data, error)
+	GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error)
+	DeleteTrades(trades ...Data) error
+}
+
+// sqlStore is the default Store which uses the connected postgres or sqlite3
+// database
+type sqlStore struct{}
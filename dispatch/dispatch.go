@@ -76,6 +76,25 @@ func IsRunning() bool {
 	return dispatcher.isRunning()
 }
 
+// QueueDepth returns the number of jobs waiting to be relayed and the job
+// queue capacity of the dispatch service
+func QueueDepth() (queued, capacity int) {
+	return dispatcher.queueDepth()
+}
+
+// queueDepth returns the number of queued jobs and the job queue capacity
+func (d *Dispatcher) queueDepth() (queued, capacity int) {
+	if d == nil {
+		return 0, 0
+	}
+	d.m.RLock()
+	defer d.m.RUnlock()
+	if !d.running {
+		return 0, 0
+	}
+	return len(d.jobs), cap(d.jobs)
+}
+
 // start sets defaults and config and spawns workers.
 // Does not provide locking protection.
 func (d *Dispatcher) start(workers, channelCapacity int) error {
@@ -191,6 +191,23 @@ func TestPublish(t *testing.T) {
 	assert.ErrorIs(t, err, errDispatcherJobsAtLimit, "publish should eventually error at limit")
 }
 
+func TestQueueDepth(t *testing.T) {
+	t.Parallel()
+	queued, capacity := (*Dispatcher)(nil).queueDepth()
+	assert.Zero(t, queued+capacity, "queueDepth should return zero for a nil dispatcher")
+
+	d := NewDispatcher()
+	d.jobs = make(chan job, 5)
+	queued, capacity = d.queueDepth()
+	assert.Zero(t, queued+capacity, "queueDepth should return zero when not running")
+
+	d.running = true
+	d.jobs <- job{ID: nonEmptyUUID}
+	queued, capacity = d.queueDepth()
+	assert.Equal(t, 1, queued, "queueDepth should return the queued jobs")
+	assert.Equal(t, 5, capacity, "queueDepth should return the jobs limit")
+}
+
 func TestPublishReceive(t *testing.T) {
 	t.Parallel()
 	d := NewDispatcher()
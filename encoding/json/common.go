@@ -15,4 +15,7 @@ type (
 	UnmarshalTypeError = json.UnmarshalTypeError
 	// A SyntaxError describes improper JSON
 	SyntaxError = json.SyntaxError
+	// A Number represents a JSON number literal.
+	// It is produced when decoding with UseNumber set
+	Number = json.Number
 )
@@ -0,0 +1,385 @@
+// Package jsonpatch applies and generates RFC 6902 JSON patches
+package jsonpatch
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Decode parses a JSON patch document
+func Decode(data []byte) (Patch, error) {
+	var p Patch
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidPatchFormat, err)
+	}
+	if len(p) == 0 {
+		return nil, errEmptyPatch
+	}
+	return p, nil
+}
+
+// Apply applies the patch operations in order to the JSON document and returns
+// the patched document. The document is left unchanged if any operation fails
+func (p Patch) Apply(doc []byte) ([]byte, error) {
+	node, err := decodeValue(doc)
+	if err != nil {
+		return nil, err
+	}
+	for i := range p {
+		if node, err = p[i].apply(node); err != nil {
+			return nil, fmt.Errorf("%w %d %s %q: %w", errOperationFailed, i, p[i].Op, p[i].Path, err)
+		}
+	}
+	return json.Marshal(node)
+}
+
+// Diff returns the patch which transforms the from document into the to
+// document. Objects and arrays of the same length are compared element by
+// element, other values which differ are replaced as a whole
+func Diff(from, to []byte) (Patch, error) {
+	a, err := decodeValue(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := decodeValue(to)
+	if err != nil {
+		return nil, err
+	}
+	return diff("", a, b, nil)
+}
+
+func (o *Operation) apply(doc any) (any, error) {
+	tokens, err := parsePointer(o.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch o.Op {
+	case OpAdd:
+		value, err := o.value()
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, tokens, value)
+	case OpRemove:
+		if len(tokens) == 0 {
+			return nil, errRemoveRoot
+		}
+		return remove(doc, tokens)
+	case OpReplace:
+		value, err := o.value()
+		if err != nil {
+			return nil, err
+		}
+		return replace(doc, tokens, value)
+	case OpMove, OpCopy:
+		from, err := parsePointer(o.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if o.Op == OpCopy {
+			return add(doc, tokens, clone(value))
+		}
+		if o.From == o.Path {
+			return doc, nil
+		}
+		if strings.HasPrefix(o.Path, o.From+"/") {
+			return nil, errMoveIntoChild
+		}
+		if doc, err = remove(doc, from); err != nil {
+			return nil, err
+		}
+		return add(doc, tokens, value)
+	case OpTest:
+		value, err := o.value()
+		if err != nil {
+			return nil, err
+		}
+		current, err := get(doc, tokens)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(current, value) {
+			return nil, errTestFailed
+		}
+		return doc, nil
+	default:
+		return nil, fmt.Errorf("%w %q", errUnsupportedOp, o.Op)
+	}
+}
+
+func (o *Operation) value() (any, error) {
+	if o.Value == nil {
+		return nil, errMissingValue
+	}
+	return decodeValue(o.Value)
+}
+
+// decodeValue decodes JSON keeping numbers as json.Number so that large
+// integers survive a round trip
+func decodeValue(data []byte) (any, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// parsePointer splits an RFC 6901 JSON pointer into its unescaped tokens
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("%w %q: must be empty or start with /", errInvalidPointer, pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(tokens[i], "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// escapeToken escapes an object member name for use in a JSON pointer
+func escapeToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// arrayIndex parses an array index token which must be no greater than maxIndex
+func arrayIndex(token string, maxIndex int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("%w %q", errInvalidArrayIndex, token)
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i > maxIndex {
+		return 0, fmt.Errorf("%w %q", errInvalidArrayIndex, token)
+	}
+	return i, nil
+}
+
+func get(node any, tokens []string) (any, error) {
+	for _, token := range tokens {
+		switch n := node.(type) {
+		case map[string]any:
+			v, ok := n[token]
+			if !ok {
+				return nil, fmt.Errorf("%w: %q", errPathNotFound, token)
+			}
+			node = v
+		case []any:
+			i, err := arrayIndex(token, len(n)-1)
+			if err != nil {
+				return nil, err
+			}
+			node = n[i]
+		default:
+			return nil, fmt.Errorf("%w: %q", errInvalidTarget, token)
+		}
+	}
+	return node, nil
+}
+
+// update walks to the parent of the last token and calls fn with it, storing
+// the returned container in place of the parent
+func update(node any, tokens []string, fn func(container any, token string) (any, error)) (any, error) {
+	if len(tokens) == 1 {
+		return fn(node, tokens[0])
+	}
+	child, err := get(node, tokens[:1])
+	if err != nil {
+		return nil, err
+	}
+	if child, err = update(child, tokens[1:], fn); err != nil {
+		return nil, err
+	}
+	switch n := node.(type) {
+	case map[string]any:
+		n[tokens[0]] = child
+	case []any:
+		i, _ := arrayIndex(tokens[0], len(n)-1) // validated by get
+		n[i] = child
+	}
+	return node, nil
+}
+
+func add(doc any, tokens []string, value any) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	return update(doc, tokens, func(container any, token string) (any, error) {
+		switch n := container.(type) {
+		case map[string]any:
+			n[token] = value
+			return n, nil
+		case []any:
+			if token == "-" {
+				return append(n, value), nil
+			}
+			i, err := arrayIndex(token, len(n))
+			if err != nil {
+				return nil, err
+			}
+			return slices.Insert(n, i, value), nil
+		default:
+			return nil, fmt.Errorf("%w: %q", errInvalidTarget, token)
+		}
+	})
+}
+
+func remove(doc any, tokens []string) (any, error) {
+	return update(doc, tokens, func(container any, token string) (any, error) {
+		switch n := container.(type) {
+		case map[string]any:
+			if _, ok := n[token]; !ok {
+				return nil, fmt.Errorf("%w: %q", errPathNotFound, token)
+			}
+			delete(n, token)
+			return n, nil
+		case []any:
+			i, err := arrayIndex(token, len(n)-1)
+			if err != nil {
+				return nil, err
+			}
+			return slices.Delete(n, i, i+1), nil
+		default:
+			return nil, fmt.Errorf("%w: %q", errInvalidTarget, token)
+		}
+	})
+}
+
+func replace(doc any, tokens []string, value any) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	return update(doc, tokens, func(container any, token string) (any, error) {
+		switch n := container.(type) {
+		case map[string]any:
+			if _, ok := n[token]; !ok {
+				return nil, fmt.Errorf("%w: %q", errPathNotFound, token)
+			}
+			n[token] = value
+			return n, nil
+		case []any:
+			i, err := arrayIndex(token, len(n)-1)
+			if err != nil {
+				return nil, err
+			}
+			n[i] = value
+			return n, nil
+		default:
+			return nil, fmt.Errorf("%w: %q", errInvalidTarget, token)
+		}
+	})
+}
+
+func clone(v any) any {
+	switch n := v.(type) {
+	case map[string]any:
+		c := make(map[string]any, len(n))
+		for k, v := range n {
+			c[k] = clone(v)
+		}
+		return c
+	case []any:
+		c := make([]any, len(n))
+		for i := range n {
+			c[i] = clone(n[i])
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+// equal compares decoded JSON values, numbers are compared by value
+func equal(a, b any) bool {
+	switch x := a.(type) {
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, v := range x {
+			w, ok := y[k]
+			if !ok || !equal(v, w) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		y, ok := b.([]any)
+		return ok && slices.EqualFunc(x, y, equal)
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if x == y {
+			return true
+		}
+		xf, xErr := x.Float64()
+		yf, yErr := y.Float64()
+		return xErr == nil && yErr == nil && xf == yf
+	default:
+		return a == b
+	}
+}
+
+func diff(path string, a, b any, p Patch) (Patch, error) {
+	var err error
+	if as, ok := a.([]any); ok {
+		if bs, ok := b.([]any); ok && len(as) == len(bs) {
+			for i := range as {
+				if p, err = diff(path+"/"+strconv.Itoa(i), as[i], bs[i], p); err != nil {
+					return nil, err
+				}
+			}
+			return p, nil
+		}
+	}
+	am, aok := a.(map[string]any)
+	bm, bok := b.(map[string]any)
+	if !aok || !bok {
+		if equal(a, b) {
+			return p, nil
+		}
+		value, err := json.Marshal(b)
+		if err != nil {
+			return nil, err
+		}
+		return append(p, Operation{Op: OpReplace, Path: path, Value: value}), nil
+	}
+	for _, k := range slices.Sorted(maps.Keys(am)) {
+		childPath := path + "/" + escapeToken(k)
+		bv, ok := bm[k]
+		if !ok {
+			p = append(p, Operation{Op: OpRemove, Path: childPath})
+			continue
+		}
+		if p, err = diff(childPath, am[k], bv, p); err != nil {
+			return nil, err
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(bm)) {
+		if _, ok := am[k]; ok {
+			continue
+		}
+		value, err := json.Marshal(bm[k])
+		if err != nil {
+			return nil, err
+		}
+		p = append(p, Operation{Op: OpAdd, Path: path + "/" + escapeToken(k), Value: value})
+	}
+	return p, nil
+}
@@ -0,0 +1,95 @@
+package jsonpatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+	_, err := Decode([]byte(`{"op":"add"}`))
+	assert.ErrorIs(t, err, errInvalidPatchFormat)
+	_, err = Decode([]byte(`[]`))
+	assert.ErrorIs(t, err, errEmptyPatch)
+	p, err := Decode([]byte(`[{"op":"add","path":"/a","value":1}]`))
+	require.NoError(t, err, "Decode must not error")
+	assert.Equal(t, Patch{{Op: OpAdd, Path: "/a", Value: []byte("1")}}, p)
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+	doc := `{"a":{"b":[1,2,3],"c":"x"},"d~/e":true,"big":1234567890123456789}`
+	for _, tc := range []struct {
+		name  string
+		patch string
+		want  string
+		err   error
+	}{
+		{name: "add member", patch: `[{"op":"add","path":"/a/f","value":{"g":null}}]`, want: `{"a":{"b":[1,2,3],"c":"x","f":{"g":null}},"big":1234567890123456789,"d~/e":true}`},
+		{name: "add array element", patch: `[{"op":"add","path":"/a/b/1","value":9},{"op":"add","path":"/a/b/-","value":10}]`, want: `{"a":{"b":[1,9,2,3,10],"c":"x"},"big":1234567890123456789,"d~/e":true}`},
+		{name: "add root", patch: `[{"op":"add","path":"","value":[]}]`, want: `[]`},
+		{name: "remove escaped", patch: `[{"op":"remove","path":"/d~0~1e"}]`, want: `{"a":{"b":[1,2,3],"c":"x"},"big":1234567890123456789}`},
+		{name: "remove array element", patch: `[{"op":"remove","path":"/a/b/0"}]`, want: `{"a":{"b":[2,3],"c":"x"},"big":1234567890123456789,"d~/e":true}`},
+		{name: "replace", patch: `[{"op":"replace","path":"/a/c","value":"y"}]`, want: `{"a":{"b":[1,2,3],"c":"y"},"big":1234567890123456789,"d~/e":true}`},
+		{name: "move", patch: `[{"op":"move","from":"/a/c","path":"/c"}]`, want: `{"a":{"b":[1,2,3]},"big":1234567890123456789,"c":"x","d~/e":true}`},
+		{name: "copy", patch: `[{"op":"copy","from":"/a/b","path":"/b"},{"op":"add","path":"/b/-","value":4}]`, want: `{"a":{"b":[1,2,3],"c":"x"},"b":[1,2,3,4],"big":1234567890123456789,"d~/e":true}`},
+		{name: "test", patch: `[{"op":"test","path":"/a/b","value":[1,2.0,3]}]`, want: `{"a":{"b":[1,2,3],"c":"x"},"big":1234567890123456789,"d~/e":true}`},
+		{name: "test failed", patch: `[{"op":"test","path":"/a/c","value":"y"}]`, err: errTestFailed},
+		{name: "missing value", patch: `[{"op":"replace","path":"/a/c"}]`, err: errMissingValue},
+		{name: "missing path", patch: `[{"op":"replace","path":"/z","value":1}]`, err: errPathNotFound},
+		{name: "missing parent", patch: `[{"op":"add","path":"/z/y","value":1}]`, err: errPathNotFound},
+		{name: "invalid pointer", patch: `[{"op":"add","path":"a","value":1}]`, err: errInvalidPointer},
+		{name: "invalid index", patch: `[{"op":"add","path":"/a/b/01","value":1}]`, err: errInvalidArrayIndex},
+		{name: "index out of range", patch: `[{"op":"add","path":"/a/b/4","value":1}]`, err: errInvalidArrayIndex},
+		{name: "scalar target", patch: `[{"op":"add","path":"/a/c/d","value":1}]`, err: errInvalidTarget},
+		{name: "remove root", patch: `[{"op":"remove","path":""}]`, err: errRemoveRoot},
+		{name: "move into child", patch: `[{"op":"move","from":"/a","path":"/a/z"}]`, err: errMoveIntoChild},
+		{name: "unsupported", patch: `[{"op":"merge","path":"/a"}]`, err: errUnsupportedOp},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := Decode([]byte(tc.patch))
+			require.NoError(t, err, "Decode must not error")
+			got, err := p.Apply([]byte(doc))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.ErrorIs(t, err, errOperationFailed)
+				return
+			}
+			require.NoError(t, err, "Apply must not error")
+			assert.JSONEq(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+	from := []byte(`{"a":{"b":[1,2],"c":"x","d/e":1},"f":true,"n":1.0}`)
+	to := []byte(`{"a":{"b":[1,3],"c":"x","g":null},"h":[1,2],"n":1}`)
+	p, err := Diff(from, to)
+	require.NoError(t, err, "Diff must not error")
+	assert.Equal(t, Patch{
+		{Op: OpReplace, Path: "/a/b/1", Value: []byte(`3`)},
+		{Op: OpRemove, Path: "/a/d~1e"},
+		{Op: OpAdd, Path: "/a/g", Value: []byte(`null`)},
+		{Op: OpRemove, Path: "/f"},
+		{Op: OpAdd, Path: "/h", Value: []byte(`[1,2]`)},
+	}, p)
+
+	got, err := p.Apply(from)
+	require.NoError(t, err, "Apply must not error")
+	assert.JSONEq(t, `{"a":{"b":[1,3],"c":"x","g":null},"h":[1,2],"n":1.0}`, string(got), "applying the diff should produce the to document")
+
+	p, err = Diff([]byte(`[1,2]`), []byte(`[1]`))
+	require.NoError(t, err, "Diff must not error")
+	assert.Equal(t, Patch{{Op: OpReplace, Path: "", Value: []byte(`[1]`)}}, p, "arrays of different lengths should be replaced")
+
+	p, err = Diff(from, from)
+	require.NoError(t, err, "Diff must not error")
+	assert.Empty(t, p, "identical documents should not produce operations")
+
+	_, err = Diff([]byte(`{`), to)
+	assert.Error(t, err, "Diff should error on invalid JSON")
+}
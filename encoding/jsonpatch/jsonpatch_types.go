@@ -0,0 +1,44 @@
+package jsonpatch
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Operation names
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpMove    = "move"
+	OpCopy    = "copy"
+	OpTest    = "test"
+)
+
+var (
+	errEmptyPatch         = errors.New("patch has no operations")
+	errUnsupportedOp      = errors.New("unsupported patch operation")
+	errMissingValue       = errors.New("patch operation is missing a value")
+	errInvalidPointer     = errors.New("invalid JSON pointer")
+	errPathNotFound       = errors.New("path not found")
+	errInvalidArrayIndex  = errors.New("invalid array index")
+	errInvalidTarget      = errors.New("path does not refer to an object or array")
+	errMoveIntoChild      = errors.New("cannot move a value into one of its children")
+	errRemoveRoot         = errors.New("cannot remove the root document")
+	errTestFailed         = errors.New("test operation failed")
+	errOperationFailed    = errors.New("patch operation failed")
+	errInvalidPatchFormat = errors.New("invalid patch format")
+)
+
+// Operation is a single RFC 6902 JSON patch operation. Path and From are
+// RFC 6901 JSON pointers
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// Patch is an ordered list of operations which are applied atomically
+type Patch []Operation
@@ -0,0 +1,229 @@
+// Package jsonschema generates JSON Schemas from Go types and validates JSON
+// documents against them
+package jsonschema
+
+import (
+	"encoding"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeFor[interface{ MarshalJSON() ([]byte, error) }]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	defNameRegexp     = regexp.MustCompile(`[^a-zA-Z0-9_.]+`)
+)
+
+// Reflect returns the schema of the type of v, or of the type it points to.
+// Named struct types are added to the root schema's $defs and referenced,
+// struct fields follow the encoding/json rules and unknown fields are not
+// allowed
+func (r *Reflector) Reflect(v any) (*Schema, error) {
+	if v == nil {
+		return nil, errNilValue
+	}
+	r.defs = make(map[string]*Schema)
+	r.names = make(map[reflect.Type]string)
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	s, err := r.reflectType(t)
+	if err != nil {
+		return nil, err
+	}
+	root := *s
+	root.Schema = Draft
+	if len(r.defs) > 0 {
+		root.Defs = r.defs
+	}
+	return &root, nil
+}
+
+func (r *Reflector) reflectType(t reflect.Type) (*Schema, error) {
+	if s, ok := r.Overrides[t]; ok {
+		c := *s
+		return &c, nil
+	}
+	if t.Kind() == reflect.Pointer {
+		s, err := r.reflectType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return nullable(s), nil
+	}
+	if !r.DefaultEncoded[t] {
+		if t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType) {
+			return &Schema{Description: "custom encoding of " + t.String()}, nil
+		}
+		if t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType) {
+			return &Schema{Type: TypeList{TypeString}}, nil
+		}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: TypeList{TypeBoolean}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: TypeList{TypeInteger}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		minimum := 0.0
+		return &Schema{Type: TypeList{TypeInteger}, Minimum: &minimum}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: TypeList{TypeNumber}}, nil
+	case reflect.String:
+		return &Schema{Type: TypeList{TypeString}}, nil
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: TypeList{TypeString, TypeNull}}, nil
+		}
+		items, err := r.reflectType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: TypeList{TypeArray, TypeNull}, Items: items}, nil
+	case reflect.Array:
+		items, err := r.reflectType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: TypeList{TypeArray}, Items: items}, nil
+	case reflect.Map:
+		values, err := r.reflectType(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: TypeList{TypeObject, TypeNull}, AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return r.reflectStruct(t)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedType, t)
+	}
+}
+
+// reflectStruct returns a reference to the definition of a named struct type,
+// adding it to $defs, or the schema of an anonymous struct type
+func (r *Reflector) reflectStruct(t reflect.Type) (*Schema, error) {
+	if t.Name() == "" {
+		return r.structSchema(t)
+	}
+	if name, ok := r.names[t]; ok {
+		return &Schema{Ref: "#/$defs/" + name}, nil
+	}
+	name := defNameRegexp.ReplaceAllString(path.Base(t.PkgPath())+"."+t.Name(), "_")
+	for i := 2; r.defs[name] != nil; i++ {
+		name = defNameRegexp.ReplaceAllString(path.Base(t.PkgPath())+"."+t.Name(), "_") + strconv.Itoa(i)
+	}
+	r.names[t] = name
+	r.defs[name] = &Schema{} // reserves the name while recursive fields are reflected
+	s, err := r.structSchema(t)
+	if err != nil {
+		return nil, err
+	}
+	r.defs[name] = s
+	return &Schema{Ref: "#/$defs/" + name}, nil
+}
+
+func (r *Reflector) structSchema(t reflect.Type) (*Schema, error) {
+	s := &Schema{
+		Type:                 TypeList{TypeObject},
+		Properties:           make(map[string]*Schema),
+		AdditionalProperties: &Schema{False: true},
+	}
+	if err := r.addFields(s, t, 0, make(map[string]int)); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// addFields adds the encoded fields of t to s. Fields of embedded structs are
+// promoted unless a shallower field has the same name
+func (r *Reflector) addFields(s *Schema, t reflect.Type, depth int, depths map[string]int) error {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if err := r.addFields(s, ft, depth+1, depths); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if d, ok := depths[name]; ok && d <= depth {
+			continue
+		}
+		fs, err := r.reflectType(f.Type)
+		if err != nil {
+			return fmt.Errorf("%s.%s: %w", t, f.Name, err)
+		}
+		if slices.Contains(strings.Split(opts, ","), "string") {
+			switch f.Type.Kind() {
+			case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Float32, reflect.Float64:
+				fs = &Schema{Type: TypeList{TypeString}}
+			}
+		}
+		depths[name] = depth
+		s.Properties[name] = fs
+	}
+	return nil
+}
+
+// nullable returns a schema which also allows null
+func nullable(s *Schema) *Schema {
+	if len(s.Type) == 0 && s.Ref == "" && len(s.AnyOf) == 0 && len(s.Enum) == 0 {
+		return s // already allows any value
+	}
+	if s.Ref == "" && len(s.AnyOf) == 0 && len(s.Enum) == 0 {
+		for _, typ := range s.Type {
+			if typ == TypeNull {
+				return s
+			}
+		}
+		c := *s
+		c.Type = append(TypeList{}, s.Type...)
+		c.Type = append(c.Type, TypeNull)
+		return &c
+	}
+	return &Schema{AnyOf: []*Schema{s, {Type: TypeList{TypeNull}}}}
+}
+
+// MarshalJSON encodes a schema, a schema with False set is encoded as false
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	if s.False {
+		return []byte("false"), nil
+	}
+	type alias Schema
+	return json.Marshal((*alias)(s))
+}
+
+// MarshalJSON encodes a single type as a string and multiple types as an array
+func (t TypeList) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
@@ -0,0 +1,115 @@
+package jsonschema
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+type testEmbedded struct {
+	Embedded string `json:"embedded"`
+	Name     string `json:"shadowed"`
+}
+
+type testNode struct {
+	testEmbedded
+	Name     string          `json:"name"`
+	Count    uint            `json:"count"`
+	Ratio    float64         `json:"ratio,string"`
+	Tags     []string        `json:"tags"`
+	Labels   map[string]int  `json:"labels"`
+	Child    *testNode       `json:"child"`
+	When     time.Time       `json:"when"`
+	Raw      json.RawMessage `json:"raw"`
+	Ignored  string          `json:"-"`
+	Untagged bool
+	private  string //nolint:unused // ensures unexported fields are skipped
+}
+
+func TestReflect(t *testing.T) {
+	t.Parallel()
+	r := &Reflector{}
+	_, err := r.Reflect(nil)
+	require.ErrorIs(t, err, errNilValue)
+
+	_, err = r.Reflect(struct{ C chan int }{})
+	require.ErrorIs(t, err, errUnsupportedType)
+
+	r.Overrides = map[reflect.Type]*Schema{reflect.TypeFor[time.Time](): {Type: TypeList{TypeString}, Format: FormatDateTime}}
+	s, err := r.Reflect(&testNode{})
+	require.NoError(t, err, "Reflect must not error")
+	assert.Equal(t, Draft, s.Schema)
+	assert.Equal(t, "#/$defs/jsonschema.testNode", s.Ref)
+	def := s.Defs["jsonschema.testNode"]
+	require.NotNil(t, def, "testNode must be defined")
+	assert.ElementsMatch(t, []string{"embedded", "shadowed", "name", "count", "ratio", "tags", "labels", "child", "when", "raw", "Untagged"}, keys(def.Properties))
+	assert.True(t, def.AdditionalProperties.False, "unknown fields should not be allowed")
+	assert.Equal(t, TypeList{TypeInteger}, def.Properties["count"].Type)
+	require.NotNil(t, def.Properties["count"].Minimum, "unsigned integers must have a minimum")
+	assert.Zero(t, *def.Properties["count"].Minimum)
+	assert.Equal(t, TypeList{TypeString}, def.Properties["ratio"].Type, "string tagged numbers should be strings")
+	assert.Equal(t, TypeList{TypeArray, TypeNull}, def.Properties["tags"].Type)
+	assert.Equal(t, TypeList{TypeObject, TypeNull}, def.Properties["labels"].Type)
+	assert.Equal(t, "#/$defs/jsonschema.testNode", def.Properties["child"].AnyOf[0].Ref, "recursive types should be referenced")
+	assert.Equal(t, FormatDateTime, def.Properties["when"].Format, "overrides should be used")
+	assert.Empty(t, def.Properties["raw"].Type, "custom encodings should allow any value")
+
+	b, err := json.Marshal(s)
+	require.NoError(t, err, "Marshal must not error")
+	assert.Contains(t, string(b), `"additionalProperties":false`)
+	assert.Contains(t, string(b), `"type":["array","null"]`)
+	assert.Contains(t, string(b), `"name":{"type":"string"}`)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	s, err := (&Reflector{
+		Overrides: map[reflect.Type]*Schema{reflect.TypeFor[time.Time](): {Type: TypeList{TypeString}, Format: FormatDateTime}},
+	}).Reflect(&testNode{})
+	require.NoError(t, err, "Reflect must not error")
+
+	_, err = s.Validate([]byte(`{`))
+	require.Error(t, err, "Validate must error on invalid JSON")
+
+	errs, err := s.Validate([]byte(`{"name":"a","count":1,"ratio":"1.5","tags":null,"child":{"name":"b"},"when":"2024-01-01T00:00:00Z","raw":[1]}`))
+	require.NoError(t, err, "Validate must not error")
+	assert.Empty(t, errs, "a valid document should have no errors")
+
+	errs, err = s.Validate([]byte(`{"name":1,"count":-1,"ratio":1.5,"tags":[1],"child":{"unknown":true,"child":{"labels":{"a/b":"x"}}},"when":"yesterday"}`))
+	require.NoError(t, err, "Validate must not error")
+	assert.Equal(t, ValidationErrors{
+		{Path: "/child/child/labels/a~1b", Message: "expected integer, got string"},
+		{Path: "/child/unknown", Message: "unknown field"},
+		{Path: "/count", Message: "must be at least 0"},
+		{Path: "/name", Message: "expected string, got integer"},
+		{Path: "/ratio", Message: "expected string, got number"},
+		{Path: "/tags/0", Message: "expected string, got integer"},
+		{Path: "/when", Message: "must be an RFC 3339 date-time"},
+	}, errs)
+	assert.Equal(t, "/count: must be at least 0", errs[2].Error())
+
+	enum := &Schema{Enum: []any{"a", 1}}
+	errs, err = enum.Validate([]byte(`"b"`))
+	require.NoError(t, err, "Validate must not error")
+	assert.Equal(t, `/: must be one of "a", 1`, errs.Error())
+
+	pattern := &Schema{Type: TypeList{TypeString}, Pattern: "^[0-9]+ms$"}
+	errs, err = pattern.Validate([]byte(`"10ms"`))
+	require.NoError(t, err, "Validate must not error")
+	assert.Empty(t, errs, "a matching string should have no errors")
+	errs, err = pattern.Validate([]byte(`"10s"`))
+	require.NoError(t, err, "Validate must not error")
+	assert.Len(t, errs, 1, "a string which does not match should error")
+}
+
+func keys(m map[string]*Schema) []string {
+	k := make([]string, 0, len(m))
+	for key := range m {
+		k = append(k, key)
+	}
+	return k
+}
@@ -0,0 +1,75 @@
+package jsonschema
+
+import (
+	"errors"
+	"reflect"
+)
+
+// Draft is the JSON Schema dialect generated schemas declare
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSON types
+const (
+	TypeNull    = "null"
+	TypeBoolean = "boolean"
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeNumber  = "number"
+	TypeInteger = "integer"
+	TypeString  = "string"
+)
+
+// FormatDateTime is the format of RFC 3339 timestamps
+const FormatDateTime = "date-time"
+
+var (
+	errUnsupportedType = errors.New("unsupported type")
+	errNilValue        = errors.New("cannot reflect a nil value")
+	errUnresolvedRef   = errors.New("unresolved schema reference")
+)
+
+// Schema is a JSON Schema. Only the keywords needed to describe Go types are
+// supported. A schema with False set rejects every value
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 TypeList           `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+	False                bool               `json:"-"`
+}
+
+// TypeList holds the JSON types a value may have. It is encoded as a single
+// string when it holds one type
+type TypeList []string
+
+// Reflector generates schemas from Go types
+type Reflector struct {
+	// Overrides replaces the schema generated for a type, for types with
+	// custom JSON encodings
+	Overrides map[reflect.Type]*Schema
+	// DefaultEncoded lists types with custom JSON encodings which match the
+	// encoding/json encoding of their kind
+	DefaultEncoded map[reflect.Type]bool
+	defs           map[string]*Schema
+	names          map[reflect.Type]string
+}
+
+// ValidationError describes a value which does not match its schema. Path is
+// the JSON pointer to the value
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+// ValidationErrors is a list of validation errors
+type ValidationErrors []ValidationError
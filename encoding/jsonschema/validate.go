@@ -0,0 +1,204 @@
+package jsonschema
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Validate checks the JSON document against the schema and returns every
+// mismatch found. An error is returned if the document is not valid JSON
+func (s *Schema) Validate(doc []byte) (ValidationErrors, error) {
+	d := json.NewDecoder(bytes.NewReader(doc))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	var errs ValidationErrors
+	s.validate(s, "", v, &errs)
+	return errs, nil
+}
+
+func (s *Schema) validate(root *Schema, path string, v any, errs *ValidationErrors) {
+	if s.False {
+		errs.add(path, "is not allowed")
+		return
+	}
+	if s.Ref != "" {
+		target, ok := root.Defs[strings.TrimPrefix(s.Ref, "#/$defs/")]
+		if !ok {
+			errs.add(path, fmt.Sprintf("%s %q", errUnresolvedRef, s.Ref))
+			return
+		}
+		target.validate(root, path, v, errs)
+	}
+	if len(s.AnyOf) > 0 {
+		// Report the errors of the closest match, preferring schemas which
+		// allow the value's type
+		var closest ValidationErrors
+		closestTypeMatch := false
+		for i, sub := range s.AnyOf {
+			var subErrs ValidationErrors
+			sub.validate(root, path, v, &subErrs)
+			if len(subErrs) == 0 {
+				closest = nil
+				break
+			}
+			typeMatch := sub.allowsType(root, typeOf(v))
+			if i == 0 || (typeMatch && !closestTypeMatch) || (typeMatch == closestTypeMatch && len(subErrs) < len(closest)) {
+				closest, closestTypeMatch = subErrs, typeMatch
+			}
+		}
+		*errs = append(*errs, closest...)
+	}
+	if len(s.Type) > 0 {
+		if typ := typeOf(v); !s.Type.allows(typ) {
+			errs.add(path, fmt.Sprintf("expected %s, got %s", strings.Join(s.Type, " or "), typ))
+			return
+		}
+	}
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return sameJSON(e, v) }) {
+		errs.add(path, fmt.Sprintf("must be one of %s", enumString(s.Enum)))
+	}
+	switch n := v.(type) {
+	case json.Number:
+		if s.Minimum != nil {
+			if f, err := n.Float64(); err == nil && f < *s.Minimum {
+				errs.add(path, fmt.Sprintf("must be at least %v", *s.Minimum))
+			}
+		}
+	case string:
+		if s.Format == FormatDateTime {
+			if _, err := time.Parse(time.RFC3339Nano, n); err != nil {
+				errs.add(path, "must be an RFC 3339 date-time")
+			}
+		}
+		if s.Pattern != "" {
+			if re, err := regexp.Compile(s.Pattern); err != nil {
+				errs.add(path, fmt.Sprintf("invalid schema pattern %q: %s", s.Pattern, err))
+			} else if !re.MatchString(n) {
+				errs.add(path, fmt.Sprintf("must match %q", s.Pattern))
+			}
+		}
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(n)) {
+			childPath := path + "/" + escapeToken(k)
+			if ps, ok := s.Properties[k]; ok {
+				ps.validate(root, childPath, n[k], errs)
+				continue
+			}
+			if s.AdditionalProperties == nil {
+				continue
+			}
+			if s.AdditionalProperties.False {
+				errs.add(childPath, "unknown field")
+				continue
+			}
+			s.AdditionalProperties.validate(root, childPath, n[k], errs)
+		}
+	case []any:
+		if s.Items != nil {
+			for i := range n {
+				s.Items.validate(root, path+"/"+strconv.Itoa(i), n[i], errs)
+			}
+		}
+	}
+}
+
+// allowsType reports whether the schema allows values of the JSON type
+func (s *Schema) allowsType(root *Schema, typ string) bool {
+	if s.False {
+		return false
+	}
+	if s.Ref != "" {
+		if target, ok := root.Defs[strings.TrimPrefix(s.Ref, "#/$defs/")]; ok && !target.allowsType(root, typ) {
+			return false
+		}
+	}
+	return len(s.Type) == 0 || s.Type.allows(typ)
+}
+
+// Error implements the error interface
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return "/: " + e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// Error implements the error interface, listing each validation error
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (e *ValidationErrors) add(path, msg string) {
+	*e = append(*e, ValidationError{Path: path, Message: msg})
+}
+
+func (t TypeList) allows(typ string) bool {
+	return slices.Contains(t, typ) || (typ == TypeInteger && slices.Contains(t, TypeNumber))
+}
+
+// typeOf returns the JSON type of a decoded value. Numbers without a
+// fractional part are integers
+func typeOf(v any) string {
+	switch n := v.(type) {
+	case nil:
+		return TypeNull
+	case bool:
+		return TypeBoolean
+	case string:
+		return TypeString
+	case map[string]any:
+		return TypeObject
+	case []any:
+		return TypeArray
+	case json.Number:
+		if _, err := n.Int64(); err == nil {
+			return TypeInteger
+		}
+		if f, err := n.Float64(); err == nil && f == float64(int64(f)) {
+			return TypeInteger
+		}
+		return TypeNumber
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+// sameJSON reports whether two values have the same JSON encoding
+func sameJSON(a, b any) bool {
+	x, errA := json.Marshal(a)
+	y, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(x, y)
+}
+
+func enumString(enum []any) string {
+	values := make([]string, len(enum))
+	for i := range enum {
+		b, err := json.Marshal(enum[i])
+		if err != nil {
+			values[i] = fmt.Sprint(enum[i])
+			continue
+		}
+		values[i] = string(b)
+	}
+	return strings.Join(values, ", ")
+}
+
+// escapeToken escapes an object member name for use in a JSON pointer
+func escapeToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
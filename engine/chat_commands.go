@@ -0,0 +1,355 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// newChatCommandHandler returns a chat command handler for the engine
+func newChatCommandHandler(bot *Engine) *chatCommandHandler {
+	h := &chatCommandHandler{
+		rpc:     &RPCServer{Engine: bot},
+		pending: make(map[string]*pendingChatOrder),
+	}
+	h.commands = map[string]*chatCommand{
+		"balances": {
+			usage:       "balances <exchange> [asset] [account]",
+			description: "Displays account balances",
+			run:         h.balances,
+		},
+		"orders": {
+			usage:       "orders [exchange]",
+			description: "Displays open orders tracked by the order manager",
+			run:         h.orders,
+		},
+		"positions": {
+			usage:       "positions",
+			description: "Displays futures positions tracked by the order manager",
+			run:         h.positions,
+		},
+		"exchange": {
+			usage:       "exchange <enable|disable> <exchange>",
+			description: "Enables or disables an exchange",
+			privileged:  true,
+			run:         h.exchange,
+		},
+		"subsystem": {
+			usage:       "subsystem <enable|disable> <subsystem>",
+			description: "Enables or disables a subsystem",
+			privileged:  true,
+			run:         h.subsystem,
+		},
+		"cancelall": {
+			usage:       "cancelall <exchange> [account]",
+			description: "Cancels all open orders on an exchange",
+			privileged:  true,
+			run:         h.cancelAll,
+		},
+		"buy": {
+			usage:       "buy <exchange> <asset> <pair> <amount> [price]",
+			description: "Stages a market, or limit when a price is given, buy order for confirmation",
+			run:         h.stageOrder,
+		},
+		"sell": {
+			usage:       "sell <exchange> <asset> <pair> <amount> [price]",
+			description: "Stages a market, or limit when a price is given, sell order for confirmation",
+			run:         h.stageOrder,
+		},
+		"confirm": {
+			usage:       "confirm <code>",
+			description: "Submits the staged order",
+			privileged:  true,
+			run:         h.confirmOrder,
+		},
+		"abort": {
+			usage:       "abort",
+			description: "Discards the staged order",
+			run:         h.abortOrder,
+		},
+	}
+	return h
+}
+
+// Help returns the usage of each command
+func (h *chatCommandHandler) Help() string {
+	names := make([]string, 0, len(h.commands))
+	for name := range h.commands {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var sb strings.Builder
+	for _, name := range names {
+		fmt.Fprintf(&sb, "%s - %s\n", h.commands[name].usage, h.commands[name].description)
+	}
+	return sb.String()
+}
+
+// HandleCommand executes a command for the sender and returns the reply
+func (h *chatCommandHandler) HandleCommand(ctx context.Context, sender, command string) string {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return errChatCommandUnknown.Error()
+	}
+	cmd, ok := h.commands[strings.ToLower(fields[0])]
+	if !ok {
+		return fmt.Sprintf("%s: %s", fields[0], errChatCommandUnknown)
+	}
+	if cmd.privileged {
+		log.Infof(log.CommunicationMgr, "Chat command %q received from %s", command, sender)
+	}
+	// The command name is passed through so buy and sell can share a handler
+	reply, err := cmd.run(ctx, sender, fields)
+	if cmd.privileged {
+		outcome := "succeeded"
+		if err != nil {
+			outcome = "failed: " + err.Error()
+		}
+		audit.Event(sender, chatAuditEventType, fmt.Sprintf("%s %s", command, outcome))
+	}
+	if err != nil {
+		return "Error: " + err.Error()
+	}
+	return reply
+}
+
+func (h *chatCommandHandler) balances(ctx context.Context, _ string, args []string) (string, error) {
+	if len(args) < 2 {
+		return "", fmt.Errorf("%w: %s", errChatCommandUsage, h.commands["balances"].usage)
+	}
+	a := asset.Spot.String()
+	if len(args) > 2 {
+		a = args[2]
+	}
+	var account string
+	if len(args) > 3 {
+		account = args[3]
+	}
+	resp, err := h.rpc.GetAccountBalances(ctx, &gctrpc.GetAccountBalancesRequest{Exchange: args[1], AssetType: a, Account: account})
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s balances:\n", resp.Exchange, a)
+	var count int
+	for _, acc := range resp.Accounts {
+		for _, c := range acc.Currencies {
+			if c.TotalValue == 0 {
+				continue
+			}
+			count++
+			if acc.Id != "" {
+				sb.WriteString(acc.Id + " ")
+			}
+			fmt.Fprintf(&sb, "%s total: %v free: %v hold: %v\n", c.Currency, c.TotalValue, c.Free, c.Hold)
+		}
+	}
+	if count == 0 {
+		sb.WriteString("No balances\n")
+	}
+	return sb.String(), nil
+}
+
+func (h *chatCommandHandler) orders(_ context.Context, _ string, args []string) (string, error) {
+	f := &order.Filter{}
+	if len(args) > 1 {
+		exch, err := h.rpc.GetExchangeByName(args[1])
+		if err != nil {
+			return "", err
+		}
+		f.Exchange = exch.GetName()
+	}
+	orders, err := h.rpc.OrderManager.GetOrdersActive(f)
+	if err != nil {
+		return "", err
+	}
+	if len(orders) == 0 {
+		return "No open orders", nil
+	}
+	var sb strings.Builder
+	for i := range orders {
+		o := &orders[i]
+		fmt.Fprintf(&sb, "%s %s %s %s %s %v@%v %s %s\n", o.Exchange, o.AssetType, o.Pair, o.Side, o.Type, o.Amount, o.Price, o.Status, o.OrderID)
+	}
+	return sb.String(), nil
+}
+
+func (h *chatCommandHandler) positions(ctx context.Context, _ string, _ []string) (string, error) {
+	resp, err := h.rpc.GetAllManagedPositions(ctx, &gctrpc.GetAllManagedPositionsRequest{})
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Positions) == 0 {
+		return "No positions", nil
+	}
+	var sb strings.Builder
+	for _, p := range resp.Positions {
+		var pair string
+		if p.Pair != nil {
+			pair = p.Pair.Base + p.Pair.Delimiter + p.Pair.Quote
+		}
+		fmt.Fprintf(&sb, "%s %s %s %s %s size: %s unrealised PnL: %s realised PnL: %s\n", p.Exchange, p.Asset, pair, p.Status, p.CurrentDirection, p.CurrentSize, p.UnrealisedPnl, p.RealisedPnl)
+	}
+	return sb.String(), nil
+}
+
+func (h *chatCommandHandler) exchange(ctx context.Context, _ string, args []string) (string, error) {
+	if len(args) != 3 {
+		return "", fmt.Errorf("%w: %s", errChatCommandUsage, h.commands["exchange"].usage)
+	}
+	req := &gctrpc.GenericExchangeNameRequest{Exchange: args[2]}
+	var err error
+	switch strings.ToLower(args[1]) {
+	case "enable":
+		_, err = h.rpc.EnableExchange(ctx, req)
+	case "disable":
+		_, err = h.rpc.DisableExchange(ctx, req)
+	default:
+		return "", fmt.Errorf("%w: %s", errChatCommandUsage, h.commands["exchange"].usage)
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Exchange %s %sd", args[2], strings.ToLower(args[1])), nil
+}
+
+func (h *chatCommandHandler) subsystem(ctx context.Context, _ string, args []string) (string, error) {
+	if len(args) != 3 {
+		return "", fmt.Errorf("%w: %s", errChatCommandUsage, h.commands["subsystem"].usage)
+	}
+	req := &gctrpc.GenericSubsystemRequest{Subsystem: args[2]}
+	var err error
+	switch strings.ToLower(args[1]) {
+	case "enable":
+		_, err = h.rpc.EnableSubsystem(ctx, req)
+	case "disable":
+		_, err = h.rpc.DisableSubsystem(ctx, req)
+	default:
+		return "", fmt.Errorf("%w: %s", errChatCommandUsage, h.commands["subsystem"].usage)
+	}
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Subsystem %s %sd", args[2], strings.ToLower(args[1])), nil
+}
+
+func (h *chatCommandHandler) cancelAll(ctx context.Context, _ string, args []string) (string, error) {
+	if len(args) < 2 {
+		return "", fmt.Errorf("%w: %s", errChatCommandUsage, h.commands["cancelall"].usage)
+	}
+	var account string
+	if len(args) > 2 {
+		account = args[2]
+	}
+	resp, err := h.rpc.CancelAllOrders(ctx, &gctrpc.CancelAllOrdersRequest{Exchange: args[1], Account: account})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Cancelled %d orders on %s", resp.Count, args[1]), nil
+}
+
+// stageOrder validates an order and holds it until the sender confirms it
+func (h *chatCommandHandler) stageOrder(_ context.Context, sender string, args []string) (string, error) {
+	side := strings.ToLower(args[0])
+	if len(args) != 5 && len(args) != 6 {
+		return "", fmt.Errorf("%w: %s", errChatCommandUsage, h.commands[side].usage)
+	}
+	exch, err := h.rpc.GetExchangeByName(args[1])
+	if err != nil {
+		return "", err
+	}
+	a, err := asset.New(args[2])
+	if err != nil {
+		return "", err
+	}
+	pair, err := currency.NewPairFromString(args[3])
+	if err != nil {
+		return "", err
+	}
+	amount, err := strconv.ParseFloat(args[4], 64)
+	if err != nil || amount <= 0 {
+		return "", fmt.Errorf("%w amount: %s", errChatCommandInvalidValue, args[4])
+	}
+	orderType := order.Market
+	var price float64
+	if len(args) == 6 {
+		price, err = strconv.ParseFloat(args[5], 64)
+		if err != nil || price <= 0 {
+			return "", fmt.Errorf("%w price: %s", errChatCommandInvalidValue, args[5])
+		}
+		orderType = order.Limit
+	}
+	p := &pendingChatOrder{
+		code: fmt.Sprintf("%06d", rand.IntN(1000000)), //nolint:gosec // Confirmation code guards against mistakes, not attackers
+		request: &gctrpc.SubmitOrderRequest{
+			Exchange:  exch.GetName(),
+			Pair:      &gctrpc.CurrencyPair{Base: pair.Base.String(), Quote: pair.Quote.String(), Delimiter: pair.Delimiter},
+			Side:      side,
+			OrderType: orderType.String(),
+			Amount:    amount,
+			Price:     price,
+			AssetType: a.String(),
+		},
+		expires: time.Now().Add(chatOrderConfirmTimeout),
+	}
+	h.m.Lock()
+	h.pending[sender] = p
+	h.m.Unlock()
+	return fmt.Sprintf("Staged %s, reply \"confirm %s\" within %s to submit or \"abort\" to discard", describeChatOrder(p.request), p.code, chatOrderConfirmTimeout), nil
+}
+
+// confirmOrder submits the sender's staged order when the code matches
+func (h *chatCommandHandler) confirmOrder(ctx context.Context, sender string, args []string) (string, error) {
+	if len(args) != 2 {
+		return "", fmt.Errorf("%w: %s", errChatCommandUsage, h.commands["confirm"].usage)
+	}
+	h.m.Lock()
+	p, ok := h.pending[sender]
+	if ok && p.code == args[1] {
+		delete(h.pending, sender)
+	}
+	h.m.Unlock()
+	switch {
+	case !ok:
+		return "", errChatOrderNotPending
+	case p.code != args[1]:
+		return "", errChatOrderCodeMismatch
+	case time.Now().After(p.expires):
+		return "", errChatOrderExpired
+	}
+	resp, err := h.rpc.SubmitOrder(ctx, p.request)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Submitted %s order ID: %s", describeChatOrder(p.request), resp.OrderId), nil
+}
+
+// abortOrder discards the sender's staged order
+func (h *chatCommandHandler) abortOrder(_ context.Context, sender string, _ []string) (string, error) {
+	h.m.Lock()
+	defer h.m.Unlock()
+	if _, ok := h.pending[sender]; !ok {
+		return "", errChatOrderNotPending
+	}
+	delete(h.pending, sender)
+	return "Staged order discarded", nil
+}
+
+func describeChatOrder(r *gctrpc.SubmitOrderRequest) string {
+	desc := fmt.Sprintf("%s %s %v %s%s%s on %s %s", r.OrderType, r.Side, r.Amount, r.Pair.Base, r.Pair.Delimiter, r.Pair.Quote, r.Exchange, r.AssetType)
+	if r.Price > 0 {
+		desc += fmt.Sprintf(" at %v", r.Price)
+	}
+	return desc
+}
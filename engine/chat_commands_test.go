@@ -0,0 +1,63 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func newChatCommandTestEngine(t *testing.T) *Engine {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch))
+	return &Engine{Config: &config.Config{}, ExchangeManager: em}
+}
+
+func TestChatCommandHelp(t *testing.T) {
+	t.Parallel()
+	h := newChatCommandHandler(newChatCommandTestEngine(t))
+	help := h.Help()
+	for _, cmd := range h.commands {
+		assert.Contains(t, help, cmd.usage)
+	}
+	assert.Contains(t, h.HandleCommand(t.Context(), "test", "moon"), errChatCommandUnknown.Error())
+	assert.Contains(t, h.HandleCommand(t.Context(), "test", ""), errChatCommandUnknown.Error())
+	assert.Contains(t, h.HandleCommand(t.Context(), "test", "balances"), errChatCommandUsage.Error())
+	assert.Contains(t, h.HandleCommand(t.Context(), "test", "exchange toggle "+testExchange), errChatCommandUsage.Error())
+}
+
+func TestChatCommandStageOrder(t *testing.T) {
+	t.Parallel()
+	h := newChatCommandHandler(newChatCommandTestEngine(t))
+
+	assert.Contains(t, h.HandleCommand(t.Context(), "alice", "confirm 123456"), errChatOrderNotPending.Error())
+	assert.Contains(t, h.HandleCommand(t.Context(), "alice", "buy "+testExchange+" spot BTC-USD lots"), errChatCommandInvalidValue.Error())
+	assert.Contains(t, h.HandleCommand(t.Context(), "alice", "buy "+testExchange+" spot BTC-USD 1 -5"), errChatCommandInvalidValue.Error())
+
+	reply := h.HandleCommand(t.Context(), "alice", "buy "+testExchange+" spot BTC-USD 0.5 25000")
+	require.Contains(t, h.pending, "alice", "buy must stage an order")
+	p := h.pending["alice"]
+	assert.Contains(t, reply, p.code, "reply should include the confirmation code")
+	assert.Equal(t, "LIMIT", p.request.OrderType)
+	assert.Equal(t, 25000.0, p.request.Price)
+
+	assert.Contains(t, h.HandleCommand(t.Context(), "bob", "confirm "+p.code), errChatOrderNotPending.Error(), "orders should only be confirmed by the user who staged them")
+	assert.Contains(t, h.HandleCommand(t.Context(), "alice", "confirm nope"), errChatOrderCodeMismatch.Error())
+	require.Contains(t, h.pending, "alice", "a mismatched code must not discard the order")
+
+	p.expires = time.Now().Add(-time.Second)
+	assert.Contains(t, h.HandleCommand(t.Context(), "alice", "confirm "+p.code), errChatOrderExpired.Error())
+	assert.NotContains(t, h.pending, "alice", "expired orders should be discarded")
+
+	h.HandleCommand(t.Context(), "alice", "sell "+testExchange+" spot BTC-USD 0.5")
+	require.Contains(t, h.pending, "alice", "sell must stage an order")
+	assert.Equal(t, "MARKET", h.pending["alice"].request.OrderType)
+	assert.Equal(t, "Staged order discarded", h.HandleCommand(t.Context(), "alice", "abort"))
+	assert.Contains(t, h.HandleCommand(t.Context(), "alice", "abort"), errChatOrderNotPending.Error())
+}
@@ -0,0 +1,54 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+)
+
+const (
+	// chatOrderConfirmTimeout is how long a staged chat order awaits
+	// confirmation before it is discarded
+	chatOrderConfirmTimeout = time.Minute
+	// chatAuditEventType is the audit event type for chat commands which
+	// change state
+	chatAuditEventType = "chat"
+)
+
+var (
+	errChatCommandUnknown      = errors.New("unknown command, send help for a list of commands")
+	errChatCommandUsage        = errors.New("usage")
+	errChatOrderNotPending     = errors.New("no order awaiting confirmation")
+	errChatOrderCodeMismatch   = errors.New("confirmation code does not match the pending order")
+	errChatOrderExpired        = errors.New("pending order expired")
+	errChatCommandInvalidValue = errors.New("invalid value")
+)
+
+// chatCommandHandler executes trading commands received by chat relayers,
+// using the same functions as the gRPC server
+type chatCommandHandler struct {
+	rpc      *RPCServer
+	commands map[string]*chatCommand
+	m        sync.Mutex
+	pending  map[string]*pendingChatOrder
+}
+
+// chatCommand is a command available to authorised chat users
+type chatCommand struct {
+	usage       string
+	description string
+	// privileged commands change state and are audited
+	privileged bool
+	run        func(ctx context.Context, sender string, args []string) (string, error)
+}
+
+// pendingChatOrder is an order awaiting confirmation by the chat user who
+// staged it
+type pendingChatOrder struct {
+	code    string
+	request *gctrpc.SubmitOrderRequest
+	expires time.Time
+}
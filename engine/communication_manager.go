@@ -2,6 +2,7 @@ package engine
 
 import (
 	"fmt"
+	"sync"
 	"sync/atomic"
 
 	"github.com/thrasher-corp/gocryptotrader/communications"
@@ -18,6 +19,8 @@ type CommunicationManager struct {
 	shutdown chan struct{}
 	relayMsg chan base.Event
 	comms    *communications.Communications
+	handler  base.CommandHandler
+	mu       sync.RWMutex
 }
 
 // SetupCommunicationManager creates a communications manager
@@ -64,9 +67,48 @@ func (m *CommunicationManager) GetStatus() (map[string]base.CommsStatus, error)
 	if !m.IsRunning() {
 		return nil, fmt.Errorf("communications manager %w", ErrSubSystemNotStarted)
 	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	return m.comms.GetStatus(), nil
 }
 
+// SetCommandHandler sets the handler for commands received from authorised
+// chat users
+func (m *CommunicationManager) SetCommandHandler(h base.CommandHandler) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handler = h
+	if m.comms == nil {
+		return
+	}
+	m.comms.SetCommandHandler(h)
+}
+
+// reload replaces the communication relayers with those enabled in the
+// supplied config. The command handler is carried over to the new relayers
+func (m *CommunicationManager) reload(cfg *base.CommunicationsConfig) error {
+	if m == nil {
+		return fmt.Errorf("communications manager %w", ErrNilSubsystem)
+	}
+	if cfg == nil {
+		return errNilConfig
+	}
+	comms, err := communications.NewComm(cfg)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.handler != nil {
+		comms.SetCommandHandler(m.handler)
+	}
+	m.comms = comms
+	return nil
+}
+
 // Stop attempts to shutdown the subsystem
 func (m *CommunicationManager) Stop() error {
 	if m == nil {
@@ -106,7 +148,9 @@ func (m *CommunicationManager) run() {
 	for {
 		select {
 		case msg := <-m.relayMsg:
+			m.mu.RLock()
 			m.comms.PushEvent(msg)
+			m.mu.RUnlock()
 		case <-m.shutdown:
 			return
 		}
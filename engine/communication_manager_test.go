@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 )
@@ -130,3 +131,35 @@ func TestPushEvent(t *testing.T) {
 	m = nil
 	m.PushEvent(base.Event{})
 }
+
+func TestCommunicationManagerReload(t *testing.T) {
+	t.Parallel()
+	var m *CommunicationManager
+	err := m.reload(&base.CommunicationsConfig{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, err = SetupCommunicationManager(&base.CommunicationsConfig{
+		SlackConfig: base.SlackConfig{
+			Enabled: true,
+		},
+	})
+	require.NoError(t, err, "SetupCommunicationManager must not error")
+
+	err = m.reload(nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	err = m.reload(&base.CommunicationsConfig{})
+	assert.ErrorIs(t, err, communications.ErrNoRelayersEnabled)
+	assert.Len(t, m.comms.IComm, 1, "relayers should not be replaced on error")
+
+	err = m.reload(&base.CommunicationsConfig{
+		SMSGlobalConfig: base.SMSGlobalConfig{
+			Enabled: true,
+		},
+		SlackConfig: base.SlackConfig{
+			Enabled: true,
+		},
+	})
+	require.NoError(t, err, "reload must not error")
+	assert.Len(t, m.comms.IComm, 2, "relayers should be replaced")
+}
@@ -0,0 +1,181 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/encoding/jsonpatch"
+	gctlog "github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// PatchConfig applies an RFC 6902 JSON patch to the running config. The
+// patched config is validated and applied as a config reload would be, then
+// saved to the config file, recording a new config history version. When
+// dryRun is set the changes are reported without being applied or saved
+func (bot *Engine) PatchConfig(patch []byte, dryRun bool) (*ConfigReloadResult, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	if !bot.configReloadMtx.TryLock() {
+		return nil, errConfigReloadInProgress
+	}
+	defer bot.configReloadMtx.Unlock()
+
+	newCfg, err := bot.Config.Patch(patch)
+	if err != nil {
+		return nil, err
+	}
+	return bot.applyAndSaveConfig(newCfg, dryRun)
+}
+
+// RollbackConfig applies a saved config history version to the running engine
+// and saves it to the config file as a new version. When dryRun is set the
+// changes are reported without being applied or saved
+func (bot *Engine) RollbackConfig(version uint64, dryRun bool) (*ConfigReloadResult, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	if !bot.configReloadMtx.TryLock() {
+		return nil, errConfigReloadInProgress
+	}
+	defer bot.configReloadMtx.Unlock()
+
+	path, err := bot.configFilePath()
+	if err != nil {
+		return nil, err
+	}
+	newCfg, err := bot.Config.ReadHistoryVersion(path, version)
+	if err != nil {
+		return nil, err
+	}
+	return bot.applyAndSaveConfig(newCfg, dryRun)
+}
+
+// GetConfigHistory returns the saved versions of the config file, oldest first
+func (bot *Engine) GetConfigHistory() ([]config.HistoryVersion, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	path, err := bot.configFilePath()
+	if err != nil {
+		return nil, err
+	}
+	return config.GetHistory(path)
+}
+
+// DiffConfig returns the JSON patch which transforms config history version
+// from into version to. Version 0 refers to the running config. Credentials
+// are redacted before diffing so they are never included in the patch
+func (bot *Engine) DiffConfig(from, to uint64) (jsonpatch.Patch, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	a, err := bot.configVersionJSON(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := bot.configVersionJSON(to)
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.Diff(a, b)
+}
+
+// applyAndSaveConfig applies newCfg to the running engine and saves it to the
+// config file. The applied changes are rolled back if the config cannot be
+// saved. configReloadMtx must be held
+func (bot *Engine) applyAndSaveConfig(newCfg *config.Config, dryRun bool) (*ConfigReloadResult, error) {
+	if dryRun || bot.Settings.EnableDryRun {
+		return bot.reloadConfig(newCfg, dryRun, nil)
+	}
+	path, err := bot.configFilePath()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errConfigSaveFailed, err)
+	}
+	result, err := bot.reloadConfig(newCfg, false, func() error {
+		if err := newCfg.SaveConfigToFile(path); err != nil {
+			return fmt.Errorf("%w: %w", errConfigSaveFailed, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	versions, err := config.GetHistory(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errConfigSaveFailed, err)
+	}
+	if len(versions) > 0 {
+		result.Version = versions[len(versions)-1].Version
+	}
+	gctlog.Infof(gctlog.Global, "Config saved as version %d", result.Version)
+	return result, nil
+}
+
+// setPendingConfigChanges records the differences between the running config
+// and newCfg which were not applied, so the running config can be saved
+// without discarding them
+func (bot *Engine) setPendingConfigChanges(newCfg *config.Config) error {
+	running, err := json.Marshal(bot.Config)
+	if err != nil {
+		return err
+	}
+	updated, err := json.Marshal(newCfg)
+	if err != nil {
+		return err
+	}
+	p, err := jsonpatch.Diff(running, updated)
+	if err != nil {
+		return err
+	}
+	if len(p) == 0 {
+		bot.pendingConfigChanges = nil
+		return nil
+	}
+	bot.pendingConfigChanges, err = json.Marshal(p)
+	return err
+}
+
+// configToSave returns the running config with any changes pending a restart
+// applied
+func (bot *Engine) configToSave() *config.Config {
+	bot.configReloadMtx.Lock()
+	defer bot.configReloadMtx.Unlock()
+	if len(bot.pendingConfigChanges) == 0 {
+		return bot.Config
+	}
+	cfg, err := bot.Config.Patch(bot.pendingConfigChanges)
+	if err != nil {
+		gctlog.Errorf(gctlog.Global, "Unable to apply config changes pending a restart, saving the running config: %v", err)
+		return bot.Config
+	}
+	return cfg
+}
+
+// configFilePath returns the path of the config file the engine was started
+// with
+func (bot *Engine) configFilePath() (string, error) {
+	path, _, err := config.GetFilePath(bot.Settings.ConfigFile)
+	return path, err
+}
+
+// configVersionJSON returns the JSON of a config history version, or of the
+// running config for version 0, with its credentials redacted
+func (bot *Engine) configVersionJSON(version uint64) ([]byte, error) {
+	if version == 0 {
+		return bot.runningConfigJSON()
+	}
+	path, err := bot.configFilePath()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := bot.Config.ReadHistoryVersion(path, version)
+	if err != nil {
+		return nil, err
+	}
+	if cfg, err = cfg.Redacted(); err != nil {
+		return nil, err
+	}
+	return json.Marshal(cfg)
+}
@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/encoding/jsonpatch"
+)
+
+// newConfigHistoryTestBot returns a bot with a single loaded exchange whose
+// config file is a copy of the test config in a temporary directory
+func newConfigHistoryTestBot(t *testing.T) *Engine {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), config.File)
+	data, err := os.ReadFile(config.TestFile)
+	require.NoError(t, err, "ReadFile must not error")
+	require.NoError(t, os.WriteFile(path, data, 0o600), "WriteFile must not error")
+
+	cfg := &config.Config{}
+	require.NoError(t, cfg.LoadConfig(path, true), "LoadConfig must not error")
+	exchCfg, err := cfg.GetExchangeConfig(testExchange)
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	exchCfg.Enabled = true
+	cfg.Exchanges = []config.Exchange{*exchCfg}
+	require.NoError(t, cfg.SaveConfigToFile(path), "SaveConfigToFile must not error")
+	require.NoError(t, os.RemoveAll(config.HistoryDir(path)), "RemoveAll must not error")
+
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, exch.Setup(&cfg.Exchanges[0]), "Setup must not error")
+	require.NoError(t, em.Add(exch), "Add must not error")
+	return &Engine{Config: cfg, ExchangeManager: em, Settings: Settings{ConfigFile: path}}
+}
+
+func TestPatchConfig(t *testing.T) {
+	t.Parallel()
+	var bot *Engine
+	_, err := bot.PatchConfig(nil, false)
+	require.ErrorIs(t, err, errNilBot)
+
+	bot = newConfigHistoryTestBot(t)
+	_, err = bot.PatchConfig([]byte(`[{"op":"add","path":"/unknown","value":1}]`), false)
+	require.ErrorIs(t, err, config.ErrInvalidConfigPatch)
+
+	patch := []byte(`[{"op":"replace","path":"/name","value":"patched"}]`)
+	result, err := bot.PatchConfig(patch, true)
+	require.NoError(t, err, "PatchConfig must not error")
+	assert.Equal(t, []string{"name"}, result.RestartRequired)
+	assert.Zero(t, result.Version, "a dry run should not save a version")
+	versions, err := bot.GetConfigHistory()
+	require.NoError(t, err, "GetConfigHistory must not error")
+	assert.Empty(t, versions, "a dry run should not save the config")
+
+	result, err = bot.PatchConfig(patch, false)
+	require.NoError(t, err, "PatchConfig must not error")
+	assert.Equal(t, uint64(1), result.Version, "the patched config should be saved as a version")
+	assert.NotEqual(t, "patched", bot.Config.Name, "restart required sections should not change the running config")
+	assert.Equal(t, "patched", bot.configToSave().Name, "restart required sections should be kept when saving the running config")
+
+	saved := &config.Config{}
+	require.NoError(t, saved.ReadConfigFromFile(bot.Settings.ConfigFile, true), "ReadConfigFromFile must not error")
+	assert.Equal(t, "patched", saved.Name, "the patched config should be saved")
+}
+
+func TestPatchConfigSaveFailure(t *testing.T) {
+	t.Parallel()
+	bot := newConfigHistoryTestBot(t)
+	require.NoError(t, os.Remove(bot.Settings.ConfigFile), "Remove must not error")
+	require.NoError(t, os.Mkdir(bot.Settings.ConfigFile, 0o700), "Mkdir must not error")
+
+	_, err := bot.PatchConfig([]byte(`[{"op":"replace","path":"/orderManager/verbose","value":true}]`), false)
+	require.ErrorIs(t, err, errConfigSaveFailed)
+	assert.False(t, bot.Config.OrderManager.Verbose, "applied changes should be rolled back when the config cannot be saved")
+}
+
+func TestRollbackConfig(t *testing.T) {
+	t.Parallel()
+	var bot *Engine
+	_, err := bot.RollbackConfig(1, false)
+	require.ErrorIs(t, err, errNilBot)
+
+	bot = newConfigHistoryTestBot(t)
+	name := bot.Config.Name
+	_, err = bot.RollbackConfig(1, false)
+	require.ErrorIs(t, err, config.ErrConfigVersionNotFound)
+
+	_, err = bot.PatchConfig([]byte(`[{"op":"replace","path":"/orderManager/verbose","value":true}]`), false)
+	require.NoError(t, err, "PatchConfig must not error")
+	_, err = bot.PatchConfig([]byte(`[{"op":"replace","path":"/name","value":"patched"}]`), false)
+	require.NoError(t, err, "PatchConfig must not error")
+
+	p, err := bot.DiffConfig(1, 2)
+	require.NoError(t, err, "DiffConfig must not error")
+	assert.Equal(t, jsonpatch.Patch{{Op: jsonpatch.OpReplace, Path: "/name", Value: []byte(`"patched"`)}}, p)
+
+	result, err := bot.RollbackConfig(1, false)
+	require.NoError(t, err, "RollbackConfig must not error")
+	assert.Equal(t, uint64(3), result.Version, "a rollback should be saved as a new version")
+	assert.Equal(t, name, bot.configToSave().Name, "pending changes should be rolled back")
+
+	p, err = bot.DiffConfig(1, 3)
+	require.NoError(t, err, "DiffConfig must not error")
+	assert.Empty(t, p, "the rolled back version should match the original")
+
+	p, err = bot.DiffConfig(0, 3)
+	require.NoError(t, err, "DiffConfig must not error")
+	assert.Empty(t, p, "the running config should match the rolled back version")
+}
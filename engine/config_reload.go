@@ -0,0 +1,650 @@
+package engine
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctlog "github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// ReloadConfig reads the config at path, or the config the engine was started
+// with when path is empty, and applies any differences to the running engine.
+// The new config is validated before anything is applied. Changes are applied
+// subsystem by subsystem and a failure rolls back those already applied. When
+// dryRun is set the changes are reported without being applied.
+func (bot *Engine) ReloadConfig(path string, dryRun bool) (*ConfigReloadResult, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	if !bot.configReloadMtx.TryLock() {
+		return nil, errConfigReloadInProgress
+	}
+	defer bot.configReloadMtx.Unlock()
+
+	if path == "" {
+		path = bot.Settings.ConfigFile
+	}
+	newCfg := &config.Config{EncryptionKeyProvider: bot.Config.EncryptionKeyProvider}
+	if err := newCfg.ReadConfigFromFile(path, true); err != nil {
+		return nil, fmt.Errorf("%w %s: %w", config.ErrFailureOpeningConfig, path, err)
+	}
+	return bot.reloadConfig(newCfg, dryRun, nil)
+}
+
+// reloadConfig validates newCfg and applies its differences to the running
+// engine. Differences which require a restart are kept so they are not lost
+// when the running config is saved on shutdown. When save is set it is called
+// once the changes are applied, and the changes are rolled back if it fails.
+// configReloadMtx must be held
+func (bot *Engine) reloadConfig(newCfg *config.Config, dryRun bool, save func() error) (*ConfigReloadResult, error) {
+	err := newCfg.CheckConfig()
+	// CheckConfig sets the global logger config, restore the running logger
+	// config until the logging changes are applied
+	if logErr := gctlog.SetGlobalLogConfig(&bot.Config.Logging); logErr != nil {
+		gctlog.Errorf(gctlog.Global, "Config reload unable to restore logger config: %v", logErr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidReloadConfig, err)
+	}
+
+	steps, result, err := bot.planConfigReload(newCfg)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errInvalidReloadConfig, err)
+	}
+	if dryRun {
+		return result, nil
+	}
+	if err := applyConfigReloadSteps(steps); err != nil {
+		return nil, err
+	}
+	if save != nil {
+		if err := save(); err != nil {
+			return nil, rollbackConfigReloadSteps(steps, err)
+		}
+	}
+	if len(steps) > 0 {
+		gctlog.Infof(gctlog.Global, "Config reloaded, applied: %v", result.Applied)
+	}
+	if err := bot.setPendingConfigChanges(newCfg); err != nil {
+		gctlog.Errorf(gctlog.Global, "Config reload unable to record changes pending a restart: %v", err)
+	}
+	if len(result.RestartRequired) > 0 {
+		gctlog.Warnf(gctlog.Global, "Config reload requires an engine restart to apply: %v", result.RestartRequired)
+	}
+	return result, nil
+}
+
+// planConfigReload compares newCfg to the running config and returns the
+// steps needed to apply the differences
+func (bot *Engine) planConfigReload(newCfg *config.Config) ([]configReloadStep, *ConfigReloadResult, error) {
+	result := &ConfigReloadResult{
+		RestartRequired: restartRequiredConfigSections(bot.Config, newCfg),
+	}
+	var steps []configReloadStep
+
+	if step := bot.loggingReloadStep(newCfg, result); step != nil {
+		steps = append(steps, *step)
+	}
+	if step := bot.communicationsReloadStep(newCfg, result); step != nil {
+		steps = append(steps, *step)
+	}
+	if step := bot.orderManagerReloadStep(newCfg, result); step != nil {
+		steps = append(steps, *step)
+	}
+	exchSteps, err := bot.exchangeReloadSteps(newCfg, result)
+	if err != nil {
+		return nil, nil, err
+	}
+	steps = append(steps, exchSteps...)
+	if step := bot.syncManagerReloadStep(newCfg, result); step != nil {
+		steps = append(steps, *step)
+	}
+
+	for i := range steps {
+		result.Applied = append(result.Applied, steps[i].name)
+	}
+	return steps, result, nil
+}
+
+// applyConfigReloadSteps applies each step in order, rolling back the steps
+// already applied in reverse order if one fails
+func applyConfigReloadSteps(steps []configReloadStep) error {
+	for i := range steps {
+		err := steps[i].apply()
+		if err == nil {
+			continue
+		}
+		return rollbackConfigReloadSteps(steps[:i], fmt.Errorf("%w: %s: %w", errConfigReloadFailed, steps[i].name, err))
+	}
+	return nil
+}
+
+// rollbackConfigReloadSteps rolls back applied steps in reverse order and
+// returns err with any rollback errors appended
+func rollbackConfigReloadSteps(steps []configReloadStep, err error) error {
+	for j := len(steps) - 1; j >= 0; j-- {
+		if rollbackErr := steps[j].rollback(); rollbackErr != nil {
+			err = common.AppendError(err, fmt.Errorf("%s rollback: %w", steps[j].name, rollbackErr))
+		}
+	}
+	return err
+}
+
+// restartRequiredConfigSections returns the config sections which differ but
+// are only read when the engine starts
+func restartRequiredConfigSections(running, newCfg *config.Config) []string {
+	sections := []struct {
+		name             string
+		running, updated any
+	}{
+		{"name", running.Name, newCfg.Name},
+		{"dataDirectory", running.DataDirectory, newCfg.DataDirectory},
+		{"globalHTTPTimeout", running.GlobalHTTPTimeout, newCfg.GlobalHTTPTimeout},
+		{"database", running.Database, newCfg.Database},
+		{"connectionMonitor", running.ConnectionMonitor, newCfg.ConnectionMonitor},
+		{"dataHistoryManager", running.DataHistoryManager, newCfg.DataHistoryManager},
+		{"currencyStateManager", running.CurrencyStateManager, newCfg.CurrencyStateManager},
+		{"futuresRiskManager", running.FuturesRiskManager, newCfg.FuturesRiskManager},
+		{"transferManager", running.TransferManager, newCfg.TransferManager},
+		{"credentialProvider", running.CredentialProvider, newCfg.CredentialProvider},
+		{"profiler", running.Profiler, newCfg.Profiler},
+		{"metricsExporter", running.MetricsExporter, newCfg.MetricsExporter},
+		{"tracing", running.Tracing, newCfg.Tracing},
+		{"healthCheck", running.HealthCheck, newCfg.HealthCheck},
+		{"ntpclient", running.NTPClient, newCfg.NTPClient},
+		{"gctscript", running.GCTScript, newCfg.GCTScript},
+		{"currencyConfig", running.Currency, newCfg.Currency},
+		{"remoteControl", running.RemoteControl, newCfg.RemoteControl},
+		{"portfolioAddresses", running.Portfolio, newCfg.Portfolio},
+		{"bankAccounts", running.BankAccounts, newCfg.BankAccounts},
+	}
+	var changed []string
+	for i := range sections {
+		if configSectionChanged(sections[i].running, sections[i].updated) {
+			changed = append(changed, sections[i].name)
+		}
+	}
+	return changed
+}
+
+// configSectionChanged reports whether two config values serialise
+// differently
+func configSectionChanged(a, b any) bool {
+	x, errA := json.Marshal(a)
+	y, errB := json.Marshal(b)
+	return errA != nil || errB != nil || !bytes.Equal(x, y)
+}
+
+// loggingReloadStep returns a step which reconfigures the global and sub
+// loggers
+func (bot *Engine) loggingReloadStep(newCfg *config.Config, result *ConfigReloadResult) *configReloadStep {
+	oldLogging, newLogging := bot.Config.Logging, newCfg.Logging
+	if !configSectionChanged(oldLogging, newLogging) {
+		return nil
+	}
+	oldEnabled := oldLogging.Enabled != nil && *oldLogging.Enabled
+	newEnabled := newLogging.Enabled != nil && *newLogging.Enabled
+	if !oldEnabled || !newEnabled {
+		result.RestartRequired = append(result.RestartRequired, "logging.enabled")
+		return nil
+	}
+	return &configReloadStep{
+		name: "logging",
+		apply: func() error {
+			if err := bot.setupLogging(&newLogging); err != nil {
+				return common.AppendError(err, bot.setupLogging(&oldLogging))
+			}
+			bot.updateConfig(func(c *config.Config) { c.Logging = newLogging })
+			return nil
+		},
+		rollback: func() error {
+			bot.updateConfig(func(c *config.Config) { c.Logging = oldLogging })
+			return bot.setupLogging(&oldLogging)
+		},
+	}
+}
+
+// setupLogging applies a logger config to the global and sub loggers
+func (bot *Engine) setupLogging(cfg *gctlog.Config) error {
+	if err := gctlog.SetGlobalLogConfig(cfg); err != nil {
+		return err
+	}
+	if err := gctlog.SetupGlobalLogger(bot.Config.Name, cfg.AdvancedSettings.StructuredLogging); err != nil {
+		return fmt.Errorf("failed to setup global logger. %w", err)
+	}
+	if err := gctlog.SetupSubLoggers(cfg.SubLoggers); err != nil {
+		return fmt.Errorf("failed to setup sub loggers. %w", err)
+	}
+	return nil
+}
+
+// communicationsReloadStep returns a step which replaces the communication
+// relayers, stopping the communications manager when none are enabled
+func (bot *Engine) communicationsReloadStep(newCfg *config.Config, result *ConfigReloadResult) *configReloadStep {
+	oldComms, newComms := bot.Config.Communications, newCfg.Communications
+	if !configSectionChanged(oldComms, newComms) {
+		return nil
+	}
+	m := bot.CommunicationsManager
+	if m == nil {
+		result.RestartRequired = append(result.RestartRequired, "communications")
+		return nil
+	}
+	wasRunning := m.IsRunning()
+	restore := func(cfg *base.CommunicationsConfig, running bool) error {
+		if cfg.IsAnyEnabled() {
+			if err := m.reload(cfg); err != nil {
+				return err
+			}
+		}
+		switch {
+		case running && !m.IsRunning():
+			return m.Start()
+		case !running && m.IsRunning():
+			return m.Stop()
+		}
+		return nil
+	}
+	return &configReloadStep{
+		name: "communications",
+		apply: func() error {
+			if err := restore(&newComms, newComms.IsAnyEnabled()); err != nil {
+				return common.AppendError(err, restore(&oldComms, wasRunning))
+			}
+			bot.updateConfig(func(c *config.Config) { c.Communications = newComms })
+			return nil
+		},
+		rollback: func() error {
+			bot.updateConfig(func(c *config.Config) { c.Communications = oldComms })
+			return restore(&oldComms, wasRunning)
+		},
+	}
+}
+
+// orderManagerReloadStep returns a step which updates the order manager
+// settings. Enabling or disabling the order manager requires a restart
+func (bot *Engine) orderManagerReloadStep(newCfg *config.Config, result *ConfigReloadResult) *configReloadStep {
+	oldOM, newOM := bot.Config.OrderManager, newCfg.OrderManager
+	if oldOM.Enabled != newOM.Enabled {
+		result.RestartRequired = append(result.RestartRequired, "orderManager.enabled")
+	}
+	newOM.Enabled = oldOM.Enabled
+	if oldOM == newOM {
+		return nil
+	}
+	set := func(cfg *config.OrderManager) error {
+		if bot.OrderManager != nil {
+			if err := bot.OrderManager.setConfig(cfg); err != nil {
+				return err
+			}
+		}
+		bot.updateConfig(func(c *config.Config) { c.OrderManager = *cfg })
+		return nil
+	}
+	return &configReloadStep{
+		name:     "orderManager",
+		apply:    func() error { return set(&newOM) },
+		rollback: func() error { return set(&oldOM) },
+	}
+}
+
+// exchangeReloadSteps returns steps which load newly enabled exchanges, unload
+// disabled exchanges and exchanges removed from the config, and update the
+// enabled pairs, assets and subscriptions of loaded exchanges
+func (bot *Engine) exchangeReloadSteps(newCfg *config.Config, result *ConfigReloadResult) ([]configReloadStep, error) {
+	var steps []configReloadStep
+	for i := range bot.Config.Exchanges {
+		name := bot.Config.Exchanges[i].Name
+		if _, err := newCfg.GetExchangeConfig(name); err == nil {
+			continue
+		}
+		if _, err := bot.ExchangeManager.GetExchangeByName(name); err != nil {
+			continue
+		}
+		steps = append(steps, configReloadStep{
+			name:     "exchanges." + name + ".removed",
+			apply:    func() error { return bot.UnloadExchange(name) },
+			rollback: func() error { return bot.LoadExchange(name) },
+		})
+	}
+	for i := range newCfg.Exchanges {
+		newExch := newCfg.Exchanges[i]
+		exchCfg, err := bot.Config.GetExchangeConfig(newExch.Name)
+		if err != nil {
+			result.RestartRequired = append(result.RestartRequired, "exchanges."+newExch.Name)
+			continue
+		}
+		name := exchCfg.Name
+		exch, err := bot.ExchangeManager.GetExchangeByName(name)
+		loaded := err == nil
+		switch {
+		case loaded && !newExch.Enabled:
+			steps = append(steps, configReloadStep{
+				name:     "exchanges." + name + ".disabled",
+				apply:    func() error { return bot.UnloadExchange(name) },
+				rollback: func() error { return bot.LoadExchange(name) },
+			})
+		case !loaded && newExch.Enabled:
+			oldExch := *exchCfg
+			steps = append(steps, configReloadStep{
+				name: "exchanges." + name + ".enabled",
+				apply: func() error {
+					bot.updateConfig(func(*config.Config) { *exchCfg = newExch })
+					err := bot.LoadExchange(name)
+					if err == nil {
+						return nil
+					}
+					if _, getErr := bot.ExchangeManager.GetExchangeByName(name); getErr == nil {
+						err = common.AppendError(err, bot.ExchangeManager.RemoveExchange(name))
+					}
+					bot.updateConfig(func(*config.Config) { *exchCfg = oldExch })
+					return err
+				},
+				rollback: func() error {
+					err := bot.UnloadExchange(name)
+					bot.updateConfig(func(*config.Config) { *exchCfg = oldExch })
+					return err
+				},
+			})
+		case loaded:
+			step, err := exchangeSettingsReloadStep(exch, exchCfg, &newExch)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			if step != nil {
+				steps = append(steps, *step)
+			}
+			fields, err := exchangeRestartRequiredFields(exchCfg, &newExch)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			for _, f := range fields {
+				result.RestartRequired = append(result.RestartRequired, "exchanges."+name+"."+f)
+			}
+		}
+	}
+	return steps, nil
+}
+
+// exchangeSettingsReloadStep returns a step which updates the enabled pairs,
+// assets and subscriptions of a loaded exchange and refreshes its websocket
+// subscriptions. New enabled pairs must already be available on the exchange
+func exchangeSettingsReloadStep(exch exchange.IBotExchange, exchCfg, newExch *config.Exchange) (*configReloadStep, error) {
+	b := exch.GetBase()
+	if b == nil {
+		return nil, errExchangeBaseNotFound
+	}
+	if exchCfg.CurrencyPairs == nil || newExch.CurrencyPairs == nil {
+		return nil, nil
+	}
+	oldSettings := exchangeReloadSettings{
+		pairs:        make(map[asset.Item]currency.Pairs),
+		assetEnabled: make(map[asset.Item]bool),
+	}
+	newSettings := exchangeReloadSettings{
+		pairs:        make(map[asset.Item]currency.Pairs),
+		assetEnabled: make(map[asset.Item]bool),
+	}
+	for _, a := range newExch.CurrencyPairs.GetAssetTypes(false) {
+		if !b.CurrencyPairs.IsAssetSupported(a) {
+			continue
+		}
+		oldPairs, err := b.CurrencyPairs.GetPairs(a, true)
+		if err != nil {
+			return nil, err
+		}
+		newPairs, err := newExch.CurrencyPairs.GetPairs(a, true)
+		if err != nil {
+			return nil, err
+		}
+		oldEnabled := b.CurrencyPairs.IsAssetEnabled(a) == nil
+		newEnabled := newExch.CurrencyPairs.IsAssetEnabled(a) == nil
+		if oldPairs.Equal(newPairs) && oldEnabled == newEnabled {
+			continue
+		}
+		if len(newPairs) != 0 {
+			available, err := b.CurrencyPairs.GetPairs(a, false)
+			if err != nil {
+				return nil, err
+			}
+			if err := available.ContainsAll(newPairs, true); err != nil {
+				return nil, fmt.Errorf("%w: %s %w", errPairsNotAvailable, a, err)
+			}
+		}
+		oldSettings.pairs[a], newSettings.pairs[a] = oldPairs, newPairs
+		oldSettings.assetEnabled[a], newSettings.assetEnabled[a] = oldEnabled, newEnabled
+	}
+	// An empty subscription list is populated with the exchange defaults on
+	// setup, so is not treated as a change
+	if exchCfg.Features != nil && newExch.Features != nil && len(newExch.Features.Subscriptions) != 0 &&
+		configSectionChanged(exchCfg.Features.Subscriptions, newExch.Features.Subscriptions) {
+		oldSettings.subscriptions = exchCfg.Features.Subscriptions.Clone()
+		newSettings.subscriptions = newExch.Features.Subscriptions.Clone()
+	}
+	if len(newSettings.pairs) == 0 && newSettings.subscriptions == nil {
+		return nil, nil
+	}
+	return &configReloadStep{
+		name: "exchanges." + exchCfg.Name,
+		apply: func() error {
+			if err := applyExchangeReloadSettings(exch, exchCfg, &newSettings); err != nil {
+				return common.AppendError(err, applyExchangeReloadSettings(exch, exchCfg, &oldSettings))
+			}
+			return nil
+		},
+		rollback: func() error { return applyExchangeReloadSettings(exch, exchCfg, &oldSettings) },
+	}, nil
+}
+
+// exchangeRestartRequiredFields returns the config fields of a loaded exchange
+// which differ but are not applied on reload. The enabled pairs, enabled
+// assets and subscriptions are applied by exchangeSettingsReloadStep, and the
+// available pairs are maintained by the exchange
+func exchangeRestartRequiredFields(exchCfg, newExch *config.Exchange) ([]string, error) {
+	running, err := exchangeConfigFields(exchCfg)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := exchangeConfigFields(newExch)
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for k, v := range running {
+		if u, ok := updated[k]; !ok || configSectionChanged(v, u) {
+			changed = append(changed, k)
+		}
+	}
+	for k := range updated {
+		if _, ok := running[k]; !ok {
+			changed = append(changed, k)
+		}
+	}
+	slices.Sort(changed)
+	return changed, nil
+}
+
+// exchangeConfigFields returns the leaf fields of an exchange config keyed by
+// their dotted JSON path, excluding the fields applied on reload
+func exchangeConfigFields(e *config.Exchange) (map[string]any, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	var tree map[string]any
+	if err := json.Unmarshal(b, &tree); err != nil {
+		return nil, err
+	}
+	delete(tree, "enabled")
+	if features, ok := tree["features"].(map[string]any); ok {
+		delete(features, "subscriptions")
+	}
+	if pairs, ok := tree["currencyPairs"].(map[string]any); ok {
+		delete(pairs, "lastUpdated")
+		if stores, ok := pairs["pairs"].(map[string]any); ok {
+			for _, v := range stores {
+				if store, ok := v.(map[string]any); ok {
+					delete(store, "assetEnabled")
+					delete(store, "enabled")
+					delete(store, "available")
+				}
+			}
+		}
+	}
+	fields := make(map[string]any)
+	flattenConfigFields("", tree, fields)
+	return fields, nil
+}
+
+// flattenConfigFields stores each leaf value of a decoded JSON object in
+// fields keyed by its dotted path. Empty objects have no leaves so are
+// equivalent to omitted ones
+func flattenConfigFields(prefix string, v any, fields map[string]any) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		fields[prefix] = v
+		return
+	}
+	for k, child := range m {
+		if prefix != "" {
+			k = prefix + "." + k
+		}
+		flattenConfigFields(k, child, fields)
+	}
+}
+
+// applyExchangeReloadSettings stores the settings in both the exchange and its
+// config then flushes the websocket subscriptions
+func applyExchangeReloadSettings(exch exchange.IBotExchange, exchCfg *config.Exchange, s *exchangeReloadSettings) error {
+	b := exch.GetBase()
+	for _, a := range slices.Collect(maps.Keys(s.pairs)) {
+		for _, pm := range []*currency.PairsManager{exchCfg.CurrencyPairs, &b.CurrencyPairs} {
+			if err := pm.StorePairs(a, s.pairs[a], true); err != nil {
+				return err
+			}
+			if err := pm.SetAssetEnabled(a, s.assetEnabled[a]); err != nil {
+				return err
+			}
+		}
+	}
+	if s.subscriptions != nil {
+		exchCfg.Features.Subscriptions = s.subscriptions.Clone()
+		b.SetSubscriptionsFromConfig()
+	}
+	if exch.IsWebsocketEnabled() && b.Websocket != nil && b.Websocket.IsConnected() {
+		return exch.FlushWebsocketChannels()
+	}
+	return nil
+}
+
+// syncManagerReloadStep returns a step which replaces a running sync manager
+// with one using the new settings. Enabling or disabling the sync manager
+// requires a restart
+func (bot *Engine) syncManagerReloadStep(newCfg *config.Config, result *ConfigReloadResult) *configReloadStep {
+	oldSync, newSync := bot.Config.SyncManagerConfig, newCfg.SyncManagerConfig
+	if oldSync.Enabled != newSync.Enabled {
+		result.RestartRequired = append(result.RestartRequired, "syncManager.enabled")
+	}
+	newSync.Enabled = oldSync.Enabled
+	if !configSectionChanged(oldSync, newSync) {
+		return nil
+	}
+	oldSettings := bot.Settings.ExchangeSyncerSettings
+	newSettings := oldSettings
+	newSettings.EnableTickerSyncing = newSync.SynchronizeTicker
+	newSettings.EnableOrderbookSyncing = newSync.SynchronizeOrderbook
+	newSettings.EnableTradeSyncing = newSync.SynchronizeTrades
+	newSettings.SyncContinuously = newSync.SynchronizeContinuously
+	oldSyncer := bot.currencyPairSyncer
+	wasRunning := oldSyncer.IsRunning()
+	return &configReloadStep{
+		name: "syncManager",
+		apply: func() error {
+			bot.updateConfig(func(c *config.Config) {
+				c.SyncManagerConfig = newSync
+				bot.Settings.ExchangeSyncerSettings = newSettings
+			})
+			if !wasRunning {
+				return nil
+			}
+			if err := bot.replaceSyncManager(oldSyncer); err != nil {
+				bot.updateConfig(func(c *config.Config) {
+					c.SyncManagerConfig = oldSync
+					bot.Settings.ExchangeSyncerSettings = oldSettings
+				})
+				return err
+			}
+			return nil
+		},
+		rollback: func() error {
+			bot.updateConfig(func(c *config.Config) {
+				c.SyncManagerConfig = oldSync
+				bot.Settings.ExchangeSyncerSettings = oldSettings
+			})
+			if !wasRunning {
+				return nil
+			}
+			if err := bot.currencyPairSyncer.Stop(); err != nil {
+				return err
+			}
+			if err := oldSyncer.Start(); err != nil {
+				return err
+			}
+			bot.currencyPairSyncer = oldSyncer
+			if bot.WebsocketRoutineManager == nil {
+				return nil
+			}
+			return bot.WebsocketRoutineManager.setCurrencyPairSyncer(oldSyncer)
+		},
+	}
+}
+
+// replaceSyncManager stops the running sync manager and starts a new one
+// using the current config, restarting the old one if the new one fails
+func (bot *Engine) replaceSyncManager(old *SyncManager) error {
+	cfg := bot.syncManagerConfig()
+	s, err := SetupSyncManager(&cfg, bot.ExchangeManager, &bot.Config.RemoteControl, bot.Settings.EnableWebsocketRoutine)
+	if err != nil {
+		return err
+	}
+	if err := old.Stop(); err != nil {
+		return err
+	}
+	if err := s.Start(); err != nil {
+		return common.AppendError(err, old.Start())
+	}
+	bot.currencyPairSyncer = s
+	if bot.WebsocketRoutineManager == nil {
+		return nil
+	}
+	return bot.WebsocketRoutineManager.setCurrencyPairSyncer(s)
+}
+
+// updateConfig applies fn to the running config while holding the config lock,
+// so readers outside of a config reload do not observe a partial update
+func (bot *Engine) updateConfig(fn func(*config.Config)) {
+	bot.configMtx.Lock()
+	defer bot.configMtx.Unlock()
+	fn(bot.Config)
+}
+
+// runningConfigJSON returns the running config, with its credentials
+// redacted, serialised while holding the config lock
+func (bot *Engine) runningConfigJSON() ([]byte, error) {
+	bot.configMtx.RLock()
+	cfg, err := bot.Config.Redacted()
+	bot.configMtx.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(cfg)
+}
@@ -0,0 +1,238 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestReloadConfig(t *testing.T) {
+	t.Parallel()
+	var bot *Engine
+	_, err := bot.ReloadConfig("", false)
+	require.ErrorIs(t, err, errNilBot)
+
+	cfg := &config.Config{}
+	require.NoError(t, cfg.LoadConfig(config.TestFile, true), "LoadConfig must not error")
+	bot = &Engine{Config: cfg, ExchangeManager: NewExchangeManager()}
+
+	bot.configReloadMtx.Lock()
+	_, err = bot.ReloadConfig(config.TestFile, true)
+	require.ErrorIs(t, err, errConfigReloadInProgress)
+	bot.configReloadMtx.Unlock()
+
+	_, err = bot.ReloadConfig("missing.json", true)
+	require.ErrorIs(t, err, config.ErrFailureOpeningConfig)
+
+	result, err := bot.ReloadConfig(config.TestFile, true)
+	require.NoError(t, err, "ReloadConfig must not error")
+	assert.Contains(t, result.Applied, "exchanges.Bitstamp.enabled", "enabled exchanges which are not loaded should be loaded")
+	assert.Empty(t, result.RestartRequired, "an unchanged config should not require a restart")
+	_, err = bot.ExchangeManager.GetExchangeByName(testExchange)
+	assert.ErrorIs(t, err, ErrExchangeNotFound, "a dry run should not load exchanges")
+}
+
+func TestApplyConfigReloadSteps(t *testing.T) {
+	t.Parallel()
+	var applied, rolledBack []string
+	step := func(name string, applyErr, rollbackErr error) configReloadStep {
+		return configReloadStep{
+			name: name,
+			apply: func() error {
+				if applyErr == nil {
+					applied = append(applied, name)
+				}
+				return applyErr
+			},
+			rollback: func() error {
+				rolledBack = append(rolledBack, name)
+				return rollbackErr
+			},
+		}
+	}
+	require.NoError(t, applyConfigReloadSteps([]configReloadStep{step("a", nil, nil), step("b", nil, nil)}), "applyConfigReloadSteps must not error")
+	assert.Equal(t, []string{"a", "b"}, applied, "all steps should be applied in order")
+	assert.Empty(t, rolledBack, "no steps should be rolled back")
+
+	applied, rolledBack = nil, nil
+	errApply, errRollback := errors.New("apply"), errors.New("rollback")
+	err := applyConfigReloadSteps([]configReloadStep{
+		step("a", nil, errRollback),
+		step("b", nil, nil),
+		step("c", errApply, nil),
+		step("d", nil, nil),
+	})
+	require.ErrorIs(t, err, errConfigReloadFailed)
+	require.ErrorIs(t, err, errApply)
+	require.ErrorIs(t, err, errRollback)
+	assert.Equal(t, []string{"a", "b"}, applied, "steps after the failed step should not be applied")
+	assert.Equal(t, []string{"b", "a"}, rolledBack, "applied steps should be rolled back in reverse order")
+}
+
+func TestRestartRequiredConfigSections(t *testing.T) {
+	t.Parallel()
+	running := &config.Config{Name: "gct"}
+	newCfg := &config.Config{Name: "gct"}
+	assert.Empty(t, restartRequiredConfigSections(running, newCfg), "unchanged sections should not require a restart")
+
+	newCfg.Database.Enabled = true
+	newCfg.RemoteControl.GRPC.ListenAddress = "localhost:9053"
+	assert.Equal(t, []string{"database", "remoteControl"}, restartRequiredConfigSections(running, newCfg))
+}
+
+func TestOrderManagerReloadStep(t *testing.T) {
+	t.Parallel()
+	var wg sync.WaitGroup
+	om, err := SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &wg, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	bot := &Engine{Config: &config.Config{}, OrderManager: om}
+
+	result := &ConfigReloadResult{}
+	assert.Nil(t, bot.orderManagerReloadStep(&config.Config{}, result), "an unchanged config should not return a step")
+
+	newCfg := &config.Config{OrderManager: config.OrderManager{Enabled: true, Verbose: true, CancelOrdersOnShutdown: true}}
+	step := bot.orderManagerReloadStep(newCfg, result)
+	require.NotNil(t, step, "orderManagerReloadStep must return a step")
+	assert.Equal(t, []string{"orderManager.enabled"}, result.RestartRequired)
+
+	require.NoError(t, step.apply(), "apply must not error")
+	assert.True(t, om.verbose.Load(), "verbose should be applied")
+	assert.True(t, om.cancelOrdersOnShutdown.Load(), "cancelOrdersOnShutdown should be applied")
+	assert.False(t, bot.Config.OrderManager.Enabled, "enabled should not be applied")
+
+	require.NoError(t, step.rollback(), "rollback must not error")
+	assert.False(t, om.verbose.Load(), "verbose should be rolled back")
+	assert.False(t, bot.Config.OrderManager.CancelOrdersOnShutdown, "config should be rolled back")
+
+	newCfg.OrderManager = config.OrderManager{ActivelyTrackFuturesPositions: true}
+	step = bot.orderManagerReloadStep(newCfg, result)
+	require.NotNil(t, step, "orderManagerReloadStep must return a step")
+	require.ErrorIs(t, step.apply(), errInvalidFuturesTrackingSeekDuration)
+}
+
+func TestExchangeSettingsReloadStep(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+
+	btcusd := currency.NewPair(currency.BTC, currency.USD)
+	ethusd := currency.NewPair(currency.ETH, currency.USD)
+	ltcusd := currency.NewPair(currency.LTC, currency.USD)
+	available := currency.Pairs{btcusd, ethusd}
+	newPairsManager := func(enabled ...currency.Pair) *currency.PairsManager {
+		pm := &currency.PairsManager{}
+		require.NoError(t, pm.StorePairs(asset.Spot, available, false), "StorePairs must not error")
+		require.NoError(t, pm.StorePairs(asset.Spot, enabled, true), "StorePairs must not error")
+		return pm
+	}
+	b := exch.GetBase()
+	b.CurrencyPairs.Load(newPairsManager(btcusd))
+	exchCfg := &config.Exchange{Name: testExchange, CurrencyPairs: newPairsManager(btcusd)}
+
+	step, err := exchangeSettingsReloadStep(exch, exchCfg, &config.Exchange{CurrencyPairs: newPairsManager(btcusd)})
+	require.NoError(t, err, "exchangeSettingsReloadStep must not error")
+	assert.Nil(t, step, "unchanged pairs should not return a step")
+
+	step, err = exchangeSettingsReloadStep(exch, exchCfg, &config.Exchange{CurrencyPairs: newPairsManager(btcusd, ethusd)})
+	require.NoError(t, err, "exchangeSettingsReloadStep must not error")
+	require.NotNil(t, step, "exchangeSettingsReloadStep must return a step")
+
+	require.NoError(t, step.apply(), "apply must not error")
+	for _, pm := range []*currency.PairsManager{&b.CurrencyPairs, exchCfg.CurrencyPairs} {
+		enabled, err := pm.GetPairs(asset.Spot, true)
+		require.NoError(t, err, "GetPairs must not error")
+		assert.True(t, enabled.Equal(currency.Pairs{btcusd, ethusd}), "enabled pairs should be updated")
+	}
+
+	require.NoError(t, step.rollback(), "rollback must not error")
+	for _, pm := range []*currency.PairsManager{&b.CurrencyPairs, exchCfg.CurrencyPairs} {
+		enabled, err := pm.GetPairs(asset.Spot, true)
+		require.NoError(t, err, "GetPairs must not error")
+		assert.True(t, enabled.Equal(currency.Pairs{btcusd}), "enabled pairs should be rolled back")
+	}
+
+	unavailable := &currency.PairsManager{}
+	require.NoError(t, unavailable.StorePairs(asset.Spot, currency.Pairs{ltcusd}, false), "StorePairs must not error")
+	require.NoError(t, unavailable.StorePairs(asset.Spot, currency.Pairs{ltcusd}, true), "StorePairs must not error")
+	_, err = exchangeSettingsReloadStep(exch, exchCfg, &config.Exchange{CurrencyPairs: unavailable})
+	assert.ErrorIs(t, err, errPairsNotAvailable)
+}
+
+func TestExchangeReloadStepsRemovedExchange(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	bot := &Engine{
+		Config:          &config.Config{Exchanges: []config.Exchange{{Name: testExchange, Enabled: true}}},
+		ExchangeManager: em,
+	}
+
+	result := &ConfigReloadResult{}
+	steps, err := bot.exchangeReloadSteps(&config.Config{}, result)
+	require.NoError(t, err, "exchangeReloadSteps must not error")
+	require.Len(t, steps, 1, "exchangeReloadSteps must return a step for the removed exchange")
+	assert.Equal(t, "exchanges."+testExchange+".removed", steps[0].name)
+	assert.Empty(t, result.RestartRequired, "removing an exchange should not require a restart")
+
+	require.NoError(t, steps[0].apply(), "apply must not error")
+	_, err = em.GetExchangeByName(testExchange)
+	assert.ErrorIs(t, err, ErrExchangeNotFound, "the removed exchange should be unloaded")
+	assert.False(t, bot.Config.Exchanges[0].Enabled, "the removed exchange should be disabled in the running config")
+
+	steps, err = bot.exchangeReloadSteps(&config.Config{}, result)
+	require.NoError(t, err, "exchangeReloadSteps must not error")
+	assert.Empty(t, steps, "removed exchanges which are not loaded should not return a step")
+}
+
+func TestExchangeReloadStepsRestartRequired(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	require.NoError(t, em.Add(exch), "Add must not error")
+
+	btcusd := currency.NewPair(currency.BTC, currency.USD)
+	ethusd := currency.NewPair(currency.ETH, currency.USD)
+	newExchangeConfig := func(enabled ...currency.Pair) config.Exchange {
+		pm := &currency.PairsManager{}
+		require.NoError(t, pm.StorePairs(asset.Spot, currency.Pairs{btcusd, ethusd}, false), "StorePairs must not error")
+		require.NoError(t, pm.StorePairs(asset.Spot, enabled, true), "StorePairs must not error")
+		return config.Exchange{Name: testExchange, Enabled: true, HTTPTimeout: time.Second, CurrencyPairs: pm, Features: &config.FeaturesConfig{}}
+	}
+	exch.GetBase().CurrencyPairs.Load(newExchangeConfig(btcusd).CurrencyPairs)
+	bot := &Engine{Config: &config.Config{Exchanges: []config.Exchange{newExchangeConfig(btcusd)}}, ExchangeManager: em}
+
+	result := &ConfigReloadResult{}
+	steps, err := bot.exchangeReloadSteps(&config.Config{Exchanges: []config.Exchange{newExchangeConfig(btcusd)}}, result)
+	require.NoError(t, err, "exchangeReloadSteps must not error")
+	assert.Empty(t, steps, "an unchanged exchange should not return a step")
+	assert.Empty(t, result.RestartRequired, "an unchanged exchange should not require a restart")
+
+	newExch := newExchangeConfig(btcusd, ethusd)
+	newExch.Verbose = true
+	newExch.HTTPTimeout = time.Minute
+	newExch.API.Credentials.Key = "key"
+	newExch.Features.Enabled.Websocket = true
+	steps, err = bot.exchangeReloadSteps(&config.Config{Exchanges: []config.Exchange{newExch}}, result)
+	require.NoError(t, err, "exchangeReloadSteps must not error")
+	require.Len(t, steps, 1, "exchangeReloadSteps must return a step for the enabled pairs")
+	assert.Equal(t, []string{
+		"exchanges." + testExchange + ".api.credentials.key",
+		"exchanges." + testExchange + ".features.enabled.websocketAPI",
+		"exchanges." + testExchange + ".httpTimeout",
+		"exchanges." + testExchange + ".verbose",
+	}, result.RestartRequired, "unapplied exchange changes should require a restart")
+}
@@ -0,0 +1,43 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+)
+
+var (
+	errConfigReloadInProgress = errors.New("config reload already in progress")
+	errInvalidReloadConfig    = errors.New("reloaded config is invalid")
+	errConfigReloadFailed     = errors.New("config reload failed, applied changes have been rolled back")
+	errPairsNotAvailable      = errors.New("enabled pairs are not available on the exchange")
+	errConfigSaveFailed       = errors.New("config could not be saved")
+)
+
+// ConfigReloadResult lists the config sections applied to the running engine
+// by a reload and those which differ from the running config but can only be
+// applied by restarting the engine. Version is the config history version
+// saved by the change, if any
+type ConfigReloadResult struct {
+	Applied         []string
+	RestartRequired []string
+	Version         uint64
+}
+
+// configReloadStep applies a single change to the running engine along with
+// the means to undo it. A failed apply must not leave the change half applied
+type configReloadStep struct {
+	name     string
+	apply    func() error
+	rollback func() error
+}
+
+// exchangeReloadSettings holds the exchange settings which can be changed
+// without reloading the exchange
+type exchangeReloadSettings struct {
+	pairs         map[asset.Item]currency.Pairs
+	assetEnabled  map[asset.Item]bool
+	subscriptions subscription.List
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync/atomic"
 
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/connchecker"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -20,6 +21,7 @@ type connectionManager struct {
 	started int32
 	conn    *connchecker.Checker
 	cfg     *config.ConnectionMonitorConfig
+	comms   iCommsManager
 }
 
 // IsRunning safely checks whether the subsystem is running
@@ -31,7 +33,7 @@ func (m *connectionManager) IsRunning() bool {
 }
 
 // setupConnectionManager creates a connection manager
-func setupConnectionManager(cfg *config.ConnectionMonitorConfig) (*connectionManager, error) {
+func setupConnectionManager(cfg *config.ConnectionMonitorConfig, comms iCommsManager) (*connectionManager, error) {
 	if cfg == nil {
 		return nil, errNilConfig
 	}
@@ -45,7 +47,8 @@ func setupConnectionManager(cfg *config.ConnectionMonitorConfig) (*connectionMan
 		cfg.CheckInterval = connchecker.DefaultCheckInterval
 	}
 	return &connectionManager{
-		cfg: cfg,
+		cfg:   cfg,
+		comms: comms,
 	}, nil
 }
 
@@ -67,6 +70,7 @@ func (m *connectionManager) Start() error {
 		atomic.CompareAndSwapInt32(&m.started, 1, 0)
 		return err
 	}
+	m.conn.SetStatusChangeHandler(m.notifyStatusChange)
 
 	log.Debugln(log.ConnectionMgr, "Connection manager started.")
 	return nil
@@ -104,3 +108,22 @@ func (m *connectionManager) IsOnline() bool {
 
 	return m.conn.IsConnected()
 }
+
+// notifyStatusChange pushes a connectivity notification to the communications
+// manager
+func (m *connectionManager) notifyStatusChange(connected bool) {
+	if m.comms == nil {
+		return
+	}
+	evt := base.Event{
+		Category: base.CategoryConnectivity,
+		Severity: base.SeverityCritical,
+		Message:  connchecker.ConnLost,
+		Payload:  map[string]any{"connected": connected},
+	}
+	if connected {
+		evt.Severity = base.SeverityInfo
+		evt.Message = connchecker.ConnRe
+	}
+	m.comms.PushEvent(evt)
+}
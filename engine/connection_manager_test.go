@@ -9,10 +9,10 @@ import (
 
 func TestSetupConnectionManager(t *testing.T) {
 	t.Parallel()
-	_, err := setupConnectionManager(nil)
+	_, err := setupConnectionManager(nil, nil)
 	assert.ErrorIs(t, err, errNilConfig)
 
-	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{})
+	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{}, nil)
 	assert.NoError(t, err)
 
 	if m == nil {
@@ -22,7 +22,7 @@ func TestSetupConnectionManager(t *testing.T) {
 
 func TestConnectionMonitorIsRunning(t *testing.T) {
 	t.Parallel()
-	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{})
+	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{}, nil)
 	assert.NoError(t, err)
 
 	err = m.Start()
@@ -43,7 +43,7 @@ func TestConnectionMonitorIsRunning(t *testing.T) {
 
 func TestConnectionMonitorStart(t *testing.T) {
 	t.Parallel()
-	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{})
+	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{}, nil)
 	assert.NoError(t, err)
 
 	err = m.Start()
@@ -62,7 +62,7 @@ func TestConnectionMonitorStop(t *testing.T) {
 	err := (&connectionManager{started: 1}).Stop()
 	assert.ErrorIs(t, err, errConnectionCheckerIsNil)
 
-	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{})
+	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{}, nil)
 	assert.NoError(t, err)
 
 	err = m.Start()
@@ -81,7 +81,7 @@ func TestConnectionMonitorStop(t *testing.T) {
 
 func TestConnectionMonitorIsOnline(t *testing.T) {
 	t.Parallel()
-	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{})
+	m, err := setupConnectionManager(&config.ConnectionMonitorConfig{}, nil)
 	assert.NoError(t, err)
 
 	err = m.Start()
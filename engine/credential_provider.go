@@ -0,0 +1,170 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	gctlog "github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errCredentialProviderNotSetup = errors.New("credential provider is not setup")
+	errCredentialProviderUnused   = errors.New("exchange does not use the credential provider")
+	errNotKeystoreProvider        = errors.New("credential provider is not a keystore")
+)
+
+// setupCredentialProvider creates the credential provider exchanges with
+// useCredentialProvider set fetch their API credentials from, and unlocks the
+// keystore keys they require
+func (bot *Engine) setupCredentialProvider() error {
+	if bot.Config.CredentialProvider.Type == "" {
+		return nil
+	}
+	p, err := secrets.New(&bot.Config.CredentialProvider, bot.Settings.DataDir)
+	if err != nil {
+		return fmt.Errorf("unable to setup credential provider: %w", err)
+	}
+	bot.credentialProvider = p
+	gctlog.Debugf(gctlog.Global, "Using %s credential provider\n", bot.Config.CredentialProvider.Type)
+	if k, ok := p.Provider().(*secrets.Keystore); ok {
+		bot.unlockKeystore(k)
+	}
+	return nil
+}
+
+// unlockKeystore unlocks the default and named account keys of each enabled
+// exchange using the credential provider. Keys which cannot be unlocked are
+// left locked and can be unlocked at runtime.
+func (bot *Engine) unlockKeystore(k *secrets.Keystore) {
+	for _, exchCfg := range bot.Config.GetAllExchangeConfigs() {
+		if !exchCfg.API.UseCredentialProvider || (!exchCfg.Enabled && !bot.Settings.EnableAllExchanges) {
+			continue
+		}
+		names := []string{""}
+		for i := range exchCfg.API.Accounts {
+			names = append(names, exchCfg.API.Accounts[i].Name)
+		}
+		for _, account := range names {
+			passphrase, err := bot.getKeystorePassphrase(secrets.Path(exchCfg.Name, account))
+			if err == nil {
+				err = k.Unlock(exchCfg.Name, account, passphrase)
+			}
+			if err != nil {
+				gctlog.Warnf(gctlog.ExchangeSys, "%s unable to unlock keystore %s: %v", exchCfg.Name, accountDescription(account), err)
+			}
+		}
+	}
+}
+
+// attachCredentialProvider sets the credential provider on an exchange
+func (bot *Engine) attachCredentialProvider(exch exchange.IBotExchange, exchCfg *config.Exchange) error {
+	if bot.credentialProvider == nil {
+		return fmt.Errorf("%s %w", exchCfg.Name, errCredentialProviderNotSetup)
+	}
+	exch.GetBase().SetCredentialProvider(bot.credentialProvider)
+	return nil
+}
+
+// getKeystorePassphrase returns the keystore passphrase from the configured
+// environment variable, otherwise it is prompted for
+func (bot *Engine) getKeystorePassphrase(path string) ([]byte, error) {
+	if env := bot.Config.CredentialProvider.Keystore.PassphraseEnvVar; env != "" {
+		if p := os.Getenv(env); p != "" {
+			return []byte(p), nil
+		}
+	}
+	return config.PromptForSecret("Please enter keystore passphrase for " + path + ": ")
+}
+
+// UnlockCredentials unlocks an exchange account's keystore key at runtime and
+// re-enables authenticated support if its credentials are valid
+func (bot *Engine) UnlockCredentials(ctx context.Context, exchName, account string, passphrase []byte) error {
+	exch, k, err := bot.getCredentialProviderExchange(exchName)
+	if err != nil {
+		return err
+	}
+	if k == nil {
+		return errNotKeystoreProvider
+	}
+	if err := k.Unlock(exch.GetName(), account, passphrase); err != nil {
+		return err
+	}
+	return bot.reloadExchangeCredentials(ctx, exch)
+}
+
+// ReloadCredentials discards cached credentials so that rotated credentials
+// are fetched from the credential provider. An empty exchange name reloads
+// all exchanges using the provider.
+func (bot *Engine) ReloadCredentials(ctx context.Context, exchName string) error {
+	if bot.credentialProvider == nil {
+		return errCredentialProviderNotSetup
+	}
+	if exchName != "" {
+		exch, _, err := bot.getCredentialProviderExchange(exchName)
+		if err != nil {
+			return err
+		}
+		return bot.reloadExchangeCredentials(ctx, exch)
+	}
+	exchanges, err := bot.ExchangeManager.GetExchanges()
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, exch := range exchanges {
+		if exchCfg, err := bot.Config.GetExchangeConfig(exch.GetName()); err == nil && exchCfg.API.UseCredentialProvider {
+			errs = errors.Join(errs, bot.reloadExchangeCredentials(ctx, exch))
+		}
+	}
+	return errs
+}
+
+func (bot *Engine) getCredentialProviderExchange(exchName string) (exchange.IBotExchange, *secrets.Keystore, error) {
+	if bot.credentialProvider == nil {
+		return nil, nil, errCredentialProviderNotSetup
+	}
+	exch, err := bot.GetExchangeByName(exchName)
+	if err != nil {
+		return nil, nil, err
+	}
+	exchCfg, err := bot.Config.GetExchangeConfig(exch.GetName())
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exchCfg.API.UseCredentialProvider {
+		return nil, nil, fmt.Errorf("%s %w", exch.GetName(), errCredentialProviderUnused)
+	}
+	k, _ := bot.credentialProvider.Provider().(*secrets.Keystore)
+	return exch, k, nil
+}
+
+// reloadExchangeCredentials discards an exchange's cached credentials and
+// revalidates them, restoring the configured authenticated support when valid
+func (bot *Engine) reloadExchangeCredentials(ctx context.Context, exch exchange.IBotExchange) error {
+	bot.credentialProvider.Invalidate(exch.GetName())
+	exchCfg, err := bot.Config.GetExchangeConfig(exch.GetName())
+	if err != nil {
+		return err
+	}
+	if !exchCfg.API.AuthenticatedSupport && !exchCfg.API.AuthenticatedWebsocketSupport {
+		return nil
+	}
+	b := exch.GetBase()
+	b.SetAuthenticatedSupport(exchCfg.API.AuthenticatedSupport, exchCfg.API.AuthenticatedWebsocketSupport)
+	if err := exch.ValidateAPICredentials(ctx, preferredCredentialAsset(b)); err != nil {
+		b.SetAuthenticatedSupport(false, false)
+		if b.Websocket != nil {
+			b.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		}
+		return fmt.Errorf("%s error validating credentials: %w", exch.GetName(), err)
+	}
+	if b.Websocket != nil && b.IsWebsocketAuthenticationSupported() {
+		b.Websocket.SetCanUseAuthenticatedEndpoints(true)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
+)
+
+func TestCredentialProvider(t *testing.T) {
+	t.Setenv("GCT_TEST_KEYSTORE_PASSPHRASE", "pass")
+	dir := t.TempDir()
+	k, err := secrets.NewKeystore(dir + "/" + secrets.DefaultKeystoreFile)
+	require.NoError(t, err)
+	require.NoError(t, k.Set(testExchange, "", []byte("pass"), &secrets.Secret{Key: "k1", Secret: "s1", ClientID: "c1"}))
+	require.NoError(t, k.Set(testExchange, "hedge", []byte("other"), &secrets.Secret{Key: "k2", Secret: "s2", ClientID: "c2"}))
+
+	bot := &Engine{
+		Settings: Settings{DataDir: dir},
+		Config: &config.Config{
+			CredentialProvider: config.CredentialProvider{
+				Type:     config.CredentialProviderKeystore,
+				Keystore: config.KeystoreCredentialProvider{PassphraseEnvVar: "GCT_TEST_KEYSTORE_PASSPHRASE"},
+			},
+			Exchanges: []config.Exchange{
+				{
+					Name:    testExchange,
+					Enabled: true,
+					API: config.APIConfig{
+						UseCredentialProvider: true,
+						Accounts:              []config.APIAccountConfig{{Name: "hedge"}},
+					},
+				},
+			},
+		},
+		ExchangeManager: NewExchangeManager(),
+	}
+	assert.ErrorIs(t, bot.ReloadCredentials(t.Context(), ""), errCredentialProviderNotSetup)
+
+	require.NoError(t, bot.setupCredentialProvider())
+	require.NotNil(t, bot.credentialProvider)
+	provider, ok := bot.credentialProvider.Provider().(*secrets.Keystore)
+	require.True(t, ok, "Provider must be a keystore")
+	assert.True(t, provider.IsUnlocked(testExchange, ""), "default key should be unlocked from the environment")
+	assert.False(t, provider.IsUnlocked(testExchange, "hedge"), "keys with a different passphrase should remain locked")
+
+	exch, err := bot.ExchangeManager.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	exchCfg, err := bot.Config.GetExchangeConfig(testExchange)
+	require.NoError(t, err)
+	require.NoError(t, bot.attachCredentialProvider(exch, exchCfg))
+	require.NoError(t, exch.GetBase().SetCredentialProviderAccount("hedge"))
+	require.NoError(t, bot.ExchangeManager.Add(exch))
+
+	creds, err := exch.GetCredentials(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "k1", creds.Key, "credentials should be fetched from the keystore")
+
+	_, err = exch.GetCredentials(accounts.DeployAccountToContext(t.Context(), "hedge"))
+	assert.ErrorIs(t, err, secrets.ErrKeyLocked)
+	assert.Error(t, bot.UnlockCredentials(t.Context(), testExchange, "hedge", []byte("wrong")), "UnlockCredentials must error with the wrong passphrase")
+	require.NoError(t, bot.UnlockCredentials(t.Context(), testExchange, "hedge", []byte("other")))
+	creds, err = exch.GetCredentials(accounts.DeployAccountToContext(t.Context(), "hedge"))
+	require.NoError(t, err)
+	assert.Equal(t, "k2", creds.Key)
+
+	require.NoError(t, k.Set(testExchange, "", []byte("pass"), &secrets.Secret{Key: "k3", Secret: "s3", ClientID: "c3"}))
+	require.NoError(t, bot.ReloadCredentials(t.Context(), ""))
+	creds, err = exch.GetCredentials(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "k3", creds.Key, "rotated credentials should be fetched after a reload")
+
+	exchCfg.API.UseCredentialProvider = false
+	assert.ErrorIs(t, bot.ReloadCredentials(t.Context(), testExchange), errCredentialProviderUnused)
+}
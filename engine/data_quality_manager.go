@@ -0,0 +1,638 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/dataquality"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupDataQualityManager creates a data quality manager subsystem. The data
+// history manager is only required when repair jobs are scheduled
+func SetupDataQualityManager(dcm iDatabaseConnectionManager, dhm iDataHistoryJobManager, cfg *config.DataQualityManager) (*DataQualityManager, error) {
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	d := &DataQualityManager{
+		databaseManager:    dcm,
+		dataHistoryManager: dhm,
+		cfg:                *cfg,
+		shutdown:           make(chan struct{}),
+		candleLoader:       kline.LoadFromDatabase,
+		tradeLoader:        trade.GetTradesInRange,
+		reportSaver:        dataquality.Insert,
+		reportLoader:       dataquality.Series,
+	}
+	if d.cfg.CheckInterval <= 0 {
+		log.Warnf(log.DataHistory, "Data quality manager check interval is invalid, defaulting to: %s", DefaultDataQualityCheckInterval)
+		d.cfg.CheckInterval = DefaultDataQualityCheckInterval
+	}
+	if d.cfg.LookbackPeriod <= 0 {
+		log.Warnf(log.DataHistory, "Data quality manager lookback period is invalid, defaulting to: %s", DefaultDataQualityLookbackPeriod)
+		d.cfg.LookbackPeriod = DefaultDataQualityLookbackPeriod
+	}
+	return d, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (d *DataQualityManager) IsRunning() bool {
+	return d != nil && atomic.LoadInt32(&d.started) == 1
+}
+
+// Start runs the subsystem
+func (d *DataQualityManager) Start() error {
+	if d == nil {
+		return fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&d.started, 0, 1) {
+		return fmt.Errorf("%s %w", DataQualityManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.DataHistory, "Data quality manager %s", MsgSubSystemStarting)
+	d.wg.Add(1)
+	go d.monitor()
+	log.Debugf(log.DataHistory, "Data quality manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (d *DataQualityManager) Stop() error {
+	if d == nil {
+		return fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&d.started) == 0 {
+		return fmt.Errorf("%s %w", DataQualityManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.DataHistory, "Data quality manager %s", MsgSubSystemShuttingDown)
+	close(d.shutdown)
+	d.wg.Wait()
+	d.shutdown = make(chan struct{})
+	atomic.StoreInt32(&d.started, 0)
+	log.Debugf(log.DataHistory, "Data quality manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+func (d *DataQualityManager) monitor() {
+	defer d.wg.Done()
+	timer := time.NewTimer(0) // Prime firing of channel for initial check.
+	for {
+		select {
+		case <-d.shutdown:
+			timer.Stop()
+			return
+		case <-timer.C:
+			if d.isDatabaseConnected() {
+				d.checkTargets(context.TODO())
+			}
+			timer.Reset(d.cfg.CheckInterval)
+		}
+	}
+}
+
+// checkTargets scans every configured target over the lookback period
+func (d *DataQualityManager) checkTargets(ctx context.Context) {
+	end := time.Now()
+	start := end.Add(-d.cfg.LookbackPeriod)
+	for i := range d.cfg.Targets {
+		report, err := d.RunCheck(ctx, &d.cfg.Targets[i], start, end)
+		if err != nil {
+			log.Errorf(log.DataHistory, "Data quality manager %s %s %s %s check failed: %v", d.cfg.Targets[i].Exchange, d.cfg.Targets[i].Asset, d.cfg.Targets[i].Pair, d.cfg.Targets[i].DataType, err)
+			continue
+		}
+		if len(report.Issues) > 0 || d.cfg.Verbose {
+			log.Infof(log.DataHistory, "Data quality manager %s %s %s %s %s scanned %d records between %s and %s, found %d issues", report.Exchange, report.Asset, report.Pair, report.DataType, report.Interval.Word(), report.Records, report.StartDate, report.EndDate, len(report.Issues))
+		}
+	}
+}
+
+// RunCheck scans a target's saved data between start and end, persists the
+// report when the database is connected and schedules repair jobs if enabled
+func (d *DataQualityManager) RunCheck(ctx context.Context, target *config.DataQualityTarget, start, end time.Time) (*DataQualityReport, error) {
+	if d == nil {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if !d.IsRunning() {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrSubSystemNotStarted)
+	}
+	if target == nil {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, common.ErrNilPointer)
+	}
+	if target.Exchange == "" {
+		return nil, common.ErrExchangeNameNotSet
+	}
+	if target.Pair.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	if !target.Asset.IsValid() {
+		return nil, fmt.Errorf("%w %s", asset.ErrNotSupported, target.Asset)
+	}
+	if target.Interval <= 0 {
+		return nil, fmt.Errorf("%w %s", kline.ErrInvalidInterval, target.Interval)
+	}
+	if target.DataType != DataQualityCandles && target.DataType != DataQualityTrades {
+		return nil, fmt.Errorf("%w: %q", errInvalidDataQualityDataType, target.DataType)
+	}
+	start = start.UTC().Truncate(target.Interval.Duration())
+	end = end.UTC().Truncate(target.Interval.Duration())
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	report := &DataQualityReport{
+		Exchange:  target.Exchange,
+		Asset:     target.Asset,
+		Pair:      target.Pair,
+		DataType:  target.DataType,
+		Interval:  target.Interval,
+		StartDate: start,
+		EndDate:   end,
+	}
+	var candles []kline.Candle
+	var err error
+	if target.DataType == DataQualityTrades {
+		var trades []trade.Data
+		trades, err = d.tradeLoader(target.Exchange, target.Asset.String(), target.Pair.Base.String(), target.Pair.Quote.String(), start, end)
+		if err != nil {
+			return nil, err
+		}
+		report.Records = int64(len(trades))
+		var invalid []DataQualityIssue
+		trades, invalid = checkTrades(trades, target.Interval)
+		report.Issues = append(report.Issues, invalid...)
+		candles, err = tradesToCandles(trades, target.Interval, start, end)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		candles, err = d.loadCandles(target.Exchange, target.Pair, target.Asset, target.Interval, start, end)
+		if err != nil {
+			return nil, err
+		}
+		report.Records = int64(len(candles))
+	}
+
+	report.Issues = append(report.Issues, findCandleGaps(candles, target.Interval, start, end)...)
+	report.Issues = append(report.Issues, findZeroVolumeRuns(candles, target.Interval, d.cfg.MinZeroVolumeRun)...)
+	report.Issues = append(report.Issues, findOHLCViolations(candles, target.Interval)...)
+	report.Issues = append(report.Issues, findPriceSpikes(candles, target.Interval, d.cfg.SpikeWindow, d.cfg.SpikeSigma)...)
+	for _, exch := range target.ComparisonExchanges {
+		if strings.EqualFold(exch, target.Exchange) {
+			continue
+		}
+		comparison, err := d.loadCandles(exch, target.Pair, target.Asset, target.Interval, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("comparison exchange %s: %w", exch, err)
+		}
+		report.Issues = append(report.Issues, findExchangeDeviations(candles, comparison, exch, target.Interval, d.cfg.MaxDeviationPercentage)...)
+	}
+
+	if d.cfg.ScheduleRepairJobs {
+		d.scheduleRepairs(report)
+	}
+
+	report.ID, err = uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	report.CreatedAt = time.Now().UTC()
+	if d.isDatabaseConnected() {
+		if err := d.reportSaver(ctx, reportToDB(report)); err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// GetReports returns saved reports for an exchange, asset and pair which
+// were created between start and end
+func (d *DataQualityManager) GetReports(ctx context.Context, exch string, a asset.Item, pair currency.Pair, start, end time.Time) ([]DataQualityReport, error) {
+	if d == nil {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if !d.IsRunning() {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrSubSystemNotStarted)
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	reports, err := d.reportLoader(ctx, exch, pair.Base.String(), pair.Quote.String(), a.String(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]DataQualityReport, len(reports))
+	for i := range reports {
+		if err := reportFromDB(&reports[i], &resp[i]); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (d *DataQualityManager) isDatabaseConnected() bool {
+	db := d.databaseManager.GetInstance()
+	return db != nil && db.IsConnected()
+}
+
+// loadCandles returns saved candles within [start, end) sorted by time, no
+// saved candles is not an error as the whole range is reported as a gap
+func (d *DataQualityManager) loadCandles(exch string, pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) ([]kline.Candle, error) {
+	item, err := d.candleLoader(exch, pair, a, interval, start, end)
+	if err != nil {
+		if errors.Is(err, candle.ErrNoCandleDataFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	item.RemoveOutsideRange(start, end)
+	item.RemoveDuplicates()
+	item.SortCandlesByTimestamp(false)
+	return item.Candles, nil
+}
+
+// scheduleRepairs upserts data history jobs covering gaps, OHLC violations
+// and price spikes. Overlapping and adjacent issues share a single job
+func (d *DataQualityManager) scheduleRepairs(report *DataQualityReport) {
+	if d.dataHistoryManager == nil || !d.dataHistoryManager.IsRunning() {
+		log.Warnf(log.DataHistory, "Data quality manager cannot schedule repair jobs for %s %s %s as the data history manager is not running", report.Exchange, report.Asset, report.Pair)
+		return
+	}
+	var refetch, overwrite []int
+	for i := range report.Issues {
+		switch report.Issues[i].Type {
+		case DataQualityIssueGap:
+			refetch = append(refetch, i)
+		case DataQualityIssueOHLCViolation, DataQualityIssuePriceSpike:
+			// Saved trades cannot be overwritten, so only candles are refetched
+			if report.DataType == DataQualityCandles {
+				overwrite = append(overwrite, i)
+			}
+		}
+	}
+	d.upsertRepairJobs(report, refetch, false)
+	d.upsertRepairJobs(report, overwrite, true)
+}
+
+// upsertRepairJobs schedules a job for every repair block touched by the
+// issues. Blocks are aligned to the interval rather than the scanned range so
+// that repeated checks over a sliding lookback period reuse the same nickname
+// instead of scheduling overlapping jobs
+func (d *DataQualityManager) upsertRepairJobs(report *DataQualityReport, issues []int, overwriteExisting bool) {
+	slices.SortFunc(issues, func(a, b int) int {
+		return report.Issues[a].StartDate.Compare(report.Issues[b].StartDate)
+	})
+	dataType := dataHistoryCandleDataType
+	if report.DataType == DataQualityTrades {
+		dataType = dataHistoryTradeDataType
+	}
+	repairType := "refetch"
+	if overwriteExisting {
+		repairType = "overwrite"
+	}
+	block := report.Interval.Duration() * dataQualityRepairBlockCandles
+	for i := 0; i < len(issues); {
+		start, end := report.Issues[issues[i]].StartDate, report.Issues[issues[i]].EndDate
+		j := i + 1
+		for ; j < len(issues) && !report.Issues[issues[j]].StartDate.After(end); j++ {
+			if report.Issues[issues[j]].EndDate.After(end) {
+				end = report.Issues[issues[j]].EndDate
+			}
+		}
+		for blockStart := start.Truncate(block); blockStart.Before(end); blockStart = blockStart.Add(block) {
+			blockEnd := blockStart.Add(block)
+			job := &DataHistoryJob{
+				Nickname:              strings.ToLower(fmt.Sprintf("%s-%s-%s-%s-%s-%s-%d-%d", dataQualityRepairPrefix, repairType, report.Exchange, report.Asset, report.Pair, report.DataType, blockStart.Unix(), blockEnd.Unix())),
+				Exchange:              report.Exchange,
+				Asset:                 report.Asset,
+				Pair:                  report.Pair,
+				StartDate:             blockStart,
+				EndDate:               blockEnd,
+				Interval:              report.Interval,
+				DataType:              dataType,
+				OverwriteExistingData: overwriteExisting,
+			}
+			// The open block is only repaired up to the end of the scanned range
+			if job.EndDate.After(report.EndDate) {
+				job.EndDate = report.EndDate
+			}
+			if err := d.dataHistoryManager.UpsertJob(job, true); err != nil {
+				if errors.Is(err, errNicknameInUse) {
+					if d.cfg.Verbose {
+						log.Debugf(log.DataHistory, "Data quality manager repair job %s already scheduled", job.Nickname)
+					}
+				} else {
+					log.Errorf(log.DataHistory, "Data quality manager could not schedule repair job %s: %v", job.Nickname, err)
+				}
+				continue
+			}
+			for _, idx := range issues[i:j] {
+				if report.Issues[idx].StartDate.Before(blockEnd) && report.Issues[idx].EndDate.After(blockStart) {
+					report.Issues[idx].RepairJobID = job.ID
+				}
+			}
+		}
+		i = j
+	}
+}
+
+// checkTrades splits out trades with a non-positive price or amount
+func checkTrades(trades []trade.Data, interval kline.Interval) (valid []trade.Data, issues []DataQualityIssue) {
+	valid = make([]trade.Data, 0, len(trades))
+	for i := range trades {
+		var field string
+		var value float64
+		switch {
+		case trades[i].Price <= 0:
+			field, value = "price", trades[i].Price
+		case trades[i].Amount <= 0:
+			field, value = "amount", trades[i].Amount
+		default:
+			valid = append(valid, trades[i])
+			continue
+		}
+		candleTime := trades[i].Timestamp.UTC().Truncate(interval.Duration())
+		issues = append(issues, DataQualityIssue{
+			Type:        DataQualityIssueInvalidTrade,
+			StartDate:   candleTime,
+			EndDate:     candleTime.Add(interval.Duration()),
+			Value:       value,
+			Description: fmt.Sprintf("trade %s at %s has invalid %s %v", trades[i].TID, trades[i].Timestamp.UTC(), field, value),
+		})
+	}
+	return valid, issues
+}
+
+// tradesToCandles converts trades into sorted UTC candles within [start, end)
+func tradesToCandles(trades []trade.Data, interval kline.Interval, start, end time.Time) ([]kline.Candle, error) {
+	if len(trades) == 0 {
+		return nil, nil
+	}
+	item, err := trade.ConvertTradesToCandles(interval, trades...)
+	if err != nil {
+		return nil, err
+	}
+	item.FormatDates()
+	item.RemoveOutsideRange(start, end)
+	item.SortCandlesByTimestamp(false)
+	return item.Candles, nil
+}
+
+// findCandleGaps groups consecutive missing candles within [start, end)
+func findCandleGaps(candles []kline.Candle, interval kline.Interval, start, end time.Time) []DataQualityIssue {
+	saved := make(map[int64]struct{}, len(candles))
+	for i := range candles {
+		saved[candles[i].Time.Unix()] = struct{}{}
+	}
+	var issues []DataQualityIssue
+	var gap *DataQualityIssue
+	for t := start; t.Before(end); t = t.Add(interval.Duration()) {
+		if _, ok := saved[t.Unix()]; ok {
+			gap = nil
+			continue
+		}
+		if gap == nil {
+			issues = append(issues, DataQualityIssue{Type: DataQualityIssueGap, StartDate: t})
+			gap = &issues[len(issues)-1]
+		}
+		gap.EndDate = t.Add(interval.Duration())
+		gap.Value++
+	}
+	for i := range issues {
+		issues[i].Description = fmt.Sprintf("%v missing %s candles", issues[i].Value, interval.Word())
+	}
+	return issues
+}
+
+// findZeroVolumeRuns flags at least minRun consecutive candles without volume
+func findZeroVolumeRuns(candles []kline.Candle, interval kline.Interval, minRun int64) []DataQualityIssue {
+	if minRun <= 0 {
+		minRun = 1
+	}
+	var issues []DataQualityIssue
+	runStart := -1
+	for i := range candles {
+		if runStart >= 0 && (candles[i].Volume != 0 || !candles[i].Time.Equal(candles[i-1].Time.Add(interval.Duration()))) {
+			issues = appendZeroVolumeRun(issues, candles[runStart:i], interval, minRun)
+			runStart = -1
+		}
+		if candles[i].Volume == 0 && runStart < 0 {
+			runStart = i
+		}
+	}
+	if runStart >= 0 {
+		issues = appendZeroVolumeRun(issues, candles[runStart:], interval, minRun)
+	}
+	return issues
+}
+
+func appendZeroVolumeRun(issues []DataQualityIssue, run []kline.Candle, interval kline.Interval, minRun int64) []DataQualityIssue {
+	if int64(len(run)) < minRun {
+		return issues
+	}
+	return append(issues, DataQualityIssue{
+		Type:        DataQualityIssueZeroVolume,
+		StartDate:   run[0].Time,
+		EndDate:     run[len(run)-1].Time.Add(interval.Duration()),
+		Value:       float64(len(run)),
+		Description: fmt.Sprintf("%d consecutive %s candles without volume", len(run), interval.Word()),
+	})
+}
+
+// findOHLCViolations flags candles with non-positive prices, negative volume
+// or a high and low which do not bound the open and close
+func findOHLCViolations(candles []kline.Candle, interval kline.Interval) []DataQualityIssue {
+	var issues []DataQualityIssue
+	for i := range candles {
+		c := &candles[i]
+		var reason string
+		var value float64
+		switch {
+		case c.Open <= 0 || c.High <= 0 || c.Low <= 0 || c.Close <= 0:
+			reason, value = "non-positive price", math.Min(math.Min(c.Open, c.High), math.Min(c.Low, c.Close))
+		case c.High < math.Max(c.Open, math.Max(c.Close, c.Low)):
+			reason, value = "high below open, close or low", c.High
+		case c.Low > math.Min(c.Open, c.Close):
+			reason, value = "low above open or close", c.Low
+		case c.Volume < 0:
+			reason, value = "negative volume", c.Volume
+		default:
+			continue
+		}
+		issues = append(issues, DataQualityIssue{
+			Type:        DataQualityIssueOHLCViolation,
+			StartDate:   c.Time,
+			EndDate:     c.Time.Add(interval.Duration()),
+			Value:       value,
+			Description: fmt.Sprintf("%s open: %v high: %v low: %v close: %v volume: %v", reason, c.Open, c.High, c.Low, c.Close, c.Volume),
+		})
+	}
+	return issues
+}
+
+// findPriceSpikes flags close to close log returns which are more than sigma
+// standard deviations from the mean of the previous window returns
+func findPriceSpikes(candles []kline.Candle, interval kline.Interval, window int64, sigma float64) []DataQualityIssue {
+	if window < 2 || sigma <= 0 || int64(len(candles)) <= window+1 {
+		return nil
+	}
+	returns := make([]float64, len(candles))
+	for i := 1; i < len(candles); i++ {
+		if candles[i].Close > 0 && candles[i-1].Close > 0 {
+			returns[i] = math.Log(candles[i].Close / candles[i-1].Close)
+		}
+	}
+	var issues []DataQualityIssue
+	for i := int(window) + 1; i < len(candles); i++ {
+		previous := returns[i-int(window) : i]
+		var mean float64
+		for _, r := range previous {
+			mean += r
+		}
+		mean /= float64(window)
+		var variance float64
+		for _, r := range previous {
+			variance += (r - mean) * (r - mean)
+		}
+		stdDev := math.Sqrt(variance / float64(window))
+		if stdDev == 0 {
+			continue
+		}
+		z := (returns[i] - mean) / stdDev
+		if math.Abs(z) <= sigma {
+			continue
+		}
+		issues = append(issues, DataQualityIssue{
+			Type:        DataQualityIssuePriceSpike,
+			StartDate:   candles[i].Time,
+			EndDate:     candles[i].Time.Add(interval.Duration()),
+			Value:       z,
+			Description: fmt.Sprintf("close moved from %v to %v, %.2f standard deviations from the previous %d returns", candles[i-1].Close, candles[i].Close, z, window),
+		})
+	}
+	return issues
+}
+
+// findExchangeDeviations groups consecutive candles whose close differs from
+// the comparison exchange's close by more than maxDeviation percent
+func findExchangeDeviations(candles, comparison []kline.Candle, comparisonExchange string, interval kline.Interval, maxDeviation float64) []DataQualityIssue {
+	closes := make(map[int64]float64, len(comparison))
+	for i := range comparison {
+		closes[comparison[i].Time.Unix()] = comparison[i].Close
+	}
+	var issues []DataQualityIssue
+	var run *DataQualityIssue
+	for i := range candles {
+		other, ok := closes[candles[i].Time.Unix()]
+		if !ok || other <= 0 || candles[i].Close <= 0 {
+			run = nil
+			continue
+		}
+		deviation := math.Abs(candles[i].Close-other) / other * 100
+		if deviation <= maxDeviation {
+			run = nil
+			continue
+		}
+		if run == nil || !run.EndDate.Equal(candles[i].Time) {
+			issues = append(issues, DataQualityIssue{
+				Type:               DataQualityIssueDeviation,
+				StartDate:          candles[i].Time,
+				ComparisonExchange: comparisonExchange,
+			})
+			run = &issues[len(issues)-1]
+		}
+		run.EndDate = candles[i].Time.Add(interval.Duration())
+		run.Value = math.Max(run.Value, deviation)
+	}
+	for i := range issues {
+		issues[i].Description = fmt.Sprintf("close deviated up to %.4f%% from %s", issues[i].Value, comparisonExchange)
+	}
+	return issues
+}
+
+func reportToDB(report *DataQualityReport) *dataquality.Report {
+	r := &dataquality.Report{
+		ID:        report.ID.String(),
+		Exchange:  report.Exchange,
+		Base:      report.Pair.Base.String(),
+		Quote:     report.Pair.Quote.String(),
+		Asset:     report.Asset.String(),
+		DataType:  report.DataType,
+		Interval:  int64(report.Interval.Duration().Seconds()),
+		StartDate: report.StartDate,
+		EndDate:   report.EndDate,
+		Records:   report.Records,
+		CreatedAt: report.CreatedAt,
+		Issues:    make([]dataquality.Issue, len(report.Issues)),
+	}
+	for i := range report.Issues {
+		r.Issues[i] = dataquality.Issue{
+			Type:               report.Issues[i].Type,
+			StartDate:          report.Issues[i].StartDate,
+			EndDate:            report.Issues[i].EndDate,
+			Value:              report.Issues[i].Value,
+			ComparisonExchange: report.Issues[i].ComparisonExchange,
+			Description:        report.Issues[i].Description,
+		}
+		if report.Issues[i].RepairJobID != uuid.Nil {
+			r.Issues[i].RepairJobID = report.Issues[i].RepairJobID.String()
+		}
+	}
+	return r
+}
+
+func reportFromDB(r *dataquality.Report, report *DataQualityReport) error {
+	var err error
+	report.ID, err = uuid.FromString(r.ID)
+	if err != nil {
+		return err
+	}
+	report.Asset, err = asset.New(r.Asset)
+	if err != nil {
+		return err
+	}
+	report.Pair = currency.NewPair(currency.NewCode(r.Base), currency.NewCode(r.Quote))
+	report.Exchange = r.Exchange
+	report.DataType = r.DataType
+	report.Interval = kline.Interval(time.Duration(r.Interval) * time.Second)
+	report.StartDate = r.StartDate
+	report.EndDate = r.EndDate
+	report.Records = r.Records
+	report.CreatedAt = r.CreatedAt
+	report.Issues = make([]DataQualityIssue, len(r.Issues))
+	for i := range r.Issues {
+		report.Issues[i] = DataQualityIssue{
+			Type:               r.Issues[i].Type,
+			StartDate:          r.Issues[i].StartDate,
+			EndDate:            r.Issues[i].EndDate,
+			Value:              r.Issues[i].Value,
+			ComparisonExchange: r.Issues[i].ComparisonExchange,
+			Description:        r.Issues[i].Description,
+		}
+		if r.Issues[i].RepairJobID != "" {
+			report.Issues[i].RepairJobID, err = uuid.FromString(r.Issues[i].RepairJobID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
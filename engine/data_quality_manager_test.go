@@ -0,0 +1,341 @@
+package engine
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/dataquality"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+type testDataQualityDatabase struct{}
+
+func (testDataQualityDatabase) IsConnected() bool               { return true }
+func (testDataQualityDatabase) GetSQL() (*sql.DB, error)        { return nil, nil }
+func (testDataQualityDatabase) GetConfig() *database.Config     { return nil }
+func (testDataQualityDatabase) GetInstance() database.IDatabase { return testDataQualityDatabase{} }
+
+type testDataHistoryJobManager struct {
+	jobs []*DataHistoryJob
+}
+
+func (t *testDataHistoryJobManager) IsRunning() bool { return true }
+
+func (t *testDataHistoryJobManager) UpsertJob(job *DataHistoryJob, _ bool) error {
+	for i := range t.jobs {
+		if t.jobs[i].Nickname == job.Nickname {
+			return errNicknameInUse
+		}
+	}
+	var err error
+	job.ID, err = uuid.NewV4()
+	t.jobs = append(t.jobs, job)
+	return err
+}
+
+var dataQualityTestStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func dataQualityTestCandles(closes ...float64) []kline.Candle {
+	candles := make([]kline.Candle, len(closes))
+	for i := range closes {
+		candles[i] = kline.Candle{
+			Time:   dataQualityTestStart.Add(time.Duration(i) * time.Hour),
+			Open:   closes[i],
+			High:   closes[i] + 1,
+			Low:    closes[i] - 1,
+			Close:  closes[i],
+			Volume: 1,
+		}
+	}
+	return candles
+}
+
+func TestSetupDataQualityManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupDataQualityManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	_, err = SetupDataQualityManager(&DatabaseConnectionManager{}, nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	d, err := SetupDataQualityManager(&DatabaseConnectionManager{}, nil, &config.DataQualityManager{})
+	require.NoError(t, err, "SetupDataQualityManager must not error")
+	assert.Equal(t, DefaultDataQualityCheckInterval, d.cfg.CheckInterval, "check interval should default")
+	assert.Equal(t, DefaultDataQualityLookbackPeriod, d.cfg.LookbackPeriod, "lookback period should default")
+}
+
+func TestDataQualityManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var d *DataQualityManager
+	assert.ErrorIs(t, d.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, d.Stop(), ErrNilSubsystem)
+	assert.False(t, d.IsRunning(), "IsRunning should return false on a nil manager")
+
+	d, err := SetupDataQualityManager(&DatabaseConnectionManager{}, nil, &config.DataQualityManager{})
+	require.NoError(t, err, "SetupDataQualityManager must not error")
+	assert.ErrorIs(t, d.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, d.Start(), "Start must not error")
+	assert.True(t, d.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, d.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, d.Stop(), "Stop must not error")
+	assert.False(t, d.IsRunning(), "IsRunning should return false")
+}
+
+func TestDataQualityManagerRunCheck(t *testing.T) {
+	t.Parallel()
+	dhm := &testDataHistoryJobManager{}
+	d, err := SetupDataQualityManager(testDataQualityDatabase{}, dhm, &config.DataQualityManager{
+		SpikeSigma:             3,
+		SpikeWindow:            100,
+		MinZeroVolumeRun:       2,
+		MaxDeviationPercentage: 1,
+		ScheduleRepairJobs:     true,
+	})
+	require.NoError(t, err, "SetupDataQualityManager must not error")
+
+	target := &config.DataQualityTarget{
+		Exchange:            testExchange,
+		Asset:               asset.Spot,
+		Pair:                currency.NewBTCUSDT(),
+		DataType:            DataQualityCandles,
+		Interval:            kline.OneHour,
+		ComparisonExchanges: []string{"binance", testExchange},
+	}
+	start, end := dataQualityTestStart, dataQualityTestStart.Add(10*time.Hour)
+	_, err = d.RunCheck(t.Context(), target, start, end)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	require.NoError(t, d.Start(), "Start must not error")
+	defer func() { assert.NoError(t, d.Stop(), "Stop should not error") }()
+
+	_, err = d.RunCheck(t.Context(), nil, start, end)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = d.RunCheck(t.Context(), &config.DataQualityTarget{Exchange: testExchange, Asset: asset.Spot, Pair: currency.NewBTCUSDT(), Interval: kline.OneHour}, start, end)
+	assert.ErrorIs(t, err, errInvalidDataQualityDataType)
+	_, err = d.RunCheck(t.Context(), target, end, start)
+	assert.ErrorIs(t, err, common.ErrStartAfterEnd)
+
+	candles := dataQualityTestCandles(100, 100, 100, 100, 100, 100, 100, 100, 100, 100)
+	candles[6].Volume, candles[7].Volume = 0, 0
+	candles[8].High = 99
+	comparison := dataQualityTestCandles(100, 100, 100, 100, 100, 100, 100, 100, 100, 90)
+	candles = append(candles[:3], candles[4:]...)
+	d.candleLoader = func(exch string, pair currency.Pair, a asset.Item, interval kline.Interval, _, _ time.Time) (*kline.Item, error) {
+		item := &kline.Item{Exchange: exch, Pair: pair, Asset: a, Interval: interval}
+		switch exch {
+		case testExchange:
+			item.Candles = append(item.Candles, candles...)
+		case "binance":
+			item.Candles = append(item.Candles, comparison...)
+		default:
+			return nil, candle.ErrNoCandleDataFound
+		}
+		return item, nil
+	}
+	var saved *dataquality.Report
+	d.reportSaver = func(_ context.Context, r *dataquality.Report) error {
+		saved = r
+		return nil
+	}
+
+	report, err := d.RunCheck(t.Context(), target, start.Add(time.Minute), end.Add(time.Minute))
+	require.NoError(t, err, "RunCheck must not error")
+	assert.Equal(t, start, report.StartDate, "start should be truncated to the interval")
+	assert.Equal(t, end, report.EndDate, "end should be truncated to the interval")
+	assert.Equal(t, int64(9), report.Records, "Records should be correct")
+	require.Len(t, report.Issues, 4, "RunCheck must find every issue")
+	assert.Equal(t, DataQualityIssueGap, report.Issues[0].Type, "first issue should be a gap")
+	assert.Equal(t, DataQualityIssueZeroVolume, report.Issues[1].Type, "second issue should be zero volume")
+	assert.Equal(t, DataQualityIssueOHLCViolation, report.Issues[2].Type, "third issue should be an OHLC violation")
+	assert.Equal(t, DataQualityIssueDeviation, report.Issues[3].Type, "fourth issue should be an exchange deviation")
+	assert.Equal(t, "binance", report.Issues[3].ComparisonExchange, "ComparisonExchange should be set")
+
+	require.Len(t, dhm.jobs, 2, "RunCheck must schedule a refetch and an overwrite repair job")
+	assert.False(t, dhm.jobs[0].OverwriteExistingData, "gap repair should not overwrite existing data")
+	block := kline.OneHour.Duration() * dataQualityRepairBlockCandles
+	assert.Equal(t, candles[2].Time.Add(time.Hour).Truncate(block), dhm.jobs[0].StartDate, "gap repair should start at the repair block containing the missing candle")
+	assert.Equal(t, end, dhm.jobs[0].EndDate, "gap repair should end at the end of the scanned range")
+	assert.True(t, dhm.jobs[1].OverwriteExistingData, "OHLC repair should overwrite existing data")
+	assert.Equal(t, dataHistoryCandleDataType, dhm.jobs[1].DataType, "repair should refetch candles")
+	assert.Equal(t, dhm.jobs[0].ID, report.Issues[0].RepairJobID, "gap should reference its repair job")
+	assert.Equal(t, dhm.jobs[1].ID, report.Issues[2].RepairJobID, "OHLC violation should reference its repair job")
+	assert.Equal(t, uuid.Nil, report.Issues[3].RepairJobID, "deviation should not be repaired")
+
+	require.NotNil(t, saved, "report must be saved")
+	assert.Equal(t, report.ID.String(), saved.ID, "saved report should have the same ID")
+	assert.Equal(t, int64(3600), saved.Interval, "saved interval should be in seconds")
+	assert.Equal(t, dhm.jobs[0].ID.String(), saved.Issues[0].RepairJobID, "saved issue should reference its repair job")
+
+	_, err = d.RunCheck(t.Context(), target, start.Add(time.Hour*2), end.Add(time.Hour))
+	require.NoError(t, err, "RunCheck must not error")
+	assert.Len(t, dhm.jobs, 2, "a later check over a sliding range should not schedule duplicate repair jobs")
+}
+
+func TestDataQualityManagerRunCheckTrades(t *testing.T) {
+	t.Parallel()
+	d, err := SetupDataQualityManager(&DatabaseConnectionManager{}, nil, &config.DataQualityManager{MinZeroVolumeRun: 1})
+	require.NoError(t, err, "SetupDataQualityManager must not error")
+	require.NoError(t, d.Start(), "Start must not error")
+	defer func() { assert.NoError(t, d.Stop(), "Stop should not error") }()
+
+	d.tradeLoader = func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error) {
+		return []trade.Data{
+			{TID: "1", Price: 100, Amount: 1, Timestamp: dataQualityTestStart},
+			{TID: "2", Price: 0, Amount: 1, Timestamp: dataQualityTestStart.Add(time.Minute)},
+			{TID: "3", Price: 101, Amount: 1, Timestamp: dataQualityTestStart.Add(2 * time.Hour)},
+		}, nil
+	}
+	report, err := d.RunCheck(t.Context(), &config.DataQualityTarget{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewBTCUSDT(),
+		DataType: DataQualityTrades,
+		Interval: kline.OneHour,
+	}, dataQualityTestStart, dataQualityTestStart.Add(3*time.Hour))
+	require.NoError(t, err, "RunCheck must not error")
+	assert.Equal(t, int64(3), report.Records, "Records should count every trade")
+	require.Len(t, report.Issues, 2, "RunCheck must find the invalid trade and the gap")
+	assert.Equal(t, DataQualityIssueInvalidTrade, report.Issues[0].Type, "first issue should be an invalid trade")
+	assert.Equal(t, DataQualityIssueGap, report.Issues[1].Type, "second issue should be a gap")
+	assert.Equal(t, dataQualityTestStart.Add(time.Hour), report.Issues[1].StartDate, "gap should start at the candle without trades")
+}
+
+func TestDataQualityManagerGetReports(t *testing.T) {
+	t.Parallel()
+	d, err := SetupDataQualityManager(&DatabaseConnectionManager{}, nil, &config.DataQualityManager{})
+	require.NoError(t, err, "SetupDataQualityManager must not error")
+	_, err = d.GetReports(t.Context(), testExchange, asset.Spot, currency.NewBTCUSDT(), dataQualityTestStart, dataQualityTestStart.Add(time.Hour))
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	require.NoError(t, d.Start(), "Start must not error")
+	defer func() { assert.NoError(t, d.Stop(), "Stop should not error") }()
+
+	id, err := uuid.NewV4()
+	require.NoError(t, err, "NewV4 must not error")
+	report := &DataQualityReport{
+		ID:        id,
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      currency.NewBTCUSDT(),
+		DataType:  DataQualityCandles,
+		Interval:  kline.OneHour,
+		StartDate: dataQualityTestStart,
+		EndDate:   dataQualityTestStart.Add(time.Hour),
+		Records:   1,
+		CreatedAt: dataQualityTestStart,
+		Issues:    []DataQualityIssue{{Type: DataQualityIssueGap, StartDate: dataQualityTestStart, EndDate: dataQualityTestStart.Add(time.Hour), Value: 1, RepairJobID: id}},
+	}
+	d.reportLoader = func(context.Context, string, string, string, string, time.Time, time.Time) ([]dataquality.Report, error) {
+		return []dataquality.Report{*reportToDB(report)}, nil
+	}
+	_, err = d.GetReports(t.Context(), testExchange, asset.Spot, currency.NewBTCUSDT(), dataQualityTestStart.Add(time.Hour), dataQualityTestStart)
+	assert.ErrorIs(t, err, common.ErrStartAfterEnd)
+
+	reports, err := d.GetReports(t.Context(), testExchange, asset.Spot, currency.NewBTCUSDT(), dataQualityTestStart, dataQualityTestStart.Add(time.Hour))
+	require.NoError(t, err, "GetReports must not error")
+	require.Len(t, reports, 1, "GetReports must return the saved report")
+	assert.Equal(t, *report, reports[0], "GetReports should return the report unchanged")
+}
+
+func TestFindCandleGaps(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(1, 1, 1, 1, 1)
+	candles = append(candles[:1], candles[3:4]...)
+	issues := findCandleGaps(candles, kline.OneHour, dataQualityTestStart, dataQualityTestStart.Add(5*time.Hour))
+	require.Len(t, issues, 2, "findCandleGaps must group consecutive missing candles")
+	assert.Equal(t, dataQualityTestStart.Add(time.Hour), issues[0].StartDate, "first gap should start after the first candle")
+	assert.Equal(t, dataQualityTestStart.Add(3*time.Hour), issues[0].EndDate, "first gap should end at the next saved candle")
+	assert.Equal(t, 2.0, issues[0].Value, "first gap should count the missing candles")
+	assert.Equal(t, 1.0, issues[1].Value, "trailing gap should count the missing candle")
+
+	assert.Empty(t, findCandleGaps(dataQualityTestCandles(1, 1), kline.OneHour, dataQualityTestStart, dataQualityTestStart.Add(2*time.Hour)), "complete candles should not have gaps")
+}
+
+func TestFindZeroVolumeRuns(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(1, 1, 1, 1, 1, 1, 1)
+	for _, i := range []int{1, 2, 3, 5, 6} {
+		candles[i].Volume = 0
+	}
+	issues := findZeroVolumeRuns(candles, kline.OneHour, 3)
+	require.Len(t, issues, 1, "findZeroVolumeRuns must only flag runs of the minimum length")
+	assert.Equal(t, 3.0, issues[0].Value, "run length should be correct")
+	assert.Equal(t, candles[1].Time, issues[0].StartDate, "run should start at the first zero volume candle")
+	assert.Equal(t, candles[4].Time, issues[0].EndDate, "run should end at the next candle")
+
+	issues = findZeroVolumeRuns(candles, kline.OneHour, 2)
+	require.Len(t, issues, 2, "findZeroVolumeRuns must flag a trailing run")
+	assert.Equal(t, candles[6].Time.Add(time.Hour), issues[1].EndDate, "trailing run should end after the last candle")
+
+	candles = append(candles[:2], candles[3:]...)
+	assert.Len(t, findZeroVolumeRuns(candles, kline.OneHour, 1), 3, "findZeroVolumeRuns should split runs across missing candles")
+}
+
+func TestFindOHLCViolations(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(10, 10, 10, 10, 10)
+	candles[1].Close = 0
+	candles[2].High = 9
+	candles[3].Low = 10.5
+	candles[4].Volume = -1
+	issues := findOHLCViolations(candles, kline.OneHour)
+	require.Len(t, issues, 4, "findOHLCViolations must flag every invalid candle")
+	assert.Equal(t, 0.0, issues[0].Value, "non-positive price should be the value")
+	assert.Equal(t, 9.0, issues[1].Value, "high should be the value")
+	assert.Equal(t, 10.5, issues[2].Value, "low should be the value")
+	assert.Equal(t, -1.0, issues[3].Value, "volume should be the value")
+}
+
+func TestFindPriceSpikes(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(100, 101, 100, 101, 100, 101, 100, 150)
+	assert.Empty(t, findPriceSpikes(candles, kline.OneHour, 1, 3), "a window below two should disable spike detection")
+	assert.Empty(t, findPriceSpikes(candles[:4], kline.OneHour, 4, 3), "too few candles should disable spike detection")
+
+	issues := findPriceSpikes(candles, kline.OneHour, 4, 3)
+	require.Len(t, issues, 1, "findPriceSpikes must flag the spike")
+	assert.Equal(t, candles[7].Time, issues[0].StartDate, "spike should be on the last candle")
+	assert.Greater(t, issues[0].Value, 3.0, "z-score should exceed sigma")
+
+	assert.Empty(t, findPriceSpikes(dataQualityTestCandles(100, 100, 100, 100, 100, 150), kline.OneHour, 3, 3), "flat prices should not be flagged")
+}
+
+func TestFindExchangeDeviations(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(100, 100, 100, 100, 100)
+	comparison := dataQualityTestCandles(100, 95, 90, 100, 102)
+	comparison = append(comparison[:3], comparison[4:]...)
+	issues := findExchangeDeviations(candles, comparison, "binance", kline.OneHour, 1)
+	require.Len(t, issues, 2, "findExchangeDeviations must group consecutive deviations")
+	assert.Equal(t, candles[1].Time, issues[0].StartDate, "first deviation should start at the second candle")
+	assert.Equal(t, candles[3].Time, issues[0].EndDate, "first deviation should end after the third candle")
+	assert.InDelta(t, 11.1111, issues[0].Value, 0.0001, "Value should be the maximum deviation")
+	assert.Equal(t, "binance", issues[0].ComparisonExchange, "ComparisonExchange should be set")
+	assert.InDelta(t, 1.9608, issues[1].Value, 0.0001, "Value should be the deviation")
+}
+
+func TestCheckTrades(t *testing.T) {
+	t.Parallel()
+	valid, issues := checkTrades([]trade.Data{
+		{Price: 1, Amount: 1, Timestamp: dataQualityTestStart},
+		{Price: -1, Amount: 1, Timestamp: dataQualityTestStart.Add(90 * time.Minute)},
+		{Price: 1, Amount: 0, Timestamp: dataQualityTestStart},
+	}, kline.OneHour)
+	assert.Len(t, valid, 1, "checkTrades should return the valid trade")
+	require.Len(t, issues, 2, "checkTrades must flag invalid trades")
+	assert.Equal(t, dataQualityTestStart.Add(time.Hour), issues[0].StartDate, "issue should start at the trade's candle")
+	assert.Equal(t, -1.0, issues[0].Value, "price should be the value")
+	assert.Equal(t, 0.0, issues[1].Value, "amount should be the value")
+}
@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/dataquality"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+const (
+	// DataQualityManagerName is an exported subsystem name
+	DataQualityManagerName = "data_quality_manager"
+	// DefaultDataQualityCheckInterval is the default time between scans of
+	// the configured targets
+	DefaultDataQualityCheckInterval = time.Hour
+	// DefaultDataQualityLookbackPeriod is the default range of saved data
+	// scanned on each check
+	DefaultDataQualityLookbackPeriod = time.Hour * 24
+	// dataQualityRepairPrefix prefixes the nickname of every repair job
+	dataQualityRepairPrefix = "dataquality"
+	// dataQualityRepairBlockCandles is the number of candles covered by each
+	// interval aligned repair job
+	dataQualityRepairBlockCandles = 500
+)
+
+// Data quality target data types
+const (
+	DataQualityCandles = "candles"
+	DataQualityTrades  = "trades"
+)
+
+// Data quality issue types
+const (
+	DataQualityIssueGap           = "gap"
+	DataQualityIssueZeroVolume    = "zero_volume"
+	DataQualityIssuePriceSpike    = "price_spike"
+	DataQualityIssueOHLCViolation = "ohlc_violation"
+	DataQualityIssueDeviation     = "exchange_deviation"
+	DataQualityIssueInvalidTrade  = "invalid_trade"
+)
+
+var errInvalidDataQualityDataType = errors.New("data quality data type must be candles or trades")
+
+// DataQualityManager scans saved candles and trades for gaps, zero volume
+// runs, price spikes, OHLC violations and deviations from other exchanges,
+// persists a report of each scan and optionally schedules data history jobs to
+// repair the data
+type DataQualityManager struct {
+	started            int32
+	shutdown           chan struct{}
+	wg                 sync.WaitGroup
+	databaseManager    iDatabaseConnectionManager
+	dataHistoryManager iDataHistoryJobManager
+	cfg                config.DataQualityManager
+	// m serialises scans so periodic and requested scans cannot schedule the
+	// same repair jobs concurrently
+	m            sync.Mutex
+	candleLoader func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (*kline.Item, error)
+	tradeLoader  func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
+	reportSaver  func(context.Context, *dataquality.Report) error
+	reportLoader func(context.Context, string, string, string, string, time.Time, time.Time) ([]dataquality.Report, error)
+}
+
+// DataQualityReport is the result of scanning a target's saved data
+type DataQualityReport struct {
+	ID        uuid.UUID
+	Exchange  string
+	Asset     asset.Item
+	Pair      currency.Pair
+	DataType  string
+	Interval  kline.Interval
+	StartDate time.Time
+	EndDate   time.Time
+	// Records is the number of candles or trades which were scanned
+	Records   int64
+	CreatedAt time.Time
+	Issues    []DataQualityIssue
+}
+
+// DataQualityIssue is a single problem found within a report's range. Value
+// depends on the type, being the number of missing or zero volume candles, the
+// z-score of a price spike, the offending value of an OHLC violation or
+// invalid trade, or the maximum percentage deviation from another exchange.
+// EndDate is exclusive, being the close time of the last affected candle
+type DataQualityIssue struct {
+	Type               string
+	StartDate          time.Time
+	EndDate            time.Time
+	Value              float64
+	ComparisonExchange string
+	Description        string
+	RepairJobID        uuid.UUID
+}
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"database/sql"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -10,6 +11,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	dbpsql "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
+	dbtimescale "github.com/thrasher-corp/gocryptotrader/database/drivers/timescale"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/timescale"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -23,6 +28,9 @@ type DatabaseConnectionManager struct {
 	cfg      database.Config
 	wg       sync.WaitGroup
 	dbConn   *database.Instance
+	// marketData is the optional time-series connection used for candles and
+	// trades
+	marketData *sql.DB
 }
 
 // IsRunning safely checks whether the subsystem is running
@@ -109,6 +117,12 @@ func (m *DatabaseConnectionManager) Start(wg *sync.WaitGroup) (err error) {
 		if err != nil {
 			return fmt.Errorf("%w: %v Some features that utilise a database will be unavailable", database.ErrFailedToConnect, err)
 		}
+		if err = m.startMarketData(); err != nil {
+			if errClose := m.dbConn.CloseConnection(); errClose != nil {
+				log.Errorf(log.DatabaseMgr, "Failed to close database: %v", errClose)
+			}
+			return fmt.Errorf("%w: market data %v", database.ErrFailedToConnect, err)
+		}
 		m.dbConn.SetConnected(true)
 		wg.Add(1)
 		m.wg.Add(1)
@@ -136,12 +150,56 @@ func (m *DatabaseConnectionManager) Stop() error {
 	if err != nil {
 		log.Errorf(log.DatabaseMgr, "Failed to close database: %v", err)
 	}
+	m.stopMarketData()
 
 	close(m.shutdown)
 	m.wg.Wait()
 	return nil
 }
 
+// startMarketData connects to the configured time-series database and routes
+// all candle and trade storage to it
+func (m *DatabaseConnectionManager) startMarketData() error {
+	if m.cfg.MarketData == nil || !m.cfg.MarketData.Enabled {
+		return nil
+	}
+	log.Debugf(log.DatabaseMgr,
+		"Attempting to establish market data connection to host %s/%s utilising %s driver\n",
+		m.cfg.MarketData.Host,
+		m.cfg.MarketData.Database,
+		m.cfg.MarketData.Driver)
+	db, err := dbtimescale.Connect(m.cfg.MarketData)
+	if err != nil {
+		return err
+	}
+	candleStore, err := timescale.NewCandleStore(db)
+	if err != nil {
+		return err
+	}
+	tradeStore, err := timescale.NewTradeStore(db)
+	if err != nil {
+		return err
+	}
+	m.marketData = db
+	candle.SetStore(candleStore)
+	trade.SetStore(tradeStore)
+	return nil
+}
+
+// stopMarketData reverts candle and trade storage to the main database and
+// closes the time-series connection
+func (m *DatabaseConnectionManager) stopMarketData() {
+	if m.marketData == nil {
+		return
+	}
+	candle.SetStore(nil)
+	trade.SetStore(nil)
+	if err := m.marketData.Close(); err != nil {
+		log.Errorf(log.DatabaseMgr, "Failed to close market data database: %v", err)
+	}
+	m.marketData = nil
+}
+
 func (m *DatabaseConnectionManager) run(wg *sync.WaitGroup) {
 	log.Debugln(log.DatabaseMgr, "Database manager started.")
 	t := time.NewTicker(time.Second * 2)
@@ -184,6 +242,11 @@ func (m *DatabaseConnectionManager) checkConnection() error {
 		m.dbConn.SetConnected(false)
 		return err
 	}
+	if m.marketData != nil {
+		if err := m.marketData.Ping(); err != nil {
+			return fmt.Errorf("market data %w", err)
+		}
+	}
 
 	if !m.dbConn.IsConnected() {
 		log.Infoln(log.DatabaseMgr, "Database connection reestablished")
@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/timescale"
 )
 
 func CreateDatabase(t *testing.T) {
@@ -217,3 +220,32 @@ func TestGetInstance(t *testing.T) {
 		t.Error("expected nil")
 	}
 }
+
+// TestStartMarketData does not require a successful connection
+func TestStartMarketData(t *testing.T) {
+	CreateDatabase(t)
+	m, err := SetupDatabaseConnectionManager(&database.Config{
+		Enabled: true,
+		Driver:  database.DBSQLite,
+		ConnectionDetails: drivers.ConnectionDetails{
+			Host:     "localhost",
+			Database: "test.db",
+		},
+		MarketData: &database.MarketDataConfig{
+			Enabled: true,
+			Driver:  database.DBTimescaleDB,
+			ConnectionDetails: drivers.ConnectionDetails{
+				Host: "localhost",
+			},
+		},
+	})
+	require.NoError(t, err, "SetupDatabaseConnectionManager must not error")
+
+	var wg sync.WaitGroup
+	err = m.Start(&wg)
+	assert.ErrorIs(t, err, database.ErrFailedToConnect)
+	assert.False(t, m.IsRunning(), "IsRunning should return false when market data fails to connect")
+	assert.Nil(t, m.marketData, "marketData should be nil when market data fails to connect")
+	_, ok := candle.GetStore().(*timescale.CandleStore)
+	assert.False(t, ok, "candle store should remain the database store")
+}
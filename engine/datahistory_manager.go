@@ -12,14 +12,19 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	fundingratesql "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/openinterest"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/pricecandle"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -69,6 +74,10 @@ func SetupDataHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager
 		tradeSaver:                 trade.SaveTradesToDatabase,
 		candleLoader:               kline.LoadFromDatabase,
 		candleSaver:                kline.StoreInDatabase,
+		fundingRateSaver:           fundingratesql.Insert,
+		openInterestSaver:          openinterest.Insert,
+		priceCandleLoader:          pricecandle.Series,
+		priceCandleSaver:           pricecandle.Insert,
 	}, nil
 }
 
@@ -199,7 +208,9 @@ func (m *DataHistoryManager) compareJobsToData(jobs ...*DataHistoryJob) error {
 			if err != nil {
 				return err
 			}
-		case dataHistoryTradeDataType:
+		case dataHistoryTradeDataType,
+			dataHistoryFundingRateDataType,
+			dataHistoryOpenInterestDataType:
 			for x := range jobs[i].rangeHolder.Ranges {
 				results, ok := jobs[i].Results[jobs[i].rangeHolder.Ranges[x].Start.Time.Unix()]
 				if !ok {
@@ -226,6 +237,38 @@ func (m *DataHistoryManager) compareJobsToData(jobs ...*DataHistoryJob) error {
 			if err != nil {
 				return err
 			}
+		case dataHistoryMarkPriceCandleDataType,
+			dataHistoryIndexPriceCandleDataType:
+			var priceCandles *pricecandle.Item
+			priceCandles, err = m.priceCandleLoader(context.TODO(),
+				jobs[i].Exchange,
+				jobs[i].Pair.Base.String(),
+				jobs[i].Pair.Quote.String(),
+				jobs[i].Asset.String(),
+				jobs[i].DataType.priceCandleType(),
+				int64(jobs[i].Interval.Duration().Seconds()),
+				jobs[i].StartDate,
+				jobs[i].EndDate)
+			if err != nil && !errors.Is(err, pricecandle.ErrNoPriceCandlesFound) {
+				return fmt.Errorf("%s could not load %s data: %w", jobs[i].Nickname, jobs[i].DataType, err)
+			}
+			candles = &kline.Item{}
+			if priceCandles != nil {
+				candles.Candles = make([]kline.Candle, len(priceCandles.Candles))
+				for x := range priceCandles.Candles {
+					candles.Candles[x] = kline.Candle{
+						Time:  priceCandles.Candles[x].Timestamp,
+						Open:  priceCandles.Candles[x].Open,
+						High:  priceCandles.Candles[x].High,
+						Low:   priceCandles.Candles[x].Low,
+						Close: priceCandles.Candles[x].Close,
+					}
+				}
+			}
+			err = jobs[i].rangeHolder.SetHasDataFromCandles(candles.Candles)
+			if err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("%s %w %s", jobs[i].Nickname, errUnknownDataType, jobs[i].DataType)
 		}
@@ -441,6 +484,10 @@ ranges:
 		if hasDataInRange {
 			continue
 		}
+		if job.DataType == dataHistoryOpenInterestDataType && job.rangeHolder.Ranges[i].Start.Time.After(time.Now()) {
+			// open interest snapshots can only be taken once the range has begun
+			continue
+		}
 		if m.verbose {
 			log.Debugf(log.DataHistory, "job %s processing range %v-%v", job.Nickname, job.rangeHolder.Ranges[i].Start, job.rangeHolder.Ranges[i].End)
 		}
@@ -456,6 +503,12 @@ ranges:
 			result, err = m.convertTradesToCandles(job, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
 		case dataHistoryConvertCandlesDataType:
 			result, err = m.convertCandleData(job, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
+		case dataHistoryFundingRateDataType:
+			result, err = m.processFundingRateData(job, exch, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
+		case dataHistoryOpenInterestDataType:
+			result, err = m.processOpenInterestData(job, exch, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
+		case dataHistoryMarkPriceCandleDataType, dataHistoryIndexPriceCandleDataType:
+			result, err = m.processPriceCandleData(job, exch, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time, int64(i))
 		default:
 			return errUnknownDataType
 		}
@@ -821,6 +874,232 @@ func (m *DataHistoryManager) processTradeData(job *DataHistoryJob, exch exchange
 	return r, nil
 }
 
+func (m *DataHistoryManager) processFundingRateData(job *DataHistoryJob, exch exchange.IBotExchange, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	if err := common.StartEndTimeCheck(startRange, endRange); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: startRange,
+		IntervalEndDate:   endRange,
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	rates, err := exch.GetHistoricalFundingRates(context.TODO(), &fundingrate.HistoricalRatesRequest{
+		Asset:                job.Asset,
+		Pair:                 job.Pair,
+		StartDate:            startRange,
+		EndDate:              endRange,
+		RespectHistoryLimits: true,
+	})
+	if err != nil {
+		r.Result += "could not get funding rates: " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+		return r, nil //nolint:nilerr // error is returned in the job result
+	}
+	if len(rates.FundingRates) == 0 {
+		// funding is paid at fixed times, so a range shorter than the
+		// funding interval can legitimately have no rates
+		r.Result += fmt.Sprintf("no funding rates from %v - %v. ",
+			startRange.Format(common.SimpleTimeFormatWithTimezone),
+			endRange.Format(common.SimpleTimeFormatWithTimezone))
+		return r, nil
+	}
+	item := &fundingratesql.Item{
+		Exchange:    job.Exchange,
+		Base:        job.Pair.Base.String(),
+		Quote:       job.Pair.Quote.String(),
+		Asset:       job.Asset.String(),
+		SourceJobID: job.ID.String(),
+		Rates:       make([]fundingratesql.Rate, len(rates.FundingRates)),
+	}
+	for i := range rates.FundingRates {
+		item.Rates[i] = fundingratesql.Rate{
+			Timestamp: rates.FundingRates[i].Time,
+			Rate:      rates.FundingRates[i].Rate.InexactFloat64(),
+			Payment:   rates.FundingRates[i].Payment.InexactFloat64(),
+		}
+	}
+	if m.verbose {
+		log.Debugf(log.DataHistory, "Saving %v funding rates", len(item.Rates))
+	}
+	_, err = m.fundingRateSaver(context.TODO(), item, job.OverwriteExistingData)
+	if err != nil {
+		r.Result += "could not save results: " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+	}
+	return r, nil
+}
+
+// processOpenInterestData takes a snapshot of the current open interest.
+// Exchanges only report current open interest, so ranges which have already
+// passed cannot be backfilled and are marked as having issues
+func (m *DataHistoryManager) processOpenInterestData(job *DataHistoryJob, exch exchange.IBotExchange, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	if err := common.StartEndTimeCheck(startRange, endRange); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: startRange,
+		IntervalEndDate:   endRange,
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	if !r.Date.Before(endRange) {
+		r.Result += fmt.Sprintf("cannot snapshot open interest for elapsed period %v - %v. ",
+			startRange.Format(common.SimpleTimeFormatWithTimezone),
+			endRange.Format(common.SimpleTimeFormatWithTimezone))
+		r.Status = dataHistoryIntervalIssuesFound
+		return r, nil
+	}
+	resp, err := exch.GetOpenInterest(context.TODO(), key.PairAsset{
+		Base:  job.Pair.Base.Item,
+		Quote: job.Pair.Quote.Item,
+		Asset: job.Asset,
+	})
+	if err != nil {
+		r.Result += "could not get open interest: " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+		return r, nil //nolint:nilerr // error is returned in the job result
+	}
+	if len(resp) == 0 {
+		r.Result += "no open interest returned. "
+		r.Status = dataHistoryStatusFailed
+		return r, nil
+	}
+	item := &openinterest.Item{
+		Exchange:    job.Exchange,
+		Base:        job.Pair.Base.String(),
+		Quote:       job.Pair.Quote.String(),
+		Asset:       job.Asset.String(),
+		SourceJobID: job.ID.String(),
+		Snapshots: []openinterest.Snapshot{
+			{
+				Timestamp:    r.Date,
+				OpenInterest: resp[0].OpenInterest,
+			},
+		},
+	}
+	_, err = m.openInterestSaver(context.TODO(), item, job.OverwriteExistingData)
+	if err != nil {
+		r.Result += "could not save results: " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+	}
+	return r, nil
+}
+
+func (m *DataHistoryManager) processPriceCandleData(job *DataHistoryJob, exch exchange.IBotExchange, startRange, endRange time.Time, intervalIndex int64) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+	if err := common.StartEndTimeCheck(startRange, endRange); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: startRange,
+		IntervalEndDate:   endRange,
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	var candles *kline.Item
+	switch job.DataType {
+	case dataHistoryMarkPriceCandleDataType:
+		candles, err = exch.GetHistoricMarkPriceCandles(context.TODO(), job.Pair, job.Asset, job.Interval, startRange, endRange)
+	case dataHistoryIndexPriceCandleDataType:
+		candles, err = exch.GetHistoricIndexPriceCandles(context.TODO(), job.Pair, job.Asset, job.Interval, startRange, endRange)
+	default:
+		return nil, fmt.Errorf("%s %w %s", job.Nickname, errUnknownDataType, job.DataType)
+	}
+	if err != nil {
+		r.Result += "could not get " + job.DataType.String() + ": " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+		return r, nil //nolint:nilerr // error is returned in the job result
+	}
+	err = job.rangeHolder.SetHasDataFromCandles(candles.Candles)
+	if err != nil {
+		return nil, err
+	}
+	for i := range job.rangeHolder.Ranges[intervalIndex].Intervals {
+		if !job.rangeHolder.Ranges[intervalIndex].Intervals[i].HasData {
+			r.Status = dataHistoryStatusFailed
+			r.Result += fmt.Sprintf("missing data from %v - %v. ",
+				startRange.Format(common.SimpleTimeFormatWithTimezone),
+				endRange.Format(common.SimpleTimeFormatWithTimezone))
+		}
+	}
+	if len(candles.Candles) == 0 {
+		return r, nil
+	}
+	item := &pricecandle.Item{
+		Exchange:    job.Exchange,
+		Base:        job.Pair.Base.String(),
+		Quote:       job.Pair.Quote.String(),
+		Asset:       job.Asset.String(),
+		PriceType:   job.DataType.priceCandleType(),
+		Interval:    int64(job.Interval.Duration().Seconds()),
+		SourceJobID: job.ID.String(),
+		Candles:     make([]pricecandle.Candle, len(candles.Candles)),
+	}
+	for i := range candles.Candles {
+		item.Candles[i] = pricecandle.Candle{
+			Timestamp: candles.Candles[i].Time,
+			Open:      candles.Candles[i].Open,
+			High:      candles.Candles[i].High,
+			Low:       candles.Candles[i].Low,
+			Close:     candles.Candles[i].Close,
+		}
+	}
+	if m.verbose {
+		log.Debugf(log.DataHistory, "Saving %v %s", len(item.Candles), job.DataType)
+	}
+	_, err = m.priceCandleSaver(context.TODO(), item, job.OverwriteExistingData)
+	if err != nil {
+		r.Result += "could not save results: " + err.Error() + ". "
+		r.Status = dataHistoryStatusFailed
+	}
+	return r, nil
+}
+
 func (m *DataHistoryManager) convertTradesToCandles(job *DataHistoryJob, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
 	if !m.IsRunning() {
 		return nil, ErrSubSystemNotStarted
@@ -1233,6 +1512,19 @@ func (m *DataHistoryManager) validateJob(job *DataHistoryJob) error {
 	if job.RequestSizeLimit <= 0 {
 		job.RequestSizeLimit = defaultDataHistoryRequestSizeLimit
 	}
+	switch job.DataType {
+	case dataHistoryFundingRateDataType,
+		dataHistoryOpenInterestDataType,
+		dataHistoryMarkPriceCandleDataType,
+		dataHistoryIndexPriceCandleDataType:
+		if !job.Asset.IsFutures() {
+			return fmt.Errorf("job %s %w: %s %s", job.Nickname, errFuturesAssetRequired, job.DataType, job.Asset)
+		}
+	}
+	if job.DataType == dataHistoryOpenInterestDataType && job.RequestSizeLimit != defaultDataHistoryOpenInterestRequestSize {
+		log.Warnf(log.DataHistory, "job %s open interest request size %v unsupported, defaulting to %v interval per snapshot", job.Nickname, job.RequestSizeLimit, defaultDataHistoryOpenInterestRequestSize)
+		job.RequestSizeLimit = defaultDataHistoryOpenInterestRequestSize
+	}
 	if job.DataType == dataHistoryTradeDataType {
 		if job.Interval > kline.FourHour {
 			log.Warnf(log.DataHistory, "job %s interval %v above the limit of 4h, defaulting to %v interval size worth of trades to fetch", job.Nickname, job.Interval.Word(), defaultDataHistoryTradeInterval)
@@ -1250,7 +1542,10 @@ func (m *DataHistoryManager) validateJob(job *DataHistoryJob) error {
 	b := exch.GetBase()
 	// TODO: In future allow custom candles.
 	if !b.Features.Enabled.Kline.Intervals.ExchangeSupported(job.Interval) &&
-		(job.DataType == dataHistoryCandleDataType || job.DataType == dataHistoryCandleValidationDataType) {
+		(job.DataType == dataHistoryCandleDataType ||
+			job.DataType == dataHistoryCandleValidationDataType ||
+			job.DataType == dataHistoryMarkPriceCandleDataType ||
+			job.DataType == dataHistoryIndexPriceCandleDataType) {
 		return fmt.Errorf("job interval %s %s %w %s", job.Nickname, job.Interval.Word(), kline.ErrUnsupportedInterval, job.Exchange)
 	}
 	if job.DataType == dataHistoryConvertTradesDataType && job.Interval <= 0 {
@@ -10,17 +10,24 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	fundingratesql "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/openinterest"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/pricecandle"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
@@ -413,6 +420,12 @@ func TestValidateJob(t *testing.T) {
 	err = m.validateJob(dhj)
 	assert.NoError(t, err)
 
+	for _, dt := range []dataHistoryDataType{dataHistoryFundingRateDataType, dataHistoryOpenInterestDataType, dataHistoryMarkPriceCandleDataType, dataHistoryIndexPriceCandleDataType} {
+		dhj.DataType = dt
+		err = m.validateJob(dhj)
+		assert.ErrorIsf(t, err, errFuturesAssetRequired, "validateJob should require a futures asset for %s", dt)
+	}
+
 	dhj.DataType = dataHistoryCandleValidationSecondarySourceType
 	err = m.validateJob(dhj)
 	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
@@ -505,6 +518,27 @@ func TestCompareJobsToData(t *testing.T) {
 	err = m.compareJobsToData(dhj)
 	assert.NoError(t, err)
 
+	dhj.DataType = dataHistoryFundingRateDataType
+	err = m.compareJobsToData(dhj)
+	assert.NoError(t, err)
+
+	m.priceCandleLoader = dataHistoryPriceCandleLoader
+	dhj.DataType = dataHistoryMarkPriceCandleDataType
+	err = m.compareJobsToData(dhj)
+	require.NoError(t, err)
+	for i := range dhj.rangeHolder.Ranges {
+		for j := range dhj.rangeHolder.Ranges[i].Intervals {
+			assert.True(t, dhj.rangeHolder.Ranges[i].Intervals[j].HasData, "compareJobsToData should mark stored price candles as having data")
+		}
+	}
+
+	m.priceCandleLoader = func(context.Context, string, string, string, string, string, int64, time.Time, time.Time) (*pricecandle.Item, error) {
+		return nil, pricecandle.ErrNoPriceCandlesFound
+	}
+	dhj.DataType = dataHistoryIndexPriceCandleDataType
+	err = m.compareJobsToData(dhj)
+	assert.NoError(t, err, "ErrNoPriceCandlesFound should be non-fatal")
+
 	t.Run("no existing candle data for primary interval", func(t *testing.T) {
 		t.Parallel()
 		mgr, _ := createDHM(t)
@@ -1016,6 +1050,145 @@ func TestProcessTradeData(t *testing.T) {
 	}
 }
 
+func TestProcessFundingRateData(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.processFundingRateData(nil, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errNilJob)
+
+	j := &DataHistoryJob{
+		Exchange:  testExchange,
+		Asset:     asset.USDTMarginedFutures,
+		Pair:      currency.NewBTCUSDT(),
+		StartDate: time.Now().Add(-kline.EightHour.Duration() * 3).Truncate(kline.EightHour.Duration()),
+		EndDate:   time.Now().Truncate(kline.EightHour.Duration()),
+		Interval:  kline.EightHour,
+	}
+	_, err = m.processFundingRateData(j, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	_, err = m.processFundingRateData(j, exch, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, common.ErrDateUnset)
+
+	r, err := m.processFundingRateData(j, exch, j.StartDate, j.EndDate)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusFailed, r.Status, "processFundingRateData should fail when the exchange does not support funding rates")
+
+	var saved *fundingratesql.Item
+	m.fundingRateSaver = func(_ context.Context, item *fundingratesql.Item, _ bool) (uint64, error) {
+		saved = item
+		return uint64(len(item.Rates)), nil
+	}
+	r, err = m.processFundingRateData(j, dhmExchange{IBotExchange: exch}, j.StartDate, j.EndDate)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusComplete, r.Status)
+	require.NotNil(t, saved, "processFundingRateData must save funding rates")
+	assert.Len(t, saved.Rates, 3)
+	assert.Equal(t, 0.0001, saved.Rates[0].Rate)
+}
+
+func TestProcessOpenInterestData(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.processOpenInterestData(nil, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errNilJob)
+
+	tt := time.Now().Truncate(kline.OneHour.Duration())
+	j := &DataHistoryJob{
+		Exchange:  testExchange,
+		Asset:     asset.USDTMarginedFutures,
+		Pair:      currency.NewBTCUSDT(),
+		StartDate: tt.Add(-kline.OneHour.Duration()),
+		EndDate:   tt.Add(kline.OneHour.Duration()),
+		Interval:  kline.OneHour,
+	}
+	_, err = m.processOpenInterestData(j, nil, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	fakeExchange := dhmExchange{IBotExchange: exch}
+	_, err = m.processOpenInterestData(j, fakeExchange, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, common.ErrDateUnset)
+
+	r, err := m.processOpenInterestData(j, fakeExchange, j.StartDate, tt)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryIntervalIssuesFound, r.Status, "processOpenInterestData should not backfill elapsed periods")
+
+	r, err = m.processOpenInterestData(j, exch, tt, j.EndDate)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusFailed, r.Status, "processOpenInterestData should fail when the exchange does not support open interest")
+
+	var saved *openinterest.Item
+	m.openInterestSaver = func(_ context.Context, item *openinterest.Item, _ bool) (uint64, error) {
+		saved = item
+		return 1, nil
+	}
+	r, err = m.processOpenInterestData(j, fakeExchange, tt, j.EndDate)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusComplete, r.Status)
+	require.NotNil(t, saved, "processOpenInterestData must save the snapshot")
+	require.Len(t, saved.Snapshots, 1)
+	assert.Equal(t, 1337.0, saved.Snapshots[0].OpenInterest)
+}
+
+func TestProcessPriceCandleData(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.processPriceCandleData(nil, nil, time.Time{}, time.Time{}, 0)
+	assert.ErrorIs(t, err, errNilJob)
+
+	j := &DataHistoryJob{
+		Exchange:  testExchange,
+		Asset:     asset.USDTMarginedFutures,
+		Pair:      currency.NewBTCUSDT(),
+		StartDate: time.Now().Add(-kline.OneHour.Duration() * 3).Truncate(kline.OneHour.Duration()),
+		EndDate:   time.Now().Truncate(kline.OneHour.Duration()),
+		Interval:  kline.OneHour,
+		DataType:  dataHistoryMarkPriceCandleDataType,
+	}
+	_, err = m.processPriceCandleData(j, nil, time.Time{}, time.Time{}, 0)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	fakeExchange := dhmExchange{IBotExchange: exch}
+	_, err = m.processPriceCandleData(j, fakeExchange, time.Time{}, time.Time{}, 0)
+	assert.ErrorIs(t, err, common.ErrDateUnset)
+
+	j.rangeHolder, err = kline.CalculateCandleDateRanges(j.StartDate, j.EndDate, j.Interval, 1337)
+	require.NoError(t, err)
+	var saved *pricecandle.Item
+	m.priceCandleSaver = func(_ context.Context, item *pricecandle.Item, _ bool) (uint64, error) {
+		saved = item
+		return uint64(len(item.Candles)), nil
+	}
+	r, err := m.processPriceCandleData(j, fakeExchange, j.StartDate, j.EndDate, 0)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusComplete, r.Status)
+	require.NotNil(t, saved, "processPriceCandleData must save price candles")
+	assert.Equal(t, markPriceCandleType, saved.PriceType)
+	assert.Equal(t, int64(3600), saved.Interval)
+	assert.Len(t, saved.Candles, 3)
+
+	j.DataType = dataHistoryIndexPriceCandleDataType
+	r, err = m.processPriceCandleData(j, exch, j.StartDate, j.EndDate, 0)
+	require.NoError(t, err)
+	assert.Equal(t, dataHistoryStatusFailed, r.Status, "processPriceCandleData should fail when the exchange does not support index price candles")
+
+	j.DataType = dataHistoryCandleDataType
+	_, err = m.processPriceCandleData(j, fakeExchange, j.StartDate, j.EndDate, 0)
+	assert.ErrorIs(t, err, errUnknownDataType)
+}
+
 func TestConvertJobTradesToCandles(t *testing.T) {
 	t.Parallel()
 	m, _ := createDHM(t)
@@ -1409,6 +1582,21 @@ func dataHistoryCandleLoader(exch string, cp currency.Pair, a asset.Item, interv
 	}, nil
 }
 
+func dataHistoryPriceCandleLoader(_ context.Context, exch, base, quote, a, priceType string, interval int64, start, end time.Time) (*pricecandle.Item, error) {
+	resp := &pricecandle.Item{
+		Exchange:  exch,
+		Base:      base,
+		Quote:     quote,
+		Asset:     a,
+		PriceType: priceType,
+		Interval:  interval,
+	}
+	for ts := start.Truncate(time.Duration(interval) * time.Second); !ts.After(end); ts = ts.Add(time.Duration(interval) * time.Second) {
+		resp.Candles = append(resp.Candles, pricecandle.Candle{Timestamp: ts, Open: 1, High: 2, Low: 1, Close: 2})
+	}
+	return resp, nil
+}
+
 func dataHistoryTradeSaver(...trade.Data) error {
 	return nil
 }
@@ -1480,3 +1668,40 @@ func (f dhmExchange) GetHistoricTrades(_ context.Context, p currency.Pair, a ass
 		},
 	}, nil
 }
+
+func (f dhmExchange) GetHistoricMarkPriceCandles(ctx context.Context, p currency.Pair, a asset.Item, interval kline.Interval, timeStart, timeEnd time.Time) (*kline.Item, error) {
+	return f.GetHistoricCandlesExtended(ctx, p, a, interval, timeStart, timeEnd)
+}
+
+func (f dhmExchange) GetHistoricalFundingRates(_ context.Context, r *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error) {
+	resp := &fundingrate.HistoricalRates{
+		Exchange:  testExchange,
+		Asset:     r.Asset,
+		Pair:      r.Pair,
+		StartDate: r.StartDate,
+		EndDate:   r.EndDate,
+	}
+	for ts := r.StartDate; ts.Before(r.EndDate); ts = ts.Add(kline.EightHour.Duration()) {
+		resp.FundingRates = append(resp.FundingRates, fundingrate.Rate{
+			Time: ts,
+			Rate: decimal.NewFromFloat(0.0001),
+		})
+	}
+	return resp, nil
+}
+
+func (f dhmExchange) GetOpenInterest(_ context.Context, k ...key.PairAsset) ([]futures.OpenInterest, error) {
+	resp := make([]futures.OpenInterest, len(k))
+	for i := range k {
+		resp[i] = futures.OpenInterest{
+			Key: key.ExchangeAssetPair{
+				Exchange: testExchange,
+				Base:     k[i].Base,
+				Quote:    k[i].Quote,
+				Asset:    k[i].Asset,
+			},
+			OpenInterest: 1337,
+		}
+	}
+	return resp, nil
+}
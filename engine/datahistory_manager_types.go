@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -9,6 +10,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	fundingratesql "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/openinterest"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/pricecandle"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
@@ -29,6 +33,16 @@ const (
 	dataHistoryConvertCandlesDataType
 	dataHistoryCandleValidationDataType
 	dataHistoryCandleValidationSecondarySourceType
+	dataHistoryFundingRateDataType
+	dataHistoryOpenInterestDataType
+	dataHistoryMarkPriceCandleDataType
+	dataHistoryIndexPriceCandleDataType
+)
+
+// Price candle types stored alongside mark and index price candles
+const (
+	markPriceCandleType  = "mark"
+	indexPriceCandleType = "index"
 )
 
 // DataHistoryJob status descriptors
@@ -81,13 +95,33 @@ func (d dataHistoryDataType) String() string {
 		return "conversion validation"
 	case 5:
 		return "conversion validation secondary source"
+	case 6:
+		return "funding rates"
+	case 7:
+		return "open interest"
+	case 8:
+		return "mark price candles"
+	case 9:
+		return "index price candles"
 	}
 	return ""
 }
 
 // Valid ensures the value set is legitimate
 func (d dataHistoryDataType) Valid() bool {
-	return int64(d) >= 0 && int64(d) <= 5
+	return int64(d) >= 0 && int64(d) <= 9
+}
+
+// priceCandleType returns the price type stored for mark and index price
+// candle jobs
+func (d dataHistoryDataType) priceCandleType() string {
+	switch d {
+	case dataHistoryMarkPriceCandleDataType:
+		return markPriceCandleType
+	case dataHistoryIndexPriceCandleDataType:
+		return indexPriceCandleType
+	}
+	return ""
 }
 
 var (
@@ -106,6 +140,7 @@ var (
 	errNilResult                  = errors.New("received nil job result")
 	errJobMustBeActiveOrPaused    = errors.New("job must be active or paused to be set as a prerequisite")
 	errNilCandles                 = errors.New("received nil candles")
+	errFuturesAssetRequired       = errors.New("job data type requires a futures asset")
 )
 
 const (
@@ -120,10 +155,13 @@ const (
 	defaultDataHistoryTicker                  = time.Minute
 	defaultDataHistoryTradeRequestSize uint64 = 10
 	defaultDecimalPlaceComparison      uint64 = 3
+	// defaultDataHistoryOpenInterestRequestSize is fixed as each open interest
+	// snapshot can only be taken at the time a job is run
+	defaultDataHistoryOpenInterestRequestSize uint64 = 1
 )
 
 // DataHistoryManager is responsible for synchronising,
-// retrieving and saving candle and trade data from loaded jobs
+// retrieving and saving candle, trade and futures data from loaded jobs
 type DataHistoryManager struct {
 	exchangeManager            iExchangeManager
 	databaseConnectionInstance database.IDatabase
@@ -140,6 +178,10 @@ type DataHistoryManager struct {
 	tradeLoader                func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
 	tradeSaver                 func(...trade.Data) error
 	candleSaver                func(*kline.Item, bool) (uint64, error)
+	fundingRateSaver           func(context.Context, *fundingratesql.Item, bool) (uint64, error)
+	openInterestSaver          func(context.Context, *openinterest.Item, bool) (uint64, error)
+	priceCandleLoader          func(context.Context, string, string, string, string, string, int64, time.Time, time.Time) (*pricecandle.Item, error)
+	priceCandleSaver           func(context.Context, *pricecandle.Item, bool) (uint64, error)
 }
 
 // DataHistoryJob used to gather candle/trade history and save
@@ -18,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/secrets"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -27,6 +28,7 @@ import (
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 // Engine contains configuration, portfolio manager, exchange & ticker data and is the
@@ -47,7 +49,19 @@ type Engine struct {
 	WebsocketRoutineManager *WebsocketRoutineManager
 	WithdrawManager         *WithdrawManager
 	dataHistoryManager      *DataHistoryManager
+	dataQualityManager      *DataQualityManager
 	currencyStateManager    *CurrencyStateManager
+	futuresRiskManager      *FuturesRiskManager
+	transferManager         *TransferManager
+	metricsExporter         *MetricsExporter
+	tracingProvider         *sdktrace.TracerProvider
+	tracingFile             *os.File
+	healthChecker           *HealthChecker
+	configReloadMtx         sync.Mutex
+	configMtx               sync.RWMutex
+	pendingConfigChanges    []byte
+	apiTokens               *apiTokenStore
+	credentialProvider      *secrets.Cache
 	Settings                Settings
 	uptime                  time.Time
 	GRPCShutdownSignal      chan struct{}
@@ -148,6 +162,31 @@ func loadConfigWithSettings(settings *Settings, flagSet map[string]bool) (*confi
 	return conf, conf.CheckConfig()
 }
 
+// syncManagerConfig returns the sync manager config with any command line
+// overrides applied
+func (bot *Engine) syncManagerConfig() config.SyncManagerConfig {
+	cfg := bot.Config.SyncManagerConfig
+	cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
+	cfg.SynchronizeOrderbook = bot.Settings.EnableOrderbookSyncing
+	cfg.SynchronizeContinuously = bot.Settings.SyncContinuously
+	cfg.SynchronizeTrades = bot.Settings.EnableTradeSyncing
+	cfg.Verbose = bot.Settings.Verbose || cfg.Verbose
+
+	if cfg.TimeoutREST != bot.Settings.SyncTimeoutREST &&
+		bot.Settings.SyncTimeoutREST != config.DefaultSyncerTimeoutREST {
+		cfg.TimeoutREST = bot.Settings.SyncTimeoutREST
+	}
+	if cfg.TimeoutWebsocket != bot.Settings.SyncTimeoutWebsocket &&
+		bot.Settings.SyncTimeoutWebsocket != config.DefaultSyncerTimeoutWebsocket {
+		cfg.TimeoutWebsocket = bot.Settings.SyncTimeoutWebsocket
+	}
+	if cfg.NumWorkers != bot.Settings.SyncWorkersCount &&
+		bot.Settings.SyncWorkersCount != config.DefaultSyncerWorkers {
+		cfg.NumWorkers = bot.Settings.SyncWorkersCount
+	}
+	return cfg
+}
+
 // FlagSet defines set flags from command line args for comparison methods
 type FlagSet map[string]bool
 
@@ -174,7 +213,13 @@ func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 	flagSet.WithBool("openexchangerates", &b.Settings.EnableOpenExchangeRates, b.Config.Currency.ForexProviders.IsEnabled("openexchangerates"))
 
 	flagSet.WithBool("datahistorymanager", &b.Settings.EnableDataHistoryManager, b.Config.DataHistoryManager.Enabled)
+	flagSet.WithBool("dataqualitymanager", &b.Settings.EnableDataQualityManager, b.Config.DataQualityManager.Enabled)
 	flagSet.WithBool("currencystatemanager", &b.Settings.EnableCurrencyStateManager, b.Config.CurrencyStateManager.Enabled != nil && *b.Config.CurrencyStateManager.Enabled)
+	flagSet.WithBool("futuresriskmanager", &b.Settings.EnableFuturesRiskManager, b.Config.FuturesRiskManager.Enabled)
+	flagSet.WithBool("transfermanager", &b.Settings.EnableTransferManager, b.Config.TransferManager.Enabled)
+	flagSet.WithBool("healthcheck", &b.Settings.EnableHealthCheck, b.Config.HealthCheck.Enabled)
+	flagSet.WithBool("tracing", &b.Settings.EnableTracing, b.Config.Tracing.Enabled)
+	flagSet.WithBool("metricsexporter", &b.Settings.EnableMetricsExporter, b.Config.MetricsExporter.Enabled)
 	flagSet.WithBool("gctscriptmanager", &b.Settings.EnableGCTScriptManager, b.Config.GCTScript.Enabled)
 
 	flagSet.WithBool("tickersync", &b.Settings.EnableTickerSyncing, b.Config.SyncManagerConfig.SynchronizeTicker)
@@ -301,6 +346,12 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableTracing {
+		if err := bot.setupTracing(); err != nil {
+			gctlog.Errorln(gctlog.Global, err)
+		}
+	}
+
 	if bot.Settings.EnableDatabaseManager {
 		if d, err := SetupDatabaseConnectionManager(&bot.Config.Database); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to setup: %v", err)
@@ -318,9 +369,21 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableCommsRelayer {
+		if c, err := SetupCommunicationManager(&bot.Config.Communications); err != nil {
+			gctlog.Errorf(gctlog.Global, "Communications manager unable to setup: %s", err)
+		} else {
+			bot.CommunicationsManager = c
+			bot.CommunicationsManager.SetCommandHandler(newChatCommandHandler(bot))
+			if err := bot.CommunicationsManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Communications manager unable to start: %s", err)
+			}
+		}
+	}
+
 	// Sets up internet connectivity monitor
 	if bot.Settings.EnableConnectivityMonitor {
-		if c, err := setupConnectionManager(&bot.Config.ConnectionMonitor); err != nil {
+		if c, err := setupConnectionManager(&bot.Config.ConnectionMonitor, bot.CommunicationsManager); err != nil {
 			gctlog.Errorf(gctlog.Global, "Connection manager unable to setup: %v", err)
 		} else {
 			bot.connectionManager = c
@@ -373,22 +436,41 @@ func (bot *Engine) Start() error {
 		bot.Config.PurgeExchangeAPICredentials()
 	}
 
-	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
-	if err := bot.SetupExchanges(); err != nil {
+	if err := bot.setupCredentialProvider(); err != nil {
 		return err
 	}
 
-	if bot.Settings.EnableCommsRelayer {
-		if c, err := SetupCommunicationManager(&bot.Config.Communications); err != nil {
-			gctlog.Errorf(gctlog.Global, "Communications manager unable to setup: %s", err)
+	// Started before exchanges are set up so that their first requests and
+	// websocket connections are recorded
+	if bot.Settings.EnableMetricsExporter {
+		if m, err := SetupMetricsExporter(bot, &bot.Config.MetricsExporter); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics exporter unable to setup: %s", err)
 		} else {
-			bot.CommunicationsManager = c
-			if err := bot.CommunicationsManager.Start(); err != nil {
-				gctlog.Errorf(gctlog.Global, "Communications manager unable to start: %s", err)
+			bot.metricsExporter = m
+			if err := bot.metricsExporter.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Metrics exporter unable to start: %s", err)
 			}
 		}
 	}
 
+	// Started before exchanges are set up so liveness probes pass while
+	// exchanges load, readiness fails until the engine has started
+	if bot.Settings.EnableHealthCheck {
+		if h, err := SetupHealthChecker(bot, &bot.Config.HealthCheck); err != nil {
+			gctlog.Errorf(gctlog.Global, "Health checker unable to setup: %s", err)
+		} else {
+			bot.healthChecker = h
+			if err := bot.healthChecker.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Health checker unable to start: %s", err)
+			}
+		}
+	}
+
+	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
+	if err := bot.SetupExchanges(); err != nil {
+		return err
+	}
+
 	if err := currency.RunStorageUpdater(
 		currency.BotOverrides{
 			Coinmarketcap:     bot.Settings.EnableCoinmarketcapAnalysis,
@@ -434,7 +516,18 @@ func (bot *Engine) Start() error {
 		}
 	}
 
-	if w, err := SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.Settings.EnableDryRun); err != nil {
+	if bot.Settings.EnableDataQualityManager {
+		if d, err := SetupDataQualityManager(bot.DatabaseManager, bot.dataHistoryManager, &bot.Config.DataQualityManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Data quality manager unable to setup: %s", err)
+		} else {
+			bot.dataQualityManager = d
+			if err := bot.dataQualityManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Data quality manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if w, err := SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.CommunicationsManager, bot.Settings.EnableDryRun); err != nil {
 		return err
 	} else { //nolint:revive // TODO: revive false positive, see https://github.com/mgechev/revive/pull/832 for more information
 		bot.WithdrawManager = w
@@ -449,6 +542,25 @@ func (bot *Engine) Start() error {
 		}()
 	}
 
+	if bot.Settings.EnableTransferManager {
+		if bot.DepositAddressManager == nil {
+			gctlog.Errorf(gctlog.Global, "Transfer manager unable to setup: %s", errNilDepositAddressManager)
+		} else if t, err := SetupTransferManager(
+			bot.ExchangeManager,
+			bot.WithdrawManager,
+			bot.DepositAddressManager,
+			bot.CommunicationsManager,
+			&bot.Config.TransferManager,
+			filepath.Join(bot.Settings.DataDir, transferStoreFile)); err != nil {
+			gctlog.Errorf(gctlog.Global, "Transfer manager unable to setup: %s", err)
+		} else {
+			bot.transferManager = t
+			if err = bot.transferManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Transfer manager unable to start: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableOrderManager {
 		if o, err := SetupOrderManager(
 			bot.ExchangeManager,
@@ -458,32 +570,34 @@ func (bot *Engine) Start() error {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
 			bot.OrderManager = o
+			if err = bot.OrderManager.tca.load(filepath.Join(bot.Settings.DataDir, tcaStoreFile)); err != nil {
+				gctlog.Errorf(gctlog.Global, "Order manager unable to load transaction costs: %s", err)
+			}
 			if err = bot.OrderManager.Start(); err != nil {
 				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
 			}
 		}
 	}
 
-	if bot.Settings.EnableExchangeSyncManager {
-		cfg := bot.Config.SyncManagerConfig
-		cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
-		cfg.SynchronizeOrderbook = bot.Settings.EnableOrderbookSyncing
-		cfg.SynchronizeContinuously = bot.Settings.SyncContinuously
-		cfg.SynchronizeTrades = bot.Settings.EnableTradeSyncing
-		cfg.Verbose = bot.Settings.Verbose || cfg.Verbose
-
-		if cfg.TimeoutREST != bot.Settings.SyncTimeoutREST &&
-			bot.Settings.SyncTimeoutREST != config.DefaultSyncerTimeoutREST {
-			cfg.TimeoutREST = bot.Settings.SyncTimeoutREST
-		}
-		if cfg.TimeoutWebsocket != bot.Settings.SyncTimeoutWebsocket &&
-			bot.Settings.SyncTimeoutWebsocket != config.DefaultSyncerTimeoutWebsocket {
-			cfg.TimeoutWebsocket = bot.Settings.SyncTimeoutWebsocket
-		}
-		if cfg.NumWorkers != bot.Settings.SyncWorkersCount &&
-			bot.Settings.SyncWorkersCount != config.DefaultSyncerWorkers {
-			cfg.NumWorkers = bot.Settings.SyncWorkersCount
+	if bot.Settings.EnableFuturesRiskManager {
+		if !bot.OrderManager.IsRunning() {
+			gctlog.Errorf(gctlog.Global, "Futures risk manager unable to setup: order manager %s", ErrSubSystemNotStarted)
+		} else if f, err := SetupFuturesRiskManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.CommunicationsManager,
+			&bot.Config.FuturesRiskManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Futures risk manager unable to setup: %s", err)
+		} else {
+			bot.futuresRiskManager = f
+			if err = bot.futuresRiskManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Futures risk manager unable to start: %s", err)
+			}
 		}
+	}
+
+	if bot.Settings.EnableExchangeSyncManager {
+		cfg := bot.syncManagerConfig()
 		if s, err := SetupSyncManager(
 			&cfg,
 			bot.ExchangeManager,
@@ -534,6 +648,13 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.WebsocketRoutineManager != nil && bot.gctScriptManager != nil {
+		// Order updates and fills are passed to event driven scripts
+		if err := bot.WebsocketRoutineManager.registerWebsocketDataHandler(bot.gctScriptManager.WebsocketDataHandler, false); err != nil {
+			gctlog.Errorf(gctlog.Global, "Unable to register GCTScript websocket data handler. Err: %s", err)
+		}
+	}
+
 	if bot.Settings.EnableCurrencyStateManager {
 		if c, err := SetupCurrencyStateManager(
 			bot.Config.CurrencyStateManager.Delay,
@@ -554,6 +675,8 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	bot.healthChecker.markReady()
+
 	return nil
 }
 
@@ -564,6 +687,8 @@ func (bot *Engine) Stop() {
 
 	gctlog.Debugln(gctlog.Global, "Engine shutting down..")
 
+	bot.healthChecker.markShuttingDown()
+
 	if len(bot.portfolioManager.GetAddresses()) != 0 {
 		bot.Config.Portfolio = bot.portfolioManager.GetPortfolio()
 	}
@@ -573,6 +698,26 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.transferManager.IsRunning() {
+		if err := bot.transferManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Transfer manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.metricsExporter.IsRunning() {
+		if err := bot.metricsExporter.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics exporter unable to stop. Error: %v", err)
+		}
+	}
+	if bot.healthChecker.IsRunning() {
+		if err := bot.healthChecker.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Health checker unable to stop. Error: %v", err)
+		}
+	}
+	if bot.futuresRiskManager.IsRunning() {
+		if err := bot.futuresRiskManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Futures risk manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.OrderManager.IsRunning() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -603,6 +748,11 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "Connection manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.dataQualityManager.IsRunning() {
+		if err := bot.dataQualityManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.DataHistory, "Data quality manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.dataHistoryManager.IsRunning() {
 		if err := bot.dataHistoryManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.DataHistory, "data history manager unable to stop. Error: %v", err)
@@ -636,13 +786,15 @@ func (bot *Engine) Stop() {
 		gctlog.Errorf(gctlog.Global, "Exchange manager unable to stop. Error: %v", err)
 	}
 
+	bot.shutdownTracing()
+
 	err = currency.ShutdownStorageUpdater()
 	if err != nil {
 		gctlog.Errorf(gctlog.Global, "Currency Converter unable to stop. Error: %v", err)
 	}
 
 	if !bot.Settings.EnableDryRun {
-		err = bot.Config.SaveConfigToFile(bot.Settings.ConfigFile)
+		err = bot.configToSave().SaveConfigToFile(bot.Settings.ConfigFile)
 		if err != nil {
 			gctlog.Errorln(gctlog.Global, "Unable to save config.")
 		} else {
@@ -675,7 +827,7 @@ func (bot *Engine) UnloadExchange(exchName string) error {
 		return err
 	}
 
-	exchCfg.Enabled = false
+	bot.updateConfig(func(*config.Config) { exchCfg.Enabled = false })
 	return nil
 }
 
@@ -707,6 +859,12 @@ func (bot *Engine) LoadExchange(name string) error {
 		return err
 	}
 
+	if exchCfg.API.UseCredentialProvider {
+		if err := bot.attachCredentialProvider(exch, exchCfg); err != nil {
+			return err
+		}
+	}
+
 	if bot.Settings.EnableAllPairs && exchCfg.CurrencyPairs != nil {
 		for _, a := range exchCfg.CurrencyPairs.GetAssetTypes(false) {
 			pairs, err := exchCfg.CurrencyPairs.GetPairs(a, false)
@@ -769,27 +927,10 @@ func (bot *Engine) LoadExchange(name string) error {
 
 	b := exch.GetBase()
 	if b.API.AuthenticatedSupport || b.API.AuthenticatedWebsocketSupport {
-		enabledAssets := b.CurrencyPairs.GetAssetTypes(true)
-		var preferredAsset asset.Item
-		switch {
-		case enabledAssets.Contains(asset.Spot): // prioritise validating credentials with spot due to wide usage across GCT
-			preferredAsset = asset.Spot
-		default:
-			for _, a := range enabledAssets { // second priority to futures if spot isn't available
-				if a.IsFutures() {
-					preferredAsset = a
-					break
-				}
-			}
-			if preferredAsset == 0 {
-				preferredAsset = enabledAssets[0] // last resort pick first available
-			}
-		}
-
+		preferredAsset := preferredCredentialAsset(b)
 		if err := exch.ValidateAPICredentials(context.TODO(), preferredAsset); err != nil {
 			gctlog.Warnf(gctlog.ExchangeSys, "%s: Error validating credentials: %v for %s", b.Name, err, preferredAsset)
-			b.API.AuthenticatedSupport = false
-			b.API.AuthenticatedWebsocketSupport = false
+			b.SetAuthenticatedSupport(false, false)
 			if b.Websocket != nil {
 				b.Websocket.SetCanUseAuthenticatedEndpoints(false)
 			}
@@ -799,6 +940,21 @@ func (bot *Engine) LoadExchange(name string) error {
 	return exchange.Bootstrap(context.TODO(), exch)
 }
 
+// preferredCredentialAsset returns the enabled asset used to validate an
+// exchange's API credentials
+func preferredCredentialAsset(b *exchange.Base) asset.Item {
+	enabledAssets := b.CurrencyPairs.GetAssetTypes(true)
+	if enabledAssets.Contains(asset.Spot) { // prioritise validating credentials with spot due to wide usage across GCT
+		return asset.Spot
+	}
+	for _, a := range enabledAssets { // second priority to futures if spot isn't available
+		if a.IsFutures() {
+			return a
+		}
+	}
+	return enabledAssets[0] // last resort pick first available
+}
+
 func (bot *Engine) dryRunParamInteraction(param string) {
 	if !bot.Settings.CheckParamInteraction {
 		return
@@ -37,6 +37,7 @@ type CoreSettings struct {
 	EnableCoinmarketcapAnalysis bool
 	EnablePortfolioManager      bool
 	EnableDataHistoryManager    bool
+	EnableDataQualityManager    bool
 	PortfolioManagerDelay       time.Duration
 	EnableGRPC                  bool
 	EnableGRPCProxy             bool
@@ -52,6 +53,11 @@ type CoreSettings struct {
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
 	EnableCurrencyStateManager  bool
+	EnableFuturesRiskManager    bool
+	EnableTransferManager       bool
+	EnableMetricsExporter       bool
+	EnableTracing               bool
+	EnableHealthCheck           bool
 	EventManagerDelay           time.Duration
 	EnableFuturesTracking       bool
 	Verbose                     bool
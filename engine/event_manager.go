@@ -98,7 +98,18 @@ func (m *eventManager) executeEvent(i int) {
 		}
 		msg := fmt.Sprintf("Events: ID: %d triggered on %s successfully [%v]\n", m.events[i].ID, m.events[i].Exchange, m.events[i].String())
 		log.Infoln(log.EventMgr, msg)
-		m.comms.PushEvent(base.Event{Type: "event", Message: msg})
+		m.comms.PushEvent(base.Event{
+			Category: base.CategoryEvent,
+			Message:  msg,
+			Exchange: m.events[i].Exchange,
+			Pair:     m.events[i].Pair.String(),
+			Payload: map[string]any{
+				"id":     m.events[i].ID,
+				"item":   m.events[i].Item,
+				"asset":  m.events[i].Asset.String(),
+				"action": m.events[i].Action,
+			},
+		})
 		m.events[i].Executed = true
 	}
 }
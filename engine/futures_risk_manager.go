@@ -0,0 +1,519 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupFuturesRiskManager applies configuration parameters before running
+func SetupFuturesRiskManager(em iExchangeManager, pm iFuturesPositionManager, comms iCommsManager, cfg *config.FuturesRiskManager) (*FuturesRiskManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if pm == nil {
+		return nil, errNilFuturesPositionManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	f := &FuturesRiskManager{
+		exchangeManager: em,
+		positionManager: pm,
+		commsManager:    comms,
+		cfg:             *cfg,
+		shutdown:        make(chan struct{}),
+		breaches:        make(map[string]RiskAlert),
+		subscribers:     make(map[int64]chan *FuturesRiskSnapshot),
+		contracts:       make(map[key.ExchangeAsset][]futures.Contract),
+	}
+	if f.cfg.Delay <= 0 {
+		log.Warnf(log.OrderMgr, "Futures risk manager delay is invalid, defaulting to: %s", DefaultFuturesRiskManagerDelay)
+		f.cfg.Delay = DefaultFuturesRiskManagerDelay
+	}
+	return f, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (f *FuturesRiskManager) IsRunning() bool {
+	return f != nil && atomic.LoadInt32(&f.started) == 1
+}
+
+// Start runs the subsystem
+func (f *FuturesRiskManager) Start() error {
+	if f == nil {
+		return fmt.Errorf("%s %w", FuturesRiskManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&f.started, 0, 1) {
+		return fmt.Errorf("%s %w", FuturesRiskManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Futures risk manager %s", MsgSubSystemStarting)
+	f.wg.Add(1)
+	go f.monitor()
+	log.Debugf(log.OrderMgr, "Futures risk manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (f *FuturesRiskManager) Stop() error {
+	if f == nil {
+		return fmt.Errorf("%s %w", FuturesRiskManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&f.started) == 0 {
+		return fmt.Errorf("%s %w", FuturesRiskManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Futures risk manager %s", MsgSubSystemShuttingDown)
+	close(f.shutdown)
+	f.wg.Wait()
+	f.shutdown = make(chan struct{})
+	f.m.Lock()
+	for id, ch := range f.subscribers {
+		close(ch)
+		delete(f.subscribers, id)
+	}
+	f.m.Unlock()
+	atomic.StoreInt32(&f.started, 0)
+	log.Debugf(log.OrderMgr, "Futures risk manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+func (f *FuturesRiskManager) monitor() {
+	defer f.wg.Done()
+	timer := time.NewTimer(0) // Prime firing of channel for initial calculation.
+	for {
+		select {
+		case <-f.shutdown:
+			timer.Stop()
+			return
+		case <-timer.C:
+			if _, err := f.Update(context.TODO()); err != nil && !errors.Is(err, errFuturesTrackingDisabled) {
+				log.Errorf(log.OrderMgr, "Futures risk manager failed to update: %v", err)
+			}
+			timer.Reset(f.cfg.Delay)
+		}
+	}
+}
+
+// GetLatestSnapshot returns the most recently calculated futures risk snapshot
+func (f *FuturesRiskManager) GetLatestSnapshot() (*FuturesRiskSnapshot, error) {
+	if f == nil {
+		return nil, fmt.Errorf("%s %w", FuturesRiskManagerName, ErrNilSubsystem)
+	}
+	if !f.IsRunning() {
+		return nil, fmt.Errorf("%s %w", FuturesRiskManagerName, ErrSubSystemNotStarted)
+	}
+	f.m.RLock()
+	defer f.m.RUnlock()
+	if f.latest == nil {
+		return nil, errNoRiskSnapshot
+	}
+	return f.latest, nil
+}
+
+// Subscribe returns a channel which receives every newly calculated futures
+// risk snapshot and a function to release the subscription. Slow receivers
+// only receive the most recent snapshot.
+func (f *FuturesRiskManager) Subscribe() (<-chan *FuturesRiskSnapshot, func(), error) {
+	if f == nil {
+		return nil, nil, fmt.Errorf("%s %w", FuturesRiskManagerName, ErrNilSubsystem)
+	}
+	if !f.IsRunning() {
+		return nil, nil, fmt.Errorf("%s %w", FuturesRiskManagerName, ErrSubSystemNotStarted)
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.subID++
+	id := f.subID
+	ch := make(chan *FuturesRiskSnapshot, riskSubscriberBuffer)
+	f.subscribers[id] = ch
+	return ch, func() {
+		f.m.Lock()
+		defer f.m.Unlock()
+		if sub, ok := f.subscribers[id]; ok {
+			close(sub)
+			delete(f.subscribers, id)
+		}
+	}, nil
+}
+
+// Update calculates a new futures risk snapshot, alerts on any newly breached
+// thresholds and publishes the snapshot to subscribers
+func (f *FuturesRiskManager) Update(ctx context.Context) (*FuturesRiskSnapshot, error) {
+	if f == nil {
+		return nil, fmt.Errorf("%s %w", FuturesRiskManagerName, ErrNilSubsystem)
+	}
+	if !f.IsRunning() {
+		return nil, fmt.Errorf("%s %w", FuturesRiskManagerName, ErrSubSystemNotStarted)
+	}
+	positions, err := f.positionManager.GetAllOpenFuturesPositions()
+	if err != nil && !errors.Is(err, futures.ErrNoPositionsFound) {
+		return nil, err
+	}
+
+	positionRisks := make([]PositionRisk, 0, len(positions))
+	accounts := make(map[key.ExchangeAsset]struct{})
+	for i := range positions {
+		exch, err := f.exchangeManager.GetExchangeByName(positions[i].Exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Futures risk manager %s: %v", positions[i].Exchange, err)
+			continue
+		}
+		positionRisks = append(positionRisks, f.getPositionRisk(ctx, exch, &positions[i]))
+		accounts[key.ExchangeAsset{Exchange: positions[i].Exchange, Asset: positions[i].Asset}] = struct{}{}
+	}
+
+	accountRisks := make([]AccountMarginRisk, 0, len(accounts))
+	for k := range accounts {
+		exch, err := f.exchangeManager.GetExchangeByName(k.Exchange)
+		if err != nil {
+			continue
+		}
+		acc, err := getAccountMarginRisk(ctx, exch, &k)
+		if err != nil {
+			if !errors.Is(err, common.ErrFunctionNotSupported) {
+				log.Errorf(log.OrderMgr, "Futures risk manager %s %s margin: %v", k.Exchange, k.Asset, err)
+			}
+			continue
+		}
+		accountRisks = append(accountRisks, *acc)
+	}
+
+	snapshot := buildFuturesRiskSnapshot(positionRisks, accountRisks, &f.cfg)
+	f.processAlerts(snapshot.Alerts)
+	f.publish(snapshot)
+	return snapshot, nil
+}
+
+// getPositionRisk enriches a tracked position with the exchange's position
+// summary where supported, falling back to the tracked latest price
+func (f *FuturesRiskManager) getPositionRisk(ctx context.Context, exch exchange.IBotExchange, p *futures.Position) PositionRisk {
+	pr := PositionRisk{
+		Exchange:      p.Exchange,
+		Asset:         p.Asset,
+		Pair:          p.Pair,
+		Underlying:    p.Underlying,
+		Side:          p.LatestDirection,
+		Size:          p.LatestSize.InexactFloat64(),
+		MarkPrice:     p.LatestPrice.InexactFloat64(),
+		UnrealisedPNL: p.UnrealisedPNL.InexactFloat64(),
+	}
+	if pr.Underlying.IsEmpty() {
+		pr.Underlying = p.Pair.Base
+	}
+	summary, err := exch.GetFuturesPositionSummary(ctx, &futures.PositionSummaryRequest{
+		Asset: p.Asset,
+		Pair:  p.Pair,
+	})
+	if err != nil {
+		if f.cfg.Verbose {
+			log.Debugf(log.OrderMgr, "Futures risk manager %s %s %s position summary unavailable: %v", p.Exchange, p.Asset, p.Pair, err)
+		}
+		summary = nil
+	}
+	var contractValue float64
+	if summary != nil {
+		if summary.MarkPrice.IsPositive() {
+			pr.MarkPrice = summary.MarkPrice.InexactFloat64()
+		}
+		if !summary.UnrealisedPNL.IsZero() {
+			pr.UnrealisedPNL = summary.UnrealisedPNL.InexactFloat64()
+		}
+		pr.LiquidationPrice = summary.EstimatedLiquidationPrice.InexactFloat64()
+		pr.SettlementType = summary.ContractSettlementType
+		contractValue = summary.ContractMultiplier.InexactFloat64()
+	}
+	if pr.SettlementType == futures.UnsetSettlementType || contractValue <= 0 {
+		if c := f.getContract(ctx, exch, p.Asset, p.Pair); c != nil {
+			if pr.SettlementType == futures.UnsetSettlementType {
+				pr.SettlementType = c.SettlementType
+			}
+			if contractValue <= 0 {
+				contractValue = c.Multiplier
+			}
+		}
+	}
+	pr.Notional, pr.Delta = positionExposure(pr.Size, pr.MarkPrice, contractValue, pr.SettlementType)
+	if pr.LiquidationPrice > 0 && pr.MarkPrice > 0 {
+		pr.LiquidationDistance = math.Abs(pr.MarkPrice-pr.LiquidationPrice) / pr.MarkPrice
+	}
+	return pr
+}
+
+// positionExposure returns the notional in quote currency and the delta in
+// units of the underlying for a position. Inverse contracts are sized in
+// contracts each worth a fixed quote amount, so their notional is the size
+// multiplied by the contract value and their delta depends on the mark price.
+// A contract value of zero is treated as one unit of quote per contract.
+func positionExposure(size, markPrice, contractValue float64, settlement futures.ContractSettlementType) (notional, delta float64) {
+	if settlement != futures.Inverse {
+		return size * markPrice, size
+	}
+	if contractValue <= 0 {
+		contractValue = 1
+	}
+	notional = size * contractValue
+	if markPrice > 0 {
+		delta = notional / markPrice
+	}
+	return notional, delta
+}
+
+// getContract returns the contract details of a futures pair, caching
+// contract details per exchange asset. Nil is returned if details are
+// unavailable.
+func (f *FuturesRiskManager) getContract(ctx context.Context, exch exchange.IBotExchange, a asset.Item, pair currency.Pair) *futures.Contract {
+	k := key.ExchangeAsset{Exchange: exch.GetName(), Asset: a}
+	f.contractsM.Lock()
+	defer f.contractsM.Unlock()
+	contracts, ok := f.contracts[k]
+	if !ok {
+		var err error
+		contracts, err = exch.GetFuturesContractDetails(ctx, a)
+		if err != nil {
+			if f.cfg.Verbose {
+				log.Debugf(log.OrderMgr, "Futures risk manager %s %s contract details unavailable: %v", k.Exchange, a, err)
+			}
+			// Unsupported exchanges are cached to avoid requesting on every update
+			if errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented) {
+				f.contracts[k] = nil
+			}
+			return nil
+		}
+		f.contracts[k] = contracts
+	}
+	for i := range contracts {
+		if contracts[i].Name.Equal(pair) {
+			return &contracts[i]
+		}
+	}
+	return nil
+}
+
+// getAccountMarginRisk calculates collateral utilisation for an exchange
+// account using its cached balances
+func getAccountMarginRisk(ctx context.Context, exch exchange.IBotExchange, k *key.ExchangeAsset) (*AccountMarginRisk, error) {
+	if feat := exch.GetSupportedFeatures(); !feat.FuturesCapabilities.Collateral {
+		return nil, fmt.Errorf("%w collateral for exchange %v", common.ErrFunctionNotSupported, exch.GetName())
+	}
+	balances, err := exch.GetCachedCurrencyBalances(ctx, k.Asset)
+	if err != nil {
+		return nil, err
+	}
+	calculators := make([]futures.CollateralCalculator, 0, len(balances))
+	for curr, balance := range balances {
+		total := decimal.NewFromFloat(balance.Total)
+		free := decimal.NewFromFloat(balance.AvailableWithoutBorrow)
+		calculators = append(calculators, futures.CollateralCalculator{
+			CollateralCurrency: curr,
+			Asset:              k.Asset,
+			FreeCollateral:     free,
+			LockedCollateral:   total.Sub(free),
+		})
+	}
+	c, err := exch.CalculateTotalCollateral(ctx, &futures.TotalCollateralCalculator{
+		CollateralAssets: calculators,
+		FetchPositions:   true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	total := c.UsedCollateral.Add(c.AvailableCollateral)
+	acc := &AccountMarginRisk{
+		Exchange:            k.Exchange,
+		Asset:               k.Asset,
+		CollateralCurrency:  c.CollateralCurrency,
+		TotalCollateral:     total.InexactFloat64(),
+		UsedCollateral:      c.UsedCollateral.InexactFloat64(),
+		AvailableCollateral: c.AvailableCollateral.InexactFloat64(),
+		UnrealisedPNL:       c.UnrealisedPNL.InexactFloat64(),
+	}
+	if total.IsPositive() {
+		acc.MarginRatio = c.UsedCollateral.Div(total).InexactFloat64()
+	}
+	return acc, nil
+}
+
+// buildFuturesRiskSnapshot aggregates position and account risk into a
+// snapshot and evaluates the configured thresholds against it
+func buildFuturesRiskSnapshot(positions []PositionRisk, accounts []AccountMarginRisk, cfg *config.FuturesRiskManager) *FuturesRiskSnapshot {
+	s := &FuturesRiskSnapshot{
+		Timestamp: time.Now(),
+		Positions: positions,
+		Accounts:  accounts,
+	}
+	exposures := make(map[*currency.Item]*UnderlyingExposure)
+	for i := range positions {
+		u := exposures[positions[i].Underlying.Item]
+		if u == nil {
+			u = &UnderlyingExposure{Underlying: positions[i].Underlying}
+			exposures[positions[i].Underlying.Item] = u
+		}
+		direction := 1.0
+		if positions[i].Side.IsShort() {
+			direction = -1
+		}
+		u.NetDelta += direction * positions[i].Delta
+		u.NetNotional += direction * positions[i].Notional
+		u.GrossNotional += math.Abs(positions[i].Notional)
+		if !slices.Contains(u.Exchanges, positions[i].Exchange) {
+			u.Exchanges = append(u.Exchanges, positions[i].Exchange)
+		}
+		s.GrossNotional += math.Abs(positions[i].Notional)
+	}
+	s.Underlyings = make([]UnderlyingExposure, 0, len(exposures))
+	for _, u := range exposures {
+		if s.GrossNotional > 0 {
+			u.Concentration = u.GrossNotional / s.GrossNotional
+		}
+		sort.Strings(u.Exchanges)
+		s.Underlyings = append(s.Underlyings, *u)
+	}
+	sort.Slice(s.Underlyings, func(i, j int) bool {
+		return s.Underlyings[i].Underlying.String() < s.Underlyings[j].Underlying.String()
+	})
+	s.Alerts = evaluateRiskThresholds(s, cfg)
+	return s
+}
+
+// evaluateRiskThresholds returns all alerts for thresholds breached by the
+// snapshot. A zero threshold disables its check.
+func evaluateRiskThresholds(s *FuturesRiskSnapshot, cfg *config.FuturesRiskManager) []RiskAlert {
+	var alerts []RiskAlert
+	if cfg.MinLiquidationDistance > 0 {
+		for i := range s.Positions {
+			p := &s.Positions[i]
+			if p.LiquidationPrice <= 0 || p.LiquidationDistance >= cfg.MinLiquidationDistance {
+				continue
+			}
+			alerts = append(alerts, RiskAlert{
+				Type:      RiskAlertLiquidationDistance,
+				Key:       p.Exchange + " " + p.Asset.String() + " " + p.Pair.String(),
+				Value:     p.LiquidationDistance,
+				Threshold: cfg.MinLiquidationDistance,
+				Message: fmt.Sprintf("%s %s %s %s position is %.2f%% from liquidation price %v, mark price %v",
+					p.Exchange, p.Asset, p.Pair, p.Side, p.LiquidationDistance*100, p.LiquidationPrice, p.MarkPrice),
+			})
+		}
+	}
+	if cfg.MaxMarginRatio > 0 {
+		for i := range s.Accounts {
+			a := &s.Accounts[i]
+			if a.MarginRatio <= cfg.MaxMarginRatio {
+				continue
+			}
+			alerts = append(alerts, RiskAlert{
+				Type:      RiskAlertMarginRatio,
+				Key:       a.Exchange + " " + a.Asset.String(),
+				Value:     a.MarginRatio,
+				Threshold: cfg.MaxMarginRatio,
+				Message: fmt.Sprintf("%s %s margin ratio %.2f%% exceeds %.2f%%, used %v of %v %s",
+					a.Exchange, a.Asset, a.MarginRatio*100, cfg.MaxMarginRatio*100, a.UsedCollateral, a.TotalCollateral, a.CollateralCurrency),
+			})
+		}
+	}
+	for i := range s.Underlyings {
+		u := &s.Underlyings[i]
+		// Holding a single underlying is always fully concentrated and not
+		// worth alerting on
+		if cfg.MaxConcentration > 0 && len(s.Underlyings) > 1 && u.Concentration > cfg.MaxConcentration {
+			alerts = append(alerts, RiskAlert{
+				Type:      RiskAlertConcentration,
+				Key:       u.Underlying.String(),
+				Value:     u.Concentration,
+				Threshold: cfg.MaxConcentration,
+				Message: fmt.Sprintf("%s accounts for %.2f%% of gross futures notional, exceeding %.2f%%",
+					u.Underlying, u.Concentration*100, cfg.MaxConcentration*100),
+			})
+		}
+		if cfg.MaxNetDeltaNotional > 0 && math.Abs(u.NetNotional) > cfg.MaxNetDeltaNotional {
+			alerts = append(alerts, RiskAlert{
+				Type:      RiskAlertNetDelta,
+				Key:       u.Underlying.String(),
+				Value:     u.NetNotional,
+				Threshold: cfg.MaxNetDeltaNotional,
+				Message: fmt.Sprintf("%s net delta notional %v across %v exceeds %v",
+					u.Underlying, u.NetNotional, u.Exchanges, cfg.MaxNetDeltaNotional),
+			})
+		}
+	}
+	return alerts
+}
+
+// processAlerts pushes newly breached thresholds to the communications manager.
+// Alerts that remain breached are not repeated until they have recovered.
+func (f *FuturesRiskManager) processAlerts(alerts []RiskAlert) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	current := make(map[string]RiskAlert, len(alerts))
+	for i := range alerts {
+		id := alerts[i].Type + " " + alerts[i].Key
+		current[id] = alerts[i]
+		if _, ok := f.breaches[id]; ok {
+			continue
+		}
+		log.Warnf(log.OrderMgr, "Futures risk alert: %s", alerts[i].Message)
+		severity := base.SeverityWarning
+		if alerts[i].Type == RiskAlertLiquidationDistance {
+			severity = base.SeverityCritical
+		}
+		f.commsManager.PushEvent(riskAlertEvent(severity, alerts[i].Message, &alerts[i]))
+	}
+	for id, alert := range f.breaches {
+		if _, ok := current[id]; ok {
+			continue
+		}
+		msg := fmt.Sprintf("%s %s has recovered", alert.Type, alert.Key)
+		log.Infof(log.OrderMgr, "Futures risk alert: %s", msg)
+		f.commsManager.PushEvent(riskAlertEvent(base.SeverityInfo, msg, &alert))
+	}
+	f.breaches = current
+}
+
+// publish stores the latest snapshot and sends it to all subscribers,
+// replacing any snapshot a slow subscriber has yet to receive
+func (f *FuturesRiskManager) publish(s *FuturesRiskSnapshot) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.latest = s
+	for _, ch := range f.subscribers {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- s
+	}
+}
+
+func riskAlertEvent(severity base.Severity, msg string, alert *RiskAlert) base.Event {
+	return base.Event{
+		Type:     futuresRiskEventType,
+		Category: base.CategoryRisk,
+		Severity: severity,
+		Message:  msg,
+		Payload: map[string]any{
+			"type":      alert.Type,
+			"key":       alert.Key,
+			"value":     alert.Value,
+			"threshold": alert.Threshold,
+		},
+	}
+}
@@ -0,0 +1,268 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+type testFuturesPositionManager struct {
+	positions []futures.Position
+	err       error
+}
+
+func (t *testFuturesPositionManager) IsRunning() bool { return true }
+
+func (t *testFuturesPositionManager) GetAllOpenFuturesPositions() ([]futures.Position, error) {
+	return t.positions, t.err
+}
+
+// riskExchange overrides exchange functions used by the futures risk manager
+type riskExchange struct {
+	exchange.IBotExchange
+	liquidationPrice decimal.Decimal
+}
+
+func (r riskExchange) GetSupportedFeatures() exchange.FeaturesSupported {
+	f := r.IBotExchange.GetSupportedFeatures()
+	f.FuturesCapabilities.Collateral = true
+	return f
+}
+
+func (r riskExchange) GetFuturesPositionSummary(context.Context, *futures.PositionSummaryRequest) (*futures.PositionSummary, error) {
+	return &futures.PositionSummary{
+		MarkPrice:                 decimal.NewFromInt(100),
+		EstimatedLiquidationPrice: r.liquidationPrice,
+	}, nil
+}
+
+func (r riskExchange) GetFuturesContractDetails(_ context.Context, a asset.Item) ([]futures.Contract, error) {
+	if a != asset.CoinMarginedFutures {
+		return nil, common.ErrFunctionNotSupported
+	}
+	return []futures.Contract{{Name: currency.NewBTCUSD(), SettlementType: futures.Inverse, Multiplier: 100}}, nil
+}
+
+func (r riskExchange) GetCachedCurrencyBalances(context.Context, asset.Item) (accounts.CurrencyBalances, error) {
+	return accounts.CurrencyBalances{currency.USDT: {Total: 1000, AvailableWithoutBorrow: 100}}, nil
+}
+
+func (r riskExchange) CalculateTotalCollateral(context.Context, *futures.TotalCollateralCalculator) (*futures.TotalCollateralResponse, error) {
+	return &futures.TotalCollateralResponse{
+		CollateralCurrency:  currency.USDT,
+		UsedCollateral:      decimal.NewFromInt(900),
+		AvailableCollateral: decimal.NewFromInt(100),
+	}, nil
+}
+
+func futuresRiskSetup(t *testing.T, pm iFuturesPositionManager, cfg *config.FuturesRiskManager) (*FuturesRiskManager, *testCommsManager) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, em.Add(riskExchange{IBotExchange: exch, liquidationPrice: decimal.NewFromInt(95)}))
+	comms := &testCommsManager{}
+	f, err := SetupFuturesRiskManager(em, pm, comms, cfg)
+	require.NoError(t, err)
+	return f, comms
+}
+
+func TestSetupFuturesRiskManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupFuturesRiskManager(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+
+	_, err = SetupFuturesRiskManager(NewExchangeManager(), nil, nil, nil)
+	assert.ErrorIs(t, err, errNilFuturesPositionManager)
+
+	_, err = SetupFuturesRiskManager(NewExchangeManager(), &testFuturesPositionManager{}, nil, nil)
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+
+	_, err = SetupFuturesRiskManager(NewExchangeManager(), &testFuturesPositionManager{}, &testCommsManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	f, err := SetupFuturesRiskManager(NewExchangeManager(), &testFuturesPositionManager{}, &testCommsManager{}, &config.FuturesRiskManager{})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultFuturesRiskManagerDelay, f.cfg.Delay, "Delay should be defaulted")
+}
+
+func TestFuturesRiskManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var f *FuturesRiskManager
+	assert.ErrorIs(t, f.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, f.Stop(), ErrNilSubsystem)
+	assert.False(t, f.IsRunning(), "IsRunning should return false on a nil manager")
+
+	f, _ = futuresRiskSetup(t, &testFuturesPositionManager{err: errFuturesTrackingDisabled}, &config.FuturesRiskManager{})
+	assert.ErrorIs(t, f.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, f.Start())
+	assert.ErrorIs(t, f.Start(), ErrSubSystemAlreadyStarted)
+	assert.True(t, f.IsRunning(), "IsRunning should return true")
+
+	ch, _, err := f.Subscribe()
+	require.NoError(t, err)
+	require.NoError(t, f.Stop())
+	_, ok := <-ch
+	assert.False(t, ok, "subscriber channel should be closed on stop")
+	assert.False(t, f.IsRunning(), "IsRunning should return false")
+}
+
+func TestFuturesRiskManagerUpdate(t *testing.T) {
+	t.Parallel()
+	pm := &testFuturesPositionManager{
+		positions: []futures.Position{
+			{
+				Exchange:        testExchange,
+				Asset:           asset.USDTMarginedFutures,
+				Pair:            currency.NewBTCUSDT(),
+				LatestDirection: order.Long,
+				LatestSize:      decimal.NewFromInt(2),
+				LatestPrice:     decimal.NewFromInt(99),
+			},
+			{
+				Exchange:        "unknown",
+				Asset:           asset.USDTMarginedFutures,
+				Pair:            currency.NewBTCUSDT(),
+				LatestDirection: order.Short,
+				LatestSize:      decimal.NewFromInt(1),
+			},
+		},
+	}
+	f, comms := futuresRiskSetup(t, pm, &config.FuturesRiskManager{MaxMarginRatio: 0.8, MinLiquidationDistance: 0.1})
+
+	_, err := f.Update(t.Context())
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = f.GetLatestSnapshot()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	f.started = 1
+	_, err = f.GetLatestSnapshot()
+	assert.ErrorIs(t, err, errNoRiskSnapshot)
+
+	ch, release, err := f.Subscribe()
+	require.NoError(t, err)
+	defer release()
+
+	s, err := f.Update(t.Context())
+	require.NoError(t, err)
+	require.Len(t, s.Positions, 1, "positions on unknown exchanges must be skipped")
+	assert.Equal(t, 100.0, s.Positions[0].MarkPrice, "MarkPrice should be taken from the position summary")
+	assert.Equal(t, 200.0, s.Positions[0].Notional)
+	assert.InDelta(t, 0.05, s.Positions[0].LiquidationDistance, 1e-9)
+	assert.Equal(t, currency.BTC, s.Positions[0].Underlying, "Underlying should default to the pair base")
+	require.Len(t, s.Accounts, 1)
+	assert.InDelta(t, 0.9, s.Accounts[0].MarginRatio, 1e-9)
+	require.Len(t, s.Alerts, 2)
+	require.Len(t, comms.events, 2, "breached thresholds must be pushed to comms")
+	assert.Equal(t, futuresRiskEventType, comms.events[0].Type)
+
+	published := <-ch
+	assert.Same(t, s, published, "Update should publish the snapshot to subscribers")
+	latest, err := f.GetLatestSnapshot()
+	require.NoError(t, err)
+	assert.Same(t, s, latest)
+
+	_, err = f.Update(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, comms.events, 2, "ongoing breaches should not be pushed again")
+
+	f.cfg.MaxMarginRatio = 0
+	_, err = f.Update(t.Context())
+	require.NoError(t, err)
+	require.Len(t, comms.events, 3, "recovered breaches must be pushed to comms")
+	assert.Contains(t, comms.events[2].Message, "recovered")
+}
+
+func TestBuildFuturesRiskSnapshot(t *testing.T) {
+	t.Parallel()
+	positions := []PositionRisk{
+		{Exchange: "a", Underlying: currency.BTC, Side: order.Long, Size: 2, Delta: 2, Notional: 200},
+		{Exchange: "b", Underlying: currency.BTC, Side: order.Short, SettlementType: futures.Inverse, Size: 100, Delta: 1, Notional: 100},
+		{Exchange: "a", Underlying: currency.ETH, Side: order.Short, Size: 10, Delta: 10, Notional: 100},
+	}
+	s := buildFuturesRiskSnapshot(positions, nil, &config.FuturesRiskManager{MaxConcentration: 0.5, MaxNetDeltaNotional: 50})
+	assert.Equal(t, 400.0, s.GrossNotional)
+	require.Len(t, s.Underlyings, 2)
+	btc := s.Underlyings[0]
+	assert.Equal(t, currency.BTC, btc.Underlying)
+	assert.Equal(t, 1.0, btc.NetDelta)
+	assert.Equal(t, 100.0, btc.NetNotional)
+	assert.Equal(t, 300.0, btc.GrossNotional)
+	assert.Equal(t, 0.75, btc.Concentration)
+	assert.Equal(t, []string{"a", "b"}, btc.Exchanges)
+	eth := s.Underlyings[1]
+	assert.Equal(t, -10.0, eth.NetDelta)
+	assert.Equal(t, -100.0, eth.NetNotional)
+
+	require.Len(t, s.Alerts, 3)
+	assert.Equal(t, RiskAlertConcentration, s.Alerts[0].Type)
+	assert.Equal(t, RiskAlertNetDelta, s.Alerts[1].Type)
+	assert.Equal(t, "BTC", s.Alerts[1].Key)
+	assert.Equal(t, RiskAlertNetDelta, s.Alerts[2].Type)
+	assert.Equal(t, "ETH", s.Alerts[2].Key)
+
+	s = buildFuturesRiskSnapshot(positions[:1], nil, &config.FuturesRiskManager{MaxConcentration: 0.5})
+	assert.Empty(t, s.Alerts, "a single underlying should not alert on concentration")
+}
+
+func TestPositionExposure(t *testing.T) {
+	t.Parallel()
+	notional, delta := positionExposure(2, 100, 0, futures.Linear)
+	assert.Equal(t, 200.0, notional)
+	assert.Equal(t, 2.0, delta)
+
+	notional, delta = positionExposure(2, 100, 0, futures.UnsetSettlementType)
+	assert.Equal(t, 200.0, notional, "unset settlement types should be treated as linear")
+	assert.Equal(t, 2.0, delta)
+
+	notional, delta = positionExposure(20, 50000, 100, futures.Inverse)
+	assert.Equal(t, 2000.0, notional, "inverse notional should be size multiplied by contract value")
+	assert.Equal(t, 0.04, delta)
+
+	notional, delta = positionExposure(2000, 0, 0, futures.Inverse)
+	assert.Equal(t, 2000.0, notional, "inverse contracts without a contract value should be valued at one quote unit")
+	assert.Zero(t, delta, "delta should be zero without a mark price")
+}
+
+func TestFuturesRiskManagerGetContract(t *testing.T) {
+	t.Parallel()
+	f, _ := futuresRiskSetup(t, &testFuturesPositionManager{}, &config.FuturesRiskManager{})
+	exch, err := f.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err)
+
+	c := f.getContract(t.Context(), exch, asset.CoinMarginedFutures, currency.NewBTCUSD())
+	require.NotNil(t, c)
+	assert.Equal(t, futures.Inverse, c.SettlementType)
+	assert.Nil(t, f.getContract(t.Context(), exch, asset.CoinMarginedFutures, currency.NewBTCUSDT()), "getContract should return nil for unknown pairs")
+	assert.Nil(t, f.getContract(t.Context(), exch, asset.USDTMarginedFutures, currency.NewBTCUSDT()), "getContract should return nil when unsupported")
+	assert.Contains(t, f.contracts, key.ExchangeAsset{Exchange: exch.GetName(), Asset: asset.USDTMarginedFutures}, "unsupported exchange assets should be cached")
+
+	p := &futures.Position{Exchange: testExchange, Asset: asset.CoinMarginedFutures, Pair: currency.NewBTCUSD(), LatestDirection: order.Short, LatestSize: decimal.NewFromInt(10)}
+	pr := f.getPositionRisk(t.Context(), exch, p)
+	assert.Equal(t, futures.Inverse, pr.SettlementType)
+	assert.Equal(t, 1000.0, pr.Notional, "inverse notional should use the contract value")
+	assert.Equal(t, 10.0, pr.Delta)
+}
+
+func TestGetAccountMarginRisk(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	_, err = getAccountMarginRisk(t.Context(), exch, nil)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+}
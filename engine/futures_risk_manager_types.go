@@ -0,0 +1,130 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// FuturesRiskManagerName is an exported subsystem name
+	FuturesRiskManagerName = "futures_risk_manager"
+	// DefaultFuturesRiskManagerDelay defines the default duration between
+	// futures risk calculations
+	DefaultFuturesRiskManagerDelay = time.Minute
+	// futuresRiskEventType is the communications event type for risk alerts
+	futuresRiskEventType = "futures_risk"
+	// riskSubscriberBuffer is the number of snapshots buffered per subscriber
+	// before older snapshots are dropped
+	riskSubscriberBuffer = 1
+)
+
+// Futures risk alert types
+const (
+	RiskAlertMarginRatio         = "margin_ratio"
+	RiskAlertLiquidationDistance = "liquidation_distance"
+	RiskAlertConcentration       = "concentration"
+	RiskAlertNetDelta            = "net_delta"
+)
+
+var (
+	errNilFuturesPositionManager = errors.New("cannot start with nil futures position manager")
+	errNoRiskSnapshot            = errors.New("no futures risk snapshot has been calculated yet")
+)
+
+// FuturesRiskManager combines the futures positions tracked by the order
+// manager with exchange position summaries and collateral to produce an
+// aggregated risk view across all exchanges
+type FuturesRiskManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	positionManager iFuturesPositionManager
+	commsManager    iCommsManager
+	cfg             config.FuturesRiskManager
+
+	m           sync.RWMutex
+	latest      *FuturesRiskSnapshot
+	breaches    map[string]RiskAlert
+	subscribers map[int64]chan *FuturesRiskSnapshot
+	subID       int64
+
+	contractsM sync.Mutex
+	contracts  map[key.ExchangeAsset][]futures.Contract
+}
+
+// PositionRisk holds the risk measurements of a single open futures position
+type PositionRisk struct {
+	Exchange       string
+	Asset          asset.Item
+	Pair           currency.Pair
+	Underlying     currency.Code
+	Side           order.Side
+	SettlementType futures.ContractSettlementType
+	// Size is the position size as tracked, in base currency units for linear
+	// contracts and in contracts for inverse contracts
+	Size float64
+	// Delta is the position size in units of the underlying
+	Delta            float64
+	MarkPrice        float64
+	Notional         float64
+	UnrealisedPNL    float64
+	LiquidationPrice float64
+	// LiquidationDistance is the distance between the mark price and the
+	// estimated liquidation price as a ratio of the mark price. It is zero when
+	// the exchange does not provide a liquidation price.
+	LiquidationDistance float64
+}
+
+// UnderlyingExposure holds the aggregated exposure of an underlying currency
+// across all exchanges
+type UnderlyingExposure struct {
+	Underlying currency.Code
+	// NetDelta is the signed position size where longs are positive
+	NetDelta      float64
+	NetNotional   float64
+	GrossNotional float64
+	// Concentration is the underlying's share of the total gross notional
+	Concentration float64
+	Exchanges     []string
+}
+
+// AccountMarginRisk holds the collateral utilisation of an exchange account
+type AccountMarginRisk struct {
+	Exchange            string
+	Asset               asset.Item
+	CollateralCurrency  currency.Code
+	TotalCollateral     float64
+	UsedCollateral      float64
+	AvailableCollateral float64
+	UnrealisedPNL       float64
+	// MarginRatio is used collateral divided by total collateral
+	MarginRatio float64
+}
+
+// RiskAlert is a breached futures risk threshold
+type RiskAlert struct {
+	Type      string
+	Key       string
+	Value     float64
+	Threshold float64
+	Message   string
+}
+
+// FuturesRiskSnapshot is the aggregated futures risk view at a point in time
+type FuturesRiskSnapshot struct {
+	Timestamp     time.Time
+	GrossNotional float64
+	Positions     []PositionRisk
+	Underlyings   []UnderlyingExposure
+	Accounts      []AccountMarginRisk
+	Alerts        []RiskAlert
+}
@@ -0,0 +1,338 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupHealthChecker creates the health checker
+func SetupHealthChecker(bot *Engine, cfg *config.HealthCheck) (*HealthChecker, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	return &HealthChecker{cfg: *cfg, bot: bot}, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (h *HealthChecker) IsRunning() bool {
+	return h != nil && atomic.LoadInt32(&h.started) == 1
+}
+
+// Start serves the liveness and readiness endpoints
+func (h *HealthChecker) Start() error {
+	if h == nil {
+		return fmt.Errorf("%s %w", HealthCheckerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&h.started, 0, 1) {
+		return fmt.Errorf("%s %w", HealthCheckerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Global, "Health checker %s", MsgSubSystemStarting)
+	lc := net.ListenConfig{}
+	ln, err := lc.Listen(context.TODO(), "tcp", h.cfg.ListenAddress)
+	if err != nil {
+		atomic.StoreInt32(&h.started, 0)
+		return fmt.Errorf("%s listen error: %w", HealthCheckerName, err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(HealthLivenessPath, h.serveLiveness)
+	mux.HandleFunc(HealthReadinessPath, h.serveReadiness)
+	h.server = &http.Server{
+		Addr:              h.cfg.ListenAddress,
+		ReadHeaderTimeout: 10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		Handler:           mux,
+	}
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+		if err := h.server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.Global, "Health checker serve error: %s", err)
+		}
+	}()
+	log.Infof(log.Global, "Health checker listening on http://%s", ln.Addr())
+	log.Debugf(log.Global, "Health checker %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops serving the health endpoints
+func (h *HealthChecker) Stop() error {
+	if h == nil {
+		return fmt.Errorf("%s %w", HealthCheckerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&h.started) == 0 {
+		return fmt.Errorf("%s %w", HealthCheckerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "Health checker %s", MsgSubSystemShuttingDown)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := h.server.Shutdown(ctx)
+	h.wg.Wait()
+	atomic.StoreInt32(&h.started, 0)
+	log.Debugf(log.Global, "Health checker %s", MsgSubSystemShutdown)
+	return err
+}
+
+// markReady records the subsystems running once the engine has started as the
+// subsystems readiness expects to be running
+func (h *HealthChecker) markReady() {
+	if h == nil {
+		return
+	}
+	expected := make(map[string]bool)
+	for name, running := range h.bot.GetSubsystemsStatus() {
+		if running && name != HealthCheckerName {
+			expected[name] = true
+		}
+	}
+	h.m.Lock()
+	h.expected = expected
+	h.m.Unlock()
+	atomic.StoreInt32(&h.ready, 1)
+}
+
+// markShuttingDown fails readiness while the engine shuts down
+func (h *HealthChecker) markShuttingDown() {
+	if h == nil {
+		return
+	}
+	atomic.StoreInt32(&h.ready, 0)
+}
+
+// setExpected updates whether a subsystem is expected to be running after it
+// has been enabled or disabled at runtime
+func (h *HealthChecker) setExpected(name string, enable bool) {
+	if h == nil || name == HealthCheckerName {
+		return
+	}
+	h.m.Lock()
+	defer h.m.Unlock()
+	if h.expected == nil {
+		return
+	}
+	if enable {
+		h.expected[name] = true
+	} else {
+		delete(h.expected, name)
+	}
+}
+
+func (h *HealthChecker) serveLiveness(w http.ResponseWriter, _ *http.Request) {
+	writeHealthReport(w, &HealthReport{Status: HealthStatusPass, Time: time.Now()})
+}
+
+func (h *HealthChecker) serveReadiness(w http.ResponseWriter, _ *http.Request) {
+	writeHealthReport(w, h.Readiness())
+}
+
+func writeHealthReport(w http.ResponseWriter, r *HealthReport) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if r.Status == HealthStatusFail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	if err := json.NewEncoder(w).Encode(r); err != nil {
+		log.Errorf(log.Global, "Health checker unable to write response: %s", err)
+	}
+}
+
+// Readiness runs all readiness checks. The report fails when any check fails
+func (h *HealthChecker) Readiness() *HealthReport {
+	r := &HealthReport{
+		Status: HealthStatusPass,
+		Time:   time.Now(),
+		Checks: map[string]*HealthCheckResult{
+			healthCheckEngine:       h.checkEngine(),
+			healthCheckSubsystems:   h.checkSubsystems(),
+			healthCheckConnectivity: h.checkConnectivity(),
+			healthCheckNTP:          h.checkNTP(),
+			healthCheckDatabase:     h.checkDatabase(),
+			healthCheckWebsockets:   h.checkWebsockets(),
+			healthCheckSync:         h.checkSync(),
+		},
+	}
+	for _, c := range r.Checks {
+		if c.Status == HealthStatusFail {
+			r.Status = HealthStatusFail
+			break
+		}
+	}
+	return r
+}
+
+func (h *HealthChecker) checkEngine() *HealthCheckResult {
+	if atomic.LoadInt32(&h.ready) == 0 {
+		return &HealthCheckResult{Status: HealthStatusFail, Message: "engine is starting or shutting down"}
+	}
+	return &HealthCheckResult{Status: HealthStatusPass}
+}
+
+func (h *HealthChecker) checkSubsystems() *HealthCheckResult {
+	h.m.Lock()
+	expected := make(map[string]bool, len(h.expected))
+	for k, v := range h.expected {
+		expected[k] = v
+	}
+	h.m.Unlock()
+	details := make(map[string]string)
+	var stopped []string
+	for name, running := range h.bot.GetSubsystemsStatus() {
+		switch {
+		case running:
+			details[name] = subsystemRunning
+		case expected[name]:
+			details[name] = subsystemStopped
+			stopped = append(stopped, name)
+		default:
+			details[name] = subsystemDisabled
+		}
+	}
+	if len(stopped) > 0 {
+		sort.Strings(stopped)
+		return &HealthCheckResult{
+			Status:  HealthStatusFail,
+			Message: "subsystems stopped unexpectedly: " + strings.Join(stopped, ", "),
+			Details: details,
+		}
+	}
+	return &HealthCheckResult{Status: HealthStatusPass, Details: details}
+}
+
+func (h *HealthChecker) checkConnectivity() *HealthCheckResult {
+	if !h.bot.connectionManager.IsRunning() {
+		return &HealthCheckResult{Status: HealthStatusSkip, Message: "connection monitor is not running"}
+	}
+	if !h.bot.connectionManager.IsOnline() {
+		return &HealthCheckResult{Status: HealthStatusFail, Message: "internet connectivity lost"}
+	}
+	return &HealthCheckResult{Status: HealthStatusPass}
+}
+
+func (h *HealthChecker) checkNTP() *HealthCheckResult {
+	n := h.bot.ntpManager
+	if !n.IsRunning() {
+		return &HealthCheckResult{Status: HealthStatusSkip, Message: "NTP manager is not running"}
+	}
+	drift, checked := n.lastDrift()
+	if checked.IsZero() {
+		return &HealthCheckResult{Status: HealthStatusPass, Message: "awaiting first time check"}
+	}
+	details := &ntpHealth{
+		Drift:                     drift.String(),
+		AllowedDifference:         n.allowedDifference.String(),
+		AllowedNegativeDifference: n.allowedNegativeDifference.String(),
+		LastChecked:               checked,
+	}
+	if drift > n.allowedDifference || drift < -n.allowedNegativeDifference {
+		return &HealthCheckResult{Status: HealthStatusFail, Message: "system clock is out of sync with NTP", Details: details}
+	}
+	return &HealthCheckResult{Status: HealthStatusPass, Details: details}
+}
+
+func (h *HealthChecker) checkDatabase() *HealthCheckResult {
+	if !h.bot.DatabaseManager.IsRunning() {
+		return &HealthCheckResult{Status: HealthStatusSkip, Message: "database manager is not running"}
+	}
+	if !h.bot.DatabaseManager.IsConnected() {
+		return &HealthCheckResult{Status: HealthStatusFail, Message: "database is not connected"}
+	}
+	return &HealthCheckResult{Status: HealthStatusPass}
+}
+
+func (h *HealthChecker) checkWebsockets() *HealthCheckResult {
+	// Websocket connections are made by the websocket routine manager
+	if !h.bot.WebsocketRoutineManager.IsRunning() || h.bot.ExchangeManager == nil {
+		return &HealthCheckResult{Status: HealthStatusSkip, Message: "websocket routine manager is not running"}
+	}
+	exchanges, err := h.bot.ExchangeManager.GetExchanges()
+	if err != nil {
+		return &HealthCheckResult{Status: HealthStatusFail, Message: err.Error()}
+	}
+	details := make(map[string]string)
+	var disconnected []string
+	for _, exch := range exchanges {
+		if !exch.IsWebsocketEnabled() {
+			continue
+		}
+		ws, err := exch.GetWebsocket()
+		if err != nil || ws == nil {
+			continue
+		}
+		if ws.IsConnected() {
+			details[exch.GetName()] = "connected"
+			continue
+		}
+		details[exch.GetName()] = "disconnected"
+		disconnected = append(disconnected, exch.GetName())
+	}
+	if len(details) == 0 {
+		return &HealthCheckResult{Status: HealthStatusSkip, Message: "no exchange websockets enabled"}
+	}
+	if len(disconnected) > 0 {
+		sort.Strings(disconnected)
+		return &HealthCheckResult{
+			Status:  HealthStatusFail,
+			Message: "websockets disconnected: " + strings.Join(disconnected, ", "),
+			Details: details,
+		}
+	}
+	return &HealthCheckResult{Status: HealthStatusPass, Details: details}
+}
+
+func (h *HealthChecker) checkSync() *HealthCheckResult {
+	s := h.bot.currencyPairSyncer
+	if !s.IsRunning() {
+		return &HealthCheckResult{Status: HealthStatusSkip, Message: "sync manager is not running"}
+	}
+	now := time.Now()
+	var stale []staleSyncItem
+	for _, item := range []struct {
+		item     syncItemType
+		enabled  bool
+		maxStale time.Duration
+	}{
+		{SyncItemTicker, s.config.SynchronizeTicker, h.cfg.MaxTickerStaleness},
+		{SyncItemOrderbook, s.config.SynchronizeOrderbook, h.cfg.MaxOrderbookStaleness},
+	} {
+		if !item.enabled || item.maxStale <= 0 {
+			continue
+		}
+		for k, updated := range s.lastUpdated(item.item) {
+			if age := now.Sub(updated); age > item.maxStale {
+				stale = append(stale, staleSyncItem{
+					Exchange:    k.Exchange,
+					Asset:       k.Asset.String(),
+					Pair:        k.Pair().String(),
+					Item:        item.item.String(),
+					LastUpdated: updated,
+					Age:         age.Truncate(time.Second).String(),
+				})
+			}
+		}
+	}
+	if len(stale) > 0 {
+		slices.SortFunc(stale, func(a, b staleSyncItem) int {
+			return strings.Compare(a.Exchange+a.Asset+a.Pair+a.Item, b.Exchange+b.Asset+b.Pair+b.Item)
+		})
+		return &HealthCheckResult{
+			Status:  HealthStatusFail,
+			Message: fmt.Sprintf("%d synced items have not updated within the allowed staleness", len(stale)),
+			Details: stale,
+		}
+	}
+	return &HealthCheckResult{Status: HealthStatusPass}
+}
@@ -0,0 +1,140 @@
+package engine
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestSetupHealthChecker(t *testing.T) {
+	t.Parallel()
+	_, err := SetupHealthChecker(nil, &config.HealthCheck{})
+	assert.ErrorIs(t, err, errNilBot)
+	_, err = SetupHealthChecker(&Engine{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	h, err := SetupHealthChecker(&Engine{}, &config.HealthCheck{})
+	require.NoError(t, err, "SetupHealthChecker must not error")
+	assert.NotNil(t, h)
+}
+
+func TestHealthCheckerStartStop(t *testing.T) {
+	t.Parallel()
+	var h *HealthChecker
+	assert.ErrorIs(t, h.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, h.Stop(), ErrNilSubsystem)
+	assert.False(t, h.IsRunning(), "IsRunning should return false on a nil subsystem")
+
+	h, err := SetupHealthChecker(&Engine{}, &config.HealthCheck{ListenAddress: "localhost:0"})
+	require.NoError(t, err, "SetupHealthChecker must not error")
+	assert.ErrorIs(t, h.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, h.Start(), "Start must not error")
+	assert.True(t, h.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, h.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, h.Stop(), "Stop must not error")
+	assert.False(t, h.IsRunning(), "IsRunning should return false")
+}
+
+func TestHealthCheckerEndpoints(t *testing.T) {
+	t.Parallel()
+	h, err := SetupHealthChecker(&Engine{Config: &config.Config{}}, &config.HealthCheck{})
+	require.NoError(t, err, "SetupHealthChecker must not error")
+
+	rec := httptest.NewRecorder()
+	h.serveLiveness(rec, httptest.NewRequest(http.MethodGet, HealthLivenessPath, http.NoBody))
+	assert.Equal(t, http.StatusOK, rec.Code, "liveness should pass while the engine is starting")
+
+	rec = httptest.NewRecorder()
+	h.serveReadiness(rec, httptest.NewRequest(http.MethodGet, HealthReadinessPath, http.NoBody))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code, "readiness should fail while the engine is starting")
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var report HealthReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report), "Unmarshal must not error")
+	assert.Equal(t, HealthStatusFail, report.Status)
+	require.Contains(t, report.Checks, healthCheckEngine)
+	assert.Equal(t, HealthStatusFail, report.Checks[healthCheckEngine].Status)
+
+	h.markReady()
+	rec = httptest.NewRecorder()
+	h.serveReadiness(rec, httptest.NewRequest(http.MethodGet, HealthReadinessPath, http.NoBody))
+	assert.Equal(t, http.StatusOK, rec.Code, "readiness should pass once the engine has started")
+
+	h.markShuttingDown()
+	assert.Equal(t, HealthStatusFail, h.Readiness().Status, "readiness should fail while shutting down")
+}
+
+func TestHealthCheckerSubsystems(t *testing.T) {
+	t.Parallel()
+	h, err := SetupHealthChecker(&Engine{Config: &config.Config{}}, &config.HealthCheck{})
+	require.NoError(t, err, "SetupHealthChecker must not error")
+	h.markReady()
+	assert.Equal(t, HealthStatusPass, h.checkSubsystems().Status)
+
+	h.setExpected(OrderManagerName, true)
+	r := h.checkSubsystems()
+	assert.Equal(t, HealthStatusFail, r.Status, "a stopped expected subsystem should fail")
+	assert.Contains(t, r.Message, OrderManagerName)
+	assert.Equal(t, subsystemStopped, r.Details.(map[string]string)[OrderManagerName])
+	assert.Equal(t, subsystemDisabled, r.Details.(map[string]string)[PortfolioManagerName])
+
+	h.setExpected(OrderManagerName, false)
+	assert.Equal(t, HealthStatusPass, h.checkSubsystems().Status, "a subsystem disabled at runtime should not fail")
+}
+
+func TestHealthCheckerNTP(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: &config.Config{}}
+	h, err := SetupHealthChecker(bot, &config.HealthCheck{})
+	require.NoError(t, err, "SetupHealthChecker must not error")
+	assert.Equal(t, HealthStatusSkip, h.checkNTP().Status, "NTP should be skipped when not running")
+
+	bot.ntpManager = &ntpManager{started: 1, allowedDifference: time.Second, allowedNegativeDifference: time.Second}
+	assert.Equal(t, HealthStatusPass, h.checkNTP().Status, "NTP should pass before the first check")
+
+	bot.ntpManager.drift.Store(int64(-2 * time.Second))
+	bot.ntpManager.lastChecked.Store(time.Now().UnixNano())
+	r := h.checkNTP()
+	assert.Equal(t, HealthStatusFail, r.Status, "NTP should fail when drift exceeds the allowed difference")
+	require.IsType(t, &ntpHealth{}, r.Details)
+	assert.Equal(t, "-2s", r.Details.(*ntpHealth).Drift)
+
+	bot.ntpManager.drift.Store(int64(time.Millisecond))
+	assert.Equal(t, HealthStatusPass, h.checkNTP().Status)
+}
+
+func TestHealthCheckerSync(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{Config: &config.Config{}}
+	h, err := SetupHealthChecker(bot, &config.HealthCheck{MaxTickerStaleness: time.Minute, MaxOrderbookStaleness: time.Minute})
+	require.NoError(t, err, "SetupHealthChecker must not error")
+	assert.Equal(t, HealthStatusSkip, h.checkSync().Status, "sync should be skipped when not running")
+
+	m := &SyncManager{
+		started:       1,
+		config:        config.SyncManagerConfig{SynchronizeTicker: true, SynchronizeOrderbook: true},
+		currencyPairs: make(map[key.ExchangeAssetPair]*currencyPairSyncAgent),
+	}
+	bot.currencyPairSyncer = m
+	fresh := key.NewExchangeAssetPair("Bitstamp", asset.Spot, currency.NewBTCUSD())
+	stale := key.NewExchangeAssetPair("Bitstamp", asset.Spot, currency.NewPair(currency.ETH, currency.USD))
+	m.add(fresh, syncBase{HaveData: true, LastUpdated: time.Now()})
+	m.add(stale, syncBase{HaveData: true, LastUpdated: time.Now()})
+	assert.Equal(t, HealthStatusPass, h.checkSync().Status)
+
+	m.currencyPairs[stale].trackers[SyncItemOrderbook].LastUpdated = time.Now().Add(-time.Hour)
+	r := h.checkSync()
+	assert.Equal(t, HealthStatusFail, r.Status, "sync should fail when a pair is stale")
+	items, ok := r.Details.([]staleSyncItem)
+	require.True(t, ok, "Details must be stale sync items")
+	require.Len(t, items, 1, "must only report the stale orderbook")
+	assert.Equal(t, "ETHUSD", items[0].Pair)
+	assert.Equal(t, SyncItemOrderbook.String(), items[0].Item)
+}
@@ -0,0 +1,82 @@
+package engine
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+const (
+	// HealthCheckerName is an exported subsystem name
+	HealthCheckerName = "health_checker"
+	// HealthLivenessPath is the HTTP path of the liveness endpoint
+	HealthLivenessPath = "/livez"
+	// HealthReadinessPath is the HTTP path of the readiness endpoint
+	HealthReadinessPath = "/readyz"
+
+	// Health check statuses
+	HealthStatusPass = "pass"
+	HealthStatusFail = "fail"
+	HealthStatusSkip = "skip"
+
+	healthCheckEngine       = "engine"
+	healthCheckSubsystems   = "subsystems"
+	healthCheckConnectivity = "connectivity"
+	healthCheckNTP          = "ntp"
+	healthCheckDatabase     = "database"
+	healthCheckWebsockets   = "websockets"
+	healthCheckSync         = "sync"
+
+	subsystemRunning  = "running"
+	subsystemStopped  = "stopped"
+	subsystemDisabled = "disabled"
+)
+
+// HealthChecker serves HTTP liveness and readiness endpoints reporting the
+// state of the engine, its subsystems and exchange connections
+type HealthChecker struct {
+	started  int32
+	ready    int32
+	cfg      config.HealthCheck
+	bot      *Engine
+	server   *http.Server
+	wg       sync.WaitGroup
+	m        sync.Mutex
+	expected map[string]bool
+}
+
+// HealthReport is the response of the health endpoints
+type HealthReport struct {
+	Status string                        `json:"status"`
+	Time   time.Time                     `json:"time"`
+	Checks map[string]*HealthCheckResult `json:"checks,omitempty"`
+}
+
+// HealthCheckResult is the result of a single readiness check. Message and
+// Details describe why a check failed
+type HealthCheckResult struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+	Details any    `json:"details,omitempty"`
+}
+
+// ntpHealth holds the details of the NTP check
+type ntpHealth struct {
+	Drift                     string    `json:"drift"`
+	AllowedDifference         string    `json:"allowedDifference"`
+	AllowedNegativeDifference string    `json:"allowedNegativeDifference"`
+	LastChecked               time.Time `json:"lastChecked"`
+}
+
+// staleSyncItem is a synced pair which has not been updated within the
+// configured staleness
+type staleSyncItem struct {
+	Exchange    string    `json:"exchange"`
+	Asset       string    `json:"asset"`
+	Pair        string    `json:"pair"`
+	Item        string    `json:"item"`
+	LastUpdated time.Time `json:"lastUpdated"`
+	Age         string    `json:"age"`
+}
@@ -88,7 +88,12 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		vm.Name:                       bot.gctScriptManager.IsRunning(),
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
+		DataQualityManagerName:        bot.dataQualityManager.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
+		FuturesRiskManagerName:        bot.futuresRiskManager.IsRunning(),
+		TransferManagerName:           bot.transferManager.IsRunning(),
+		MetricsExporterName:           bot.metricsExporter.IsRunning(),
+		HealthCheckerName:             bot.healthChecker.IsRunning(),
 	}
 }
 
@@ -117,6 +122,15 @@ func (bot *Engine) GetRPCEndpoints() (map[string]RPCEndpoint, error) {
 
 // SetSubsystem enables or disables an engine subsystem
 func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
+	if err := bot.setSubsystem(subSystemName, enable); err != nil {
+		return err
+	}
+	bot.healthChecker.setExpected(strings.ToLower(subSystemName), enable)
+	return nil
+}
+
+// setSubsystem enables or disables an engine subsystem
+func (bot *Engine) setSubsystem(subSystemName string, enable bool) error {
 	if bot == nil {
 		return errNilBot
 	}
@@ -135,6 +149,7 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 				if err != nil {
 					return err
 				}
+				bot.CommunicationsManager.SetCommandHandler(newChatCommandHandler(bot))
 			}
 			return bot.CommunicationsManager.Start()
 		}
@@ -142,7 +157,7 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 	case ConnectionManagerName:
 		if enable {
 			if bot.connectionManager == nil {
-				bot.connectionManager, err = setupConnectionManager(&bot.Config.ConnectionMonitor)
+				bot.connectionManager, err = setupConnectionManager(&bot.Config.ConnectionMonitor, bot.CommunicationsManager)
 				if err != nil {
 					return err
 				}
@@ -157,6 +172,9 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 				if err != nil {
 					return err
 				}
+				if err = bot.OrderManager.tca.load(filepath.Join(bot.Settings.DataDir, tcaStoreFile)); err != nil {
+					return err
+				}
 			}
 			return bot.OrderManager.Start()
 		}
@@ -199,25 +217,7 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 	case SyncManagerName:
 		if enable {
 			if bot.currencyPairSyncer == nil {
-				cfg := bot.Config.SyncManagerConfig
-				cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
-				cfg.SynchronizeOrderbook = bot.Settings.EnableOrderbookSyncing
-				cfg.SynchronizeContinuously = bot.Settings.SyncContinuously
-				cfg.SynchronizeTrades = bot.Settings.EnableTradeSyncing
-				cfg.Verbose = bot.Settings.Verbose || cfg.Verbose
-
-				if cfg.TimeoutREST != bot.Settings.SyncTimeoutREST &&
-					bot.Settings.SyncTimeoutREST != config.DefaultSyncerTimeoutREST {
-					cfg.TimeoutREST = bot.Settings.SyncTimeoutREST
-				}
-				if cfg.TimeoutWebsocket != bot.Settings.SyncTimeoutWebsocket &&
-					bot.Settings.SyncTimeoutWebsocket != config.DefaultSyncerTimeoutWebsocket {
-					cfg.TimeoutWebsocket = bot.Settings.SyncTimeoutWebsocket
-				}
-				if cfg.NumWorkers != bot.Settings.SyncWorkersCount &&
-					bot.Settings.SyncWorkersCount != config.DefaultSyncerWorkers {
-					cfg.NumWorkers = bot.Settings.SyncWorkersCount
-				}
+				cfg := bot.syncManagerConfig()
 				bot.currencyPairSyncer, err = SetupSyncManager(
 					&cfg,
 					bot.ExchangeManager,
@@ -248,6 +248,17 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.dataHistoryManager.Start()
 		}
 		return bot.dataHistoryManager.Stop()
+	case DataQualityManagerName:
+		if enable {
+			if bot.dataQualityManager == nil {
+				bot.dataQualityManager, err = SetupDataQualityManager(bot.DatabaseManager, bot.dataHistoryManager, &bot.Config.DataQualityManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.dataQualityManager.Start()
+		}
+		return bot.dataQualityManager.Stop()
 	case vm.Name:
 		if enable {
 			if bot.gctScriptManager == nil {
@@ -272,6 +283,64 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.currencyStateManager.Start()
 		}
 		return bot.currencyStateManager.Stop()
+	case FuturesRiskManagerName:
+		if enable {
+			if bot.futuresRiskManager == nil {
+				bot.futuresRiskManager, err = SetupFuturesRiskManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.CommunicationsManager,
+					&bot.Config.FuturesRiskManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.futuresRiskManager.Start()
+		}
+		return bot.futuresRiskManager.Stop()
+	case TransferManagerName:
+		if enable {
+			if bot.transferManager == nil {
+				if bot.DepositAddressManager == nil {
+					return errNilDepositAddressManager
+				}
+				bot.transferManager, err = SetupTransferManager(
+					bot.ExchangeManager,
+					bot.WithdrawManager,
+					bot.DepositAddressManager,
+					bot.CommunicationsManager,
+					&bot.Config.TransferManager,
+					filepath.Join(bot.Settings.DataDir, transferStoreFile))
+				if err != nil {
+					return err
+				}
+			}
+			return bot.transferManager.Start()
+		}
+		return bot.transferManager.Stop()
+	case MetricsExporterName:
+		if enable {
+			if bot.metricsExporter == nil {
+				bot.metricsExporter, err = SetupMetricsExporter(bot, &bot.Config.MetricsExporter)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.metricsExporter.Start()
+		}
+		return bot.metricsExporter.Stop()
+	case HealthCheckerName:
+		if enable {
+			if bot.healthChecker == nil {
+				bot.healthChecker, err = SetupHealthChecker(bot, &bot.Config.HealthCheck)
+				if err != nil {
+					return err
+				}
+				bot.healthChecker.markReady()
+			}
+			return bot.healthChecker.Start()
+		}
+		return bot.healthChecker.Stop()
 	}
 	return fmt.Errorf("%s: %w", subSystemName, errSubsystemNotFound)
 }
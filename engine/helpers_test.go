@@ -99,7 +99,7 @@ func CreateTestBot(tb testing.TB) *Engine {
 }
 
 func TestGetSubsystemsStatus(t *testing.T) {
-	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 13, "GetSubsystemStatus should return the correct number of subsystems")
+	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 18, "GetSubsystemStatus should return the correct number of subsystems")
 }
 
 func TestGetRPCEndpoints(t *testing.T) {
@@ -298,7 +298,7 @@ func TestIsOnline(t *testing.T) {
 	t.Parallel()
 	e := CreateTestBot(t)
 	var err error
-	e.connectionManager, err = setupConnectionManager(&e.Config.ConnectionMonitor)
+	e.connectionManager, err = setupConnectionManager(&e.Config.ConnectionMonitor, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
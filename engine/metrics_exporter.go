@@ -0,0 +1,238 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/metrics"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupMetricsExporter creates the metrics exporter and registers the engine
+// and exchange metrics
+func SetupMetricsExporter(bot *Engine, cfg *config.MetricsExporter) (*MetricsExporter, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	m := &MetricsExporter{
+		cfg:      *cfg,
+		bot:      bot,
+		registry: metrics.NewRegistry(),
+	}
+	if m.cfg.ListenAddress == "" {
+		m.cfg.ListenAddress = DefaultMetricsExporterListenAddress
+	}
+	if m.cfg.Path == "" {
+		m.cfg.Path = DefaultMetricsExporterPath
+	}
+	if !strings.HasPrefix(m.cfg.Path, "/") {
+		return nil, fmt.Errorf("%w: %q", errMetricsPathInvalid, m.cfg.Path)
+	}
+
+	m.httpRequests = metrics.NewCounterVec("gct_exchange_http_requests", "Exchange REST request attempts by response status code", "exchange", "method", "rate_limit", "status")
+	m.httpRequestErrors = metrics.NewCounterVec("gct_exchange_http_request_errors", "Exchange REST request attempts which failed or returned a non 2xx status", "exchange", "method", "rate_limit")
+	m.httpRequestDuration = metrics.NewHistogramVec("gct_exchange_http_request_duration_seconds", "Exchange REST request latency", nil, "exchange", "method", "rate_limit")
+	m.rateLimitWait = metrics.NewHistogramVec("gct_exchange_rate_limit_wait_seconds", "Time exchange REST requests waited on rate limiters", rateLimitWaitBuckets, "exchange")
+	m.wsMessages = metrics.NewCounterVec("gct_websocket_messages_received", "Websocket messages received", "exchange")
+	m.wsReconnects = metrics.NewCounterVec("gct_websocket_reconnects", "Websocket reconnections made by the connection monitor", "exchange")
+	m.wsTrafficTimeouts = metrics.NewCounterVec("gct_websocket_traffic_timeouts", "Websocket connections reset after no traffic was received", "exchange")
+
+	err := m.registry.Register(
+		m.httpRequests,
+		m.httpRequestErrors,
+		m.httpRequestDuration,
+		m.rateLimitWait,
+		m.wsMessages,
+		m.wsReconnects,
+		m.wsTrafficTimeouts,
+		metrics.NewGaugeFunc("gct_websocket_connected", "Whether an enabled exchange websocket is connected", []string{"exchange"}, m.collectWebsocketState),
+		metrics.NewGaugeFunc("gct_orderbook_staleness_seconds", "Time since the sync manager last updated an orderbook", []string{"exchange", "asset", "pair"}, m.collectOrderbookStaleness),
+		metrics.NewGaugeFunc("gct_dispatch_queue_depth", "Jobs waiting in the dispatch queue", nil, m.collectDispatchQueueDepth),
+		metrics.NewGaugeFunc("gct_dispatch_queue_capacity", "Capacity of the dispatch queue", nil, m.collectDispatchQueueCapacity),
+		metrics.NewGaugeFunc("gct_orders", "Orders tracked by the order manager", []string{"exchange", "status"}, m.collectOrders),
+		metrics.NewGaugeFunc("gct_gctscript_vms", "Running gctscript virtual machines", nil, m.collectScriptVMs),
+		metrics.NewGaugeFunc("gct_gctscript_vms_max", "Maximum gctscript virtual machines", nil, m.collectScriptVMsMax),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MetricsExporter) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Start serves the metrics endpoint and starts collecting exchange metrics
+func (m *MetricsExporter) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsExporterName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", MetricsExporterName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Global, "Metrics exporter %s", MsgSubSystemStarting)
+	lc := net.ListenConfig{}
+	ln, err := lc.Listen(context.TODO(), "tcp", m.cfg.ListenAddress)
+	if err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return fmt.Errorf("%s listen error: %w", MetricsExporterName, err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(m.cfg.Path, m.registry.Handler())
+	m.server = &http.Server{
+		Addr:              m.cfg.ListenAddress,
+		ReadHeaderTimeout: 10 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		Handler:           mux,
+	}
+	request.SetupGlobalMetricsReporter(m)
+	websocket.SetupGlobalMetricsReporter(m)
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		if err := m.server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.Global, "Metrics exporter serve error: %s", err)
+		}
+	}()
+	log.Infof(log.Global, "Metrics exporter listening on http://%s%s", ln.Addr(), m.cfg.Path)
+	log.Debugf(log.Global, "Metrics exporter %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops serving metrics and collecting exchange metrics
+func (m *MetricsExporter) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsExporterName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", MetricsExporterName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "Metrics exporter %s", MsgSubSystemShuttingDown)
+	request.SetupGlobalMetricsReporter(nil)
+	websocket.SetupGlobalMetricsReporter(nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := m.server.Shutdown(ctx)
+	m.wg.Wait()
+	atomic.StoreInt32(&m.started, 0)
+	log.Debugf(log.Global, "Metrics exporter %s", MsgSubSystemShutdown)
+	return err
+}
+
+// Request records an exchange REST request attempt
+func (m *MetricsExporter) Request(name, method string, endpoint request.EndpointLimit, statusCode int, t time.Duration, err error) {
+	rateLimit := strconv.FormatUint(uint64(endpoint), 10)
+	status := metricsUnknownLabel
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	m.httpRequests.Inc(name, method, rateLimit, status)
+	m.httpRequestDuration.Observe(t.Seconds(), name, method, rateLimit)
+	if err != nil || statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		m.httpRequestErrors.Inc(name, method, rateLimit)
+	}
+}
+
+// RateLimitWait records the time an exchange REST request waited on its rate
+// limiter
+func (m *MetricsExporter) RateLimitWait(name string, t time.Duration) {
+	m.rateLimitWait.Observe(t.Seconds(), name)
+}
+
+// MessageReceived records a websocket message received
+func (m *MetricsExporter) MessageReceived(exch string) {
+	m.wsMessages.Inc(exch)
+}
+
+// Reconnected records a websocket reconnection
+func (m *MetricsExporter) Reconnected(exch string) {
+	m.wsReconnects.Inc(exch)
+}
+
+// TrafficTimeout records a websocket connection reset due to missing traffic
+func (m *MetricsExporter) TrafficTimeout(exch string) {
+	m.wsTrafficTimeouts.Inc(exch)
+}
+
+func (m *MetricsExporter) collectWebsocketState(emit func(float64, ...string)) {
+	if m.bot.ExchangeManager == nil {
+		return
+	}
+	exchanges, err := m.bot.ExchangeManager.GetExchanges()
+	if err != nil {
+		return
+	}
+	for _, exch := range exchanges {
+		if !exch.IsWebsocketEnabled() {
+			continue
+		}
+		ws, err := exch.GetWebsocket()
+		if err != nil || ws == nil {
+			continue
+		}
+		var connected float64
+		if ws.IsConnected() {
+			connected = 1
+		}
+		emit(connected, exch.GetName())
+	}
+}
+
+func (m *MetricsExporter) collectOrderbookStaleness(emit func(float64, ...string)) {
+	if !m.bot.currencyPairSyncer.IsRunning() {
+		return
+	}
+	now := time.Now()
+	for k, updated := range m.bot.currencyPairSyncer.lastUpdated(SyncItemOrderbook) {
+		emit(now.Sub(updated).Seconds(), k.Exchange, k.Asset.String(), k.Pair().String())
+	}
+}
+
+func (m *MetricsExporter) collectDispatchQueueDepth(emit func(float64, ...string)) {
+	queued, _ := dispatch.QueueDepth()
+	emit(float64(queued))
+}
+
+func (m *MetricsExporter) collectDispatchQueueCapacity(emit func(float64, ...string)) {
+	_, capacity := dispatch.QueueDepth()
+	emit(float64(capacity))
+}
+
+func (m *MetricsExporter) collectOrders(emit func(float64, ...string)) {
+	if !m.bot.OrderManager.IsRunning() {
+		return
+	}
+	for exch, byStatus := range m.bot.OrderManager.orderStore.countByStatus() {
+		for status, count := range byStatus {
+			emit(float64(count), exch, status.String())
+		}
+	}
+}
+
+func (m *MetricsExporter) collectScriptVMs(emit func(float64, ...string)) {
+	emit(float64(vm.VMSCount.Len()))
+}
+
+func (m *MetricsExporter) collectScriptVMsMax(emit func(float64, ...string)) {
+	if !m.bot.gctScriptManager.IsRunning() {
+		return
+	}
+	emit(float64(m.bot.gctScriptManager.GetMaxVirtualMachines()))
+}
@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+func TestSetupMetricsExporter(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMetricsExporter(nil, &config.MetricsExporter{})
+	assert.ErrorIs(t, err, errNilBot)
+
+	_, err = SetupMetricsExporter(&Engine{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = SetupMetricsExporter(&Engine{}, &config.MetricsExporter{Path: "metrics"})
+	assert.ErrorIs(t, err, errMetricsPathInvalid)
+
+	m, err := SetupMetricsExporter(&Engine{}, &config.MetricsExporter{})
+	require.NoError(t, err, "SetupMetricsExporter must not error")
+	assert.Equal(t, DefaultMetricsExporterListenAddress, m.cfg.ListenAddress, "ListenAddress should default")
+	assert.Equal(t, DefaultMetricsExporterPath, m.cfg.Path, "Path should default")
+}
+
+func TestMetricsExporterStartStop(t *testing.T) {
+	t.Parallel()
+	var m *MetricsExporter
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning(), "IsRunning should return false on a nil subsystem")
+
+	m, err := SetupMetricsExporter(&Engine{}, &config.MetricsExporter{ListenAddress: "localhost:0"})
+	require.NoError(t, err, "SetupMetricsExporter must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning(), "IsRunning should return true")
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning(), "IsRunning should return false")
+}
+
+func TestMetricsExporterCollect(t *testing.T) {
+	t.Parallel()
+	m, err := SetupMetricsExporter(&Engine{}, &config.MetricsExporter{})
+	require.NoError(t, err, "SetupMetricsExporter must not error")
+
+	m.Request("Bitstamp", http.MethodGet, request.UnAuth, http.StatusOK, time.Millisecond*20, nil)
+	m.Request("Bitstamp", http.MethodGet, request.UnAuth, http.StatusTooManyRequests, time.Millisecond*20, errors.New("rate limited"))
+	m.Request("Bitstamp", http.MethodPost, request.Auth, 0, time.Second, io.ErrUnexpectedEOF)
+	m.RateLimitWait("Bitstamp", time.Millisecond*3)
+	m.MessageReceived("Bitstamp")
+	m.MessageReceived("Bitstamp")
+	m.Reconnected("Bitstamp")
+	m.TrafficTimeout("Bitstamp")
+
+	var b bytes.Buffer
+	require.NoError(t, m.registry.Write(&b), "Write must not error")
+	out := b.String()
+	for _, exp := range []string{
+		`gct_exchange_http_requests_total{exchange="Bitstamp",method="GET",rate_limit="2",status="200"} 1`,
+		`gct_exchange_http_requests_total{exchange="Bitstamp",method="GET",rate_limit="2",status="429"} 1`,
+		`gct_exchange_http_requests_total{exchange="Bitstamp",method="POST",rate_limit="1",status="unknown"} 1`,
+		`gct_exchange_http_request_errors_total{exchange="Bitstamp",method="GET",rate_limit="2"} 1`,
+		`gct_exchange_http_request_errors_total{exchange="Bitstamp",method="POST",rate_limit="1"} 1`,
+		`gct_exchange_http_request_duration_seconds_count{exchange="Bitstamp",method="GET",rate_limit="2"} 2`,
+		`gct_exchange_rate_limit_wait_seconds_count{exchange="Bitstamp"} 1`,
+		`gct_websocket_messages_received_total{exchange="Bitstamp"} 2`,
+		`gct_websocket_reconnects_total{exchange="Bitstamp"} 1`,
+		`gct_websocket_traffic_timeouts_total{exchange="Bitstamp"} 1`,
+		`gct_dispatch_queue_capacity `,
+		`gct_gctscript_vms `,
+	} {
+		assert.Contains(t, out, exp, "metrics output should contain the expected sample")
+	}
+	assert.NotContains(t, out, "gct_orders{", "orders should not be reported without a running order manager")
+	assert.NotContains(t, out, "\ngct_gctscript_vms_max ", "max VMs should not be reported without a running script manager")
+}
@@ -0,0 +1,50 @@
+package engine
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common/metrics"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+const (
+	// MetricsExporterName is an exported subsystem name
+	MetricsExporterName = "metrics_exporter"
+	// DefaultMetricsExporterListenAddress is the default address the metrics
+	// endpoint is served on
+	DefaultMetricsExporterListenAddress = "localhost:9464"
+	// DefaultMetricsExporterPath is the default HTTP path of the metrics
+	// endpoint
+	DefaultMetricsExporterPath = "/metrics"
+	// metricsUnknownLabel is used for label values which cannot be determined
+	metricsUnknownLabel = "unknown"
+)
+
+var (
+	errMetricsPathInvalid = errors.New("metrics path must start with /")
+
+	// rateLimitWaitBuckets are histogram buckets in seconds for rate limiter
+	// waits, which are usually far shorter than requests
+	rateLimitWaitBuckets = []float64{.0001, .001, .005, .01, .05, .1, .5, 1, 5, 10, 30}
+)
+
+// MetricsExporter serves engine and exchange health metrics in the
+// Prometheus text exposition format
+type MetricsExporter struct {
+	started  int32
+	cfg      config.MetricsExporter
+	bot      *Engine
+	registry *metrics.Registry
+	server   *http.Server
+	wg       sync.WaitGroup
+
+	httpRequests        *metrics.CounterVec
+	httpRequestErrors   *metrics.CounterVec
+	httpRequestDuration *metrics.HistogramVec
+	rateLimitWait       *metrics.HistogramVec
+	wsMessages          *metrics.CounterVec
+	wsReconnects        *metrics.CounterVec
+	wsTrafficTimeouts   *metrics.CounterVec
+}
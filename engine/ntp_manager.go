@@ -138,6 +138,8 @@ func (m *ntpManager) processTime() error {
 	}
 	currentTime := time.Now()
 	diff := NTPTime.Sub(currentTime)
+	m.drift.Store(int64(diff))
+	m.lastChecked.Store(currentTime.UnixNano())
 	configNTPTime := m.allowedDifference
 	negDiff := m.allowedNegativeDifference
 	configNTPNegativeTime := -negDiff
@@ -152,6 +154,17 @@ func (m *ntpManager) processTime() error {
 	return nil
 }
 
+// lastDrift returns the difference between NTP and system time found by the
+// most recent check and when the check was made. A zero time is returned when
+// no check has completed
+func (m *ntpManager) lastDrift() (time.Duration, time.Time) {
+	checked := m.lastChecked.Load()
+	if checked == 0 {
+		return 0, time.Time{}
+	}
+	return time.Duration(m.drift.Load()), time.Unix(0, checked)
+}
+
 // checkTimeInPools returns local based on ntp servers provided timestamp
 // if no server can be reached will return local time in UTC()
 func (m *ntpManager) checkTimeInPools() time.Time {
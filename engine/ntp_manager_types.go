@@ -2,6 +2,7 @@ package engine
 
 import (
 	"errors"
+	"sync/atomic"
 	"time"
 )
 
@@ -28,6 +29,9 @@ type ntpManager struct {
 	checkInterval             time.Duration
 	retryLimit                int
 	loggingEnabled            bool
+	// drift and lastChecked hold the result of the most recent time check
+	drift       atomic.Int64
+	lastChecked atomic.Int64
 }
 
 type ntpPacket struct {
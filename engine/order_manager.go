@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -13,9 +14,11 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
@@ -23,6 +26,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SetupOrderManager will boot up the OrderManager
@@ -39,15 +44,10 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 	if cfg == nil {
 		return nil, fmt.Errorf("%w OrderManager", errNilConfig)
 	}
-	if cfg.ActivelyTrackFuturesPositions && cfg.FuturesTrackingSeekDuration <= 0 {
-		return nil, errInvalidFuturesTrackingSeekDuration
-	}
 
 	om := &OrderManager{
-		shutdown:                      make(chan struct{}),
-		activelyTrackFuturesPositions: cfg.ActivelyTrackFuturesPositions,
-		futuresPositionSeekDuration:   cfg.FuturesTrackingSeekDuration,
-		respectOrderHistoryLimits:     cfg.RespectOrderHistoryLimits,
+		shutdown: make(chan struct{}),
+		tca:      newTransactionCostAnalyser(),
 		orderStore: store{
 			Orders:                    make(map[string][]*order.Detail),
 			exchangeManager:           exchangeManager,
@@ -55,14 +55,34 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 			wg:                        wg,
 			futuresPositionController: futures.SetupPositionController(),
 		},
-		verbose: cfg.Verbose,
-		cfg: orderManagerConfig{
-			CancelOrdersOnShutdown: cfg.CancelOrdersOnShutdown,
-		},
+	}
+	if err := om.setConfig(cfg); err != nil {
+		return nil, err
 	}
 	return om, nil
 }
 
+// setConfig applies the config values which can be changed while the
+// subsystem is running
+func (m *OrderManager) setConfig(cfg *config.OrderManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if cfg == nil {
+		return fmt.Errorf("%w OrderManager", errNilConfig)
+	}
+	if cfg.ActivelyTrackFuturesPositions && cfg.FuturesTrackingSeekDuration <= 0 {
+		return errInvalidFuturesTrackingSeekDuration
+	}
+	m.verbose.Store(cfg.Verbose)
+	m.activelyTrackFuturesPositions.Store(cfg.ActivelyTrackFuturesPositions)
+	m.futuresPositionSeekDuration.Store(int64(cfg.FuturesTrackingSeekDuration))
+	m.respectOrderHistoryLimits.Store(cfg.RespectOrderHistoryLimits)
+	m.cancelOrdersOnShutdown.Store(cfg.CancelOrdersOnShutdown)
+	m.tca.setPostTradeDelay(cfg.TransactionCostPostTradeDelay)
+	return nil
+}
+
 // IsRunning safely checks whether the subsystem is running
 func (m *OrderManager) IsRunning() bool {
 	return m != nil && atomic.LoadInt32(&m.started) == 1
@@ -99,7 +119,7 @@ func (m *OrderManager) Stop() error {
 
 // gracefulShutdown cancels all orders (if enabled) before shutting down
 func (m *OrderManager) gracefulShutdown() {
-	if !m.cfg.CancelOrdersOnShutdown {
+	if !m.cancelOrdersOnShutdown.Load() {
 		return
 	}
 	log.Debugln(log.OrderMgr, "Cancelling any open orders...")
@@ -115,20 +135,33 @@ func (m *OrderManager) gracefulShutdown() {
 func (m *OrderManager) run() {
 	log.Debugln(log.OrderMgr, "Order manager started.")
 	m.processOrders()
+	tcaTicker := time.NewTicker(transactionCostInterval)
+	defer tcaTicker.Stop()
 	for {
 		select {
 		case <-m.shutdown:
 			m.gracefulShutdown()
+			m.flushTransactionCosts()
 			m.orderStore.wg.Done()
 			log.Debugln(log.OrderMgr, "Order manager shutdown.")
 			return
 		case <-time.After(orderManagerInterval):
 			// Process orders go routine allows shutdown procedures to continue
 			go m.processOrders()
+		case now := <-tcaTicker.C:
+			m.tca.samplePostTradeMids(m.orderStore.exchangeManager, now)
+			m.flushTransactionCosts()
 		}
 	}
 }
 
+// flushTransactionCosts persists any transaction cost analysis changes
+func (m *OrderManager) flushTransactionCosts() {
+	if err := m.tca.flush(); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to persist transaction costs: %v", err)
+	}
+}
+
 // CancelAllOrders iterates and cancels all orders for each exchange provided
 func (m *OrderManager) CancelAllOrders(ctx context.Context, exchanges []exchange.IBotExchange) {
 	if m == nil || atomic.LoadInt32(&m.started) == 0 {
@@ -169,13 +202,25 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 	if atomic.LoadInt32(&m.started) == 0 {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
+	ctx, span := tracing.Start(ctx, "OrderManager.Cancel")
 	var err error
 	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
 		if err != nil {
-			m.orderStore.commsManager.PushEvent(base.Event{
-				Type:    "order",
-				Message: err.Error(),
-			})
+			evt := base.Event{
+				Category: base.CategoryOrder,
+				Severity: base.SeverityError,
+				Message:  err.Error(),
+			}
+			if cancel != nil {
+				evt.Exchange = cancel.Exchange
+				if !cancel.Pair.IsEmpty() {
+					evt.Pair = cancel.Pair.String()
+				}
+				evt.Payload = map[string]any{"orderID": cancel.OrderID}
+			}
+			m.orderStore.commsManager.PushEvent(evt)
 		}
 	}()
 
@@ -204,7 +249,11 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 	log.Debugf(log.OrderMgr, "Cancelling order ID %v [%+v]",
 		cancel.OrderID, cancel)
 
-	err = exch.CancelOrder(ctx, cancel)
+	span.SetAttributes(attribute.String("exchange", cancel.Exchange), attribute.String("order_id", cancel.OrderID))
+	exchCtx, exchSpan := tracing.Start(ctx, "exchange.CancelOrder", trace.WithAttributes(attribute.String("exchange", exch.GetName())))
+	err = exch.CancelOrder(exchCtx, cancel)
+	tracing.RecordError(exchSpan, err)
+	exchSpan.End()
 	if err != nil {
 		err = fmt.Errorf("%v - Failed to cancel order: %w", cancel.Exchange, err)
 		return err
@@ -222,10 +271,12 @@ func (m *OrderManager) Cancel(ctx context.Context, cancel *order.Cancel) error {
 		return err
 	}
 
+	m.observeTransactionCost(od)
+
 	msg := fmt.Sprintf("Exchange %s order ID=%v cancelled.",
 		od.Exchange, od.OrderID)
 	log.Debugln(log.OrderMgr, msg)
-	m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+	m.orderStore.commsManager.PushEvent(orderEvent(base.SeverityInfo, msg, od))
 	return nil
 }
 
@@ -257,7 +308,7 @@ func (m *OrderManager) GetOpenFuturesPosition(exch string, item asset.Item, pair
 	if !item.IsFutures() {
 		return nil, fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
-	if !m.activelyTrackFuturesPositions {
+	if !m.activelyTrackFuturesPositions.Load() {
 		return nil, errFuturesTrackingDisabled
 	}
 	return m.orderStore.futuresPositionController.GetOpenPosition(exch, item, pair)
@@ -272,7 +323,7 @@ func (m *OrderManager) GetAllOpenFuturesPositions() ([]futures.Position, error)
 	if atomic.LoadInt32(&m.started) == 0 {
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	if !m.activelyTrackFuturesPositions {
+	if !m.activelyTrackFuturesPositions.Load() {
 		return nil, errFuturesTrackingDisabled
 	}
 	return m.orderStore.futuresPositionController.GetAllOpenPositions()
@@ -333,6 +384,7 @@ func (m *OrderManager) GetOrderInfo(ctx context.Context, exchangeName, orderID s
 	if err != nil {
 		return order.Detail{}, err
 	}
+	result.Account = accounts.AccountFromContext(ctx)
 
 	upsertResponse, err := m.orderStore.upsert(result)
 	if err != nil {
@@ -379,13 +431,21 @@ func (m *OrderManager) validate(exch exchange.IBotExchange, newOrder *order.Subm
 
 // Modify depends on the order.Modify.ID and order.Modify.Exchange fields to uniquely
 // identify an order to modify.
-func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (_ *order.ModifyResponse, err error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
 	if atomic.LoadInt32(&m.started) == 0 {
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
+	ctx, span := tracing.Start(ctx, "OrderManager.Modify", trace.WithAttributes(
+		attribute.String("exchange", mod.Exchange),
+		attribute.String("order_id", mod.OrderID),
+	))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
 
 	// Fetch details from locally managed order store.
 	det, err := m.orderStore.getByExchangeAndID(mod.Exchange, mod.OrderID)
@@ -413,7 +473,10 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	if err != nil {
 		return nil, err
 	}
-	res, err := exch.ModifyOrder(ctx, mod)
+	exchCtx, exchSpan := tracing.Start(ctx, "exchange.ModifyOrder", trace.WithAttributes(attribute.String("exchange", exch.GetName())))
+	res, err := exch.ModifyOrder(exchCtx, mod)
+	tracing.RecordError(exchSpan, err)
+	exchSpan.End()
 	if err != nil {
 		message := fmt.Sprintf(
 			"Exchange %s order ID=%v: failed to modify",
@@ -421,8 +484,12 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 			mod.OrderID,
 		)
 		m.orderStore.commsManager.PushEvent(base.Event{
-			Type:    "order",
-			Message: message,
+			Category: base.CategoryOrder,
+			Severity: base.SeverityError,
+			Message:  message,
+			Exchange: mod.Exchange,
+			Pair:     mod.Pair.String(),
+			Payload:  map[string]any{"orderID": mod.OrderID},
 		})
 		return nil, err
 	}
@@ -434,22 +501,24 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	err = m.orderStore.modifyExisting(mod.OrderID, res)
 
 	// Notify observers.
-	var message string
+	message, severity := "Exchange %s order ID=%v: modified successfully", base.SeverityInfo
 	if err != nil {
-		message = "Exchange %s order ID=%v: modified on exchange, but failed to modify locally"
-	} else {
-		message = "Exchange %s order ID=%v: modified successfully"
+		message, severity = "Exchange %s order ID=%v: modified on exchange, but failed to modify locally", base.SeverityWarning
 	}
 	m.orderStore.commsManager.PushEvent(base.Event{
-		Type:    "order",
-		Message: fmt.Sprintf(message, mod.Exchange, res.OrderID),
+		Category: base.CategoryOrder,
+		Severity: severity,
+		Message:  fmt.Sprintf(message, mod.Exchange, res.OrderID),
+		Exchange: mod.Exchange,
+		Pair:     mod.Pair.String(),
+		Payload:  map[string]any{"orderID": res.OrderID},
 	})
 	return &order.ModifyResponse{OrderID: res.OrderID}, err
 }
 
 // Submit will take in an order struct, send it to the exchange and
 // populate it in the OrderManager if successful
-func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (resp *OrderSubmitResponse, err error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -459,11 +528,25 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	if newOrder == nil {
 		return nil, errNilOrder
 	}
+	ctx, span := tracing.Start(ctx, "OrderManager.Submit", trace.WithAttributes(
+		attribute.String("exchange", newOrder.Exchange),
+		attribute.String("asset", newOrder.AssetType.String()),
+		attribute.String("pair", newOrder.Pair.String()),
+		attribute.String("side", newOrder.Side.String()),
+		attribute.String("type", newOrder.Type.String()),
+	))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(newOrder.Exchange)
 	if err != nil {
 		return nil, err
 	}
+	_, validateSpan := tracing.Start(ctx, "OrderManager.validate")
 	err = m.validate(exch, newOrder)
+	tracing.RecordError(validateSpan, err)
+	validateSpan.End()
 	if err != nil {
 		return nil, err
 	}
@@ -490,12 +573,28 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 			err)
 	}
 
-	result, err := exch.SubmitOrder(ctx, newOrder)
+	// Arrival prices are captured best effort as they are only required for
+	// transaction cost analysis and must not prevent order submission
+	arrival, err := captureArrivalPrice(exch, newOrder, strategyFromContext(ctx))
+	if err != nil && m.verbose.Load() {
+		log.Debugf(log.OrderMgr, "Order manager unable to capture arrival price: %v", err)
+	}
+
+	exchCtx, exchSpan := tracing.Start(ctx, "exchange.SubmitOrder", trace.WithAttributes(attribute.String("exchange", exch.GetName())))
+	result, err := exch.SubmitOrder(exchCtx, newOrder)
+	tracing.RecordError(exchSpan, err)
+	exchSpan.End()
 	if err != nil {
 		return nil, err
 	}
 
-	return m.processSubmittedOrder(result)
+	resp, err = m.processSubmittedOrder(result, accounts.AccountFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	m.tca.record(resp.Detail.OrderID, arrival)
+	m.observeTransactionCost(resp.Detail)
+	return resp, nil
 }
 
 // SubmitFakeOrder runs through the same process as order submission
@@ -532,7 +631,7 @@ func (m *OrderManager) SubmitFakeOrder(newOrder *order.Submit, resultingOrder *o
 				err)
 		}
 	}
-	return m.processSubmittedOrder(resultingOrder)
+	return m.processSubmittedOrder(resultingOrder, "")
 }
 
 // GetOrdersSnapshot returns a snapshot of all orders in the orderstore. It optionally filters any orders that do not match the status
@@ -579,8 +678,9 @@ func (m *OrderManager) GetOrdersActive(f *order.Filter) ([]order.Detail, error)
 	return m.orderStore.getActiveOrders(f), nil
 }
 
-// processSubmittedOrder adds a new order to the manager
-func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse) (*OrderSubmitResponse, error) {
+// processSubmittedOrder adds a new order to the manager, recording the named
+// account it was submitted with
+func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse, account string) (*OrderSubmitResponse, error) {
 	if newOrderResp == nil {
 		return nil, order.ErrOrderDetailIsNil
 	}
@@ -594,6 +694,7 @@ func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse)
 	if err != nil {
 		return nil, err
 	}
+	detail.Account = account
 
 	if err := m.orderStore.add(detail.CopyToPointer()); errors.Is(err, ErrOrdersAlreadyExists) {
 		// Streamed by ws before we got here. Details from ws supersede since they are more recent.
@@ -617,7 +718,7 @@ func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse)
 
 	log.Debugln(log.OrderMgr, msg)
 	if m.orderStore.commsManager != nil {
-		m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+		m.orderStore.commsManager.PushEvent(orderEvent(base.SeverityInfo, msg, detail))
 	}
 
 	return &OrderSubmitResponse{Detail: detail, InternalOrderID: detail.InternalOrderID.String()}, nil
@@ -640,11 +741,14 @@ func (m *OrderManager) processOrders() {
 		if !exchanges[x].IsRESTAuthenticationSupported() {
 			continue
 		}
-		if m.verbose {
+		if m.verbose.Load() {
 			log.Debugf(log.OrderMgr,
 				"Processing orders for exchange %v",
 				exchanges[x].GetName())
 		}
+		// Orders are synced for the default credentials followed by each
+		// named account
+		accountNames := append([]string{""}, exchanges[x].GetAccountNames()...)
 		enabledAssets := exchanges[x].GetAssetTypes(true)
 		for y := range enabledAssets {
 			var pairs currency.Pairs
@@ -659,7 +763,7 @@ func (m *OrderManager) processOrders() {
 			}
 
 			if len(pairs) == 0 {
-				if m.verbose {
+				if m.verbose.Load() {
 					log.Debugf(log.OrderMgr,
 						"No pairs enabled for %s and asset type %s, skipping...",
 						exchanges[x].GetName(),
@@ -668,48 +772,12 @@ func (m *OrderManager) processOrders() {
 				continue
 			}
 
-			filter := &order.Filter{Exchange: exchanges[x].GetName()}
-			orders := m.orderStore.getActiveOrders(filter)
-			order.FilterOrdersByPairs(&orders, pairs)
-			var result []order.Detail
-			result, err = exchanges[x].GetActiveOrders(context.TODO(), &order.MultiOrderRequest{
-				Side:      order.AnySide,
-				Type:      order.AnyType,
-				Pairs:     pairs,
-				AssetType: enabledAssets[y],
-			})
-			if err != nil {
-				log.Errorf(log.OrderMgr,
-					"Unable to get active orders for %s and asset type %s: %s",
-					exchanges[x].GetName(),
-					enabledAssets[y],
-					err)
-				continue
-			}
-			for z := range result {
-				var upsertResponse *OrderUpsertResponse
-				upsertResponse, err = m.UpsertOrder(&result[z])
-				if err != nil {
-					log.Errorln(log.OrderMgr, err)
-					continue
-				}
-				for i := range orders {
-					if orders[i].InternalOrderID != upsertResponse.OrderDetails.InternalOrderID {
-						continue
-					}
-					orders[i] = orders[len(orders)-1]
-					orders = orders[:len(orders)-1]
-					break
-				}
-			}
-
-			if exchanges[x].GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
-				wg.Add(1)
-				go m.processMatchingOrders(exchanges[x], orders, &wg)
+			for _, account := range accountNames {
+				m.processActiveOrders(exchanges[x], enabledAssets[y], pairs, account, &wg)
 			}
 
 			supportedFeatures := exchanges[x].GetSupportedFeatures()
-			if m.activelyTrackFuturesPositions && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
+			if m.activelyTrackFuturesPositions.Load() && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
 				var positions []futures.PositionResponse
 				var sd time.Time
 				sd, err = m.orderStore.futuresPositionController.LastUpdated()
@@ -718,13 +786,13 @@ func (m *OrderManager) processOrders() {
 					return
 				}
 				if sd.IsZero() {
-					sd = time.Now().Add(-m.futuresPositionSeekDuration)
+					sd = time.Now().Add(-time.Duration(m.futuresPositionSeekDuration.Load()))
 				}
 				positions, err = exchanges[x].GetFuturesPositionOrders(context.TODO(), &futures.PositionsRequest{
 					Asset:                     enabledAssets[y],
 					Pairs:                     pairs,
 					StartDate:                 sd,
-					RespectOrderHistoryLimits: m.respectOrderHistoryLimits,
+					RespectOrderHistoryLimits: m.respectOrderHistoryLimits.Load(),
 				})
 				if err != nil {
 					if !errors.Is(err, common.ErrNotYetImplemented) {
@@ -745,14 +813,69 @@ func (m *OrderManager) processOrders() {
 		}
 	}
 	wg.Wait()
-	if m.verbose {
+	if m.verbose.Load() {
 		log.Debugf(log.OrderMgr, "Finished processing orders")
 	}
 }
 
+// processActiveOrders syncs active orders for an exchange account and asset
+// with the order store, checking orders which are no longer active on the
+// exchange for their final state
+func (m *OrderManager) processActiveOrders(exch exchange.IBotExchange, a asset.Item, pairs currency.Pairs, account string, wg *sync.WaitGroup) {
+	orders := m.orderStore.getActiveOrders(&order.Filter{Exchange: exch.GetName()})
+	orders = slices.DeleteFunc(orders, func(d order.Detail) bool { return !strings.EqualFold(d.Account, account) })
+	order.FilterOrdersByPairs(&orders, pairs)
+	result, err := exch.GetActiveOrders(accounts.DeployAccountToContext(context.TODO(), account), &order.MultiOrderRequest{
+		Side:      order.AnySide,
+		Type:      order.AnyType,
+		Pairs:     pairs,
+		AssetType: a,
+	})
+	if err != nil {
+		log.Errorf(log.OrderMgr,
+			"Unable to get active orders for %s %s and asset type %s: %s",
+			exch.GetName(),
+			accountDescription(account),
+			a,
+			err)
+		return
+	}
+	for z := range result {
+		if account != "" {
+			result[z].Account = account
+		}
+		upsertResponse, err := m.UpsertOrder(&result[z])
+		if err != nil {
+			log.Errorln(log.OrderMgr, err)
+			continue
+		}
+		for i := range orders {
+			if orders[i].InternalOrderID != upsertResponse.OrderDetails.InternalOrderID {
+				continue
+			}
+			orders[i] = orders[len(orders)-1]
+			orders = orders[:len(orders)-1]
+			break
+		}
+	}
+
+	if exch.GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
+		wg.Add(1)
+		go m.processMatchingOrders(exch, orders, wg)
+	}
+}
+
+// accountDescription returns a log friendly description of a named account
+func accountDescription(account string) string {
+	if account == "" {
+		return "default account"
+	}
+	return "account " + account
+}
+
 // processFuturesPositions ensures any open position found is kept up to date in the order manager
 func (m *OrderManager) processFuturesPositions(exch exchange.IBotExchange, position *futures.PositionResponse) error {
-	if !m.activelyTrackFuturesPositions {
+	if !m.activelyTrackFuturesPositions.Load() {
 		return errFuturesTrackingDisabled
 	}
 	if exch == nil {
@@ -835,11 +958,12 @@ func (m *OrderManager) FetchAndUpdateExchangeOrder(exch exchange.IBotExchange, o
 	if ord == nil {
 		return errors.New("order manager: Order is nil")
 	}
-	fetchedOrder, err := exch.GetOrderInfo(context.TODO(), ord.OrderID, ord.Pair, assetType)
+	fetchedOrder, err := exch.GetOrderInfo(accounts.DeployAccountToContext(context.TODO(), ord.Account), ord.OrderID, ord.Pair, assetType)
 	if err != nil {
 		ord.Status = order.UnknownStatus
 		return err
 	}
+	fetchedOrder.Account = ord.Account
 	fetchedOrder.LastUpdated = time.Now()
 	_, err = m.UpsertOrder(fetchedOrder)
 	return err
@@ -881,7 +1005,13 @@ func (m *OrderManager) UpdateExistingOrder(od *order.Detail) error {
 	if atomic.LoadInt32(&m.started) == 0 {
 		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
-	return m.orderStore.updateExisting(od)
+	if err := m.orderStore.updateExisting(od); err != nil {
+		return err
+	}
+	if updated, err := m.orderStore.getByExchangeAndID(od.Exchange, od.OrderID); err == nil {
+		m.observeTransactionCost(updated)
+	}
+	return nil
 }
 
 // UpsertOrder updates an existing order or adds a new one to the orderstore
@@ -895,21 +1025,15 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 	if od == nil {
 		return nil, errNilOrder
 	}
-	var msg string
-	defer func(message *string) {
-		if message == nil {
-			log.Errorf(log.OrderMgr, "UpsertOrder: produced nil order event message\n")
-			return
-		}
-		m.orderStore.commsManager.PushEvent(base.Event{
-			Type:    "order",
-			Message: *message,
-		})
-	}(&msg)
+	var evt base.Event
+	defer func() {
+		m.orderStore.commsManager.PushEvent(evt)
+	}()
 
 	upsertResponse, err := m.orderStore.upsert(od)
 	if err != nil {
-		msg = fmt.Sprintf(
+		evt = orderEvent(base.SeverityError, "", od)
+		evt.Message = fmt.Sprintf(
 			"Exchange %s unable to upsert order ID=%v internal ID=%v pair=%v price=%.8f amount=%.8f side=%v type=%v status=%v: %s",
 			od.Exchange, od.OrderID, od.InternalOrderID, od.Pair, od.Price, od.Amount, od.Side, od.Type, od.Status, err)
 		return nil, err
@@ -919,10 +1043,12 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 	if upsertResponse.IsNewOrder {
 		status = "added"
 	}
-	msg = fmt.Sprintf("Exchange %s %s order ID=%v internal ID=%v pair=%v price=%.8f amount=%.8f side=%v type=%v status=%v.",
+	msg := fmt.Sprintf("Exchange %s %s order ID=%v internal ID=%v pair=%v price=%.8f amount=%.8f side=%v type=%v status=%v.",
 		upsertResponse.OrderDetails.Exchange, status, upsertResponse.OrderDetails.OrderID, upsertResponse.OrderDetails.InternalOrderID,
 		upsertResponse.OrderDetails.Pair, upsertResponse.OrderDetails.Price, upsertResponse.OrderDetails.Amount,
 		upsertResponse.OrderDetails.Side, upsertResponse.OrderDetails.Type, upsertResponse.OrderDetails.Status)
+	evt = orderEvent(base.SeverityInfo, msg, &upsertResponse.OrderDetails)
+	m.observeTransactionCost(&upsertResponse.OrderDetails)
 	if upsertResponse.IsNewOrder {
 		log.Infoln(log.OrderMgr, msg)
 		return upsertResponse, nil
@@ -942,6 +1068,21 @@ func (s *store) get() map[string][]*order.Detail {
 	return orders
 }
 
+// countByStatus returns the number of orders per exchange and status
+func (s *store) countByStatus() map[string]map[order.Status]int {
+	counts := make(map[string]map[order.Status]int)
+	s.m.RLock()
+	defer s.m.RUnlock()
+	for exch, orders := range s.Orders {
+		byStatus := make(map[order.Status]int)
+		for _, od := range orders {
+			byStatus[od.Status]++
+		}
+		counts[exch] = byStatus
+	}
+	return counts
+}
+
 // getByExchangeAndID returns a specific order by exchange and id
 func (s *store) getByExchangeAndID(exch, id string) (*order.Detail, error) {
 	s.m.Lock()
@@ -1181,3 +1322,32 @@ func (s *store) getActiveOrders(f *order.Filter) []order.Detail {
 
 	return orders
 }
+
+// orderEvent returns an order notification for the order, categorised as a
+// fill when the order has been wholly or partially filled
+func orderEvent(severity base.Severity, msg string, od *order.Detail) base.Event {
+	evt := base.Event{
+		Category: base.CategoryOrder,
+		Severity: severity,
+		Message:  msg,
+		Exchange: od.Exchange,
+		Payload: map[string]any{
+			"orderID":         od.OrderID,
+			"internalOrderID": od.InternalOrderID.String(),
+			"asset":           od.AssetType.String(),
+			"side":            od.Side.String(),
+			"type":            od.Type.String(),
+			"status":          od.Status.String(),
+			"price":           od.Price,
+			"amount":          od.Amount,
+			"executedAmount":  od.ExecutedAmount,
+		},
+	}
+	if !od.Pair.IsEmpty() {
+		evt.Pair = od.Pair.String()
+	}
+	if severity == base.SeverityInfo && (od.Status == order.Filled || od.Status == order.PartiallyFilled) {
+		evt.Category = base.CategoryFill
+	}
+	return evt
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
@@ -1402,7 +1403,7 @@ func TestGetAllOpenFuturesPositions(t *testing.T) {
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started = 1
-	o.activelyTrackFuturesPositions = true
+	o.activelyTrackFuturesPositions.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
 	_, err = o.GetAllOpenFuturesPositions()
 	assert.ErrorIs(t, err, futures.ErrNoPositionsFound)
@@ -1615,3 +1616,86 @@ func TestGetByDetail(t *testing.T) {
 		assert.Equal(t, od.ClientOrderID, byID.ClientOrderID, "Retrieve by id pointer should contain the correct ClientOrderID")
 	}
 }
+
+// accountOrderExchange records the named account requests are made with
+type accountOrderExchange struct {
+	exchange.IBotExchange
+	requested []string
+}
+
+func (f *accountOrderExchange) GetActiveOrders(ctx context.Context, _ *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	account := accounts.AccountFromContext(ctx)
+	f.requested = append(f.requested, account)
+	return []order.Detail{{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Amount:    1,
+		Side:      order.Buy,
+		Status:    order.Active,
+		OrderID:   "active-" + account,
+	}}, nil
+}
+
+func (f *accountOrderExchange) GetOrderInfo(ctx context.Context, orderID string, pair currency.Pair, assetType asset.Item) (*order.Detail, error) {
+	f.requested = append(f.requested, accounts.AccountFromContext(ctx))
+	return &order.Detail{
+		Exchange:  testExchange,
+		OrderID:   orderID,
+		Pair:      pair,
+		AssetType: assetType,
+		Status:    order.Cancelled,
+	}, nil
+}
+
+func TestOrderManagerAccounts(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	fake := &accountOrderExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(fake))
+	m := &OrderManager{
+		started: 1,
+		orderStore: store{
+			Orders:          make(map[string][]*order.Detail),
+			exchangeManager: em,
+			commsManager:    &CommunicationManager{},
+		},
+	}
+
+	submitted := &order.Submit{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    1,
+	}
+	resp, err := submitted.DeriveSubmitResponse("submitted")
+	require.NoError(t, err)
+	resp.Status = order.New
+	result, err := m.processSubmittedOrder(resp, "arbitrage")
+	require.NoError(t, err)
+	assert.Equal(t, "arbitrage", result.Account, "processSubmittedOrder should record the account")
+
+	var wg sync.WaitGroup
+	m.processActiveOrders(fake, asset.Spot, currency.Pairs{btcusdPair}, "hedging", &wg)
+	wg.Wait()
+	orders, err := m.GetOrdersFiltered(&order.Filter{Exchange: testExchange, Account: "hedging"})
+	require.NoError(t, err)
+	require.Len(t, orders, 1, "active orders must be stored against their account")
+	assert.Equal(t, "active-hedging", orders[0].OrderID)
+
+	ord, err := m.orderStore.getByExchangeAndID(testExchange, "submitted")
+	require.NoError(t, err)
+	ord.LastUpdated = time.Time{}
+	require.NoError(t, m.FetchAndUpdateExchangeOrder(fake, ord, asset.Spot))
+	ord, err = m.orderStore.getByExchangeAndID(testExchange, "submitted")
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled, ord.Status)
+	assert.Equal(t, "arbitrage", ord.Account, "FetchAndUpdateExchangeOrder should retain the account")
+	assert.Contains(t, fake.requested, "arbitrage", "FetchAndUpdateExchangeOrder should request with the order account")
+	assert.Contains(t, fake.requested, "hedging", "processActiveOrders should request with the account")
+}
@@ -3,6 +3,7 @@ package engine
 import (
 	"errors"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -32,7 +33,6 @@ var (
 type orderManagerConfig struct {
 	EnforceLimitConfig     bool
 	AllowMarketOrders      bool
-	CancelOrdersOnShutdown bool
 	LimitAmount            float64
 	AllowedPairs           currency.Pairs
 	AllowedExchanges       []string
@@ -46,10 +46,12 @@ type OrderManager struct {
 	shutdown                      chan struct{}
 	orderStore                    store
 	cfg                           orderManagerConfig
-	verbose                       bool
-	activelyTrackFuturesPositions bool
-	futuresPositionSeekDuration   time.Duration
-	respectOrderHistoryLimits     bool
+	verbose                       atomic.Bool
+	activelyTrackFuturesPositions atomic.Bool
+	futuresPositionSeekDuration   atomic.Int64
+	respectOrderHistoryLimits     atomic.Bool
+	cancelOrdersOnShutdown        atomic.Bool
+	tca                           *transactionCostAnalyser
 }
 
 // store holds all orders by exchange
@@ -2,13 +2,16 @@ package engine
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -31,6 +34,17 @@ type portfolioManager struct {
 	shutdown              chan struct{}
 	base                  *portfolio.Base
 	m                     sync.Mutex
+	holdingsMu            sync.RWMutex
+	accountHoldings       []AccountHoldings
+}
+
+// AccountHoldings holds the currency balances of an exchange API account
+type AccountHoldings struct {
+	Exchange string
+	// Account is the configured account name, empty for the default
+	// credentials
+	Account  string
+	Balances accounts.CurrencyBalances
 }
 
 // setupPortfolioManager creates a new portfolio manager
@@ -149,6 +163,7 @@ func (m *portfolioManager) updateExchangeBalances() error {
 	if errs != nil {
 		return fmt.Errorf("portfolio manager cannot get exchanges: %w", errs)
 	}
+	var holdings []AccountHoldings
 	for _, e := range exchanges {
 		if !e.IsEnabled() {
 			continue
@@ -164,18 +179,48 @@ func (m *portfolioManager) updateExchangeBalances() error {
 			assetTypes = e.GetAssetTypes(true)
 		}
 
-		for _, a := range assetTypes {
-			if _, err := e.UpdateAccountBalances(context.TODO(), a); err != nil {
-				errs = common.AppendError(errs, fmt.Errorf("error updating %s %s account balances: %w", e.GetName(), a, err))
+		// Balances are updated for the default credentials followed by each
+		// named account
+		for _, account := range append([]string{""}, e.GetAccountNames()...) {
+			ctx := accounts.DeployAccountToContext(context.TODO(), account)
+			for _, a := range assetTypes {
+				if _, err := e.UpdateAccountBalances(ctx, a); err != nil {
+					errs = common.AppendError(errs, fmt.Errorf("error updating %s %s %s balances: %w", e.GetName(), accountDescription(account), a, err))
+				}
+			}
+			h, err := getAccountHoldings(ctx, e, account)
+			if err != nil {
+				if !errors.Is(err, accounts.ErrNoBalances) {
+					errs = common.AppendError(errs, fmt.Errorf("error getting %s %s holdings: %w", e.GetName(), accountDescription(account), err))
+				}
+				continue
 			}
+			holdings = append(holdings, h)
 		}
 		if err := m.updateExchangeAddressBalances(e); err != nil {
 			errs = common.AppendError(errs, fmt.Errorf("error updating %s account balances: %w", e.GetName(), err))
 		}
 	}
+	m.holdingsMu.Lock()
+	m.accountHoldings = holdings
+	m.holdingsMu.Unlock()
 	return errs
 }
 
+// getAccountHoldings returns the cached balances across all assets for the
+// credentials in use by the context
+func getAccountHoldings(ctx context.Context, e exchange.IBotExchange, account string) (AccountHoldings, error) {
+	creds, err := e.GetCredentials(ctx)
+	if err != nil {
+		return AccountHoldings{}, err
+	}
+	balances, err := e.GetBase().Accounts.CurrencyBalances(creds, asset.All)
+	if err != nil {
+		return AccountHoldings{}, err
+	}
+	return AccountHoldings{Exchange: e.GetName(), Account: account, Balances: balances}, nil
+}
+
 // updateExchangeAddressBalances fetches and collates all account balances with their deposit addresses
 func (m *portfolioManager) updateExchangeAddressBalances(e exchange.IBotExchange) error {
 	if err := common.NilGuard(m, e); err != nil {
@@ -251,6 +296,17 @@ func (m *portfolioManager) GetPortfolioSummary() portfolio.Summary {
 	return m.base.GetPortfolioSummary()
 }
 
+// GetAccountHoldings returns the balances of each exchange API account from
+// the last portfolio update
+func (m *portfolioManager) GetAccountHoldings() []AccountHoldings {
+	if m == nil || !m.IsRunning() {
+		return nil
+	}
+	m.holdingsMu.RLock()
+	defer m.holdingsMu.RUnlock()
+	return slices.Clone(m.accountHoldings)
+}
+
 // GetAddresses returns all addresses
 func (m *portfolioManager) GetAddresses() []portfolio.Address {
 	if m == nil || !m.IsRunning() {
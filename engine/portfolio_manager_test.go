@@ -123,6 +123,34 @@ func TestUpdateExchangeBalances(t *testing.T) {
 	assert.ErrorIs(t, m.updateExchangeBalances(), e.err, "error should contain the UpdateAccountBalances error message")
 }
 
+func TestGetAccountHoldings(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, (*portfolioManager)(nil).GetAccountHoldings(), "GetAccountHoldings should return nil on a nil manager")
+
+	m, err := setupPortfolioManager(NewExchangeManager(), 0, nil)
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	e := &mockExchange{enabled: true, authSupported: true, accountNames: []string{"arbitrage", "empty"}}
+	e.accounts = accounts.MustNewAccounts(e)
+	m.exchangeManager.exchanges = map[string]exchange.IBotExchange{"mock": e}
+
+	for account, total := range map[string]float64{"": 1, "arbitrage": 2} {
+		subAcct := accounts.NewSubAccount(asset.Spot, "")
+		subAcct.Balances.Set(currency.BTC, accounts.Balance{Total: total})
+		require.NoError(t, e.accounts.Save(accounts.DeployAccountToContext(t.Context(), account), accounts.SubAccounts{subAcct}, true), "accounts.Save must not error")
+	}
+	require.NoError(t, m.updateExchangeBalances())
+	assert.Nil(t, m.GetAccountHoldings(), "GetAccountHoldings should return nil when not running")
+
+	m.started = 1
+	h := m.GetAccountHoldings()
+	require.Len(t, h, 2, "accounts without balances must be skipped")
+	assert.Empty(t, h[0].Account, "default account holdings should be listed first")
+	assert.Equal(t, 1.0, h[0].Balances[currency.BTC].Total)
+	assert.Equal(t, "arbitrage", h[1].Account)
+	assert.Equal(t, 2.0, h[1].Balances[currency.BTC].Total)
+	assert.Equal(t, "mocky", h[1].Exchange)
+}
+
 func TestUpdateExchangeAddressBalances(t *testing.T) {
 	t.Parallel()
 
@@ -163,6 +191,7 @@ type mockExchange struct {
 	authSupported bool
 	err           error
 	accounts      *accounts.Accounts
+	accountNames  []string
 }
 
 func (m *mockExchange) GetName() string {
@@ -193,6 +222,10 @@ func (m *mockExchange) GetBase() *exchange.Base {
 	return &exchange.Base{Name: "mocky", Accounts: m.accounts}
 }
 
-func (m *mockExchange) GetCredentials(context.Context) (*accounts.Credentials, error) {
-	return &accounts.Credentials{Key: m.GetName()}, nil
+func (m *mockExchange) GetCredentials(ctx context.Context) (*accounts.Credentials, error) {
+	return &accounts.Credentials{Key: m.GetName() + accounts.AccountFromContext(ctx)}, nil
+}
+
+func (m *mockExchange) GetAccountNames() []string {
+	return m.accountNames
 }
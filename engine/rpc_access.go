@@ -0,0 +1,522 @@
+package engine
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// String returns the config name of the role
+func (r RPCRole) String() string {
+	switch r {
+	case RPCRoleReadOnly:
+		return config.RemoteControlRoleReadOnly
+	case RPCRoleTrader:
+		return config.RemoteControlRoleTrader
+	case RPCRoleWithdrawer:
+		return config.RemoteControlRoleWithdrawer
+	case RPCRoleAdmin:
+		return config.RemoteControlRoleAdmin
+	default:
+		return "unknown"
+	}
+}
+
+// parseRPCRole returns the role for a config role name
+func parseRPCRole(s string) (RPCRole, error) {
+	for r := RPCRoleReadOnly; r <= RPCRoleAdmin; r++ {
+		if strings.EqualFold(s, r.String()) {
+			return r, nil
+		}
+	}
+	return 0, fmt.Errorf("%w %q", errInvalidRPCRole, s)
+}
+
+// requiredRPCRole returns the minimum role required to call a gRPC method
+func requiredRPCRole(method string) RPCRole {
+	switch {
+	case slices.Contains(rpcReadOnlyMethods, method):
+		return RPCRoleReadOnly
+	case slices.Contains(rpcTraderMethods, method):
+		return RPCRoleTrader
+	case slices.Contains(rpcWithdrawerMethods, method):
+		return RPCRoleWithdrawer
+	default:
+		return RPCRoleAdmin
+	}
+}
+
+// newRPCPrincipal returns a principal with the supplied access
+func newRPCPrincipal(name string, role RPCRole, exchanges, pairs []string) (*rpcPrincipal, error) {
+	p := &rpcPrincipal{name: name, role: role, exchanges: exchanges}
+	for _, s := range pairs {
+		pair, err := currency.NewPairFromString(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		p.pairs = append(p.pairs, pair)
+	}
+	return p, nil
+}
+
+// auditID returns the identifier recorded against audit events
+func (p *rpcPrincipal) auditID() string {
+	if p.tokenID != "" {
+		return "token:" + p.tokenID
+	}
+	return p.name
+}
+
+// authorizeMethod checks the principal's role grants access to the method
+func (p *rpcPrincipal) authorizeMethod(method string) error {
+	if p.role < requiredRPCRole(method) {
+		return fmt.Errorf("%s %w for %s role", method, errRPCPermissionDenied, p.role)
+	}
+	return nil
+}
+
+// authorizeRequest checks the request's exchange and currency pair fields
+// against the principal's restrictions. Methods which change state must
+// specify an exchange and pair when the principal is restricted.
+func (p *rpcPrincipal) authorizeRequest(method string, req any) error {
+	if len(p.exchanges) == 0 && len(p.pairs) == 0 {
+		return nil
+	}
+	m, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	r := m.ProtoReflect()
+	privileged := requiredRPCRole(method) > RPCRoleReadOnly
+	if len(p.exchanges) > 0 {
+		hasField, values := rpcStringFieldValues(r, rpcExchangeFields)
+		for _, v := range values {
+			if !slices.ContainsFunc(p.exchanges, func(e string) bool { return strings.EqualFold(e, v) }) {
+				return fmt.Errorf("%s %w: %s", method, errRPCExchangeNotPermitted, v)
+			}
+		}
+		if len(values) == 0 && (hasField || privileged) {
+			return fmt.Errorf("%s %w", method, errRPCExchangeRequired)
+		}
+	}
+	if len(p.pairs) > 0 {
+		pairs, err := rpcPairFieldValues(r)
+		if err != nil {
+			return fmt.Errorf("%s %w", method, err)
+		}
+		for _, pair := range pairs {
+			if !p.pairs.Contains(pair, false) {
+				return fmt.Errorf("%s %w: %s", method, errRPCPairNotPermitted, pair)
+			}
+		}
+		if len(pairs) == 0 && privileged {
+			return fmt.Errorf("%s %w", method, errRPCPairRequired)
+		}
+	}
+	return nil
+}
+
+// rpcStringFieldValues returns whether the message has any of the named string
+// fields and their non-empty values
+func rpcStringFieldValues(r protoreflect.Message, names []string) (hasField bool, values []string) {
+	fields := r.Descriptor().Fields()
+	for _, name := range names {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil || fd.Kind() != protoreflect.StringKind {
+			continue
+		}
+		hasField = true
+		if fd.IsList() {
+			l := r.Get(fd).List()
+			for i := range l.Len() {
+				if v := l.Get(i).String(); v != "" {
+					values = append(values, v)
+				}
+			}
+		} else if v := r.Get(fd).String(); v != "" {
+			values = append(values, v)
+		}
+	}
+	return hasField, values
+}
+
+// rpcPairFieldValues returns the currency pairs specified by the message
+func rpcPairFieldValues(r protoreflect.Message) ([]currency.Pair, error) {
+	var pairs []currency.Pair
+	fields := r.Descriptor().Fields()
+	for _, name := range rpcPairFields {
+		fd := fields.ByName(protoreflect.Name(name))
+		if fd == nil {
+			continue
+		}
+		var values []protoreflect.Value
+		if fd.IsList() {
+			l := r.Get(fd).List()
+			for i := range l.Len() {
+				values = append(values, l.Get(i))
+			}
+		} else if r.Has(fd) {
+			values = append(values, r.Get(fd))
+		}
+		switch fd.Kind() {
+		case protoreflect.StringKind:
+			for _, v := range values {
+				if v.String() == "" {
+					continue
+				}
+				pair, err := currency.NewPairFromString(v.String())
+				if err != nil {
+					return nil, err
+				}
+				pairs = append(pairs, pair)
+			}
+		case protoreflect.MessageKind:
+			pd := fd.Message().Fields()
+			base, quote, delimiter := pd.ByName("base"), pd.ByName("quote"), pd.ByName("delimiter")
+			if base == nil || quote == nil || delimiter == nil {
+				continue
+			}
+			for _, v := range values {
+				pm := v.Message()
+				if pm.Get(base).String() == "" && pm.Get(quote).String() == "" {
+					continue
+				}
+				pair, err := currency.NewPairFromStrings(pm.Get(base).String(), pm.Get(quote).String())
+				if err != nil {
+					return nil, err
+				}
+				pair.Delimiter = pm.Get(delimiter).String()
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+	return pairs, nil
+}
+
+// authenticate returns the principal for a Basic or Bearer authorization
+// header value
+func (s *RPCServer) authenticate(authorization string) (*rpcPrincipal, error) {
+	scheme, value, ok := strings.Cut(authorization, " ")
+	if !ok {
+		return nil, errors.New("invalid authorization header")
+	}
+	switch {
+	case strings.EqualFold(scheme, "Basic"):
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, errors.New("unable to base64 decode authorization header")
+		}
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return nil, errInvalidCredentials
+		}
+		return s.authenticateUser(username, password)
+	case strings.EqualFold(scheme, "Bearer"):
+		if s.apiTokens == nil {
+			return nil, errAPITokenStoreNotSetup
+		}
+		t, err := s.apiTokens.authenticate(value)
+		if err != nil {
+			return nil, err
+		}
+		role, err := parseRPCRole(t.Role)
+		if err != nil {
+			return nil, err
+		}
+		p, err := newRPCPrincipal(t.Name, role, t.Exchanges, t.Pairs)
+		if err != nil {
+			return nil, err
+		}
+		p.tokenID = t.ID.String()
+		return p, nil
+	default:
+		return nil, errors.New("basic or bearer not found in authorization header")
+	}
+}
+
+// authenticateUser returns the principal for a configured username and
+// password. The remote control username is granted the admin role.
+func (s *RPCServer) authenticateUser(username, password string) (*rpcPrincipal, error) {
+	rc := &s.Config.RemoteControl
+	if username == rc.Username {
+		if subtle.ConstantTimeCompare([]byte(password), []byte(rc.Password)) != 1 {
+			return nil, errInvalidCredentials
+		}
+		return &rpcPrincipal{name: username, role: RPCRoleAdmin}, nil
+	}
+	for i := range rc.Users {
+		u := &rc.Users[i]
+		if u.Username != username {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(password), []byte(u.Password)) != 1 {
+			return nil, errInvalidCredentials
+		}
+		role, err := parseRPCRole(u.Role)
+		if err != nil {
+			return nil, err
+		}
+		return newRPCPrincipal(u.Username, role, u.Exchanges, u.Pairs)
+	}
+	return nil, errInvalidCredentials
+}
+
+func principalFromContext(ctx context.Context) (*rpcPrincipal, error) {
+	p, ok := ctx.Value(rpcPrincipalKey{}).(*rpcPrincipal)
+	if !ok {
+		return nil, errRPCPrincipalNotFound
+	}
+	return p, nil
+}
+
+// authorizeUnary checks the authenticated principal is permitted to make the
+// call and audits privileged calls
+func (s *RPCServer) authorizeUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	method := path.Base(info.FullMethod)
+	p, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.authorizeMethod(method); err != nil {
+		auditRPC(p, method, req, err)
+		return nil, err
+	}
+	if err := p.authorizeRequest(method, req); err != nil {
+		auditRPC(p, method, req, err)
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	if requiredRPCRole(method) > RPCRoleReadOnly {
+		auditRPC(p, method, req, err)
+	}
+	return resp, err
+}
+
+// authorizeStream checks the authenticated principal is permitted to open the
+// stream and checks each received request against its restrictions
+func (s *RPCServer) authorizeStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	method := path.Base(info.FullMethod)
+	p, err := principalFromContext(ss.Context())
+	if err != nil {
+		return err
+	}
+	if err := p.authorizeMethod(method); err != nil {
+		auditRPC(p, method, nil, err)
+		return err
+	}
+	return handler(srv, &authorizedStream{ServerStream: ss, principal: p, method: method})
+}
+
+// authorizedStream checks received stream requests against the principal's
+// restrictions
+type authorizedStream struct {
+	grpc.ServerStream
+	principal *rpcPrincipal
+	method    string
+}
+
+// RecvMsg receives a request and checks it against the principal's
+// restrictions
+func (a *authorizedStream) RecvMsg(m any) error {
+	if err := a.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if err := a.principal.authorizeRequest(a.method, m); err != nil {
+		auditRPC(a.principal, a.method, m, err)
+		return err
+	}
+	return nil
+}
+
+// auditRPC records a gRPC call with its redacted request as an audit event
+func auditRPC(p *rpcPrincipal, method string, req any, err error) {
+	outcome := "succeeded"
+	if err != nil {
+		outcome = "failed: " + err.Error()
+	}
+	msg := fmt.Sprintf("%s by %s %s request: %s", method, p.name, outcome, redactRPCRequest(req))
+	if err != nil && (errors.Is(err, errRPCPermissionDenied) || errors.Is(err, errRPCExchangeNotPermitted) || errors.Is(err, errRPCPairNotPermitted)) {
+		log.Warnln(log.GRPCSys, msg)
+	}
+	audit.Event(p.auditID(), rpcAuditEventType, msg)
+}
+
+// redactRPCRequest returns the request as JSON with sensitive fields removed
+func redactRPCRequest(req any) string {
+	m, ok := req.(proto.Message)
+	if !ok || m == nil {
+		return "{}"
+	}
+	c := proto.Clone(m).ProtoReflect()
+	fields := c.Descriptor().Fields()
+	for _, name := range rpcRedactedFields {
+		if fd := fields.ByName(protoreflect.Name(name)); fd != nil {
+			c.Clear(fd)
+		}
+	}
+	b, err := protojson.Marshal(c.Interface())
+	if err != nil {
+		return "{}"
+	}
+	return string(b)
+}
+
+// newAPITokenStore loads API tokens from path, discarding expired tokens
+func newAPITokenStore(path string) (*apiTokenStore, error) {
+	a := &apiTokenStore{path: path, tokens: make(map[uuid.UUID]*APIToken)}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return a, nil
+		}
+		return nil, err
+	}
+	var tokens []*APIToken
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, t := range tokens {
+		if now.Before(t.ExpiresAt) {
+			a.tokens[t.ID] = t
+		}
+	}
+	return a, nil
+}
+
+// create issues a new API token, returning its secret which is not stored
+func (a *apiTokenStore) create(name, createdBy string, role RPCRole, exchanges, pairs []string, expiry time.Duration) (string, *APIToken, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", nil, errAPITokenNameEmpty
+	}
+	if expiry <= 0 {
+		return "", nil, errAPITokenExpiryInvalid
+	}
+	if role < RPCRoleReadOnly || role > RPCRoleAdmin {
+		return "", nil, errInvalidRPCRole
+	}
+	if _, err := newRPCPrincipal(name, role, exchanges, pairs); err != nil {
+		return "", nil, err
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, err
+	}
+	secret := apiTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", nil, err
+	}
+	now := time.Now().UTC()
+	t := &APIToken{
+		ID:        id,
+		Name:      strings.TrimSpace(name),
+		Hash:      hashAPIToken(secret),
+		Role:      role.String(),
+		Exchanges: exchanges,
+		Pairs:     pairs,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		ExpiresAt: now.Add(expiry),
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.tokens[id] = t
+	if err := a.save(); err != nil {
+		delete(a.tokens, id)
+		return "", nil, err
+	}
+	cpy := *t
+	return secret, &cpy, nil
+}
+
+// authenticate returns the unexpired token matching secret
+func (a *apiTokenStore) authenticate(secret string) (*APIToken, error) {
+	h := []byte(hashAPIToken(secret))
+	a.m.Lock()
+	defer a.m.Unlock()
+	for _, t := range a.tokens {
+		if subtle.ConstantTimeCompare(h, []byte(t.Hash)) != 1 {
+			continue
+		}
+		if !time.Now().Before(t.ExpiresAt) {
+			return nil, errAPITokenExpired
+		}
+		cpy := *t
+		return &cpy, nil
+	}
+	return nil, errAPITokenNotFound
+}
+
+// list returns all unexpired tokens ordered by creation time
+func (a *apiTokenStore) list() []APIToken {
+	a.m.Lock()
+	defer a.m.Unlock()
+	now := time.Now()
+	tokens := make([]APIToken, 0, len(a.tokens))
+	for _, t := range a.tokens {
+		if now.Before(t.ExpiresAt) {
+			tokens = append(tokens, *t)
+		}
+	}
+	slices.SortFunc(tokens, func(a, b APIToken) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return tokens
+}
+
+// revoke removes a token so it can no longer be used
+func (a *apiTokenStore) revoke(id uuid.UUID) error {
+	a.m.Lock()
+	defer a.m.Unlock()
+	t, ok := a.tokens[id]
+	if !ok {
+		return fmt.Errorf("%w: %s", errAPITokenNotFound, id)
+	}
+	delete(a.tokens, id)
+	if err := a.save(); err != nil {
+		a.tokens[id] = t
+		return err
+	}
+	return nil
+}
+
+// save persists the tokens, must be called with the lock held
+func (a *apiTokenStore) save() error {
+	tokens := make([]*APIToken, 0, len(a.tokens))
+	for _, t := range a.tokens {
+		tokens = append(tokens, t)
+	}
+	slices.SortFunc(tokens, func(a, b *APIToken) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	data, err := json.MarshalIndent(tokens, "", " ")
+	if err != nil {
+		return err
+	}
+	return file.Write(a.path, data)
+}
+
+func hashAPIToken(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
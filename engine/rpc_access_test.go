@@ -0,0 +1,166 @@
+package engine
+
+import (
+	"context"
+	"encoding/base64"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestParseRPCRole(t *testing.T) {
+	t.Parallel()
+	for r := RPCRoleReadOnly; r <= RPCRoleAdmin; r++ {
+		role, err := parseRPCRole(r.String())
+		require.NoError(t, err)
+		assert.Equal(t, r, role)
+	}
+	_, err := parseRPCRole("superuser")
+	assert.ErrorIs(t, err, errInvalidRPCRole)
+}
+
+func TestRequiredRPCRole(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, RPCRoleReadOnly, requiredRPCRole("GetTicker"))
+	assert.Equal(t, RPCRoleTrader, requiredRPCRole("SubmitOrder"))
+	assert.Equal(t, RPCRoleWithdrawer, requiredRPCRole("WithdrawCryptocurrencyFunds"))
+	assert.Equal(t, RPCRoleAdmin, requiredRPCRole("Shutdown"), "unlisted methods should require admin")
+}
+
+func TestAuthorizeRequest(t *testing.T) {
+	t.Parallel()
+	p, err := newRPCPrincipal("bob", RPCRoleTrader, []string{"Bitstamp"}, []string{"BTC-USD"})
+	require.NoError(t, err)
+
+	assert.NoError(t, p.authorizeMethod("SubmitOrder"))
+	assert.ErrorIs(t, p.authorizeMethod("WithdrawFiatFunds"), errRPCPermissionDenied)
+
+	btcusd := &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD", Delimiter: "-"}
+	assert.NoError(t, p.authorizeRequest("SubmitOrder", &gctrpc.SubmitOrderRequest{Exchange: "bitstamp", Pair: btcusd}))
+	assert.ErrorIs(t, p.authorizeRequest("SubmitOrder", &gctrpc.SubmitOrderRequest{Exchange: "Binance", Pair: btcusd}), errRPCExchangeNotPermitted)
+	assert.ErrorIs(t, p.authorizeRequest("SubmitOrder", &gctrpc.SubmitOrderRequest{Pair: btcusd}), errRPCExchangeRequired)
+	assert.ErrorIs(t, p.authorizeRequest("SubmitOrder", &gctrpc.SubmitOrderRequest{Exchange: "Bitstamp", Pair: &gctrpc.CurrencyPair{Base: "ETH", Quote: "USD"}}), errRPCPairNotPermitted)
+	assert.ErrorIs(t, p.authorizeRequest("SubmitOrder", &gctrpc.SubmitOrderRequest{Exchange: "Bitstamp"}), errRPCPairRequired)
+	assert.NoError(t, p.authorizeRequest("GetOrders", &gctrpc.GetOrdersRequest{Exchange: "Bitstamp"}), "read only methods should not require a pair")
+	assert.ErrorIs(t, p.authorizeRequest("CancelAllOrders", &gctrpc.CancelAllOrdersRequest{Exchange: "Bitstamp"}), errRPCPairRequired)
+
+	unrestricted := &rpcPrincipal{name: "admin", role: RPCRoleAdmin}
+	assert.NoError(t, unrestricted.authorizeRequest("SubmitOrder", &gctrpc.SubmitOrderRequest{Exchange: "Binance"}))
+}
+
+func TestRedactRPCRequest(t *testing.T) {
+	t.Parallel()
+	out := redactRPCRequest(&gctrpc.UnlockCredentialsRequest{Exchange: "Bitstamp", Passphrase: "hunter2"})
+	assert.Contains(t, out, "Bitstamp")
+	assert.NotContains(t, out, "hunter2", "passphrase should be redacted")
+	assert.Equal(t, "{}", redactRPCRequest(nil))
+}
+
+func TestAPITokenStore(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), apiTokenStoreFile)
+	a, err := newAPITokenStore(path)
+	require.NoError(t, err)
+
+	_, _, err = a.create("", "admin", RPCRoleReadOnly, nil, nil, time.Hour)
+	assert.ErrorIs(t, err, errAPITokenNameEmpty)
+	_, _, err = a.create("bot", "admin", RPCRoleReadOnly, nil, nil, 0)
+	assert.ErrorIs(t, err, errAPITokenExpiryInvalid)
+
+	secret, tok, err := a.create("bot", "admin", RPCRoleTrader, []string{"Bitstamp"}, []string{"BTC-USD"}, time.Hour)
+	require.NoError(t, err)
+	assert.Contains(t, secret, apiTokenPrefix)
+	assert.NotContains(t, tok.Hash, secret, "secret should not be stored")
+
+	got, err := a.authenticate(secret)
+	require.NoError(t, err)
+	assert.Equal(t, tok.ID, got.ID)
+	_, err = a.authenticate(apiTokenPrefix + "nope")
+	assert.ErrorIs(t, err, errAPITokenNotFound)
+
+	reloaded, err := newAPITokenStore(path)
+	require.NoError(t, err)
+	require.Len(t, reloaded.list(), 1, "tokens must be persisted")
+	_, err = reloaded.authenticate(secret)
+	assert.NoError(t, err, "persisted token should authenticate")
+
+	require.NoError(t, a.revoke(tok.ID))
+	assert.ErrorIs(t, a.revoke(tok.ID), errAPITokenNotFound)
+	_, err = a.authenticate(secret)
+	assert.ErrorIs(t, err, errAPITokenNotFound)
+
+	secret, tok, err = a.create("short", "admin", RPCRoleReadOnly, nil, nil, time.Hour)
+	require.NoError(t, err)
+	tok.ExpiresAt = time.Now().Add(-time.Minute)
+	a.tokens[tok.ID].ExpiresAt = tok.ExpiresAt
+	_, err = a.authenticate(secret)
+	assert.ErrorIs(t, err, errAPITokenExpired)
+	assert.Empty(t, a.list(), "expired tokens should not be listed")
+}
+
+func TestRPCAuthentication(t *testing.T) {
+	t.Parallel()
+	tokens, err := newAPITokenStore(filepath.Join(t.TempDir(), apiTokenStoreFile))
+	require.NoError(t, err)
+	s := &RPCServer{Engine: &Engine{
+		Config: &config.Config{
+			RemoteControl: config.RemoteControlConfig{
+				Username: "admin",
+				Password: "pass",
+				Users: []config.RemoteControlUser{
+					{Username: "viewer", Password: "view", Role: config.RemoteControlRoleReadOnly},
+				},
+			},
+		},
+		apiTokens: tokens,
+	}}
+	basic := func(u, p string) string { return "Basic " + base64.StdEncoding.EncodeToString([]byte(u+":"+p)) }
+
+	p, err := s.authenticate(basic("admin", "pass"))
+	require.NoError(t, err)
+	assert.Equal(t, RPCRoleAdmin, p.role, "remote control user should be an admin")
+
+	p, err = s.authenticate(basic("viewer", "view"))
+	require.NoError(t, err)
+	assert.Equal(t, RPCRoleReadOnly, p.role)
+
+	_, err = s.authenticate(basic("viewer", "pass"))
+	assert.ErrorIs(t, err, errInvalidCredentials)
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("authorization", basic("viewer", "view")))
+	ctx, err = s.authenticateClient(ctx)
+	require.NoError(t, err)
+	handler := func(context.Context, any) (any, error) { return &gctrpc.GenericResponse{}, nil }
+	_, err = s.authorizeUnary(ctx, &gctrpc.GetInfoRequest{}, &grpc.UnaryServerInfo{FullMethod: "/gctrpc.GoCryptoTraderService/GetInfo"}, handler)
+	assert.NoError(t, err, "read only users should be able to call GetInfo")
+	_, err = s.authorizeUnary(ctx, &gctrpc.SubmitOrderRequest{}, &grpc.UnaryServerInfo{FullMethod: "/gctrpc.GoCryptoTraderService/SubmitOrder"}, handler)
+	assert.ErrorIs(t, err, errRPCPermissionDenied)
+
+	adminCtx := context.WithValue(t.Context(), rpcPrincipalKey{}, &rpcPrincipal{name: "admin", role: RPCRoleAdmin})
+	resp, err := s.CreateAPIToken(adminCtx, &gctrpc.CreateAPITokenRequest{Name: "bot", Role: "trader", Expiry: "1h"})
+	require.NoError(t, err)
+	assert.Equal(t, "admin", resp.Token.CreatedBy)
+	_, err = s.CreateAPIToken(adminCtx, &gctrpc.CreateAPITokenRequest{Name: "bot", Role: "trader", Expiry: "soon"})
+	assert.ErrorIs(t, err, errAPITokenExpiryInvalid)
+
+	p, err = s.authenticate("Bearer " + resp.Secret)
+	require.NoError(t, err)
+	assert.Equal(t, RPCRoleTrader, p.role)
+	assert.Equal(t, resp.Token.Id, p.tokenID)
+
+	list, err := s.GetAPITokens(adminCtx, &gctrpc.GetAPITokensRequest{})
+	require.NoError(t, err)
+	assert.Len(t, list.Tokens, 1)
+
+	_, err = s.RevokeAPIToken(adminCtx, &gctrpc.RevokeAPITokenRequest{Id: resp.Token.Id})
+	require.NoError(t, err)
+	_, err = s.authenticate("Bearer " + resp.Secret)
+	assert.ErrorIs(t, err, errAPITokenNotFound)
+}
@@ -0,0 +1,132 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// RPCRole defines the level of gRPC access granted to a user or token. Each
+// role is granted the access of the roles before it.
+type RPCRole uint8
+
+// gRPC roles
+const (
+	RPCRoleReadOnly RPCRole = iota + 1
+	RPCRoleTrader
+	RPCRoleWithdrawer
+	RPCRoleAdmin
+)
+
+const (
+	// apiTokenStoreFile is the file name API tokens are persisted to within
+	// the data directory
+	apiTokenStoreFile = "apitokens.json"
+	// apiTokenPrefix identifies API token secrets
+	apiTokenPrefix = "gct_"
+	// rpcAuditEventType is the audit event type for privileged gRPC calls
+	rpcAuditEventType = "gRPC"
+)
+
+var (
+	errRPCPermissionDenied     = errors.New("permission denied")
+	errRPCExchangeNotPermitted = errors.New("exchange not permitted")
+	errRPCPairNotPermitted     = errors.New("currency pair not permitted")
+	errRPCExchangeRequired     = errors.New("an exchange must be specified")
+	errRPCPairRequired         = errors.New("a currency pair must be specified")
+	errRPCPrincipalNotFound    = errors.New("no authenticated user found")
+	errInvalidRPCRole          = errors.New("invalid role")
+	errAPITokenNotFound        = errors.New("API token not found")
+	errAPITokenExpired         = errors.New("API token expired")
+	errAPITokenStoreNotSetup   = errors.New("API token store is not setup")
+	errAPITokenNameEmpty       = errors.New("API token name cannot be empty")
+	errAPITokenExpiryInvalid   = errors.New("API token expiry must be greater than zero")
+	errInvalidCredentials      = errors.New("username/password mismatch")
+)
+
+// rpcReadOnlyMethods are gRPC methods which do not change state, available to
+// all roles. Methods not listed in any role require the admin role.
+var rpcReadOnlyMethods = []string{
+	"GetInfo", "GetSubsystems", "GetRPCEndpoints", "GetCommunicationRelayers",
+	"GetExchanges", "GetExchangeInfo", "GetTicker", "GetTickers", "GetOrderbook",
+	"GetOrderbooks", "GetAccountBalances", "GetAccountBalancesStream",
+	"GetPortfolio", "GetPortfolioSummary", "GetForexProviders", "GetForexRates",
+	"GetOrders", "GetOrder", "SimulateOrder", "WhaleBomb", "GetEvents",
+	"GetCryptocurrencyDepositAddresses", "GetCryptocurrencyDepositAddress",
+	"GetAvailableTransferChains", "WithdrawalEventByID",
+	"WithdrawalEventsByExchange", "WithdrawalEventsByDate", "GetLoggerDetails",
+	"GetExchangePairs", "GetOrderbookStream", "GetExchangeOrderbookStream",
+	"GetTickerStream", "GetExchangeTickerStream", "GCTScriptStatus",
+	"GCTScriptListAll", "GetHistoricCandles", "GetExchangeAssets",
+	"WebsocketGetInfo", "WebsocketGetSubscriptions", "GetRecentTrades",
+	"GetHistoricTrades", "GetSavedTrades", "FindMissingSavedCandleIntervals",
+	"FindMissingSavedTradeIntervals", "GetDataHistoryJobDetails",
+	"GetActiveDataHistoryJobs", "GetDataHistoryJobsBetween",
+	"GetDataHistoryJobSummary", "GetManagedOrders", "CurrencyStateGetAll",
+	"CurrencyStateTrading", "CurrencyStateDeposit", "CurrencyStateWithdraw",
+	"CurrencyStateTradingPair", "GetFuturesPositionsSummary",
+	"GetFuturesPositionsOrders", "GetCollateral", "GetTechnicalAnalysis",
+	"GetMarginRatesHistory", "GetManagedPosition", "GetAllManagedPositions",
+	"GetFundingRates", "GetLatestFundingRate", "GetOrderbookMovement",
+	"GetOrderbookAmountByNominal", "GetOrderbookAmountByImpact",
+	"GetCollateralMode", "GetLeverage", "GetOpenInterest", "GetCurrencyTradeURL",
+	"GetTransactionCostAnalysis", "GetFuturesRisk", "GetFuturesRiskStream",
+	"GetTransfers", "GetDataQualityReports",
+}
+
+// rpcTraderMethods are gRPC methods which place, amend or cancel orders and
+// manage positions
+var rpcTraderMethods = []string{
+	"UpdateAccountBalances", "SubmitOrder", "CancelOrder", "CancelBatchOrders",
+	"CancelAllOrders", "ModifyOrder", "AddEvent", "RemoveEvent",
+	"SetCollateralMode", "SetMarginType", "SetLeverage", "ChangePositionMargin",
+}
+
+// rpcWithdrawerMethods are gRPC methods which move funds off an exchange
+var rpcWithdrawerMethods = []string{
+	"WithdrawFiatFunds", "WithdrawCryptocurrencyFunds", "CreateTransfer",
+}
+
+// rpcExchangeFields are request fields restricted to a user's exchanges
+var rpcExchangeFields = []string{"exchange", "exchange_name", "exchanges", "source", "destination"}
+
+// rpcPairFields are request fields restricted to a user's currency pairs
+var rpcPairFields = []string{"pair", "currency_pair", "pairs"}
+
+// rpcRedactedFields are request fields removed before a call is audited
+var rpcRedactedFields = []string{"passphrase", "password", "secret", "otp", "pin", "trade_password"}
+
+// rpcPrincipal is an authenticated gRPC user or API token
+type rpcPrincipal struct {
+	name      string
+	role      RPCRole
+	exchanges []string
+	pairs     currency.Pairs
+	tokenID   string
+}
+
+type rpcPrincipalKey struct{}
+
+// APIToken is a scoped, expiring bearer token for the gRPC server. Only a hash
+// of the token secret is stored.
+type APIToken struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Hash      string    `json:"hash"`
+	Role      string    `json:"role"`
+	Exchanges []string  `json:"exchanges,omitempty"`
+	Pairs     []string  `json:"pairs,omitempty"`
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// apiTokenStore holds API tokens, persisting them on every change
+type apiTokenStore struct {
+	path   string
+	m      sync.Mutex
+	tokens map[uuid.UUID]*APIToken
+}
@@ -2,13 +2,14 @@ package engine
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -25,13 +26,18 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/common/timeperiods"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/parquetstore"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	fundingratesql "github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/encoding/jsonpatch"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -46,7 +52,6 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
-	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
@@ -77,6 +82,16 @@ var (
 	errGRPCShutdownSignalIsNil = errors.New("cannot shutdown, gRPC shutdown channel is nil")
 	errInvalidStrategy         = errors.New("invalid strategy")
 	errSpecificPairNotEnabled  = errors.New("specified pair is not enabled")
+	errInvalidMarketDataType   = errors.New("invalid market data type")
+	errInvalidMarketDataDir    = errors.New("market data directory must be relative to the parquet directory and cannot contain '..'")
+)
+
+// Market data types which can be exported to and imported from parquet
+const (
+	marketDataCandles       = "candles"
+	marketDataTrades        = "trades"
+	marketDataFundingRates  = "fundingrates"
+	defaultParquetDirectory = "parquet"
 )
 
 // RPCServer struct
@@ -85,6 +100,17 @@ type RPCServer struct {
 	*Engine
 }
 
+// marketDataTransfer holds the parsed details of a parquet export or import
+type marketDataTransfer struct {
+	exchange  string
+	pair      currency.Pair
+	asset     asset.Item
+	interval  kline.Interval
+	start     time.Time
+	end       time.Time
+	directory string
+}
+
 func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -96,23 +122,11 @@ func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, er
 		return ctx, errors.New("authorization header missing")
 	}
 
-	if !strings.Contains(authStr[0], "Basic") {
-		return ctx, errors.New("basic not found in authorization header")
-	}
-
-	decoded, err := base64.StdEncoding.DecodeString(strings.Split(authStr[0], " ")[1])
+	p, err := s.authenticate(authStr[0])
 	if err != nil {
-		return ctx, errors.New("unable to base64 decode authorization header")
-	}
-
-	cred := strings.Split(string(decoded), ":")
-	username := cred[0]
-	password := cred[1]
-
-	if username != s.Config.RemoteControl.Username ||
-		password != s.Config.RemoteControl.Password {
-		return ctx, errors.New("username/password mismatch")
+		return ctx, err
 	}
+	ctx = context.WithValue(ctx, rpcPrincipalKey{}, p)
 	ctx, err = accounts.ParseCredentialsMetadata(ctx, md)
 	if err != nil {
 		return ctx, err
@@ -144,11 +158,19 @@ func StartRPCServer(engine *Engine) {
 		return
 	}
 
+	if engine.apiTokens == nil {
+		engine.apiTokens, err = newAPITokenStore(filepath.Join(engine.Settings.DataDir, apiTokenStoreFile))
+		if err != nil {
+			log.Errorf(log.GRPCSys, "gRPC server could not load API tokens: %s\n", err)
+			return
+		}
+	}
+
 	s := RPCServer{Engine: engine}
 	opts := []grpc.ServerOption{
 		grpc.Creds(creds),
-		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient)),
-		grpc.StreamInterceptor(grpcauth.StreamServerInterceptor(s.authenticateClient)),
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor, grpcauth.UnaryServerInterceptor(s.authenticateClient), s.authorizeUnary),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor, grpcauth.StreamServerInterceptor(s.authenticateClient), s.authorizeStream),
 	}
 	server := grpc.NewServer(opts...)
 	gctrpc.RegisterGoCryptoTraderServiceServer(server, &s)
@@ -180,13 +202,9 @@ func (s *RPCServer) StartRPCRESTProxy() {
 		return
 	}
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(proxyHeaderMatcher))
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
-			Username: s.Config.RemoteControl.Username,
-			Password: s.Config.RemoteControl.Password,
-		}),
 	}
 	err = gctrpc.RegisterGoCryptoTraderServiceHandlerFromEndpoint(context.Background(),
 		mux, s.Config.RemoteControl.GRPC.ListenAddress, opts)
@@ -214,8 +232,9 @@ func (s *RPCServer) StartRPCRESTProxy() {
 
 func (s *RPCServer) authClient(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		username, password, ok := r.BasicAuth()
-		if !ok || username != s.Config.RemoteControl.Username || password != s.Config.RemoteControl.Password {
+		// The authorization header is forwarded to the gRPC server which
+		// authorises the call for the authenticated user
+		if _, err := s.authenticate(r.Header.Get("Authorization")); err != nil {
 			w.Header().Set("WWW-Authenticate", `Basic realm="restricted"`)
 			http.Error(w, "Access denied", http.StatusUnauthorized)
 			log.Warnf(log.GRPCSys, "gRPC proxy server unauthorised access attempt. IP: %s Path: %s\n", r.RemoteAddr, r.URL.Path)
@@ -571,6 +590,11 @@ func (s *RPCServer) GetAccountBalances(ctx context.Context, r *gctrpc.GetAccount
 		return nil, err
 	}
 
+	ctx, err = withAccount(ctx, e, r.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := e.GetCachedSubAccounts(ctx, assetType)
 	if err != nil {
 		return nil, err
@@ -595,6 +619,11 @@ func (s *RPCServer) UpdateAccountBalances(ctx context.Context, r *gctrpc.GetAcco
 		return nil, err
 	}
 
+	ctx, err = withAccount(ctx, e, r.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := e.UpdateAccountBalances(ctx, assetType)
 	if err != nil {
 		return nil, err
@@ -603,6 +632,64 @@ func (s *RPCServer) UpdateAccountBalances(ctx context.Context, r *gctrpc.GetAcco
 	return accountBalanceResp(r.Exchange, resp), nil
 }
 
+// withAccount deploys a named exchange account to the context so its
+// credentials are used for the request. An empty name uses the default
+// credentials. The name is checked against the accounts loaded from the
+// exchange config, and credentials are only fetched when the request is sent.
+func withAccount(ctx context.Context, exch exchange.IBotExchange, account string) (context.Context, error) {
+	if account == "" {
+		return ctx, nil
+	}
+	if !slices.ContainsFunc(exch.GetAccountNames(), func(n string) bool { return strings.EqualFold(n, account) }) {
+		return nil, fmt.Errorf("%s %q %w", exch.GetName(), account, exchange.ErrAccountNotFound)
+	}
+	return accounts.DeployAccountToContext(ctx, account), nil
+}
+
+// setWithdrawalAuth sets the one time password, PIN and trade password of a
+// withdrawal request from the account credentials. Exchanges using the
+// credential provider have them fetched from the provider, otherwise they are
+// read from the exchange config
+func (s *RPCServer) setWithdrawalAuth(ctx context.Context, exch exchange.IBotExchange, account string, req *withdraw.Request) error {
+	exchCfg, err := s.Config.GetExchangeConfig(exch.GetName())
+	if err != nil {
+		return err
+	}
+	var otpSecret, pin string
+	if exchCfg.API.UseCredentialProvider {
+		creds, err := exch.GetCredentials(ctx)
+		if err != nil {
+			return err
+		}
+		otpSecret, pin, req.TradePassword = creds.OneTimePassword, creds.PIN, creds.TradePassword
+	} else {
+		creds, err := exchCfg.GetAPIAccountCredentials(account)
+		if err != nil {
+			return err
+		}
+		otpSecret, pin, req.TradePassword = creds.OTPSecret, creds.PIN, creds.TradePassword
+	}
+
+	if otpSecret != "" {
+		code, err := totp.GenerateCode(otpSecret, time.Now())
+		if err != nil {
+			return err
+		}
+		req.OneTimePassword, err = strconv.ParseInt(code, 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pin != "" {
+		req.PIN, err = strconv.ParseInt(pin, 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func accountBalanceResp(eName string, s accounts.SubAccounts) *gctrpc.GetAccountBalancesResponse {
 	subAccts := make([]*gctrpc.Account, len(s))
 	for i, sa := range s {
@@ -644,6 +731,11 @@ func (s *RPCServer) GetAccountBalancesStream(r *gctrpc.GetAccountBalancesRequest
 		return err
 	}
 
+	ctx, err := withAccount(stream.Context(), exch, r.Account)
+	if err != nil {
+		return err
+	}
+
 	pipe, err := exch.SubscribeAccountBalances()
 	if err != nil {
 		return err
@@ -669,7 +761,7 @@ func (s *RPCServer) GetAccountBalancesStream(r *gctrpc.GetAccountBalancesRequest
 		case <-init:
 		}
 
-		subAccts, err := exch.GetCachedSubAccounts(stream.Context(), assetType)
+		subAccts, err := exch.GetCachedSubAccounts(ctx, assetType)
 		if err != nil {
 			return err
 		}
@@ -682,7 +774,11 @@ func (s *RPCServer) GetAccountBalancesStream(r *gctrpc.GetAccountBalancesRequest
 
 // GetConfig returns the bots config
 func (s *RPCServer) GetConfig(_ context.Context, _ *gctrpc.GetConfigRequest) (*gctrpc.GetConfigResponse, error) {
-	return &gctrpc.GetConfigResponse{}, common.ErrNotYetImplemented
+	data, err := s.runningConfigJSON()
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetConfigResponse{Data: data}, nil
 }
 
 // GetPortfolio returns the portfoliomanager details
@@ -754,9 +850,40 @@ func (s *RPCServer) GetPortfolioSummary(_ context.Context, _ *gctrpc.GetPortfoli
 		}
 	}
 
+	holdings := s.portfolioManager.GetAccountHoldings()
+	resp.AccountHoldings = make([]*gctrpc.AccountHoldings, len(holdings))
+	for i := range holdings {
+		resp.AccountHoldings[i] = &gctrpc.AccountHoldings{
+			Exchange:   holdings[i].Exchange,
+			Account:    holdings[i].Account,
+			Currencies: currencyBalancesToRPC(holdings[i].Balances),
+		}
+	}
+
 	return &resp, nil
 }
 
+// currencyBalancesToRPC converts currency balances to RPC account currency
+// info sorted by currency
+func currencyBalancesToRPC(balances accounts.CurrencyBalances) []*gctrpc.AccountCurrencyInfo {
+	resp := make([]*gctrpc.AccountCurrencyInfo, 0, len(balances))
+	for curr, bal := range balances {
+		resp = append(resp, &gctrpc.AccountCurrencyInfo{
+			Currency:          curr.String(),
+			TotalValue:        bal.Total,
+			Hold:              bal.Hold,
+			Free:              bal.Free,
+			FreeWithoutBorrow: bal.AvailableWithoutBorrow,
+			Borrowed:          bal.Borrowed,
+			UpdatedAt:         timestamppb.New(bal.UpdatedAt),
+		})
+	}
+	slices.SortFunc(resp, func(a, b *gctrpc.AccountCurrencyInfo) int {
+		return strings.Compare(a.Currency, b.Currency)
+	})
+	return resp
+}
+
 // AddPortfolioAddress adds an address to the portfoliomanager manager
 func (s *RPCServer) AddPortfolioAddress(_ context.Context, r *gctrpc.AddPortfolioAddressRequest) (*gctrpc.GenericResponse, error) {
 	err := s.portfolioManager.AddAddress(r.Address,
@@ -866,6 +993,11 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 		return nil, err
 	}
 
+	ctx, err = withAccount(ctx, exch, r.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	var start, end time.Time
 	if r.StartDate != "" {
 		start, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.StartDate)
@@ -938,6 +1070,7 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 			Fee:           resp[x].Fee,
 			Cost:          resp[x].Cost,
 			Trades:        trades,
+			Account:       r.Account,
 		}
 		if !resp[x].Date.IsZero() {
 			o.CreationTime = resp[x].Date.Format(common.SimpleTimeFormatWithTimezone)
@@ -953,7 +1086,7 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 
 // GetManagedOrders returns all orders from the Order Manager for the provided exchange,
 // asset type  and currency pair
-func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
+func (s *RPCServer) GetManagedOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
 	if r == nil {
 		return nil, errInvalidArguments
 	}
@@ -981,11 +1114,16 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 		return nil, err
 	}
 
+	if _, err = withAccount(ctx, exch, r.Account); err != nil {
+		return nil, err
+	}
+
 	var resp []order.Detail
 	filter := order.Filter{
 		Exchange:  exch.GetName(),
 		Pair:      cp,
 		AssetType: a,
+		Account:   r.Account,
 	}
 	resp, err = s.OrderManager.GetOrdersFiltered(&filter)
 	if err != nil {
@@ -1027,6 +1165,7 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 			Fee:           resp[x].Fee,
 			Cost:          resp[x].Cost,
 			Trades:        trades,
+			Account:       resp[x].Account,
 		}
 		if !resp[x].Date.IsZero() {
 			o.CreationTime = resp[x].Date.Format(common.SimpleTimeFormatWithTimezone)
@@ -1067,6 +1206,11 @@ func (s *RPCServer) GetOrder(ctx context.Context, r *gctrpc.GetOrderRequest) (*g
 		return nil, err
 	}
 
+	ctx, err = withAccount(ctx, exch, r.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	result, err := s.OrderManager.GetOrderInfo(ctx,
 		r.Exchange,
 		r.OrderId,
@@ -1116,6 +1260,7 @@ func (s *RPCServer) GetOrder(ctx context.Context, r *gctrpc.GetOrderRequest) (*g
 		Trades:        trades,
 		Cost:          result.Cost,
 		UpdateTime:    updateTime,
+		Account:       result.Account,
 	}, err
 }
 
@@ -1149,6 +1294,11 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 		return nil, err
 	}
 
+	ctx, err = withAccount(ctx, exch, r.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	side, err := order.StringToOrderSide(r.Side)
 	if err != nil {
 		return nil, err
@@ -1174,6 +1324,10 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 		submission.MarginType = marginType
 	}
 
+	if r.Strategy != "" {
+		ctx = WithStrategy(ctx, r.Strategy)
+	}
+
 	resp, err := s.OrderManager.Submit(ctx, submission)
 	if err != nil {
 		return &gctrpc.SubmitOrderResponse{}, err
@@ -1318,6 +1472,11 @@ func (s *RPCServer) CancelOrder(ctx context.Context, r *gctrpc.CancelOrderReques
 		return nil, err
 	}
 
+	ctx, err = withAccount(ctx, exch, r.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	var side order.Side
 	side, err = order.StringToOrderSide(r.Side)
 	if err != nil {
@@ -1361,6 +1520,11 @@ func (s *RPCServer) CancelBatchOrders(ctx context.Context, r *gctrpc.CancelBatch
 		return nil, err
 	}
 
+	ctx, err = withAccount(ctx, exch, r.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	var side order.Side
 	side, err = order.StringToOrderSide(r.Side)
 	if err != nil {
@@ -1403,6 +1567,11 @@ func (s *RPCServer) CancelAllOrders(ctx context.Context, r *gctrpc.CancelAllOrde
 		return nil, err
 	}
 
+	ctx, err = withAccount(ctx, exch, r.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO: Change to order manager
 	resp, err := exch.CancelAllOrders(ctx, nil)
 	if err != nil {
@@ -1434,6 +1603,11 @@ func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderReques
 	if err != nil {
 		return nil, err
 	}
+
+	ctx, err = withAccount(ctx, exch, r.Account)
+	if err != nil {
+		return nil, err
+	}
 	resp, err := s.OrderManager.Modify(ctx, &order.Modify{
 		Exchange:  r.Exchange,
 		AssetType: assetType,
@@ -1594,7 +1768,12 @@ func (s *RPCServer) GetAvailableTransferChains(ctx context.Context, r *gctrpc.Ge
 // WithdrawCryptocurrencyFunds withdraws cryptocurrency funds specified by
 // exchange
 func (s *RPCServer) WithdrawCryptocurrencyFunds(ctx context.Context, r *gctrpc.WithdrawCryptoRequest) (*gctrpc.WithdrawResponse, error) {
-	_, err := s.GetExchangeByName(r.Exchange)
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = withAccount(ctx, exch, r.Account)
 	if err != nil {
 		return nil, err
 	}
@@ -1613,34 +1792,10 @@ func (s *RPCServer) WithdrawCryptocurrencyFunds(ctx context.Context, r *gctrpc.W
 		},
 	}
 
-	exchCfg, err := s.Config.GetExchangeConfig(r.Exchange)
-	if err != nil {
+	if err := s.setWithdrawalAuth(ctx, exch, r.Account, req); err != nil {
 		return nil, err
 	}
 
-	if exchCfg.API.Credentials.OTPSecret != "" {
-		code, errOTP := totp.GenerateCode(exchCfg.API.Credentials.OTPSecret, time.Now())
-		if errOTP != nil {
-			return nil, errOTP
-		}
-
-		codeNum, errOTP := strconv.ParseInt(code, 10, 64)
-		if errOTP != nil {
-			return nil, errOTP
-		}
-		req.OneTimePassword = codeNum
-	}
-
-	if exchCfg.API.Credentials.PIN != "" {
-		pinCode, errPin := strconv.ParseInt(exchCfg.API.Credentials.PIN, 10, 64)
-		if errPin != nil {
-			return nil, errPin
-		}
-		req.PIN = pinCode
-	}
-
-	req.TradePassword = exchCfg.API.Credentials.TradePassword
-
 	resp, err := s.Engine.WithdrawManager.SubmitWithdrawal(ctx, req)
 	if err != nil {
 		return nil, err
@@ -1659,6 +1814,11 @@ func (s *RPCServer) WithdrawFiatFunds(ctx context.Context, r *gctrpc.WithdrawFia
 		return nil, err
 	}
 
+	ctx, err = withAccount(ctx, exch, r.Account)
+	if err != nil {
+		return nil, err
+	}
+
 	bankAccount, err := banking.GetBankAccountByID(r.BankAccountId)
 	if err != nil {
 		base := exch.GetBase()
@@ -1683,34 +1843,10 @@ func (s *RPCServer) WithdrawFiatFunds(ctx context.Context, r *gctrpc.WithdrawFia
 		},
 	}
 
-	exchCfg, err := s.Config.GetExchangeConfig(r.Exchange)
-	if err != nil {
+	if err := s.setWithdrawalAuth(ctx, exch, r.Account, req); err != nil {
 		return nil, err
 	}
 
-	if exchCfg.API.Credentials.OTPSecret != "" {
-		code, errOTP := totp.GenerateCode(exchCfg.API.Credentials.OTPSecret, time.Now())
-		if errOTP != nil {
-			return nil, errOTP
-		}
-
-		codeNum, errOTP := strconv.ParseInt(code, 10, 64)
-		if errOTP != nil {
-			return nil, errOTP
-		}
-		req.OneTimePassword = codeNum
-	}
-
-	if exchCfg.API.Credentials.PIN != "" {
-		pinCode, errPIN := strconv.ParseInt(exchCfg.API.Credentials.PIN, 10, 64)
-		if errPIN != nil {
-			return nil, errPIN
-		}
-		req.PIN = pinCode
-	}
-
-	req.TradePassword = exchCfg.API.Credentials.TradePassword
-
 	resp, err := s.Engine.WithdrawManager.SubmitWithdrawal(ctx, req)
 	if err != nil {
 		return nil, err
@@ -2533,24 +2669,51 @@ func (s *RPCServer) GCTScriptQuery(_ context.Context, r *gctrpc.GCTScriptQueryRe
 	return resp, nil
 }
 
-// GCTScriptExecute execute a script
-func (s *RPCServer) GCTScriptExecute(_ context.Context, r *gctrpc.GCTScriptExecuteRequest) (*gctrpc.GenericResponse, error) {
+// GCTScriptExecute execute a script. Debug executions run the script once and
+// return a trace of its gct module calls
+func (s *RPCServer) GCTScriptExecute(_ context.Context, r *gctrpc.GCTScriptExecuteRequest) (*gctrpc.GCTScriptExecuteResponse, error) {
 	if !s.gctScriptManager.IsRunning() {
-		return &gctrpc.GenericResponse{Status: gctscript.ErrScriptingDisabled.Error()}, nil
+		return &gctrpc.GCTScriptExecuteResponse{Status: gctscript.ErrScriptingDisabled.Error()}, nil
 	}
 
 	if r.Script.Path == "" {
 		r.Script.Path = gctscript.ScriptPath
 	}
 
+	script := filepath.Join(r.Script.Path, r.Script.Name)
+	if r.Debug {
+		trace, err := s.gctScriptManager.Debug(script, r.Breakpoints)
+		resp := &gctrpc.GCTScriptExecuteResponse{
+			Status: MsgStatusOK,
+			Data:   filepath.Base(script) + " debugged",
+			Trace:  make([]*gctrpc.GCTScriptTraceEntry, len(trace)),
+		}
+		if err != nil {
+			resp.Status = MsgStatusError
+			resp.Data = err.Error()
+		}
+		for i := range trace {
+			resp.Trace[i] = &gctrpc.GCTScriptTraceEntry{
+				Module:     trace[i].Module,
+				Function:   trace[i].Function,
+				Args:       trace[i].Args,
+				Result:     trace[i].Result,
+				Error:      trace[i].Error,
+				Timestamp:  trace[i].Time.Format(common.SimpleTimeFormatWithTimezone),
+				Duration:   trace[i].Duration.String(),
+				Breakpoint: trace[i].Breakpoint,
+			}
+		}
+		return resp, nil
+	}
+
 	gctVM := s.gctScriptManager.New()
 	if gctVM == nil {
-		return &gctrpc.GenericResponse{Status: MsgStatusError, Data: "unable to create VM instance"}, nil
+		return &gctrpc.GCTScriptExecuteResponse{Status: MsgStatusError, Data: "unable to create VM instance"}, nil
 	}
 
-	script := filepath.Join(r.Script.Path, r.Script.Name)
 	if err := gctVM.Load(script); err != nil {
-		return &gctrpc.GenericResponse{ //nolint:nilerr // error is returned in the generic response
+		return &gctrpc.GCTScriptExecuteResponse{ //nolint:nilerr // error is returned in the response
 			Status: MsgStatusError,
 			Data:   err.Error(),
 		}, nil
@@ -2558,12 +2721,39 @@ func (s *RPCServer) GCTScriptExecute(_ context.Context, r *gctrpc.GCTScriptExecu
 
 	go gctVM.CompileAndRun()
 
-	return &gctrpc.GenericResponse{
+	return &gctrpc.GCTScriptExecuteResponse{
 		Status: MsgStatusOK,
 		Data:   gctVM.ShortName() + " (" + gctVM.ID.String() + ") executed",
 	}, nil
 }
 
+// GCTScriptREPL evaluates tengo snippets against the live engine. Variables
+// are kept between snippets for the life of the stream
+func (s *RPCServer) GCTScriptREPL(stream gctrpc.GoCryptoTraderService_GCTScriptREPLServer) error {
+	repl, err := s.gctScriptManager.NewREPL()
+	if err != nil {
+		return err
+	}
+	defer repl.Close()
+	for {
+		r, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		resp := &gctrpc.GCTScriptREPLResponse{}
+		resp.Output, err = repl.Eval(stream.Context(), r.Code, r.DryRun)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
 // GCTScriptStop terminate a running script
 func (s *RPCServer) GCTScriptStop(_ context.Context, r *gctrpc.GCTScriptStopRequest) (*gctrpc.GenericResponse, error) {
 	if !s.gctScriptManager.IsRunning() {
@@ -3381,6 +3571,295 @@ func (s *RPCServer) FindMissingSavedTradeIntervals(_ context.Context, r *gctrpc.
 	return resp, nil
 }
 
+// ExportMarketData writes saved candles, trades or funding rates to daily
+// partitioned parquet files
+func (s *RPCServer) ExportMarketData(ctx context.Context, r *gctrpc.MarketDataTransferRequest) (*gctrpc.MarketDataTransferResponse, error) {
+	params, err := s.marketDataTransferParams(r)
+	if err != nil {
+		return nil, err
+	}
+	var count int
+	switch r.DataType {
+	case marketDataCandles:
+		var item *kline.Item
+		item, err = kline.LoadFromDatabase(params.exchange, params.pair, params.asset, params.interval, params.start, params.end)
+		if err != nil {
+			return nil, err
+		}
+		count, err = parquetstore.WriteCandles(params.directory, item, r.Overwrite)
+	case marketDataTrades:
+		var trades []trade.Data
+		trades, err = trade.GetTradesInRange(params.exchange, params.asset.String(), params.pair.Base.String(), params.pair.Quote.String(), params.start, params.end)
+		if err != nil {
+			return nil, err
+		}
+		if len(trades) == 0 {
+			return nil, fmt.Errorf("%w for %v %v %v between %v and %v", errNoTrades, params.exchange, params.asset, params.pair, r.Start, r.End)
+		}
+		count, err = parquetstore.WriteTrades(params.directory, r.Overwrite, trades...)
+	case marketDataFundingRates:
+		var item *fundingratesql.Item
+		item, err = fundingratesql.Series(ctx, params.exchange, params.pair.Base.String(), params.pair.Quote.String(), params.asset.String(), params.start, params.end)
+		if err != nil {
+			return nil, err
+		}
+		rates := &fundingrate.HistoricalRates{
+			Exchange:     params.exchange,
+			Asset:        params.asset,
+			Pair:         params.pair,
+			StartDate:    params.start,
+			EndDate:      params.end,
+			FundingRates: make([]fundingrate.Rate, len(item.Rates)),
+		}
+		for i := range item.Rates {
+			rates.FundingRates[i] = fundingrate.Rate{
+				Time:    item.Rates[i].Timestamp,
+				Rate:    decimal.NewFromFloat(item.Rates[i].Rate),
+				Payment: decimal.NewFromFloat(item.Rates[i].Payment),
+			}
+		}
+		count, err = parquetstore.WriteFundingRates(params.directory, rates, r.Overwrite)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.MarketDataTransferResponse{
+		Directory: params.directory,
+		Count:     uint64(count), //nolint:gosec // count cannot be negative
+	}, nil
+}
+
+// ImportMarketData saves candles, trades or funding rates from daily
+// partitioned parquet files to the database
+func (s *RPCServer) ImportMarketData(ctx context.Context, r *gctrpc.MarketDataTransferRequest) (*gctrpc.MarketDataTransferResponse, error) {
+	params, err := s.marketDataTransferParams(r)
+	if err != nil {
+		return nil, err
+	}
+	var count uint64
+	switch r.DataType {
+	case marketDataCandles:
+		var item *kline.Item
+		item, err = parquetstore.ReadCandles(params.directory, params.exchange, params.pair, params.asset, params.interval, params.start, params.end)
+		if err != nil {
+			return nil, err
+		}
+		count, err = kline.StoreInDatabase(item, r.Overwrite)
+	case marketDataTrades:
+		var trades []trade.Data
+		trades, err = parquetstore.ReadTrades(params.directory, params.exchange, params.pair, params.asset, params.start, params.end)
+		if err != nil {
+			return nil, err
+		}
+		err = trade.SaveTradesToDatabase(trades...)
+		count = uint64(len(trades))
+	case marketDataFundingRates:
+		var rates *fundingrate.HistoricalRates
+		rates, err = parquetstore.ReadFundingRates(params.directory, params.exchange, params.pair, params.asset, params.start, params.end)
+		if err != nil {
+			return nil, err
+		}
+		item := &fundingratesql.Item{
+			Exchange: params.exchange,
+			Base:     params.pair.Base.String(),
+			Quote:    params.pair.Quote.String(),
+			Asset:    params.asset.String(),
+			Rates:    make([]fundingratesql.Rate, len(rates.FundingRates)),
+		}
+		for i := range rates.FundingRates {
+			item.Rates[i] = fundingratesql.Rate{
+				Timestamp: rates.FundingRates[i].Time,
+				Rate:      rates.FundingRates[i].Rate.InexactFloat64(),
+				Payment:   rates.FundingRates[i].Payment.InexactFloat64(),
+			}
+		}
+		count, err = fundingratesql.Insert(ctx, item, r.Overwrite)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.MarketDataTransferResponse{
+		Directory: params.directory,
+		Count:     count,
+	}, nil
+}
+
+// marketDataTransferParams validates and parses a market data export or
+// import request
+func (s *RPCServer) marketDataTransferParams(r *gctrpc.MarketDataTransferRequest) (*marketDataTransfer, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	if r.End == "" || r.Start == "" || r.Exchange == "" || r.Pair == nil || r.AssetType == "" || r.Pair.String() == "" {
+		return nil, errInvalidArguments
+	}
+	switch r.DataType {
+	case marketDataCandles:
+		if r.Interval <= 0 {
+			return nil, fmt.Errorf("%w: candle interval required", errInvalidArguments)
+		}
+	case marketDataTrades, marketDataFundingRates:
+	default:
+		return nil, fmt.Errorf("%w %q, expected %v, %v or %v", errInvalidMarketDataType, r.DataType, marketDataCandles, marketDataTrades, marketDataFundingRates)
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if !exch.GetAssetTypes(false).Contains(a) {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	dir, err := s.marketDataDirectory(r.Directory)
+	if err != nil {
+		return nil, err
+	}
+	return &marketDataTransfer{
+		exchange:  exch.GetName(),
+		pair:      currency.NewPair(currency.NewCode(r.Pair.Base), currency.NewCode(r.Pair.Quote)).Upper(),
+		asset:     a,
+		interval:  kline.Interval(r.Interval),
+		start:     start.UTC(),
+		end:       end.UTC(),
+		directory: dir,
+	}, nil
+}
+
+// marketDataDirectory resolves a requested parquet directory under the parquet
+// folder within the bot's data directory, rejecting absolute paths and any
+// path which could escape it
+func (s *RPCServer) marketDataDirectory(dir string) (string, error) {
+	base := filepath.Join(s.Settings.DataDir, defaultParquetDirectory)
+	if dir == "" {
+		return base, nil
+	}
+	if filepath.IsAbs(dir) || filepath.VolumeName(dir) != "" || slices.Contains(strings.FieldsFunc(dir, isPathSeparator), "..") {
+		return "", fmt.Errorf("%w: %q", errInvalidMarketDataDir, dir)
+	}
+	return filepath.Join(base, dir), nil
+}
+
+// isPathSeparator reports whether r separates path elements on any platform
+func isPathSeparator(r rune) bool {
+	return r == '/' || r == '\\'
+}
+
+// RunDataQualityCheck scans saved candles or trades for gaps, outliers and
+// deviations from other exchanges, returning the report
+func (s *RPCServer) RunDataQualityCheck(ctx context.Context, r *gctrpc.RunDataQualityCheckRequest) (*gctrpc.DataQualityReport, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w RunDataQualityCheckRequest", common.ErrNilPointer)
+	}
+	if r.Exchange == "" || r.Pair == nil || r.AssetType == "" || r.Start == "" || r.End == "" {
+		return nil, errInvalidArguments
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	report, err := s.dataQualityManager.RunCheck(ctx, &config.DataQualityTarget{
+		Exchange:            r.Exchange,
+		Asset:               a,
+		Pair:                currency.NewPair(currency.NewCode(r.Pair.Base), currency.NewCode(r.Pair.Quote)).Upper(),
+		DataType:            r.DataType,
+		Interval:            kline.Interval(r.Interval),
+		ComparisonExchanges: r.ComparisonExchanges,
+	}, start, end)
+	if err != nil {
+		return nil, err
+	}
+	return dataQualityReportToRPC(report), nil
+}
+
+// GetDataQualityReports returns saved data quality reports for an exchange,
+// asset and pair which were created within the requested range
+func (s *RPCServer) GetDataQualityReports(ctx context.Context, r *gctrpc.GetDataQualityReportsRequest) (*gctrpc.GetDataQualityReportsResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetDataQualityReportsRequest", common.ErrNilPointer)
+	}
+	if r.Exchange == "" || r.Pair == nil || r.AssetType == "" || r.Start == "" || r.End == "" {
+		return nil, errInvalidArguments
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	pair := currency.NewPair(currency.NewCode(r.Pair.Base), currency.NewCode(r.Pair.Quote)).Upper()
+	reports, err := s.dataQualityManager.GetReports(ctx, r.Exchange, a, pair, start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetDataQualityReportsResponse{Reports: make([]*gctrpc.DataQualityReport, len(reports))}
+	for i := range reports {
+		resp.Reports[i] = dataQualityReportToRPC(&reports[i])
+	}
+	return resp, nil
+}
+
+func dataQualityReportToRPC(report *DataQualityReport) *gctrpc.DataQualityReport {
+	resp := &gctrpc.DataQualityReport{
+		Id:       report.ID.String(),
+		Exchange: report.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: report.Pair.Delimiter,
+			Base:      report.Pair.Base.String(),
+			Quote:     report.Pair.Quote.String(),
+		},
+		AssetType: report.Asset.String(),
+		DataType:  report.DataType,
+		Interval:  int64(report.Interval),
+		Start:     report.StartDate.Format(common.SimpleTimeFormatWithTimezone),
+		End:       report.EndDate.Format(common.SimpleTimeFormatWithTimezone),
+		Records:   report.Records,
+		CreatedAt: report.CreatedAt.Format(common.SimpleTimeFormatWithTimezone),
+		Issues:    make([]*gctrpc.DataQualityIssue, len(report.Issues)),
+	}
+	for i := range report.Issues {
+		resp.Issues[i] = &gctrpc.DataQualityIssue{
+			Type:               report.Issues[i].Type,
+			Start:              report.Issues[i].StartDate.Format(common.SimpleTimeFormatWithTimezone),
+			End:                report.Issues[i].EndDate.Format(common.SimpleTimeFormatWithTimezone),
+			Value:              report.Issues[i].Value,
+			ComparisonExchange: report.Issues[i].ComparisonExchange,
+			Description:        report.Issues[i].Description,
+		}
+		if report.Issues[i].RepairJobID != uuid.Nil {
+			resp.Issues[i].RepairJobId = report.Issues[i].RepairJobID.String()
+		}
+	}
+	return resp
+}
+
 // SetExchangeTradeProcessing allows the setting of exchange trade processing
 func (s *RPCServer) SetExchangeTradeProcessing(_ context.Context, r *gctrpc.SetExchangeTradeProcessingRequest) (*gctrpc.GenericResponse, error) {
 	exch, err := s.GetExchangeByName(r.Exchange)
@@ -5875,3 +6354,485 @@ func (s *RPCServer) GetCurrencyTradeURL(ctx context.Context, r *gctrpc.GetCurren
 		Url: url,
 	}, nil
 }
+
+// GetTransactionCostAnalysis returns transaction cost analysis for orders
+// submitted through the order manager, optionally rendering an HTML report
+func (s *RPCServer) GetTransactionCostAnalysis(_ context.Context, r *gctrpc.GetTransactionCostAnalysisRequest) (*gctrpc.GetTransactionCostAnalysisResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetTransactionCostAnalysisRequest", common.ErrNilPointer)
+	}
+	f := &TransactionCostFilter{
+		Exchange: r.Exchange,
+		Strategy: r.Strategy,
+	}
+	var err error
+	if r.Asset != "" {
+		f.Asset, err = asset.New(r.Asset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.Pair != nil {
+		f.Pair, err = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.StartDate != "" {
+		f.StartDate, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+		}
+	}
+	if r.EndDate != "" {
+		f.EndDate, err = time.Parse(common.SimpleTimeFormatWithTimezone, r.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+		}
+	}
+	if !f.StartDate.IsZero() && !f.EndDate.IsZero() {
+		if err = common.StartEndTimeCheck(f.StartDate, f.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	report, err := s.OrderManager.GetTransactionCostAnalysis(f)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetTransactionCostAnalysisResponse{
+		Orders:     make([]*gctrpc.OrderTransactionCost, len(report.Orders)),
+		ByExchange: transactionCostAggregatesToRPC(report.ByExchange),
+		ByPair:     transactionCostAggregatesToRPC(report.ByPair),
+		ByStrategy: transactionCostAggregatesToRPC(report.ByStrategy),
+	}
+	for i := range report.Orders {
+		o := &report.Orders[i]
+		resp.Orders[i] = &gctrpc.OrderTransactionCost{
+			Exchange: o.Exchange,
+			Asset:    o.Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: o.Pair.Delimiter,
+				Base:      o.Pair.Base.String(),
+				Quote:     o.Pair.Quote.String(),
+			},
+			Strategy:                   o.Strategy,
+			OrderId:                    o.OrderID,
+			InternalOrderId:            o.InternalOrderID,
+			Side:                       o.Side.String(),
+			Status:                     o.Status.String(),
+			SubmittedAt:                o.SubmittedAt.UTC().Format(common.SimpleTimeFormatWithTimezone),
+			Amount:                     o.Amount,
+			ExecutedAmount:             o.ExecutedAmount,
+			ArrivalPrice:               o.ArrivalPrice,
+			ArrivalSource:              o.ArrivalSource,
+			AverageFill:                o.AverageFill,
+			PostTradeMid:               o.PostTradeMid,
+			Notional:                   o.Notional,
+			Fees:                       o.Fees,
+			ArrivalSlippageBps:         o.ArrivalSlippageBPS,
+			ImplementationShortfall:    o.ImplementationShortfall,
+			ImplementationShortfallBps: o.ImplementationShortfallBPS,
+			RealisedSpreadBps:          o.RealisedSpreadBPS,
+			FeeDragBps:                 o.FeeDragBPS,
+		}
+	}
+	if r.GenerateReport {
+		resp.ReportPath, err = report.GenerateHTMLReport(filepath.Join(s.Settings.DataDir, "reports"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func transactionCostAggregatesToRPC(aggregates []TransactionCostAggregate) []*gctrpc.TransactionCostAggregate {
+	resp := make([]*gctrpc.TransactionCostAggregate, len(aggregates))
+	for i := range aggregates {
+		resp[i] = &gctrpc.TransactionCostAggregate{
+			Key:                        aggregates[i].Key,
+			Orders:                     int64(aggregates[i].Orders),
+			Notional:                   aggregates[i].Notional,
+			Fees:                       aggregates[i].Fees,
+			ImplementationShortfall:    aggregates[i].ImplementationShortfall,
+			ArrivalSlippageBps:         aggregates[i].ArrivalSlippageBPS,
+			ImplementationShortfallBps: aggregates[i].ImplementationShortfallBPS,
+			RealisedSpreadBps:          aggregates[i].RealisedSpreadBPS,
+			FeeDragBps:                 aggregates[i].FeeDragBPS,
+		}
+	}
+	return resp
+}
+
+// GetFuturesRisk returns the latest aggregated futures risk snapshot across
+// all exchanges
+func (s *RPCServer) GetFuturesRisk(_ context.Context, _ *gctrpc.GetFuturesRiskRequest) (*gctrpc.GetFuturesRiskResponse, error) {
+	snapshot, err := s.futuresRiskManager.GetLatestSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return futuresRiskSnapshotToRPC(snapshot), nil
+}
+
+// GetFuturesRiskStream streams aggregated futures risk snapshots as they are
+// calculated
+func (s *RPCServer) GetFuturesRiskStream(_ *gctrpc.GetFuturesRiskRequest, stream gctrpc.GoCryptoTraderService_GetFuturesRiskStreamServer) error {
+	pipe, release, err := s.futuresRiskManager.Subscribe()
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	if snapshot, err := s.futuresRiskManager.GetLatestSnapshot(); err == nil {
+		if err := stream.Send(futuresRiskSnapshotToRPC(snapshot)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case snapshot, ok := <-pipe:
+			if !ok {
+				return fmt.Errorf("%s %w", FuturesRiskManagerName, ErrSubSystemNotStarted)
+			}
+			if err := stream.Send(futuresRiskSnapshotToRPC(snapshot)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func futuresRiskSnapshotToRPC(snapshot *FuturesRiskSnapshot) *gctrpc.GetFuturesRiskResponse {
+	resp := &gctrpc.GetFuturesRiskResponse{
+		Timestamp:     snapshot.Timestamp.Format(common.SimpleTimeFormatWithTimezone),
+		GrossNotional: snapshot.GrossNotional,
+		Positions:     make([]*gctrpc.FuturesPositionRisk, len(snapshot.Positions)),
+		Underlyings:   make([]*gctrpc.FuturesUnderlyingExposure, len(snapshot.Underlyings)),
+		Accounts:      make([]*gctrpc.FuturesAccountMarginRisk, len(snapshot.Accounts)),
+		Alerts:        make([]*gctrpc.FuturesRiskAlert, len(snapshot.Alerts)),
+	}
+	for i := range snapshot.Positions {
+		p := &snapshot.Positions[i]
+		resp.Positions[i] = &gctrpc.FuturesPositionRisk{
+			Exchange: p.Exchange,
+			Asset:    p.Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Pair.Delimiter,
+				Base:      p.Pair.Base.String(),
+				Quote:     p.Pair.Quote.String(),
+			},
+			Underlying:          p.Underlying.String(),
+			Side:                p.Side.String(),
+			Size:                p.Size,
+			MarkPrice:           p.MarkPrice,
+			Notional:            p.Notional,
+			UnrealisedPnl:       p.UnrealisedPNL,
+			LiquidationPrice:    p.LiquidationPrice,
+			LiquidationDistance: p.LiquidationDistance,
+		}
+	}
+	for i := range snapshot.Underlyings {
+		u := &snapshot.Underlyings[i]
+		resp.Underlyings[i] = &gctrpc.FuturesUnderlyingExposure{
+			Underlying:    u.Underlying.String(),
+			NetDelta:      u.NetDelta,
+			NetNotional:   u.NetNotional,
+			GrossNotional: u.GrossNotional,
+			Concentration: u.Concentration,
+			Exchanges:     u.Exchanges,
+		}
+	}
+	for i := range snapshot.Accounts {
+		a := &snapshot.Accounts[i]
+		resp.Accounts[i] = &gctrpc.FuturesAccountMarginRisk{
+			Exchange:            a.Exchange,
+			Asset:               a.Asset.String(),
+			CollateralCurrency:  a.CollateralCurrency.String(),
+			TotalCollateral:     a.TotalCollateral,
+			UsedCollateral:      a.UsedCollateral,
+			AvailableCollateral: a.AvailableCollateral,
+			UnrealisedPnl:       a.UnrealisedPNL,
+			MarginRatio:         a.MarginRatio,
+		}
+	}
+	for i := range snapshot.Alerts {
+		resp.Alerts[i] = &gctrpc.FuturesRiskAlert{
+			Type:      snapshot.Alerts[i].Type,
+			Key:       snapshot.Alerts[i].Key,
+			Value:     snapshot.Alerts[i].Value,
+			Threshold: snapshot.Alerts[i].Threshold,
+			Message:   snapshot.Alerts[i].Message,
+		}
+	}
+	return resp
+}
+
+// CreateTransfer withdraws funds from one exchange and tracks them until they
+// are deposited into another
+func (s *RPCServer) CreateTransfer(ctx context.Context, r *gctrpc.CreateTransferRequest) (*gctrpc.Transfer, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w CreateTransferRequest", common.ErrNilPointer)
+	}
+	t, err := s.transferManager.CreateTransfer(ctx, &TransferRequest{
+		Source:      r.Source,
+		Destination: r.Destination,
+		Currency:    currency.NewCode(r.Currency),
+		Amount:      r.Amount,
+		Chain:       r.Chain,
+	})
+	if err != nil {
+		if t != nil {
+			return nil, fmt.Errorf("transfer %s %w", t.ID, err)
+		}
+		return nil, err
+	}
+	return transferToRPC(t), nil
+}
+
+// GetTransfers returns inter-exchange transfers, optionally filtered by ID or
+// status
+func (s *RPCServer) GetTransfers(_ context.Context, r *gctrpc.GetTransfersRequest) (*gctrpc.GetTransfersResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetTransfersRequest", common.ErrNilPointer)
+	}
+	if r.Id != "" {
+		id, err := uuid.FromString(r.Id)
+		if err != nil {
+			return nil, err
+		}
+		t, err := s.transferManager.GetTransfer(id)
+		if err != nil {
+			return nil, err
+		}
+		return &gctrpc.GetTransfersResponse{Transfers: []*gctrpc.Transfer{transferToRPC(t)}}, nil
+	}
+	transfers, err := s.transferManager.GetTransfers()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetTransfersResponse{Transfers: make([]*gctrpc.Transfer, 0, len(transfers))}
+	for i := range transfers {
+		if r.Status != "" && !strings.EqualFold(r.Status, string(transfers[i].Status)) {
+			continue
+		}
+		resp.Transfers = append(resp.Transfers, transferToRPC(&transfers[i]))
+	}
+	return resp, nil
+}
+
+func transferToRPC(t *Transfer) *gctrpc.Transfer {
+	formatTime := func(tt time.Time) string {
+		if tt.IsZero() {
+			return ""
+		}
+		return tt.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return &gctrpc.Transfer{
+		Id:                   t.ID.String(),
+		Source:               t.Source,
+		Destination:          t.Destination,
+		Currency:             t.Currency.String(),
+		Amount:               t.Amount,
+		Chain:                t.Chain,
+		Address:              t.Address,
+		AddressTag:           t.AddressTag,
+		EstimatedFee:         t.EstimatedFee,
+		Status:               string(t.Status),
+		WithdrawalId:         t.WithdrawalID,
+		ExchangeWithdrawalId: t.ExchangeWithdrawalID,
+		TxId:                 t.TxID,
+		Error:                t.Error,
+		CreatedAt:            formatTime(t.CreatedAt),
+		SubmittedAt:          formatTime(t.SubmittedAt),
+		UpdatedAt:            formatTime(t.UpdatedAt),
+		CompletedAt:          formatTime(t.CompletedAt),
+	}
+}
+
+// UnlockCredentials unlocks an exchange account's keystore credentials at
+// runtime
+func (s *RPCServer) UnlockCredentials(ctx context.Context, r *gctrpc.UnlockCredentialsRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w UnlockCredentialsRequest", common.ErrNilPointer)
+	}
+	if err := s.Engine.UnlockCredentials(ctx, r.Exchange, r.Account, []byte(r.Passphrase)); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// ReloadCredentials discards cached credentials so rotated credentials are
+// fetched from the credential provider
+func (s *RPCServer) ReloadCredentials(ctx context.Context, r *gctrpc.ReloadCredentialsRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w ReloadCredentialsRequest", common.ErrNilPointer)
+	}
+	if err := s.Engine.ReloadCredentials(ctx, r.Exchange); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// CreateAPIToken issues a scoped, expiring API token. The token secret is only
+// returned once.
+func (s *RPCServer) CreateAPIToken(ctx context.Context, r *gctrpc.CreateAPITokenRequest) (*gctrpc.CreateAPITokenResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w CreateAPITokenRequest", common.ErrNilPointer)
+	}
+	if s.apiTokens == nil {
+		return nil, errAPITokenStoreNotSetup
+	}
+	role, err := parseRPCRole(r.Role)
+	if err != nil {
+		return nil, err
+	}
+	expiry, err := time.ParseDuration(r.Expiry)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errAPITokenExpiryInvalid, err)
+	}
+	var createdBy string
+	if p, err := principalFromContext(ctx); err == nil {
+		createdBy = p.name
+	}
+	secret, t, err := s.apiTokens.create(r.Name, createdBy, role, r.Exchanges, r.Pairs, expiry)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.CreateAPITokenResponse{Token: apiTokenToRPC(t), Secret: secret}, nil
+}
+
+// GetAPITokens returns all unexpired API tokens
+func (s *RPCServer) GetAPITokens(_ context.Context, r *gctrpc.GetAPITokensRequest) (*gctrpc.GetAPITokensResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetAPITokensRequest", common.ErrNilPointer)
+	}
+	if s.apiTokens == nil {
+		return nil, errAPITokenStoreNotSetup
+	}
+	tokens := s.apiTokens.list()
+	resp := &gctrpc.GetAPITokensResponse{Tokens: make([]*gctrpc.APIToken, len(tokens))}
+	for i := range tokens {
+		resp.Tokens[i] = apiTokenToRPC(&tokens[i])
+	}
+	return resp, nil
+}
+
+// RevokeAPIToken revokes an API token so it can no longer be used
+func (s *RPCServer) RevokeAPIToken(_ context.Context, r *gctrpc.RevokeAPITokenRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w RevokeAPITokenRequest", common.ErrNilPointer)
+	}
+	if s.apiTokens == nil {
+		return nil, errAPITokenStoreNotSetup
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.apiTokens.revoke(id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+func apiTokenToRPC(t *APIToken) *gctrpc.APIToken {
+	return &gctrpc.APIToken{
+		Id:        t.ID.String(),
+		Name:      t.Name,
+		Role:      t.Role,
+		Exchanges: t.Exchanges,
+		Pairs:     t.Pairs,
+		CreatedBy: t.CreatedBy,
+		CreatedAt: t.CreatedAt.Format(common.SimpleTimeFormatWithTimezone),
+		ExpiresAt: t.ExpiresAt.Format(common.SimpleTimeFormatWithTimezone),
+	}
+}
+
+// ReloadConfig validates a config file and applies its differences to the
+// running engine, rolling back on failure
+func (s *RPCServer) ReloadConfig(_ context.Context, r *gctrpc.ReloadConfigRequest) (*gctrpc.ReloadConfigResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w ReloadConfigRequest", common.ErrNilPointer)
+	}
+	result, err := s.Engine.ReloadConfig(r.ConfigPath, r.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ReloadConfigResponse{
+		Applied:         result.Applied,
+		RestartRequired: result.RestartRequired,
+	}, nil
+}
+
+// PatchConfig applies a JSON patch to the running config and saves it
+func (s *RPCServer) PatchConfig(_ context.Context, r *gctrpc.PatchConfigRequest) (*gctrpc.ConfigChangeResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w PatchConfigRequest", common.ErrNilPointer)
+	}
+	result, err := s.Engine.PatchConfig([]byte(r.Patch), r.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ConfigChangeResponse{
+		Applied:         result.Applied,
+		RestartRequired: result.RestartRequired,
+		Version:         result.Version,
+	}, nil
+}
+
+// GetConfigHistory returns the saved config versions
+func (s *RPCServer) GetConfigHistory(_ context.Context, _ *gctrpc.GetConfigHistoryRequest) (*gctrpc.GetConfigHistoryResponse, error) {
+	versions, err := s.Engine.GetConfigHistory()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetConfigHistoryResponse{Versions: make([]*gctrpc.ConfigVersion, len(versions))}
+	for i := range versions {
+		resp.Versions[i] = &gctrpc.ConfigVersion{
+			Version:   versions[i].Version,
+			SavedAt:   versions[i].Saved.Format(common.SimpleTimeFormatWithTimezone),
+			Encrypted: versions[i].Encrypted,
+		}
+	}
+	return resp, nil
+}
+
+// DiffConfig returns the JSON patch between two saved config versions, version
+// 0 being the running config
+func (s *RPCServer) DiffConfig(_ context.Context, r *gctrpc.DiffConfigRequest) (*gctrpc.DiffConfigResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w DiffConfigRequest", common.ErrNilPointer)
+	}
+	p, err := s.Engine.DiffConfig(r.FromVersion, r.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		p = jsonpatch.Patch{}
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.DiffConfigResponse{Patch: string(data)}, nil
+}
+
+// RollbackConfig applies a saved config version to the running engine and
+// saves it as a new version
+func (s *RPCServer) RollbackConfig(_ context.Context, r *gctrpc.RollbackConfigRequest) (*gctrpc.ConfigChangeResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w RollbackConfigRequest", common.ErrNilPointer)
+	}
+	result, err := s.Engine.RollbackConfig(r.Version, r.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ConfigChangeResponse{
+		Applied:         result.Applied,
+		RestartRequired: result.RestartRequired,
+		Version:         result.Version,
+	}, nil
+}
@@ -29,7 +29,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/parquetstore"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/dataquality"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
@@ -48,6 +50,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/goose"
@@ -992,6 +996,106 @@ func TestFindMissingSavedTradeIntervals(t *testing.T) {
 	}
 }
 
+func TestMarketDataDirectory(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Settings: Settings{DataDir: "data"}}}
+	base := filepath.Join("data", defaultParquetDirectory)
+	for _, tc := range []struct {
+		dir  string
+		want string
+		err  error
+	}{
+		{dir: "", want: base},
+		{dir: "export", want: filepath.Join(base, "export")},
+		{dir: "export/binance", want: filepath.Join(base, "export", "binance")},
+		{dir: "./export", want: filepath.Join(base, "export")},
+		{dir: "..", err: errInvalidMarketDataDir},
+		{dir: "../export", err: errInvalidMarketDataDir},
+		{dir: "export/../..", err: errInvalidMarketDataDir},
+		{dir: "export/../binance", err: errInvalidMarketDataDir},
+		{dir: `export\..\..`, err: errInvalidMarketDataDir},
+		{dir: "/tmp/export", err: errInvalidMarketDataDir},
+	} {
+		t.Run(tc.dir, func(t *testing.T) {
+			t.Parallel()
+			dir, err := s.marketDataDirectory(tc.dir)
+			require.ErrorIs(t, err, tc.err, "marketDataDirectory must return the correct error")
+			assert.Equal(t, tc.want, dir, "marketDataDirectory should resolve the correct directory")
+		})
+	}
+}
+
+func TestExportImportMarketData(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	engerino.Settings.DataDir = t.TempDir()
+	s := RPCServer{Engine: engerino}
+	_, err := s.ExportMarketData(t.Context(), nil)
+	assert.ErrorIs(t, err, errNilRequestData)
+
+	_, err = s.ExportMarketData(t.Context(), &gctrpc.MarketDataTransferRequest{})
+	assert.ErrorIs(t, err, errInvalidArguments)
+
+	req := &gctrpc.MarketDataTransferRequest{
+		Exchange: testExchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: currency.DashDelimiter,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+		},
+		AssetType: asset.Spot.String(),
+		Start:     time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		End:       time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		DataType:  "orderbooks",
+		Directory: "export",
+	}
+	_, err = s.ExportMarketData(t.Context(), req)
+	assert.ErrorIs(t, err, errInvalidMarketDataType)
+
+	req.DataType = marketDataTrades
+	req.Directory = "../export"
+	_, err = s.ExportMarketData(t.Context(), req)
+	assert.ErrorIs(t, err, errInvalidMarketDataDir)
+	_, err = s.ImportMarketData(t.Context(), req)
+	assert.ErrorIs(t, err, errInvalidMarketDataDir)
+	req.Directory = "export"
+
+	req.DataType = marketDataCandles
+	_, err = s.ExportMarketData(t.Context(), req)
+	assert.ErrorIs(t, err, errInvalidArguments, "ExportMarketData should error without a candle interval")
+
+	req.DataType = marketDataTrades
+	_, err = s.ExportMarketData(t.Context(), req)
+	assert.ErrorIs(t, err, errNoTrades)
+
+	err = sqltrade.Insert(sqltrade.Data{
+		Timestamp: time.Date(2020, 1, 1, 1, 0, 0, 0, time.UTC),
+		Exchange:  testExchange,
+		Base:      currency.BTC.String(),
+		Quote:     currency.USD.String(),
+		AssetType: asset.Spot.String(),
+		Price:     1337,
+		Amount:    1337,
+		Side:      order.Buy.String(),
+		TID:       "1337",
+	})
+	require.NoError(t, err, "Insert must not error")
+
+	resp, err := s.ExportMarketData(t.Context(), req)
+	require.NoError(t, err, "ExportMarketData must not error")
+	assert.Equal(t, uint64(1), resp.Count, "ExportMarketData should export the saved trade")
+	assert.Equal(t, filepath.Join(engerino.Settings.DataDir, defaultParquetDirectory, "export"), resp.Directory, "ExportMarketData should resolve the requested directory under the parquet directory")
+
+	resp, err = s.ImportMarketData(t.Context(), req)
+	require.NoError(t, err, "ImportMarketData must not error")
+	assert.Equal(t, uint64(1), resp.Count, "ImportMarketData should import the exported trade")
+
+	req.DataType = marketDataCandles
+	req.Interval = int64(kline.OneHour)
+	_, err = s.ImportMarketData(t.Context(), req)
+	assert.ErrorIs(t, err, parquetstore.ErrNoDataFound)
+}
+
 func TestFindMissingSavedCandleIntervals(t *testing.T) {
 	engerino := RPCTestSetup(t)
 	defer CleanRPCTest(t, engerino)
@@ -1235,6 +1339,56 @@ func TestGetAccountBalances(t *testing.T) {
 	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
 	_, err = s.GetAccountBalances(ctx, &gctrpc.GetAccountBalancesRequest{Exchange: fakeExchangeName, AssetType: asset.Spot.String()})
 	assert.NoError(t, err)
+
+	_, err = s.GetAccountBalances(t.Context(), &gctrpc.GetAccountBalancesRequest{Exchange: fakeExchangeName, AssetType: asset.Spot.String(), Account: "arbitrage"})
+	assert.ErrorIs(t, err, exchange.ErrAccountNotFound)
+
+	require.NoError(t, b.SetAccountCredentials("arbitrage", &accounts.Credentials{Key: "arbKey", Secret: "arbSecret", SubAccount: "7"}))
+	resp, err := s.GetAccountBalances(t.Context(), &gctrpc.GetAccountBalancesRequest{Exchange: fakeExchangeName, AssetType: asset.Spot.String(), Account: "arbitrage"})
+	require.NoError(t, err)
+	require.Len(t, resp.Accounts, 1)
+	assert.Equal(t, "7", resp.Accounts[0].Id, "GetAccountBalances should use the named account credentials")
+}
+
+type withdrawalAuthProvider struct{}
+
+func (withdrawalAuthProvider) GetCredentials(context.Context, string, string) (*accounts.Credentials, error) {
+	return &accounts.Credentials{Key: "key", Secret: "secret", OneTimePassword: "JBSWY3DPEHPK3PXP", PIN: "1234", TradePassword: "hunter2"}, nil
+}
+
+func TestSetWithdrawalAuth(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	require.NoError(t, em.Add(exch))
+
+	exchCfg := config.Exchange{Name: fakeExchangeName}
+	exchCfg.API.Credentials.PIN = "4321"
+	exchCfg.API.Credentials.TradePassword = "configPassword"
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, Config: &config.Config{Exchanges: []config.Exchange{exchCfg}}}}
+
+	req := &withdraw.Request{}
+	require.NoError(t, s.setWithdrawalAuth(t.Context(), exch, "", req))
+	assert.Equal(t, int64(4321), req.PIN, "PIN should be read from the exchange config")
+	assert.Equal(t, "configPassword", req.TradePassword, "TradePassword should be read from the exchange config")
+	assert.Zero(t, req.OneTimePassword, "OneTimePassword should not be set without an OTP secret")
+
+	s.Config.Exchanges[0].API.UseCredentialProvider = true
+	b.SetCredentialProvider(withdrawalAuthProvider{})
+	require.NoError(t, b.SetCredentialProviderAccount("hedge"))
+	ctx, err := withAccount(t.Context(), exch, "Hedge")
+	require.NoError(t, err)
+	req = &withdraw.Request{}
+	require.NoError(t, s.setWithdrawalAuth(ctx, exch, "Hedge", req))
+	assert.Equal(t, int64(1234), req.PIN, "PIN should be fetched from the credential provider")
+	assert.Equal(t, "hunter2", req.TradePassword, "TradePassword should be fetched from the credential provider")
+	assert.NotZero(t, req.OneTimePassword, "OneTimePassword should be generated from the provider OTP secret")
+
+	_, err = withAccount(t.Context(), exch, "arbitrage")
+	assert.ErrorIs(t, err, exchange.ErrAccountNotFound, "withAccount should reject accounts missing from config")
 }
 
 func TestUpdateAccountBalances(t *testing.T) {
@@ -2908,7 +3062,7 @@ func TestGetManagedPosition(t *testing.T) {
 	assert.NoError(t, err)
 
 	s.OrderManager.started = 1
-	s.OrderManager.activelyTrackFuturesPositions = true
+	s.OrderManager.activelyTrackFuturesPositions.Store(true)
 	_, err = s.GetManagedPosition(t.Context(), request)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
@@ -3870,3 +4024,218 @@ func TestGetCurrencyTradeURL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, resp.Url)
 }
+
+func TestGetFuturesRisk(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetFuturesRisk(t.Context(), &gctrpc.GetFuturesRiskRequest{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	pm := &testFuturesPositionManager{
+		positions: []futures.Position{
+			{
+				Exchange:        testExchange,
+				Asset:           asset.USDTMarginedFutures,
+				Pair:            currency.NewBTCUSDT(),
+				LatestDirection: order.Long,
+				LatestSize:      decimal.NewFromInt(1),
+			},
+		},
+	}
+	s.futuresRiskManager, _ = futuresRiskSetup(t, pm, &config.FuturesRiskManager{MinLiquidationDistance: 0.1})
+	s.futuresRiskManager.started = 1
+	_, err = s.GetFuturesRisk(t.Context(), &gctrpc.GetFuturesRiskRequest{})
+	assert.ErrorIs(t, err, errNoRiskSnapshot)
+
+	_, err = s.futuresRiskManager.Update(t.Context())
+	require.NoError(t, err)
+	resp, err := s.GetFuturesRisk(t.Context(), &gctrpc.GetFuturesRiskRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 1)
+	assert.Equal(t, 100.0, resp.Positions[0].Notional)
+	require.Len(t, resp.Underlyings, 1)
+	assert.Equal(t, "BTC", resp.Underlyings[0].Underlying)
+	require.Len(t, resp.Accounts, 1)
+	assert.Len(t, resp.Alerts, 1)
+}
+
+func TestRunDataQualityCheck(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.RunDataQualityCheck(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.RunDataQualityCheckRequest{
+		Exchange:  testExchange,
+		Pair:      &gctrpc.CurrencyPair{Base: "btc", Quote: "usdt"},
+		AssetType: asset.Spot.String(),
+		DataType:  DataQualityCandles,
+		Interval:  int64(kline.OneHour),
+		Start:     dataQualityTestStart.Format(common.SimpleTimeFormatWithTimezone),
+		End:       dataQualityTestStart.Add(2 * time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	}
+	_, err = s.RunDataQualityCheck(t.Context(), &gctrpc.RunDataQualityCheckRequest{Exchange: testExchange})
+	assert.ErrorIs(t, err, errInvalidArguments)
+	_, err = s.RunDataQualityCheck(t.Context(), req)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	s.dataQualityManager, err = SetupDataQualityManager(&DatabaseConnectionManager{}, nil, &config.DataQualityManager{})
+	require.NoError(t, err, "SetupDataQualityManager must not error")
+	s.dataQualityManager.started = 1
+	s.dataQualityManager.candleLoader = func(exch string, pair currency.Pair, a asset.Item, interval kline.Interval, _, _ time.Time) (*kline.Item, error) {
+		return &kline.Item{Exchange: exch, Pair: pair, Asset: a, Interval: interval, Candles: dataQualityTestCandles(100)}, nil
+	}
+	resp, err := s.RunDataQualityCheck(t.Context(), req)
+	require.NoError(t, err, "RunDataQualityCheck must not error")
+	assert.Equal(t, "BTC", resp.Pair.Base, "pair should be upper cased")
+	assert.Equal(t, int64(1), resp.Records, "Records should be correct")
+	require.Len(t, resp.Issues, 1, "RunDataQualityCheck must return the gap")
+	assert.Equal(t, DataQualityIssueGap, resp.Issues[0].Type, "issue should be a gap")
+	assert.Equal(t, req.End, resp.Issues[0].End, "gap should end at the end of the range")
+}
+
+func TestGetDataQualityReports(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.GetDataQualityReports(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.GetDataQualityReportsRequest{
+		Exchange:  testExchange,
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USDT"},
+		AssetType: asset.Spot.String(),
+		Start:     dataQualityTestStart.Format(common.SimpleTimeFormatWithTimezone),
+		End:       dataQualityTestStart.Add(time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	}
+	_, err = s.GetDataQualityReports(t.Context(), &gctrpc.GetDataQualityReportsRequest{})
+	assert.ErrorIs(t, err, errInvalidArguments)
+	_, err = s.GetDataQualityReports(t.Context(), req)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	s.dataQualityManager, err = SetupDataQualityManager(&DatabaseConnectionManager{}, nil, &config.DataQualityManager{})
+	require.NoError(t, err, "SetupDataQualityManager must not error")
+	s.dataQualityManager.started = 1
+	s.dataQualityManager.reportLoader = func(_ context.Context, exch, base, quote, a string, start, end time.Time) ([]dataquality.Report, error) {
+		return []dataquality.Report{{ID: "9c1bf0c5-3f5f-4d6f-9e1d-3f7e0c3c6a11", Exchange: exch, Base: base, Quote: quote, Asset: a, DataType: DataQualityTrades, Interval: 60, StartDate: start, EndDate: end}}, nil
+	}
+	resp, err := s.GetDataQualityReports(t.Context(), req)
+	require.NoError(t, err, "GetDataQualityReports must not error")
+	require.Len(t, resp.Reports, 1, "GetDataQualityReports must return the saved report")
+	assert.Equal(t, int64(kline.OneMin), resp.Reports[0].Interval, "interval should be converted from seconds")
+	assert.Equal(t, req.Start, resp.Reports[0].Start, "start should be formatted")
+}
+
+func TestRPCServerReloadConfig(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{}
+	require.NoError(t, cfg.LoadConfig(config.TestFile, true), "LoadConfig must not error")
+	s := RPCServer{Engine: &Engine{Config: cfg, ExchangeManager: NewExchangeManager()}}
+
+	_, err := s.ReloadConfig(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.ReloadConfig(t.Context(), &gctrpc.ReloadConfigRequest{ConfigPath: "missing.json"})
+	assert.ErrorIs(t, err, config.ErrFailureOpeningConfig)
+
+	resp, err := s.ReloadConfig(t.Context(), &gctrpc.ReloadConfigRequest{ConfigPath: config.TestFile, DryRun: true})
+	require.NoError(t, err, "ReloadConfig must not error")
+	assert.Contains(t, resp.Applied, "exchanges."+testExchange+".enabled")
+}
+
+func TestRPCServerConfigHistory(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: newConfigHistoryTestBot(t)}
+
+	_, err := s.PatchConfig(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.DiffConfig(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.RollbackConfig(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	resp, err := s.PatchConfig(t.Context(), &gctrpc.PatchConfigRequest{Patch: `[{"op":"replace","path":"/name","value":"patched"}]`})
+	require.NoError(t, err, "PatchConfig must not error")
+	assert.Equal(t, uint64(1), resp.Version)
+
+	history, err := s.GetConfigHistory(t.Context(), &gctrpc.GetConfigHistoryRequest{})
+	require.NoError(t, err, "GetConfigHistory must not error")
+	require.Len(t, history.Versions, 1, "GetConfigHistory must return the saved version")
+	assert.Equal(t, uint64(1), history.Versions[0].Version)
+
+	diff, err := s.DiffConfig(t.Context(), &gctrpc.DiffConfigRequest{ToVersion: 1})
+	require.NoError(t, err, "DiffConfig must not error")
+	assert.JSONEq(t, `[{"op":"replace","path":"/name","value":"patched"}]`, diff.Patch)
+
+	_, err = s.RollbackConfig(t.Context(), &gctrpc.RollbackConfigRequest{Version: 2})
+	assert.ErrorIs(t, err, config.ErrConfigVersionNotFound)
+
+	cfg, err := s.GetConfig(t.Context(), &gctrpc.GetConfigRequest{})
+	require.NoError(t, err, "GetConfig must not error")
+	assert.Contains(t, string(cfg.Data), `"name":`, "GetConfig should return the running config")
+}
+
+func TestRPCServerConfigRedacted(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: newConfigHistoryTestBot(t)}
+
+	_, err := s.PatchConfig(t.Context(), &gctrpc.PatchConfigRequest{Patch: `[{"op":"replace","path":"/name","value":"patched"}]`})
+	require.NoError(t, err, "PatchConfig must not error")
+
+	secrets := []string{"exchangeKey1", "exchangeSecret2", "accountSecret3", "grpcPassword4", "userPassword5", "telegramToken6", "slackToken7", "webhookSecret8", "matrixToken9", "https://discord.com/api/webhooks/10"}
+	_, err = s.PatchConfig(t.Context(), &gctrpc.PatchConfigRequest{Patch: `[
+		{"op":"replace","path":"/exchanges/0/api/credentials/key","value":"exchangeKey1"},
+		{"op":"replace","path":"/exchanges/0/api/credentials/secret","value":"exchangeSecret2"},
+		{"op":"add","path":"/exchanges/0/api/accounts","value":[{"name":"hedge","credentials":{"key":"accountKey","secret":"accountSecret3","clientID":"1"}}]},
+		{"op":"replace","path":"/remoteControl/password","value":"grpcPassword4"},
+		{"op":"add","path":"/remoteControl/users","value":[{"username":"viewer","password":"userPassword5","role":"readonly"}]},
+		{"op":"replace","path":"/communications/telegram/verificationToken","value":"telegramToken6"},
+		{"op":"replace","path":"/communications/slack/verificationToken","value":"slackToken7"},
+		{"op":"add","path":"/communications/webhook/secret","value":"webhookSecret8"},
+		{"op":"replace","path":"/communications/matrix/accessToken","value":"matrixToken9"},
+		{"op":"replace","path":"/communications/discord/webhookURL","value":"https://discord.com/api/webhooks/10"}
+	]`})
+	require.NoError(t, err, "PatchConfig must not error")
+
+	cfg, err := s.GetConfig(t.Context(), &gctrpc.GetConfigRequest{})
+	require.NoError(t, err, "GetConfig must not error")
+	assert.Contains(t, string(cfg.Data), config.RedactedValue, "GetConfig should redact credentials")
+	for _, d := range []*gctrpc.DiffConfigRequest{{FromVersion: 1, ToVersion: 2}, {FromVersion: 1}, {ToVersion: 2}} {
+		diff, err := s.DiffConfig(t.Context(), d)
+		require.NoError(t, err, "DiffConfig must not error")
+		if d.ToVersion == 2 {
+			assert.Contains(t, diff.Patch, config.RedactedValue, "DiffConfig should redact changed credentials")
+		}
+		for _, secret := range secrets {
+			assert.NotContainsf(t, diff.Patch, secret, "DiffConfig %d to %d should not leak credentials", d.FromVersion, d.ToVersion)
+			assert.NotContains(t, string(cfg.Data), secret, "GetConfig should not leak credentials")
+		}
+	}
+}
+
+func TestGCTScriptExecuteDebug(t *testing.T) {
+	m, err := gctscript.NewManager(&gctscript.Config{Enabled: true, ScriptTimeout: time.Minute, MaxVirtualMachines: 10})
+	require.NoError(t, err, "NewManager must not error")
+	s := RPCServer{Engine: &Engine{gctScriptManager: m}}
+	req := &gctrpc.GCTScriptExecuteRequest{
+		Script:      &gctrpc.GCTScript{Name: "debug.gct", Path: filepath.Join("..", "testdata", "gctscript")},
+		Debug:       true,
+		Breakpoints: []string{"exchange.ordersubmit"},
+	}
+	resp, err := s.GCTScriptExecute(t.Context(), req)
+	require.NoError(t, err, "GCTScriptExecute must not error")
+	assert.Equal(t, gctscript.ErrScriptingDisabled.Error(), resp.Status)
+
+	var wg sync.WaitGroup
+	require.NoError(t, m.Start(&wg), "Start must not error")
+	defer func() {
+		assert.NoError(t, m.Stop(), "Stop should not error")
+		wg.Wait()
+	}()
+	resp, err = s.GCTScriptExecute(t.Context(), req)
+	require.NoError(t, err, "GCTScriptExecute must not error")
+	assert.Equal(t, MsgStatusError, resp.Status, "GCTScriptExecute should return an error status when a breakpoint is hit")
+	assert.Contains(t, resp.Data, gct.ErrBreakpoint.Error())
+	require.Len(t, resp.Trace, 2, "GCTScriptExecute must return the trace")
+	assert.Equal(t, "set_verbose", resp.Trace[0].Function)
+	assert.True(t, resp.Trace[1].Breakpoint, "GCTScriptExecute should mark the breakpoint call")
+}
@@ -9,10 +9,13 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 const (
@@ -61,6 +64,24 @@ type iOrderManager interface {
 	UpdateExistingOrder(*order.Detail) error
 }
 
+// iFuturesPositionManager limits exposure of accessible functions to the order
+// manager's futures position tracking
+type iFuturesPositionManager interface {
+	IsRunning() bool
+	GetAllOpenFuturesPositions() ([]futures.Position, error)
+}
+
+// iWithdrawManager limits exposure of accessible functions to withdraw manager
+type iWithdrawManager interface {
+	SubmitWithdrawal(context.Context, *withdraw.Request) (*withdraw.Response, error)
+}
+
+// iDepositAddressManager limits exposure of accessible functions to deposit
+// address manager
+type iDepositAddressManager interface {
+	GetDepositAddressByExchangeAndCurrency(exchName, chain string, currencyItem currency.Code) (deposit.Address, error)
+}
+
 // iPortfolioManager limits exposure of accessible functions to portfolio manager
 type iPortfolioManager interface {
 	GetPortfolioSummary() portfolio.Summary
@@ -80,3 +101,10 @@ type iCurrencyPairSyncer interface {
 type iDatabaseConnectionManager interface {
 	GetInstance() database.IDatabase
 }
+
+// iDataHistoryJobManager limits exposure of accessible functions to the data
+// history manager
+type iDataHistoryJobManager interface {
+	IsRunning() bool
+	UpsertJob(*DataHistoryJob, bool) error
+}
@@ -860,6 +860,29 @@ func (m *SyncManager) PrintOrderbookSummary(result *orderbook.Book, protocol str
 	)
 }
 
+// lastUpdated returns when each synced pair last updated the sync item. Items
+// which are currently being fetched are skipped rather than waiting for the
+// fetch to complete
+func (m *SyncManager) lastUpdated(item syncItemType) map[key.ExchangeAssetPair]time.Time {
+	m.mux.Lock()
+	agents := make([]*currencyPairSyncAgent, 0, len(m.currencyPairs))
+	for _, c := range m.currencyPairs {
+		agents = append(agents, c)
+	}
+	m.mux.Unlock()
+	updated := make(map[key.ExchangeAssetPair]time.Time, len(agents))
+	for _, c := range agents {
+		if c.trackers[item] == nil || !c.locks[item].TryLock() {
+			continue
+		}
+		if c.trackers[item].HaveData {
+			updated[c.Key] = c.trackers[item].LastUpdated
+		}
+		c.locks[item].Unlock()
+	}
+	return updated
+}
+
 // WaitForInitialSync allows for a routine to wait for an initial sync to be
 // completed without exposing the underlying type. This needs to be called in a
 // separate routine.
@@ -0,0 +1,123 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	gctlog "github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const tracingShutdownTimeout = 5 * time.Second
+
+// setupTracing creates the configured OpenTelemetry exporter and sets the
+// global tracer provider so spans are recorded across gRPC calls, the order
+// manager and exchange requests
+func (bot *Engine) setupTracing() error {
+	cfg := &bot.Config.Tracing
+	exp, f, err := bot.newTracingExporter(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to setup tracing: %w", err)
+	}
+	// Traces started by a remote traceparent follow the caller's sampling
+	// decision
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(cfg.ServiceName))),
+	)
+	bot.tracingProvider = tp
+	bot.tracingFile = f
+	otel.SetTracerProvider(tp)
+	gctlog.Debugf(gctlog.Global, "Tracing enabled using %s exporter with sample ratio %v\n", cfg.Exporter, cfg.SampleRatio)
+	return nil
+}
+
+// newTracingExporter returns the span exporter for the configured exporter
+// type, and the file it writes to for the file exporter
+func (bot *Engine) newTracingExporter(cfg *config.Tracing) (sdktrace.SpanExporter, *os.File, error) {
+	switch cfg.Exporter {
+	case config.TracingExporterOTLP, config.TracingExporterOTLPGRPC:
+		u, err := url.Parse(cfg.Endpoint)
+		if err != nil {
+			return nil, nil, err
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, nil, fmt.Errorf("endpoint %q must be an absolute http or https URL", cfg.Endpoint)
+		}
+		if cfg.Exporter == config.TracingExporterOTLPGRPC {
+			exp, err := otlptracegrpc.New(context.Background(), otlptracegrpc.WithEndpointURL(cfg.Endpoint), otlptracegrpc.WithHeaders(cfg.Headers))
+			return exp, nil, err
+		}
+		exp, err := otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(cfg.Endpoint), otlptracehttp.WithHeaders(cfg.Headers))
+		return exp, nil, err
+	case config.TracingExporterStdout:
+		exp, err := stdouttrace.New()
+		return exp, nil, err
+	case config.TracingExporterFile:
+		path := cfg.FilePath
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(bot.Settings.DataDir, path)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), file.DefaultPermissionOctal); err != nil {
+			return nil, nil, err
+		}
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, nil, err
+		}
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			return nil, nil, common.AppendError(err, f.Close())
+		}
+		return exp, f, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported exporter %q", cfg.Exporter)
+	}
+}
+
+// shutdownTracing disables tracing and exports any queued spans
+func (bot *Engine) shutdownTracing() {
+	if bot.tracingProvider == nil {
+		return
+	}
+	otel.SetTracerProvider(noop.NewTracerProvider())
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	if err := bot.tracingProvider.Shutdown(ctx); err != nil {
+		gctlog.Errorf(gctlog.Global, "Tracing unable to shutdown. Error: %v", err)
+	}
+	if bot.tracingFile != nil {
+		if err := bot.tracingFile.Close(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tracing unable to close file. Error: %v", err)
+		}
+	}
+	bot.tracingProvider = nil
+	bot.tracingFile = nil
+}
+
+// proxyHeaderMatcher forwards the W3C traceparent header from gRPC proxy
+// requests so REST callers can join their traces to the gRPC server spans
+func proxyHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, tracing.TraceParentHeader) {
+		return tracing.TraceParentHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
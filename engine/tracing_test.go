@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+// TestSetupTracing sets the global tracer provider so must not run in
+// parallel
+func TestSetupTracing(t *testing.T) {
+	bot := &Engine{Config: &config.Config{}, Settings: Settings{DataDir: t.TempDir()}}
+	bot.Config.Tracing = config.Tracing{Exporter: "bad"}
+	require.Error(t, bot.setupTracing(), "setupTracing must error on an unknown exporter")
+	assert.Nil(t, bot.tracingProvider, "tracingProvider should not be set on error")
+
+	bot.Config.Tracing = config.Tracing{Exporter: config.TracingExporterOTLP, Endpoint: "localhost:4318"}
+	require.Error(t, bot.setupTracing(), "setupTracing must error on a relative OTLP endpoint")
+
+	bot.Config.Tracing = config.Tracing{Exporter: config.TracingExporterOTLPGRPC, Endpoint: "http://localhost:4317", SampleRatio: 1}
+	require.NoError(t, bot.setupTracing(), "setupTracing must not error for the OTLP/gRPC exporter")
+	bot.shutdownTracing()
+
+	bot.Config.Tracing = config.Tracing{Exporter: config.TracingExporterFile, FilePath: "traces.jsonl", ServiceName: "gct-test", SampleRatio: 1}
+	require.NoError(t, bot.setupTracing(), "setupTracing must not error")
+	_, span := tracing.Start(t.Context(), "test")
+	assert.True(t, span.IsRecording(), "spans should be recorded once tracing is setup")
+	span.End()
+	bot.shutdownTracing()
+	assert.Nil(t, bot.tracingProvider, "tracingProvider should be cleared after shutdown")
+	_, span = tracing.Start(t.Context(), "disabled")
+	assert.False(t, span.IsRecording(), "spans should not be recorded after shutdown")
+
+	b, err := os.ReadFile(filepath.Join(bot.Settings.DataDir, "traces.jsonl"))
+	require.NoError(t, err, "ReadFile must not error")
+	assert.Contains(t, string(b), `"Name":"test"`, "span should be exported to the data directory file")
+	assert.Contains(t, string(b), "gct-test", "service name should be exported")
+	assert.NotContains(t, string(b), `"Name":"disabled"`, "spans started after shutdown should not be exported")
+}
+
+func TestProxyHeaderMatcher(t *testing.T) {
+	t.Parallel()
+	k, ok := proxyHeaderMatcher("Traceparent")
+	assert.True(t, ok, "traceparent should be forwarded")
+	assert.Equal(t, tracing.TraceParentHeader, k)
+	_, ok = proxyHeaderMatcher("X-Unknown")
+	assert.False(t, ok, "unknown headers should not be forwarded")
+}
@@ -0,0 +1,639 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// WithStrategy tags a context with a strategy name so that orders submitted
+// through the order manager with it can be grouped by strategy in transaction
+// cost analysis
+func WithStrategy(ctx context.Context, strategy string) context.Context {
+	return context.WithValue(ctx, strategyContextKey{}, strategy)
+}
+
+// strategyFromContext returns the strategy tag of a context if set
+func strategyFromContext(ctx context.Context) string {
+	strategy, _ := ctx.Value(strategyContextKey{}).(string)
+	return strategy
+}
+
+func newTransactionCostAnalyser() *transactionCostAnalyser {
+	return &transactionCostAnalyser{arrivals: make(map[string]*ArrivalPrice), postTradeDelay: defaultPostTradeMidDelay}
+}
+
+// setPostTradeDelay sets how long after an order's last fill the post trade
+// mid price is sampled. Zero uses the default delay
+func (t *transactionCostAnalyser) setPostTradeDelay(delay time.Duration) {
+	if t == nil {
+		return
+	}
+	if delay <= 0 {
+		delay = defaultPostTradeMidDelay
+	}
+	t.m.Lock()
+	t.postTradeDelay = delay
+	t.m.Unlock()
+}
+
+// arrivalKey returns the key an order's arrival price is stored under. Orders
+// are keyed by exchange and order ID as internal order IDs are regenerated
+// when orders are reloaded from an exchange after a restart.
+func arrivalKey(exch, orderID string) string {
+	return strings.ToLower(exch) + " " + orderID
+}
+
+// isTerminalOrderStatus returns whether an order can no longer receive fills
+func isTerminalOrderStatus(s order.Status) bool {
+	return s != order.UnknownStatus && s != order.AnyStatus && s != order.Cancelling && s.IsInactive()
+}
+
+// load reads persisted arrival prices and completed transaction costs and
+// enables persistence of any further changes to the store path
+func (t *transactionCostAnalyser) load(storePath string) error {
+	if t == nil {
+		return fmt.Errorf("%w transactionCostAnalyser", common.ErrNilPointer)
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	t.storePath = storePath
+	data, err := os.ReadFile(storePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	var stored transactionCostStore
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return fmt.Errorf("unable to load transaction costs from %s: %w", storePath, err)
+	}
+	for _, arrival := range stored.Arrivals {
+		if arrival == nil || arrival.OrderID == "" {
+			continue
+		}
+		t.arrivals[arrivalKey(arrival.Exchange, arrival.OrderID)] = arrival
+	}
+	t.completed = stored.Completed
+	t.dirty = false
+	return nil
+}
+
+// flush persists arrival prices and completed transaction costs if they have
+// changed since they were last persisted and a store path is set. The state
+// is copied under the lock and written without it so order updates are not
+// blocked on disk I/O.
+func (t *transactionCostAnalyser) flush() error {
+	if t == nil {
+		return nil
+	}
+	t.m.Lock()
+	if !t.dirty || t.storePath == "" {
+		t.m.Unlock()
+		return nil
+	}
+	path := t.storePath
+	// Pairs are stored delimited as undelimited pairs cannot be unmarshalled
+	stored := transactionCostStore{
+		Arrivals:  make([]*ArrivalPrice, 0, len(t.arrivals)),
+		Completed: make([]OrderTransactionCost, len(t.completed)),
+	}
+	for _, arrival := range t.arrivals {
+		a := *arrival
+		a.Pair = delimitedPair(a.Pair)
+		stored.Arrivals = append(stored.Arrivals, &a)
+	}
+	for i := range t.completed {
+		stored.Completed[i] = t.completed[i]
+		stored.Completed[i].Pair = delimitedPair(t.completed[i].Pair)
+	}
+	t.dirty = false
+	t.m.Unlock()
+
+	sort.Slice(stored.Arrivals, func(i, j int) bool {
+		return stored.Arrivals[i].Timestamp.Before(stored.Arrivals[j].Timestamp)
+	})
+	data, err := json.MarshalIndent(stored, "", " ")
+	if err == nil {
+		err = file.Write(path, data)
+	}
+	if err != nil {
+		t.m.Lock()
+		t.dirty = true
+		t.m.Unlock()
+	}
+	return err
+}
+
+// delimitedPair returns the pair with a delimiter so it can be unmarshalled
+func delimitedPair(p currency.Pair) currency.Pair {
+	if p.IsEmpty() || p.Delimiter != "" {
+		return p
+	}
+	p.Delimiter = currency.DashDelimiter
+	return p
+}
+
+// record stores the arrival price for a submitted order and prunes arrival
+// prices of orders which have not reached a terminal status within the
+// retention period
+func (t *transactionCostAnalyser) record(orderID string, arrival *ArrivalPrice) {
+	if t == nil || arrival == nil || orderID == "" {
+		return
+	}
+	arrival.OrderID = orderID
+	t.m.Lock()
+	defer t.m.Unlock()
+	for k, a := range t.arrivals {
+		if time.Since(a.Timestamp) > arrivalPriceRetention {
+			delete(t.arrivals, k)
+		}
+	}
+	t.arrivals[arrivalKey(arrival.Exchange, orderID)] = arrival
+	t.dirty = true
+}
+
+// get returns a copy of the arrival price stored for an open order
+func (t *transactionCostAnalyser) get(exch, orderID string) (ArrivalPrice, error) {
+	if t == nil {
+		return ArrivalPrice{}, errNoArrivalPrice
+	}
+	t.m.RLock()
+	defer t.m.RUnlock()
+	arrival, ok := t.arrivals[arrivalKey(exch, orderID)]
+	if !ok {
+		return ArrivalPrice{}, errNoArrivalPrice
+	}
+	return *arrival, nil
+}
+
+// getCompleted returns a copy of the transaction costs of orders which have
+// reached a terminal status
+func (t *transactionCostAnalyser) getCompleted() []OrderTransactionCost {
+	if t == nil {
+		return nil
+	}
+	t.m.RLock()
+	defer t.m.RUnlock()
+	return slices.Clone(t.completed)
+}
+
+// observe updates the arrival price of an order from its latest state. The
+// time of each new fill is recorded so the post trade mid price can be sampled
+// the post trade delay after it. Once the order reaches a terminal status its
+// transaction cost is finalised and its arrival price is pruned.
+func (t *transactionCostAnalyser) observe(d *order.Detail) {
+	if t == nil || d == nil {
+		return
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	k := arrivalKey(d.Exchange, d.OrderID)
+	arrival, ok := t.arrivals[k]
+	if !ok {
+		return
+	}
+	if _, executed, _ := getFillDetails(d); executed > arrival.FilledAmount {
+		arrival.FilledAmount = executed
+		arrival.LastFillTimestamp = time.Now()
+		arrival.PostTradeMid = 0
+		arrival.PostTradeTimestamp = time.Time{}
+		t.dirty = true
+	}
+	if isTerminalOrderStatus(d.Status) {
+		delete(t.arrivals, k)
+		if tc, err := calculateOrderTransactionCost(d, arrival, arrival.PostTradeMid); err == nil {
+			tc.LastFillAt = arrival.LastFillTimestamp
+			t.completed = append(t.completed, *tc)
+			if len(t.completed) > maxCompletedTransactionCosts {
+				t.completed = slices.Clone(t.completed[len(t.completed)-maxCompletedTransactionCosts:])
+			}
+		}
+		t.dirty = true
+	}
+}
+
+// samplePostTradeMids samples the mid price of orders whose last fill was at
+// least the post trade delay ago and have not been sampled since. Orders which
+// cannot be sampled within the sample window are left without a post trade
+// mid price rather than being measured against a later market.
+func (t *transactionCostAnalyser) samplePostTradeMids(em iExchangeManager, now time.Time) {
+	if t == nil || em == nil {
+		return
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	due := func(lastFill time.Time, postTradeMid float64) bool {
+		if lastFill.IsZero() || postTradeMid > 0 {
+			return false
+		}
+		since := now.Sub(lastFill)
+		return since >= t.postTradeDelay && since < t.postTradeDelay+postTradeMidSampleWindow
+	}
+	sample := func(exchName string, pair currency.Pair, a asset.Item) (float64, bool) {
+		exch, err := em.GetExchangeByName(exchName)
+		if err != nil {
+			return 0, false
+		}
+		mid, _, _, _, err := getCachedMarketPrices(exch, pair, a)
+		return mid, err == nil
+	}
+	for _, arrival := range t.arrivals {
+		if !due(arrival.LastFillTimestamp, arrival.PostTradeMid) {
+			continue
+		}
+		if mid, ok := sample(arrival.Exchange, arrival.Pair, arrival.Asset); ok {
+			arrival.PostTradeMid = mid
+			arrival.PostTradeTimestamp = now
+			t.dirty = true
+		}
+	}
+	for i := range t.completed {
+		tc := &t.completed[i]
+		if !due(tc.LastFillAt, tc.PostTradeMid) {
+			continue
+		}
+		if mid, ok := sample(tc.Exchange, tc.Pair, tc.Asset); ok {
+			if err := tc.applyPostTradeMid(mid); err == nil {
+				t.dirty = true
+			}
+		}
+	}
+}
+
+// observeTransactionCost passes the latest state of a managed order to
+// transaction cost analysis
+func (m *OrderManager) observeTransactionCost(d *order.Detail) {
+	m.tca.observe(d)
+}
+
+// captureArrivalPrice snapshots the cached market prices for an order about to
+// be submitted. The orderbook is preferred over the ticker as it is generally
+// updated more frequently.
+func captureArrivalPrice(exch exchange.IBotExchange, s *order.Submit, strategy string) (*ArrivalPrice, error) {
+	mid, bid, ask, source, err := getCachedMarketPrices(exch, s.Pair, s.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	return &ArrivalPrice{
+		Exchange:  exch.GetName(),
+		Asset:     s.AssetType,
+		Pair:      s.Pair,
+		Strategy:  strategy,
+		Side:      s.Side,
+		Amount:    s.Amount,
+		Mid:       mid,
+		Bid:       bid,
+		Ask:       ask,
+		Source:    source,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// getCachedMarketPrices returns the mid, best bid and best ask from the cached
+// orderbook, falling back to the cached ticker
+func getCachedMarketPrices(exch exchange.IBotExchange, pair currency.Pair, a asset.Item) (mid, bid, ask float64, source string, err error) {
+	if depth, err := orderbook.GetDepth(exch.GetName(), pair, a); err == nil {
+		bid, bidErr := depth.GetBestBid()
+		ask, askErr := depth.GetBestAsk()
+		if bidErr == nil && askErr == nil && bid > 0 && ask > 0 {
+			return (bid + ask) / 2, bid, ask, arrivalSourceOrderbook, nil
+		}
+	}
+	tick, err := exch.GetCachedTicker(pair, a)
+	if err != nil {
+		return 0, 0, 0, "", fmt.Errorf("%s %s %s %w: %w", exch.GetName(), a, pair, errNoMarketPriceForPair, err)
+	}
+	switch {
+	case tick.Bid > 0 && tick.Ask > 0:
+		return (tick.Bid + tick.Ask) / 2, tick.Bid, tick.Ask, arrivalSourceTicker, nil
+	case tick.Last > 0:
+		return tick.Last, tick.Bid, tick.Ask, arrivalSourceTicker, nil
+	}
+	return 0, 0, 0, "", fmt.Errorf("%s %s %s %w", exch.GetName(), a, pair, errNoMarketPriceForPair)
+}
+
+// getFillDetails returns the volume weighted average fill price, the executed
+// amount and the fees in quote currency for an order. Trade level data is
+// preferred when available.
+func getFillDetails(d *order.Detail) (averagePrice, executed, fees float64) {
+	var notional float64
+	for i := range d.Trades {
+		notional += d.Trades[i].Price * d.Trades[i].Amount
+		executed += d.Trades[i].Amount
+		fees += feeInQuote(d.Trades[i].Fee, currency.NewCode(d.Trades[i].FeeAsset), d.Pair, d.Trades[i].Price)
+	}
+	if executed > 0 {
+		return notional / executed, executed, fees
+	}
+	averagePrice = d.AverageExecutedPrice
+	if averagePrice == 0 {
+		averagePrice = d.Price
+	}
+	return averagePrice, d.ExecutedAmount, feeInQuote(d.Fee, d.FeeAsset, d.Pair, averagePrice)
+}
+
+// feeInQuote converts fees charged in the base currency to the quote currency.
+// Fees without a currency are assumed to be charged in quote.
+func feeInQuote(fee float64, feeAsset currency.Code, pair currency.Pair, price float64) float64 {
+	if !feeAsset.IsEmpty() && feeAsset.Equal(pair.Base) {
+		return fee * price
+	}
+	return fee
+}
+
+// sideDirection returns 1 for buying sides and -1 for selling sides so that
+// adverse price moves are always expressed as a positive cost
+func sideDirection(s order.Side) (float64, error) {
+	switch {
+	case s.IsLong():
+		return 1, nil
+	case s.IsShort():
+		return -1, nil
+	}
+	return 0, fmt.Errorf("%w %v", order.ErrSideIsInvalid, s)
+}
+
+// calculateOrderTransactionCost derives the transaction costs of an order
+// against its arrival price. A postTradeMid of zero indicates that no market
+// price has been sampled after the order's last fill, so realised spread
+// cannot be measured and no opportunity cost is attributed to any unfilled
+// amount.
+func calculateOrderTransactionCost(d *order.Detail, arrival *ArrivalPrice, postTradeMid float64) (*OrderTransactionCost, error) {
+	if d == nil {
+		return nil, errNilOrder
+	}
+	if arrival == nil {
+		return nil, errNoArrivalPrice
+	}
+	if arrival.Mid <= 0 {
+		return nil, fmt.Errorf("%w %v", errInvalidArrivalPrice, arrival.Mid)
+	}
+	direction, err := sideDirection(d.Side)
+	if err != nil {
+		return nil, err
+	}
+	averageFill, executed, fees := getFillDetails(d)
+	if executed <= 0 || averageFill <= 0 {
+		return nil, fmt.Errorf("%s %s %w", d.Exchange, d.OrderID, errNoExecutedAmount)
+	}
+
+	amount := d.Amount
+	if amount < executed {
+		amount = executed
+	}
+	notional := averageFill * executed
+	shortfall := direction*(averageFill-arrival.Mid)*executed + fees
+
+	tc := &OrderTransactionCost{
+		Exchange:                   d.Exchange,
+		Asset:                      d.AssetType,
+		Pair:                       d.Pair,
+		Strategy:                   arrival.Strategy,
+		OrderID:                    d.OrderID,
+		InternalOrderID:            d.InternalOrderID.String(),
+		Side:                       d.Side,
+		Status:                     d.Status,
+		SubmittedAt:                arrival.Timestamp,
+		Amount:                     amount,
+		ExecutedAmount:             executed,
+		ArrivalPrice:               arrival.Mid,
+		ArrivalSource:              arrival.Source,
+		AverageFill:                averageFill,
+		Notional:                   notional,
+		Fees:                       fees,
+		ArrivalSlippageBPS:         direction * (averageFill - arrival.Mid) / arrival.Mid * basisPoints,
+		ImplementationShortfall:    shortfall,
+		ImplementationShortfallBPS: shortfall / (arrival.Mid * amount) * basisPoints,
+		FeeDragBPS:                 fees / notional * basisPoints,
+	}
+	if postTradeMid > 0 {
+		if err := tc.applyPostTradeMid(postTradeMid); err != nil {
+			return nil, err
+		}
+	}
+	return tc, nil
+}
+
+// applyPostTradeMid measures realised spread against the mid price sampled
+// after the order's last fill and attributes the opportunity cost of any
+// unfilled amount to the implementation shortfall
+func (tc *OrderTransactionCost) applyPostTradeMid(postTradeMid float64) error {
+	direction, err := sideDirection(tc.Side)
+	if err != nil {
+		return err
+	}
+	tc.PostTradeMid = postTradeMid
+	tc.ImplementationShortfall += direction * (postTradeMid - tc.ArrivalPrice) * (tc.Amount - tc.ExecutedAmount)
+	tc.ImplementationShortfallBPS = tc.ImplementationShortfall / (tc.ArrivalPrice * tc.Amount) * basisPoints
+	tc.RealisedSpreadBPS = 2 * direction * (tc.AverageFill - postTradeMid) / postTradeMid * basisPoints
+	return nil
+}
+
+// aggregateTransactionCosts groups order transaction costs by the requested
+// grouping and weights basis point values by notional
+func aggregateTransactionCosts(costs []OrderTransactionCost, group string) []TransactionCostAggregate {
+	aggregates := make(map[string]*TransactionCostAggregate)
+	var keys []string
+	for i := range costs {
+		var k string
+		switch group {
+		case TCAGroupExchange:
+			k = costs[i].Exchange
+		case TCAGroupPair:
+			k = costs[i].Exchange + " " + costs[i].Asset.String() + " " + costs[i].Pair.String()
+		case TCAGroupStrategy:
+			k = costs[i].Strategy
+		}
+		agg, ok := aggregates[k]
+		if !ok {
+			agg = &TransactionCostAggregate{Group: group, Key: k}
+			aggregates[k] = agg
+			keys = append(keys, k)
+		}
+		agg.Orders++
+		agg.Notional += costs[i].Notional
+		agg.Fees += costs[i].Fees
+		agg.ImplementationShortfall += costs[i].ImplementationShortfall
+		agg.ArrivalSlippageBPS += costs[i].ArrivalSlippageBPS * costs[i].Notional
+		agg.ImplementationShortfallBPS += costs[i].ImplementationShortfallBPS * costs[i].Notional
+		agg.RealisedSpreadBPS += costs[i].RealisedSpreadBPS * costs[i].Notional
+		agg.FeeDragBPS += costs[i].FeeDragBPS * costs[i].Notional
+	}
+	sort.Strings(keys)
+	resp := make([]TransactionCostAggregate, len(keys))
+	for i := range keys {
+		agg := aggregates[keys[i]]
+		if agg.Notional > 0 {
+			agg.ArrivalSlippageBPS /= agg.Notional
+			agg.ImplementationShortfallBPS /= agg.Notional
+			agg.RealisedSpreadBPS /= agg.Notional
+			agg.FeeDragBPS /= agg.Notional
+		}
+		resp[i] = *agg
+	}
+	return resp
+}
+
+// matches checks whether an order's transaction cost matches the filter
+func (f *TransactionCostFilter) matches(tc *OrderTransactionCost) bool {
+	if f == nil {
+		return true
+	}
+	if f.Exchange != "" && !strings.EqualFold(f.Exchange, tc.Exchange) {
+		return false
+	}
+	if f.Asset != asset.Empty && f.Asset != tc.Asset {
+		return false
+	}
+	if !f.Pair.IsEmpty() && !f.Pair.Equal(tc.Pair) {
+		return false
+	}
+	if f.Strategy != "" && !strings.EqualFold(f.Strategy, tc.Strategy) {
+		return false
+	}
+	if !f.StartDate.IsZero() && tc.SubmittedAt.Before(f.StartDate) {
+		return false
+	}
+	if !f.EndDate.IsZero() && tc.SubmittedAt.After(f.EndDate) {
+		return false
+	}
+	return true
+}
+
+// GetTransactionCostAnalysis reports transaction costs for all orders that
+// were submitted through the order manager and have fills, then aggregates
+// them per exchange, pair and strategy. Orders which have reached a terminal
+// status are reported from their finalised costs, open orders from their
+// current state.
+func (m *OrderManager) GetTransactionCostAnalysis(f *TransactionCostFilter) (*TransactionCostReport, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+
+	report := &TransactionCostReport{GeneratedAt: time.Now()}
+	completed := m.tca.getCompleted()
+	for i := range completed {
+		if f.matches(&completed[i]) {
+			report.Orders = append(report.Orders, completed[i])
+		}
+	}
+	for _, orders := range m.orderStore.get() {
+		for _, d := range orders {
+			arrival, err := m.tca.get(d.Exchange, d.OrderID)
+			if err != nil {
+				continue
+			}
+			tc, err := calculateOrderTransactionCost(d, &arrival, arrival.PostTradeMid)
+			if err != nil || !f.matches(tc) {
+				continue
+			}
+			report.Orders = append(report.Orders, *tc)
+		}
+	}
+	if len(report.Orders) == 0 {
+		return nil, errNoTCAOrders
+	}
+	sort.Slice(report.Orders, func(i, j int) bool {
+		return report.Orders[i].SubmittedAt.Before(report.Orders[j].SubmittedAt)
+	})
+	report.ByExchange = aggregateTransactionCosts(report.Orders, TCAGroupExchange)
+	report.ByPair = aggregateTransactionCosts(report.Orders, TCAGroupPair)
+	report.ByStrategy = aggregateTransactionCosts(report.Orders, TCAGroupStrategy)
+	return report, nil
+}
+
+// GenerateHTMLReport renders the transaction cost report to an HTML file in
+// the output directory and returns the file path
+func (r *TransactionCostReport) GenerateHTMLReport(outputDir string) (string, error) {
+	if r == nil {
+		return "", fmt.Errorf("%w TransactionCostReport", common.ErrNilPointer)
+	}
+	if outputDir == "" {
+		return "", errReportDirectoryUnset
+	}
+	tmpl, err := template.New("tca").Funcs(template.FuncMap{
+		"bps": func(v float64) string { return fmt.Sprintf("%.2f", v) },
+		"aggregateTable": func(title string, rows []TransactionCostAggregate) any {
+			return struct {
+				Title string
+				Rows  []TransactionCostAggregate
+			}{Title: title, Rows: rows}
+		},
+	}).Parse(tcaReportTemplate)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, r); err != nil {
+		return "", err
+	}
+	path := filepath.Join(outputDir, "tca-"+r.GeneratedAt.UTC().Format("2006-01-02-15-04-05")+".html")
+	if err := file.Write(path, buf.Bytes()); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+const tcaReportTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+	<title>GoCryptoTrader Transaction Cost Analysis</title>
+	<link rel="icon" href="https://raw.githubusercontent.com/thrasher-corp/gocryptotrader/master/docs/assets/gctlogo-notext.svg" />
+	<link href="https://cdnjs.cloudflare.com/ajax/libs/mdb-ui-kit/3.6.0/mdb.min.css" rel="stylesheet" />
+</head>
+<body>
+<div class="container-fluid">
+	<h2>Transaction Cost Analysis</h2>
+	<p>Generated {{.GeneratedAt.UTC.Format "2006-01-02 15:04:05 MST"}}. Basis point values are positive when they represent a cost.</p>
+	{{- define "aggregates"}}
+	<table class="table table-sm table-striped">
+		<thead><tr><th>{{.Title}}</th><th>Orders</th><th>Notional</th><th>Fees</th><th>Shortfall</th><th>Arrival Slippage (bps)</th><th>Shortfall (bps)</th><th>Realised Spread (bps)</th><th>Fee Drag (bps)</th></tr></thead>
+		<tbody>
+		{{- range .Rows}}
+		<tr><td>{{.Key}}</td><td>{{.Orders}}</td><td>{{.Notional}}</td><td>{{.Fees}}</td><td>{{.ImplementationShortfall}}</td><td>{{bps .ArrivalSlippageBPS}}</td><td>{{bps .ImplementationShortfallBPS}}</td><td>{{bps .RealisedSpreadBPS}}</td><td>{{bps .FeeDragBPS}}</td></tr>
+		{{- end}}
+		</tbody>
+	</table>
+	{{- end}}
+	<h4>By Exchange</h4>
+	{{template "aggregates" (aggregateTable "Exchange" .ByExchange)}}
+	<h4>By Pair</h4>
+	{{template "aggregates" (aggregateTable "Pair" .ByPair)}}
+	<h4>By Strategy</h4>
+	{{template "aggregates" (aggregateTable "Strategy" .ByStrategy)}}
+	<h4>Orders</h4>
+	<table class="table table-sm table-striped">
+		<thead><tr><th>Submitted</th><th>Exchange</th><th>Asset</th><th>Pair</th><th>Strategy</th><th>Order ID</th><th>Side</th><th>Status</th><th>Executed</th><th>Arrival</th><th>Average Fill</th><th>Post Trade Mid</th><th>Fees</th><th>Arrival Slippage (bps)</th><th>Shortfall (bps)</th><th>Realised Spread (bps)</th><th>Fee Drag (bps)</th></tr></thead>
+		<tbody>
+		{{- range .Orders}}
+		<tr><td>{{.SubmittedAt.UTC.Format "2006-01-02 15:04:05"}}</td><td>{{.Exchange}}</td><td>{{.Asset}}</td><td>{{.Pair}}</td><td>{{.Strategy}}</td><td>{{.OrderID}}</td><td>{{.Side}}</td><td>{{.Status}}</td><td>{{.ExecutedAmount}}/{{.Amount}}</td><td>{{.ArrivalPrice}} ({{.ArrivalSource}})</td><td>{{.AverageFill}}</td><td>{{.PostTradeMid}}</td><td>{{.Fees}}</td><td>{{bps .ArrivalSlippageBPS}}</td><td>{{bps .ImplementationShortfallBPS}}</td><td>{{bps .RealisedSpreadBPS}}</td><td>{{bps .FeeDragBPS}}</td></tr>
+		{{- end}}
+		</tbody>
+	</table>
+</div>
+</body>
+</html>
+`
@@ -0,0 +1,343 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestWithStrategy(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, strategyFromContext(t.Context()), "strategyFromContext should return empty for untagged context")
+	assert.Equal(t, "dca", strategyFromContext(WithStrategy(t.Context(), "dca")), "strategyFromContext should return the tagged strategy")
+}
+
+func TestTransactionCostAnalyserRecord(t *testing.T) {
+	t.Parallel()
+	var nilTCA *transactionCostAnalyser
+	nilTCA.record("1337", &ArrivalPrice{})
+	_, err := nilTCA.get(testExchange, "1337")
+	assert.ErrorIs(t, err, errNoArrivalPrice)
+
+	tca := newTransactionCostAnalyser()
+	_, err = tca.get(testExchange, "1337")
+	assert.ErrorIs(t, err, errNoArrivalPrice)
+
+	tca.record("", &ArrivalPrice{Mid: 1})
+	assert.Empty(t, tca.arrivals, "record should ignore empty order IDs")
+
+	tca.record("stale", &ArrivalPrice{Exchange: testExchange, Mid: 1, Timestamp: time.Now().Add(-arrivalPriceRetention - time.Minute)})
+	tca.record("1337", &ArrivalPrice{Exchange: testExchange, Mid: 1337, Timestamp: time.Now()})
+	arrival, err := tca.get(strings.ToUpper(testExchange), "1337")
+	require.NoError(t, err)
+	assert.Equal(t, 1337.0, arrival.Mid)
+	assert.Equal(t, "1337", arrival.OrderID)
+
+	tca.record("1338", &ArrivalPrice{Exchange: testExchange, Mid: 1338, Timestamp: time.Now()})
+	_, err = tca.get(testExchange, "stale")
+	assert.ErrorIs(t, err, errNoArrivalPrice, "record should prune arrival prices past retention")
+}
+
+func TestTransactionCostAnalyserObserve(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	em := m.orderStore.exchangeManager
+
+	p := currency.NewPair(currency.NewCode("TCA"), currency.USDT)
+	tca := newTransactionCostAnalyser()
+	tca.record("1337", &ArrivalPrice{Exchange: testExchange, Asset: asset.Spot, Pair: p, Mid: 1330, Timestamp: time.Now()})
+
+	d := &order.Detail{Exchange: testExchange, OrderID: "1337", Pair: p, AssetType: asset.Spot, Side: order.Buy, Amount: 2, Status: order.Open}
+	tca.observe(d)
+	arrival, err := tca.get(testExchange, "1337")
+	require.NoError(t, err)
+	assert.True(t, arrival.LastFillTimestamp.IsZero(), "LastFillTimestamp should not be set without fills")
+
+	d.Status = order.PartiallyFilled
+	d.ExecutedAmount = 1
+	d.AverageExecutedPrice = 1340
+	tca.observe(d)
+	arrival, err = tca.get(testExchange, "1337")
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, arrival.FilledAmount)
+	assert.Zero(t, arrival.PostTradeMid, "PostTradeMid should not be captured at fill time")
+	tca.samplePostTradeMids(em, arrival.LastFillTimestamp.Add(defaultPostTradeMidDelay-time.Second))
+	arrival, err = tca.get(testExchange, "1337")
+	require.NoError(t, err)
+	assert.Zero(t, arrival.PostTradeMid, "PostTradeMid should not be sampled before the post trade delay")
+	tca.samplePostTradeMids(em, arrival.LastFillTimestamp.Add(defaultPostTradeMidDelay))
+	arrival, err = tca.get(testExchange, "1337")
+	require.NoError(t, err)
+	assert.Equal(t, 1337.0, arrival.PostTradeMid, "PostTradeMid should be sampled after the post trade delay")
+	assert.Empty(t, tca.getCompleted())
+
+	d.Status = order.Cancelled
+	tca.observe(d)
+	_, err = tca.get(testExchange, "1337")
+	assert.ErrorIs(t, err, errNoArrivalPrice, "arrival price should be pruned on terminal status")
+	completed := tca.getCompleted()
+	require.Len(t, completed, 1)
+	assert.Equal(t, "1337", completed[0].OrderID)
+	assert.Equal(t, 1337.0, completed[0].PostTradeMid)
+
+	tca.record("1339", &ArrivalPrice{Exchange: testExchange, Asset: asset.Spot, Pair: p, Mid: 1330, Timestamp: time.Now()})
+	tca.observe(&order.Detail{Exchange: testExchange, OrderID: "1339", Pair: p, AssetType: asset.Spot, Side: order.Buy, Amount: 2, ExecutedAmount: 1, AverageExecutedPrice: 1340, Status: order.Cancelled})
+	completed = tca.getCompleted()
+	require.Len(t, completed, 2)
+	assert.Zero(t, completed[1].PostTradeMid, "PostTradeMid should not be sampled before the post trade delay")
+	assert.Zero(t, completed[1].RealisedSpreadBPS, "RealisedSpreadBPS should not be measured before the post trade delay")
+	tca.samplePostTradeMids(em, completed[1].LastFillAt.Add(defaultPostTradeMidDelay+postTradeMidSampleWindow))
+	assert.Zero(t, tca.getCompleted()[1].PostTradeMid, "PostTradeMid should not be sampled after the sample window")
+	tca.samplePostTradeMids(em, completed[1].LastFillAt.Add(defaultPostTradeMidDelay))
+	completed = tca.getCompleted()
+	assert.Equal(t, 1337.0, completed[1].PostTradeMid, "PostTradeMid should be sampled for completed orders")
+	assert.InDelta(t, 44.87658938, completed[1].RealisedSpreadBPS, 1e-6)
+	assert.Equal(t, 17.0, completed[1].ImplementationShortfall, "opportunity cost should be attributed to the unfilled amount")
+
+	tca.record("1338", &ArrivalPrice{Exchange: testExchange, Mid: 1330, Timestamp: time.Now()})
+	tca.observe(&order.Detail{Exchange: testExchange, OrderID: "1338", Amount: 1, Side: order.Buy, Status: order.Rejected})
+	assert.Empty(t, tca.arrivals, "arrival price should be pruned for terminal orders without fills")
+	assert.Len(t, tca.getCompleted(), 2, "orders without fills should not be completed")
+}
+
+func TestTransactionCostAnalyserPersistence(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), tcaStoreFile)
+	tca := newTransactionCostAnalyser()
+	require.NoError(t, tca.load(path))
+	tca.record("1337", &ArrivalPrice{Exchange: testExchange, Pair: btcusdPair, Asset: asset.Spot, Side: order.Buy, Mid: 1337, Timestamp: time.Now()})
+	tca.completed = append(tca.completed, OrderTransactionCost{Exchange: testExchange, OrderID: "1336", Side: order.Sell})
+	require.NoError(t, tca.flush())
+	assert.False(t, tca.dirty, "flush should clear the dirty state")
+	require.NoError(t, os.Remove(path))
+	require.NoError(t, tca.flush())
+	assert.NoFileExists(t, path, "flush should not persist an unchanged state")
+	tca.dirty = true
+	require.NoError(t, tca.flush())
+
+	loaded := newTransactionCostAnalyser()
+	require.NoError(t, loaded.load(path))
+	arrival, err := loaded.get(testExchange, "1337")
+	require.NoError(t, err)
+	assert.Equal(t, 1337.0, arrival.Mid)
+	assert.True(t, btcusdPair.Equal(arrival.Pair), "Pair should be restored")
+	assert.Equal(t, order.Buy, arrival.Side)
+	require.Len(t, loaded.getCompleted(), 1)
+
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	assert.Error(t, newTransactionCostAnalyser().load(path), "load should error on invalid data")
+}
+
+func TestCaptureArrivalPrice(t *testing.T) {
+	t.Parallel()
+	m := OrdersSetup(t)
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err)
+
+	p := currency.NewPair(currency.NewCode("TCA"), currency.USDT)
+	arrival, err := captureArrivalPrice(exch, &order.Submit{Pair: p, AssetType: asset.Spot, Side: order.Buy, Amount: 1}, "twap")
+	require.NoError(t, err)
+	assert.Equal(t, 1337.0, arrival.Mid)
+	assert.Equal(t, arrivalSourceTicker, arrival.Source)
+	assert.Equal(t, "twap", arrival.Strategy)
+	assert.False(t, arrival.Timestamp.IsZero(), "Timestamp should be set")
+}
+
+func TestCalculateOrderTransactionCost(t *testing.T) {
+	t.Parallel()
+	_, err := calculateOrderTransactionCost(nil, nil, 0)
+	assert.ErrorIs(t, err, errNilOrder)
+
+	d := &order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "1",
+		Pair:           btcusdPair,
+		AssetType:      asset.Spot,
+		Amount:         2,
+		ExecutedAmount: 1,
+	}
+	_, err = calculateOrderTransactionCost(d, nil, 0)
+	assert.ErrorIs(t, err, errNoArrivalPrice)
+
+	_, err = calculateOrderTransactionCost(d, &ArrivalPrice{}, 0)
+	assert.ErrorIs(t, err, errInvalidArrivalPrice)
+
+	arrival := &ArrivalPrice{Mid: 100, Strategy: "dca"}
+	_, err = calculateOrderTransactionCost(d, arrival, 0)
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+
+	d.Side = order.Buy
+	d.ExecutedAmount = 0
+	_, err = calculateOrderTransactionCost(d, arrival, 0)
+	assert.ErrorIs(t, err, errNoExecutedAmount)
+
+	d.Trades = []order.TradeHistory{
+		{Price: 100, Amount: 0.5, Fee: 0.05},
+		{Price: 102, Amount: 0.5, Fee: 0.005, FeeAsset: "BTC"},
+	}
+	tc, err := calculateOrderTransactionCost(d, arrival, 104)
+	require.NoError(t, err)
+	assert.Equal(t, 101.0, tc.AverageFill)
+	assert.Equal(t, 1.0, tc.ExecutedAmount)
+	assert.InDelta(t, 0.56, tc.Fees, 1e-9)
+	assert.InDelta(t, 100.0, tc.ArrivalSlippageBPS, 1e-9)
+	// 1 filled at 1 over arrival + fees + 1 unfilled at 4 over arrival
+	assert.InDelta(t, 5.56, tc.ImplementationShortfall, 1e-9)
+	assert.InDelta(t, 278.0, tc.ImplementationShortfallBPS, 1e-9)
+	assert.InDelta(t, -576.9230769, tc.RealisedSpreadBPS, 1e-6)
+	assert.InDelta(t, 55.4455445, tc.FeeDragBPS, 1e-6)
+	assert.Equal(t, "dca", tc.Strategy)
+
+	d.Side = order.Sell
+	d.Trades = nil
+	d.Amount = 1
+	d.ExecutedAmount = 1
+	d.AverageExecutedPrice = 99
+	d.Fee = 0
+	tc, err = calculateOrderTransactionCost(d, arrival, 0)
+	require.NoError(t, err)
+	assert.InDelta(t, 100.0, tc.ArrivalSlippageBPS, 1e-9, "selling below arrival should be a cost")
+	assert.Zero(t, tc.RealisedSpreadBPS, "RealisedSpreadBPS should be zero without a post trade mid")
+}
+
+func TestAggregateTransactionCosts(t *testing.T) {
+	t.Parallel()
+	costs := []OrderTransactionCost{
+		{Exchange: "a", Strategy: "x", Pair: btcusdPair, Asset: asset.Spot, Notional: 100, Fees: 1, ArrivalSlippageBPS: 10, FeeDragBPS: 100},
+		{Exchange: "a", Strategy: "y", Pair: btcusdPair, Asset: asset.Spot, Notional: 300, Fees: 3, ArrivalSlippageBPS: 20, FeeDragBPS: 100},
+		{Exchange: "b", Strategy: "x", Pair: btcusdPair, Asset: asset.Spot, Notional: 50, ArrivalSlippageBPS: -5},
+	}
+	byExchange := aggregateTransactionCosts(costs, TCAGroupExchange)
+	require.Len(t, byExchange, 2)
+	assert.Equal(t, "a", byExchange[0].Key)
+	assert.Equal(t, 2, byExchange[0].Orders)
+	assert.Equal(t, 400.0, byExchange[0].Notional)
+	assert.Equal(t, 4.0, byExchange[0].Fees)
+	assert.InDelta(t, 17.5, byExchange[0].ArrivalSlippageBPS, 1e-9)
+	assert.InDelta(t, 100.0, byExchange[0].FeeDragBPS, 1e-9)
+
+	byStrategy := aggregateTransactionCosts(costs, TCAGroupStrategy)
+	require.Len(t, byStrategy, 2)
+	assert.Equal(t, "x", byStrategy[0].Key)
+	assert.InDelta(t, 5.0, byStrategy[0].ArrivalSlippageBPS, 1e-9)
+
+	byPair := aggregateTransactionCosts(costs, TCAGroupPair)
+	require.Len(t, byPair, 2)
+	assert.Equal(t, TCAGroupPair, byPair[0].Group)
+}
+
+func TestTransactionCostFilterMatches(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	tc := &OrderTransactionCost{Exchange: testExchange, Asset: asset.Spot, Pair: btcusdPair, Strategy: "dca", SubmittedAt: tt}
+
+	var f *TransactionCostFilter
+	assert.True(t, f.matches(tc), "nil filter should match")
+	assert.True(t, (&TransactionCostFilter{Exchange: strings.ToUpper(testExchange), Asset: asset.Spot, Pair: btcusdPair, Strategy: "DCA"}).matches(tc), "filter should match")
+	assert.False(t, (&TransactionCostFilter{Exchange: "okx"}).matches(tc), "filter should not match exchange")
+	assert.False(t, (&TransactionCostFilter{Asset: asset.Futures}).matches(tc), "filter should not match asset")
+	assert.False(t, (&TransactionCostFilter{Pair: currency.NewBTCUSDT()}).matches(tc), "filter should not match pair")
+	assert.False(t, (&TransactionCostFilter{Strategy: "twap"}).matches(tc), "filter should not match strategy")
+	assert.False(t, (&TransactionCostFilter{StartDate: tt.Add(time.Second)}).matches(tc), "filter should not match start date")
+	assert.False(t, (&TransactionCostFilter{EndDate: tt.Add(-time.Second)}).matches(tc), "filter should not match end date")
+}
+
+func TestGetTransactionCostAnalysis(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.GetTransactionCostAnalysis(nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = OrdersSetup(t)
+	m.started = 0
+	_, err = m.GetTransactionCostAnalysis(nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started = 1
+	_, err = m.GetTransactionCostAnalysis(nil)
+	assert.ErrorIs(t, err, errNoTCAOrders)
+
+	p := currency.NewPair(currency.NewCode("TCA"), currency.USDT)
+	filled := &order.Detail{
+		Exchange:             testExchange,
+		OrderID:              "filled",
+		Pair:                 p,
+		AssetType:            asset.Spot,
+		Side:                 order.Buy,
+		Amount:               1,
+		ExecutedAmount:       1,
+		AverageExecutedPrice: 1340,
+		Status:               order.Filled,
+	}
+	require.NoError(t, m.orderStore.add(filled))
+	unfilled := &order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "unfilled",
+		Pair:      p,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Amount:    1,
+		Status:    order.Open,
+	}
+	require.NoError(t, m.orderStore.add(unfilled))
+	untracked := &order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "untracked",
+		Pair:           p,
+		AssetType:      asset.Spot,
+		Side:           order.Sell,
+		Amount:         1,
+		ExecutedAmount: 1,
+		Status:         order.Filled,
+	}
+	require.NoError(t, m.orderStore.add(untracked))
+
+	m.tca.record("filled", &ArrivalPrice{Exchange: testExchange, Mid: 1337, Strategy: "dca", Timestamp: time.Now(), PostTradeMid: 1338})
+	m.tca.record("unfilled", &ArrivalPrice{Exchange: testExchange, Mid: 1337, Strategy: "dca", Timestamp: time.Now()})
+	m.tca.completed = append(m.tca.completed, OrderTransactionCost{Exchange: testExchange, Asset: asset.Spot, Pair: p, OrderID: "completed", Strategy: "dca", SubmittedAt: time.Now().Add(-time.Minute)})
+
+	_, err = m.GetTransactionCostAnalysis(&TransactionCostFilter{Strategy: "twap"})
+	assert.ErrorIs(t, err, errNoTCAOrders)
+
+	report, err := m.GetTransactionCostAnalysis(&TransactionCostFilter{Exchange: testExchange, Strategy: "dca"})
+	require.NoError(t, err)
+	require.Len(t, report.Orders, 2, "only orders with arrival prices and fills must be analysed")
+	assert.Equal(t, "completed", report.Orders[0].OrderID)
+	assert.Equal(t, "filled", report.Orders[1].OrderID)
+	assert.Equal(t, 1338.0, report.Orders[1].PostTradeMid, "PostTradeMid should be the mid captured at fill time")
+	require.Len(t, report.ByExchange, 1)
+	require.Len(t, report.ByPair, 1)
+	require.Len(t, report.ByStrategy, 1)
+	assert.Equal(t, "dca", report.ByStrategy[0].Key)
+}
+
+func TestGenerateHTMLReport(t *testing.T) {
+	t.Parallel()
+	var r *TransactionCostReport
+	_, err := r.GenerateHTMLReport("")
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	r = &TransactionCostReport{GeneratedAt: time.Now()}
+	_, err = r.GenerateHTMLReport("")
+	assert.ErrorIs(t, err, errReportDirectoryUnset)
+
+	r.Orders = []OrderTransactionCost{{Exchange: testExchange, Pair: btcusdPair, Asset: asset.Spot, Strategy: "dca", Side: order.Buy, ArrivalSlippageBPS: 12.3456}}
+	r.ByExchange = aggregateTransactionCosts(r.Orders, TCAGroupExchange)
+	r.ByPair = aggregateTransactionCosts(r.Orders, TCAGroupPair)
+	r.ByStrategy = aggregateTransactionCosts(r.Orders, TCAGroupStrategy)
+	path, err := r.GenerateHTMLReport(t.TempDir())
+	require.NoError(t, err)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "By Strategy")
+	assert.Contains(t, string(data), "12.35")
+}
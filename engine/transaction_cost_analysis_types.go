@@ -0,0 +1,178 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Arrival price sources
+const (
+	arrivalSourceOrderbook = "orderbook"
+	arrivalSourceTicker    = "ticker"
+)
+
+// Transaction cost aggregation groupings
+const (
+	TCAGroupExchange = "exchange"
+	TCAGroupPair     = "pair"
+	TCAGroupStrategy = "strategy"
+)
+
+const (
+	// basisPoints converts a ratio into basis points
+	basisPoints = 10000
+	// tcaStoreFile is the file name arrival prices and completed transaction
+	// costs are persisted to within the data directory
+	tcaStoreFile = "transaction_costs.json"
+	// maxCompletedTransactionCosts limits the number of completed order
+	// transaction costs retained, dropping the oldest first
+	maxCompletedTransactionCosts = 10000
+	// arrivalPriceRetention is how long the arrival price of an order that
+	// never reaches a terminal status is retained
+	arrivalPriceRetention = time.Hour * 24 * 30
+	// defaultPostTradeMidDelay is how long after an order's last fill the
+	// post trade mid price is sampled when no delay is configured
+	defaultPostTradeMidDelay = time.Minute * 5
+	// postTradeMidSampleWindow is how long after the post trade delay a mid
+	// price may still be sampled when no market price was available
+	postTradeMidSampleWindow = time.Minute
+	// transactionCostInterval is how often post trade mid prices are sampled
+	// and changes are persisted
+	transactionCostInterval = time.Second
+)
+
+var (
+	errNoArrivalPrice       = errors.New("no arrival price recorded for order")
+	errNoExecutedAmount     = errors.New("order has no executed amount")
+	errInvalidArrivalPrice  = errors.New("invalid arrival price")
+	errNoMarketPriceForPair = errors.New("no cached orderbook or ticker price available")
+	errNoTCAOrders          = errors.New("no orders available for transaction cost analysis")
+	errReportDirectoryUnset = errors.New("report output directory unset")
+)
+
+type strategyContextKey struct{}
+
+// ArrivalPrice is the market state captured immediately before an order is
+// sent to an exchange. It serves as the benchmark for all transaction cost
+// measurements of that order.
+type ArrivalPrice struct {
+	Exchange  string
+	OrderID   string
+	Asset     asset.Item
+	Pair      currency.Pair
+	Strategy  string
+	Side      order.Side
+	Amount    float64
+	Mid       float64
+	Bid       float64
+	Ask       float64
+	Source    string
+	Timestamp time.Time
+	// FilledAmount is the executed amount of the order when a fill was last
+	// observed
+	FilledAmount      float64
+	LastFillTimestamp time.Time
+	// PostTradeMid is the mid price sampled the post trade delay after the
+	// last fill
+	PostTradeMid       float64
+	PostTradeTimestamp time.Time
+}
+
+// transactionCostAnalyser stores arrival prices of open orders keyed by
+// exchange and order ID, and the transaction costs of orders which have
+// reached a terminal status. Changes are marked dirty and persisted by the
+// order manager's run loop
+type transactionCostAnalyser struct {
+	m              sync.RWMutex
+	arrivals       map[string]*ArrivalPrice
+	completed      []OrderTransactionCost
+	storePath      string
+	dirty          bool
+	postTradeDelay time.Duration
+}
+
+// transactionCostStore is the persisted state of the transaction cost
+// analyser
+type transactionCostStore struct {
+	Arrivals  []*ArrivalPrice        `json:"arrivals"`
+	Completed []OrderTransactionCost `json:"completed"`
+}
+
+// TransactionCostFilter limits the orders included in a transaction cost
+// analysis. Empty fields are ignored.
+type TransactionCostFilter struct {
+	Exchange  string
+	Asset     asset.Item
+	Pair      currency.Pair
+	Strategy  string
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// OrderTransactionCost holds the transaction cost breakdown for a single order.
+// All basis point values are positive when they represent a cost to the trader
+// and negative when the execution was favourable.
+type OrderTransactionCost struct {
+	Exchange        string
+	Asset           asset.Item
+	Pair            currency.Pair
+	Strategy        string
+	OrderID         string
+	InternalOrderID string
+	Side            order.Side
+	Status          order.Status
+	SubmittedAt     time.Time
+	Amount          float64
+	ExecutedAmount  float64
+	ArrivalPrice    float64
+	ArrivalSource   string
+	AverageFill     float64
+	LastFillAt      time.Time
+	// PostTradeMid is the mid price sampled the post trade delay after the
+	// last fill
+	PostTradeMid float64
+	Notional     float64
+	Fees         float64
+	// ArrivalSlippageBPS is the difference between the average fill price and
+	// the arrival mid price
+	ArrivalSlippageBPS float64
+	// ImplementationShortfall is the total cost in quote currency versus a
+	// paper portfolio executed entirely at the arrival price, including fees
+	// and the opportunity cost of any unfilled amount
+	ImplementationShortfall    float64
+	ImplementationShortfallBPS float64
+	// RealisedSpreadBPS measures the fill price against the post trade mid
+	// price, as twice the signed distance between them
+	RealisedSpreadBPS float64
+	FeeDragBPS        float64
+}
+
+// TransactionCostAggregate summarises the transaction costs of a group of
+// orders. Basis point values are weighted by order notional.
+type TransactionCostAggregate struct {
+	Group                      string
+	Key                        string
+	Orders                     int
+	Notional                   float64
+	Fees                       float64
+	ImplementationShortfall    float64
+	ArrivalSlippageBPS         float64
+	ImplementationShortfallBPS float64
+	RealisedSpreadBPS          float64
+	FeeDragBPS                 float64
+}
+
+// TransactionCostReport holds per order transaction costs and their
+// aggregations per exchange, pair and strategy
+type TransactionCostReport struct {
+	GeneratedAt time.Time
+	Orders      []OrderTransactionCost
+	ByExchange  []TransactionCostAggregate
+	ByPair      []TransactionCostAggregate
+	ByStrategy  []TransactionCostAggregate
+}
@@ -0,0 +1,565 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// SetupTransferManager applies configuration parameters and loads any
+// persisted transfers before running
+func SetupTransferManager(em iExchangeManager, wm iWithdrawManager, dam iDepositAddressManager, comms iCommsManager, cfg *config.TransferManager, storePath string) (*TransferManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if wm == nil {
+		return nil, errNilWithdrawManager
+	}
+	if dam == nil {
+		return nil, errNilDepositAddressManager
+	}
+	if comms == nil {
+		return nil, errNilCommunicationsManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if storePath == "" {
+		return nil, errTransferStorePathUnset
+	}
+	t := &TransferManager{
+		exchangeManager:       em,
+		withdrawManager:       wm,
+		depositAddressManager: dam,
+		commsManager:          comms,
+		cfg:                   *cfg,
+		storePath:             storePath,
+		shutdown:              make(chan struct{}),
+		transfers:             make(map[uuid.UUID]*Transfer),
+	}
+	if t.cfg.PollInterval <= 0 {
+		log.Warnf(log.Global, "Transfer manager poll interval is invalid, defaulting to: %s", DefaultTransferManagerPollInterval)
+		t.cfg.PollInterval = DefaultTransferManagerPollInterval
+	}
+	if t.cfg.Timeout <= 0 {
+		log.Warnf(log.Global, "Transfer manager timeout is invalid, defaulting to: %s", DefaultTransferManagerTimeout)
+		t.cfg.Timeout = DefaultTransferManagerTimeout
+	}
+	if err := t.load(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (t *TransferManager) IsRunning() bool {
+	return t != nil && atomic.LoadInt32(&t.started) == 1
+}
+
+// Start runs the subsystem and resumes any unfinished transfers
+func (t *TransferManager) Start() error {
+	if t == nil {
+		return fmt.Errorf("%s %w", TransferManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&t.started, 0, 1) {
+		return fmt.Errorf("%s %w", TransferManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Global, "Transfer manager %s", MsgSubSystemStarting)
+	t.wg.Add(1)
+	go t.monitor()
+	log.Debugf(log.Global, "Transfer manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem. Unfinished transfers are resumed on next start.
+func (t *TransferManager) Stop() error {
+	if t == nil {
+		return fmt.Errorf("%s %w", TransferManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&t.started) == 0 {
+		return fmt.Errorf("%s %w", TransferManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "Transfer manager %s", MsgSubSystemShuttingDown)
+	close(t.shutdown)
+	t.wg.Wait()
+	t.shutdown = make(chan struct{})
+	atomic.StoreInt32(&t.started, 0)
+	log.Debugf(log.Global, "Transfer manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+func (t *TransferManager) monitor() {
+	defer t.wg.Done()
+	timer := time.NewTimer(0) // Prime firing of channel for resuming transfers.
+	for {
+		select {
+		case <-t.shutdown:
+			timer.Stop()
+			return
+		case <-timer.C:
+			t.processTransfers(context.TODO())
+			timer.Reset(t.cfg.PollInterval)
+		}
+	}
+}
+
+// CreateTransfer validates a transfer request, selects the cheapest available
+// chain with a known destination deposit address and submits the withdrawal
+// from the source exchange. The deposit is then tracked until it lands.
+func (t *TransferManager) CreateTransfer(ctx context.Context, req *TransferRequest) (*Transfer, error) {
+	if t == nil {
+		return nil, fmt.Errorf("%s %w", TransferManagerName, ErrNilSubsystem)
+	}
+	if !t.IsRunning() {
+		return nil, fmt.Errorf("%s %w", TransferManagerName, ErrSubSystemNotStarted)
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w TransferRequest", common.ErrNilPointer)
+	}
+	if req.Currency.IsEmpty() {
+		return nil, currency.ErrCurrencyCodeEmpty
+	}
+	if req.Amount <= 0 {
+		return nil, errInvalidTransferAmount
+	}
+	if strings.EqualFold(req.Source, req.Destination) {
+		return nil, errSameTransferExchange
+	}
+	src, err := t.exchangeManager.GetExchangeByName(req.Source)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := t.exchangeManager.GetExchangeByName(req.Destination)
+	if err != nil {
+		return nil, err
+	}
+	if err = src.CanWithdraw(req.Currency, asset.Spot); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		return nil, fmt.Errorf("%s: %w", src.GetName(), err)
+	}
+	if err = dst.CanDeposit(req.Currency, asset.Spot); err != nil && !errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
+		return nil, fmt.Errorf("%s: %w", dst.GetName(), err)
+	}
+
+	chain, err := t.selectTransferChain(ctx, src, dst.GetName(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	tr := &Transfer{
+		ID:           id,
+		Source:       src.GetName(),
+		Destination:  dst.GetName(),
+		Currency:     req.Currency,
+		Amount:       req.Amount,
+		Chain:        chain.chain,
+		Address:      chain.address,
+		AddressTag:   chain.addressTag,
+		EstimatedFee: chain.fee,
+		Status:       TransferStatusSubmitting,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+
+	// Persist before submission so an interrupted submission is never
+	// silently resubmitted
+	t.m.Lock()
+	t.transfers[tr.ID] = tr
+	err = t.saveLocked()
+	t.m.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.withdrawManager.SubmitWithdrawal(ctx, &withdraw.Request{
+		Exchange:    tr.Source,
+		Currency:    tr.Currency,
+		Description: "GoCryptoTrader transfer " + tr.ID.String(),
+		Amount:      tr.Amount,
+		Type:        withdraw.Crypto,
+		Crypto: withdraw.CryptoRequest{
+			Address:    tr.Address,
+			AddressTag: tr.AddressTag,
+			Chain:      tr.Chain,
+			FeeAmount:  tr.EstimatedFee,
+		},
+	})
+	if err != nil {
+		t.fail(tr.ID, err)
+		failed, getErr := t.GetTransfer(tr.ID)
+		if getErr != nil {
+			return nil, getErr
+		}
+		return failed, err
+	}
+
+	t.update(tr.ID, func(tr *Transfer) {
+		tr.WithdrawalID = resp.ID.String()
+		tr.ExchangeWithdrawalID = resp.Exchange.ID
+		tr.SubmittedAt = time.Now()
+		if resp.ID == withdraw.DryRunID {
+			tr.Status = TransferStatusCompleted
+			tr.CompletedAt = tr.SubmittedAt
+			return
+		}
+		tr.Status = TransferStatusWithdrawing
+	})
+	return t.GetTransfer(tr.ID)
+}
+
+// GetTransfer returns a copy of a transfer by ID
+func (t *TransferManager) GetTransfer(id uuid.UUID) (*Transfer, error) {
+	if t == nil {
+		return nil, fmt.Errorf("%s %w", TransferManagerName, ErrNilSubsystem)
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	tr, ok := t.transfers[id]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errTransferNotFound, id)
+	}
+	cpy := *tr
+	return &cpy, nil
+}
+
+// GetTransfers returns copies of all transfers ordered by creation time
+func (t *TransferManager) GetTransfers() ([]Transfer, error) {
+	if t == nil {
+		return nil, fmt.Errorf("%s %w", TransferManagerName, ErrNilSubsystem)
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	return t.sortedTransfersLocked(), nil
+}
+
+// selectTransferChain returns the cheapest chain supported by the source
+// exchange that has a known deposit address on the destination exchange
+func (t *TransferManager) selectTransferChain(ctx context.Context, src exchange.IBotExchange, dst string, req *TransferRequest) (*transferChain, error) {
+	chains, err := src.GetAvailableTransferChains(ctx, req.Currency)
+	if err != nil && !errors.Is(err, common.ErrFunctionNotSupported) && !errors.Is(err, common.ErrNotYetImplemented) {
+		return nil, err
+	}
+	if len(chains) == 0 {
+		// Exchange does not differentiate chains, use the default address
+		chains = []string{req.Chain}
+	}
+
+	candidates := make([]transferChain, 0, len(chains))
+	for _, chain := range chains {
+		if req.Chain != "" && !strings.EqualFold(chain, req.Chain) {
+			continue
+		}
+		addr, err := t.depositAddressManager.GetDepositAddressByExchangeAndCurrency(dst, chain, req.Currency)
+		if err != nil {
+			if t.cfg.Verbose {
+				log.Debugf(log.Global, "Transfer manager %s %s chain %q has no deposit address: %v", dst, req.Currency, chain, err)
+			}
+			continue
+		}
+		c := transferChain{chain: chain, address: addr.Address, addressTag: addr.Tag}
+		c.fee, c.feeKnown = t.chainFee(src.GetName(), req, chain)
+		candidates = append(candidates, c)
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w %s to %s %s", errNoTransferChain, src.GetName(), dst, req.Currency)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].feeKnown != candidates[j].feeKnown {
+			return candidates[i].feeKnown
+		}
+		if candidates[i].fee != candidates[j].fee {
+			return candidates[i].fee < candidates[j].fee
+		}
+		return candidates[i].chain < candidates[j].chain
+	})
+	return &candidates[0], nil
+}
+
+// chainFee returns the configured withdrawal fee estimate for a chain
+func (t *TransferManager) chainFee(exch string, req *TransferRequest, chain string) (fee float64, ok bool) {
+	for i := range t.cfg.ChainFees {
+		f := &t.cfg.ChainFees[i]
+		if strings.EqualFold(f.Exchange, exch) && f.Currency.Equal(req.Currency) && strings.EqualFold(f.Chain, chain) {
+			return f.Fee, true
+		}
+	}
+	return 0, false
+}
+
+// processTransfers progresses all unfinished transfers
+func (t *TransferManager) processTransfers(ctx context.Context) {
+	t.m.Lock()
+	pending := make([]Transfer, 0, len(t.transfers))
+	for _, tr := range t.transfers {
+		if tr.Status == TransferStatusWithdrawing || tr.Status == TransferStatusDepositing {
+			pending = append(pending, *tr)
+		}
+	}
+	t.m.Unlock()
+
+	for i := range pending {
+		if err := t.processTransfer(ctx, &pending[i]); err != nil {
+			log.Errorf(log.Global, "Transfer manager %s %s to %s: %v", pending[i].ID, pending[i].Source, pending[i].Destination, err)
+		}
+	}
+}
+
+// processTransfer checks the source exchange's withdrawal history and the
+// destination exchange's funding history to progress a transfer
+func (t *TransferManager) processTransfer(ctx context.Context, tr *Transfer) error {
+	if !tr.SubmittedAt.IsZero() && time.Since(tr.SubmittedAt) > t.cfg.Timeout {
+		t.fail(tr.ID, fmt.Errorf("%w after %s in %s state", errTransferTimedOut, t.cfg.Timeout, tr.Status))
+		return nil
+	}
+
+	if tr.Status == TransferStatusWithdrawing {
+		src, err := t.exchangeManager.GetExchangeByName(tr.Source)
+		if err != nil {
+			return err
+		}
+		history, err := src.GetWithdrawalsHistory(ctx, tr.Currency, asset.Spot)
+		switch {
+		case errors.Is(err, common.ErrFunctionNotSupported), errors.Is(err, common.ErrNotYetImplemented):
+			// Withdrawal cannot be tracked, rely on the deposit instead
+			tr.Status = TransferStatusDepositing
+		case err != nil:
+			return err
+		default:
+			w := matchWithdrawal(history, tr)
+			if w == nil {
+				if tr.ExchangeWithdrawalID != "" {
+					// Withdrawal history can lag behind new withdrawals, keep
+					// polling so a rejected withdrawal is still detected
+					return nil
+				}
+				// The exchange returned no withdrawal ID to track, rely on
+				// the deposit instead
+				tr.Status = TransferStatusDepositing
+				break
+			}
+			if containsFold(withdrawalFailedStatuses, w.Status) {
+				t.fail(tr.ID, fmt.Errorf("%w: %s", errWithdrawalRejected, w.Status))
+				return nil
+			}
+			if w.CryptoTxID == "" && !containsFold(withdrawalCompleteStatuses, w.Status) {
+				return nil
+			}
+			tr.TxID = w.CryptoTxID
+			tr.Status = TransferStatusDepositing
+		}
+		t.update(tr.ID, func(stored *Transfer) {
+			stored.TxID = tr.TxID
+			stored.Status = tr.Status
+		})
+	}
+
+	dst, err := t.exchangeManager.GetExchangeByName(tr.Destination)
+	if err != nil {
+		return err
+	}
+	funding, err := dst.GetAccountFundingHistory(ctx)
+	if err != nil {
+		return err
+	}
+	d := matchDeposit(funding, tr)
+	if d == nil {
+		return nil
+	}
+	t.update(tr.ID, func(stored *Transfer) {
+		if stored.TxID == "" {
+			stored.TxID = d.CryptoTxID
+		}
+		stored.Status = TransferStatusCompleted
+		stored.CompletedAt = time.Now()
+	})
+	return nil
+}
+
+// matchWithdrawal finds the transfer's withdrawal in an exchange's withdrawal
+// history
+func matchWithdrawal(history []exchange.WithdrawalHistory, tr *Transfer) *exchange.WithdrawalHistory {
+	for i := range history {
+		if tr.ExchangeWithdrawalID != "" && history[i].TransferID == tr.ExchangeWithdrawalID {
+			return &history[i]
+		}
+	}
+	return nil
+}
+
+// matchDeposit finds the transfer's deposit in an exchange's funding history,
+// by transaction ID when known or otherwise by address and amount after the
+// withdrawal was submitted
+func matchDeposit(funding []exchange.FundingHistory, tr *Transfer) *exchange.FundingHistory {
+	for i := range funding {
+		f := &funding[i]
+		if !strings.EqualFold(f.Currency, tr.Currency.String()) {
+			continue
+		}
+		if f.TransferType != "" && !strings.Contains(strings.ToLower(f.TransferType), "deposit") {
+			continue
+		}
+		if containsFold(withdrawalFailedStatuses, f.Status) {
+			continue
+		}
+		if tr.TxID != "" && f.CryptoTxID != "" {
+			if strings.EqualFold(f.CryptoTxID, tr.TxID) {
+				return f
+			}
+			continue
+		}
+		if f.Timestamp.Before(tr.SubmittedAt) {
+			continue
+		}
+		if f.CryptoToAddress != "" && !strings.EqualFold(f.CryptoToAddress, tr.Address) {
+			continue
+		}
+		// Withdrawal fees are deducted in transit so only bound the amount
+		// from above and allow for the fee below
+		if f.Amount > tr.Amount*(1+transferAmountTolerance) || f.Amount < (tr.Amount-tr.EstimatedFee)*(1-transferAmountTolerance) {
+			continue
+		}
+		return f
+	}
+	return nil
+}
+
+// containsFold reports whether the status is within the list ignoring case
+func containsFold(list []string, status string) bool {
+	return slices.ContainsFunc(list, func(s string) bool { return strings.EqualFold(s, status) })
+}
+
+// fail marks a transfer as failed with the supplied error
+func (t *TransferManager) fail(id uuid.UUID, err error) {
+	t.update(id, func(tr *Transfer) {
+		tr.Status = TransferStatusFailed
+		tr.Error = err.Error()
+	})
+}
+
+// update applies a change to a stored transfer, persists all transfers and
+// notifies on status changes
+func (t *TransferManager) update(id uuid.UUID, fn func(*Transfer)) {
+	t.m.Lock()
+	defer t.m.Unlock()
+	tr, ok := t.transfers[id]
+	if !ok {
+		return
+	}
+	previous := tr.Status
+	fn(tr)
+	tr.UpdatedAt = time.Now()
+	if err := t.saveLocked(); err != nil {
+		log.Errorf(log.Global, "Transfer manager unable to persist transfer %s: %v", id, err)
+	}
+	if previous == tr.Status {
+		return
+	}
+	msg := fmt.Sprintf("Transfer %s of %v %s from %s to %s via chain %q is %s", tr.ID, tr.Amount, tr.Currency, tr.Source, tr.Destination, tr.Chain, tr.Status)
+	if tr.Error != "" {
+		msg += ": " + tr.Error
+	}
+	if t.cfg.Verbose || tr.Status == TransferStatusCompleted || tr.Status == TransferStatusFailed {
+		log.Infoln(log.Global, msg)
+	}
+	severity := base.SeverityInfo
+	if tr.Status == TransferStatusFailed {
+		severity = base.SeverityError
+	}
+	t.commsManager.PushEvent(base.Event{
+		Type:     transferEventType,
+		Category: base.CategoryTransfer,
+		Severity: severity,
+		Message:  msg,
+		Exchange: tr.Source,
+		Payload: map[string]any{
+			"id":          tr.ID.String(),
+			"source":      tr.Source,
+			"destination": tr.Destination,
+			"currency":    tr.Currency.String(),
+			"amount":      tr.Amount,
+			"status":      string(tr.Status),
+		},
+	})
+}
+
+// sortedTransfersLocked returns copies of all transfers ordered by creation
+// time. The caller must hold the lock.
+func (t *TransferManager) sortedTransfersLocked() []Transfer {
+	resp := make([]Transfer, 0, len(t.transfers))
+	for _, tr := range t.transfers {
+		resp = append(resp, *tr)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		if !resp[i].CreatedAt.Equal(resp[j].CreatedAt) {
+			return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+		}
+		return resp[i].ID.String() < resp[j].ID.String()
+	})
+	return resp
+}
+
+// saveLocked persists all transfers. The caller must hold the lock.
+func (t *TransferManager) saveLocked() error {
+	data, err := json.MarshalIndent(t.sortedTransfersLocked(), "", " ")
+	if err != nil {
+		return err
+	}
+	return file.Write(t.storePath, data)
+}
+
+// load reads persisted transfers. Transfers interrupted during withdrawal
+// submission are failed as their outcome cannot be determined.
+func (t *TransferManager) load() error {
+	data, err := os.ReadFile(t.storePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	var transfers []Transfer
+	if err := json.Unmarshal(data, &transfers); err != nil {
+		return fmt.Errorf("unable to load transfers from %s: %w", t.storePath, err)
+	}
+	t.m.Lock()
+	defer t.m.Unlock()
+	var interrupted bool
+	for i := range transfers {
+		tr := transfers[i]
+		if tr.Status == TransferStatusSubmitting {
+			tr.Status = TransferStatusFailed
+			tr.Error = errTransferOutcomeUnknown.Error()
+			tr.UpdatedAt = time.Now()
+			interrupted = true
+			log.Warnf(log.Global, "Transfer manager %s from %s: %v", tr.ID, tr.Source, errTransferOutcomeUnknown)
+		}
+		t.transfers[tr.ID] = &tr
+	}
+	if interrupted {
+		return t.saveLocked()
+	}
+	return nil
+}
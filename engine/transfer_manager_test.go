@@ -0,0 +1,363 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const transferDestination = "Binance"
+
+type testWithdrawManager struct {
+	requests []*withdraw.Request
+	resp     *withdraw.Response
+	err      error
+}
+
+func (t *testWithdrawManager) SubmitWithdrawal(_ context.Context, req *withdraw.Request) (*withdraw.Response, error) {
+	t.requests = append(t.requests, req)
+	return t.resp, t.err
+}
+
+// transferExchange overrides exchange functions used by the transfer manager
+type transferExchange struct {
+	exchange.IBotExchange
+	chains      []string
+	withdrawals []exchange.WithdrawalHistory
+	funding     []exchange.FundingHistory
+}
+
+func (e *transferExchange) GetAvailableTransferChains(context.Context, currency.Code) ([]string, error) {
+	if e.chains == nil {
+		return nil, common.ErrFunctionNotSupported
+	}
+	return e.chains, nil
+}
+
+func (e *transferExchange) CanWithdraw(currency.Code, asset.Item) error { return nil }
+
+func (e *transferExchange) CanDeposit(currency.Code, asset.Item) error { return nil }
+
+func (e *transferExchange) GetWithdrawalsHistory(context.Context, currency.Code, asset.Item) ([]exchange.WithdrawalHistory, error) {
+	return e.withdrawals, nil
+}
+
+func (e *transferExchange) GetAccountFundingHistory(context.Context) ([]exchange.FundingHistory, error) {
+	return e.funding, nil
+}
+
+func transferSetup(t *testing.T) (tm *TransferManager, src, dst *transferExchange, wm *testWithdrawManager) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	src = &transferExchange{IBotExchange: exch, chains: []string{"ERC20", "TRC20", "SOL"}}
+	require.NoError(t, em.Add(src))
+	exch, err = em.NewExchangeByName(transferDestination)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	dst = &transferExchange{IBotExchange: exch}
+	require.NoError(t, em.Add(dst))
+
+	dam := SetupDepositAddressManager()
+	require.NoError(t, dam.Sync(map[string]ExchangeDepositAddresses{
+		transferDestination: {
+			"USDT": {
+				{Address: "0xerc", Chain: "ERC20"},
+				{Address: "Ttrc", Chain: "TRC20"},
+			},
+		},
+	}))
+
+	wm = &testWithdrawManager{resp: &withdraw.Response{ID: uuid.Must(uuid.NewV4()), Exchange: withdraw.ExchangeResponse{ID: "1337"}}}
+	tm, err = SetupTransferManager(em, wm, dam, &testCommsManager{}, &config.TransferManager{
+		ChainFees: []config.TransferChainFee{
+			{Exchange: testExchange, Currency: currency.USDT, Chain: "ERC20", Fee: 5},
+			{Exchange: testExchange, Currency: currency.USDT, Chain: "TRC20", Fee: 1},
+		},
+	}, filepath.Join(t.TempDir(), transferStoreFile))
+	require.NoError(t, err)
+	tm.started = 1
+	return tm, src, dst, wm
+}
+
+func TestSetupTransferManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupTransferManager(nil, nil, nil, nil, nil, "")
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	em := NewExchangeManager()
+	_, err = SetupTransferManager(em, nil, nil, nil, nil, "")
+	assert.ErrorIs(t, err, errNilWithdrawManager)
+	wm := &testWithdrawManager{}
+	_, err = SetupTransferManager(em, wm, nil, nil, nil, "")
+	assert.ErrorIs(t, err, errNilDepositAddressManager)
+	dam := SetupDepositAddressManager()
+	_, err = SetupTransferManager(em, wm, dam, nil, nil, "")
+	assert.ErrorIs(t, err, errNilCommunicationsManager)
+	_, err = SetupTransferManager(em, wm, dam, &testCommsManager{}, nil, "")
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupTransferManager(em, wm, dam, &testCommsManager{}, &config.TransferManager{}, "")
+	assert.ErrorIs(t, err, errTransferStorePathUnset)
+
+	path := filepath.Join(t.TempDir(), transferStoreFile)
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	_, err = SetupTransferManager(em, wm, dam, &testCommsManager{}, &config.TransferManager{}, path)
+	assert.Error(t, err, "SetupTransferManager should error on a corrupt store")
+
+	tm, err := SetupTransferManager(em, wm, dam, &testCommsManager{}, &config.TransferManager{}, filepath.Join(t.TempDir(), transferStoreFile))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultTransferManagerPollInterval, tm.cfg.PollInterval, "PollInterval should be defaulted")
+	assert.Equal(t, DefaultTransferManagerTimeout, tm.cfg.Timeout, "Timeout should be defaulted")
+}
+
+func TestTransferManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var tm *TransferManager
+	assert.ErrorIs(t, tm.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, tm.Stop(), ErrNilSubsystem)
+
+	tm, _, _, _ = transferSetup(t)
+	tm.started = 0
+	assert.ErrorIs(t, tm.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, tm.Start())
+	assert.ErrorIs(t, tm.Start(), ErrSubSystemAlreadyStarted)
+	assert.True(t, tm.IsRunning(), "IsRunning should return true")
+	require.NoError(t, tm.Stop())
+	assert.False(t, tm.IsRunning(), "IsRunning should return false")
+}
+
+func TestSelectTransferChain(t *testing.T) {
+	t.Parallel()
+	tm, src, _, _ := transferSetup(t)
+	req := &TransferRequest{Source: testExchange, Destination: transferDestination, Currency: currency.USDT, Amount: 100}
+
+	c, err := tm.selectTransferChain(t.Context(), src, transferDestination, req)
+	require.NoError(t, err)
+	assert.Equal(t, "TRC20", c.chain, "selectTransferChain should select the cheapest chain")
+	assert.Equal(t, "Ttrc", c.address)
+	assert.Equal(t, 1.0, c.fee)
+
+	req.Chain = "erc20"
+	c, err = tm.selectTransferChain(t.Context(), src, transferDestination, req)
+	require.NoError(t, err)
+	assert.Equal(t, "ERC20", c.chain, "selectTransferChain should respect the requested chain")
+
+	req.Chain = "SOL"
+	_, err = tm.selectTransferChain(t.Context(), src, transferDestination, req)
+	assert.ErrorIs(t, err, errNoTransferChain, "chains without a deposit address must not be selected")
+
+	req.Chain = ""
+	tm.cfg.ChainFees = nil
+	c, err = tm.selectTransferChain(t.Context(), src, transferDestination, req)
+	require.NoError(t, err)
+	assert.Equal(t, "ERC20", c.chain, "selectTransferChain should fall back to chain name order without fees")
+
+	src.chains = nil
+	c, err = tm.selectTransferChain(t.Context(), src, transferDestination, req)
+	require.NoError(t, err)
+	assert.Equal(t, "0xerc", c.address, "selectTransferChain should use the default address when chains are unsupported")
+}
+
+func TestCreateTransfer(t *testing.T) {
+	t.Parallel()
+	var tm *TransferManager
+	_, err := tm.CreateTransfer(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	tm, _, _, wm := transferSetup(t)
+	_, err = tm.CreateTransfer(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = tm.CreateTransfer(t.Context(), &TransferRequest{})
+	assert.ErrorIs(t, err, currency.ErrCurrencyCodeEmpty)
+	_, err = tm.CreateTransfer(t.Context(), &TransferRequest{Currency: currency.USDT})
+	assert.ErrorIs(t, err, errInvalidTransferAmount)
+	_, err = tm.CreateTransfer(t.Context(), &TransferRequest{Source: testExchange, Destination: testExchange, Currency: currency.USDT, Amount: 1})
+	assert.ErrorIs(t, err, errSameTransferExchange)
+	_, err = tm.CreateTransfer(t.Context(), &TransferRequest{Source: "unknown", Destination: testExchange, Currency: currency.USDT, Amount: 1})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	tr, err := tm.CreateTransfer(t.Context(), &TransferRequest{Source: testExchange, Destination: transferDestination, Currency: currency.USDT, Amount: 100})
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusWithdrawing, tr.Status)
+	assert.Equal(t, "TRC20", tr.Chain)
+	assert.Equal(t, "1337", tr.ExchangeWithdrawalID)
+	assert.False(t, tr.SubmittedAt.IsZero(), "SubmittedAt should be set")
+	require.Len(t, wm.requests, 1)
+	assert.Equal(t, "Ttrc", wm.requests[0].Crypto.Address)
+	assert.Equal(t, withdraw.Crypto, wm.requests[0].Type)
+
+	data, err := os.ReadFile(tm.storePath)
+	require.NoError(t, err)
+	var stored []Transfer
+	require.NoError(t, json.Unmarshal(data, &stored))
+	require.Len(t, stored, 1, "transfer must be persisted")
+	assert.Equal(t, tr.ID, stored[0].ID)
+
+	wm.err = errExpectedTestError
+	tr, err = tm.CreateTransfer(t.Context(), &TransferRequest{Source: testExchange, Destination: transferDestination, Currency: currency.USDT, Amount: 100})
+	assert.ErrorIs(t, err, errExpectedTestError)
+	require.NotNil(t, tr, "failed transfer must be returned")
+	assert.Equal(t, TransferStatusFailed, tr.Status)
+
+	wm.err = nil
+	wm.resp = &withdraw.Response{ID: withdraw.DryRunID}
+	tr, err = tm.CreateTransfer(t.Context(), &TransferRequest{Source: testExchange, Destination: transferDestination, Currency: currency.USDT, Amount: 100})
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusCompleted, tr.Status, "dry run transfers should complete immediately")
+
+	transfers, err := tm.GetTransfers()
+	require.NoError(t, err)
+	assert.Len(t, transfers, 3)
+}
+
+func TestProcessTransfers(t *testing.T) {
+	t.Parallel()
+	tm, src, dst, _ := transferSetup(t)
+	tr, err := tm.CreateTransfer(t.Context(), &TransferRequest{Source: testExchange, Destination: transferDestination, Currency: currency.USDT, Amount: 100})
+	require.NoError(t, err)
+
+	src.withdrawals = []exchange.WithdrawalHistory{{TransferID: "1337", Status: "processing"}}
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusWithdrawing, tr.Status, "transfer should wait for the withdrawal to be sent")
+
+	src.withdrawals[0].Status = "Completed"
+	src.withdrawals[0].CryptoTxID = "0xdeadbeef"
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusDepositing, tr.Status)
+	assert.Equal(t, "0xdeadbeef", tr.TxID)
+
+	dst.funding = []exchange.FundingHistory{
+		{Currency: "USDT", TransferType: "deposit", CryptoTxID: "0xother", Amount: 99, Timestamp: time.Now()},
+	}
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusDepositing, tr.Status, "deposits with other transaction IDs must not match")
+
+	dst.funding = append(dst.funding, exchange.FundingHistory{Currency: "USDT", TransferType: "deposit", CryptoTxID: "0xdeadbeef", Amount: 99, Timestamp: time.Now()})
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusCompleted, tr.Status)
+	assert.False(t, tr.CompletedAt.IsZero(), "CompletedAt should be set")
+
+	tr, err = tm.CreateTransfer(t.Context(), &TransferRequest{Source: testExchange, Destination: transferDestination, Currency: currency.USDT, Amount: 50})
+	require.NoError(t, err)
+	src.withdrawals[0].Status = "Rejected"
+	src.withdrawals[0].CryptoTxID = ""
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusFailed, tr.Status)
+
+	tr, err = tm.CreateTransfer(t.Context(), &TransferRequest{Source: testExchange, Destination: transferDestination, Currency: currency.USDT, Amount: 25})
+	require.NoError(t, err)
+	tm.update(tr.ID, func(tr *Transfer) { tr.ExchangeWithdrawalID = "" })
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusDepositing, tr.Status, "transfers without a withdrawal ID should rely on the deposit")
+
+	dst.funding = append(dst.funding, exchange.FundingHistory{Currency: "USDT", TransferType: "deposit", CryptoToAddress: tr.Address, Amount: 24.5, Timestamp: time.Now()})
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusCompleted, tr.Status, "deposit should be matched by address and amount")
+
+	tr, err = tm.CreateTransfer(t.Context(), &TransferRequest{Source: testExchange, Destination: transferDestination, Currency: currency.USDT, Amount: 50})
+	require.NoError(t, err)
+	tm.update(tr.ID, func(tr *Transfer) { tr.SubmittedAt = time.Now().Add(-tm.cfg.Timeout * 2) })
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusFailed, tr.Status)
+	assert.Contains(t, tr.Error, errTransferTimedOut.Error())
+}
+
+func TestProcessTransferLaggingWithdrawalHistory(t *testing.T) {
+	t.Parallel()
+	tm, src, _, _ := transferSetup(t)
+	tr, err := tm.CreateTransfer(t.Context(), &TransferRequest{Source: testExchange, Destination: transferDestination, Currency: currency.USDT, Amount: 100})
+	require.NoError(t, err)
+
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusWithdrawing, tr.Status, "transfer should keep polling while the withdrawal is missing from history")
+
+	src.withdrawals = []exchange.WithdrawalHistory{{TransferID: "1337", Status: "Cancelled"}}
+	tm.processTransfers(t.Context())
+	tr, err = tm.GetTransfer(tr.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusFailed, tr.Status, "a withdrawal which fails after appearing in history should fail the transfer")
+}
+
+func TestMatchDeposit(t *testing.T) {
+	t.Parallel()
+	submitted := time.Now()
+	tr := &Transfer{Currency: currency.USDT, Amount: 100, EstimatedFee: 1, Address: "Ttrc", SubmittedAt: submitted}
+	funding := []exchange.FundingHistory{
+		{Currency: "BTC", TransferType: "deposit", Amount: 99, Timestamp: submitted.Add(time.Minute)},
+		{Currency: "USDT", TransferType: "withdrawal", Amount: 99, Timestamp: submitted.Add(time.Minute)},
+		{Currency: "USDT", TransferType: "deposit", Amount: 99, Timestamp: submitted.Add(-time.Minute)},
+		{Currency: "USDT", TransferType: "deposit", Amount: 99, CryptoToAddress: "Tother", Timestamp: submitted.Add(time.Minute)},
+		{Currency: "USDT", TransferType: "deposit", Amount: 50, Timestamp: submitted.Add(time.Minute)},
+		{Currency: "USDT", TransferType: "deposit", Amount: 99, Status: "failed", Timestamp: submitted.Add(time.Minute)},
+	}
+	assert.Nil(t, matchDeposit(funding, tr), "matchDeposit should not match unrelated deposits")
+
+	funding = append(funding, exchange.FundingHistory{Currency: "usdt", TransferType: "Deposit", Amount: 99, CryptoToAddress: "ttrc", Timestamp: submitted.Add(time.Minute)})
+	d := matchDeposit(funding, tr)
+	require.NotNil(t, d, "matchDeposit must match by address and amount")
+	assert.Equal(t, 99.0, d.Amount)
+}
+
+func TestTransferManagerResume(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), transferStoreFile)
+	transfers := []Transfer{
+		{ID: uuid.Must(uuid.NewV4()), Source: testExchange, Currency: currency.USDT, Status: TransferStatusSubmitting},
+		{ID: uuid.Must(uuid.NewV4()), Source: testExchange, Currency: currency.USDT, Status: TransferStatusDepositing, TxID: "0x1"},
+	}
+	data, err := json.Marshal(transfers)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	tm, err := SetupTransferManager(NewExchangeManager(), &testWithdrawManager{}, SetupDepositAddressManager(), &testCommsManager{}, &config.TransferManager{}, path)
+	require.NoError(t, err)
+
+	tr, err := tm.GetTransfer(transfers[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusFailed, tr.Status, "interrupted submissions must not be resumed")
+	assert.Equal(t, errTransferOutcomeUnknown.Error(), tr.Error)
+
+	tr, err = tm.GetTransfer(transfers[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, TransferStatusDepositing, tr.Status, "in flight transfers must be resumed")
+	assert.Equal(t, currency.USDT, tr.Currency)
+
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), string(TransferStatusFailed), "recovered state must be persisted")
+
+	_, err = tm.GetTransfer(uuid.Nil)
+	assert.ErrorIs(t, err, errTransferNotFound)
+}
@@ -0,0 +1,133 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+const (
+	// TransferManagerName is an exported subsystem name
+	TransferManagerName = "transfer_manager"
+	// DefaultTransferManagerPollInterval defines the default duration between
+	// transfer status checks
+	DefaultTransferManagerPollInterval = time.Minute
+	// DefaultTransferManagerTimeout defines the default maximum duration of a
+	// transfer before it is marked as failed
+	DefaultTransferManagerTimeout = time.Hour * 24
+	// transferStoreFile is the file name transfers are persisted to within
+	// the data directory
+	transferStoreFile = "transfers.json"
+	// transferEventType is the communications event type for transfer updates
+	transferEventType = "transfer"
+	// transferAmountTolerance is the relative difference allowed between the
+	// withdrawn amount and a deposit when matching without a transaction ID
+	transferAmountTolerance = 0.01
+)
+
+// TransferStatus is the state of an inter-exchange transfer
+type TransferStatus string
+
+// Transfer statuses
+const (
+	// TransferStatusSubmitting is set before the withdrawal is sent to the
+	// source exchange. A transfer found in this state on startup has an
+	// unknown outcome and is failed rather than resubmitted.
+	TransferStatusSubmitting TransferStatus = "submitting"
+	// TransferStatusWithdrawing is set once the source exchange has accepted
+	// the withdrawal
+	TransferStatusWithdrawing TransferStatus = "withdrawing"
+	// TransferStatusDepositing is set once the source exchange reports the
+	// withdrawal as sent
+	TransferStatusDepositing TransferStatus = "depositing"
+	// TransferStatusCompleted is set once the deposit is seen on the
+	// destination exchange
+	TransferStatusCompleted TransferStatus = "completed"
+	// TransferStatusFailed is set when any step of the transfer fails
+	TransferStatusFailed TransferStatus = "failed"
+)
+
+var (
+	errNilWithdrawManager       = errors.New("cannot start with nil withdraw manager")
+	errNilDepositAddressManager = errors.New("cannot start with nil deposit address manager")
+	errTransferStorePathUnset   = errors.New("transfer store path unset")
+	errSameTransferExchange     = errors.New("source and destination exchanges must differ")
+	errInvalidTransferAmount    = errors.New("transfer amount must be greater than zero")
+	errNoTransferChain          = errors.New("no transfer chain available with a destination deposit address")
+	errTransferNotFound         = errors.New("transfer not found")
+	errTransferTimedOut         = errors.New("transfer timed out")
+	errTransferOutcomeUnknown   = errors.New("transfer was interrupted during withdrawal submission, check the source exchange manually")
+	errWithdrawalRejected       = errors.New("withdrawal rejected by exchange")
+)
+
+// withdrawalCompleteStatuses are exchange withdrawal statuses which indicate
+// funds have left the source exchange
+var withdrawalCompleteStatuses = []string{"complete", "completed", "success", "succeeded", "successful", "done", "confirmed", "finished", "sent", "ok"}
+
+// withdrawalFailedStatuses are exchange withdrawal statuses which indicate the
+// withdrawal will not be sent
+var withdrawalFailedStatuses = []string{"failed", "failure", "rejected", "cancelled", "canceled", "refused", "error", "expired"}
+
+// TransferManager orchestrates moving funds between exchanges. Transfers are
+// persisted on every state change so they can be resumed after a restart.
+type TransferManager struct {
+	started               int32
+	shutdown              chan struct{}
+	wg                    sync.WaitGroup
+	exchangeManager       iExchangeManager
+	withdrawManager       iWithdrawManager
+	depositAddressManager iDepositAddressManager
+	commsManager          iCommsManager
+	cfg                   config.TransferManager
+	storePath             string
+
+	m         sync.Mutex
+	transfers map[uuid.UUID]*Transfer
+}
+
+// TransferRequest holds the parameters required to create a transfer
+type TransferRequest struct {
+	Source      string
+	Destination string
+	Currency    currency.Code
+	Amount      float64
+	// Chain is optional and restricts the transfer to the specified chain
+	Chain string
+}
+
+// Transfer holds the state of an inter-exchange transfer
+type Transfer struct {
+	ID           uuid.UUID      `json:"id"`
+	Source       string         `json:"source"`
+	Destination  string         `json:"destination"`
+	Currency     currency.Code  `json:"currency"`
+	Amount       float64        `json:"amount"`
+	Chain        string         `json:"chain"`
+	Address      string         `json:"address"`
+	AddressTag   string         `json:"addressTag,omitempty"`
+	EstimatedFee float64        `json:"estimatedFee"`
+	Status       TransferStatus `json:"status"`
+	// WithdrawalID is the withdraw manager's ID for the withdrawal request
+	WithdrawalID string `json:"withdrawalID,omitempty"`
+	// ExchangeWithdrawalID is the source exchange's ID for the withdrawal
+	ExchangeWithdrawalID string    `json:"exchangeWithdrawalID,omitempty"`
+	TxID                 string    `json:"txID,omitempty"`
+	Error                string    `json:"error,omitempty"`
+	CreatedAt            time.Time `json:"createdAt"`
+	SubmittedAt          time.Time `json:"submittedAt"`
+	UpdatedAt            time.Time `json:"updatedAt"`
+	CompletedAt          time.Time `json:"completedAt"`
+}
+
+// transferChain is a candidate chain for a transfer
+type transferChain struct {
+	chain      string
+	address    string
+	addressTag string
+	fee        float64
+	feeKnown   bool
+}
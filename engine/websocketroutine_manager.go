@@ -427,3 +427,19 @@ func (m *WebsocketRoutineManager) setWebsocketDataHandler(fn WebsocketDataHandle
 	m.mu.Unlock()
 	return nil
 }
+
+// setCurrencyPairSyncer replaces the syncer notified of websocket updates.
+// Data handlers are called under the read lock so the swap cannot race with
+// an in flight update.
+func (m *WebsocketRoutineManager) setCurrencyPairSyncer(syncer iCurrencyPairSyncer) error {
+	if m == nil {
+		return fmt.Errorf("%T %w", m, ErrNilSubsystem)
+	}
+	if syncer == nil {
+		return errNilCurrencyPairSyncer
+	}
+	m.mu.Lock()
+	m.syncer = syncer
+	m.mu.Unlock()
+	return nil
+}
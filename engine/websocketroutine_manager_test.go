@@ -310,3 +310,18 @@ func TestSetWebsocketDataHandler(t *testing.T) {
 		t.Fatal("unexpected data handler count")
 	}
 }
+
+func TestSetCurrencyPairSyncer(t *testing.T) {
+	t.Parallel()
+	var m *WebsocketRoutineManager
+	err := m.setCurrencyPairSyncer(&SyncManager{})
+	require.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = new(WebsocketRoutineManager)
+	err = m.setCurrencyPairSyncer(nil)
+	require.ErrorIs(t, err, errNilCurrencyPairSyncer)
+
+	s := &SyncManager{}
+	require.NoError(t, m.setCurrencyPairSyncer(s), "setCurrencyPairSyncer must not error")
+	assert.Same(t, s, m.syncer, "syncer should be replaced")
+}
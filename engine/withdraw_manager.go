@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
@@ -15,13 +16,14 @@ import (
 )
 
 // SetupWithdrawManager creates a new withdraw manager
-func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, isDryRun bool) (*WithdrawManager, error) {
+func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, comms iCommsManager, isDryRun bool) (*WithdrawManager, error) {
 	if em == nil {
 		return nil, errors.New("nil manager")
 	}
 	return &WithdrawManager{
 		exchangeManager:  em,
 		portfolioManager: pm,
+		commsManager:     comms,
 		isDryRun:         isDryRun,
 	}, nil
 }
@@ -89,9 +91,39 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 	if err == nil {
 		withdraw.Cache.Add(resp.ID, resp)
 	}
+	m.notify(resp, err)
 	return resp, err
 }
 
+// notify pushes a withdrawal notification to the communications manager
+func (m *WithdrawManager) notify(resp *withdraw.Response, err error) {
+	if m.commsManager == nil {
+		return
+	}
+	req := &resp.RequestDetails
+	destination := req.Crypto.Address
+	if req.Type == withdraw.Fiat {
+		destination = "bank account"
+	}
+	evt := base.Event{
+		Category: base.CategoryWithdrawal,
+		Severity: base.SeverityInfo,
+		Message:  fmt.Sprintf("Exchange %s withdrawal ID=%v of %v %s to %s status: %s", req.Exchange, resp.ID, req.Amount, req.Currency, destination, resp.Exchange.Status),
+		Exchange: req.Exchange,
+		Payload: map[string]any{
+			"id":         resp.ID.String(),
+			"exchangeID": resp.Exchange.ID,
+			"currency":   req.Currency.String(),
+			"amount":     req.Amount,
+			"status":     resp.Exchange.Status,
+		},
+	}
+	if err != nil {
+		evt.Severity = base.SeverityError
+	}
+	m.commsManager.PushEvent(evt)
+}
+
 // WithdrawalEventByID returns a withdrawal request by ID
 func (m *WithdrawManager) WithdrawalEventByID(id string) (*withdraw.Response, error) {
 	if m == nil {
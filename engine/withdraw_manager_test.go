@@ -47,7 +47,7 @@ func withdrawManagerTestHelper(t *testing.T) (*ExchangeManager, *portfolioManage
 func TestSubmitWithdrawal(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, pm, false)
+	m, err := SetupWithdrawManager(em, pm, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -120,7 +120,7 @@ func TestSubmitWithdrawal(t *testing.T) {
 func TestWithdrawEventByID(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, pm, false)
+	m, err := SetupWithdrawManager(em, pm, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -148,7 +148,7 @@ func TestWithdrawEventByID(t *testing.T) {
 func TestWithdrawalEventByExchange(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, pm, false)
+	m, err := SetupWithdrawManager(em, pm, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -163,7 +163,7 @@ func TestWithdrawalEventByExchange(t *testing.T) {
 func TestWithdrawEventByDate(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, pm, false)
+	m, err := SetupWithdrawManager(em, pm, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -178,7 +178,7 @@ func TestWithdrawEventByDate(t *testing.T) {
 func TestWithdrawalEventByExchangeID(t *testing.T) {
 	t.Parallel()
 	em, _ := withdrawManagerTestHelper(t)
-	m, err := SetupWithdrawManager(em, nil, false)
+	m, err := SetupWithdrawManager(em, nil, nil, false)
 	if err != nil {
 		t.Fatal(err)
 	}
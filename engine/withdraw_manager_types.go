@@ -12,5 +12,6 @@ var ErrWithdrawRequestNotFound = errors.New("request not found")
 type WithdrawManager struct {
 	exchangeManager  iExchangeManager
 	portfolioManager iPortfolioManager
+	commsManager     iCommsManager
 	isDryRun         bool
 }
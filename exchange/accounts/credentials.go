@@ -21,6 +21,10 @@ const (
 	// context, when the default config credentials sub account needs to be
 	// changed while the same keys can be used.
 	ContextSubAccountFlag contextCredential = "subaccountoverride"
+	// ContextAccountFlag used for retrieving a named account from context,
+	// when a configured set of credentials other than the default needs to be
+	// used.
+	ContextAccountFlag contextCredential = "accountname"
 
 	apiKeyDisplaySize = 16
 )
@@ -41,6 +45,13 @@ var (
 	errMissingInfo                     = errors.New("cannot parse meta data missing information in key value pair")
 )
 
+// CredentialProvider fetches exchange API credentials from an external store
+// at the time of use. An empty account refers to the exchange's default
+// credentials.
+type CredentialProvider interface {
+	GetCredentials(ctx context.Context, exchange, account string) (*Credentials, error)
+}
+
 // Credentials define parameters that allow for an authenticated request.
 type Credentials struct {
 	Key                 string
@@ -50,6 +61,10 @@ type Credentials struct {
 	SubAccount          string
 	OneTimePassword     string
 	SecretBase64Decoded bool
+	// PIN and TradePassword are only used to authorise withdrawals and are
+	// only set by credential providers
+	PIN           string
+	TradePassword string
 	// TODO: Add AccessControl uint8 for READ/WRITE/Withdraw capabilities.
 }
 
@@ -205,6 +220,23 @@ func DeployCredentialsToContext(ctx context.Context, creds *Credentials) context
 	return context.WithValue(ctx, flag, store)
 }
 
+// DeployAccountToContext sets a named account to context which selects the
+// matching configured credentials. An empty name leaves the context untouched
+// so the default credentials are used.
+func DeployAccountToContext(ctx context.Context, name string) context.Context {
+	if name == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ContextAccountFlag, name)
+}
+
+// AccountFromContext returns the named account set in context, or an empty
+// string if the default credentials are in use.
+func AccountFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(ContextAccountFlag).(string)
+	return name
+}
+
 // DeploySubAccountOverrideToContext sets subaccount as override to credentials
 // as a separate flag.
 func DeploySubAccountOverrideToContext(ctx context.Context, subAccount string) context.Context {
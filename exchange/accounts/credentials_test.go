@@ -3,6 +3,7 @@ package accounts
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 )
@@ -172,3 +173,11 @@ func TestCredentialsEqual(t *testing.T) {
 		t.Fatal("unexpected value")
 	}
 }
+
+func TestDeployAccountToContext(t *testing.T) {
+	t.Parallel()
+	ctx := DeployAccountToContext(t.Context(), "")
+	assert.Empty(t, AccountFromContext(ctx), "AccountFromContext should return empty for the default account")
+	ctx = DeployAccountToContext(ctx, "arbitrage")
+	assert.Equal(t, "arbitrage", AccountFromContext(ctx))
+}
@@ -0,0 +1,261 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"golang.org/x/crypto/scrypt"
+)
+
+// NewKeystore returns a keystore backed by the file at path. The file is
+// created when the first key is set.
+func NewKeystore(path string) (*Keystore, error) {
+	if path == "" {
+		return nil, errKeystorePathEmpty
+	}
+	return &Keystore{path: path, unlocked: make(map[string]*unlockedKey)}, nil
+}
+
+// Set encrypts and stores the secret for an exchange account with the
+// supplied passphrase, replacing any existing secret. The key is left
+// unlocked.
+func (k *Keystore) Set(exchange, account string, passphrase []byte, s *Secret) error {
+	if exchange == "" {
+		return errExchangeNameEmpty
+	}
+	if len(passphrase) == 0 {
+		return errPassphraseEmpty
+	}
+	if s == nil || *s == (Secret{}) {
+		return errSecretEmpty
+	}
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	dk, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return err
+	}
+	plaintext, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	data, err := encrypt(dk, plaintext)
+	if err != nil {
+		return err
+	}
+
+	k.m.Lock()
+	defer k.m.Unlock()
+	f, err := k.load()
+	if err != nil {
+		return err
+	}
+	p := Path(exchange, account)
+	f.Keys[p] = &keystoreEntry{Salt: salt, Data: data, Updated: time.Now().UTC()}
+	if err := k.save(f); err != nil {
+		return err
+	}
+	k.unlocked[p] = &unlockedKey{passphrase: bytes.Clone(passphrase), salt: salt, dk: dk}
+	return nil
+}
+
+// Remove deletes the secret for an exchange account
+func (k *Keystore) Remove(exchange, account string) error {
+	k.m.Lock()
+	defer k.m.Unlock()
+	f, err := k.load()
+	if err != nil {
+		return err
+	}
+	p := Path(exchange, account)
+	if _, ok := f.Keys[p]; !ok {
+		return fmt.Errorf("%s %w", p, ErrSecretNotFound)
+	}
+	delete(f.Keys, p)
+	delete(k.unlocked, p)
+	return k.save(f)
+}
+
+// Unlock verifies the passphrase for an exchange account's secret and holds
+// it in memory so the secret can be fetched
+func (k *Keystore) Unlock(exchange, account string, passphrase []byte) error {
+	if len(passphrase) == 0 {
+		return errPassphraseEmpty
+	}
+	k.m.Lock()
+	defer k.m.Unlock()
+	f, err := k.load()
+	if err != nil {
+		return err
+	}
+	p := Path(exchange, account)
+	e, ok := f.Keys[p]
+	if !ok {
+		return fmt.Errorf("%s %w", p, ErrSecretNotFound)
+	}
+	dk, err := deriveKey(passphrase, e.Salt)
+	if err != nil {
+		return err
+	}
+	if _, err := decrypt(dk, e.Data); err != nil {
+		return fmt.Errorf("%s: %w", p, err)
+	}
+	k.unlocked[p] = &unlockedKey{passphrase: bytes.Clone(passphrase), salt: e.Salt, dk: dk}
+	return nil
+}
+
+// Lock removes the passphrase for an exchange account from memory
+func (k *Keystore) Lock(exchange, account string) {
+	k.m.Lock()
+	defer k.m.Unlock()
+	delete(k.unlocked, Path(exchange, account))
+}
+
+// IsUnlocked returns whether an exchange account's key has been unlocked
+func (k *Keystore) IsUnlocked(exchange, account string) bool {
+	k.m.Lock()
+	defer k.m.Unlock()
+	_, ok := k.unlocked[Path(exchange, account)]
+	return ok
+}
+
+// Keys returns the sorted paths of all stored secrets
+func (k *Keystore) Keys() ([]string, error) {
+	k.m.Lock()
+	defer k.m.Unlock()
+	f, err := k.load()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(f.Keys))
+	for p := range f.Keys {
+		keys = append(keys, p)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+// GetCredentials reads and decrypts the credentials for an unlocked exchange
+// account from the keystore file
+func (k *Keystore) GetCredentials(_ context.Context, exchange, account string) (*accounts.Credentials, error) {
+	k.m.Lock()
+	defer k.m.Unlock()
+	p := Path(exchange, account)
+	u, ok := k.unlocked[p]
+	if !ok {
+		return nil, fmt.Errorf("%s %w", p, ErrKeyLocked)
+	}
+	f, err := k.load()
+	if err != nil {
+		return nil, err
+	}
+	e, ok := f.Keys[p]
+	if !ok {
+		return nil, fmt.Errorf("%s %w", p, ErrSecretNotFound)
+	}
+	if !bytes.Equal(u.salt, e.Salt) {
+		// The secret has been rotated since it was unlocked
+		if u.dk, err = deriveKey(u.passphrase, e.Salt); err != nil {
+			return nil, err
+		}
+		u.salt = e.Salt
+	}
+	plaintext, err := decrypt(u.dk, e.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", p, err)
+	}
+	var s Secret
+	if err := json.Unmarshal(plaintext, &s); err != nil {
+		return nil, err
+	}
+	return s.toCredentials(), nil
+}
+
+// load reads the keystore file, returning an empty keystore if it does not
+// exist
+func (k *Keystore) load() (*keystoreFile, error) {
+	f := &keystoreFile{Version: keystoreVersion, Keys: make(map[string]*keystoreEntry)}
+	data, err := os.ReadFile(k.path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return f, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, err
+	}
+	if f.Version != keystoreVersion {
+		return nil, fmt.Errorf("%w: %d", errUnsupportedKeystore, f.Version)
+	}
+	if f.Keys == nil {
+		f.Keys = make(map[string]*keystoreEntry)
+	}
+	return f, nil
+}
+
+// save atomically replaces the keystore file, readable only by the owner
+func (k *Keystore) save(f *keystoreFile) error {
+	data, err := json.MarshalIndent(f, "", " ")
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(k.path)
+	if err := os.MkdirAll(dir, file.DefaultPermissionOctal); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(k.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		return errors.Join(err, tmp.Close(), os.Remove(tmp.Name()))
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Join(err, os.Remove(tmp.Name()))
+	}
+	return os.Rename(tmp.Name(), k.path)
+}
+
+func deriveKey(passphrase, salt []byte) ([]byte, error) {
+	return scrypt.Key(passphrase, salt, 32768, 8, 1, 32)
+}
+
+func encrypt(dk, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(dk)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCMWithRandomNonce(block)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nil, nil, plaintext, nil), nil
+}
+
+func decrypt(dk, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(dk)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCMWithRandomNonce(block)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Open(nil, nil, ciphertext, nil)
+}
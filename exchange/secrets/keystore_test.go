@@ -0,0 +1,74 @@
+package secrets
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeystore(t *testing.T) {
+	t.Parallel()
+	_, err := NewKeystore("")
+	assert.ErrorIs(t, err, errKeystorePathEmpty)
+
+	path := filepath.Join(t.TempDir(), "keys", DefaultKeystoreFile)
+	k, err := NewKeystore(path)
+	require.NoError(t, err)
+
+	_, err = k.GetCredentials(t.Context(), "Binance", "")
+	assert.ErrorIs(t, err, ErrKeyLocked)
+	assert.ErrorIs(t, k.Unlock("Binance", "", []byte("pass")), ErrSecretNotFound)
+	assert.ErrorIs(t, k.Set("", "", []byte("pass"), &Secret{Key: "k"}), errExchangeNameEmpty)
+	assert.ErrorIs(t, k.Set("Binance", "", nil, &Secret{Key: "k"}), errPassphraseEmpty)
+	assert.ErrorIs(t, k.Set("Binance", "", []byte("pass"), &Secret{}), errSecretEmpty)
+
+	require.NoError(t, k.Set("Binance", "", []byte("pass"), &Secret{Key: "k1", Secret: "s1", OTPSecret: "otp", PIN: "1234", TradePassword: "tp"}))
+	require.NoError(t, k.Set("Binance", "Hedge", []byte("hedge"), &Secret{Key: "k2", Secret: "s2"}))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "s1", "secrets must not be stored in plaintext")
+
+	keys, err := k.Keys()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"binance/default", "binance/hedge"}, keys)
+
+	creds, err := k.GetCredentials(t.Context(), "binance", "")
+	require.NoError(t, err)
+	assert.Equal(t, "k1", creds.Key)
+	assert.Equal(t, "s1", creds.Secret)
+	assert.Equal(t, "otp", creds.OneTimePassword)
+	assert.Equal(t, "1234", creds.PIN)
+	assert.Equal(t, "tp", creds.TradePassword)
+
+	// A second keystore on the same file is locked until each key is unlocked
+	k2, err := NewKeystore(path)
+	require.NoError(t, err)
+	assert.False(t, k2.IsUnlocked("Binance", ""))
+	assert.Error(t, k2.Unlock("Binance", "", []byte("wrong")), "Unlock must error with the wrong passphrase")
+	require.NoError(t, k2.Unlock("Binance", "", []byte("pass")))
+	assert.True(t, k2.IsUnlocked("Binance", ""))
+	_, err = k2.GetCredentials(t.Context(), "Binance", "Hedge")
+	assert.ErrorIs(t, err, ErrKeyLocked, "keys should be unlocked individually")
+
+	// Rotating with the same passphrase is picked up by other holders
+	require.NoError(t, k.Set("Binance", "", []byte("pass"), &Secret{Key: "k3", Secret: "s3"}))
+	creds, err = k2.GetCredentials(t.Context(), "Binance", "")
+	require.NoError(t, err)
+	assert.Equal(t, "k3", creds.Key, "rotated credentials should be read from the file")
+
+	k2.Lock("Binance", "")
+	_, err = k2.GetCredentials(t.Context(), "Binance", "")
+	assert.ErrorIs(t, err, ErrKeyLocked)
+
+	require.NoError(t, k.Remove("Binance", "Hedge"))
+	assert.ErrorIs(t, k.Remove("Binance", "Hedge"), ErrSecretNotFound)
+	_, err = k.GetCredentials(t.Context(), "Binance", "Hedge")
+	assert.ErrorIs(t, err, ErrKeyLocked)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":2}`), 0o600))
+	_, err = k.Keys()
+	assert.ErrorIs(t, err, errUnsupportedKeystore)
+}
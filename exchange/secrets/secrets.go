@@ -0,0 +1,124 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+// New returns a cached credential provider for the supplied config. A relative
+// or empty keystore path is resolved against the data directory.
+func New(cfg *config.CredentialProvider, dataDir string) (*Cache, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w: config", errNilProvider)
+	}
+	var p accounts.CredentialProvider
+	switch cfg.Type {
+	case config.CredentialProviderKeystore:
+		path := cfg.Keystore.Path
+		if path == "" {
+			path = DefaultKeystoreFile
+		}
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dataDir, path)
+		}
+		k, err := NewKeystore(path)
+		if err != nil {
+			return nil, err
+		}
+		p = k
+	case config.CredentialProviderVault:
+		v, err := NewVault(&cfg.Vault)
+		if err != nil {
+			return nil, err
+		}
+		p = v
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownProviderType, cfg.Type)
+	}
+	return NewCache(p, cfg.CacheTTL)
+}
+
+// NewCache wraps a credential provider, holding fetched credentials for the
+// supplied ttl. A ttl of zero or less fetches credentials on every request.
+func NewCache(p accounts.CredentialProvider, ttl time.Duration) (*Cache, error) {
+	if p == nil {
+		return nil, errNilProvider
+	}
+	return &Cache{provider: p, ttl: ttl, entries: make(map[string]*cacheEntry)}, nil
+}
+
+// Provider returns the underlying credential provider
+func (c *Cache) Provider() accounts.CredentialProvider {
+	return c.provider
+}
+
+// GetCredentials returns cached credentials for the exchange account, fetching
+// them from the underlying provider once expired
+func (c *Cache) GetCredentials(ctx context.Context, exchange, account string) (*accounts.Credentials, error) {
+	k := Path(exchange, account)
+	if c.ttl > 0 {
+		c.m.Lock()
+		e, ok := c.entries[k]
+		c.m.Unlock()
+		if ok && time.Now().Before(e.expires) {
+			creds := e.creds
+			return &creds, nil
+		}
+	}
+	creds, err := c.provider.GetCredentials(ctx, exchange, account)
+	if err != nil {
+		return nil, err
+	}
+	if c.ttl > 0 {
+		c.m.Lock()
+		c.entries[k] = &cacheEntry{creds: *creds, expires: time.Now().Add(c.ttl)}
+		c.m.Unlock()
+	}
+	return creds, nil
+}
+
+// Invalidate removes cached credentials for an exchange so they are fetched
+// again on next use. An empty exchange name invalidates all credentials.
+func (c *Cache) Invalidate(exchange string) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if exchange == "" {
+		clear(c.entries)
+		return
+	}
+	prefix := strings.ToLower(exchange) + "/"
+	for k := range c.entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// Path returns the lower case path a secret is stored under for an exchange
+// account e.g. binance/default
+func Path(exchange, account string) string {
+	if account == "" {
+		account = defaultAccount
+	}
+	return strings.ToLower(exchange) + "/" + strings.ToLower(account)
+}
+
+// toCredentials converts a stored secret to exchange API credentials
+func (s *Secret) toCredentials() *accounts.Credentials {
+	return &accounts.Credentials{
+		Key:             s.Key,
+		Secret:          s.Secret,
+		ClientID:        s.ClientID,
+		PEMKey:          s.PEMKey,
+		SubAccount:      s.SubAccount,
+		OneTimePassword: s.OTPSecret,
+		PIN:             s.PIN,
+		TradePassword:   s.TradePassword,
+	}
+}
@@ -0,0 +1,106 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+var errTestProvider = errors.New("test provider error")
+
+type testProvider struct {
+	calls int
+	key   string
+	err   error
+}
+
+func (t *testProvider) GetCredentials(context.Context, string, string) (*accounts.Credentials, error) {
+	t.calls++
+	if t.err != nil {
+		return nil, t.err
+	}
+	return &accounts.Credentials{Key: t.key, Secret: "secret"}, nil
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil, "")
+	assert.ErrorIs(t, err, errNilProvider)
+
+	_, err = New(&config.CredentialProvider{Type: "bad"}, "")
+	assert.ErrorIs(t, err, errUnknownProviderType)
+
+	dir := t.TempDir()
+	c, err := New(&config.CredentialProvider{Type: config.CredentialProviderKeystore}, dir)
+	require.NoError(t, err)
+	k, ok := c.Provider().(*Keystore)
+	require.True(t, ok, "Provider must be a keystore")
+	assert.Equal(t, filepath.Join(dir, DefaultKeystoreFile), k.path, "path should default to the data directory")
+
+	_, err = New(&config.CredentialProvider{Type: config.CredentialProviderVault}, dir)
+	assert.ErrorIs(t, err, errVaultAddressEmpty)
+
+	c, err = New(&config.CredentialProvider{Type: config.CredentialProviderVault, Vault: config.VaultCredentialProvider{Address: "http://localhost:8200", KVVersion: 2}}, dir)
+	require.NoError(t, err)
+	assert.IsType(t, &Vault{}, c.Provider())
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+	_, err := NewCache(nil, time.Minute)
+	assert.ErrorIs(t, err, errNilProvider)
+
+	p := &testProvider{key: "a"}
+	c, err := NewCache(p, time.Hour)
+	require.NoError(t, err)
+
+	creds, err := c.GetCredentials(t.Context(), "Binance", "")
+	require.NoError(t, err)
+	assert.Equal(t, "a", creds.Key)
+
+	creds.Key = "modified"
+	p.key = "b"
+	creds, err = c.GetCredentials(t.Context(), "binance", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "a", creds.Key, "cached credentials should be returned unmodified")
+	assert.Equal(t, 1, p.calls)
+
+	c.Invalidate("Bitstamp")
+	_, err = c.GetCredentials(t.Context(), "Binance", "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, p.calls, "invalidating another exchange should not refetch")
+
+	c.Invalidate("BINANCE")
+	creds, err = c.GetCredentials(t.Context(), "Binance", "")
+	require.NoError(t, err)
+	assert.Equal(t, "b", creds.Key, "rotated credentials should be fetched after invalidation")
+
+	c.Invalidate("")
+	assert.Empty(t, c.entries)
+
+	p.err = errTestProvider
+	_, err = c.GetCredentials(t.Context(), "Binance", "")
+	assert.ErrorIs(t, err, errTestProvider)
+
+	p = &testProvider{key: "a"}
+	c, err = NewCache(p, 0)
+	require.NoError(t, err)
+	for range 2 {
+		_, err = c.GetCredentials(t.Context(), "Binance", "")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, p.calls, "a zero ttl should fetch on every request")
+}
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "binance/default", Path("Binance", ""))
+	assert.Equal(t, "binance/hedge", Path("Binance", "Hedge"))
+}
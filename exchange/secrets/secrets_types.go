@@ -0,0 +1,127 @@
+package secrets
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+const (
+	// DefaultKeystoreFile is the file name of the keystore within the data
+	// directory when no path is configured
+	DefaultKeystoreFile = "keystore.json"
+	// defaultAccount is the path element used for an exchange's default
+	// credentials
+	defaultAccount  = "default"
+	keystoreVersion = 1
+	saltLength      = 16
+)
+
+// Public errors
+var (
+	// ErrSecretNotFound is returned when no secret is stored for the requested
+	// exchange account
+	ErrSecretNotFound = errors.New("secret not found")
+	// ErrKeyLocked is returned when a keystore key has not been unlocked
+	ErrKeyLocked = errors.New("keystore key is locked")
+)
+
+var (
+	errNilProvider          = errors.New("credential provider cannot be nil")
+	errExchangeNameEmpty    = errors.New("exchange name cannot be empty")
+	errPassphraseEmpty      = errors.New("passphrase cannot be empty")
+	errKeystorePathEmpty    = errors.New("keystore path cannot be empty")
+	errUnsupportedKeystore  = errors.New("unsupported keystore version")
+	errVaultAddressEmpty    = errors.New("vault address cannot be empty")
+	errVaultTokenUnset      = errors.New("vault token environment variable unset")
+	errUnsupportedKVVersion = errors.New("unsupported vault KV version")
+	errUnexpectedStatus     = errors.New("unexpected response status")
+	errUnknownProviderType  = errors.New("unknown credential provider type")
+	errSecretEmpty          = errors.New("secret cannot be empty")
+)
+
+// Secret is the stored representation of an exchange account's API
+// credentials
+type Secret struct {
+	Key        string `json:"key"`
+	Secret     string `json:"secret"`
+	ClientID   string `json:"clientID,omitempty"`
+	PEMKey     string `json:"pemKey,omitempty"`
+	SubAccount string `json:"subAccount,omitempty"`
+	OTPSecret  string `json:"otpSecret,omitempty"`
+	// PIN and TradePassword are only used to authorise withdrawals
+	PIN           string `json:"pin,omitempty"`
+	TradePassword string `json:"tradePassword,omitempty"`
+}
+
+// Cache wraps a credential provider and holds fetched credentials in memory
+// for a limited time, so credentials rotated in the underlying store are
+// picked up without a restart
+type Cache struct {
+	provider accounts.CredentialProvider
+	ttl      time.Duration
+
+	m       sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	creds   accounts.Credentials
+	expires time.Time
+}
+
+// Keystore is a local file of exchange API credentials, each encrypted with
+// its own passphrase. Keys must be unlocked before use and are decrypted from
+// the file each time they are fetched.
+type Keystore struct {
+	path string
+
+	m        sync.Mutex
+	unlocked map[string]*unlockedKey
+}
+
+type unlockedKey struct {
+	passphrase []byte
+	salt       []byte
+	dk         []byte
+}
+
+type keystoreFile struct {
+	Version int                       `json:"version"`
+	Keys    map[string]*keystoreEntry `json:"keys"`
+}
+
+type keystoreEntry struct {
+	Salt    []byte    `json:"salt"`
+	Data    []byte    `json:"data"`
+	Updated time.Time `json:"updated"`
+}
+
+// Vault fetches credentials from a HashiCorp Vault compatible KV secrets
+// engine over HTTP
+type Vault struct {
+	address     string
+	namespace   string
+	mount       string
+	pathPrefix  string
+	kvVersion   int
+	tokenEnvVar string
+	client      *http.Client
+}
+
+type vaultKVv1Response struct {
+	Data *Secret `json:"data"`
+}
+
+type vaultKVv2Response struct {
+	Data struct {
+		Data *Secret `json:"data"`
+	} `json:"data"`
+}
+
+type vaultErrorResponse struct {
+	Errors []string `json:"errors"`
+}
@@ -0,0 +1,118 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+)
+
+// NewVault returns a Vault KV credential provider. The token is read from the
+// configured environment variable on each request so it can be renewed
+// without a restart.
+func NewVault(cfg *config.VaultCredentialProvider) (*Vault, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w: vault config", errNilProvider)
+	}
+	if cfg.Address == "" {
+		return nil, errVaultAddressEmpty
+	}
+	if cfg.KVVersion != 1 && cfg.KVVersion != 2 {
+		return nil, fmt.Errorf("%w: %d", errUnsupportedKVVersion, cfg.KVVersion)
+	}
+	return &Vault{
+		address:     strings.TrimSuffix(cfg.Address, "/"),
+		namespace:   cfg.Namespace,
+		mount:       strings.Trim(cfg.Mount, "/"),
+		pathPrefix:  strings.Trim(cfg.PathPrefix, "/"),
+		kvVersion:   cfg.KVVersion,
+		tokenEnvVar: cfg.TokenEnvVar,
+		client:      common.NewHTTPClientWithTimeout(cfg.Timeout),
+	}, nil
+}
+
+// GetCredentials fetches the credentials for an exchange account from the KV
+// secrets engine at <mount>/<pathPrefix>/<exchange>/<account>
+func (v *Vault) GetCredentials(ctx context.Context, exchange, account string) (*accounts.Credentials, error) {
+	if exchange == "" {
+		return nil, errExchangeNameEmpty
+	}
+	token := os.Getenv(v.tokenEnvVar)
+	if token == "" {
+		return nil, fmt.Errorf("%w: %s", errVaultTokenUnset, v.tokenEnvVar)
+	}
+	u, err := v.secretURL(exchange, account)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	p := Path(exchange, account)
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s %w", p, ErrSecretNotFound)
+	default:
+		var e vaultErrorResponse
+		if err := json.Unmarshal(body, &e); err == nil && len(e.Errors) > 0 {
+			return nil, fmt.Errorf("%s %w %d: %s", p, errUnexpectedStatus, resp.StatusCode, strings.Join(e.Errors, ", "))
+		}
+		return nil, fmt.Errorf("%s %w %d", p, errUnexpectedStatus, resp.StatusCode)
+	}
+
+	var s *Secret
+	if v.kvVersion == 1 {
+		var r vaultKVv1Response
+		if err := json.Unmarshal(body, &r); err != nil {
+			return nil, err
+		}
+		s = r.Data
+	} else {
+		var r vaultKVv2Response
+		if err := json.Unmarshal(body, &r); err != nil {
+			return nil, err
+		}
+		s = r.Data.Data
+	}
+	if s == nil || *s == (Secret{}) {
+		// KV v2 returns deleted versions with nil data
+		return nil, fmt.Errorf("%s %w", p, ErrSecretNotFound)
+	}
+	return s.toCredentials(), nil
+}
+
+func (v *Vault) secretURL(exchange, account string) (string, error) {
+	elems := []string{"v1", v.mount}
+	if v.kvVersion == 2 {
+		elems = append(elems, "data")
+	}
+	if v.pathPrefix != "" {
+		elems = append(elems, v.pathPrefix)
+	}
+	elems = append(elems, strings.Split(Path(exchange, account), "/")...)
+	return url.JoinPath(v.address, elems...)
+}
@@ -0,0 +1,89 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+// newVaultStandIn returns a server which serves a single KV v1 and v2 secret
+func newVaultStandIn(t *testing.T) *httptest.Server {
+	t.Helper()
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/v1/secret/data/gct/binance/default":
+			_, _ = w.Write([]byte(`{"data":{"data":{"key":"k2","secret":"s2","subAccount":"sub"},"metadata":{"version":3}}}`))
+		case "/v1/kv/gct/binance/hedge":
+			_, _ = w.Write([]byte(`{"data":{"key":"k1","secret":"s1"}}`))
+		case "/v1/secret/data/gct/binance/deleted":
+			_, _ = w.Write([]byte(`{"data":{"data":null,"metadata":{"deletion_time":"2024-01-01T00:00:00Z"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestVault(t *testing.T) {
+	_, err := NewVault(nil)
+	assert.ErrorIs(t, err, errNilProvider)
+	_, err = NewVault(&config.VaultCredentialProvider{})
+	assert.ErrorIs(t, err, errVaultAddressEmpty)
+	_, err = NewVault(&config.VaultCredentialProvider{Address: "http://localhost", KVVersion: 3})
+	assert.ErrorIs(t, err, errUnsupportedKVVersion)
+
+	s := newVaultStandIn(t)
+	cfg := &config.VaultCredentialProvider{
+		Address:     s.URL + "/",
+		Mount:       "secret",
+		PathPrefix:  "/gct/",
+		KVVersion:   2,
+		TokenEnvVar: "GCT_TEST_VAULT_TOKEN",
+		Timeout:     time.Second,
+	}
+	v, err := NewVault(cfg)
+	require.NoError(t, err)
+
+	_, err = v.GetCredentials(t.Context(), "Binance", "")
+	assert.ErrorIs(t, err, errVaultTokenUnset)
+
+	t.Setenv("GCT_TEST_VAULT_TOKEN", "bad")
+	_, err = v.GetCredentials(t.Context(), "Binance", "")
+	assert.ErrorIs(t, err, errUnexpectedStatus)
+	assert.ErrorContains(t, err, "permission denied")
+
+	t.Setenv("GCT_TEST_VAULT_TOKEN", "token")
+	_, err = v.GetCredentials(t.Context(), "", "")
+	assert.ErrorIs(t, err, errExchangeNameEmpty)
+
+	creds, err := v.GetCredentials(t.Context(), "Binance", "")
+	require.NoError(t, err)
+	assert.Equal(t, "k2", creds.Key)
+	assert.Equal(t, "s2", creds.Secret)
+	assert.Equal(t, "sub", creds.SubAccount)
+
+	_, err = v.GetCredentials(t.Context(), "Binance", "missing")
+	assert.ErrorIs(t, err, ErrSecretNotFound)
+	_, err = v.GetCredentials(t.Context(), "Binance", "deleted")
+	assert.ErrorIs(t, err, ErrSecretNotFound, "deleted KV v2 versions should not be found")
+
+	cfg.Mount = "kv"
+	cfg.KVVersion = 1
+	v, err = NewVault(cfg)
+	require.NoError(t, err)
+	creds, err = v.GetCredentials(t.Context(), "Binance", "Hedge")
+	require.NoError(t, err)
+	assert.Equal(t, "k1", creds.Key)
+}
@@ -18,10 +18,13 @@ import (
 
 	gws "github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -299,6 +302,10 @@ func (c *connection) ReadMessage() Response {
 	default: // Non-Blocking write ensures 1 buffered signal per trafficCheckInterval to avoid flooding
 	}
 
+	if r := metricsReporter(); r != nil {
+		r.MessageReceived(c.ExchangeName)
+	}
+
 	var standardMessage []byte
 	switch mType {
 	case gws.TextMessage:
@@ -378,7 +385,16 @@ func (c *connection) SendMessageReturnResponses(ctx context.Context, epl request
 
 // SendMessageReturnResponsesWithInspector will send a WS message to the connection and wait for N responses
 // An error of ErrSignatureTimeout can be ignored if individual responses are being otherwise tracked
-func (c *connection) SendMessageReturnResponsesWithInspector(ctx context.Context, epl request.EndpointLimit, signature, payload any, expected int, messageInspector Inspector) ([][]byte, error) {
+func (c *connection) SendMessageReturnResponsesWithInspector(ctx context.Context, epl request.EndpointLimit, signature, payload any, expected int, messageInspector Inspector) (resps [][]byte, err error) {
+	ctx, span := tracing.Start(ctx, "websocket.SendMessageReturnResponse", trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		attribute.String("exchange", c.ExchangeName),
+		attribute.Int("expected_responses", expected),
+	))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
+
 	outbound, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling json for %s: %w", signature, err)
@@ -395,7 +411,7 @@ func (c *connection) SendMessageReturnResponsesWithInspector(ctx context.Context
 		return nil, err
 	}
 
-	resps, err := c.waitForResponses(ctx, signature, ch, expected, messageInspector)
+	resps, err = c.waitForResponses(ctx, signature, ch, expected, messageInspector)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +165,12 @@ type websocket struct {
 	connections   []Connection
 }
 
-var globalReporter Reporter
+var (
+	globalReporter        Reporter
+	globalMetricsReporter atomic.Pointer[metricsReporterHolder]
+)
+
+type metricsReporterHolder struct{ MetricsReporter }
 
 // SetupGlobalReporter sets a reporter interface to be used
 // for all exchange requests
@@ -173,6 +178,24 @@ func SetupGlobalReporter(r Reporter) {
 	globalReporter = r
 }
 
+// SetupGlobalMetricsReporter sets the metrics reporter used by all websocket
+// managers and connections, including those already created. A nil reporter
+// disables reporting
+func SetupGlobalMetricsReporter(r MetricsReporter) {
+	if r == nil {
+		globalMetricsReporter.Store(nil)
+		return
+	}
+	globalMetricsReporter.Store(&metricsReporterHolder{r})
+}
+
+func metricsReporter() MetricsReporter {
+	if h := globalMetricsReporter.Load(); h != nil {
+		return h.MetricsReporter
+	}
+	return nil
+}
+
 // NewManager initialises the websocket struct
 func NewManager() *Manager {
 	return &Manager{
@@ -1090,6 +1113,8 @@ func (m *Manager) observeConnection(ctx context.Context, t *time.Timer) (exit bo
 		if m.IsEnabled() && (!m.IsConnected() && !m.IsConnecting()) {
 			if connectErr := m.Connect(ctx); connectErr != nil {
 				log.Errorln(log.WebsocketMgr, connectErr)
+			} else if r := metricsReporter(); r != nil {
+				r.Reconnected(m.exchangeName)
 			}
 		}
 		if err := m.DataHandler.Send(ctx, err); err != nil {
@@ -1119,6 +1144,8 @@ func (m *Manager) observeConnection(ctx context.Context, t *time.Timer) (exit bo
 			err := m.Connect(ctx)
 			if err != nil {
 				log.Errorln(log.WebsocketMgr, err)
+			} else if r := metricsReporter(); r != nil {
+				r.Reconnected(m.exchangeName)
 			}
 		}
 		t.Reset(m.connectionMonitorDelay)
@@ -1153,6 +1180,9 @@ func (m *Manager) observeTraffic(timeout <-chan time.Time, onTimeout func()) boo
 		if m.verbose {
 			log.Warnf(log.WebsocketMgr, "%v websocket: has not received a traffic alert in %v. Reconnecting", m.exchangeName, m.trafficTimeout)
 		}
+		if r := metricsReporter(); r != nil {
+			r.TrafficTimeout(m.exchangeName)
+		}
 		if m.IsConnected() && onTimeout != nil {
 			onTimeout()
 		}
@@ -1715,6 +1715,54 @@ func TestMonitorTraffic(t *testing.T) { //nolint:tparallel // top-level parallel
 	})
 }
 
+type testMetricsReporter struct {
+	m               sync.Mutex
+	messages        int
+	reconnects      int
+	trafficTimeouts int
+}
+
+func (r *testMetricsReporter) MessageReceived(exch string) {
+	if exch == "metrics" {
+		r.m.Lock()
+		r.messages++
+		r.m.Unlock()
+	}
+}
+
+func (r *testMetricsReporter) Reconnected(exch string) {
+	if exch == "metrics" {
+		r.m.Lock()
+		r.reconnects++
+		r.m.Unlock()
+	}
+}
+
+func (r *testMetricsReporter) TrafficTimeout(exch string) {
+	if exch == "metrics" {
+		r.m.Lock()
+		r.trafficTimeouts++
+		r.m.Unlock()
+	}
+}
+
+func TestMetricsReporter(t *testing.T) {
+	t.Parallel()
+	rep := &testMetricsReporter{}
+	SetupGlobalMetricsReporter(rep)
+	t.Cleanup(func() { SetupGlobalMetricsReporter(nil) })
+
+	m := &Manager{exchangeName: "metrics", ShutdownC: make(chan struct{}), TrafficAlert: make(chan struct{}, 1)}
+	m.setState(connectedState)
+	timeout := make(chan time.Time, 1)
+	timeout <- time.Now()
+	require.True(t, m.observeTraffic(timeout, func() {}))
+
+	rep.m.Lock()
+	defer rep.m.Unlock()
+	assert.Equal(t, 1, rep.trafficTimeouts, "traffic timeouts should be reported")
+}
+
 func TestGetConnection(t *testing.T) {
 	t.Parallel()
 	var ws *Manager
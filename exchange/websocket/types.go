@@ -37,3 +37,11 @@ type UnhandledMessageWarning struct {
 type Reporter interface {
 	Latency(name string, message []byte, t time.Duration)
 }
+
+// MetricsReporter receives websocket throughput and connection health metrics
+// for all exchanges
+type MetricsReporter interface {
+	MessageReceived(exchange string)
+	Reconnected(exchange string)
+	TrafficTimeout(exchange string)
+}
@@ -381,6 +381,34 @@ func TestGetHistoricCandlesExtended(t *testing.T) {
 	assert.ErrorIs(t, err, asset.ErrNotSupported)
 }
 
+func TestGetHistoricMarkPriceCandles(t *testing.T) {
+	t.Parallel()
+	startTime := time.Now().Add(-time.Hour * 24 * 3)
+	end := time.Now().Add(-time.Hour * 1)
+	if mockTests {
+		startTime = time.UnixMilli(1692889428738)
+		end = time.UnixMilli(1693145028738)
+	}
+	_, err := e.GetHistoricMarkPriceCandles(t.Context(), usdtMarginedTradablePair, asset.USDTMarginedFutures, kline.OneHour, startTime, end)
+	assert.NoError(t, err, "GetHistoricMarkPriceCandles should not error")
+	_, err = e.GetHistoricMarkPriceCandles(t.Context(), spotTradablePair, asset.Spot, kline.OneHour, startTime, end)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+}
+
+func TestGetHistoricIndexPriceCandles(t *testing.T) {
+	t.Parallel()
+	startTime := time.Now().Add(-time.Hour * 24 * 3)
+	end := time.Now().Add(-time.Hour * 1)
+	if mockTests {
+		startTime = time.UnixMilli(1692889428738)
+		end = time.UnixMilli(1693145028738)
+	}
+	_, err := e.GetHistoricIndexPriceCandles(t.Context(), inverseTradablePair, asset.CoinMarginedFutures, kline.OneHour, startTime, end)
+	assert.NoError(t, err, "GetHistoricIndexPriceCandles should not error")
+	_, err = e.GetHistoricIndexPriceCandles(t.Context(), optionsTradablePair, asset.Options, kline.OneHour, startTime, end)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+}
+
 func TestCancelOrder(t *testing.T) {
 	t.Parallel()
 	if mockTests {
@@ -1540,6 +1540,56 @@ func (e *Exchange) GetHistoricCandlesExtended(ctx context.Context, pair currency
 	}
 }
 
+// GetHistoricMarkPriceCandles returns mark price candles for a future
+func (e *Exchange) GetHistoricMarkPriceCandles(ctx context.Context, pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error) {
+	return e.getHistoricPriceCandles(ctx, e.GetMarkPriceKline, pair, a, interval, start, end)
+}
+
+// GetHistoricIndexPriceCandles returns index price candles for a future
+func (e *Exchange) GetHistoricIndexPriceCandles(ctx context.Context, pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error) {
+	return e.getHistoricPriceCandles(ctx, e.GetIndexPriceKline, pair, a, interval, start, end)
+}
+
+// getHistoricPriceCandles fetches volumeless price candles across the full
+// range using the supplied mark or index price kline endpoint
+func (e *Exchange) getHistoricPriceCandles(ctx context.Context, fetch func(context.Context, string, string, kline.Interval, time.Time, time.Time, int64) ([]KlineItem, error), pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error) {
+	switch a {
+	case asset.USDTMarginedFutures, asset.USDCMarginedFutures, asset.CoinMarginedFutures:
+		req, err := e.GetKlineExtendedRequest(pair, a, interval, start, end)
+		if err != nil {
+			return nil, err
+		}
+		if req.Asset == asset.USDCMarginedFutures && !req.RequestFormatted.Quote.Equal(currency.PERP) {
+			req.RequestFormatted.Delimiter = currency.DashDelimiter
+		}
+		timeSeries := make([]kline.Candle, 0, req.Size())
+		for x := range req.RangeHolder.Ranges {
+			klineItems, err := fetch(ctx,
+				getCategoryName(req.Asset),
+				req.RequestFormatted.String(),
+				req.ExchangeInterval,
+				req.RangeHolder.Ranges[x].Start.Time,
+				req.RangeHolder.Ranges[x].End.Time,
+				int64(req.RequestLimit)) //nolint:gosec // request limit is bound by the exchange's kline limit
+			if err != nil {
+				return nil, err
+			}
+			for i := range klineItems {
+				timeSeries = append(timeSeries, kline.Candle{
+					Time:  klineItems[i].StartTime.Time(),
+					Open:  klineItems[i].Open.Float64(),
+					High:  klineItems[i].High.Float64(),
+					Low:   klineItems[i].Low.Float64(),
+					Close: klineItems[i].Close.Float64(),
+				})
+			}
+		}
+		return req.ProcessResponse(timeSeries)
+	default:
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+}
+
 // GetServerTime returns the current exchange server time.
 func (e *Exchange) GetServerTime(ctx context.Context, _ asset.Item) (time.Time, error) {
 	info, err := e.GetBybitServerTime(ctx)
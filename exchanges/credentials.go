@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -22,12 +23,18 @@ var (
 	// completely empty but an attempt at retrieving credentials was made to
 	// undertake an authenticated HTTP request.
 	ErrCredentialsAreEmpty = errors.New("credentials are empty")
+	// ErrAccountNotFound defines an error for when a named account is
+	// requested but has not been configured for the exchange.
+	ErrAccountNotFound = errors.New("account not found")
 	// Errors related to API requirements and failures
 	errRequiresAPIKey      = errors.New("requires API key but default/empty one set")
 	errRequiresAPISecret   = errors.New("requires API secret but default/empty one set")
 	errRequiresAPIPEMKey   = errors.New("requires API PEM key but default/empty one set")
 	errRequiresAPIClientID = errors.New("requires API Client ID but default/empty one set")
 	errBase64DecodeFailure = errors.New("base64 decode has failed")
+	errAccountNameEmpty    = errors.New("account name cannot be empty")
+
+	errCredentialProviderFailure = errors.New("credential provider failure")
 )
 
 // SetKey sets new key for the default credentials
@@ -81,7 +88,7 @@ func (b *Base) CheckCredentials(creds *accounts.Credentials, isContext bool) err
 	// Bot usage, AuthenticatedSupport can be disabled by user if desired, so
 	// don't allow authenticated requests. Context credentials set will override
 	// default credentials and supported checks.
-	if !b.API.AuthenticatedSupport && !b.API.AuthenticatedWebsocketSupport && !isContext {
+	if !b.IsRESTAuthenticationSupported() && !b.IsWebsocketAuthenticationSupported() && !isContext {
 		return fmt.Errorf("%s %w", b.Name, ErrAuthenticationSupportNotEnabled)
 	}
 
@@ -97,15 +104,30 @@ func (b *Base) AreCredentialsValid(ctx context.Context) bool {
 }
 
 // GetDefaultCredentials returns the exchange.Base api credentials loaded by
-// config.json
+// config.json or fetched from the credential provider
 func (b *Base) GetDefaultCredentials() *accounts.Credentials {
-	b.API.credMu.RLock()
-	defer b.API.credMu.RUnlock()
-	if b.API.credentials == (accounts.Credentials{}) {
+	creds, err := b.defaultCredentials(context.TODO())
+	if err != nil || *creds == (accounts.Credentials{}) {
 		return nil
 	}
+	return creds
+}
+
+// defaultCredentials returns a copy of the default credentials, fetching them
+// from the credential provider if one is set
+func (b *Base) defaultCredentials(ctx context.Context) (*accounts.Credentials, error) {
+	b.API.credMu.RLock()
+	p := b.API.credentialProvider
 	creds := b.API.credentials
-	return &creds
+	b.API.credMu.RUnlock()
+	if p == nil {
+		return &creds, nil
+	}
+	c, err := p.GetCredentials(ctx, b.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("%s %w: %w", b.Name, errCredentialProviderFailure, err)
+	}
+	return c, nil
 }
 
 // GetCredentials checks and validates current credentials, context credentials
@@ -125,11 +147,26 @@ func (b *Base) GetCredentials(ctx context.Context) (*accounts.Credentials, error
 		return creds, nil
 	}
 
-	// Fallback to exchange loaded credentials
-	b.API.credMu.RLock()
-	creds := b.API.credentials
-	b.API.credMu.RUnlock()
-	if err := b.CheckCredentials(&creds, false); err != nil {
+	if name := accounts.AccountFromContext(ctx); name != "" {
+		creds, err := b.getAccountCredentials(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if err := b.CheckCredentials(creds, false); err != nil {
+			return nil, fmt.Errorf("error checking %s account credentials: %w", name, err)
+		}
+		if subAccountOverride, ok := ctx.Value(accounts.ContextSubAccountFlag).(string); ok {
+			creds.SubAccount = subAccountOverride
+		}
+		return creds, nil
+	}
+
+	// Fallback to exchange loaded or provider credentials
+	creds, err := b.defaultCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.CheckCredentials(creds, false); err != nil {
 		return nil, fmt.Errorf("error checking credentials: %w", err)
 	}
 
@@ -137,7 +174,7 @@ func (b *Base) GetCredentials(ctx context.Context) (*accounts.Credentials, error
 		creds.SubAccount = subAccountOverride
 	}
 
-	return &creds, nil
+	return creds, nil
 }
 
 // VerifyAPICredentials verifies the exchanges API credentials
@@ -206,6 +243,92 @@ func (b *Base) SetCredentials(apiKey, apiSecret, clientID, subaccount, pemKey, o
 	}
 }
 
+// SetAccountCredentials sets a named set of API credentials which can be
+// selected per request via accounts.DeployAccountToContext
+func (b *Base) SetAccountCredentials(name string, creds *accounts.Credentials) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%s %w", b.Name, errAccountNameEmpty)
+	}
+	if creds.IsEmpty() {
+		return fmt.Errorf("%s %s %w", b.Name, name, ErrCredentialsAreEmpty)
+	}
+	b.API.credMu.Lock()
+	defer b.API.credMu.Unlock()
+	if b.API.accounts == nil {
+		b.API.accounts = make(map[string]accounts.Credentials)
+	}
+	k := strings.ToLower(name)
+	if _, ok := b.API.accounts[k]; !ok {
+		b.API.accountNames = append(b.API.accountNames, name)
+	}
+	b.API.accounts[k] = *creds
+	return nil
+}
+
+// SetCredentialProvider sets an external store which default and named account
+// credentials are fetched from when required, in place of any credentials set
+// on the exchange. Named accounts must be registered with
+// SetCredentialProviderAccount.
+func (b *Base) SetCredentialProvider(p accounts.CredentialProvider) {
+	b.API.credMu.Lock()
+	defer b.API.credMu.Unlock()
+	b.API.credentialProvider = p
+}
+
+// SetCredentialProviderAccount registers a named account whose credentials are
+// held by the credential provider
+func (b *Base) SetCredentialProviderAccount(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%s %w", b.Name, errAccountNameEmpty)
+	}
+	b.API.credMu.Lock()
+	defer b.API.credMu.Unlock()
+	if !slices.ContainsFunc(b.API.accountNames, func(n string) bool { return strings.EqualFold(n, name) }) {
+		b.API.accountNames = append(b.API.accountNames, name)
+	}
+	return nil
+}
+
+// GetAccountCredentials returns a copy of the named account credentials
+func (b *Base) GetAccountCredentials(ctx context.Context, name string) (*accounts.Credentials, error) {
+	return b.getAccountCredentials(ctx, name)
+}
+
+func (b *Base) getAccountCredentials(ctx context.Context, name string) (*accounts.Credentials, error) {
+	b.API.credMu.RLock()
+	p := b.API.credentialProvider
+	creds, ok := b.API.accounts[strings.ToLower(name)]
+	i := slices.IndexFunc(b.API.accountNames, func(n string) bool { return strings.EqualFold(n, name) })
+	if i != -1 {
+		name = b.API.accountNames[i]
+	}
+	b.API.credMu.RUnlock()
+	if p == nil {
+		if !ok {
+			return nil, fmt.Errorf("%s %q %w", b.Name, name, ErrAccountNotFound)
+		}
+		return &creds, nil
+	}
+	if i == -1 {
+		return nil, fmt.Errorf("%s %q %w", b.Name, name, ErrAccountNotFound)
+	}
+	c, err := p.GetCredentials(ctx, b.Name, name)
+	if err != nil {
+		return nil, fmt.Errorf("%s %q %w: %w", b.Name, name, errCredentialProviderFailure, err)
+	}
+	return c, nil
+}
+
+// GetAccountNames returns the names of all configured accounts, excluding the
+// default credentials
+func (b *Base) GetAccountNames() []string {
+	b.API.credMu.RLock()
+	defer b.API.credMu.RUnlock()
+	return slices.Clone(b.API.accountNames)
+}
+
 // SetAPICredentialDefaults sets the API Credential validator defaults
 func (b *Base) SetAPICredentialDefaults() {
 	b.API.credMu.Lock()
@@ -222,11 +345,24 @@ func (b *Base) SetAPICredentialDefaults() {
 // IsWebsocketAuthenticationSupported returns whether the exchange supports
 // websocket authenticated API requests
 func (b *Base) IsWebsocketAuthenticationSupported() bool {
+	b.API.credMu.RLock()
+	defer b.API.credMu.RUnlock()
 	return b.API.AuthenticatedWebsocketSupport
 }
 
 // IsRESTAuthenticationSupported returns whether the exchange supports REST authenticated
 // API requests
 func (b *Base) IsRESTAuthenticationSupported() bool {
+	b.API.credMu.RLock()
+	defer b.API.credMu.RUnlock()
 	return b.API.AuthenticatedSupport
 }
+
+// SetAuthenticatedSupport sets whether the exchange supports REST and
+// websocket authenticated API requests
+func (b *Base) SetAuthenticatedSupport(rest, websocket bool) {
+	b.API.credMu.Lock()
+	defer b.API.credMu.Unlock()
+	b.API.AuthenticatedSupport = rest
+	b.API.AuthenticatedWebsocketSupport = websocket
+}
@@ -2,6 +2,7 @@ package exchange
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -370,6 +371,88 @@ func TestSetCredentials(t *testing.T) {
 	}
 }
 
+func TestAccountCredentials(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME"}
+	require.ErrorIs(t, b.SetAccountCredentials(" ", &accounts.Credentials{Key: "k"}), errAccountNameEmpty)
+	require.ErrorIs(t, b.SetAccountCredentials("arbitrage", nil), ErrCredentialsAreEmpty)
+	require.NoError(t, b.SetAccountCredentials("Arbitrage", &accounts.Credentials{Key: "old"}))
+	require.NoError(t, b.SetAccountCredentials("arbitrage", &accounts.Credentials{Key: "arbKey", Secret: "arbSecret"}))
+	require.NoError(t, b.SetAccountCredentials("hedging", &accounts.Credentials{Key: "hedgeKey", Secret: "hedgeSecret"}))
+	assert.Equal(t, []string{"Arbitrage", "hedging"}, b.GetAccountNames(), "GetAccountNames should return names in configured order")
+
+	_, err := b.GetAccountCredentials(t.Context(), "unknown")
+	require.ErrorIs(t, err, ErrAccountNotFound)
+	creds, err := b.GetAccountCredentials(t.Context(), "ARBITRAGE")
+	require.NoError(t, err, "GetAccountCredentials must match names case insensitively")
+	assert.Equal(t, "arbKey", creds.Key)
+
+	b.SetCredentials("defaultKey", "defaultSecret", "", "", "", "")
+	_, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "unknown"))
+	require.ErrorIs(t, err, ErrAccountNotFound)
+
+	creds, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "hedging"))
+	require.NoError(t, err)
+	assert.Equal(t, "hedgeKey", creds.Key, "GetCredentials should return the named account credentials")
+
+	ctx := accounts.DeploySubAccountOverrideToContext(accounts.DeployAccountToContext(t.Context(), "hedging"), "sub")
+	creds, err = b.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "sub", creds.SubAccount, "GetCredentials should apply the sub account override to named accounts")
+
+	ctx = accounts.DeployCredentialsToContext(accounts.DeployAccountToContext(t.Context(), "hedging"), &accounts.Credentials{Key: "ctxKey"})
+	creds, err = b.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "ctxKey", creds.Key, "context credentials should take precedence over named accounts")
+
+	creds, err = b.GetCredentials(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "defaultKey", creds.Key, "GetCredentials should return the default credentials without an account")
+}
+
+type testCredentialProvider map[string]accounts.Credentials
+
+func (t testCredentialProvider) GetCredentials(_ context.Context, _, account string) (*accounts.Credentials, error) {
+	creds, ok := t[account]
+	if !ok {
+		return nil, errTestCredentialProvider
+	}
+	return &creds, nil
+}
+
+var errTestCredentialProvider = errors.New("secret not found")
+
+func TestCredentialProvider(t *testing.T) {
+	t.Parallel()
+	b := Base{Name: "TESTNAME"}
+	b.SetCredentials("configKey", "configSecret", "", "", "", "")
+	b.SetCredentialProvider(testCredentialProvider{
+		"":      {Key: "providerKey", Secret: "providerSecret"},
+		"Hedge": {Key: "hedgeKey", Secret: "hedgeSecret"},
+	})
+
+	creds, err := b.GetCredentials(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "providerKey", creds.Key, "provider credentials should replace exchange credentials")
+	assert.Equal(t, "providerKey", b.GetDefaultCredentials().Key)
+
+	require.ErrorIs(t, b.SetCredentialProviderAccount(" "), errAccountNameEmpty)
+	_, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "hedge"))
+	require.ErrorIs(t, err, ErrAccountNotFound, "unregistered accounts must not be fetched")
+	require.NoError(t, b.SetCredentialProviderAccount("Hedge"))
+	require.NoError(t, b.SetCredentialProviderAccount("hedge"))
+	assert.Equal(t, []string{"Hedge"}, b.GetAccountNames())
+	creds, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "hedge"))
+	require.NoError(t, err)
+	assert.Equal(t, "hedgeKey", creds.Key, "named accounts should be fetched by their registered name")
+
+	b.SetCredentialProvider(testCredentialProvider{})
+	_, err = b.GetCredentials(t.Context())
+	assert.ErrorIs(t, err, errCredentialProviderFailure)
+	assert.ErrorIs(t, err, errTestCredentialProvider)
+	assert.Nil(t, b.GetDefaultCredentials(), "GetDefaultCredentials should return nil on provider failure")
+}
+
 func TestGetDefaultCredentials(t *testing.T) {
 	var b Base
 	if b.GetDefaultCredentials() != nil {
@@ -428,3 +511,14 @@ func TestGetAuthenticatedAPISupport(t *testing.T) {
 		t.Fatal("Expected WebsocketAuthentication to return true")
 	}
 }
+
+func TestSetAuthenticatedSupport(t *testing.T) {
+	t.Parallel()
+	b := &Base{}
+	b.SetAuthenticatedSupport(true, false)
+	assert.True(t, b.IsRESTAuthenticationSupported(), "IsRESTAuthenticationSupported should return true")
+	assert.False(t, b.IsWebsocketAuthenticationSupported(), "IsWebsocketAuthenticationSupported should return false")
+	b.SetAuthenticatedSupport(false, true)
+	assert.False(t, b.IsRESTAuthenticationSupported(), "IsRESTAuthenticationSupported should return false")
+	assert.True(t, b.IsWebsocketAuthenticationSupported(), "IsWebsocketAuthenticationSupported should return true")
+}
@@ -535,7 +535,14 @@ func (b *Base) SetupDefaults(exch *config.Exchange) error {
 	b.API.AuthenticatedSupport = exch.API.AuthenticatedSupport
 	b.API.AuthenticatedWebsocketSupport = exch.API.AuthenticatedWebsocketSupport
 	b.API.credentials.SubAccount = exch.API.Credentials.Subaccount
-	if b.API.AuthenticatedSupport || b.API.AuthenticatedWebsocketSupport {
+	switch {
+	case exch.API.UseCredentialProvider:
+		for i := range exch.API.Accounts {
+			if err := b.SetCredentialProviderAccount(exch.API.Accounts[i].Name); err != nil {
+				log.Warnf(log.ExchangeSys, "Unable to load API account: %v", err)
+			}
+		}
+	case b.API.AuthenticatedSupport || b.API.AuthenticatedWebsocketSupport:
 		b.SetCredentials(exch.API.Credentials.Key,
 			exch.API.Credentials.Secret,
 			exch.API.Credentials.ClientID,
@@ -543,6 +550,19 @@ func (b *Base) SetupDefaults(exch *config.Exchange) error {
 			exch.API.Credentials.PEMKey,
 			exch.API.Credentials.OTPSecret,
 		)
+		for i := range exch.API.Accounts {
+			a := &exch.API.Accounts[i]
+			if err := b.SetAccountCredentials(a.Name, &accounts.Credentials{
+				Key:             a.Credentials.Key,
+				Secret:          a.Credentials.Secret,
+				ClientID:        a.Credentials.ClientID,
+				SubAccount:      a.Credentials.Subaccount,
+				PEMKey:          a.Credentials.PEMKey,
+				OneTimePassword: a.Credentials.OTPSecret,
+			}); err != nil {
+				log.Warnf(log.ExchangeSys, "Unable to load API account: %v", err)
+			}
+		}
 	}
 
 	if exch.HTTPTimeout <= time.Duration(0) {
@@ -1711,6 +1731,16 @@ func (b *Base) GetHistoricalFundingRates(context.Context, *fundingrate.Historica
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetHistoricMarkPriceCandles returns mark price candles for a future
+func (b *Base) GetHistoricMarkPriceCandles(context.Context, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (*kline.Item, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetHistoricIndexPriceCandles returns index price candles for a future
+func (b *Base) GetHistoricIndexPriceCandles(context.Context, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (*kline.Item, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
 // IsPerpetualFutureCurrency ensures a given asset and currency is a perpetual future
 // differs by exchange
 func (b *Base) IsPerpetualFutureCurrency(asset.Item, currency.Pair) (bool, error) {
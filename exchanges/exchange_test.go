@@ -781,6 +781,9 @@ func TestSetupDefaults(t *testing.T) {
 		HTTPTimeout: time.Duration(-1),
 		API: config.APIConfig{
 			AuthenticatedSupport: true,
+			Accounts: []config.APIAccountConfig{
+				{Name: "arbitrage", Credentials: config.APICredentialsConfig{Key: "arbKey", Secret: "arbSecret"}},
+			},
 		},
 		ConnectionMonitorDelay: time.Second * 5,
 	}
@@ -792,6 +795,7 @@ func TestSetupDefaults(t *testing.T) {
 	require.Same(t, accountsStore, accounts.GetStore(), "Global accounts Store must not change during SetupDefaults")
 
 	assert.Equal(t, 15*time.Second, cfg.HTTPTimeout, "config.HTTPTimeout should default correctly")
+	assert.Equal(t, []string{"arbitrage"}, b.GetAccountNames(), "SetupDefaults should load named API accounts")
 
 	cfg.HTTPTimeout = time.Second * 30
 	require.NoError(t, b.SetupDefaults(&cfg))
@@ -1936,6 +1940,20 @@ func TestGetHistoricalFundingRates(t *testing.T) {
 	assert.ErrorIs(t, err, common.ErrNotYetImplemented)
 }
 
+func TestGetHistoricMarkPriceCandles(t *testing.T) {
+	t.Parallel()
+	var b Base
+	_, err := b.GetHistoricMarkPriceCandles(t.Context(), currency.EMPTYPAIR, asset.Empty, kline.OneHour, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+}
+
+func TestGetHistoricIndexPriceCandles(t *testing.T) {
+	t.Parallel()
+	var b Base
+	_, err := b.GetHistoricIndexPriceCandles(t.Context(), currency.EMPTYPAIR, asset.Empty, kline.OneHour, time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+}
+
 func TestGetFundingRates(t *testing.T) {
 	t.Parallel()
 	var b Base
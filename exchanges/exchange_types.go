@@ -223,7 +223,13 @@ type API struct {
 	Endpoints *Endpoints
 
 	credentials accounts.Credentials
-	credMu      sync.RWMutex
+	// accounts holds named credentials keyed by lower case account name
+	accounts     map[string]accounts.Credentials
+	accountNames []string
+	// credentialProvider when set supplies the default and named account
+	// credentials in place of those set on the exchange
+	credentialProvider accounts.CredentialProvider
+	credMu             sync.RWMutex
 
 	CredentialsValidator config.APICredentialsValidatorConfig
 }
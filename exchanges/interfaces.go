@@ -103,6 +103,12 @@ type IBotExchange interface {
 	// GetDefaultCredentials returns the exchange.Base api credentials loaded by
 	// config.json. See exchanges/credentials.go Base method for implementation.
 	GetDefaultCredentials() *accounts.Credentials
+	// GetAccountCredentials returns the named account credentials loaded by
+	// config.json. See exchanges/credentials.go Base method for
+	// implementation.
+	GetAccountCredentials(ctx context.Context, name string) (*accounts.Credentials, error)
+	// GetAccountNames returns the names of all configured accounts
+	GetAccountNames() []string
 
 	FunctionalityChecker
 	AccountManagement
@@ -183,6 +189,8 @@ type FuturesManagement interface {
 	CalculateTotalCollateral(context.Context, *futures.TotalCollateralCalculator) (*futures.TotalCollateralResponse, error)
 	GetFuturesPositions(context.Context, *futures.PositionsRequest) ([]futures.PositionDetails, error)
 	GetHistoricalFundingRates(context.Context, *fundingrate.HistoricalRatesRequest) (*fundingrate.HistoricalRates, error)
+	GetHistoricMarkPriceCandles(ctx context.Context, pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error)
+	GetHistoricIndexPriceCandles(ctx context.Context, pair currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error)
 	GetLatestFundingRates(context.Context, *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error)
 	IsPerpetualFutureCurrency(asset.Item, currency.Pair) (bool, error)
 	GetCollateralCurrencyForContract(asset.Item, currency.Pair) (currency.Code, asset.Item, error)
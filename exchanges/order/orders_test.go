@@ -1019,6 +1019,7 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 		OrderID:         "1",
 		AccountID:       "1",
 		ClientID:        "1",
+		Account:         "arbitrage",
 		ClientOrderID:   "DukeOfWombleton",
 		Type:            1,
 		Side:            1,
@@ -1054,6 +1055,7 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 	assert.Equal(t, "test", od.Exchange, "Should not be able to update exchange via modify")
 	assert.Equal(t, "1", od.OrderID)
 	assert.Equal(t, "1", od.ClientID)
+	assert.Equal(t, "arbitrage", od.Account)
 	assert.Equal(t, "DukeOfWombleton", od.ClientOrderID)
 	assert.Equal(t, Type(1), od.Type)
 	assert.Equal(t, Side(1), od.Side)
@@ -1263,6 +1265,9 @@ func TestMatchFilter(t *testing.T) {
 		{"AccountID ✓", Filter{AccountID: "A"}, Detail{AccountID: "A"}, true},
 		{"AccountID 𐄂", Filter{AccountID: "A"}, Detail{AccountID: "B"}, false},
 		{"AccountID Empty", Filter{AccountID: "A"}, Detail{}, false},
+		{"Account ✓", Filter{Account: "arb"}, Detail{Account: "ARB"}, true},
+		{"Account 𐄂", Filter{Account: "arb"}, Detail{Account: "hedge"}, false},
+		{"Account Empty", Filter{Account: "arb"}, Detail{}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
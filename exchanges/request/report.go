@@ -1,6 +1,7 @@
 package request
 
 import (
+	"sync/atomic"
 	"time"
 )
 
@@ -10,8 +11,41 @@ type Reporter interface {
 	Latency(name, method, path string, t time.Duration)
 }
 
+// MetricsReporter receives the outcome of every HTTP request attempt and the
+// time spent waiting on rate limiters for all exchange requests
+type MetricsReporter interface {
+	// Request is called after each attempt with the rate limit endpoint the
+	// request was made against. The request path is not reported as it may
+	// contain order IDs, symbols or account IDs. statusCode is 0 when no
+	// response was received
+	Request(name, method string, endpoint EndpointLimit, statusCode int, t time.Duration, err error)
+	RateLimitWait(name string, t time.Duration)
+}
+
+type metricsReporterHolder struct{ MetricsReporter }
+
+var globalMetricsReporter atomic.Pointer[metricsReporterHolder]
+
 // SetupGlobalReporter sets a reporter interface to be used
 // for all exchange requests
 func SetupGlobalReporter(r Reporter) {
 	globalReporter = r
 }
+
+// SetupGlobalMetricsReporter sets the metrics reporter used by all exchange
+// requests, including those of requesters already created. A nil reporter
+// disables reporting
+func SetupGlobalMetricsReporter(r MetricsReporter) {
+	if r == nil {
+		globalMetricsReporter.Store(nil)
+		return
+	}
+	globalMetricsReporter.Store(&metricsReporterHolder{r})
+}
+
+func metricsReporter() MetricsReporter {
+	if h := globalMetricsReporter.Load(); h != nil {
+		return h.MetricsReporter
+	}
+	return nil
+}
@@ -13,10 +13,13 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/timedmutex"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -92,10 +95,17 @@ func (r *Requester) SendPayload(ctx context.Context, ep EndpointLimit, newReques
 		return errRequestFunctionIsNil
 	}
 
+	ctx, span := tracing.Start(ctx, "request.SendPayload", trace.WithAttributes(
+		attribute.String("exchange", r.name),
+		attribute.Bool("authenticated", requestType == AuthenticatedRequest),
+	))
+	defer span.End()
+
 	err := r.doRequest(ctx, ep, newRequest)
 	if err != nil && requestType == AuthenticatedRequest {
 		err = common.AppendError(err, ErrAuthRequestFailed)
 	}
+	tracing.RecordError(span, err)
 	return err
 }
 
@@ -148,12 +158,21 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 		default:
 		}
 
+		metrics := metricsReporter()
+
 		if r.limiter != nil {
 			// Initiate a rate limit reservation and sleep on requested endpoint
+			limitStart := time.Now()
+			_, limitSpan := tracing.Start(ctx, "request.InitiateRateLimit")
 			err := r.InitiateRateLimit(ctx, endpoint)
+			tracing.RecordError(limitSpan, err)
+			limitSpan.End()
 			if err != nil {
 				return fmt.Errorf("failed to rate limit HTTP request: %w", err)
 			}
+			if metrics != nil {
+				metrics.RateLimitWait(r.name, time.Since(limitStart))
+			}
 		}
 
 		p, err := newRequest()
@@ -191,14 +210,35 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 			}
 		}
 
+		// Only the path is recorded as query parameters may hold signatures
+		_, httpSpan := tracing.Start(ctx, "HTTP "+p.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+			attribute.String("http.request.method", p.Method),
+			attribute.String("url.path", req.URL.Path),
+			attribute.Int("attempt", attempt),
+		))
+
 		start := time.Now()
 
 		resp, err := r._HTTPClient.do(req)
 
+		if resp != nil {
+			httpSpan.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		}
+		tracing.RecordError(httpSpan, err)
+		httpSpan.End()
+
 		if r.reporter != nil && err == nil {
 			r.reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
 		}
 
+		if metrics != nil {
+			var statusCode int
+			if resp != nil {
+				statusCode = resp.StatusCode
+			}
+			metrics.Request(r.name, p.Method, endpoint, statusCode, time.Since(start), err)
+		}
+
 		if retry, err := r.evaluateRetry(ctx, resp, err, attempt, verbose); err != nil {
 			return err
 		} else if retry {
@@ -14,13 +14,21 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 const unexpected = "unexpected values"
@@ -382,6 +390,90 @@ func TestDoRequest_NotRetryable(t *testing.T) {
 	require.ErrorIs(t, err, notRetryErr)
 }
 
+type testMetricsReporter struct {
+	m        sync.Mutex
+	requests map[int]int
+	waits    int
+}
+
+func (r *testMetricsReporter) Request(name, _ string, _ EndpointLimit, statusCode int, _ time.Duration, _ error) {
+	if name != "metrics" {
+		return
+	}
+	r.m.Lock()
+	r.requests[statusCode]++
+	r.m.Unlock()
+}
+
+func (r *testMetricsReporter) RateLimitWait(name string, _ time.Duration) {
+	if name != "metrics" {
+		return
+	}
+	r.m.Lock()
+	r.waits++
+	r.m.Unlock()
+}
+
+func TestMetricsReporter(t *testing.T) {
+	t.Parallel()
+	rep := &testMetricsReporter{requests: make(map[int]int)}
+	SetupGlobalMetricsReporter(rep)
+	t.Cleanup(func() { SetupGlobalMetricsReporter(nil) })
+
+	r, err := New("metrics", new(http.Client), WithLimiter(NewBasicRateLimit(time.Millisecond, 100, 1)))
+	require.NoError(t, err, "New must not error")
+	for _, path := range []string{"/", "/error"} {
+		_ = r.SendPayload(t.Context(), Unset, func() (*Item, error) {
+			return &Item{Method: http.MethodGet, Path: testURL + path}, nil
+		}, UnauthenticatedRequest)
+	}
+	rep.m.Lock()
+	defer rep.m.Unlock()
+	assert.Equal(t, map[int]int{http.StatusOK: 1, http.StatusBadRequest: 1}, rep.requests, "each request attempt should be reported with its status")
+	assert.Equal(t, 2, rep.waits, "rate limiter waits should be reported")
+}
+
+// TestSendPayloadTracing sets the global tracer provider so must not run in
+// parallel
+func TestSendPayloadTracing(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	otel.SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		assert.NoError(t, tp.Shutdown(context.Background()), "Shutdown should not error")
+	})
+
+	r, err := New("tracing", new(http.Client), WithLimiter(NewBasicRateLimit(time.Millisecond, 100, 1)))
+	require.NoError(t, err, "New must not error")
+	ctx, root := tracing.Start(t.Context(), "test")
+	err = r.SendPayload(ctx, Unset, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: testURL + "/error?signature=secret"}, nil
+	}, UnauthenticatedRequest)
+	require.ErrorIs(t, err, ErrBadStatus)
+	root.End()
+
+	spans := make(map[string]tracetest.SpanStub)
+	for _, s := range exp.GetSpans() {
+		if s.SpanContext.TraceID() == root.SpanContext().TraceID() {
+			spans[s.Name] = s
+		}
+	}
+	require.Len(t, spans, 4, "must export the root, payload, rate limit and HTTP spans")
+	payload, ok := spans["request.SendPayload"]
+	require.True(t, ok, "must export a SendPayload span")
+	assert.Equal(t, root.SpanContext().SpanID(), payload.Parent.SpanID(), "SendPayload span should be a child of the caller span")
+	assert.Equal(t, codes.Error, payload.Status.Code, "SendPayload span should record the error")
+	limit, ok := spans["request.InitiateRateLimit"]
+	require.True(t, ok, "must export a rate limit span")
+	assert.Equal(t, payload.SpanContext.SpanID(), limit.Parent.SpanID())
+	httpSpan, ok := spans["HTTP GET"]
+	require.True(t, ok, "must export an HTTP span")
+	assert.Equal(t, payload.SpanContext.SpanID(), httpSpan.Parent.SpanID())
+	assert.Contains(t, httpSpan.Attributes, attribute.String("url.path", "/error"), "HTTP span should record the path without the query")
+	assert.Contains(t, httpSpan.Attributes, attribute.Int("http.response.status_code", http.StatusBadRequest))
+}
+
 func TestEvaluateRetry(t *testing.T) {
 	t.Parallel()
 
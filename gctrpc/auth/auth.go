@@ -24,3 +24,20 @@ func (b BasicAuth) GetRequestMetadata(_ context.Context, _ ...string) (map[strin
 func (BasicAuth) RequireTransportSecurity() bool {
 	return true
 }
+
+// BearerToken stores an API token
+type BearerToken struct {
+	Token string
+}
+
+// GetRequestMetadata is a implementation of the GetRequestMetadata function
+func (b BearerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + b.Token,
+	}, nil
+}
+
+// RequireTransportSecurity is required for bearer tokens
+func (BearerToken) RequireTransportSecurity() bool {
+	return true
+}
@@ -1723,6 +1723,7 @@ type GetAccountBalancesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Account       string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1771,6 +1772,13 @@ func (x *GetAccountBalancesRequest) GetAssetType() string {
 	return ""
 }
 
+func (x *GetAccountBalancesRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type Account struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2499,6 +2507,66 @@ func (x *OnlineCoins) GetCoins() map[string]*OnlineCoinSummary {
 	return nil
 }
 
+type AccountHoldings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Account       string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Currencies    []*AccountCurrencyInfo `protobuf:"bytes,3,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountHoldings) Reset() {
+	*x = AccountHoldings{}
+	mi := &file_rpc_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountHoldings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountHoldings) ProtoMessage() {}
+
+func (x *AccountHoldings) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountHoldings.ProtoReflect.Descriptor instead.
+func (*AccountHoldings) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AccountHoldings) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *AccountHoldings) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AccountHoldings) GetCurrencies() []*AccountCurrencyInfo {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
 type GetPortfolioSummaryResponse struct {
 	state               protoimpl.MessageState   `protogen:"open.v1"`
 	CoinTotals          []*Coin                  `protobuf:"bytes,1,rep,name=coin_totals,json=coinTotals,proto3" json:"coin_totals,omitempty"`
@@ -2506,13 +2574,14 @@ type GetPortfolioSummaryResponse struct {
 	CoinsOfflineSummary map[string]*OfflineCoins `protobuf:"bytes,3,rep,name=coins_offline_summary,json=coinsOfflineSummary,proto3" json:"coins_offline_summary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CoinsOnline         []*Coin                  `protobuf:"bytes,4,rep,name=coins_online,json=coinsOnline,proto3" json:"coins_online,omitempty"`
 	CoinsOnlineSummary  map[string]*OnlineCoins  `protobuf:"bytes,5,rep,name=coins_online_summary,json=coinsOnlineSummary,proto3" json:"coins_online_summary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AccountHoldings     []*AccountHoldings       `protobuf:"bytes,6,rep,name=account_holdings,json=accountHoldings,proto3" json:"account_holdings,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *GetPortfolioSummaryResponse) Reset() {
 	*x = GetPortfolioSummaryResponse{}
-	mi := &file_rpc_proto_msgTypes[47]
+	mi := &file_rpc_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2524,7 +2593,7 @@ func (x *GetPortfolioSummaryResponse) String() string {
 func (*GetPortfolioSummaryResponse) ProtoMessage() {}
 
 func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[47]
+	mi := &file_rpc_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2537,7 +2606,7 @@ func (x *GetPortfolioSummaryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPortfolioSummaryResponse.ProtoReflect.Descriptor instead.
 func (*GetPortfolioSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{47}
+	return file_rpc_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetPortfolioSummaryResponse) GetCoinTotals() []*Coin {
@@ -2575,6 +2644,13 @@ func (x *GetPortfolioSummaryResponse) GetCoinsOnlineSummary() map[string]*Online
 	return nil
 }
 
+func (x *GetPortfolioSummaryResponse) GetAccountHoldings() []*AccountHoldings {
+	if x != nil {
+		return x.AccountHoldings
+	}
+	return nil
+}
+
 type AddPortfolioAddressRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	Address            string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -2589,7 +2665,7 @@ type AddPortfolioAddressRequest struct {
 
 func (x *AddPortfolioAddressRequest) Reset() {
 	*x = AddPortfolioAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[48]
+	mi := &file_rpc_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2601,7 +2677,7 @@ func (x *AddPortfolioAddressRequest) String() string {
 func (*AddPortfolioAddressRequest) ProtoMessage() {}
 
 func (x *AddPortfolioAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[48]
+	mi := &file_rpc_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2614,7 +2690,7 @@ func (x *AddPortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPortfolioAddressRequest.ProtoReflect.Descriptor instead.
 func (*AddPortfolioAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{48}
+	return file_rpc_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *AddPortfolioAddressRequest) GetAddress() string {
@@ -2670,7 +2746,7 @@ type RemovePortfolioAddressRequest struct {
 
 func (x *RemovePortfolioAddressRequest) Reset() {
 	*x = RemovePortfolioAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[49]
+	mi := &file_rpc_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2682,7 +2758,7 @@ func (x *RemovePortfolioAddressRequest) String() string {
 func (*RemovePortfolioAddressRequest) ProtoMessage() {}
 
 func (x *RemovePortfolioAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[49]
+	mi := &file_rpc_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2695,7 +2771,7 @@ func (x *RemovePortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemovePortfolioAddressRequest.ProtoReflect.Descriptor instead.
 func (*RemovePortfolioAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{49}
+	return file_rpc_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *RemovePortfolioAddressRequest) GetAddress() string {
@@ -2727,7 +2803,7 @@ type GetForexProvidersRequest struct {
 
 func (x *GetForexProvidersRequest) Reset() {
 	*x = GetForexProvidersRequest{}
-	mi := &file_rpc_proto_msgTypes[50]
+	mi := &file_rpc_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2739,7 +2815,7 @@ func (x *GetForexProvidersRequest) String() string {
 func (*GetForexProvidersRequest) ProtoMessage() {}
 
 func (x *GetForexProvidersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[50]
+	mi := &file_rpc_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2752,7 +2828,7 @@ func (x *GetForexProvidersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexProvidersRequest.ProtoReflect.Descriptor instead.
 func (*GetForexProvidersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{50}
+	return file_rpc_proto_rawDescGZIP(), []int{51}
 }
 
 type ForexProvider struct {
@@ -2770,7 +2846,7 @@ type ForexProvider struct {
 
 func (x *ForexProvider) Reset() {
 	*x = ForexProvider{}
-	mi := &file_rpc_proto_msgTypes[51]
+	mi := &file_rpc_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2782,7 +2858,7 @@ func (x *ForexProvider) String() string {
 func (*ForexProvider) ProtoMessage() {}
 
 func (x *ForexProvider) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[51]
+	mi := &file_rpc_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2795,7 +2871,7 @@ func (x *ForexProvider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForexProvider.ProtoReflect.Descriptor instead.
 func (*ForexProvider) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{51}
+	return file_rpc_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ForexProvider) GetName() string {
@@ -2856,7 +2932,7 @@ type GetForexProvidersResponse struct {
 
 func (x *GetForexProvidersResponse) Reset() {
 	*x = GetForexProvidersResponse{}
-	mi := &file_rpc_proto_msgTypes[52]
+	mi := &file_rpc_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2868,7 +2944,7 @@ func (x *GetForexProvidersResponse) String() string {
 func (*GetForexProvidersResponse) ProtoMessage() {}
 
 func (x *GetForexProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[52]
+	mi := &file_rpc_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2881,7 +2957,7 @@ func (x *GetForexProvidersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexProvidersResponse.ProtoReflect.Descriptor instead.
 func (*GetForexProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{52}
+	return file_rpc_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GetForexProvidersResponse) GetForexProviders() []*ForexProvider {
@@ -2899,7 +2975,7 @@ type GetForexRatesRequest struct {
 
 func (x *GetForexRatesRequest) Reset() {
 	*x = GetForexRatesRequest{}
-	mi := &file_rpc_proto_msgTypes[53]
+	mi := &file_rpc_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2911,7 +2987,7 @@ func (x *GetForexRatesRequest) String() string {
 func (*GetForexRatesRequest) ProtoMessage() {}
 
 func (x *GetForexRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[53]
+	mi := &file_rpc_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2924,7 +3000,7 @@ func (x *GetForexRatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexRatesRequest.ProtoReflect.Descriptor instead.
 func (*GetForexRatesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{53}
+	return file_rpc_proto_rawDescGZIP(), []int{54}
 }
 
 type ForexRatesConversion struct {
@@ -2939,7 +3015,7 @@ type ForexRatesConversion struct {
 
 func (x *ForexRatesConversion) Reset() {
 	*x = ForexRatesConversion{}
-	mi := &file_rpc_proto_msgTypes[54]
+	mi := &file_rpc_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2951,7 +3027,7 @@ func (x *ForexRatesConversion) String() string {
 func (*ForexRatesConversion) ProtoMessage() {}
 
 func (x *ForexRatesConversion) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[54]
+	mi := &file_rpc_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2964,7 +3040,7 @@ func (x *ForexRatesConversion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForexRatesConversion.ProtoReflect.Descriptor instead.
 func (*ForexRatesConversion) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{54}
+	return file_rpc_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *ForexRatesConversion) GetFrom() string {
@@ -3004,7 +3080,7 @@ type GetForexRatesResponse struct {
 
 func (x *GetForexRatesResponse) Reset() {
 	*x = GetForexRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[55]
+	mi := &file_rpc_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3016,7 +3092,7 @@ func (x *GetForexRatesResponse) String() string {
 func (*GetForexRatesResponse) ProtoMessage() {}
 
 func (x *GetForexRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[55]
+	mi := &file_rpc_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3029,7 +3105,7 @@ func (x *GetForexRatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetForexRatesResponse.ProtoReflect.Descriptor instead.
 func (*GetForexRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{55}
+	return file_rpc_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *GetForexRatesResponse) GetForexRates() []*ForexRatesConversion {
@@ -3059,13 +3135,14 @@ type OrderDetails struct {
 	Cost           float64                `protobuf:"fixed64,16,opt,name=cost,proto3" json:"cost,omitempty"`
 	Trades         []*TradeHistory        `protobuf:"bytes,17,rep,name=trades,proto3" json:"trades,omitempty"`
 	ContractAmount float64                `protobuf:"fixed64,18,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
+	Account        string                 `protobuf:"bytes,19,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
 func (x *OrderDetails) Reset() {
 	*x = OrderDetails{}
-	mi := &file_rpc_proto_msgTypes[56]
+	mi := &file_rpc_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3077,7 +3154,7 @@ func (x *OrderDetails) String() string {
 func (*OrderDetails) ProtoMessage() {}
 
 func (x *OrderDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[56]
+	mi := &file_rpc_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3090,7 +3167,7 @@ func (x *OrderDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderDetails.ProtoReflect.Descriptor instead.
 func (*OrderDetails) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{56}
+	return file_rpc_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *OrderDetails) GetExchange() string {
@@ -3219,6 +3296,13 @@ func (x *OrderDetails) GetContractAmount() float64 {
 	return 0
 }
 
+func (x *OrderDetails) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type TradeHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreationTime  int64                  `protobuf:"varint,1,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
@@ -3236,7 +3320,7 @@ type TradeHistory struct {
 
 func (x *TradeHistory) Reset() {
 	*x = TradeHistory{}
-	mi := &file_rpc_proto_msgTypes[57]
+	mi := &file_rpc_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3248,7 +3332,7 @@ func (x *TradeHistory) String() string {
 func (*TradeHistory) ProtoMessage() {}
 
 func (x *TradeHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[57]
+	mi := &file_rpc_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3261,7 +3345,7 @@ func (x *TradeHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TradeHistory.ProtoReflect.Descriptor instead.
 func (*TradeHistory) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{57}
+	return file_rpc_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *TradeHistory) GetCreationTime() int64 {
@@ -3334,13 +3418,14 @@ type GetOrdersRequest struct {
 	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
 	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Account       string                 `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetOrdersRequest) Reset() {
 	*x = GetOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[58]
+	mi := &file_rpc_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3352,7 +3437,7 @@ func (x *GetOrdersRequest) String() string {
 func (*GetOrdersRequest) ProtoMessage() {}
 
 func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[58]
+	mi := &file_rpc_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3365,7 +3450,7 @@ func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
 func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{58}
+	return file_rpc_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetOrdersRequest) GetExchange() string {
@@ -3403,6 +3488,13 @@ func (x *GetOrdersRequest) GetEndDate() string {
 	return ""
 }
 
+func (x *GetOrdersRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type GetOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Orders        []*OrderDetails        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -3412,7 +3504,7 @@ type GetOrdersResponse struct {
 
 func (x *GetOrdersResponse) Reset() {
 	*x = GetOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[59]
+	mi := &file_rpc_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3424,7 +3516,7 @@ func (x *GetOrdersResponse) String() string {
 func (*GetOrdersResponse) ProtoMessage() {}
 
 func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[59]
+	mi := &file_rpc_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3437,7 +3529,7 @@ func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
 func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{59}
+	return file_rpc_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetOrdersResponse) GetOrders() []*OrderDetails {
@@ -3453,13 +3545,14 @@ type GetOrderRequest struct {
 	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
 	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Account       string                 `protobuf:"bytes,5,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[60]
+	mi := &file_rpc_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3471,7 +3564,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[60]
+	mi := &file_rpc_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3484,7 +3577,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{60}
+	return file_rpc_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GetOrderRequest) GetExchange() string {
@@ -3515,6 +3608,13 @@ func (x *GetOrderRequest) GetAsset() string {
 	return ""
 }
 
+func (x *GetOrderRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type SubmitOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -3526,13 +3626,15 @@ type SubmitOrderRequest struct {
 	ClientId      string                 `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	AssetType     string                 `protobuf:"bytes,8,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	MarginType    string                 `protobuf:"bytes,9,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Strategy      string                 `protobuf:"bytes,10,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Account       string                 `protobuf:"bytes,11,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SubmitOrderRequest) Reset() {
 	*x = SubmitOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[61]
+	mi := &file_rpc_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3544,7 +3646,7 @@ func (x *SubmitOrderRequest) String() string {
 func (*SubmitOrderRequest) ProtoMessage() {}
 
 func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[61]
+	mi := &file_rpc_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3557,7 +3659,7 @@ func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitOrderRequest.ProtoReflect.Descriptor instead.
 func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{61}
+	return file_rpc_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *SubmitOrderRequest) GetExchange() string {
@@ -3623,6 +3725,20 @@ func (x *SubmitOrderRequest) GetMarginType() string {
 	return ""
 }
 
+func (x *SubmitOrderRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *SubmitOrderRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type Trades struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -3635,7 +3751,7 @@ type Trades struct {
 
 func (x *Trades) Reset() {
 	*x = Trades{}
-	mi := &file_rpc_proto_msgTypes[62]
+	mi := &file_rpc_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3647,7 +3763,7 @@ func (x *Trades) String() string {
 func (*Trades) ProtoMessage() {}
 
 func (x *Trades) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[62]
+	mi := &file_rpc_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3660,7 +3776,7 @@ func (x *Trades) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Trades.ProtoReflect.Descriptor instead.
 func (*Trades) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{62}
+	return file_rpc_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *Trades) GetAmount() float64 {
@@ -3702,7 +3818,7 @@ type SubmitOrderResponse struct {
 
 func (x *SubmitOrderResponse) Reset() {
 	*x = SubmitOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[63]
+	mi := &file_rpc_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3714,7 +3830,7 @@ func (x *SubmitOrderResponse) String() string {
 func (*SubmitOrderResponse) ProtoMessage() {}
 
 func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[63]
+	mi := &file_rpc_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3727,7 +3843,7 @@ func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitOrderResponse.ProtoReflect.Descriptor instead.
 func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{63}
+	return file_rpc_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *SubmitOrderResponse) GetOrderPlaced() bool {
@@ -3764,7 +3880,7 @@ type SimulateOrderRequest struct {
 
 func (x *SimulateOrderRequest) Reset() {
 	*x = SimulateOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[64]
+	mi := &file_rpc_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3776,7 +3892,7 @@ func (x *SimulateOrderRequest) String() string {
 func (*SimulateOrderRequest) ProtoMessage() {}
 
 func (x *SimulateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[64]
+	mi := &file_rpc_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3789,7 +3905,7 @@ func (x *SimulateOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulateOrderRequest.ProtoReflect.Descriptor instead.
 func (*SimulateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{64}
+	return file_rpc_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *SimulateOrderRequest) GetExchange() string {
@@ -3841,7 +3957,7 @@ type SimulateOrderResponse struct {
 
 func (x *SimulateOrderResponse) Reset() {
 	*x = SimulateOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[65]
+	mi := &file_rpc_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3853,7 +3969,7 @@ func (x *SimulateOrderResponse) String() string {
 func (*SimulateOrderResponse) ProtoMessage() {}
 
 func (x *SimulateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[65]
+	mi := &file_rpc_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3866,7 +3982,7 @@ func (x *SimulateOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SimulateOrderResponse.ProtoReflect.Descriptor instead.
 func (*SimulateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{65}
+	return file_rpc_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *SimulateOrderResponse) GetOrders() []*OrderbookItem {
@@ -3924,7 +4040,7 @@ type WhaleBombRequest struct {
 
 func (x *WhaleBombRequest) Reset() {
 	*x = WhaleBombRequest{}
-	mi := &file_rpc_proto_msgTypes[66]
+	mi := &file_rpc_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3936,7 +4052,7 @@ func (x *WhaleBombRequest) String() string {
 func (*WhaleBombRequest) ProtoMessage() {}
 
 func (x *WhaleBombRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[66]
+	mi := &file_rpc_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3949,7 +4065,7 @@ func (x *WhaleBombRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WhaleBombRequest.ProtoReflect.Descriptor instead.
 func (*WhaleBombRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{66}
+	return file_rpc_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *WhaleBombRequest) GetExchange() string {
@@ -3995,13 +4111,14 @@ type CancelOrderRequest struct {
 	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
 	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	Side          string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	Account       string                 `protobuf:"bytes,7,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CancelOrderRequest) Reset() {
 	*x = CancelOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[67]
+	mi := &file_rpc_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4013,7 +4130,7 @@ func (x *CancelOrderRequest) String() string {
 func (*CancelOrderRequest) ProtoMessage() {}
 
 func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[67]
+	mi := &file_rpc_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4026,7 +4143,7 @@ func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{67}
+	return file_rpc_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *CancelOrderRequest) GetExchange() string {
@@ -4071,6 +4188,13 @@ func (x *CancelOrderRequest) GetSide() string {
 	return ""
 }
 
+func (x *CancelOrderRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type CancelBatchOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -4079,13 +4203,14 @@ type CancelBatchOrdersRequest struct {
 	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
 	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	Side          string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	Account       string                 `protobuf:"bytes,7,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CancelBatchOrdersRequest) Reset() {
 	*x = CancelBatchOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[68]
+	mi := &file_rpc_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4097,7 +4222,7 @@ func (x *CancelBatchOrdersRequest) String() string {
 func (*CancelBatchOrdersRequest) ProtoMessage() {}
 
 func (x *CancelBatchOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[68]
+	mi := &file_rpc_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4110,7 +4235,7 @@ func (x *CancelBatchOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBatchOrdersRequest.ProtoReflect.Descriptor instead.
 func (*CancelBatchOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{68}
+	return file_rpc_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *CancelBatchOrdersRequest) GetExchange() string {
@@ -4155,6 +4280,13 @@ func (x *CancelBatchOrdersRequest) GetSide() string {
 	return ""
 }
 
+func (x *CancelBatchOrdersRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type Orders struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -4165,7 +4297,7 @@ type Orders struct {
 
 func (x *Orders) Reset() {
 	*x = Orders{}
-	mi := &file_rpc_proto_msgTypes[69]
+	mi := &file_rpc_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4177,7 +4309,7 @@ func (x *Orders) String() string {
 func (*Orders) ProtoMessage() {}
 
 func (x *Orders) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[69]
+	mi := &file_rpc_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4190,7 +4322,7 @@ func (x *Orders) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Orders.ProtoReflect.Descriptor instead.
 func (*Orders) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{69}
+	return file_rpc_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *Orders) GetExchange() string {
@@ -4216,7 +4348,7 @@ type CancelBatchOrdersResponse struct {
 
 func (x *CancelBatchOrdersResponse) Reset() {
 	*x = CancelBatchOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[70]
+	mi := &file_rpc_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4228,7 +4360,7 @@ func (x *CancelBatchOrdersResponse) String() string {
 func (*CancelBatchOrdersResponse) ProtoMessage() {}
 
 func (x *CancelBatchOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[70]
+	mi := &file_rpc_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4241,7 +4373,7 @@ func (x *CancelBatchOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelBatchOrdersResponse.ProtoReflect.Descriptor instead.
 func (*CancelBatchOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{70}
+	return file_rpc_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *CancelBatchOrdersResponse) GetOrders() []*Orders {
@@ -4254,13 +4386,14 @@ func (x *CancelBatchOrdersResponse) GetOrders() []*Orders {
 type CancelAllOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Account       string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CancelAllOrdersRequest) Reset() {
 	*x = CancelAllOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[71]
+	mi := &file_rpc_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4272,7 +4405,7 @@ func (x *CancelAllOrdersRequest) String() string {
 func (*CancelAllOrdersRequest) ProtoMessage() {}
 
 func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[71]
+	mi := &file_rpc_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4285,7 +4418,7 @@ func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelAllOrdersRequest.ProtoReflect.Descriptor instead.
 func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{71}
+	return file_rpc_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *CancelAllOrdersRequest) GetExchange() string {
@@ -4295,6 +4428,13 @@ func (x *CancelAllOrdersRequest) GetExchange() string {
 	return ""
 }
 
+func (x *CancelAllOrdersRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type CancelAllOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Orders        []*Orders              `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
@@ -4305,7 +4445,7 @@ type CancelAllOrdersResponse struct {
 
 func (x *CancelAllOrdersResponse) Reset() {
 	*x = CancelAllOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[72]
+	mi := &file_rpc_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4317,7 +4457,7 @@ func (x *CancelAllOrdersResponse) String() string {
 func (*CancelAllOrdersResponse) ProtoMessage() {}
 
 func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[72]
+	mi := &file_rpc_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4330,7 +4470,7 @@ func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelAllOrdersResponse.ProtoReflect.Descriptor instead.
 func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{72}
+	return file_rpc_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *CancelAllOrdersResponse) GetOrders() []*Orders {
@@ -4355,7 +4495,7 @@ type GetEventsRequest struct {
 
 func (x *GetEventsRequest) Reset() {
 	*x = GetEventsRequest{}
-	mi := &file_rpc_proto_msgTypes[73]
+	mi := &file_rpc_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4367,7 +4507,7 @@ func (x *GetEventsRequest) String() string {
 func (*GetEventsRequest) ProtoMessage() {}
 
 func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[73]
+	mi := &file_rpc_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4380,7 +4520,7 @@ func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
 func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{73}
+	return file_rpc_proto_rawDescGZIP(), []int{74}
 }
 
 type ConditionParams struct {
@@ -4396,7 +4536,7 @@ type ConditionParams struct {
 
 func (x *ConditionParams) Reset() {
 	*x = ConditionParams{}
-	mi := &file_rpc_proto_msgTypes[74]
+	mi := &file_rpc_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4408,7 +4548,7 @@ func (x *ConditionParams) String() string {
 func (*ConditionParams) ProtoMessage() {}
 
 func (x *ConditionParams) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[74]
+	mi := &file_rpc_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4421,7 +4561,7 @@ func (x *ConditionParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConditionParams.ProtoReflect.Descriptor instead.
 func (*ConditionParams) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{74}
+	return file_rpc_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *ConditionParams) GetCondition() string {
@@ -4474,7 +4614,7 @@ type GetEventsResponse struct {
 
 func (x *GetEventsResponse) Reset() {
 	*x = GetEventsResponse{}
-	mi := &file_rpc_proto_msgTypes[75]
+	mi := &file_rpc_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4486,7 +4626,7 @@ func (x *GetEventsResponse) String() string {
 func (*GetEventsResponse) ProtoMessage() {}
 
 func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[75]
+	mi := &file_rpc_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4499,7 +4639,7 @@ func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
 func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{75}
+	return file_rpc_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetEventsResponse) GetId() int64 {
@@ -4565,7 +4705,7 @@ type AddEventRequest struct {
 
 func (x *AddEventRequest) Reset() {
 	*x = AddEventRequest{}
-	mi := &file_rpc_proto_msgTypes[76]
+	mi := &file_rpc_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4577,7 +4717,7 @@ func (x *AddEventRequest) String() string {
 func (*AddEventRequest) ProtoMessage() {}
 
 func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[76]
+	mi := &file_rpc_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4590,7 +4730,7 @@ func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
 func (*AddEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{76}
+	return file_rpc_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *AddEventRequest) GetExchange() string {
@@ -4644,7 +4784,7 @@ type AddEventResponse struct {
 
 func (x *AddEventResponse) Reset() {
 	*x = AddEventResponse{}
-	mi := &file_rpc_proto_msgTypes[77]
+	mi := &file_rpc_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4656,7 +4796,7 @@ func (x *AddEventResponse) String() string {
 func (*AddEventResponse) ProtoMessage() {}
 
 func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[77]
+	mi := &file_rpc_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4669,7 +4809,7 @@ func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
 func (*AddEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{77}
+	return file_rpc_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *AddEventResponse) GetId() int64 {
@@ -4688,7 +4828,7 @@ type RemoveEventRequest struct {
 
 func (x *RemoveEventRequest) Reset() {
 	*x = RemoveEventRequest{}
-	mi := &file_rpc_proto_msgTypes[78]
+	mi := &file_rpc_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4700,7 +4840,7 @@ func (x *RemoveEventRequest) String() string {
 func (*RemoveEventRequest) ProtoMessage() {}
 
 func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[78]
+	mi := &file_rpc_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4713,7 +4853,7 @@ func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveEventRequest.ProtoReflect.Descriptor instead.
 func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{78}
+	return file_rpc_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *RemoveEventRequest) GetId() int64 {
@@ -4732,7 +4872,7 @@ type GetCryptocurrencyDepositAddressesRequest struct {
 
 func (x *GetCryptocurrencyDepositAddressesRequest) Reset() {
 	*x = GetCryptocurrencyDepositAddressesRequest{}
-	mi := &file_rpc_proto_msgTypes[79]
+	mi := &file_rpc_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4744,7 +4884,7 @@ func (x *GetCryptocurrencyDepositAddressesRequest) String() string {
 func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[79]
+	mi := &file_rpc_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4757,7 +4897,7 @@ func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use GetCryptocurrencyDepositAddressesRequest.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{79}
+	return file_rpc_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
@@ -4778,7 +4918,7 @@ type DepositAddress struct {
 
 func (x *DepositAddress) Reset() {
 	*x = DepositAddress{}
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4790,7 +4930,7 @@ func (x *DepositAddress) String() string {
 func (*DepositAddress) ProtoMessage() {}
 
 func (x *DepositAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[80]
+	mi := &file_rpc_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4803,7 +4943,7 @@ func (x *DepositAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddress.ProtoReflect.Descriptor instead.
 func (*DepositAddress) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{80}
+	return file_rpc_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *DepositAddress) GetAddress() string {
@@ -4836,7 +4976,7 @@ type DepositAddresses struct {
 
 func (x *DepositAddresses) Reset() {
 	*x = DepositAddresses{}
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4848,7 +4988,7 @@ func (x *DepositAddresses) String() string {
 func (*DepositAddresses) ProtoMessage() {}
 
 func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[81]
+	mi := &file_rpc_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4861,7 +5001,7 @@ func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddresses.ProtoReflect.Descriptor instead.
 func (*DepositAddresses) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{81}
+	return file_rpc_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *DepositAddresses) GetAddresses() []*DepositAddress {
@@ -4880,7 +5020,7 @@ type GetCryptocurrencyDepositAddressesResponse struct {
 
 func (x *GetCryptocurrencyDepositAddressesResponse) Reset() {
 	*x = GetCryptocurrencyDepositAddressesResponse{}
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4892,7 +5032,7 @@ func (x *GetCryptocurrencyDepositAddressesResponse) String() string {
 func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[82]
+	mi := &file_rpc_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4905,7 +5045,7 @@ func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use GetCryptocurrencyDepositAddressesResponse.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{82}
+	return file_rpc_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]*DepositAddresses {
@@ -4927,7 +5067,7 @@ type GetCryptocurrencyDepositAddressRequest struct {
 
 func (x *GetCryptocurrencyDepositAddressRequest) Reset() {
 	*x = GetCryptocurrencyDepositAddressRequest{}
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4939,7 +5079,7 @@ func (x *GetCryptocurrencyDepositAddressRequest) String() string {
 func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[83]
+	mi := &file_rpc_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4952,7 +5092,7 @@ func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetCryptocurrencyDepositAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{83}
+	return file_rpc_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
@@ -4993,7 +5133,7 @@ type GetCryptocurrencyDepositAddressResponse struct {
 
 func (x *GetCryptocurrencyDepositAddressResponse) Reset() {
 	*x = GetCryptocurrencyDepositAddressResponse{}
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5005,7 +5145,7 @@ func (x *GetCryptocurrencyDepositAddressResponse) String() string {
 func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage() {}
 
 func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[84]
+	mi := &file_rpc_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5018,7 +5158,7 @@ func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GetCryptocurrencyDepositAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{84}
+	return file_rpc_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
@@ -5045,7 +5185,7 @@ type GetAvailableTransferChainsRequest struct {
 
 func (x *GetAvailableTransferChainsRequest) Reset() {
 	*x = GetAvailableTransferChainsRequest{}
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5057,7 +5197,7 @@ func (x *GetAvailableTransferChainsRequest) String() string {
 func (*GetAvailableTransferChainsRequest) ProtoMessage() {}
 
 func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[85]
+	mi := &file_rpc_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5070,7 +5210,7 @@ func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAvailableTransferChainsRequest.ProtoReflect.Descriptor instead.
 func (*GetAvailableTransferChainsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{85}
+	return file_rpc_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetAvailableTransferChainsRequest) GetExchange() string {
@@ -5096,7 +5236,7 @@ type GetAvailableTransferChainsResponse struct {
 
 func (x *GetAvailableTransferChainsResponse) Reset() {
 	*x = GetAvailableTransferChainsResponse{}
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5108,7 +5248,7 @@ func (x *GetAvailableTransferChainsResponse) String() string {
 func (*GetAvailableTransferChainsResponse) ProtoMessage() {}
 
 func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[86]
+	mi := &file_rpc_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5121,7 +5261,7 @@ func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetAvailableTransferChainsResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableTransferChainsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{86}
+	return file_rpc_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *GetAvailableTransferChainsResponse) GetChains() []string {
@@ -5138,13 +5278,14 @@ type WithdrawFiatRequest struct {
 	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	BankAccountId string                 `protobuf:"bytes,5,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	Account       string                 `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WithdrawFiatRequest) Reset() {
 	*x = WithdrawFiatRequest{}
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5156,7 +5297,7 @@ func (x *WithdrawFiatRequest) String() string {
 func (*WithdrawFiatRequest) ProtoMessage() {}
 
 func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[87]
+	mi := &file_rpc_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5169,7 +5310,7 @@ func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawFiatRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{87}
+	return file_rpc_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *WithdrawFiatRequest) GetExchange() string {
@@ -5207,6 +5348,13 @@ func (x *WithdrawFiatRequest) GetBankAccountId() string {
 	return ""
 }
 
+func (x *WithdrawFiatRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type WithdrawCryptoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -5217,13 +5365,14 @@ type WithdrawCryptoRequest struct {
 	Fee           float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
 	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
 	Chain         string                 `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`
+	Account       string                 `protobuf:"bytes,9,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WithdrawCryptoRequest) Reset() {
 	*x = WithdrawCryptoRequest{}
-	mi := &file_rpc_proto_msgTypes[88]
+	mi := &file_rpc_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5235,7 +5384,7 @@ func (x *WithdrawCryptoRequest) String() string {
 func (*WithdrawCryptoRequest) ProtoMessage() {}
 
 func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[88]
+	mi := &file_rpc_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5248,7 +5397,7 @@ func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawCryptoRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{88}
+	return file_rpc_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *WithdrawCryptoRequest) GetExchange() string {
@@ -5307,6 +5456,13 @@ func (x *WithdrawCryptoRequest) GetChain() string {
 	return ""
 }
 
+func (x *WithdrawCryptoRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type WithdrawResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -5317,7 +5473,7 @@ type WithdrawResponse struct {
 
 func (x *WithdrawResponse) Reset() {
 	*x = WithdrawResponse{}
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5329,7 +5485,7 @@ func (x *WithdrawResponse) String() string {
 func (*WithdrawResponse) ProtoMessage() {}
 
 func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[89]
+	mi := &file_rpc_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5342,7 +5498,7 @@ func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{89}
+	return file_rpc_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *WithdrawResponse) GetId() string {
@@ -5368,7 +5524,7 @@ type WithdrawalEventByIDRequest struct {
 
 func (x *WithdrawalEventByIDRequest) Reset() {
 	*x = WithdrawalEventByIDRequest{}
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5380,7 +5536,7 @@ func (x *WithdrawalEventByIDRequest) String() string {
 func (*WithdrawalEventByIDRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[90]
+	mi := &file_rpc_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5393,7 +5549,7 @@ func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventByIDRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{90}
+	return file_rpc_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *WithdrawalEventByIDRequest) GetId() string {
@@ -5412,7 +5568,7 @@ type WithdrawalEventByIDResponse struct {
 
 func (x *WithdrawalEventByIDResponse) Reset() {
 	*x = WithdrawalEventByIDResponse{}
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5424,7 +5580,7 @@ func (x *WithdrawalEventByIDResponse) String() string {
 func (*WithdrawalEventByIDResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[91]
+	mi := &file_rpc_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5437,7 +5593,7 @@ func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventByIDResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventByIDResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{91}
+	return file_rpc_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *WithdrawalEventByIDResponse) GetEvent() *WithdrawalEventResponse {
@@ -5460,7 +5616,7 @@ type WithdrawalEventsByExchangeRequest struct {
 
 func (x *WithdrawalEventsByExchangeRequest) Reset() {
 	*x = WithdrawalEventsByExchangeRequest{}
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5472,7 +5628,7 @@ func (x *WithdrawalEventsByExchangeRequest) String() string {
 func (*WithdrawalEventsByExchangeRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[92]
+	mi := &file_rpc_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5485,7 +5641,7 @@ func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WithdrawalEventsByExchangeRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByExchangeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{92}
+	return file_rpc_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *WithdrawalEventsByExchangeRequest) GetExchange() string {
@@ -5535,7 +5691,7 @@ type WithdrawalEventsByDateRequest struct {
 
 func (x *WithdrawalEventsByDateRequest) Reset() {
 	*x = WithdrawalEventsByDateRequest{}
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5547,7 +5703,7 @@ func (x *WithdrawalEventsByDateRequest) String() string {
 func (*WithdrawalEventsByDateRequest) ProtoMessage() {}
 
 func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[93]
+	mi := &file_rpc_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5560,7 +5716,7 @@ func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventsByDateRequest.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByDateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{93}
+	return file_rpc_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *WithdrawalEventsByDateRequest) GetExchange() string {
@@ -5600,7 +5756,7 @@ type WithdrawalEventsByExchangeResponse struct {
 
 func (x *WithdrawalEventsByExchangeResponse) Reset() {
 	*x = WithdrawalEventsByExchangeResponse{}
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5612,7 +5768,7 @@ func (x *WithdrawalEventsByExchangeResponse) String() string {
 func (*WithdrawalEventsByExchangeResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[94]
+	mi := &file_rpc_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5625,7 +5781,7 @@ func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use WithdrawalEventsByExchangeResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventsByExchangeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{94}
+	return file_rpc_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *WithdrawalEventsByExchangeResponse) GetEvent() []*WithdrawalEventResponse {
@@ -5648,7 +5804,7 @@ type WithdrawalEventResponse struct {
 
 func (x *WithdrawalEventResponse) Reset() {
 	*x = WithdrawalEventResponse{}
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5660,7 +5816,7 @@ func (x *WithdrawalEventResponse) String() string {
 func (*WithdrawalEventResponse) ProtoMessage() {}
 
 func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[95]
+	mi := &file_rpc_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5673,7 +5829,7 @@ func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalEventResponse.ProtoReflect.Descriptor instead.
 func (*WithdrawalEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{95}
+	return file_rpc_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *WithdrawalEventResponse) GetId() string {
@@ -5722,7 +5878,7 @@ type WithdrawalExchangeEvent struct {
 
 func (x *WithdrawalExchangeEvent) Reset() {
 	*x = WithdrawalExchangeEvent{}
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5734,7 +5890,7 @@ func (x *WithdrawalExchangeEvent) String() string {
 func (*WithdrawalExchangeEvent) ProtoMessage() {}
 
 func (x *WithdrawalExchangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[96]
+	mi := &file_rpc_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5747,7 +5903,7 @@ func (x *WithdrawalExchangeEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalExchangeEvent.ProtoReflect.Descriptor instead.
 func (*WithdrawalExchangeEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{96}
+	return file_rpc_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *WithdrawalExchangeEvent) GetName() string {
@@ -5785,7 +5941,7 @@ type WithdrawalRequestEvent struct {
 
 func (x *WithdrawalRequestEvent) Reset() {
 	*x = WithdrawalRequestEvent{}
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5797,7 +5953,7 @@ func (x *WithdrawalRequestEvent) String() string {
 func (*WithdrawalRequestEvent) ProtoMessage() {}
 
 func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[97]
+	mi := &file_rpc_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5810,7 +5966,7 @@ func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WithdrawalRequestEvent.ProtoReflect.Descriptor instead.
 func (*WithdrawalRequestEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{97}
+	return file_rpc_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *WithdrawalRequestEvent) GetCurrency() string {
@@ -5869,7 +6025,7 @@ type FiatWithdrawalEvent struct {
 
 func (x *FiatWithdrawalEvent) Reset() {
 	*x = FiatWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[98]
+	mi := &file_rpc_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5881,7 +6037,7 @@ func (x *FiatWithdrawalEvent) String() string {
 func (*FiatWithdrawalEvent) ProtoMessage() {}
 
 func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[98]
+	mi := &file_rpc_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5894,7 +6050,7 @@ func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FiatWithdrawalEvent.ProtoReflect.Descriptor instead.
 func (*FiatWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{98}
+	return file_rpc_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *FiatWithdrawalEvent) GetBankName() string {
@@ -5951,7 +6107,7 @@ type CryptoWithdrawalEvent struct {
 
 func (x *CryptoWithdrawalEvent) Reset() {
 	*x = CryptoWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[99]
+	mi := &file_rpc_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5963,7 +6119,7 @@ func (x *CryptoWithdrawalEvent) String() string {
 func (*CryptoWithdrawalEvent) ProtoMessage() {}
 
 func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[99]
+	mi := &file_rpc_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,7 +6132,7 @@ func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CryptoWithdrawalEvent.ProtoReflect.Descriptor instead.
 func (*CryptoWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{99}
+	return file_rpc_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *CryptoWithdrawalEvent) GetAddress() string {
@@ -6016,7 +6172,7 @@ type GetLoggerDetailsRequest struct {
 
 func (x *GetLoggerDetailsRequest) Reset() {
 	*x = GetLoggerDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[100]
+	mi := &file_rpc_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6028,7 +6184,7 @@ func (x *GetLoggerDetailsRequest) String() string {
 func (*GetLoggerDetailsRequest) ProtoMessage() {}
 
 func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[100]
+	mi := &file_rpc_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6041,7 +6197,7 @@ func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
 func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{100}
+	return file_rpc_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *GetLoggerDetailsRequest) GetLogger() string {
@@ -6063,7 +6219,7 @@ type GetLoggerDetailsResponse struct {
 
 func (x *GetLoggerDetailsResponse) Reset() {
 	*x = GetLoggerDetailsResponse{}
-	mi := &file_rpc_proto_msgTypes[101]
+	mi := &file_rpc_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6075,7 +6231,7 @@ func (x *GetLoggerDetailsResponse) String() string {
 func (*GetLoggerDetailsResponse) ProtoMessage() {}
 
 func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[101]
+	mi := &file_rpc_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6088,7 +6244,7 @@ func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoggerDetailsResponse.ProtoReflect.Descriptor instead.
 func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{101}
+	return file_rpc_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *GetLoggerDetailsResponse) GetInfo() bool {
@@ -6129,7 +6285,7 @@ type SetLoggerDetailsRequest struct {
 
 func (x *SetLoggerDetailsRequest) Reset() {
 	*x = SetLoggerDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[102]
+	mi := &file_rpc_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6141,7 +6297,7 @@ func (x *SetLoggerDetailsRequest) String() string {
 func (*SetLoggerDetailsRequest) ProtoMessage() {}
 
 func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[102]
+	mi := &file_rpc_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6154,7 +6310,7 @@ func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
 func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{102}
+	return file_rpc_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *SetLoggerDetailsRequest) GetLogger() string {
@@ -6181,7 +6337,7 @@ type GetExchangePairsRequest struct {
 
 func (x *GetExchangePairsRequest) Reset() {
 	*x = GetExchangePairsRequest{}
-	mi := &file_rpc_proto_msgTypes[103]
+	mi := &file_rpc_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6193,7 +6349,7 @@ func (x *GetExchangePairsRequest) String() string {
 func (*GetExchangePairsRequest) ProtoMessage() {}
 
 func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[103]
+	mi := &file_rpc_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6206,7 +6362,7 @@ func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangePairsRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{103}
+	return file_rpc_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *GetExchangePairsRequest) GetExchange() string {
@@ -6232,7 +6388,7 @@ type GetExchangePairsResponse struct {
 
 func (x *GetExchangePairsResponse) Reset() {
 	*x = GetExchangePairsResponse{}
-	mi := &file_rpc_proto_msgTypes[104]
+	mi := &file_rpc_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6244,7 +6400,7 @@ func (x *GetExchangePairsResponse) String() string {
 func (*GetExchangePairsResponse) ProtoMessage() {}
 
 func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[104]
+	mi := &file_rpc_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6257,7 +6413,7 @@ func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangePairsResponse.ProtoReflect.Descriptor instead.
 func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{104}
+	return file_rpc_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
@@ -6279,7 +6435,7 @@ type SetExchangePairRequest struct {
 
 func (x *SetExchangePairRequest) Reset() {
 	*x = SetExchangePairRequest{}
-	mi := &file_rpc_proto_msgTypes[105]
+	mi := &file_rpc_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6291,7 +6447,7 @@ func (x *SetExchangePairRequest) String() string {
 func (*SetExchangePairRequest) ProtoMessage() {}
 
 func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[105]
+	mi := &file_rpc_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6304,7 +6460,7 @@ func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetExchangePairRequest.ProtoReflect.Descriptor instead.
 func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{105}
+	return file_rpc_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *SetExchangePairRequest) GetExchange() string {
@@ -6346,7 +6502,7 @@ type GetOrderbookStreamRequest struct {
 
 func (x *GetOrderbookStreamRequest) Reset() {
 	*x = GetOrderbookStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[106]
+	mi := &file_rpc_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6358,7 +6514,7 @@ func (x *GetOrderbookStreamRequest) String() string {
 func (*GetOrderbookStreamRequest) ProtoMessage() {}
 
 func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[106]
+	mi := &file_rpc_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6371,7 +6527,7 @@ func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderbookStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{106}
+	return file_rpc_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *GetOrderbookStreamRequest) GetExchange() string {
@@ -6404,7 +6560,7 @@ type GetExchangeOrderbookStreamRequest struct {
 
 func (x *GetExchangeOrderbookStreamRequest) Reset() {
 	*x = GetExchangeOrderbookStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[107]
+	mi := &file_rpc_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6416,7 +6572,7 @@ func (x *GetExchangeOrderbookStreamRequest) String() string {
 func (*GetExchangeOrderbookStreamRequest) ProtoMessage() {}
 
 func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[107]
+	mi := &file_rpc_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6429,7 +6585,7 @@ func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetExchangeOrderbookStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{107}
+	return file_rpc_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *GetExchangeOrderbookStreamRequest) GetExchange() string {
@@ -6450,7 +6606,7 @@ type GetTickerStreamRequest struct {
 
 func (x *GetTickerStreamRequest) Reset() {
 	*x = GetTickerStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[108]
+	mi := &file_rpc_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6462,7 +6618,7 @@ func (x *GetTickerStreamRequest) String() string {
 func (*GetTickerStreamRequest) ProtoMessage() {}
 
 func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[108]
+	mi := &file_rpc_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6475,7 +6631,7 @@ func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTickerStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{108}
+	return file_rpc_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *GetTickerStreamRequest) GetExchange() string {
@@ -6508,7 +6664,7 @@ type GetExchangeTickerStreamRequest struct {
 
 func (x *GetExchangeTickerStreamRequest) Reset() {
 	*x = GetExchangeTickerStreamRequest{}
-	mi := &file_rpc_proto_msgTypes[109]
+	mi := &file_rpc_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6520,7 +6676,7 @@ func (x *GetExchangeTickerStreamRequest) String() string {
 func (*GetExchangeTickerStreamRequest) ProtoMessage() {}
 
 func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[109]
+	mi := &file_rpc_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6533,7 +6689,7 @@ func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExchangeTickerStreamRequest.ProtoReflect.Descriptor instead.
 func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{109}
+	return file_rpc_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *GetExchangeTickerStreamRequest) GetExchange() string {
@@ -6556,7 +6712,7 @@ type GetAuditEventRequest struct {
 
 func (x *GetAuditEventRequest) Reset() {
 	*x = GetAuditEventRequest{}
-	mi := &file_rpc_proto_msgTypes[110]
+	mi := &file_rpc_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6568,7 +6724,7 @@ func (x *GetAuditEventRequest) String() string {
 func (*GetAuditEventRequest) ProtoMessage() {}
 
 func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[110]
+	mi := &file_rpc_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6581,7 +6737,7 @@ func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
 func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{110}
+	return file_rpc_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *GetAuditEventRequest) GetStartDate() string {
@@ -6628,7 +6784,7 @@ type GetAuditEventResponse struct {
 
 func (x *GetAuditEventResponse) Reset() {
 	*x = GetAuditEventResponse{}
-	mi := &file_rpc_proto_msgTypes[111]
+	mi := &file_rpc_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6640,7 +6796,7 @@ func (x *GetAuditEventResponse) String() string {
 func (*GetAuditEventResponse) ProtoMessage() {}
 
 func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[111]
+	mi := &file_rpc_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6653,7 +6809,7 @@ func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
 func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{111}
+	return file_rpc_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *GetAuditEventResponse) GetEvents() []*AuditEvent {
@@ -6676,7 +6832,7 @@ type GetSavedTradesRequest struct {
 
 func (x *GetSavedTradesRequest) Reset() {
 	*x = GetSavedTradesRequest{}
-	mi := &file_rpc_proto_msgTypes[112]
+	mi := &file_rpc_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6688,7 +6844,7 @@ func (x *GetSavedTradesRequest) String() string {
 func (*GetSavedTradesRequest) ProtoMessage() {}
 
 func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[112]
+	mi := &file_rpc_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6701,7 +6857,7 @@ func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSavedTradesRequest.ProtoReflect.Descriptor instead.
 func (*GetSavedTradesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{112}
+	return file_rpc_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *GetSavedTradesRequest) GetExchange() string {
@@ -6752,7 +6908,7 @@ type SavedTrades struct {
 
 func (x *SavedTrades) Reset() {
 	*x = SavedTrades{}
-	mi := &file_rpc_proto_msgTypes[113]
+	mi := &file_rpc_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6764,7 +6920,7 @@ func (x *SavedTrades) String() string {
 func (*SavedTrades) ProtoMessage() {}
 
 func (x *SavedTrades) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[113]
+	mi := &file_rpc_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6777,7 +6933,7 @@ func (x *SavedTrades) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SavedTrades.ProtoReflect.Descriptor instead.
 func (*SavedTrades) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{113}
+	return file_rpc_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *SavedTrades) GetPrice() float64 {
@@ -6827,7 +6983,7 @@ type SavedTradesResponse struct {
 
 func (x *SavedTradesResponse) Reset() {
 	*x = SavedTradesResponse{}
-	mi := &file_rpc_proto_msgTypes[114]
+	mi := &file_rpc_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6839,7 +6995,7 @@ func (x *SavedTradesResponse) String() string {
 func (*SavedTradesResponse) ProtoMessage() {}
 
 func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[114]
+	mi := &file_rpc_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6852,7 +7008,7 @@ func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SavedTradesResponse.ProtoReflect.Descriptor instead.
 func (*SavedTradesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{114}
+	return file_rpc_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *SavedTradesResponse) GetExchangeName() string {
@@ -6899,7 +7055,7 @@ type ConvertTradesToCandlesRequest struct {
 
 func (x *ConvertTradesToCandlesRequest) Reset() {
 	*x = ConvertTradesToCandlesRequest{}
-	mi := &file_rpc_proto_msgTypes[115]
+	mi := &file_rpc_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6911,7 +7067,7 @@ func (x *ConvertTradesToCandlesRequest) String() string {
 func (*ConvertTradesToCandlesRequest) ProtoMessage() {}
 
 func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[115]
+	mi := &file_rpc_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6924,7 +7080,7 @@ func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConvertTradesToCandlesRequest.ProtoReflect.Descriptor instead.
 func (*ConvertTradesToCandlesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{115}
+	return file_rpc_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *ConvertTradesToCandlesRequest) GetExchange() string {
@@ -6983,38 +7139,36 @@ func (x *ConvertTradesToCandlesRequest) GetForce() bool {
 	return false
 }
 
-type GetHistoricCandlesRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start                 string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End                   string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
-	TimeInterval          int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
-	ExRequest             bool                   `protobuf:"varint,7,opt,name=ex_request,json=exRequest,proto3" json:"ex_request,omitempty"`
-	Sync                  bool                   `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
-	UseDb                 bool                   `protobuf:"varint,9,opt,name=use_db,json=useDb,proto3" json:"use_db,omitempty"`
-	FillMissingWithTrades bool                   `protobuf:"varint,10,opt,name=fill_missing_with_trades,json=fillMissingWithTrades,proto3" json:"fill_missing_with_trades,omitempty"`
-	Force                 bool                   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type MarketDataTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	DataType      string                 `protobuf:"bytes,6,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Interval      int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	Directory     string                 `protobuf:"bytes,8,opt,name=directory,proto3" json:"directory,omitempty"`
+	Overwrite     bool                   `protobuf:"varint,9,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesRequest) Reset() {
-	*x = GetHistoricCandlesRequest{}
-	mi := &file_rpc_proto_msgTypes[116]
+func (x *MarketDataTransferRequest) Reset() {
+	*x = MarketDataTransferRequest{}
+	mi := &file_rpc_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoricCandlesRequest) String() string {
+func (x *MarketDataTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricCandlesRequest) ProtoMessage() {}
+func (*MarketDataTransferRequest) ProtoMessage() {}
 
-func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[116]
+func (x *MarketDataTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7025,115 +7179,155 @@ func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
-func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use MarketDataTransferRequest.ProtoReflect.Descriptor instead.
+func (*MarketDataTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetHistoricCandlesRequest) GetExchange() string {
+func (x *MarketDataTransferRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
+func (x *MarketDataTransferRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetHistoricCandlesRequest) GetAssetType() string {
+func (x *MarketDataTransferRequest) GetAssetType() string {
 	if x != nil {
 		return x.AssetType
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetStart() string {
+func (x *MarketDataTransferRequest) GetStart() string {
 	if x != nil {
 		return x.Start
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetEnd() string {
+func (x *MarketDataTransferRequest) GetEnd() string {
 	if x != nil {
 		return x.End
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetTimeInterval() int64 {
+func (x *MarketDataTransferRequest) GetDataType() string {
 	if x != nil {
-		return x.TimeInterval
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *MarketDataTransferRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
 	}
 	return 0
 }
 
-func (x *GetHistoricCandlesRequest) GetExRequest() bool {
+func (x *MarketDataTransferRequest) GetDirectory() string {
 	if x != nil {
-		return x.ExRequest
+		return x.Directory
 	}
-	return false
+	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetSync() bool {
+func (x *MarketDataTransferRequest) GetOverwrite() bool {
 	if x != nil {
-		return x.Sync
+		return x.Overwrite
 	}
 	return false
 }
 
-func (x *GetHistoricCandlesRequest) GetUseDb() bool {
+type MarketDataTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Directory     string                 `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarketDataTransferResponse) Reset() {
+	*x = MarketDataTransferResponse{}
+	mi := &file_rpc_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarketDataTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarketDataTransferResponse) ProtoMessage() {}
+
+func (x *MarketDataTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[118]
 	if x != nil {
-		return x.UseDb
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetHistoricCandlesRequest) GetFillMissingWithTrades() bool {
+// Deprecated: Use MarketDataTransferResponse.ProtoReflect.Descriptor instead.
+func (*MarketDataTransferResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *MarketDataTransferResponse) GetDirectory() string {
 	if x != nil {
-		return x.FillMissingWithTrades
+		return x.Directory
 	}
-	return false
+	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetForce() bool {
+func (x *MarketDataTransferResponse) GetCount() uint64 {
 	if x != nil {
-		return x.Force
+		return x.Count
 	}
-	return false
+	return 0
 }
 
-type GetHistoricCandlesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
-	Interval      string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
-	Candle        []*Candle              `protobuf:"bytes,5,rep,name=candle,proto3" json:"candle,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RunDataQualityCheckRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Exchange            string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType           string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	DataType            string                 `protobuf:"bytes,4,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Interval            int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start               string                 `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End                 string                 `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	ComparisonExchanges []string               `protobuf:"bytes,8,rep,name=comparison_exchanges,json=comparisonExchanges,proto3" json:"comparison_exchanges,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesResponse) Reset() {
-	*x = GetHistoricCandlesResponse{}
-	mi := &file_rpc_proto_msgTypes[117]
+func (x *RunDataQualityCheckRequest) Reset() {
+	*x = RunDataQualityCheckRequest{}
+	mi := &file_rpc_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoricCandlesResponse) String() string {
+func (x *RunDataQualityCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricCandlesResponse) ProtoMessage() {}
+func (*RunDataQualityCheckRequest) ProtoMessage() {}
 
-func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[117]
+func (x *RunDataQualityCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7144,81 +7338,95 @@ func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use RunDataQualityCheckRequest.ProtoReflect.Descriptor instead.
+func (*RunDataQualityCheckRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetHistoricCandlesResponse) GetExchange() string {
+func (x *RunDataQualityCheckRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
+func (x *RunDataQualityCheckRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetHistoricCandlesResponse) GetStart() string {
+func (x *RunDataQualityCheckRequest) GetAssetType() string {
 	if x != nil {
-		return x.Start
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetEnd() string {
+func (x *RunDataQualityCheckRequest) GetDataType() string {
 	if x != nil {
-		return x.End
+		return x.DataType
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetInterval() string {
+func (x *RunDataQualityCheckRequest) GetInterval() int64 {
 	if x != nil {
 		return x.Interval
 	}
+	return 0
+}
+
+func (x *RunDataQualityCheckRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetCandle() []*Candle {
+func (x *RunDataQualityCheckRequest) GetEnd() string {
 	if x != nil {
-		return x.Candle
+		return x.End
+	}
+	return ""
+}
+
+func (x *RunDataQualityCheckRequest) GetComparisonExchanges() []string {
+	if x != nil {
+		return x.ComparisonExchanges
 	}
 	return nil
 }
 
-type Candle struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	Low           float64                `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
-	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Open          float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
-	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
-	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
-	IsPartial     bool                   `protobuf:"varint,7,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DataQualityIssue struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Type               string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Start              string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Value              float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	ComparisonExchange string                 `protobuf:"bytes,5,opt,name=comparison_exchange,json=comparisonExchange,proto3" json:"comparison_exchange,omitempty"`
+	Description        string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	RepairJobId        string                 `protobuf:"bytes,7,opt,name=repair_job_id,json=repairJobId,proto3" json:"repair_job_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *Candle) Reset() {
-	*x = Candle{}
-	mi := &file_rpc_proto_msgTypes[118]
+func (x *DataQualityIssue) Reset() {
+	*x = DataQualityIssue{}
+	mi := &file_rpc_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Candle) String() string {
+func (x *DataQualityIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Candle) ProtoMessage() {}
+func (*DataQualityIssue) ProtoMessage() {}
 
-func (x *Candle) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[118]
+func (x *DataQualityIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7229,85 +7437,92 @@ func (x *Candle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
-func (*Candle) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use DataQualityIssue.ProtoReflect.Descriptor instead.
+func (*DataQualityIssue) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *Candle) GetTime() string {
+func (x *DataQualityIssue) GetType() string {
 	if x != nil {
-		return x.Time
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Candle) GetLow() float64 {
+func (x *DataQualityIssue) GetStart() string {
 	if x != nil {
-		return x.Low
+		return x.Start
 	}
-	return 0
+	return ""
 }
 
-func (x *Candle) GetHigh() float64 {
+func (x *DataQualityIssue) GetEnd() string {
 	if x != nil {
-		return x.High
+		return x.End
 	}
-	return 0
+	return ""
 }
 
-func (x *Candle) GetOpen() float64 {
+func (x *DataQualityIssue) GetValue() float64 {
 	if x != nil {
-		return x.Open
+		return x.Value
 	}
 	return 0
 }
 
-func (x *Candle) GetClose() float64 {
+func (x *DataQualityIssue) GetComparisonExchange() string {
 	if x != nil {
-		return x.Close
+		return x.ComparisonExchange
 	}
-	return 0
+	return ""
 }
 
-func (x *Candle) GetVolume() float64 {
+func (x *DataQualityIssue) GetDescription() string {
 	if x != nil {
-		return x.Volume
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *Candle) GetIsPartial() bool {
+func (x *DataQualityIssue) GetRepairJobId() string {
 	if x != nil {
-		return x.IsPartial
+		return x.RepairJobId
 	}
-	return false
+	return ""
 }
 
-type AuditEvent struct {
+type DataQualityReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Identifier    string                 `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	DataType      string                 `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Interval      int64                  `protobuf:"varint,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start         string                 `protobuf:"bytes,7,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,8,opt,name=end,proto3" json:"end,omitempty"`
+	Records       int64                  `protobuf:"varint,9,opt,name=records,proto3" json:"records,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Issues        []*DataQualityIssue    `protobuf:"bytes,11,rep,name=issues,proto3" json:"issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AuditEvent) Reset() {
-	*x = AuditEvent{}
-	mi := &file_rpc_proto_msgTypes[119]
+func (x *DataQualityReport) Reset() {
+	*x = DataQualityReport{}
+	mi := &file_rpc_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditEvent) String() string {
+func (x *DataQualityReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditEvent) ProtoMessage() {}
+func (*DataQualityReport) ProtoMessage() {}
 
-func (x *AuditEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[119]
+func (x *DataQualityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7318,129 +7533,114 @@ func (x *AuditEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
-func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use DataQualityReport.ProtoReflect.Descriptor instead.
+func (*DataQualityReport) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *AuditEvent) GetType() string {
+func (x *DataQualityReport) GetId() string {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetIdentifier() string {
+func (x *DataQualityReport) GetExchange() string {
 	if x != nil {
-		return x.Identifier
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetMessage() string {
+func (x *DataQualityReport) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Message
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *DataQualityReport) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetTimestamp() string {
+func (x *DataQualityReport) GetDataType() string {
 	if x != nil {
-		return x.Timestamp
+		return x.DataType
 	}
 	return ""
 }
 
-type GCTScript struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	NextRun       string                 `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DataQualityReport) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
 }
 
-func (x *GCTScript) Reset() {
-	*x = GCTScript{}
-	mi := &file_rpc_proto_msgTypes[120]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GCTScript) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GCTScript) ProtoMessage() {}
-
-func (x *GCTScript) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[120]
+func (x *DataQualityReport) GetStart() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Start
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
-func (*GCTScript) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{120}
+	return ""
 }
 
-func (x *GCTScript) GetUuid() string {
+func (x *DataQualityReport) GetEnd() string {
 	if x != nil {
-		return x.Uuid
+		return x.End
 	}
 	return ""
 }
 
-func (x *GCTScript) GetName() string {
+func (x *DataQualityReport) GetRecords() int64 {
 	if x != nil {
-		return x.Name
+		return x.Records
 	}
-	return ""
+	return 0
 }
 
-func (x *GCTScript) GetPath() string {
+func (x *DataQualityReport) GetCreatedAt() string {
 	if x != nil {
-		return x.Path
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GCTScript) GetNextRun() string {
+func (x *DataQualityReport) GetIssues() []*DataQualityIssue {
 	if x != nil {
-		return x.NextRun
+		return x.Issues
 	}
-	return ""
+	return nil
 }
 
-type GCTScriptExecuteRequest struct {
+type GetDataQualityReportsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptExecuteRequest) Reset() {
-	*x = GCTScriptExecuteRequest{}
-	mi := &file_rpc_proto_msgTypes[121]
+func (x *GetDataQualityReportsRequest) Reset() {
+	*x = GetDataQualityReportsRequest{}
+	mi := &file_rpc_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptExecuteRequest) String() string {
+func (x *GetDataQualityReportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptExecuteRequest) ProtoMessage() {}
+func (*GetDataQualityReportsRequest) ProtoMessage() {}
 
-func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[121]
+func (x *GetDataQualityReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7451,82 +7651,67 @@ func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use GetDataQualityReportsRequest.ProtoReflect.Descriptor instead.
+func (*GetDataQualityReportsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
+func (x *GetDataQualityReportsRequest) GetExchange() string {
 	if x != nil {
-		return x.Script
+		return x.Exchange
 	}
-	return nil
-}
-
-type GCTScriptStopRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GCTScriptStopRequest) Reset() {
-	*x = GCTScriptStopRequest{}
-	mi := &file_rpc_proto_msgTypes[122]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *GCTScriptStopRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetDataQualityReportsRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (*GCTScriptStopRequest) ProtoMessage() {}
-
-func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[122]
+func (x *GetDataQualityReportsRequest) GetAssetType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AssetType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{122}
+func (x *GetDataQualityReportsRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (x *GCTScriptStopRequest) GetScript() *GCTScript {
+func (x *GetDataQualityReportsRequest) GetEnd() string {
 	if x != nil {
-		return x.Script
+		return x.End
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptStopAllRequest struct {
+type GetDataQualityReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*DataQualityReport   `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStopAllRequest) Reset() {
-	*x = GCTScriptStopAllRequest{}
+func (x *GetDataQualityReportsResponse) Reset() {
+	*x = GetDataQualityReportsResponse{}
 	mi := &file_rpc_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStopAllRequest) String() string {
+func (x *GetDataQualityReportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStopAllRequest) ProtoMessage() {}
+func (*GetDataQualityReportsResponse) ProtoMessage() {}
 
-func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDataQualityReportsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7538,31 +7723,49 @@ func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDataQualityReportsResponse.ProtoReflect.Descriptor instead.
+func (*GetDataQualityReportsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{123}
 }
 
-type GCTScriptStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetDataQualityReportsResponse) GetReports() []*DataQualityReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
 }
 
-func (x *GCTScriptStatusRequest) Reset() {
-	*x = GCTScriptStatusRequest{}
+type GetHistoricCandlesRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                 string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                   string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	TimeInterval          int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	ExRequest             bool                   `protobuf:"varint,7,opt,name=ex_request,json=exRequest,proto3" json:"ex_request,omitempty"`
+	Sync                  bool                   `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
+	UseDb                 bool                   `protobuf:"varint,9,opt,name=use_db,json=useDb,proto3" json:"use_db,omitempty"`
+	FillMissingWithTrades bool                   `protobuf:"varint,10,opt,name=fill_missing_with_trades,json=fillMissingWithTrades,proto3" json:"fill_missing_with_trades,omitempty"`
+	Force                 bool                   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetHistoricCandlesRequest) Reset() {
+	*x = GetHistoricCandlesRequest{}
 	mi := &file_rpc_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStatusRequest) String() string {
+func (x *GetHistoricCandlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStatusRequest) ProtoMessage() {}
+func (*GetHistoricCandlesRequest) ProtoMessage() {}
 
-func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7574,145 +7777,115 @@ func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{124}
 }
 
-type GCTScriptListAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GCTScriptListAllRequest) Reset() {
-	*x = GCTScriptListAllRequest{}
-	mi := &file_rpc_proto_msgTypes[125]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GCTScriptListAllRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GCTScriptListAllRequest) ProtoMessage() {}
-
-func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[125]
+func (x *GetHistoricCandlesRequest) GetExchange() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Exchange
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{125}
-}
-
-type GCTScriptUploadRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScriptName    string                 `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ScriptData    string                 `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
-	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
-	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GCTScriptUploadRequest) Reset() {
-	*x = GCTScriptUploadRequest{}
-	mi := &file_rpc_proto_msgTypes[126]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GCTScriptUploadRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GCTScriptUploadRequest) ProtoMessage() {}
-
-func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[126]
+func (x *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pair
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{126}
+func (x *GetHistoricCandlesRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetScriptName() string {
+func (x *GetHistoricCandlesRequest) GetStart() string {
 	if x != nil {
-		return x.ScriptName
+		return x.Start
 	}
 	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetScriptData() string {
+func (x *GetHistoricCandlesRequest) GetEnd() string {
 	if x != nil {
-		return x.ScriptData
+		return x.End
 	}
 	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetData() []byte {
+func (x *GetHistoricCandlesRequest) GetTimeInterval() int64 {
 	if x != nil {
-		return x.Data
+		return x.TimeInterval
 	}
-	return nil
+	return 0
 }
 
-func (x *GCTScriptUploadRequest) GetArchived() bool {
+func (x *GetHistoricCandlesRequest) GetExRequest() bool {
 	if x != nil {
-		return x.Archived
+		return x.ExRequest
 	}
 	return false
 }
 
-func (x *GCTScriptUploadRequest) GetOverwrite() bool {
+func (x *GetHistoricCandlesRequest) GetSync() bool {
 	if x != nil {
-		return x.Overwrite
+		return x.Sync
 	}
 	return false
 }
 
-type GCTScriptReadScriptRequest struct {
+func (x *GetHistoricCandlesRequest) GetUseDb() bool {
+	if x != nil {
+		return x.UseDb
+	}
+	return false
+}
+
+func (x *GetHistoricCandlesRequest) GetFillMissingWithTrades() bool {
+	if x != nil {
+		return x.FillMissingWithTrades
+	}
+	return false
+}
+
+func (x *GetHistoricCandlesRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetHistoricCandlesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Interval      string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candle        []*Candle              `protobuf:"bytes,5,rep,name=candle,proto3" json:"candle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptReadScriptRequest) Reset() {
-	*x = GCTScriptReadScriptRequest{}
-	mi := &file_rpc_proto_msgTypes[127]
+func (x *GetHistoricCandlesResponse) Reset() {
+	*x = GetHistoricCandlesResponse{}
+	mi := &file_rpc_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptReadScriptRequest) String() string {
+func (x *GetHistoricCandlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptReadScriptRequest) ProtoMessage() {}
+func (*GetHistoricCandlesResponse) ProtoMessage() {}
 
-func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[127]
+func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7723,85 +7896,81 @@ func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+func (x *GetHistoricCandlesResponse) GetExchange() string {
 	if x != nil {
-		return x.Script
+		return x.Exchange
 	}
-	return nil
-}
-
-type GCTScriptQueryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GCTScriptQueryRequest) Reset() {
-	*x = GCTScriptQueryRequest{}
-	mi := &file_rpc_proto_msgTypes[128]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *GCTScriptQueryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetHistoricCandlesResponse) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (*GCTScriptQueryRequest) ProtoMessage() {}
-
-func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[128]
+func (x *GetHistoricCandlesResponse) GetEnd() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.End
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{128}
+func (x *GetHistoricCandlesResponse) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
 }
 
-func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
+func (x *GetHistoricCandlesResponse) GetCandle() []*Candle {
 	if x != nil {
-		return x.Script
+		return x.Candle
 	}
 	return nil
 }
 
-type GCTScriptAutoLoadRequest struct {
+type Candle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Low           float64                `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Open          float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	IsPartial     bool                   `protobuf:"varint,7,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptAutoLoadRequest) Reset() {
-	*x = GCTScriptAutoLoadRequest{}
-	mi := &file_rpc_proto_msgTypes[129]
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_rpc_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptAutoLoadRequest) String() string {
+func (x *Candle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
+func (*Candle) ProtoMessage() {}
 
-func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[129]
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7812,48 +7981,85 @@ func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GCTScriptAutoLoadRequest) GetScript() string {
+func (x *Candle) GetTime() string {
 	if x != nil {
-		return x.Script
+		return x.Time
 	}
 	return ""
 }
 
-func (x *GCTScriptAutoLoadRequest) GetStatus() bool {
+func (x *Candle) GetLow() float64 {
 	if x != nil {
-		return x.Status
+		return x.Low
+	}
+	return 0
+}
+
+func (x *Candle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *Candle) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *Candle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *Candle) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *Candle) GetIsPartial() bool {
+	if x != nil {
+		return x.IsPartial
 	}
 	return false
 }
 
-type GCTScriptStatusResponse struct {
+type AuditEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Scripts       []*GCTScript           `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Identifier    string                 `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStatusResponse) Reset() {
-	*x = GCTScriptStatusResponse{}
-	mi := &file_rpc_proto_msgTypes[130]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_rpc_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStatusResponse) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStatusResponse) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[130]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7864,49 +8070,64 @@ func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
-func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GCTScriptStatusResponse) GetStatus() string {
+func (x *AuditEvent) GetType() string {
 	if x != nil {
-		return x.Status
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+func (x *AuditEvent) GetIdentifier() string {
 	if x != nil {
-		return x.Scripts
+		return x.Identifier
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptQueryResponse struct {
+func (x *AuditEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type GCTScript struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Script        *GCTScript             `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
-	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	NextRun       string                 `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptQueryResponse) Reset() {
-	*x = GCTScriptQueryResponse{}
-	mi := &file_rpc_proto_msgTypes[131]
+func (x *GCTScript) Reset() {
+	*x = GCTScript{}
+	mi := &file_rpc_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptQueryResponse) String() string {
+func (x *GCTScript) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptQueryResponse) ProtoMessage() {}
+func (*GCTScript) ProtoMessage() {}
 
-func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[131]
+func (x *GCTScript) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7917,55 +8138,63 @@ func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
-func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
+func (*GCTScript) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GCTScriptQueryResponse) GetStatus() string {
+func (x *GCTScript) GetUuid() string {
 	if x != nil {
-		return x.Status
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GCTScriptQueryResponse) GetScript() *GCTScript {
+func (x *GCTScript) GetName() string {
 	if x != nil {
-		return x.Script
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GCTScriptQueryResponse) GetData() string {
+func (x *GCTScript) GetPath() string {
 	if x != nil {
-		return x.Data
+		return x.Path
 	}
 	return ""
 }
 
-type GenericResponse struct {
+func (x *GCTScript) GetNextRun() string {
+	if x != nil {
+		return x.NextRun
+	}
+	return ""
+}
+
+type GCTScriptExecuteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Debug         bool                   `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
+	Breakpoints   []string               `protobuf:"bytes,3,rep,name=breakpoints,proto3" json:"breakpoints,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenericResponse) Reset() {
-	*x = GenericResponse{}
-	mi := &file_rpc_proto_msgTypes[132]
+func (x *GCTScriptExecuteRequest) Reset() {
+	*x = GCTScriptExecuteRequest{}
+	mi := &file_rpc_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenericResponse) String() string {
+func (x *GCTScriptExecuteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenericResponse) ProtoMessage() {}
+func (*GCTScriptExecuteRequest) ProtoMessage() {}
 
-func (x *GenericResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[132]
+func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7976,49 +8205,61 @@ func (x *GenericResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
-func (*GenericResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *GenericResponse) GetStatus() string {
+func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Status
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-func (x *GenericResponse) GetData() string {
+func (x *GCTScriptExecuteRequest) GetDebug() bool {
 	if x != nil {
-		return x.Data
+		return x.Debug
 	}
-	return ""
+	return false
 }
 
-type SetExchangeAssetRequest struct {
+func (x *GCTScriptExecuteRequest) GetBreakpoints() []string {
+	if x != nil {
+		return x.Breakpoints
+	}
+	return nil
+}
+
+type GCTScriptTraceEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Enable        bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	Module        string                 `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Function      string                 `protobuf:"bytes,2,opt,name=function,proto3" json:"function,omitempty"`
+	Args          []string               `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Result        string                 `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Duration      string                 `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	Breakpoint    bool                   `protobuf:"varint,8,opt,name=breakpoint,proto3" json:"breakpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeAssetRequest) Reset() {
-	*x = SetExchangeAssetRequest{}
-	mi := &file_rpc_proto_msgTypes[133]
+func (x *GCTScriptTraceEntry) Reset() {
+	*x = GCTScriptTraceEntry{}
+	mi := &file_rpc_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeAssetRequest) String() string {
+func (x *GCTScriptTraceEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeAssetRequest) ProtoMessage() {}
+func (*GCTScriptTraceEntry) ProtoMessage() {}
 
-func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[133]
+func (x *GCTScriptTraceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8029,55 +8270,91 @@ func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use GCTScriptTraceEntry.ProtoReflect.Descriptor instead.
+func (*GCTScriptTraceEntry) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *SetExchangeAssetRequest) GetExchange() string {
+func (x *GCTScriptTraceEntry) GetModule() string {
 	if x != nil {
-		return x.Exchange
+		return x.Module
 	}
 	return ""
 }
 
-func (x *SetExchangeAssetRequest) GetAsset() string {
+func (x *GCTScriptTraceEntry) GetFunction() string {
 	if x != nil {
-		return x.Asset
+		return x.Function
 	}
 	return ""
 }
 
-func (x *SetExchangeAssetRequest) GetEnable() bool {
+func (x *GCTScriptTraceEntry) GetArgs() []string {
 	if x != nil {
-		return x.Enable
+		return x.Args
+	}
+	return nil
+}
+
+func (x *GCTScriptTraceEntry) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *GCTScriptTraceEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GCTScriptTraceEntry) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *GCTScriptTraceEntry) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *GCTScriptTraceEntry) GetBreakpoint() bool {
+	if x != nil {
+		return x.Breakpoint
 	}
 	return false
 }
 
-type SetExchangeAllPairsRequest struct {
+type GCTScriptExecuteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Trace         []*GCTScriptTraceEntry `protobuf:"bytes,3,rep,name=trace,proto3" json:"trace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeAllPairsRequest) Reset() {
-	*x = SetExchangeAllPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[134]
+func (x *GCTScriptExecuteResponse) Reset() {
+	*x = GCTScriptExecuteResponse{}
+	mi := &file_rpc_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeAllPairsRequest) String() string {
+func (x *GCTScriptExecuteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeAllPairsRequest) ProtoMessage() {}
+func (*GCTScriptExecuteResponse) ProtoMessage() {}
 
-func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[134]
+func (x *GCTScriptExecuteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8088,47 +8365,55 @@ func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use GCTScriptExecuteResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptExecuteResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *SetExchangeAllPairsRequest) GetExchange() string {
+func (x *GCTScriptExecuteResponse) GetStatus() string {
 	if x != nil {
-		return x.Exchange
+		return x.Status
 	}
 	return ""
 }
 
-func (x *SetExchangeAllPairsRequest) GetEnable() bool {
+func (x *GCTScriptExecuteResponse) GetData() string {
 	if x != nil {
-		return x.Enable
+		return x.Data
 	}
-	return false
+	return ""
 }
 
-type UpdateExchangeSupportedPairsRequest struct {
+func (x *GCTScriptExecuteResponse) GetTrace() []*GCTScriptTraceEntry {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+type GCTScriptREPLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) Reset() {
-	*x = UpdateExchangeSupportedPairsRequest{}
-	mi := &file_rpc_proto_msgTypes[135]
+func (x *GCTScriptREPLRequest) Reset() {
+	*x = GCTScriptREPLRequest{}
+	mi := &file_rpc_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) String() string {
+func (x *GCTScriptREPLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
+func (*GCTScriptREPLRequest) ProtoMessage() {}
 
-func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[135]
+func (x *GCTScriptREPLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8139,40 +8424,48 @@ func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{135}
+// Deprecated: Use GCTScriptREPLRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptREPLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
+func (x *GCTScriptREPLRequest) GetCode() string {
 	if x != nil {
-		return x.Exchange
+		return x.Code
 	}
 	return ""
 }
 
-type GetExchangeAssetsRequest struct {
+func (x *GCTScriptREPLRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type GCTScriptREPLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeAssetsRequest) Reset() {
-	*x = GetExchangeAssetsRequest{}
-	mi := &file_rpc_proto_msgTypes[136]
+func (x *GCTScriptREPLResponse) Reset() {
+	*x = GCTScriptREPLResponse{}
+	mi := &file_rpc_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeAssetsRequest) String() string {
+func (x *GCTScriptREPLResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeAssetsRequest) ProtoMessage() {}
+func (*GCTScriptREPLResponse) ProtoMessage() {}
 
-func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[136]
+func (x *GCTScriptREPLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8183,40 +8476,47 @@ func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{136}
+// Deprecated: Use GCTScriptREPLResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptREPLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *GetExchangeAssetsRequest) GetExchange() string {
+func (x *GCTScriptREPLResponse) GetOutput() string {
 	if x != nil {
-		return x.Exchange
+		return x.Output
 	}
 	return ""
 }
 
-type GetExchangeAssetsResponse struct {
+func (x *GCTScriptREPLResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GCTScriptStopRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Assets        string                 `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeAssetsResponse) Reset() {
-	*x = GetExchangeAssetsResponse{}
-	mi := &file_rpc_proto_msgTypes[137]
+func (x *GCTScriptStopRequest) Reset() {
+	*x = GCTScriptStopRequest{}
+	mi := &file_rpc_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeAssetsResponse) String() string {
+func (x *GCTScriptStopRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeAssetsResponse) ProtoMessage() {}
+func (*GCTScriptStopRequest) ProtoMessage() {}
 
-func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[137]
+func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8227,40 +8527,39 @@ func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
-func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *GetExchangeAssetsResponse) GetAssets() string {
+func (x *GCTScriptStopRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Assets
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-type WebsocketGetInfoRequest struct {
+type GCTScriptStopAllRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetInfoRequest) Reset() {
-	*x = WebsocketGetInfoRequest{}
-	mi := &file_rpc_proto_msgTypes[138]
+func (x *GCTScriptStopAllRequest) Reset() {
+	*x = GCTScriptStopAllRequest{}
+	mi := &file_rpc_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetInfoRequest) String() string {
+func (x *GCTScriptStopAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetInfoRequest) ProtoMessage() {}
+func (*GCTScriptStopAllRequest) ProtoMessage() {}
 
-func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[138]
+func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8271,46 +8570,32 @@ func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *WebsocketGetInfoRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+type GCTScriptStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type WebsocketGetInfoResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Supported              bool                   `protobuf:"varint,2,opt,name=supported,proto3" json:"supported,omitempty"`
-	Enabled                bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	AuthenticatedSupported bool                   `protobuf:"varint,4,opt,name=authenticated_supported,json=authenticatedSupported,proto3" json:"authenticated_supported,omitempty"`
-	Authenticated          bool                   `protobuf:"varint,5,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
-	RunningUrl             string                 `protobuf:"bytes,6,opt,name=running_url,json=runningUrl,proto3" json:"running_url,omitempty"`
-	ProxyAddress           string                 `protobuf:"bytes,7,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *WebsocketGetInfoResponse) Reset() {
-	*x = WebsocketGetInfoResponse{}
-	mi := &file_rpc_proto_msgTypes[139]
+func (x *GCTScriptStatusRequest) Reset() {
+	*x = GCTScriptStatusRequest{}
+	mi := &file_rpc_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetInfoResponse) String() string {
+func (x *GCTScriptStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetInfoResponse) ProtoMessage() {}
+func (*GCTScriptStatusRequest) ProtoMessage() {}
 
-func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[139]
+func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8321,83 +8606,73 @@ func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
-func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{139}
-}
-
-func (x *WebsocketGetInfoResponse) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *WebsocketGetInfoResponse) GetSupported() bool {
-	if x != nil {
-		return x.Supported
-	}
-	return false
+type GCTScriptListAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetInfoResponse) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
+func (x *GCTScriptListAllRequest) Reset() {
+	*x = GCTScriptListAllRequest{}
+	mi := &file_rpc_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetInfoResponse) GetAuthenticatedSupported() bool {
-	if x != nil {
-		return x.AuthenticatedSupported
-	}
-	return false
+func (x *GCTScriptListAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *WebsocketGetInfoResponse) GetAuthenticated() bool {
-	if x != nil {
-		return x.Authenticated
-	}
-	return false
-}
+func (*GCTScriptListAllRequest) ProtoMessage() {}
 
-func (x *WebsocketGetInfoResponse) GetRunningUrl() string {
+func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[137]
 	if x != nil {
-		return x.RunningUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *WebsocketGetInfoResponse) GetProxyAddress() string {
-	if x != nil {
-		return x.ProxyAddress
-	}
-	return ""
+// Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{137}
 }
 
-type WebsocketSetEnabledRequest struct {
+type GCTScriptUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	ScriptName    string                 `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ScriptData    string                 `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetEnabledRequest) Reset() {
-	*x = WebsocketSetEnabledRequest{}
-	mi := &file_rpc_proto_msgTypes[140]
+func (x *GCTScriptUploadRequest) Reset() {
+	*x = GCTScriptUploadRequest{}
+	mi := &file_rpc_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetEnabledRequest) String() string {
+func (x *GCTScriptUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetEnabledRequest) ProtoMessage() {}
+func (*GCTScriptUploadRequest) ProtoMessage() {}
 
-func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[140]
+func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8408,47 +8683,68 @@ func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *WebsocketSetEnabledRequest) GetExchange() string {
+func (x *GCTScriptUploadRequest) GetScriptName() string {
 	if x != nil {
-		return x.Exchange
+		return x.ScriptName
 	}
 	return ""
 }
 
-func (x *WebsocketSetEnabledRequest) GetEnable() bool {
+func (x *GCTScriptUploadRequest) GetScriptData() string {
 	if x != nil {
-		return x.Enable
+		return x.ScriptData
+	}
+	return ""
+}
+
+func (x *GCTScriptUploadRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *GCTScriptUploadRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
 	}
 	return false
 }
 
-type WebsocketGetSubscriptionsRequest struct {
+func (x *GCTScriptUploadRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type GCTScriptReadScriptRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetSubscriptionsRequest) Reset() {
-	*x = WebsocketGetSubscriptionsRequest{}
-	mi := &file_rpc_proto_msgTypes[141]
+func (x *GCTScriptReadScriptRequest) Reset() {
+	*x = GCTScriptReadScriptRequest{}
+	mi := &file_rpc_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetSubscriptionsRequest) String() string {
+func (x *GCTScriptReadScriptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
+func (*GCTScriptReadScriptRequest) ProtoMessage() {}
 
-func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[141]
+func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8459,43 +8755,40 @@ func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
+func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Exchange
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-type WebsocketSubscription struct {
+type GCTScriptQueryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Pairs         string                 `protobuf:"bytes,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Params        string                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSubscription) Reset() {
-	*x = WebsocketSubscription{}
-	mi := &file_rpc_proto_msgTypes[142]
+func (x *GCTScriptQueryRequest) Reset() {
+	*x = GCTScriptQueryRequest{}
+	mi := &file_rpc_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSubscription) String() string {
+func (x *GCTScriptQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSubscription) ProtoMessage() {}
+func (*GCTScriptQueryRequest) ProtoMessage() {}
 
-func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[142]
+func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8506,62 +8799,41 @@ func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
-func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{142}
-}
-
-func (x *WebsocketSubscription) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *WebsocketSubscription) GetPairs() string {
-	if x != nil {
-		return x.Pairs
-	}
-	return ""
-}
-
-func (x *WebsocketSubscription) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+// Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *WebsocketSubscription) GetParams() string {
+func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Params
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-type WebsocketGetSubscriptionsResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Exchange      string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Subscriptions []*WebsocketSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+type GCTScriptAutoLoadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetSubscriptionsResponse) Reset() {
-	*x = WebsocketGetSubscriptionsResponse{}
-	mi := &file_rpc_proto_msgTypes[143]
+func (x *GCTScriptAutoLoadRequest) Reset() {
+	*x = GCTScriptAutoLoadRequest{}
+	mi := &file_rpc_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetSubscriptionsResponse) String() string {
+func (x *GCTScriptAutoLoadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
+func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
 
-func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[143]
+func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8572,48 +8844,48 @@ func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
-func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
+func (x *GCTScriptAutoLoadRequest) GetScript() string {
 	if x != nil {
-		return x.Exchange
+		return x.Script
 	}
 	return ""
 }
 
-func (x *WebsocketGetSubscriptionsResponse) GetSubscriptions() []*WebsocketSubscription {
+func (x *GCTScriptAutoLoadRequest) GetStatus() bool {
 	if x != nil {
-		return x.Subscriptions
+		return x.Status
 	}
-	return nil
+	return false
 }
 
-type WebsocketSetProxyRequest struct {
+type GCTScriptStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Proxy         string                 `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Scripts       []*GCTScript           `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetProxyRequest) Reset() {
-	*x = WebsocketSetProxyRequest{}
-	mi := &file_rpc_proto_msgTypes[144]
+func (x *GCTScriptStatusResponse) Reset() {
+	*x = GCTScriptStatusResponse{}
+	mi := &file_rpc_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetProxyRequest) String() string {
+func (x *GCTScriptStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetProxyRequest) ProtoMessage() {}
+func (*GCTScriptStatusResponse) ProtoMessage() {}
 
-func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[144]
+func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8624,48 +8896,49 @@ func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{144}
+// Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *WebsocketSetProxyRequest) GetExchange() string {
+func (x *GCTScriptStatusResponse) GetStatus() string {
 	if x != nil {
-		return x.Exchange
+		return x.Status
 	}
 	return ""
 }
 
-func (x *WebsocketSetProxyRequest) GetProxy() string {
+func (x *GCTScriptStatusResponse) GetScripts() []*GCTScript {
 	if x != nil {
-		return x.Proxy
+		return x.Scripts
 	}
-	return ""
+	return nil
 }
 
-type WebsocketSetURLRequest struct {
+type GCTScriptQueryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetURLRequest) Reset() {
-	*x = WebsocketSetURLRequest{}
-	mi := &file_rpc_proto_msgTypes[145]
+func (x *GCTScriptQueryResponse) Reset() {
+	*x = GCTScriptQueryResponse{}
+	mi := &file_rpc_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetURLRequest) String() string {
+func (x *GCTScriptQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetURLRequest) ProtoMessage() {}
+func (*GCTScriptQueryResponse) ProtoMessage() {}
 
-func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[145]
+func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8676,52 +8949,55 @@ func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *WebsocketSetURLRequest) GetExchange() string {
+func (x *GCTScriptQueryResponse) GetStatus() string {
 	if x != nil {
-		return x.Exchange
+		return x.Status
 	}
 	return ""
 }
 
-func (x *WebsocketSetURLRequest) GetUrl() string {
+func (x *GCTScriptQueryResponse) GetScript() *GCTScript {
 	if x != nil {
-		return x.Url
+		return x.Script
+	}
+	return nil
+}
+
+func (x *GCTScriptQueryResponse) GetData() string {
+	if x != nil {
+		return x.Data
 	}
 	return ""
 }
 
-type FindMissingCandlePeriodsRequest struct {
+type GenericResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Interval      int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
-	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingCandlePeriodsRequest) Reset() {
-	*x = FindMissingCandlePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[146]
+func (x *GenericResponse) Reset() {
+	*x = GenericResponse{}
+	mi := &file_rpc_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingCandlePeriodsRequest) String() string {
+func (x *GenericResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
+func (*GenericResponse) ProtoMessage() {}
 
-func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[146]
+func (x *GenericResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8732,79 +9008,49 @@ func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
-func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{146}
-}
-
-func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
-	}
-	return ""
-}
-
-func (x *FindMissingCandlePeriodsRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
-}
-
-func (x *FindMissingCandlePeriodsRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *FindMissingCandlePeriodsRequest) GetInterval() int64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
+// Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
+func (*GenericResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetStart() string {
+func (x *GenericResponse) GetStatus() string {
 	if x != nil {
-		return x.Start
+		return x.Status
 	}
 	return ""
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetEnd() string {
+func (x *GenericResponse) GetData() string {
 	if x != nil {
-		return x.End
+		return x.Data
 	}
 	return ""
 }
 
-type FindMissingTradePeriodsRequest struct {
+type SetExchangeAssetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Enable        bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingTradePeriodsRequest) Reset() {
-	*x = FindMissingTradePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[147]
+func (x *SetExchangeAssetRequest) Reset() {
+	*x = SetExchangeAssetRequest{}
+	mi := &file_rpc_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingTradePeriodsRequest) String() string {
+func (x *SetExchangeAssetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
+func (*SetExchangeAssetRequest) ProtoMessage() {}
 
-func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[147]
+func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8815,72 +9061,55 @@ func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
-func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{147}
-}
-
-func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
-	}
-	return ""
+// Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *FindMissingTradePeriodsRequest) GetAssetType() string {
+func (x *SetExchangeAssetRequest) GetExchange() string {
 	if x != nil {
-		return x.AssetType
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FindMissingTradePeriodsRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *FindMissingTradePeriodsRequest) GetStart() string {
+func (x *SetExchangeAssetRequest) GetAsset() string {
 	if x != nil {
-		return x.Start
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FindMissingTradePeriodsRequest) GetEnd() string {
+func (x *SetExchangeAssetRequest) GetEnable() bool {
 	if x != nil {
-		return x.End
+		return x.Enable
 	}
-	return ""
+	return false
 }
 
-type FindMissingIntervalsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName   string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MissingPeriods []string               `protobuf:"bytes,4,rep,name=missing_periods,json=missingPeriods,proto3" json:"missing_periods,omitempty"`
-	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type SetExchangeAllPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingIntervalsResponse) Reset() {
-	*x = FindMissingIntervalsResponse{}
-	mi := &file_rpc_proto_msgTypes[148]
+func (x *SetExchangeAllPairsRequest) Reset() {
+	*x = SetExchangeAllPairsRequest{}
+	mi := &file_rpc_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingIntervalsResponse) String() string {
+func (x *SetExchangeAllPairsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingIntervalsResponse) ProtoMessage() {}
+func (*SetExchangeAllPairsRequest) ProtoMessage() {}
 
-func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[148]
+func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8891,69 +9120,91 @@ func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
-func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{148}
+// Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *FindMissingIntervalsResponse) GetExchangeName() string {
+func (x *SetExchangeAllPairsRequest) GetExchange() string {
 	if x != nil {
-		return x.ExchangeName
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FindMissingIntervalsResponse) GetAssetType() string {
+func (x *SetExchangeAllPairsRequest) GetEnable() bool {
 	if x != nil {
-		return x.AssetType
+		return x.Enable
 	}
-	return ""
+	return false
 }
 
-func (x *FindMissingIntervalsResponse) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+type UpdateExchangeSupportedPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingIntervalsResponse) GetMissingPeriods() []string {
+func (x *UpdateExchangeSupportedPairsRequest) Reset() {
+	*x = UpdateExchangeSupportedPairsRequest{}
+	mi := &file_rpc_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateExchangeSupportedPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
+
+func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[147]
 	if x != nil {
-		return x.MissingPeriods
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FindMissingIntervalsResponse) GetStatus() string {
+// Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
 	if x != nil {
-		return x.Status
+		return x.Exchange
 	}
 	return ""
 }
 
-type SetExchangeTradeProcessingRequest struct {
+type GetExchangeAssetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeTradeProcessingRequest) Reset() {
-	*x = SetExchangeTradeProcessingRequest{}
-	mi := &file_rpc_proto_msgTypes[149]
+func (x *GetExchangeAssetsRequest) Reset() {
+	*x = GetExchangeAssetsRequest{}
+	mi := &file_rpc_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeTradeProcessingRequest) String() string {
+func (x *GetExchangeAssetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
+func (*GetExchangeAssetsRequest) ProtoMessage() {}
 
-func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[149]
+func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8964,64 +9215,83 @@ func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{149}
+// Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
+func (x *GetExchangeAssetsRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetExchangeTradeProcessingRequest) GetStatus() bool {
+type GetExchangeAssetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assets        string                 `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExchangeAssetsResponse) Reset() {
+	*x = GetExchangeAssetsResponse{}
+	mi := &file_rpc_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangeAssetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangeAssetsResponse) ProtoMessage() {}
+
+func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[149]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type UpsertDataHistoryJobRequest struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	Nickname                 string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Exchange                 string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                    string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                     *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate                string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                  string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Interval                 int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
-	RequestSizeLimit         uint64                 `protobuf:"varint,8,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
-	DataType                 int64                  `protobuf:"varint,9,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	MaxRetryAttempts         uint64                 `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
-	BatchSize                uint64                 `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	InsertOnly               bool                   `protobuf:"varint,12,opt,name=insert_only,json=insertOnly,proto3" json:"insert_only,omitempty"`
-	ConversionInterval       int64                  `protobuf:"varint,13,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
-	OverwriteExistingData    bool                   `protobuf:"varint,14,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
-	PrerequisiteJobNickname  string                 `protobuf:"bytes,15,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	DecimalPlaceComparison   uint64                 `protobuf:"varint,16,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
-	SecondaryExchangeName    string                 `protobuf:"bytes,17,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
-	IssueTolerancePercentage float64                `protobuf:"fixed64,18,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
-	ReplaceOnIssue           bool                   `protobuf:"varint,19,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+// Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
+func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *UpsertDataHistoryJobRequest) Reset() {
-	*x = UpsertDataHistoryJobRequest{}
+func (x *GetExchangeAssetsResponse) GetAssets() string {
+	if x != nil {
+		return x.Assets
+	}
+	return ""
+}
+
+type WebsocketGetInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsocketGetInfoRequest) Reset() {
+	*x = WebsocketGetInfoRequest{}
 	mi := &file_rpc_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) String() string {
+func (x *WebsocketGetInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
+func (*WebsocketGetInfoRequest) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
+func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9033,166 +9303,184 @@ func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
-func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *UpsertDataHistoryJobRequest) GetNickname() string {
+func (x *WebsocketGetInfoRequest) GetExchange() string {
 	if x != nil {
-		return x.Nickname
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+type WebsocketGetInfoResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Supported              bool                   `protobuf:"varint,2,opt,name=supported,proto3" json:"supported,omitempty"`
+	Enabled                bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AuthenticatedSupported bool                   `protobuf:"varint,4,opt,name=authenticated_supported,json=authenticatedSupported,proto3" json:"authenticated_supported,omitempty"`
+	Authenticated          bool                   `protobuf:"varint,5,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	RunningUrl             string                 `protobuf:"bytes,6,opt,name=running_url,json=runningUrl,proto3" json:"running_url,omitempty"`
+	ProxyAddress           string                 `protobuf:"bytes,7,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+func (x *WebsocketGetInfoResponse) Reset() {
+	*x = WebsocketGetInfoResponse{}
+	mi := &file_rpc_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *WebsocketGetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetStartDate() string {
+func (*WebsocketGetInfoResponse) ProtoMessage() {}
+
+func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[151]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetEndDate() string {
+// Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
+func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *WebsocketGetInfoResponse) GetExchange() string {
 	if x != nil {
-		return x.EndDate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetInterval() int64 {
+func (x *WebsocketGetInfoResponse) GetSupported() bool {
 	if x != nil {
-		return x.Interval
+		return x.Supported
 	}
-	return 0
+	return false
 }
 
-func (x *UpsertDataHistoryJobRequest) GetRequestSizeLimit() uint64 {
+func (x *WebsocketGetInfoResponse) GetEnabled() bool {
 	if x != nil {
-		return x.RequestSizeLimit
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-func (x *UpsertDataHistoryJobRequest) GetDataType() int64 {
+func (x *WebsocketGetInfoResponse) GetAuthenticatedSupported() bool {
 	if x != nil {
-		return x.DataType
+		return x.AuthenticatedSupported
 	}
-	return 0
+	return false
 }
 
-func (x *UpsertDataHistoryJobRequest) GetMaxRetryAttempts() uint64 {
+func (x *WebsocketGetInfoResponse) GetAuthenticated() bool {
 	if x != nil {
-		return x.MaxRetryAttempts
+		return x.Authenticated
 	}
-	return 0
+	return false
 }
 
-func (x *UpsertDataHistoryJobRequest) GetBatchSize() uint64 {
+func (x *WebsocketGetInfoResponse) GetRunningUrl() string {
 	if x != nil {
-		return x.BatchSize
+		return x.RunningUrl
 	}
-	return 0
+	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetInsertOnly() bool {
+func (x *WebsocketGetInfoResponse) GetProxyAddress() string {
 	if x != nil {
-		return x.InsertOnly
+		return x.ProxyAddress
 	}
-	return false
+	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetConversionInterval() int64 {
-	if x != nil {
-		return x.ConversionInterval
-	}
-	return 0
+type WebsocketSetEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) GetOverwriteExistingData() bool {
-	if x != nil {
-		return x.OverwriteExistingData
-	}
-	return false
+func (x *WebsocketSetEnabledRequest) Reset() {
+	*x = WebsocketSetEnabledRequest{}
+	mi := &file_rpc_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetPrerequisiteJobNickname() string {
-	if x != nil {
-		return x.PrerequisiteJobNickname
-	}
-	return ""
+func (x *WebsocketSetEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetDecimalPlaceComparison() uint64 {
+func (*WebsocketSetEnabledRequest) ProtoMessage() {}
+
+func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[152]
 	if x != nil {
-		return x.DecimalPlaceComparison
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetSecondaryExchangeName() string {
-	if x != nil {
-		return x.SecondaryExchangeName
-	}
-	return ""
+// Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *UpsertDataHistoryJobRequest) GetIssueTolerancePercentage() float64 {
+func (x *WebsocketSetEnabledRequest) GetExchange() string {
 	if x != nil {
-		return x.IssueTolerancePercentage
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetReplaceOnIssue() bool {
+func (x *WebsocketSetEnabledRequest) GetEnable() bool {
 	if x != nil {
-		return x.ReplaceOnIssue
+		return x.Enable
 	}
 	return false
 }
 
-type InsertSequentialJobsRequest struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Jobs          []*UpsertDataHistoryJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+type WebsocketGetSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertSequentialJobsRequest) Reset() {
-	*x = InsertSequentialJobsRequest{}
-	mi := &file_rpc_proto_msgTypes[151]
+func (x *WebsocketGetSubscriptionsRequest) Reset() {
+	*x = WebsocketGetSubscriptionsRequest{}
+	mi := &file_rpc_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSequentialJobsRequest) String() string {
+func (x *WebsocketGetSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSequentialJobsRequest) ProtoMessage() {}
+func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
 
-func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[151]
+func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9203,40 +9491,43 @@ func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
-func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{151}
+// Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
+func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
 	if x != nil {
-		return x.Jobs
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type InsertSequentialJobsResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Jobs          []*UpsertDataHistoryJobResponse `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+type WebsocketSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Pairs         string                 `protobuf:"bytes,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Params        string                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertSequentialJobsResponse) Reset() {
-	*x = InsertSequentialJobsResponse{}
-	mi := &file_rpc_proto_msgTypes[152]
+func (x *WebsocketSubscription) Reset() {
+	*x = WebsocketSubscription{}
+	mi := &file_rpc_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSequentialJobsResponse) String() string {
+func (x *WebsocketSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSequentialJobsResponse) ProtoMessage() {}
+func (*WebsocketSubscription) ProtoMessage() {}
 
-func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[152]
+func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9247,41 +9538,62 @@ func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
-func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{152}
+// Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
+func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
+func (x *WebsocketSubscription) GetChannel() string {
 	if x != nil {
-		return x.Jobs
+		return x.Channel
 	}
-	return nil
+	return ""
 }
 
-type UpsertDataHistoryJobResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+func (x *WebsocketSubscription) GetPairs() string {
+	if x != nil {
+		return x.Pairs
+	}
+	return ""
+}
+
+func (x *WebsocketSubscription) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *WebsocketSubscription) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+type WebsocketGetSubscriptionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Exchange      string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Subscriptions []*WebsocketSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobResponse) Reset() {
-	*x = UpsertDataHistoryJobResponse{}
-	mi := &file_rpc_proto_msgTypes[153]
+func (x *WebsocketGetSubscriptionsResponse) Reset() {
+	*x = WebsocketGetSubscriptionsResponse{}
+	mi := &file_rpc_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobResponse) String() string {
+func (x *WebsocketGetSubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
+func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[153]
+func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9292,49 +9604,48 @@ func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
-func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{153}
+// Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *UpsertDataHistoryJobResponse) GetMessage() string {
+func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
 	if x != nil {
-		return x.Message
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobResponse) GetJobId() string {
+func (x *WebsocketGetSubscriptionsResponse) GetSubscriptions() []*WebsocketSubscription {
 	if x != nil {
-		return x.JobId
+		return x.Subscriptions
 	}
-	return ""
+	return nil
 }
 
-type GetDataHistoryJobDetailsRequest struct {
+type WebsocketSetProxyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	FullDetails   bool                   `protobuf:"varint,3,opt,name=full_details,json=fullDetails,proto3" json:"full_details,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Proxy         string                 `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataHistoryJobDetailsRequest) Reset() {
-	*x = GetDataHistoryJobDetailsRequest{}
-	mi := &file_rpc_proto_msgTypes[154]
+func (x *WebsocketSetProxyRequest) Reset() {
+	*x = WebsocketSetProxyRequest{}
+	mi := &file_rpc_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataHistoryJobDetailsRequest) String() string {
+func (x *WebsocketSetProxyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
+func (*WebsocketSetProxyRequest) ProtoMessage() {}
 
-func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[154]
+func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9345,75 +9656,104 @@ func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{154}
+// Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetId() string {
+func (x *WebsocketSetProxyRequest) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetNickname() string {
+func (x *WebsocketSetProxyRequest) GetProxy() string {
 	if x != nil {
-		return x.Nickname
+		return x.Proxy
 	}
 	return ""
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetFullDetails() bool {
+type WebsocketSetURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebsocketSetURLRequest) Reset() {
+	*x = WebsocketSetURLRequest{}
+	mi := &file_rpc_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebsocketSetURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsocketSetURLRequest) ProtoMessage() {}
+
+func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[157]
 	if x != nil {
-		return x.FullDetails
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type DataHistoryJob struct {
-	state                    protoimpl.MessageState  `protogen:"open.v1"`
-	Id                       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname                 string                  `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Exchange                 string                  `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                    string                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                     *CurrencyPair           `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate                string                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                  string                  `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Interval                 int64                   `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
-	RequestSizeLimit         uint64                  `protobuf:"varint,9,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
-	MaxRetryAttempts         uint64                  `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
-	BatchSize                uint64                  `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	Status                   string                  `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	DataType                 string                  `protobuf:"bytes,13,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	ConversionInterval       int64                   `protobuf:"varint,14,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
-	OverwriteExistingData    bool                    `protobuf:"varint,15,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
-	PrerequisiteJobNickname  string                  `protobuf:"bytes,16,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	DecimalPlaceComparison   uint64                  `protobuf:"varint,17,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
-	SecondaryExchangeName    string                  `protobuf:"bytes,18,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
-	IssueTolerancePercentage float64                 `protobuf:"fixed64,19,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
-	ReplaceOnIssue           bool                    `protobuf:"varint,20,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
-	JobResults               []*DataHistoryJobResult `protobuf:"bytes,21,rep,name=job_results,json=jobResults,proto3" json:"job_results,omitempty"`
-	ResultSummaries          []string                `protobuf:"bytes,22,rep,name=result_summaries,json=resultSummaries,proto3" json:"result_summaries,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+// Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *DataHistoryJob) Reset() {
-	*x = DataHistoryJob{}
-	mi := &file_rpc_proto_msgTypes[155]
+func (x *WebsocketSetURLRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *WebsocketSetURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type FindMissingCandlePeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Interval      int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindMissingCandlePeriodsRequest) Reset() {
+	*x = FindMissingCandlePeriodsRequest{}
+	mi := &file_rpc_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) String() string {
+func (x *FindMissingCandlePeriodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJob) ProtoMessage() {}
+func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
 
-func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[155]
+func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9424,191 +9764,228 @@ func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
-func (*DataHistoryJob) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{155}
+// Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *DataHistoryJob) GetId() string {
+func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
 	if x != nil {
-		return x.Id
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetNickname() string {
+func (x *FindMissingCandlePeriodsRequest) GetAssetType() string {
 	if x != nil {
-		return x.Nickname
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetExchange() string {
+func (x *FindMissingCandlePeriodsRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Exchange
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *DataHistoryJob) GetAsset() string {
+func (x *FindMissingCandlePeriodsRequest) GetInterval() int64 {
 	if x != nil {
-		return x.Asset
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *DataHistoryJob) GetPair() *CurrencyPair {
+func (x *FindMissingCandlePeriodsRequest) GetStart() string {
 	if x != nil {
-		return x.Pair
+		return x.Start
 	}
-	return nil
+	return ""
 }
 
-func (x *DataHistoryJob) GetStartDate() string {
+func (x *FindMissingCandlePeriodsRequest) GetEnd() string {
 	if x != nil {
-		return x.StartDate
+		return x.End
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
+type FindMissingTradePeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJob) GetInterval() int64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
+func (x *FindMissingTradePeriodsRequest) Reset() {
+	*x = FindMissingTradePeriodsRequest{}
+	mi := &file_rpc_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) GetRequestSizeLimit() uint64 {
-	if x != nil {
-		return x.RequestSizeLimit
-	}
-	return 0
+func (x *FindMissingTradePeriodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DataHistoryJob) GetMaxRetryAttempts() uint64 {
+func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
+
+func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[159]
 	if x != nil {
-		return x.MaxRetryAttempts
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DataHistoryJob) GetBatchSize() uint64 {
-	if x != nil {
-		return x.BatchSize
-	}
-	return 0
+// Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *DataHistoryJob) GetStatus() string {
+func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
 	if x != nil {
-		return x.Status
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetDataType() string {
+func (x *FindMissingTradePeriodsRequest) GetAssetType() string {
 	if x != nil {
-		return x.DataType
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetConversionInterval() int64 {
+func (x *FindMissingTradePeriodsRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.ConversionInterval
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-func (x *DataHistoryJob) GetOverwriteExistingData() bool {
+func (x *FindMissingTradePeriodsRequest) GetStart() string {
 	if x != nil {
-		return x.OverwriteExistingData
+		return x.Start
 	}
-	return false
+	return ""
 }
 
-func (x *DataHistoryJob) GetPrerequisiteJobNickname() string {
+func (x *FindMissingTradePeriodsRequest) GetEnd() string {
 	if x != nil {
-		return x.PrerequisiteJobNickname
+		return x.End
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetDecimalPlaceComparison() uint64 {
+type FindMissingIntervalsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeName   string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MissingPeriods []string               `protobuf:"bytes,4,rep,name=missing_periods,json=missingPeriods,proto3" json:"missing_periods,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FindMissingIntervalsResponse) Reset() {
+	*x = FindMissingIntervalsResponse{}
+	mi := &file_rpc_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindMissingIntervalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMissingIntervalsResponse) ProtoMessage() {}
+
+func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[160]
 	if x != nil {
-		return x.DecimalPlaceComparison
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DataHistoryJob) GetSecondaryExchangeName() string {
+// Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
+func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *FindMissingIntervalsResponse) GetExchangeName() string {
 	if x != nil {
-		return x.SecondaryExchangeName
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetIssueTolerancePercentage() float64 {
+func (x *FindMissingIntervalsResponse) GetAssetType() string {
 	if x != nil {
-		return x.IssueTolerancePercentage
+		return x.AssetType
 	}
-	return 0
+	return ""
 }
 
-func (x *DataHistoryJob) GetReplaceOnIssue() bool {
+func (x *FindMissingIntervalsResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.ReplaceOnIssue
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-func (x *DataHistoryJob) GetJobResults() []*DataHistoryJobResult {
+func (x *FindMissingIntervalsResponse) GetMissingPeriods() []string {
 	if x != nil {
-		return x.JobResults
+		return x.MissingPeriods
 	}
 	return nil
 }
 
-func (x *DataHistoryJob) GetResultSummaries() []string {
+func (x *FindMissingIntervalsResponse) GetStatus() string {
 	if x != nil {
-		return x.ResultSummaries
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type DataHistoryJobResult struct {
+type SetExchangeTradeProcessingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	HasData       bool                   `protobuf:"varint,3,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	RunDate       string                 `protobuf:"bytes,5,opt,name=run_date,json=runDate,proto3" json:"run_date,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJobResult) Reset() {
-	*x = DataHistoryJobResult{}
-	mi := &file_rpc_proto_msgTypes[156]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SetExchangeTradeProcessingRequest) Reset() {
+	*x = SetExchangeTradeProcessingRequest{}
+	mi := &file_rpc_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJobResult) String() string {
+func (x *SetExchangeTradeProcessingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJobResult) ProtoMessage() {}
+func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
 
-func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[156]
+func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9619,68 +9996,2701 @@ func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
-func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{156}
+// Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *DataHistoryJobResult) GetStartDate() string {
+func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetExchangeTradeProcessingRequest) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+type UpsertDataHistoryJobRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Nickname                 string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Exchange                 string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                    string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                     *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate                string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                  string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval                 int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	RequestSizeLimit         uint64                 `protobuf:"varint,8,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
+	DataType                 int64                  `protobuf:"varint,9,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	MaxRetryAttempts         uint64                 `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	BatchSize                uint64                 `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	InsertOnly               bool                   `protobuf:"varint,12,opt,name=insert_only,json=insertOnly,proto3" json:"insert_only,omitempty"`
+	ConversionInterval       int64                  `protobuf:"varint,13,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
+	OverwriteExistingData    bool                   `protobuf:"varint,14,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
+	PrerequisiteJobNickname  string                 `protobuf:"bytes,15,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	DecimalPlaceComparison   uint64                 `protobuf:"varint,16,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
+	SecondaryExchangeName    string                 `protobuf:"bytes,17,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
+	IssueTolerancePercentage float64                `protobuf:"fixed64,18,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
+	ReplaceOnIssue           bool                   `protobuf:"varint,19,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *UpsertDataHistoryJobRequest) Reset() {
+	*x = UpsertDataHistoryJobRequest{}
+	mi := &file_rpc_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertDataHistoryJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
+
+func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
+func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *UpsertDataHistoryJobRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UpsertDataHistoryJobRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *UpsertDataHistoryJobRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *UpsertDataHistoryJobRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *UpsertDataHistoryJobRequest) GetStartDate() string {
 	if x != nil {
 		return x.StartDate
 	}
 	return ""
 }
 
-func (x *DataHistoryJobResult) GetEndDate() string {
+func (x *UpsertDataHistoryJobRequest) GetEndDate() string {
 	if x != nil {
 		return x.EndDate
 	}
 	return ""
 }
 
-func (x *DataHistoryJobResult) GetHasData() bool {
+func (x *UpsertDataHistoryJobRequest) GetInterval() int64 {
 	if x != nil {
-		return x.HasData
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetRequestSizeLimit() uint64 {
+	if x != nil {
+		return x.RequestSizeLimit
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetDataType() int64 {
+	if x != nil {
+		return x.DataType
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetMaxRetryAttempts() uint64 {
+	if x != nil {
+		return x.MaxRetryAttempts
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetBatchSize() uint64 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetInsertOnly() bool {
+	if x != nil {
+		return x.InsertOnly
 	}
 	return false
 }
 
-func (x *DataHistoryJobResult) GetMessage() string {
+func (x *UpsertDataHistoryJobRequest) GetConversionInterval() int64 {
 	if x != nil {
-		return x.Message
+		return x.ConversionInterval
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetOverwriteExistingData() bool {
+	if x != nil {
+		return x.OverwriteExistingData
+	}
+	return false
+}
+
+func (x *UpsertDataHistoryJobRequest) GetPrerequisiteJobNickname() string {
+	if x != nil {
+		return x.PrerequisiteJobNickname
 	}
 	return ""
 }
 
-func (x *DataHistoryJobResult) GetRunDate() string {
+func (x *UpsertDataHistoryJobRequest) GetDecimalPlaceComparison() uint64 {
 	if x != nil {
-		return x.RunDate
+		return x.DecimalPlaceComparison
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetSecondaryExchangeName() string {
+	if x != nil {
+		return x.SecondaryExchangeName
 	}
 	return ""
 }
 
-type DataHistoryJobs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*DataHistoryJob      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+func (x *UpsertDataHistoryJobRequest) GetIssueTolerancePercentage() float64 {
+	if x != nil {
+		return x.IssueTolerancePercentage
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetReplaceOnIssue() bool {
+	if x != nil {
+		return x.ReplaceOnIssue
+	}
+	return false
+}
+
+type InsertSequentialJobsRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Jobs          []*UpsertDataHistoryJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJobs) Reset() {
-	*x = DataHistoryJobs{}
-	mi := &file_rpc_proto_msgTypes[157]
+func (x *InsertSequentialJobsRequest) Reset() {
+	*x = InsertSequentialJobsRequest{}
+	mi := &file_rpc_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJobs) String() string {
+func (x *InsertSequentialJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJobs) ProtoMessage() {}
+func (*InsertSequentialJobsRequest) ProtoMessage() {}
+
+func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
+func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type InsertSequentialJobsResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Jobs          []*UpsertDataHistoryJobResponse `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertSequentialJobsResponse) Reset() {
+	*x = InsertSequentialJobsResponse{}
+	mi := &file_rpc_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertSequentialJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertSequentialJobsResponse) ProtoMessage() {}
+
+func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
+func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type UpsertDataHistoryJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertDataHistoryJobResponse) Reset() {
+	*x = UpsertDataHistoryJobResponse{}
+	mi := &file_rpc_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertDataHistoryJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
+
+func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
+func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *UpsertDataHistoryJobResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpsertDataHistoryJobResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetDataHistoryJobDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	FullDetails   bool                   `protobuf:"varint,3,opt,name=full_details,json=fullDetails,proto3" json:"full_details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataHistoryJobDetailsRequest) Reset() {
+	*x = GetDataHistoryJobDetailsRequest{}
+	mi := &file_rpc_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataHistoryJobDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
+
+func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *GetDataHistoryJobDetailsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDataHistoryJobDetailsRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GetDataHistoryJobDetailsRequest) GetFullDetails() bool {
+	if x != nil {
+		return x.FullDetails
+	}
+	return false
+}
+
+type DataHistoryJob struct {
+	state                    protoimpl.MessageState  `protogen:"open.v1"`
+	Id                       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname                 string                  `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Exchange                 string                  `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                    string                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                     *CurrencyPair           `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate                string                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                  string                  `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval                 int64                   `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	RequestSizeLimit         uint64                  `protobuf:"varint,9,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
+	MaxRetryAttempts         uint64                  `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	BatchSize                uint64                  `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Status                   string                  `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	DataType                 string                  `protobuf:"bytes,13,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	ConversionInterval       int64                   `protobuf:"varint,14,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
+	OverwriteExistingData    bool                    `protobuf:"varint,15,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
+	PrerequisiteJobNickname  string                  `protobuf:"bytes,16,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	DecimalPlaceComparison   uint64                  `protobuf:"varint,17,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
+	SecondaryExchangeName    string                  `protobuf:"bytes,18,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
+	IssueTolerancePercentage float64                 `protobuf:"fixed64,19,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
+	ReplaceOnIssue           bool                    `protobuf:"varint,20,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
+	JobResults               []*DataHistoryJobResult `protobuf:"bytes,21,rep,name=job_results,json=jobResults,proto3" json:"job_results,omitempty"`
+	ResultSummaries          []string                `protobuf:"bytes,22,rep,name=result_summaries,json=resultSummaries,proto3" json:"result_summaries,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *DataHistoryJob) Reset() {
+	*x = DataHistoryJob{}
+	mi := &file_rpc_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataHistoryJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataHistoryJob) ProtoMessage() {}
+
+func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
+func (*DataHistoryJob) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *DataHistoryJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *DataHistoryJob) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetRequestSizeLimit() uint64 {
+	if x != nil {
+		return x.RequestSizeLimit
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetMaxRetryAttempts() uint64 {
+	if x != nil {
+		return x.MaxRetryAttempts
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetBatchSize() uint64 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetConversionInterval() int64 {
+	if x != nil {
+		return x.ConversionInterval
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetOverwriteExistingData() bool {
+	if x != nil {
+		return x.OverwriteExistingData
+	}
+	return false
+}
+
+func (x *DataHistoryJob) GetPrerequisiteJobNickname() string {
+	if x != nil {
+		return x.PrerequisiteJobNickname
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetDecimalPlaceComparison() uint64 {
+	if x != nil {
+		return x.DecimalPlaceComparison
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetSecondaryExchangeName() string {
+	if x != nil {
+		return x.SecondaryExchangeName
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetIssueTolerancePercentage() float64 {
+	if x != nil {
+		return x.IssueTolerancePercentage
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetReplaceOnIssue() bool {
+	if x != nil {
+		return x.ReplaceOnIssue
+	}
+	return false
+}
+
+func (x *DataHistoryJob) GetJobResults() []*DataHistoryJobResult {
+	if x != nil {
+		return x.JobResults
+	}
+	return nil
+}
+
+func (x *DataHistoryJob) GetResultSummaries() []string {
+	if x != nil {
+		return x.ResultSummaries
+	}
+	return nil
+}
+
+type DataHistoryJobResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	HasData       bool                   `protobuf:"varint,3,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	RunDate       string                 `protobuf:"bytes,5,opt,name=run_date,json=runDate,proto3" json:"run_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataHistoryJobResult) Reset() {
+	*x = DataHistoryJobResult{}
+	mi := &file_rpc_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataHistoryJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataHistoryJobResult) ProtoMessage() {}
+
+func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
+func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *DataHistoryJobResult) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJobResult) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJobResult) GetHasData() bool {
+	if x != nil {
+		return x.HasData
+	}
+	return false
+}
+
+func (x *DataHistoryJobResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DataHistoryJobResult) GetRunDate() string {
+	if x != nil {
+		return x.RunDate
+	}
+	return ""
+}
+
+type DataHistoryJobs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*DataHistoryJob      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataHistoryJobs) Reset() {
+	*x = DataHistoryJobs{}
+	mi := &file_rpc_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataHistoryJobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataHistoryJobs) ProtoMessage() {}
+
+func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
+func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetDataHistoryJobsBetweenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) Reset() {
+	*x = GetDataHistoryJobsBetweenRequest{}
+	mi := &file_rpc_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
+
+func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type SetDataHistoryJobStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Status        int64                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDataHistoryJobStatusRequest) Reset() {
+	*x = SetDataHistoryJobStatusRequest{}
+	mi := &file_rpc_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDataHistoryJobStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
+
+func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *SetDataHistoryJobStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetDataHistoryJobStatusRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *SetDataHistoryJobStatusRequest) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type UpdateDataHistoryJobPrerequisiteRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Nickname                string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	PrerequisiteJobNickname string                 `protobuf:"bytes,2,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
+	*x = UpdateDataHistoryJobPrerequisiteRequest{}
+	mi := &file_rpc_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) GetPrerequisiteJobNickname() string {
+	if x != nil {
+		return x.PrerequisiteJobNickname
+	}
+	return ""
+}
+
+type ModifyOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Account       string                 `protobuf:"bytes,7,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyOrderRequest) Reset() {
+	*x = ModifyOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderRequest) ProtoMessage() {}
+
+func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
+func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ModifyOrderRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ModifyOrderRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ModifyOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ModifyOrderRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type ModifyOrderResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ModifiedOrderId string                 `protobuf:"bytes,1,opt,name=modified_order_id,json=modifiedOrderId,proto3" json:"modified_order_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModifyOrderResponse) Reset() {
+	*x = ModifyOrderResponse{}
+	mi := &file_rpc_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderResponse) ProtoMessage() {}
+
+func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
+func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ModifyOrderResponse) GetModifiedOrderId() string {
+	if x != nil {
+		return x.ModifiedOrderId
+	}
+	return ""
+}
+
+type CurrencyStateGetAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateGetAllRequest) Reset() {
+	*x = CurrencyStateGetAllRequest{}
+	mi := &file_rpc_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateGetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateGetAllRequest) ProtoMessage() {}
+
+func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *CurrencyStateGetAllRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type CurrencyStateTradingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateTradingRequest) Reset() {
+	*x = CurrencyStateTradingRequest{}
+	mi := &file_rpc_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateTradingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateTradingRequest) ProtoMessage() {}
+
+func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *CurrencyStateTradingRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateTradingPairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          string                 `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateTradingPairRequest) Reset() {
+	*x = CurrencyStateTradingPairRequest{}
+	mi := &file_rpc_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateTradingPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
+
+func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *CurrencyStateTradingPairRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingPairRequest) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingPairRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateWithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateWithdrawRequest) Reset() {
+	*x = CurrencyStateWithdrawRequest{}
+	mi := &file_rpc_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateWithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
+
+func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *CurrencyStateWithdrawRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateWithdrawRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateWithdrawRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateDepositRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateDepositRequest) Reset() {
+	*x = CurrencyStateDepositRequest{}
+	mi := &file_rpc_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateDepositRequest) ProtoMessage() {}
+
+func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *CurrencyStateDepositRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateDepositRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateDepositRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyStates []*CurrencyState       `protobuf:"bytes,1,rep,name=currency_states,json=currencyStates,proto3" json:"currency_states,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CurrencyStateResponse) Reset() {
+	*x = CurrencyStateResponse{}
+	mi := &file_rpc_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateResponse) ProtoMessage() {}
+
+func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
+func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
+	if x != nil {
+		return x.CurrencyStates
+	}
+	return nil
+}
+
+type CurrencyState struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	WithdrawEnabled bool                   `protobuf:"varint,3,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
+	DepositEnabled  bool                   `protobuf:"varint,4,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
+	TradingEnabled  bool                   `protobuf:"varint,5,opt,name=trading_enabled,json=tradingEnabled,proto3" json:"trading_enabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CurrencyState) Reset() {
+	*x = CurrencyState{}
+	mi := &file_rpc_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyState) ProtoMessage() {}
+
+func (x *CurrencyState) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
+func (*CurrencyState) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *CurrencyState) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyState) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *CurrencyState) GetWithdrawEnabled() bool {
+	if x != nil {
+		return x.WithdrawEnabled
+	}
+	return false
+}
+
+func (x *CurrencyState) GetDepositEnabled() bool {
+	if x != nil {
+		return x.DepositEnabled
+	}
+	return false
+}
+
+func (x *CurrencyState) GetTradingEnabled() bool {
+	if x != nil {
+		return x.TradingEnabled
+	}
+	return false
+}
+
+type FundingRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Rate          string                 `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Payment       string                 `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundingRate) Reset() {
+	*x = FundingRate{}
+	mi := &file_rpc_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundingRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingRate) ProtoMessage() {}
+
+func (x *FundingRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
+func (*FundingRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *FundingRate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FundingRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *FundingRate) GetPayment() string {
+	if x != nil {
+		return x.Payment
+	}
+	return ""
+}
+
+type FundingData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	PaymentCurrency string                 `protobuf:"bytes,4,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
+	StartDate       string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Rates           []*FundingRate         `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates,omitempty"`
+	LatestRate      *FundingRate           `protobuf:"bytes,8,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
+	UpcomingRate    *FundingRate           `protobuf:"bytes,9,opt,name=upcoming_rate,json=upcomingRate,proto3" json:"upcoming_rate,omitempty"`
+	PaymentSum      string                 `protobuf:"bytes,10,opt,name=payment_sum,json=paymentSum,proto3" json:"payment_sum,omitempty"`
+	PaymentMessage  string                 `protobuf:"bytes,11,opt,name=payment_message,json=paymentMessage,proto3" json:"payment_message,omitempty"`
+	TimeOfNextRate  string                 `protobuf:"bytes,12,opt,name=time_of_next_rate,json=timeOfNextRate,proto3" json:"time_of_next_rate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FundingData) Reset() {
+	*x = FundingData{}
+	mi := &file_rpc_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingData) ProtoMessage() {}
+
+func (x *FundingData) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
+func (*FundingData) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *FundingData) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FundingData) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FundingData) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FundingData) GetPaymentCurrency() string {
+	if x != nil {
+		return x.PaymentCurrency
+	}
+	return ""
+}
+
+func (x *FundingData) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *FundingData) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *FundingData) GetRates() []*FundingRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *FundingData) GetLatestRate() *FundingRate {
+	if x != nil {
+		return x.LatestRate
+	}
+	return nil
+}
+
+func (x *FundingData) GetUpcomingRate() *FundingRate {
+	if x != nil {
+		return x.UpcomingRate
+	}
+	return nil
+}
+
+func (x *FundingData) GetPaymentSum() string {
+	if x != nil {
+		return x.PaymentSum
+	}
+	return ""
+}
+
+func (x *FundingData) GetPaymentMessage() string {
+	if x != nil {
+		return x.PaymentMessage
+	}
+	return ""
+}
+
+func (x *FundingData) GetTimeOfNextRate() string {
+	if x != nil {
+		return x.TimeOfNextRate
+	}
+	return ""
+}
+
+type FuturesPositionStats struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceMarginRequirement string                 `protobuf:"bytes,1,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3" json:"maintenance_margin_requirement,omitempty"`
+	InitialMarginRequirement     string                 `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3" json:"initial_margin_requirement,omitempty"`
+	EstimatedLiquidationPrice    string                 `protobuf:"bytes,3,opt,name=estimated_liquidation_price,json=estimatedLiquidationPrice,proto3" json:"estimated_liquidation_price,omitempty"`
+	CollateralUsed               string                 `protobuf:"bytes,4,opt,name=collateral_used,json=collateralUsed,proto3" json:"collateral_used,omitempty"`
+	MarkPrice                    string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	CurrentSize                  string                 `protobuf:"bytes,6,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
+	ContractSize                 string                 `protobuf:"bytes,7,opt,name=contract_size,json=contractSize,proto3" json:"contract_size,omitempty"`
+	ContractMultiplier           string                 `protobuf:"bytes,8,opt,name=contract_multiplier,json=contractMultiplier,proto3" json:"contract_multiplier,omitempty"`
+	ContractSettlementType       string                 `protobuf:"bytes,9,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
+	BreakEvenPrice               string                 `protobuf:"bytes,10,opt,name=break_even_price,json=breakEvenPrice,proto3" json:"break_even_price,omitempty"`
+	AverageOpenPrice             string                 `protobuf:"bytes,11,opt,name=average_open_price,json=averageOpenPrice,proto3" json:"average_open_price,omitempty"`
+	RecentPnl                    string                 `protobuf:"bytes,12,opt,name=recent_pnl,json=recentPnl,proto3" json:"recent_pnl,omitempty"`
+	MarginFraction               string                 `protobuf:"bytes,13,opt,name=margin_fraction,json=marginFraction,proto3" json:"margin_fraction,omitempty"`
+	FreeCollateral               string                 `protobuf:"bytes,14,opt,name=free_collateral,json=freeCollateral,proto3" json:"free_collateral,omitempty"`
+	TotalCollateral              string                 `protobuf:"bytes,15,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
+	FrozenBalance                string                 `protobuf:"bytes,16,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`
+	EquityOfCurrency             string                 `protobuf:"bytes,17,opt,name=equity_of_currency,json=equityOfCurrency,proto3" json:"equity_of_currency,omitempty"`
+	AvailableEquity              string                 `protobuf:"bytes,18,opt,name=available_equity,json=availableEquity,proto3" json:"available_equity,omitempty"`
+	CashBalance                  string                 `protobuf:"bytes,19,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
+	DiscountEquity               string                 `protobuf:"bytes,20,opt,name=discount_equity,json=discountEquity,proto3" json:"discount_equity,omitempty"`
+	EquityUsd                    string                 `protobuf:"bytes,21,opt,name=equity_usd,json=equityUsd,proto3" json:"equity_usd,omitempty"`
+	IsolatedEquity               string                 `protobuf:"bytes,22,opt,name=isolated_equity,json=isolatedEquity,proto3" json:"isolated_equity,omitempty"`
+	IsolatedLiabilities          string                 `protobuf:"bytes,23,opt,name=isolated_liabilities,json=isolatedLiabilities,proto3" json:"isolated_liabilities,omitempty"`
+	IsolatedUpl                  string                 `protobuf:"bytes,24,opt,name=isolated_upl,json=isolatedUpl,proto3" json:"isolated_upl,omitempty"`
+	NotionalLeverage             string                 `protobuf:"bytes,25,opt,name=notional_leverage,json=notionalLeverage,proto3" json:"notional_leverage,omitempty"`
+	TotalEquity                  string                 `protobuf:"bytes,26,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
+	StrategyEquity               string                 `protobuf:"bytes,27,opt,name=strategy_equity,json=strategyEquity,proto3" json:"strategy_equity,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *FuturesPositionStats) Reset() {
+	*x = FuturesPositionStats{}
+	mi := &file_rpc_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuturesPositionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuturesPositionStats) ProtoMessage() {}
+
+func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
+func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
+	if x != nil {
+		return x.MaintenanceMarginRequirement
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetInitialMarginRequirement() string {
+	if x != nil {
+		return x.InitialMarginRequirement
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEstimatedLiquidationPrice() string {
+	if x != nil {
+		return x.EstimatedLiquidationPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCollateralUsed() string {
+	if x != nil {
+		return x.CollateralUsed
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetMarkPrice() string {
+	if x != nil {
+		return x.MarkPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCurrentSize() string {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractSize() string {
+	if x != nil {
+		return x.ContractSize
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractMultiplier() string {
+	if x != nil {
+		return x.ContractMultiplier
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractSettlementType() string {
+	if x != nil {
+		return x.ContractSettlementType
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetBreakEvenPrice() string {
+	if x != nil {
+		return x.BreakEvenPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetAverageOpenPrice() string {
+	if x != nil {
+		return x.AverageOpenPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetRecentPnl() string {
+	if x != nil {
+		return x.RecentPnl
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetMarginFraction() string {
+	if x != nil {
+		return x.MarginFraction
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetFreeCollateral() string {
+	if x != nil {
+		return x.FreeCollateral
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetTotalCollateral() string {
+	if x != nil {
+		return x.TotalCollateral
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetFrozenBalance() string {
+	if x != nil {
+		return x.FrozenBalance
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEquityOfCurrency() string {
+	if x != nil {
+		return x.EquityOfCurrency
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetAvailableEquity() string {
+	if x != nil {
+		return x.AvailableEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCashBalance() string {
+	if x != nil {
+		return x.CashBalance
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetDiscountEquity() string {
+	if x != nil {
+		return x.DiscountEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEquityUsd() string {
+	if x != nil {
+		return x.EquityUsd
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedEquity() string {
+	if x != nil {
+		return x.IsolatedEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedLiabilities() string {
+	if x != nil {
+		return x.IsolatedLiabilities
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedUpl() string {
+	if x != nil {
+		return x.IsolatedUpl
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetNotionalLeverage() string {
+	if x != nil {
+		return x.NotionalLeverage
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetTotalEquity() string {
+	if x != nil {
+		return x.TotalEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetStrategyEquity() string {
+	if x != nil {
+		return x.StrategyEquity
+	}
+	return ""
+}
+
+type FuturePosition struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                  string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                   *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Status                 string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	OpeningDate            string                 `protobuf:"bytes,5,opt,name=opening_date,json=openingDate,proto3" json:"opening_date,omitempty"`
+	OpeningDirection       string                 `protobuf:"bytes,6,opt,name=opening_direction,json=openingDirection,proto3" json:"opening_direction,omitempty"`
+	OpeningPrice           string                 `protobuf:"bytes,7,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
+	OpeningSize            string                 `protobuf:"bytes,8,opt,name=opening_size,json=openingSize,proto3" json:"opening_size,omitempty"`
+	CurrentDirection       string                 `protobuf:"bytes,9,opt,name=current_direction,json=currentDirection,proto3" json:"current_direction,omitempty"`
+	CurrentPrice           string                 `protobuf:"bytes,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	CurrentSize            string                 `protobuf:"bytes,11,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
+	UnrealisedPnl          string                 `protobuf:"bytes,12,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	RealisedPnl            string                 `protobuf:"bytes,13,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	ClosingDate            string                 `protobuf:"bytes,14,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
+	OrderCount             int64                  `protobuf:"varint,15,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	ContractSettlementType string                 `protobuf:"bytes,16,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
+	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
+	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
+	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FuturePosition) Reset() {
+	*x = FuturePosition{}
+	mi := &file_rpc_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuturePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuturePosition) ProtoMessage() {}
+
+func (x *FuturePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
+func (*FuturePosition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *FuturePosition) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningDate() string {
+	if x != nil {
+		return x.OpeningDate
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningDirection() string {
+	if x != nil {
+		return x.OpeningDirection
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningPrice() string {
+	if x != nil {
+		return x.OpeningPrice
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningSize() string {
+	if x != nil {
+		return x.OpeningSize
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentDirection() string {
+	if x != nil {
+		return x.CurrentDirection
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentPrice() string {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentSize() string {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetUnrealisedPnl() string {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetRealisedPnl() string {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetClosingDate() string {
+	if x != nil {
+		return x.ClosingDate
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *FuturePosition) GetContractSettlementType() string {
+	if x != nil {
+		return x.ContractSettlementType
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOrders() []*OrderDetails {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetPositionStats() *FuturesPositionStats {
+	if x != nil {
+		return x.PositionStats
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetFundingData() *FundingData {
+	if x != nil {
+		return x.FundingData
+	}
+	return nil
+}
+
+type GetManagedPositionRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludeFullOrderData    bool                   `protobuf:"varint,4,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
+	GetFundingPayments      bool                   `protobuf:"varint,5,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
+	IncludeFullFundingRates bool                   `protobuf:"varint,6,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
+	IncludePredictedRate    bool                   `protobuf:"varint,7,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetManagedPositionRequest) Reset() {
+	*x = GetManagedPositionRequest{}
+	mi := &file_rpc_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedPositionRequest) ProtoMessage() {}
+
+func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
+func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *GetManagedPositionRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetManagedPositionRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetManagedPositionRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetManagedPositionRequest) GetIncludeFullOrderData() bool {
+	if x != nil {
+		return x.IncludeFullOrderData
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetGetFundingPayments() bool {
+	if x != nil {
+		return x.GetFundingPayments
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetIncludeFullFundingRates() bool {
+	if x != nil {
+		return x.IncludeFullFundingRates
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetIncludePredictedRate() bool {
+	if x != nil {
+		return x.IncludePredictedRate
+	}
+	return false
+}
+
+type GetAllManagedPositionsRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	IncludeFullOrderData    bool                   `protobuf:"varint,1,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
+	GetFundingPayments      bool                   `protobuf:"varint,2,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
+	IncludeFullFundingRates bool                   `protobuf:"varint,3,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
+	IncludePredictedRate    bool                   `protobuf:"varint,4,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetAllManagedPositionsRequest) Reset() {
+	*x = GetAllManagedPositionsRequest{}
+	mi := &file_rpc_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllManagedPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllManagedPositionsRequest) ProtoMessage() {}
+
+func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
+	if x != nil {
+		return x.IncludeFullOrderData
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetGetFundingPayments() bool {
+	if x != nil {
+		return x.GetFundingPayments
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludeFullFundingRates() bool {
+	if x != nil {
+		return x.IncludeFullFundingRates
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludePredictedRate() bool {
+	if x != nil {
+		return x.IncludePredictedRate
+	}
+	return false
+}
+
+type GetManagedPositionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*FuturePosition      `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManagedPositionsResponse) Reset() {
+	*x = GetManagedPositionsResponse{}
+	mi := &file_rpc_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedPositionsResponse) ProtoMessage() {}
+
+func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
+func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GetFuturesPositionsSummaryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsSummaryRequest) Reset() {
+	*x = GetFuturesPositionsSummaryRequest{}
+	mi := &file_rpc_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
+
+func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+type GetFuturesPositionsSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	PositionStats *FuturesPositionStats  `protobuf:"bytes,4,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsSummaryResponse) Reset() {
+	*x = GetFuturesPositionsSummaryResponse{}
+	mi := &file_rpc_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
+
+func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetPositionStats() *FuturesPositionStats {
+	if x != nil {
+		return x.PositionStats
+	}
+	return nil
+}
+
+type GetFuturesPositionsOrdersRequest struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                  string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                     string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                      *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair            *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	StartDate                 string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                   string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RespectOrderHistoryLimits bool                   `protobuf:"varint,7,opt,name=respect_order_history_limits,json=respectOrderHistoryLimits,proto3" json:"respect_order_history_limits,omitempty"`
+	SyncWithOrderManager      bool                   `protobuf:"varint,8,opt,name=sync_with_order_manager,json=syncWithOrderManager,proto3" json:"sync_with_order_manager,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsOrdersRequest) Reset() {
+	*x = GetFuturesPositionsOrdersRequest{}
+	mi := &file_rpc_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
+
+func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetRespectOrderHistoryLimits() bool {
+	if x != nil {
+		return x.RespectOrderHistoryLimits
+	}
+	return false
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetSyncWithOrderManager() bool {
+	if x != nil {
+		return x.SyncWithOrderManager
+	}
+	return false
+}
+
+type GetFuturesPositionsOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*FuturePosition      `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsOrdersResponse) Reset() {
+	*x = GetFuturesPositionsOrdersResponse{}
+	mi := &file_rpc_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
+
+func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GetCollateralModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollateralModeRequest) Reset() {
+	*x = GetCollateralModeRequest{}
+	mi := &file_rpc_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralModeRequest) ProtoMessage() {}
+
+func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
+func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *GetCollateralModeRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCollateralModeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type GetCollateralModeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCollateralModeResponse) Reset() {
+	*x = GetCollateralModeResponse{}
+	mi := &file_rpc_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralModeResponse) ProtoMessage() {}
+
+func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
+func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *GetCollateralModeResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCollateralModeResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetCollateralModeResponse) GetCollateralMode() string {
+	if x != nil {
+		return x.CollateralMode
+	}
+	return ""
+}
+
+type SetCollateralModeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetCollateralModeRequest) Reset() {
+	*x = SetCollateralModeRequest{}
+	mi := &file_rpc_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollateralModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollateralModeRequest) ProtoMessage() {}
 
-func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[157]
+func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9691,41 +12701,56 @@ func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
-func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{157}
+// Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
+func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
+func (x *SetCollateralModeRequest) GetExchange() string {
 	if x != nil {
-		return x.Results
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type GetDataHistoryJobsBetweenRequest struct {
+func (x *SetCollateralModeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SetCollateralModeRequest) GetCollateralMode() string {
+	if x != nil {
+		return x.CollateralMode
+	}
+	return ""
+}
+
+type SetCollateralModeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) Reset() {
-	*x = GetDataHistoryJobsBetweenRequest{}
-	mi := &file_rpc_proto_msgTypes[158]
+func (x *SetCollateralModeResponse) Reset() {
+	*x = SetCollateralModeResponse{}
+	mi := &file_rpc_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) String() string {
+func (x *SetCollateralModeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
+func (*SetCollateralModeResponse) ProtoMessage() {}
 
-func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[158]
+func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9736,49 +12761,56 @@ func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
-func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{158}
+// Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
+func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
+func (x *SetCollateralModeResponse) GetExchange() string {
 	if x != nil {
-		return x.StartDate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) GetEndDate() string {
+func (x *SetCollateralModeResponse) GetAsset() string {
 	if x != nil {
-		return x.EndDate
+		return x.Asset
 	}
 	return ""
 }
 
-type SetDataHistoryJobStatusRequest struct {
+func (x *SetCollateralModeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetMarginTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Status        int64                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetDataHistoryJobStatusRequest) Reset() {
-	*x = SetDataHistoryJobStatusRequest{}
-	mi := &file_rpc_proto_msgTypes[159]
+func (x *GetMarginTypeRequest) Reset() {
+	*x = GetMarginTypeRequest{}
+	mi := &file_rpc_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetDataHistoryJobStatusRequest) String() string {
+func (x *GetMarginTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
+func (*GetMarginTypeRequest) ProtoMessage() {}
 
-func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[159]
+func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9789,55 +12821,57 @@ func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
-func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{159}
+// Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetId() string {
+func (x *GetMarginTypeRequest) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetNickname() string {
+func (x *GetMarginTypeRequest) GetAsset() string {
 	if x != nil {
-		return x.Nickname
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetStatus() int64 {
+func (x *GetMarginTypeRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Status
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-type UpdateDataHistoryJobPrerequisiteRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Nickname                string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	PrerequisiteJobNickname string                 `protobuf:"bytes,2,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type GetMarginTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
-	*x = UpdateDataHistoryJobPrerequisiteRequest{}
-	mi := &file_rpc_proto_msgTypes[160]
+func (x *GetMarginTypeResponse) Reset() {
+	*x = GetMarginTypeResponse{}
+	mi := &file_rpc_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
+func (x *GetMarginTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
+func (*GetMarginTypeResponse) ProtoMessage() {}
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[160]
+func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9848,52 +12882,67 @@ func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{160}
+// Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
+func (x *GetMarginTypeResponse) GetExchange() string {
 	if x != nil {
-		return x.Nickname
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) GetPrerequisiteJobNickname() string {
+func (x *GetMarginTypeResponse) GetAsset() string {
 	if x != nil {
-		return x.PrerequisiteJobNickname
+		return x.Asset
 	}
 	return ""
 }
 
-type ModifyOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetMarginTypeResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *ModifyOrderRequest) Reset() {
-	*x = ModifyOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[161]
+func (x *GetMarginTypeResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
+}
+
+type ChangePositionMarginRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType              string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OriginalAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=original_allocated_margin,json=originalAllocatedMargin,proto3" json:"original_allocated_margin,omitempty"`
+	NewAllocatedMargin      float64                `protobuf:"fixed64,6,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
+	MarginSide              string                 `protobuf:"bytes,7,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ChangePositionMarginRequest) Reset() {
+	*x = ChangePositionMarginRequest{}
+	mi := &file_rpc_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyOrderRequest) String() string {
+func (x *ChangePositionMarginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyOrderRequest) ProtoMessage() {}
+func (*ChangePositionMarginRequest) ProtoMessage() {}
 
-func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[161]
+func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9904,75 +12953,87 @@ func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
-func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{161}
+// Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
+func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *ModifyOrderRequest) GetExchange() string {
+func (x *ChangePositionMarginRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *ModifyOrderRequest) GetOrderId() string {
+func (x *ChangePositionMarginRequest) GetAsset() string {
 	if x != nil {
-		return x.OrderId
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *ModifyOrderRequest) GetPair() *CurrencyPair {
+func (x *ChangePositionMarginRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *ModifyOrderRequest) GetAsset() string {
+func (x *ChangePositionMarginRequest) GetMarginType() string {
 	if x != nil {
-		return x.Asset
+		return x.MarginType
 	}
 	return ""
 }
 
-func (x *ModifyOrderRequest) GetAmount() float64 {
+func (x *ChangePositionMarginRequest) GetOriginalAllocatedMargin() float64 {
 	if x != nil {
-		return x.Amount
+		return x.OriginalAllocatedMargin
 	}
 	return 0
 }
 
-func (x *ModifyOrderRequest) GetPrice() float64 {
+func (x *ChangePositionMarginRequest) GetNewAllocatedMargin() float64 {
 	if x != nil {
-		return x.Price
+		return x.NewAllocatedMargin
 	}
 	return 0
 }
 
-type ModifyOrderResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ModifiedOrderId string                 `protobuf:"bytes,1,opt,name=modified_order_id,json=modifiedOrderId,proto3" json:"modified_order_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ChangePositionMarginRequest) GetMarginSide() string {
+	if x != nil {
+		return x.MarginSide
+	}
+	return ""
 }
 
-func (x *ModifyOrderResponse) Reset() {
-	*x = ModifyOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[162]
+type ChangePositionMarginResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair               *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType         string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	NewAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
+	MarginSide         string                 `protobuf:"bytes,6,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ChangePositionMarginResponse) Reset() {
+	*x = ChangePositionMarginResponse{}
+	mi := &file_rpc_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyOrderResponse) String() string {
+func (x *ChangePositionMarginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyOrderResponse) ProtoMessage() {}
+func (*ChangePositionMarginResponse) ProtoMessage() {}
 
-func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[162]
+func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9983,86 +13044,78 @@ func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
-func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{162}
+// Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
+func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *ModifyOrderResponse) GetModifiedOrderId() string {
+func (x *ChangePositionMarginResponse) GetExchange() string {
 	if x != nil {
-		return x.ModifiedOrderId
+		return x.Exchange
 	}
 	return ""
 }
 
-type CurrencyStateGetAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CurrencyStateGetAllRequest) Reset() {
-	*x = CurrencyStateGetAllRequest{}
-	mi := &file_rpc_proto_msgTypes[163]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ChangePositionMarginResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
 }
 
-func (x *CurrencyStateGetAllRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChangePositionMarginResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (*CurrencyStateGetAllRequest) ProtoMessage() {}
-
-func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[163]
+func (x *ChangePositionMarginResponse) GetMarginType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MarginType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{163}
+func (x *ChangePositionMarginResponse) GetNewAllocatedMargin() float64 {
+	if x != nil {
+		return x.NewAllocatedMargin
+	}
+	return 0
 }
 
-func (x *CurrencyStateGetAllRequest) GetExchange() string {
+func (x *ChangePositionMarginResponse) GetMarginSide() string {
 	if x != nil {
-		return x.Exchange
+		return x.MarginSide
 	}
 	return ""
 }
 
-type CurrencyStateTradingRequest struct {
+type SetMarginTypeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurrencyStateTradingRequest) Reset() {
-	*x = CurrencyStateTradingRequest{}
-	mi := &file_rpc_proto_msgTypes[164]
+func (x *SetMarginTypeRequest) Reset() {
+	*x = SetMarginTypeRequest{}
+	mi := &file_rpc_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateTradingRequest) String() string {
+func (x *SetMarginTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateTradingRequest) ProtoMessage() {}
+func (*SetMarginTypeRequest) ProtoMessage() {}
 
-func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[164]
+func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10073,56 +13126,64 @@ func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{164}
+// Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
+func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *CurrencyStateTradingRequest) GetExchange() string {
+func (x *SetMarginTypeRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingRequest) GetCode() string {
+func (x *SetMarginTypeRequest) GetAsset() string {
 	if x != nil {
-		return x.Code
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingRequest) GetAsset() string {
+func (x *SetMarginTypeRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SetMarginTypeRequest) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
 	}
 	return ""
 }
 
-type CurrencyStateTradingPairRequest struct {
+type SetMarginTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          string                 `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurrencyStateTradingPairRequest) Reset() {
-	*x = CurrencyStateTradingPairRequest{}
-	mi := &file_rpc_proto_msgTypes[165]
+func (x *SetMarginTypeResponse) Reset() {
+	*x = SetMarginTypeResponse{}
+	mi := &file_rpc_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateTradingPairRequest) String() string {
+func (x *SetMarginTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
+func (*SetMarginTypeResponse) ProtoMessage() {}
 
-func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[165]
+func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10133,56 +13194,66 @@ func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{165}
+// Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
+func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *CurrencyStateTradingPairRequest) GetExchange() string {
+func (x *SetMarginTypeResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingPairRequest) GetPair() string {
+func (x *SetMarginTypeResponse) GetAsset() string {
 	if x != nil {
-		return x.Pair
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingPairRequest) GetAsset() string {
+func (x *SetMarginTypeResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-type CurrencyStateWithdrawRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetMarginTypeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
-func (x *CurrencyStateWithdrawRequest) Reset() {
-	*x = CurrencyStateWithdrawRequest{}
-	mi := &file_rpc_proto_msgTypes[166]
+type GetLeverageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLeverageRequest) Reset() {
+	*x = GetLeverageRequest{}
+	mi := &file_rpc_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateWithdrawRequest) String() string {
+func (x *GetLeverageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
+func (*GetLeverageRequest) ProtoMessage() {}
 
-func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[166]
+func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10193,56 +13264,81 @@ func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{166}
+// Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
+func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *CurrencyStateWithdrawRequest) GetExchange() string {
+func (x *GetLeverageRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateWithdrawRequest) GetCode() string {
+func (x *GetLeverageRequest) GetAsset() string {
 	if x != nil {
-		return x.Code
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *CurrencyStateWithdrawRequest) GetAsset() string {
+func (x *GetLeverageRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *GetLeverageRequest) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
 	}
 	return ""
 }
 
-type CurrencyStateDepositRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetLeverageRequest) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
 }
 
-func (x *CurrencyStateDepositRequest) Reset() {
-	*x = CurrencyStateDepositRequest{}
-	mi := &file_rpc_proto_msgTypes[167]
+type GetLeverageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLeverageResponse) Reset() {
+	*x = GetLeverageResponse{}
+	mi := &file_rpc_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateDepositRequest) String() string {
+func (x *GetLeverageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateDepositRequest) ProtoMessage() {}
+func (*GetLeverageResponse) ProtoMessage() {}
 
-func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[167]
+func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10253,102 +13349,88 @@ func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{167}
+// Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
+func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *CurrencyStateDepositRequest) GetExchange() string {
+func (x *GetLeverageResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateDepositRequest) GetCode() string {
+func (x *GetLeverageResponse) GetAsset() string {
 	if x != nil {
-		return x.Code
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *CurrencyStateDepositRequest) GetAsset() string {
+func (x *GetLeverageResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
 	}
-	return ""
-}
-
-type CurrencyStateResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CurrencyStates []*CurrencyState       `protobuf:"bytes,1,rep,name=currency_states,json=currencyStates,proto3" json:"currency_states,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *CurrencyStateResponse) Reset() {
-	*x = CurrencyStateResponse{}
-	mi := &file_rpc_proto_msgTypes[168]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return nil
 }
 
-func (x *CurrencyStateResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
 }
 
-func (*CurrencyStateResponse) ProtoMessage() {}
-
-func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[168]
+func (x *GetLeverageResponse) GetMarginType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MarginType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
-func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{168}
+func (x *GetLeverageResponse) GetLeverage() float64 {
+	if x != nil {
+		return x.Leverage
+	}
+	return 0
 }
 
-func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
+func (x *GetLeverageResponse) GetOrderSide() string {
 	if x != nil {
-		return x.CurrencyStates
+		return x.OrderSide
 	}
-	return nil
+	return ""
 }
 
-type CurrencyState struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	WithdrawEnabled bool                   `protobuf:"varint,3,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
-	DepositEnabled  bool                   `protobuf:"varint,4,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
-	TradingEnabled  bool                   `protobuf:"varint,5,opt,name=trading_enabled,json=tradingEnabled,proto3" json:"trading_enabled,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SetLeverageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CurrencyState) Reset() {
-	*x = CurrencyState{}
-	mi := &file_rpc_proto_msgTypes[169]
+func (x *SetLeverageRequest) Reset() {
+	*x = SetLeverageRequest{}
+	mi := &file_rpc_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyState) String() string {
+func (x *SetLeverageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyState) ProtoMessage() {}
+func (*SetLeverageRequest) ProtoMessage() {}
 
-func (x *CurrencyState) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[169]
+func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10359,70 +13441,88 @@ func (x *CurrencyState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
-func (*CurrencyState) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{169}
+// Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
+func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *CurrencyState) GetCurrency() string {
+func (x *SetLeverageRequest) GetExchange() string {
 	if x != nil {
-		return x.Currency
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyState) GetAsset() string {
+func (x *SetLeverageRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *CurrencyState) GetWithdrawEnabled() bool {
+func (x *SetLeverageRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.WithdrawEnabled
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-func (x *CurrencyState) GetDepositEnabled() bool {
+func (x *SetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
 	if x != nil {
-		return x.DepositEnabled
+		return x.UnderlyingPair
 	}
-	return false
+	return nil
 }
 
-func (x *CurrencyState) GetTradingEnabled() bool {
+func (x *SetLeverageRequest) GetMarginType() string {
 	if x != nil {
-		return x.TradingEnabled
+		return x.MarginType
 	}
-	return false
+	return ""
 }
 
-type FundingRate struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Rate          string                 `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
-	Payment       string                 `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetLeverageRequest) GetLeverage() float64 {
+	if x != nil {
+		return x.Leverage
+	}
+	return 0
 }
 
-func (x *FundingRate) Reset() {
-	*x = FundingRate{}
-	mi := &file_rpc_proto_msgTypes[170]
+func (x *SetLeverageRequest) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
+}
+
+type SetLeverageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	Success        bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetLeverageResponse) Reset() {
+	*x = SetLeverageResponse{}
+	mi := &file_rpc_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingRate) String() string {
+func (x *SetLeverageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FundingRate) ProtoMessage() {}
+func (*SetLeverageResponse) ProtoMessage() {}
 
-func (x *FundingRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[170]
+func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10433,65 +13533,86 @@ func (x *FundingRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
-func (*FundingRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{170}
+// Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
+func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *FundingRate) GetDate() string {
+func (x *SetLeverageResponse) GetExchange() string {
 	if x != nil {
-		return x.Date
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FundingRate) GetRate() string {
+func (x *SetLeverageResponse) GetAsset() string {
 	if x != nil {
-		return x.Rate
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FundingRate) GetPayment() string {
+func (x *SetLeverageResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Payment
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *SetLeverageResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
 	}
 	return ""
 }
 
-type FundingData struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	PaymentCurrency string                 `protobuf:"bytes,4,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
-	StartDate       string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate         string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Rates           []*FundingRate         `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates,omitempty"`
-	LatestRate      *FundingRate           `protobuf:"bytes,8,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
-	UpcomingRate    *FundingRate           `protobuf:"bytes,9,opt,name=upcoming_rate,json=upcomingRate,proto3" json:"upcoming_rate,omitempty"`
-	PaymentSum      string                 `protobuf:"bytes,10,opt,name=payment_sum,json=paymentSum,proto3" json:"payment_sum,omitempty"`
-	PaymentMessage  string                 `protobuf:"bytes,11,opt,name=payment_message,json=paymentMessage,proto3" json:"payment_message,omitempty"`
-	TimeOfNextRate  string                 `protobuf:"bytes,12,opt,name=time_of_next_rate,json=timeOfNextRate,proto3" json:"time_of_next_rate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *SetLeverageResponse) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
 }
 
-func (x *FundingData) Reset() {
-	*x = FundingData{}
-	mi := &file_rpc_proto_msgTypes[171]
+func (x *SetLeverageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetCollateralRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset             string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	IncludeBreakdown  bool                   `protobuf:"varint,3,opt,name=include_breakdown,json=includeBreakdown,proto3" json:"include_breakdown,omitempty"`
+	CalculateOffline  bool                   `protobuf:"varint,4,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
+	IncludeZeroValues bool                   `protobuf:"varint,5,opt,name=include_zero_values,json=includeZeroValues,proto3" json:"include_zero_values,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCollateralRequest) Reset() {
+	*x = GetCollateralRequest{}
+	mi := &file_rpc_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingData) String() string {
+func (x *GetCollateralRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FundingData) ProtoMessage() {}
+func (*GetCollateralRequest) ProtoMessage() {}
 
-func (x *FundingData) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[171]
+func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10502,143 +13623,78 @@ func (x *FundingData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
-func (*FundingData) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{171}
+// Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
+func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *FundingData) GetExchange() string {
+func (x *GetCollateralRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FundingData) GetAsset() string {
+func (x *GetCollateralRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *FundingData) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *FundingData) GetPaymentCurrency() string {
-	if x != nil {
-		return x.PaymentCurrency
-	}
-	return ""
-}
-
-func (x *FundingData) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
-}
-
-func (x *FundingData) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
-}
-
-func (x *FundingData) GetRates() []*FundingRate {
-	if x != nil {
-		return x.Rates
-	}
-	return nil
-}
-
-func (x *FundingData) GetLatestRate() *FundingRate {
-	if x != nil {
-		return x.LatestRate
-	}
-	return nil
-}
-
-func (x *FundingData) GetUpcomingRate() *FundingRate {
-	if x != nil {
-		return x.UpcomingRate
-	}
-	return nil
-}
-
-func (x *FundingData) GetPaymentSum() string {
+func (x *GetCollateralRequest) GetIncludeBreakdown() bool {
 	if x != nil {
-		return x.PaymentSum
+		return x.IncludeBreakdown
 	}
-	return ""
+	return false
 }
 
-func (x *FundingData) GetPaymentMessage() string {
+func (x *GetCollateralRequest) GetCalculateOffline() bool {
 	if x != nil {
-		return x.PaymentMessage
+		return x.CalculateOffline
 	}
-	return ""
+	return false
 }
 
-func (x *FundingData) GetTimeOfNextRate() string {
+func (x *GetCollateralRequest) GetIncludeZeroValues() bool {
 	if x != nil {
-		return x.TimeOfNextRate
+		return x.IncludeZeroValues
 	}
-	return ""
+	return false
 }
 
-type FuturesPositionStats struct {
-	state                        protoimpl.MessageState `protogen:"open.v1"`
-	MaintenanceMarginRequirement string                 `protobuf:"bytes,1,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3" json:"maintenance_margin_requirement,omitempty"`
-	InitialMarginRequirement     string                 `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3" json:"initial_margin_requirement,omitempty"`
-	EstimatedLiquidationPrice    string                 `protobuf:"bytes,3,opt,name=estimated_liquidation_price,json=estimatedLiquidationPrice,proto3" json:"estimated_liquidation_price,omitempty"`
-	CollateralUsed               string                 `protobuf:"bytes,4,opt,name=collateral_used,json=collateralUsed,proto3" json:"collateral_used,omitempty"`
-	MarkPrice                    string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
-	CurrentSize                  string                 `protobuf:"bytes,6,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
-	ContractSize                 string                 `protobuf:"bytes,7,opt,name=contract_size,json=contractSize,proto3" json:"contract_size,omitempty"`
-	ContractMultiplier           string                 `protobuf:"bytes,8,opt,name=contract_multiplier,json=contractMultiplier,proto3" json:"contract_multiplier,omitempty"`
-	ContractSettlementType       string                 `protobuf:"bytes,9,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
-	BreakEvenPrice               string                 `protobuf:"bytes,10,opt,name=break_even_price,json=breakEvenPrice,proto3" json:"break_even_price,omitempty"`
-	AverageOpenPrice             string                 `protobuf:"bytes,11,opt,name=average_open_price,json=averageOpenPrice,proto3" json:"average_open_price,omitempty"`
-	RecentPnl                    string                 `protobuf:"bytes,12,opt,name=recent_pnl,json=recentPnl,proto3" json:"recent_pnl,omitempty"`
-	MarginFraction               string                 `protobuf:"bytes,13,opt,name=margin_fraction,json=marginFraction,proto3" json:"margin_fraction,omitempty"`
-	FreeCollateral               string                 `protobuf:"bytes,14,opt,name=free_collateral,json=freeCollateral,proto3" json:"free_collateral,omitempty"`
-	TotalCollateral              string                 `protobuf:"bytes,15,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
-	FrozenBalance                string                 `protobuf:"bytes,16,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`
-	EquityOfCurrency             string                 `protobuf:"bytes,17,opt,name=equity_of_currency,json=equityOfCurrency,proto3" json:"equity_of_currency,omitempty"`
-	AvailableEquity              string                 `protobuf:"bytes,18,opt,name=available_equity,json=availableEquity,proto3" json:"available_equity,omitempty"`
-	CashBalance                  string                 `protobuf:"bytes,19,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
-	DiscountEquity               string                 `protobuf:"bytes,20,opt,name=discount_equity,json=discountEquity,proto3" json:"discount_equity,omitempty"`
-	EquityUsd                    string                 `protobuf:"bytes,21,opt,name=equity_usd,json=equityUsd,proto3" json:"equity_usd,omitempty"`
-	IsolatedEquity               string                 `protobuf:"bytes,22,opt,name=isolated_equity,json=isolatedEquity,proto3" json:"isolated_equity,omitempty"`
-	IsolatedLiabilities          string                 `protobuf:"bytes,23,opt,name=isolated_liabilities,json=isolatedLiabilities,proto3" json:"isolated_liabilities,omitempty"`
-	IsolatedUpl                  string                 `protobuf:"bytes,24,opt,name=isolated_upl,json=isolatedUpl,proto3" json:"isolated_upl,omitempty"`
-	NotionalLeverage             string                 `protobuf:"bytes,25,opt,name=notional_leverage,json=notionalLeverage,proto3" json:"notional_leverage,omitempty"`
-	TotalEquity                  string                 `protobuf:"bytes,26,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
-	StrategyEquity               string                 `protobuf:"bytes,27,opt,name=strategy_equity,json=strategyEquity,proto3" json:"strategy_equity,omitempty"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+type GetCollateralResponse struct {
+	state                                       protoimpl.MessageState   `protogen:"open.v1"`
+	SubAccount                                  string                   `protobuf:"bytes,1,opt,name=sub_account,json=subAccount,proto3" json:"sub_account,omitempty"`
+	CollateralCurrency                          string                   `protobuf:"bytes,2,opt,name=collateral_currency,json=collateralCurrency,proto3" json:"collateral_currency,omitempty"`
+	TotalValueOfPositiveSpotBalances            string                   `protobuf:"bytes,3,opt,name=total_value_of_positive_spot_balances,json=totalValueOfPositiveSpotBalances,proto3" json:"total_value_of_positive_spot_balances,omitempty"`
+	CollateralContributedByPositiveSpotBalances string                   `protobuf:"bytes,4,opt,name=collateral_contributed_by_positive_spot_balances,json=collateralContributedByPositiveSpotBalances,proto3" json:"collateral_contributed_by_positive_spot_balances,omitempty"`
+	UsedCollateral                              string                   `protobuf:"bytes,5,opt,name=used_collateral,json=usedCollateral,proto3" json:"used_collateral,omitempty"`
+	UsedBreakdown                               *CollateralUsedBreakdown `protobuf:"bytes,6,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
+	AvailableCollateral                         string                   `protobuf:"bytes,7,opt,name=available_collateral,json=availableCollateral,proto3" json:"available_collateral,omitempty"`
+	MaintenanceCollateral                       string                   `protobuf:"bytes,8,opt,name=maintenance_collateral,json=maintenanceCollateral,proto3" json:"maintenance_collateral,omitempty"`
+	UnrealisedPnl                               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	CurrencyBreakdown                           []*CollateralForCurrency `protobuf:"bytes,10,rep,name=currency_breakdown,json=currencyBreakdown,proto3" json:"currency_breakdown,omitempty"`
+	PositionBreakdown                           []*CollateralByPosition  `protobuf:"bytes,11,rep,name=position_breakdown,json=positionBreakdown,proto3" json:"position_breakdown,omitempty"`
+	unknownFields                               protoimpl.UnknownFields
+	sizeCache                                   protoimpl.SizeCache
 }
 
-func (x *FuturesPositionStats) Reset() {
-	*x = FuturesPositionStats{}
-	mi := &file_rpc_proto_msgTypes[172]
+func (x *GetCollateralResponse) Reset() {
+	*x = GetCollateralResponse{}
+	mi := &file_rpc_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturesPositionStats) String() string {
+func (x *GetCollateralResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuturesPositionStats) ProtoMessage() {}
+func (*GetCollateralResponse) ProtoMessage() {}
 
-func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[172]
+func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10649,240 +13705,256 @@ func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
-func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{172}
+// Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
+func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
+func (x *GetCollateralResponse) GetSubAccount() string {
 	if x != nil {
-		return x.MaintenanceMarginRequirement
+		return x.SubAccount
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetInitialMarginRequirement() string {
+func (x *GetCollateralResponse) GetCollateralCurrency() string {
 	if x != nil {
-		return x.InitialMarginRequirement
+		return x.CollateralCurrency
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetEstimatedLiquidationPrice() string {
+func (x *GetCollateralResponse) GetTotalValueOfPositiveSpotBalances() string {
 	if x != nil {
-		return x.EstimatedLiquidationPrice
+		return x.TotalValueOfPositiveSpotBalances
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetCollateralUsed() string {
+func (x *GetCollateralResponse) GetCollateralContributedByPositiveSpotBalances() string {
 	if x != nil {
-		return x.CollateralUsed
+		return x.CollateralContributedByPositiveSpotBalances
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetMarkPrice() string {
+func (x *GetCollateralResponse) GetUsedCollateral() string {
 	if x != nil {
-		return x.MarkPrice
+		return x.UsedCollateral
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetCurrentSize() string {
+func (x *GetCollateralResponse) GetUsedBreakdown() *CollateralUsedBreakdown {
 	if x != nil {
-		return x.CurrentSize
+		return x.UsedBreakdown
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetContractSize() string {
+func (x *GetCollateralResponse) GetAvailableCollateral() string {
 	if x != nil {
-		return x.ContractSize
+		return x.AvailableCollateral
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetContractMultiplier() string {
+func (x *GetCollateralResponse) GetMaintenanceCollateral() string {
 	if x != nil {
-		return x.ContractMultiplier
+		return x.MaintenanceCollateral
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetContractSettlementType() string {
+func (x *GetCollateralResponse) GetUnrealisedPnl() string {
 	if x != nil {
-		return x.ContractSettlementType
+		return x.UnrealisedPnl
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetBreakEvenPrice() string {
+func (x *GetCollateralResponse) GetCurrencyBreakdown() []*CollateralForCurrency {
 	if x != nil {
-		return x.BreakEvenPrice
+		return x.CurrencyBreakdown
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetAverageOpenPrice() string {
+func (x *GetCollateralResponse) GetPositionBreakdown() []*CollateralByPosition {
 	if x != nil {
-		return x.AverageOpenPrice
+		return x.PositionBreakdown
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetRecentPnl() string {
-	if x != nil {
-		return x.RecentPnl
-	}
-	return ""
+type CollateralForCurrency struct {
+	state                       protoimpl.MessageState   `protogen:"open.v1"`
+	Currency                    string                   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExcludedFromCollateral      bool                     `protobuf:"varint,2,opt,name=excluded_from_collateral,json=excludedFromCollateral,proto3" json:"excluded_from_collateral,omitempty"`
+	TotalFunds                  string                   `protobuf:"bytes,3,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
+	AvailableForUseAsCollateral string                   `protobuf:"bytes,4,opt,name=available_for_use_as_collateral,json=availableForUseAsCollateral,proto3" json:"available_for_use_as_collateral,omitempty"`
+	ApproxFairMarketValue       string                   `protobuf:"bytes,5,opt,name=approx_fair_market_value,json=approxFairMarketValue,proto3" json:"approx_fair_market_value,omitempty"`
+	Weighting                   string                   `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting,omitempty"`
+	CollateralContribution      string                   `protobuf:"bytes,7,opt,name=collateral_contribution,json=collateralContribution,proto3" json:"collateral_contribution,omitempty"`
+	ScaledToCurrency            string                   `protobuf:"bytes,8,opt,name=scaled_to_currency,json=scaledToCurrency,proto3" json:"scaled_to_currency,omitempty"`
+	UnrealisedPnl               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	FundsInUse                  string                   `protobuf:"bytes,10,opt,name=funds_in_use,json=fundsInUse,proto3" json:"funds_in_use,omitempty"`
+	AdditionalCollateralUsed    string                   `protobuf:"bytes,11,opt,name=additional_collateral_used,json=additionalCollateralUsed,proto3" json:"additional_collateral_used,omitempty"`
+	UsedBreakdown               *CollateralUsedBreakdown `protobuf:"bytes,12,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
+	Error                       string                   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
 }
 
-func (x *FuturesPositionStats) GetMarginFraction() string {
-	if x != nil {
-		return x.MarginFraction
-	}
-	return ""
+func (x *CollateralForCurrency) Reset() {
+	*x = CollateralForCurrency{}
+	mi := &file_rpc_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturesPositionStats) GetFreeCollateral() string {
+func (x *CollateralForCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollateralForCurrency) ProtoMessage() {}
+
+func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[209]
 	if x != nil {
-		return x.FreeCollateral
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
+func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *FuturesPositionStats) GetTotalCollateral() string {
+func (x *CollateralForCurrency) GetCurrency() string {
 	if x != nil {
-		return x.TotalCollateral
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetFrozenBalance() string {
+func (x *CollateralForCurrency) GetExcludedFromCollateral() bool {
 	if x != nil {
-		return x.FrozenBalance
+		return x.ExcludedFromCollateral
 	}
-	return ""
+	return false
 }
 
-func (x *FuturesPositionStats) GetEquityOfCurrency() string {
+func (x *CollateralForCurrency) GetTotalFunds() string {
 	if x != nil {
-		return x.EquityOfCurrency
+		return x.TotalFunds
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetAvailableEquity() string {
+func (x *CollateralForCurrency) GetAvailableForUseAsCollateral() string {
 	if x != nil {
-		return x.AvailableEquity
+		return x.AvailableForUseAsCollateral
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetCashBalance() string {
+func (x *CollateralForCurrency) GetApproxFairMarketValue() string {
 	if x != nil {
-		return x.CashBalance
+		return x.ApproxFairMarketValue
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetDiscountEquity() string {
+func (x *CollateralForCurrency) GetWeighting() string {
 	if x != nil {
-		return x.DiscountEquity
+		return x.Weighting
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetEquityUsd() string {
+func (x *CollateralForCurrency) GetCollateralContribution() string {
 	if x != nil {
-		return x.EquityUsd
+		return x.CollateralContribution
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetIsolatedEquity() string {
+func (x *CollateralForCurrency) GetScaledToCurrency() string {
 	if x != nil {
-		return x.IsolatedEquity
+		return x.ScaledToCurrency
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetIsolatedLiabilities() string {
+func (x *CollateralForCurrency) GetUnrealisedPnl() string {
 	if x != nil {
-		return x.IsolatedLiabilities
+		return x.UnrealisedPnl
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetIsolatedUpl() string {
+func (x *CollateralForCurrency) GetFundsInUse() string {
 	if x != nil {
-		return x.IsolatedUpl
+		return x.FundsInUse
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetNotionalLeverage() string {
+func (x *CollateralForCurrency) GetAdditionalCollateralUsed() string {
 	if x != nil {
-		return x.NotionalLeverage
+		return x.AdditionalCollateralUsed
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetTotalEquity() string {
+func (x *CollateralForCurrency) GetUsedBreakdown() *CollateralUsedBreakdown {
 	if x != nil {
-		return x.TotalEquity
+		return x.UsedBreakdown
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetStrategyEquity() string {
+func (x *CollateralForCurrency) GetError() string {
 	if x != nil {
-		return x.StrategyEquity
+		return x.Error
 	}
 	return ""
 }
 
-type FuturePosition struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                  string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                   *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Status                 string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	OpeningDate            string                 `protobuf:"bytes,5,opt,name=opening_date,json=openingDate,proto3" json:"opening_date,omitempty"`
-	OpeningDirection       string                 `protobuf:"bytes,6,opt,name=opening_direction,json=openingDirection,proto3" json:"opening_direction,omitempty"`
-	OpeningPrice           string                 `protobuf:"bytes,7,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
-	OpeningSize            string                 `protobuf:"bytes,8,opt,name=opening_size,json=openingSize,proto3" json:"opening_size,omitempty"`
-	CurrentDirection       string                 `protobuf:"bytes,9,opt,name=current_direction,json=currentDirection,proto3" json:"current_direction,omitempty"`
-	CurrentPrice           string                 `protobuf:"bytes,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	CurrentSize            string                 `protobuf:"bytes,11,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
-	UnrealisedPnl          string                 `protobuf:"bytes,12,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	RealisedPnl            string                 `protobuf:"bytes,13,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
-	ClosingDate            string                 `protobuf:"bytes,14,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
-	OrderCount             int64                  `protobuf:"varint,15,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
-	ContractSettlementType string                 `protobuf:"bytes,16,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
-	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
-	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
-	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type CollateralByPosition struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Currency            string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Size                string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	OpenOrderSize       string                 `protobuf:"bytes,3,opt,name=open_order_size,json=openOrderSize,proto3" json:"open_order_size,omitempty"`
+	PositionSize        string                 `protobuf:"bytes,4,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
+	MarkPrice           string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	RequiredMargin      string                 `protobuf:"bytes,6,opt,name=required_margin,json=requiredMargin,proto3" json:"required_margin,omitempty"`
+	TotalCollateralUsed string                 `protobuf:"bytes,7,opt,name=total_collateral_used,json=totalCollateralUsed,proto3" json:"total_collateral_used,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *FuturePosition) Reset() {
-	*x = FuturePosition{}
-	mi := &file_rpc_proto_msgTypes[173]
+func (x *CollateralByPosition) Reset() {
+	*x = CollateralByPosition{}
+	mi := &file_rpc_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturePosition) String() string {
+func (x *CollateralByPosition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuturePosition) ProtoMessage() {}
+func (*CollateralByPosition) ProtoMessage() {}
 
-func (x *FuturePosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[173]
+func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10893,172 +13965,190 @@ func (x *FuturePosition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
-func (*FuturePosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{173}
+// Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
+func (*CollateralByPosition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *FuturePosition) GetExchange() string {
+func (x *CollateralByPosition) GetCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetAsset() string {
+func (x *CollateralByPosition) GetSize() string {
 	if x != nil {
-		return x.Asset
+		return x.Size
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetPair() *CurrencyPair {
+func (x *CollateralByPosition) GetOpenOrderSize() string {
 	if x != nil {
-		return x.Pair
+		return x.OpenOrderSize
 	}
-	return nil
+	return ""
 }
 
-func (x *FuturePosition) GetStatus() string {
+func (x *CollateralByPosition) GetPositionSize() string {
 	if x != nil {
-		return x.Status
+		return x.PositionSize
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningDate() string {
+func (x *CollateralByPosition) GetMarkPrice() string {
 	if x != nil {
-		return x.OpeningDate
+		return x.MarkPrice
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningDirection() string {
+func (x *CollateralByPosition) GetRequiredMargin() string {
 	if x != nil {
-		return x.OpeningDirection
+		return x.RequiredMargin
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningPrice() string {
+func (x *CollateralByPosition) GetTotalCollateralUsed() string {
 	if x != nil {
-		return x.OpeningPrice
+		return x.TotalCollateralUsed
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningSize() string {
-	if x != nil {
-		return x.OpeningSize
-	}
-	return ""
+type CollateralUsedBreakdown struct {
+	state                           protoimpl.MessageState `protogen:"open.v1"`
+	LockedInStakes                  string                 `protobuf:"bytes,1,opt,name=locked_in_stakes,json=lockedInStakes,proto3" json:"locked_in_stakes,omitempty"`
+	LockedInNftBids                 string                 `protobuf:"bytes,2,opt,name=locked_in_nft_bids,json=lockedInNftBids,proto3" json:"locked_in_nft_bids,omitempty"`
+	LockedInFeeVoucher              string                 `protobuf:"bytes,3,opt,name=locked_in_fee_voucher,json=lockedInFeeVoucher,proto3" json:"locked_in_fee_voucher,omitempty"`
+	LockedInSpotMarginFundingOffers string                 `protobuf:"bytes,4,opt,name=locked_in_spot_margin_funding_offers,json=lockedInSpotMarginFundingOffers,proto3" json:"locked_in_spot_margin_funding_offers,omitempty"`
+	LockedInSpotOrders              string                 `protobuf:"bytes,5,opt,name=locked_in_spot_orders,json=lockedInSpotOrders,proto3" json:"locked_in_spot_orders,omitempty"`
+	LockedAsCollateral              string                 `protobuf:"bytes,6,opt,name=locked_as_collateral,json=lockedAsCollateral,proto3" json:"locked_as_collateral,omitempty"`
+	UsedInFutures                   string                 `protobuf:"bytes,7,opt,name=used_in_futures,json=usedInFutures,proto3" json:"used_in_futures,omitempty"`
+	UsedInSpotMargin                string                 `protobuf:"bytes,8,opt,name=used_in_spot_margin,json=usedInSpotMargin,proto3" json:"used_in_spot_margin,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
 }
 
-func (x *FuturePosition) GetCurrentDirection() string {
-	if x != nil {
-		return x.CurrentDirection
-	}
-	return ""
+func (x *CollateralUsedBreakdown) Reset() {
+	*x = CollateralUsedBreakdown{}
+	mi := &file_rpc_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturePosition) GetCurrentPrice() string {
-	if x != nil {
-		return x.CurrentPrice
-	}
-	return ""
+func (x *CollateralUsedBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FuturePosition) GetCurrentSize() string {
+func (*CollateralUsedBreakdown) ProtoMessage() {}
+
+func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[211]
 	if x != nil {
-		return x.CurrentSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturePosition) GetUnrealisedPnl() string {
+// Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
+func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
 	if x != nil {
-		return x.UnrealisedPnl
+		return x.LockedInStakes
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetRealisedPnl() string {
+func (x *CollateralUsedBreakdown) GetLockedInNftBids() string {
 	if x != nil {
-		return x.RealisedPnl
+		return x.LockedInNftBids
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetClosingDate() string {
+func (x *CollateralUsedBreakdown) GetLockedInFeeVoucher() string {
 	if x != nil {
-		return x.ClosingDate
+		return x.LockedInFeeVoucher
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOrderCount() int64 {
+func (x *CollateralUsedBreakdown) GetLockedInSpotMarginFundingOffers() string {
 	if x != nil {
-		return x.OrderCount
+		return x.LockedInSpotMarginFundingOffers
 	}
-	return 0
+	return ""
 }
 
-func (x *FuturePosition) GetContractSettlementType() string {
+func (x *CollateralUsedBreakdown) GetLockedInSpotOrders() string {
 	if x != nil {
-		return x.ContractSettlementType
+		return x.LockedInSpotOrders
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOrders() []*OrderDetails {
+func (x *CollateralUsedBreakdown) GetLockedAsCollateral() string {
 	if x != nil {
-		return x.Orders
+		return x.LockedAsCollateral
 	}
-	return nil
+	return ""
 }
 
-func (x *FuturePosition) GetPositionStats() *FuturesPositionStats {
+func (x *CollateralUsedBreakdown) GetUsedInFutures() string {
 	if x != nil {
-		return x.PositionStats
+		return x.UsedInFutures
 	}
-	return nil
+	return ""
 }
 
-func (x *FuturePosition) GetFundingData() *FundingData {
+func (x *CollateralUsedBreakdown) GetUsedInSpotMargin() string {
 	if x != nil {
-		return x.FundingData
+		return x.UsedInSpotMargin
 	}
-	return nil
+	return ""
 }
 
-type GetManagedPositionRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	IncludeFullOrderData    bool                   `protobuf:"varint,4,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
-	GetFundingPayments      bool                   `protobuf:"varint,5,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
-	IncludeFullFundingRates bool                   `protobuf:"varint,6,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
-	IncludePredictedRate    bool                   `protobuf:"varint,7,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type GetFundingRatesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                 *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate            string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	PaymentCurrency      string                 `protobuf:"bytes,6,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
+	IncludePredicted     bool                   `protobuf:"varint,7,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
+	IncludePayments      bool                   `protobuf:"varint,8,opt,name=include_payments,json=includePayments,proto3" json:"include_payments,omitempty"`
+	RespectHistoryLimits bool                   `protobuf:"varint,9,opt,name=respect_history_limits,json=respectHistoryLimits,proto3" json:"respect_history_limits,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *GetManagedPositionRequest) Reset() {
-	*x = GetManagedPositionRequest{}
-	mi := &file_rpc_proto_msgTypes[174]
+func (x *GetFundingRatesRequest) Reset() {
+	*x = GetFundingRatesRequest{}
+	mi := &file_rpc_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetManagedPositionRequest) String() string {
+func (x *GetFundingRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManagedPositionRequest) ProtoMessage() {}
+func (*GetFundingRatesRequest) ProtoMessage() {}
 
-func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[174]
+func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11069,85 +14159,96 @@ func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
-func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{174}
+// Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *GetManagedPositionRequest) GetExchange() string {
+func (x *GetFundingRatesRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetManagedPositionRequest) GetAsset() string {
+func (x *GetFundingRatesRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetManagedPositionRequest) GetPair() *CurrencyPair {
+func (x *GetFundingRatesRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetManagedPositionRequest) GetIncludeFullOrderData() bool {
+func (x *GetFundingRatesRequest) GetStartDate() string {
 	if x != nil {
-		return x.IncludeFullOrderData
+		return x.StartDate
 	}
-	return false
+	return ""
 }
 
-func (x *GetManagedPositionRequest) GetGetFundingPayments() bool {
+func (x *GetFundingRatesRequest) GetEndDate() string {
 	if x != nil {
-		return x.GetFundingPayments
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetFundingRatesRequest) GetPaymentCurrency() string {
+	if x != nil {
+		return x.PaymentCurrency
+	}
+	return ""
+}
+
+func (x *GetFundingRatesRequest) GetIncludePredicted() bool {
+	if x != nil {
+		return x.IncludePredicted
 	}
 	return false
 }
 
-func (x *GetManagedPositionRequest) GetIncludeFullFundingRates() bool {
+func (x *GetFundingRatesRequest) GetIncludePayments() bool {
 	if x != nil {
-		return x.IncludeFullFundingRates
+		return x.IncludePayments
 	}
 	return false
 }
 
-func (x *GetManagedPositionRequest) GetIncludePredictedRate() bool {
+func (x *GetFundingRatesRequest) GetRespectHistoryLimits() bool {
 	if x != nil {
-		return x.IncludePredictedRate
+		return x.RespectHistoryLimits
 	}
 	return false
 }
 
-type GetAllManagedPositionsRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	IncludeFullOrderData    bool                   `protobuf:"varint,1,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
-	GetFundingPayments      bool                   `protobuf:"varint,2,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
-	IncludeFullFundingRates bool                   `protobuf:"varint,3,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
-	IncludePredictedRate    bool                   `protobuf:"varint,4,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type GetFundingRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         *FundingData           `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllManagedPositionsRequest) Reset() {
-	*x = GetAllManagedPositionsRequest{}
-	mi := &file_rpc_proto_msgTypes[175]
+func (x *GetFundingRatesResponse) Reset() {
+	*x = GetFundingRatesResponse{}
+	mi := &file_rpc_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllManagedPositionsRequest) String() string {
+func (x *GetFundingRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllManagedPositionsRequest) ProtoMessage() {}
+func (*GetFundingRatesResponse) ProtoMessage() {}
 
-func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[175]
+func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11158,61 +14259,108 @@ func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{175}
+// Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
+func (x *GetFundingRatesResponse) GetRates() *FundingData {
 	if x != nil {
-		return x.IncludeFullOrderData
+		return x.Rates
 	}
-	return false
+	return nil
 }
 
-func (x *GetAllManagedPositionsRequest) GetGetFundingPayments() bool {
+type GetLatestFundingRateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset            string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair             *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludePredicted bool                   `protobuf:"varint,4,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetLatestFundingRateRequest) Reset() {
+	*x = GetLatestFundingRateRequest{}
+	mi := &file_rpc_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestFundingRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestFundingRateRequest) ProtoMessage() {}
+
+func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[214]
 	if x != nil {
-		return x.GetFundingPayments
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludeFullFundingRates() bool {
+// Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *GetLatestFundingRateRequest) GetExchange() string {
 	if x != nil {
-		return x.IncludeFullFundingRates
+		return x.Exchange
 	}
-	return false
+	return ""
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludePredictedRate() bool {
+func (x *GetLatestFundingRateRequest) GetAsset() string {
 	if x != nil {
-		return x.IncludePredictedRate
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetLatestFundingRateRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetLatestFundingRateRequest) GetIncludePredicted() bool {
+	if x != nil {
+		return x.IncludePredicted
 	}
 	return false
 }
 
-type GetManagedPositionsResponse struct {
+type GetLatestFundingRateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Positions     []*FuturePosition      `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	Rate          *FundingData           `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetManagedPositionsResponse) Reset() {
-	*x = GetManagedPositionsResponse{}
-	mi := &file_rpc_proto_msgTypes[176]
+func (x *GetLatestFundingRateResponse) Reset() {
+	*x = GetLatestFundingRateResponse{}
+	mi := &file_rpc_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetManagedPositionsResponse) String() string {
+func (x *GetLatestFundingRateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManagedPositionsResponse) ProtoMessage() {}
+func (*GetLatestFundingRateResponse) ProtoMessage() {}
 
-func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[176]
+func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11223,43 +14371,39 @@ func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
-func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{176}
+// Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
+func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
 	if x != nil {
-		return x.Positions
+		return x.Rate
 	}
 	return nil
 }
 
-type GetFuturesPositionsSummaryRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ShutdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsSummaryRequest) Reset() {
-	*x = GetFuturesPositionsSummaryRequest{}
-	mi := &file_rpc_proto_msgTypes[177]
+func (x *ShutdownRequest) Reset() {
+	*x = ShutdownRequest{}
+	mi := &file_rpc_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsSummaryRequest) String() string {
+func (x *ShutdownRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
+func (*ShutdownRequest) ProtoMessage() {}
 
-func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[177]
+func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11270,64 +14414,84 @@ func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{177}
+// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+type ShutdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+func (x *ShutdownResponse) Reset() {
+	*x = ShutdownResponse{}
+	mi := &file_rpc_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetPair() *CurrencyPair {
+func (x *ShutdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShutdownResponse) ProtoMessage() {}
+
+func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[217]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetUnderlyingPair() *CurrencyPair {
-	if x != nil {
-		return x.UnderlyingPair
-	}
-	return nil
+// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
+func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{217}
 }
 
-type GetFuturesPositionsSummaryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	PositionStats *FuturesPositionStats  `protobuf:"bytes,4,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetTechnicalAnalysisRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	AlgorithmType         string                 `protobuf:"bytes,4,opt,name=algorithm_type,json=algorithmType,proto3" json:"algorithm_type,omitempty"`
+	Interval              int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Period                int64                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
+	FastPeriod            int64                  `protobuf:"varint,9,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
+	SlowPeriod            int64                  `protobuf:"varint,10,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
+	StandardDeviationUp   float64                `protobuf:"fixed64,11,opt,name=standard_deviation_up,json=standardDeviationUp,proto3" json:"standard_deviation_up,omitempty"`
+	StandardDeviationDown float64                `protobuf:"fixed64,12,opt,name=standard_deviation_down,json=standardDeviationDown,proto3" json:"standard_deviation_down,omitempty"`
+	MovingAverageType     int64                  `protobuf:"varint,13,opt,name=moving_average_type,json=movingAverageType,proto3" json:"moving_average_type,omitempty"`
+	OtherExchange         string                 `protobuf:"bytes,14,opt,name=other_exchange,json=otherExchange,proto3" json:"other_exchange,omitempty"`
+	OtherPair             *CurrencyPair          `protobuf:"bytes,15,opt,name=other_pair,json=otherPair,proto3" json:"other_pair,omitempty"`
+	OtherAssetType        string                 `protobuf:"bytes,16,opt,name=other_asset_type,json=otherAssetType,proto3" json:"other_asset_type,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsSummaryResponse) Reset() {
-	*x = GetFuturesPositionsSummaryResponse{}
-	mi := &file_rpc_proto_msgTypes[178]
+func (x *GetTechnicalAnalysisRequest) Reset() {
+	*x = GetTechnicalAnalysisRequest{}
+	mi := &file_rpc_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsSummaryResponse) String() string {
+func (x *GetTechnicalAnalysisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
+func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
 
-func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[178]
+func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11338,161 +14502,145 @@ func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{178}
+// Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
+func (x *GetTechnicalAnalysisRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *GetFuturesPositionsSummaryResponse) GetPair() *CurrencyPair {
+func (x *GetTechnicalAnalysisRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetPositionStats() *FuturesPositionStats {
+func (x *GetTechnicalAnalysisRequest) GetAssetType() string {
 	if x != nil {
-		return x.PositionStats
+		return x.AssetType
 	}
-	return nil
+	return ""
 }
 
-type GetFuturesPositionsOrdersRequest struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                  string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                     string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                      *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair            *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	StartDate                 string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                   string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	RespectOrderHistoryLimits bool                   `protobuf:"varint,7,opt,name=respect_order_history_limits,json=respectOrderHistoryLimits,proto3" json:"respect_order_history_limits,omitempty"`
-	SyncWithOrderManager      bool                   `protobuf:"varint,8,opt,name=sync_with_order_manager,json=syncWithOrderManager,proto3" json:"sync_with_order_manager,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+func (x *GetTechnicalAnalysisRequest) GetAlgorithmType() string {
+	if x != nil {
+		return x.AlgorithmType
+	}
+	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) Reset() {
-	*x = GetFuturesPositionsOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[179]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetTechnicalAnalysisRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
 }
 
-func (x *GetFuturesPositionsOrdersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTechnicalAnalysisRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
 }
 
-func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
-
-func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[179]
+func (x *GetTechnicalAnalysisRequest) GetEnd() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.End
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{179}
+func (x *GetTechnicalAnalysisRequest) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
+func (x *GetTechnicalAnalysisRequest) GetFastPeriod() int64 {
 	if x != nil {
-		return x.Exchange
+		return x.FastPeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetAsset() string {
+func (x *GetTechnicalAnalysisRequest) GetSlowPeriod() int64 {
 	if x != nil {
-		return x.Asset
+		return x.SlowPeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetPair() *CurrencyPair {
+func (x *GetTechnicalAnalysisRequest) GetStandardDeviationUp() float64 {
 	if x != nil {
-		return x.Pair
+		return x.StandardDeviationUp
 	}
-	return nil
+	return 0
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetTechnicalAnalysisRequest) GetStandardDeviationDown() float64 {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.StandardDeviationDown
 	}
-	return nil
+	return 0
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetStartDate() string {
+func (x *GetTechnicalAnalysisRequest) GetMovingAverageType() int64 {
 	if x != nil {
-		return x.StartDate
+		return x.MovingAverageType
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetEndDate() string {
+func (x *GetTechnicalAnalysisRequest) GetOtherExchange() string {
 	if x != nil {
-		return x.EndDate
+		return x.OtherExchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetRespectOrderHistoryLimits() bool {
+func (x *GetTechnicalAnalysisRequest) GetOtherPair() *CurrencyPair {
 	if x != nil {
-		return x.RespectOrderHistoryLimits
+		return x.OtherPair
 	}
-	return false
+	return nil
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetSyncWithOrderManager() bool {
+func (x *GetTechnicalAnalysisRequest) GetOtherAssetType() string {
 	if x != nil {
-		return x.SyncWithOrderManager
+		return x.OtherAssetType
 	}
-	return false
+	return ""
 }
 
-type GetFuturesPositionsOrdersResponse struct {
+type ListOfSignals struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Positions     []*FuturePosition      `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
+	Signals       []float64              `protobuf:"fixed64,1,rep,packed,name=signals,proto3" json:"signals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsOrdersResponse) Reset() {
-	*x = GetFuturesPositionsOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[180]
+func (x *ListOfSignals) Reset() {
+	*x = ListOfSignals{}
+	mi := &file_rpc_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsOrdersResponse) String() string {
+func (x *ListOfSignals) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
+func (*ListOfSignals) ProtoMessage() {}
 
-func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[180]
+func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11503,41 +14651,40 @@ func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{180}
+// Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
+func (*ListOfSignals) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
+func (x *ListOfSignals) GetSignals() []float64 {
 	if x != nil {
-		return x.Positions
+		return x.Signals
 	}
 	return nil
 }
 
-type GetCollateralModeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+type GetTechnicalAnalysisResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Signals       map[string]*ListOfSignals `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralModeRequest) Reset() {
-	*x = GetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[181]
+func (x *GetTechnicalAnalysisResponse) Reset() {
+	*x = GetTechnicalAnalysisResponse{}
+	mi := &file_rpc_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralModeRequest) String() string {
+func (x *GetTechnicalAnalysisResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralModeRequest) ProtoMessage() {}
+func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
 
-func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[181]
+func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11548,49 +14695,52 @@ func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
-func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{181}
-}
-
-func (x *GetCollateralModeRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *GetCollateralModeRequest) GetAsset() string {
+func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
 	if x != nil {
-		return x.Asset
+		return x.Signals
 	}
-	return ""
+	return nil
 }
 
-type GetCollateralModeResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetMarginRatesHistoryRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Currency           string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartDate          string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	GetPredictedRate   bool                   `protobuf:"varint,6,opt,name=get_predicted_rate,json=getPredictedRate,proto3" json:"get_predicted_rate,omitempty"`
+	GetLendingPayments bool                   `protobuf:"varint,7,opt,name=get_lending_payments,json=getLendingPayments,proto3" json:"get_lending_payments,omitempty"`
+	GetBorrowRates     bool                   `protobuf:"varint,8,opt,name=get_borrow_rates,json=getBorrowRates,proto3" json:"get_borrow_rates,omitempty"`
+	GetBorrowCosts     bool                   `protobuf:"varint,9,opt,name=get_borrow_costs,json=getBorrowCosts,proto3" json:"get_borrow_costs,omitempty"`
+	IncludeAllRates    bool                   `protobuf:"varint,10,opt,name=include_all_rates,json=includeAllRates,proto3" json:"include_all_rates,omitempty"`
+	CalculateOffline   bool                   `protobuf:"varint,11,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
+	TakerFeeRate       string                 `protobuf:"bytes,12,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
+	Rates              []*MarginRate          `protobuf:"bytes,13,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetCollateralModeResponse) Reset() {
-	*x = GetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[182]
+func (x *GetMarginRatesHistoryRequest) Reset() {
+	*x = GetMarginRatesHistoryRequest{}
+	mi := &file_rpc_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralModeResponse) String() string {
+func (x *GetMarginRatesHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralModeResponse) ProtoMessage() {}
+func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
 
-func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[182]
+func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11601,116 +14751,125 @@ func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
-func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{182}
+// Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *GetCollateralModeResponse) GetExchange() string {
+func (x *GetMarginRatesHistoryRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCollateralModeResponse) GetAsset() string {
+func (x *GetMarginRatesHistoryRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetCollateralModeResponse) GetCollateralMode() string {
+func (x *GetMarginRatesHistoryRequest) GetCurrency() string {
 	if x != nil {
-		return x.CollateralMode
+		return x.Currency
 	}
 	return ""
 }
 
-type SetCollateralModeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetMarginRatesHistoryRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
 }
 
-func (x *SetCollateralModeRequest) Reset() {
-	*x = SetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[183]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetMarginRatesHistoryRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
 }
 
-func (x *SetCollateralModeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetMarginRatesHistoryRequest) GetGetPredictedRate() bool {
+	if x != nil {
+		return x.GetPredictedRate
+	}
+	return false
 }
 
-func (*SetCollateralModeRequest) ProtoMessage() {}
+func (x *GetMarginRatesHistoryRequest) GetGetLendingPayments() bool {
+	if x != nil {
+		return x.GetLendingPayments
+	}
+	return false
+}
 
-func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[183]
+func (x *GetMarginRatesHistoryRequest) GetGetBorrowRates() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.GetBorrowRates
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
-func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{183}
+func (x *GetMarginRatesHistoryRequest) GetGetBorrowCosts() bool {
+	if x != nil {
+		return x.GetBorrowCosts
+	}
+	return false
 }
 
-func (x *SetCollateralModeRequest) GetExchange() string {
+func (x *GetMarginRatesHistoryRequest) GetIncludeAllRates() bool {
 	if x != nil {
-		return x.Exchange
+		return x.IncludeAllRates
 	}
-	return ""
+	return false
 }
 
-func (x *SetCollateralModeRequest) GetAsset() string {
+func (x *GetMarginRatesHistoryRequest) GetCalculateOffline() bool {
 	if x != nil {
-		return x.Asset
+		return x.CalculateOffline
 	}
-	return ""
+	return false
 }
 
-func (x *SetCollateralModeRequest) GetCollateralMode() string {
+func (x *GetMarginRatesHistoryRequest) GetTakerFeeRate() string {
 	if x != nil {
-		return x.CollateralMode
+		return x.TakerFeeRate
 	}
 	return ""
 }
 
-type SetCollateralModeResponse struct {
+func (x *GetMarginRatesHistoryRequest) GetRates() []*MarginRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type LendingPayment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Payment       string                 `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetCollateralModeResponse) Reset() {
-	*x = SetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[184]
+func (x *LendingPayment) Reset() {
+	*x = LendingPayment{}
+	mi := &file_rpc_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetCollateralModeResponse) String() string {
+func (x *LendingPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetCollateralModeResponse) ProtoMessage() {}
+func (*LendingPayment) ProtoMessage() {}
 
-func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[184]
+func (x *LendingPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11721,56 +14880,48 @@ func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
-func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{184}
+// Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
+func (*LendingPayment) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{222}
 }
 
-func (x *SetCollateralModeResponse) GetExchange() string {
+func (x *LendingPayment) GetPayment() string {
 	if x != nil {
-		return x.Exchange
+		return x.Payment
 	}
 	return ""
 }
 
-func (x *SetCollateralModeResponse) GetAsset() string {
+func (x *LendingPayment) GetSize() string {
 	if x != nil {
-		return x.Asset
+		return x.Size
 	}
 	return ""
 }
 
-func (x *SetCollateralModeResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-type GetMarginTypeRequest struct {
+type BorrowCost struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Cost          string                 `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMarginTypeRequest) Reset() {
-	*x = GetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[185]
+func (x *BorrowCost) Reset() {
+	*x = BorrowCost{}
+	mi := &file_rpc_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginTypeRequest) String() string {
+func (x *BorrowCost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginTypeRequest) ProtoMessage() {}
+func (*BorrowCost) ProtoMessage() {}
 
-func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[185]
+func (x *BorrowCost) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11781,128 +14932,155 @@ func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
-func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{185}
+// Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
+func (*BorrowCost) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *GetMarginTypeRequest) GetExchange() string {
+func (x *BorrowCost) GetCost() string {
 	if x != nil {
-		return x.Exchange
+		return x.Cost
 	}
 	return ""
 }
 
-func (x *GetMarginTypeRequest) GetAsset() string {
+func (x *BorrowCost) GetSize() string {
 	if x != nil {
-		return x.Asset
+		return x.Size
 	}
 	return ""
 }
 
-func (x *GetMarginTypeRequest) GetPair() *CurrencyPair {
+type MarginRate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Time             string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	MarketBorrowSize string                 `protobuf:"bytes,2,opt,name=market_borrow_size,json=marketBorrowSize,proto3" json:"market_borrow_size,omitempty"`
+	HourlyRate       string                 `protobuf:"bytes,3,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	YearlyRate       string                 `protobuf:"bytes,4,opt,name=yearly_rate,json=yearlyRate,proto3" json:"yearly_rate,omitempty"`
+	HourlyBorrowRate string                 `protobuf:"bytes,5,opt,name=hourly_borrow_rate,json=hourlyBorrowRate,proto3" json:"hourly_borrow_rate,omitempty"`
+	YearlyBorrowRate string                 `protobuf:"bytes,6,opt,name=yearly_borrow_rate,json=yearlyBorrowRate,proto3" json:"yearly_borrow_rate,omitempty"`
+	LendingPayment   *LendingPayment        `protobuf:"bytes,7,opt,name=lending_payment,json=lendingPayment,proto3" json:"lending_payment,omitempty"`
+	BorrowCost       *BorrowCost            `protobuf:"bytes,8,opt,name=borrow_cost,json=borrowCost,proto3" json:"borrow_cost,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MarginRate) Reset() {
+	*x = MarginRate{}
+	mi := &file_rpc_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarginRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarginRate) ProtoMessage() {}
+
+func (x *MarginRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[224]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type GetMarginTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
+func (*MarginRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *GetMarginTypeResponse) Reset() {
-	*x = GetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[186]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MarginRate) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
 }
 
-func (x *GetMarginTypeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MarginRate) GetMarketBorrowSize() string {
+	if x != nil {
+		return x.MarketBorrowSize
+	}
+	return ""
 }
 
-func (*GetMarginTypeResponse) ProtoMessage() {}
-
-func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[186]
+func (x *MarginRate) GetHourlyRate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.HourlyRate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
-func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{186}
+func (x *MarginRate) GetYearlyRate() string {
+	if x != nil {
+		return x.YearlyRate
+	}
+	return ""
 }
 
-func (x *GetMarginTypeResponse) GetExchange() string {
+func (x *MarginRate) GetHourlyBorrowRate() string {
 	if x != nil {
-		return x.Exchange
+		return x.HourlyBorrowRate
 	}
 	return ""
 }
 
-func (x *GetMarginTypeResponse) GetAsset() string {
+func (x *MarginRate) GetYearlyBorrowRate() string {
 	if x != nil {
-		return x.Asset
+		return x.YearlyBorrowRate
 	}
 	return ""
 }
 
-func (x *GetMarginTypeResponse) GetPair() *CurrencyPair {
+func (x *MarginRate) GetLendingPayment() *LendingPayment {
 	if x != nil {
-		return x.Pair
+		return x.LendingPayment
 	}
 	return nil
 }
 
-func (x *GetMarginTypeResponse) GetMarginType() string {
+func (x *MarginRate) GetBorrowCost() *BorrowCost {
 	if x != nil {
-		return x.MarginType
+		return x.BorrowCost
 	}
-	return ""
+	return nil
 }
 
-type ChangePositionMarginRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType              string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OriginalAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=original_allocated_margin,json=originalAllocatedMargin,proto3" json:"original_allocated_margin,omitempty"`
-	NewAllocatedMargin      float64                `protobuf:"fixed64,6,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
-	MarginSide              string                 `protobuf:"bytes,7,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type GetMarginRatesHistoryResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Rates              []*MarginRate          `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	TotalRates         int64                  `protobuf:"varint,2,opt,name=total_rates,json=totalRates,proto3" json:"total_rates,omitempty"`
+	SumBorrowCosts     string                 `protobuf:"bytes,3,opt,name=sum_borrow_costs,json=sumBorrowCosts,proto3" json:"sum_borrow_costs,omitempty"`
+	AvgBorrowSize      string                 `protobuf:"bytes,4,opt,name=avg_borrow_size,json=avgBorrowSize,proto3" json:"avg_borrow_size,omitempty"`
+	SumLendingPayments string                 `protobuf:"bytes,5,opt,name=sum_lending_payments,json=sumLendingPayments,proto3" json:"sum_lending_payments,omitempty"`
+	AvgLendingSize     string                 `protobuf:"bytes,6,opt,name=avg_lending_size,json=avgLendingSize,proto3" json:"avg_lending_size,omitempty"`
+	LatestRate         *MarginRate            `protobuf:"bytes,7,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
+	PredictedRate      *MarginRate            `protobuf:"bytes,8,opt,name=predicted_rate,json=predictedRate,proto3" json:"predicted_rate,omitempty"`
+	TakerFeeRate       string                 `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ChangePositionMarginRequest) Reset() {
-	*x = ChangePositionMarginRequest{}
-	mi := &file_rpc_proto_msgTypes[187]
+func (x *GetMarginRatesHistoryResponse) Reset() {
+	*x = GetMarginRatesHistoryResponse{}
+	mi := &file_rpc_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePositionMarginRequest) String() string {
+func (x *GetMarginRatesHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePositionMarginRequest) ProtoMessage() {}
+func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
 
-func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[187]
+func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11913,87 +15091,102 @@ func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
-func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{187}
+// Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *ChangePositionMarginRequest) GetExchange() string {
+func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
 	if x != nil {
-		return x.Exchange
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *GetMarginRatesHistoryResponse) GetTotalRates() int64 {
+	if x != nil {
+		return x.TotalRates
+	}
+	return 0
+}
+
+func (x *GetMarginRatesHistoryResponse) GetSumBorrowCosts() string {
+	if x != nil {
+		return x.SumBorrowCosts
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetAsset() string {
+func (x *GetMarginRatesHistoryResponse) GetAvgBorrowSize() string {
 	if x != nil {
-		return x.Asset
+		return x.AvgBorrowSize
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetPair() *CurrencyPair {
+func (x *GetMarginRatesHistoryResponse) GetSumLendingPayments() string {
 	if x != nil {
-		return x.Pair
+		return x.SumLendingPayments
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetMarginType() string {
+func (x *GetMarginRatesHistoryResponse) GetAvgLendingSize() string {
 	if x != nil {
-		return x.MarginType
+		return x.AvgLendingSize
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetOriginalAllocatedMargin() float64 {
+func (x *GetMarginRatesHistoryResponse) GetLatestRate() *MarginRate {
 	if x != nil {
-		return x.OriginalAllocatedMargin
+		return x.LatestRate
 	}
-	return 0
+	return nil
 }
 
-func (x *ChangePositionMarginRequest) GetNewAllocatedMargin() float64 {
+func (x *GetMarginRatesHistoryResponse) GetPredictedRate() *MarginRate {
 	if x != nil {
-		return x.NewAllocatedMargin
+		return x.PredictedRate
 	}
-	return 0
+	return nil
 }
 
-func (x *ChangePositionMarginRequest) GetMarginSide() string {
+func (x *GetMarginRatesHistoryResponse) GetTakerFeeRate() string {
 	if x != nil {
-		return x.MarginSide
+		return x.TakerFeeRate
 	}
 	return ""
 }
 
-type ChangePositionMarginResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair               *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType         string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	NewAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
-	MarginSide         string                 `protobuf:"bytes,6,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetOrderbookMovementRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Amount                float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	Purchase              bool                   `protobuf:"varint,9,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *ChangePositionMarginResponse) Reset() {
-	*x = ChangePositionMarginResponse{}
-	mi := &file_rpc_proto_msgTypes[188]
+func (x *GetOrderbookMovementRequest) Reset() {
+	*x = GetOrderbookMovementRequest{}
+	mi := &file_rpc_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePositionMarginResponse) String() string {
+func (x *GetOrderbookMovementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePositionMarginResponse) ProtoMessage() {}
+func (*GetOrderbookMovementRequest) ProtoMessage() {}
 
-func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[188]
+func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12004,78 +15197,95 @@ func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
-func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{188}
+// Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{226}
 }
 
-func (x *ChangePositionMarginResponse) GetExchange() string {
+func (x *GetOrderbookMovementRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetAsset() string {
+func (x *GetOrderbookMovementRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookMovementRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *ChangePositionMarginResponse) GetMarginType() string {
+func (x *GetOrderbookMovementRequest) GetAmount() float64 {
 	if x != nil {
-		return x.MarginType
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangePositionMarginResponse) GetNewAllocatedMargin() float64 {
+func (x *GetOrderbookMovementRequest) GetSell() bool {
 	if x != nil {
-		return x.NewAllocatedMargin
+		return x.Sell
 	}
-	return 0
+	return false
 }
 
-func (x *ChangePositionMarginResponse) GetMarginSide() string {
+func (x *GetOrderbookMovementRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.MarginSide
+		return x.RequiresRestOrderbook
 	}
-	return ""
+	return false
 }
 
-type SetMarginTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetOrderbookMovementRequest) GetPurchase() bool {
+	if x != nil {
+		return x.Purchase
+	}
+	return false
 }
 
-func (x *SetMarginTypeRequest) Reset() {
-	*x = SetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[189]
+type GetOrderbookMovementResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	NominalPercentage         float64                `protobuf:"fixed64,1,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
+	ImpactPercentage          float64                `protobuf:"fixed64,2,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
+	SlippageCost              float64                `protobuf:"fixed64,3,opt,name=slippage_cost,json=slippageCost,proto3" json:"slippage_cost,omitempty"`
+	CurrencyBought            string                 `protobuf:"bytes,4,opt,name=currency_bought,json=currencyBought,proto3" json:"currency_bought,omitempty"`
+	Bought                    float64                `protobuf:"fixed64,5,opt,name=bought,proto3" json:"bought,omitempty"`
+	CurrencySold              string                 `protobuf:"bytes,6,opt,name=currency_sold,json=currencySold,proto3" json:"currency_sold,omitempty"`
+	Sold                      float64                `protobuf:"fixed64,7,opt,name=sold,proto3" json:"sold,omitempty"`
+	SideAffected              string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	UpdateProtocol            string                 `protobuf:"bytes,9,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed bool                   `protobuf:"varint,10,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	StartPrice                float64                `protobuf:"fixed64,11,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                  float64                `protobuf:"fixed64,12,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	NoSlippageOccurred        bool                   `protobuf:"varint,13,opt,name=no_slippage_occurred,json=noSlippageOccurred,proto3" json:"no_slippage_occurred,omitempty"`
+	AverageOrderCost          float64                `protobuf:"fixed64,14,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetOrderbookMovementResponse) Reset() {
+	*x = GetOrderbookMovementResponse{}
+	mi := &file_rpc_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMarginTypeRequest) String() string {
+func (x *GetOrderbookMovementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMarginTypeRequest) ProtoMessage() {}
+func (*GetOrderbookMovementResponse) ProtoMessage() {}
 
-func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[189]
+func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12086,134 +15296,136 @@ func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
-func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{189}
+// Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
+	if x != nil {
+		return x.NominalPercentage
+	}
+	return 0
+}
+
+func (x *GetOrderbookMovementResponse) GetImpactPercentage() float64 {
+	if x != nil {
+		return x.ImpactPercentage
+	}
+	return 0
 }
 
-func (x *SetMarginTypeRequest) GetExchange() string {
+func (x *GetOrderbookMovementResponse) GetSlippageCost() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.SlippageCost
 	}
-	return ""
+	return 0
 }
 
-func (x *SetMarginTypeRequest) GetAsset() string {
+func (x *GetOrderbookMovementResponse) GetCurrencyBought() string {
 	if x != nil {
-		return x.Asset
+		return x.CurrencyBought
 	}
 	return ""
 }
 
-func (x *SetMarginTypeRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookMovementResponse) GetBought() float64 {
 	if x != nil {
-		return x.Pair
+		return x.Bought
 	}
-	return nil
+	return 0
 }
 
-func (x *SetMarginTypeRequest) GetMarginType() string {
+func (x *GetOrderbookMovementResponse) GetCurrencySold() string {
 	if x != nil {
-		return x.MarginType
+		return x.CurrencySold
 	}
 	return ""
 }
 
-type SetMarginTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetMarginTypeResponse) Reset() {
-	*x = SetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[190]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetOrderbookMovementResponse) GetSold() float64 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
 }
 
-func (x *SetMarginTypeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrderbookMovementResponse) GetSideAffected() string {
+	if x != nil {
+		return x.SideAffected
+	}
+	return ""
 }
 
-func (*SetMarginTypeResponse) ProtoMessage() {}
-
-func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[190]
+func (x *GetOrderbookMovementResponse) GetUpdateProtocol() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UpdateProtocol
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
-func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{190}
+func (x *GetOrderbookMovementResponse) GetFullOrderbookSideConsumed() bool {
+	if x != nil {
+		return x.FullOrderbookSideConsumed
+	}
+	return false
 }
 
-func (x *SetMarginTypeResponse) GetExchange() string {
+func (x *GetOrderbookMovementResponse) GetStartPrice() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.StartPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *SetMarginTypeResponse) GetAsset() string {
+func (x *GetOrderbookMovementResponse) GetEndPrice() float64 {
 	if x != nil {
-		return x.Asset
+		return x.EndPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *SetMarginTypeResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookMovementResponse) GetNoSlippageOccurred() bool {
 	if x != nil {
-		return x.Pair
+		return x.NoSlippageOccurred
 	}
-	return nil
+	return false
 }
 
-func (x *SetMarginTypeResponse) GetSuccess() bool {
+func (x *GetOrderbookMovementResponse) GetAverageOrderCost() float64 {
 	if x != nil {
-		return x.Success
+		return x.AverageOrderCost
 	}
-	return false
+	return 0
 }
 
-type GetLeverageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetOrderbookAmountByNominalRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	NominalPercentage     float64                `protobuf:"fixed64,6,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetLeverageRequest) Reset() {
-	*x = GetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[191]
+func (x *GetOrderbookAmountByNominalRequest) Reset() {
+	*x = GetOrderbookAmountByNominalRequest{}
+	mi := &file_rpc_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeverageRequest) String() string {
+func (x *GetOrderbookAmountByNominalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeverageRequest) ProtoMessage() {}
+func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
 
-func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[191]
+func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12224,81 +15436,85 @@ func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
-func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{191}
+// Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *GetLeverageRequest) GetExchange() string {
+func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetLeverageRequest) GetAsset() string {
+func (x *GetOrderbookAmountByNominalRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetLeverageRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalRequest) GetNominalPercentage() float64 {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.NominalPercentage
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLeverageRequest) GetMarginType() string {
+func (x *GetOrderbookAmountByNominalRequest) GetSell() bool {
 	if x != nil {
-		return x.MarginType
+		return x.Sell
 	}
-	return ""
+	return false
 }
 
-func (x *GetLeverageRequest) GetOrderSide() string {
+func (x *GetOrderbookAmountByNominalRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.OrderSide
+		return x.RequiresRestOrderbook
 	}
-	return ""
+	return false
 }
 
-type GetLeverageResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetOrderbookAmountByNominalResponse struct {
+	state                                protoimpl.MessageState `protogen:"open.v1"`
+	AmountRequired                       float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
+	CurrencySelling                      string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
+	AmountReceived                       float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	CurrencyBuying                       string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
+	StartPrice                           float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                             float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	AverageOrderCost                     float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	SideAffected                         string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	ApproximateNominalSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_nominal_slippage_percentage,json=approximateNominalSlippagePercentage,proto3" json:"approximate_nominal_slippage_percentage,omitempty"`
+	UpdateProtocol                       string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed            bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	unknownFields                        protoimpl.UnknownFields
+	sizeCache                            protoimpl.SizeCache
 }
 
-func (x *GetLeverageResponse) Reset() {
-	*x = GetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[192]
+func (x *GetOrderbookAmountByNominalResponse) Reset() {
+	*x = GetOrderbookAmountByNominalResponse{}
+	mi := &file_rpc_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeverageResponse) String() string {
+func (x *GetOrderbookAmountByNominalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeverageResponse) ProtoMessage() {}
+func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
 
-func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[192]
+func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12309,88 +15525,115 @@ func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
-func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{192}
+// Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *GetLeverageResponse) GetExchange() string {
+func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.AmountRequired
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByNominalResponse) GetCurrencySelling() string {
+	if x != nil {
+		return x.CurrencySelling
 	}
 	return ""
 }
 
-func (x *GetLeverageResponse) GetAsset() string {
+func (x *GetOrderbookAmountByNominalResponse) GetAmountReceived() float64 {
 	if x != nil {
-		return x.Asset
+		return x.AmountReceived
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByNominalResponse) GetCurrencyBuying() string {
+	if x != nil {
+		return x.CurrencyBuying
 	}
 	return ""
 }
 
-func (x *GetLeverageResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalResponse) GetStartPrice() float64 {
 	if x != nil {
-		return x.Pair
+		return x.StartPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalResponse) GetEndPrice() float64 {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.EndPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLeverageResponse) GetMarginType() string {
+func (x *GetOrderbookAmountByNominalResponse) GetAverageOrderCost() float64 {
 	if x != nil {
-		return x.MarginType
+		return x.AverageOrderCost
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByNominalResponse) GetSideAffected() string {
+	if x != nil {
+		return x.SideAffected
 	}
 	return ""
 }
 
-func (x *GetLeverageResponse) GetLeverage() float64 {
+func (x *GetOrderbookAmountByNominalResponse) GetApproximateNominalSlippagePercentage() float64 {
 	if x != nil {
-		return x.Leverage
+		return x.ApproximateNominalSlippagePercentage
 	}
 	return 0
 }
 
-func (x *GetLeverageResponse) GetOrderSide() string {
+func (x *GetOrderbookAmountByNominalResponse) GetUpdateProtocol() string {
+	if x != nil {
+		return x.UpdateProtocol
+	}
+	return ""
+}
+
+func (x *GetOrderbookAmountByNominalResponse) GetFullOrderbookSideConsumed() bool {
 	if x != nil {
-		return x.OrderSide
+		return x.FullOrderbookSideConsumed
 	}
-	return ""
+	return false
 }
 
-type SetLeverageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetOrderbookAmountByImpactRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	ImpactPercentage      float64                `protobuf:"fixed64,6,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *SetLeverageRequest) Reset() {
-	*x = SetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[193]
+func (x *GetOrderbookAmountByImpactRequest) Reset() {
+	*x = GetOrderbookAmountByImpactRequest{}
+	mi := &file_rpc_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLeverageRequest) String() string {
+func (x *GetOrderbookAmountByImpactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLeverageRequest) ProtoMessage() {}
+func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
 
-func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[193]
+func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12401,88 +15644,85 @@ func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
-func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{193}
+// Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *SetLeverageRequest) GetExchange() string {
+func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetLeverageRequest) GetAsset() string {
+func (x *GetOrderbookAmountByImpactRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *SetLeverageRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByImpactRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *SetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
-	if x != nil {
-		return x.UnderlyingPair
-	}
-	return nil
-}
-
-func (x *SetLeverageRequest) GetMarginType() string {
+func (x *GetOrderbookAmountByImpactRequest) GetImpactPercentage() float64 {
 	if x != nil {
-		return x.MarginType
+		return x.ImpactPercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *SetLeverageRequest) GetLeverage() float64 {
+func (x *GetOrderbookAmountByImpactRequest) GetSell() bool {
 	if x != nil {
-		return x.Leverage
+		return x.Sell
 	}
-	return 0
+	return false
 }
 
-func (x *SetLeverageRequest) GetOrderSide() string {
+func (x *GetOrderbookAmountByImpactRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.OrderSide
+		return x.RequiresRestOrderbook
 	}
-	return ""
+	return false
 }
 
-type SetLeverageResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	Success        bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetOrderbookAmountByImpactResponse struct {
+	state                               protoimpl.MessageState `protogen:"open.v1"`
+	AmountRequired                      float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
+	CurrencySelling                     string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
+	AmountReceived                      float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	CurrencyBuying                      string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
+	StartPrice                          float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                            float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	AverageOrderCost                    float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	SideAffected                        string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	ApproximateImpactSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_impact_slippage_percentage,json=approximateImpactSlippagePercentage,proto3" json:"approximate_impact_slippage_percentage,omitempty"`
+	UpdateProtocol                      string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed           bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
 }
 
-func (x *SetLeverageResponse) Reset() {
-	*x = SetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[194]
+func (x *GetOrderbookAmountByImpactResponse) Reset() {
+	*x = GetOrderbookAmountByImpactResponse{}
+	mi := &file_rpc_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLeverageResponse) String() string {
+func (x *GetOrderbookAmountByImpactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLeverageResponse) ProtoMessage() {}
+func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
 
-func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[194]
+func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12493,86 +15733,111 @@ func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
-func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{194}
+// Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{231}
 }
 
-func (x *SetLeverageResponse) GetExchange() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.AmountRequired
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByImpactResponse) GetCurrencySelling() string {
+	if x != nil {
+		return x.CurrencySelling
 	}
 	return ""
 }
 
-func (x *SetLeverageResponse) GetAsset() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAmountReceived() float64 {
 	if x != nil {
-		return x.Asset
+		return x.AmountReceived
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByImpactResponse) GetCurrencyBuying() string {
+	if x != nil {
+		return x.CurrencyBuying
 	}
 	return ""
 }
 
-func (x *SetLeverageResponse) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByImpactResponse) GetStartPrice() float64 {
 	if x != nil {
-		return x.Pair
+		return x.StartPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *SetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+func (x *GetOrderbookAmountByImpactResponse) GetEndPrice() float64 {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.EndPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *SetLeverageResponse) GetMarginType() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAverageOrderCost() float64 {
 	if x != nil {
-		return x.MarginType
+		return x.AverageOrderCost
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByImpactResponse) GetSideAffected() string {
+	if x != nil {
+		return x.SideAffected
 	}
 	return ""
 }
 
-func (x *SetLeverageResponse) GetOrderSide() string {
+func (x *GetOrderbookAmountByImpactResponse) GetApproximateImpactSlippagePercentage() float64 {
 	if x != nil {
-		return x.OrderSide
+		return x.ApproximateImpactSlippagePercentage
+	}
+	return 0
+}
+
+func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
+	if x != nil {
+		return x.UpdateProtocol
 	}
 	return ""
 }
 
-func (x *SetLeverageResponse) GetSuccess() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
 	if x != nil {
-		return x.Success
+		return x.FullOrderbookSideConsumed
 	}
 	return false
 }
 
-type GetCollateralRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset             string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	IncludeBreakdown  bool                   `protobuf:"varint,3,opt,name=include_breakdown,json=includeBreakdown,proto3" json:"include_breakdown,omitempty"`
-	CalculateOffline  bool                   `protobuf:"varint,4,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
-	IncludeZeroValues bool                   `protobuf:"varint,5,opt,name=include_zero_values,json=includeZeroValues,proto3" json:"include_zero_values,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type GetOpenInterestRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralRequest) Reset() {
-	*x = GetCollateralRequest{}
-	mi := &file_rpc_proto_msgTypes[195]
+func (x *GetOpenInterestRequest) Reset() {
+	*x = GetOpenInterestRequest{}
+	mi := &file_rpc_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralRequest) String() string {
+func (x *GetOpenInterestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralRequest) ProtoMessage() {}
+func (*GetOpenInterestRequest) ProtoMessage() {}
 
-func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[195]
+func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12583,78 +15848,48 @@ func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
-func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{195}
+// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *GetCollateralRequest) GetExchange() string {
+func (x *GetOpenInterestRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCollateralRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *GetCollateralRequest) GetIncludeBreakdown() bool {
-	if x != nil {
-		return x.IncludeBreakdown
-	}
-	return false
-}
-
-func (x *GetCollateralRequest) GetCalculateOffline() bool {
-	if x != nil {
-		return x.CalculateOffline
-	}
-	return false
-}
-
-func (x *GetCollateralRequest) GetIncludeZeroValues() bool {
+func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
 	if x != nil {
-		return x.IncludeZeroValues
+		return x.Data
 	}
-	return false
-}
-
-type GetCollateralResponse struct {
-	state                                       protoimpl.MessageState   `protogen:"open.v1"`
-	SubAccount                                  string                   `protobuf:"bytes,1,opt,name=sub_account,json=subAccount,proto3" json:"sub_account,omitempty"`
-	CollateralCurrency                          string                   `protobuf:"bytes,2,opt,name=collateral_currency,json=collateralCurrency,proto3" json:"collateral_currency,omitempty"`
-	TotalValueOfPositiveSpotBalances            string                   `protobuf:"bytes,3,opt,name=total_value_of_positive_spot_balances,json=totalValueOfPositiveSpotBalances,proto3" json:"total_value_of_positive_spot_balances,omitempty"`
-	CollateralContributedByPositiveSpotBalances string                   `protobuf:"bytes,4,opt,name=collateral_contributed_by_positive_spot_balances,json=collateralContributedByPositiveSpotBalances,proto3" json:"collateral_contributed_by_positive_spot_balances,omitempty"`
-	UsedCollateral                              string                   `protobuf:"bytes,5,opt,name=used_collateral,json=usedCollateral,proto3" json:"used_collateral,omitempty"`
-	UsedBreakdown                               *CollateralUsedBreakdown `protobuf:"bytes,6,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
-	AvailableCollateral                         string                   `protobuf:"bytes,7,opt,name=available_collateral,json=availableCollateral,proto3" json:"available_collateral,omitempty"`
-	MaintenanceCollateral                       string                   `protobuf:"bytes,8,opt,name=maintenance_collateral,json=maintenanceCollateral,proto3" json:"maintenance_collateral,omitempty"`
-	UnrealisedPnl                               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	CurrencyBreakdown                           []*CollateralForCurrency `protobuf:"bytes,10,rep,name=currency_breakdown,json=currencyBreakdown,proto3" json:"currency_breakdown,omitempty"`
-	PositionBreakdown                           []*CollateralByPosition  `protobuf:"bytes,11,rep,name=position_breakdown,json=positionBreakdown,proto3" json:"position_breakdown,omitempty"`
-	unknownFields                               protoimpl.UnknownFields
-	sizeCache                                   protoimpl.SizeCache
+	return nil
 }
 
-func (x *GetCollateralResponse) Reset() {
-	*x = GetCollateralResponse{}
-	mi := &file_rpc_proto_msgTypes[196]
+type OpenInterestDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenInterestDataRequest) Reset() {
+	*x = OpenInterestDataRequest{}
+	mi := &file_rpc_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralResponse) String() string {
+func (x *OpenInterestDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralResponse) ProtoMessage() {}
+func (*OpenInterestDataRequest) ProtoMessage() {}
 
-func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[196]
+func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12665,122 +15900,94 @@ func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
-func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{196}
-}
-
-func (x *GetCollateralResponse) GetSubAccount() string {
-	if x != nil {
-		return x.SubAccount
-	}
-	return ""
-}
-
-func (x *GetCollateralResponse) GetCollateralCurrency() string {
-	if x != nil {
-		return x.CollateralCurrency
-	}
-	return ""
+// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *GetCollateralResponse) GetTotalValueOfPositiveSpotBalances() string {
+func (x *OpenInterestDataRequest) GetAsset() string {
 	if x != nil {
-		return x.TotalValueOfPositiveSpotBalances
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetCollateralResponse) GetCollateralContributedByPositiveSpotBalances() string {
+func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.CollateralContributedByPositiveSpotBalances
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCollateralResponse) GetUsedCollateral() string {
-	if x != nil {
-		return x.UsedCollateral
-	}
-	return ""
+type GetOpenInterestResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralResponse) GetUsedBreakdown() *CollateralUsedBreakdown {
-	if x != nil {
-		return x.UsedBreakdown
-	}
-	return nil
+func (x *GetOpenInterestResponse) Reset() {
+	*x = GetOpenInterestResponse{}
+	mi := &file_rpc_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralResponse) GetAvailableCollateral() string {
-	if x != nil {
-		return x.AvailableCollateral
-	}
-	return ""
+func (x *GetOpenInterestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetCollateralResponse) GetMaintenanceCollateral() string {
-	if x != nil {
-		return x.MaintenanceCollateral
-	}
-	return ""
-}
+func (*GetOpenInterestResponse) ProtoMessage() {}
 
-func (x *GetCollateralResponse) GetUnrealisedPnl() string {
+func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[234]
 	if x != nil {
-		return x.UnrealisedPnl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetCollateralResponse) GetCurrencyBreakdown() []*CollateralForCurrency {
-	if x != nil {
-		return x.CurrencyBreakdown
-	}
-	return nil
+// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *GetCollateralResponse) GetPositionBreakdown() []*CollateralByPosition {
+func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
 	if x != nil {
-		return x.PositionBreakdown
+		return x.Data
 	}
 	return nil
 }
 
-type CollateralForCurrency struct {
-	state                       protoimpl.MessageState   `protogen:"open.v1"`
-	Currency                    string                   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	ExcludedFromCollateral      bool                     `protobuf:"varint,2,opt,name=excluded_from_collateral,json=excludedFromCollateral,proto3" json:"excluded_from_collateral,omitempty"`
-	TotalFunds                  string                   `protobuf:"bytes,3,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
-	AvailableForUseAsCollateral string                   `protobuf:"bytes,4,opt,name=available_for_use_as_collateral,json=availableForUseAsCollateral,proto3" json:"available_for_use_as_collateral,omitempty"`
-	ApproxFairMarketValue       string                   `protobuf:"bytes,5,opt,name=approx_fair_market_value,json=approxFairMarketValue,proto3" json:"approx_fair_market_value,omitempty"`
-	Weighting                   string                   `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting,omitempty"`
-	CollateralContribution      string                   `protobuf:"bytes,7,opt,name=collateral_contribution,json=collateralContribution,proto3" json:"collateral_contribution,omitempty"`
-	ScaledToCurrency            string                   `protobuf:"bytes,8,opt,name=scaled_to_currency,json=scaledToCurrency,proto3" json:"scaled_to_currency,omitempty"`
-	UnrealisedPnl               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	FundsInUse                  string                   `protobuf:"bytes,10,opt,name=funds_in_use,json=fundsInUse,proto3" json:"funds_in_use,omitempty"`
-	AdditionalCollateralUsed    string                   `protobuf:"bytes,11,opt,name=additional_collateral_used,json=additionalCollateralUsed,proto3" json:"additional_collateral_used,omitempty"`
-	UsedBreakdown               *CollateralUsedBreakdown `protobuf:"bytes,12,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
-	Error                       string                   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type OpenInterestDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollateralForCurrency) Reset() {
-	*x = CollateralForCurrency{}
-	mi := &file_rpc_proto_msgTypes[197]
+func (x *OpenInterestDataResponse) Reset() {
+	*x = OpenInterestDataResponse{}
+	mi := &file_rpc_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralForCurrency) String() string {
+func (x *OpenInterestDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralForCurrency) ProtoMessage() {}
+func (*OpenInterestDataResponse) ProtoMessage() {}
 
-func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[197]
+func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12791,130 +15998,171 @@ func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
-func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{197}
+// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{235}
 }
 
-func (x *CollateralForCurrency) GetCurrency() string {
+func (x *OpenInterestDataResponse) GetExchange() string {
 	if x != nil {
-		return x.Currency
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetExcludedFromCollateral() bool {
+func (x *OpenInterestDataResponse) GetAsset() string {
 	if x != nil {
-		return x.ExcludedFromCollateral
+		return x.Asset
 	}
-	return false
+	return ""
 }
 
-func (x *CollateralForCurrency) GetTotalFunds() string {
+func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.TotalFunds
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *CollateralForCurrency) GetAvailableForUseAsCollateral() string {
+func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
 	if x != nil {
-		return x.AvailableForUseAsCollateral
+		return x.OpenInterest
 	}
-	return ""
+	return 0
 }
 
-func (x *CollateralForCurrency) GetApproxFairMarketValue() string {
-	if x != nil {
-		return x.ApproxFairMarketValue
-	}
-	return ""
+type GetCurrencyTradeURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollateralForCurrency) GetWeighting() string {
-	if x != nil {
-		return x.Weighting
-	}
-	return ""
+func (x *GetCurrencyTradeURLRequest) Reset() {
+	*x = GetCurrencyTradeURLRequest{}
+	mi := &file_rpc_proto_msgTypes[236]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralForCurrency) GetCollateralContribution() string {
-	if x != nil {
-		return x.CollateralContribution
-	}
-	return ""
+func (x *GetCurrencyTradeURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CollateralForCurrency) GetScaledToCurrency() string {
+func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
+
+func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[236]
 	if x != nil {
-		return x.ScaledToCurrency
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CollateralForCurrency) GetUnrealisedPnl() string {
+// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *GetCurrencyTradeURLRequest) GetExchange() string {
 	if x != nil {
-		return x.UnrealisedPnl
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetFundsInUse() string {
+func (x *GetCurrencyTradeURLRequest) GetAsset() string {
 	if x != nil {
-		return x.FundsInUse
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetAdditionalCollateralUsed() string {
+func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.AdditionalCollateralUsed
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *CollateralForCurrency) GetUsedBreakdown() *CollateralUsedBreakdown {
+type GetCurrencyTradeURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyTradeURLResponse) Reset() {
+	*x = GetCurrencyTradeURLResponse{}
+	mi := &file_rpc_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCurrencyTradeURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
+
+func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[237]
 	if x != nil {
-		return x.UsedBreakdown
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{237}
 }
 
-func (x *CollateralForCurrency) GetError() string {
+func (x *GetCurrencyTradeURLResponse) GetUrl() string {
 	if x != nil {
-		return x.Error
+		return x.Url
 	}
 	return ""
 }
 
-type CollateralByPosition struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Currency            string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Size                string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
-	OpenOrderSize       string                 `protobuf:"bytes,3,opt,name=open_order_size,json=openOrderSize,proto3" json:"open_order_size,omitempty"`
-	PositionSize        string                 `protobuf:"bytes,4,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
-	MarkPrice           string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
-	RequiredMargin      string                 `protobuf:"bytes,6,opt,name=required_margin,json=requiredMargin,proto3" json:"required_margin,omitempty"`
-	TotalCollateralUsed string                 `protobuf:"bytes,7,opt,name=total_collateral_used,json=totalCollateralUsed,proto3" json:"total_collateral_used,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type GetTransactionCostAnalysisRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Strategy       string                 `protobuf:"bytes,4,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	StartDate      string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	GenerateReport bool                   `protobuf:"varint,7,opt,name=generate_report,json=generateReport,proto3" json:"generate_report,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CollateralByPosition) Reset() {
-	*x = CollateralByPosition{}
-	mi := &file_rpc_proto_msgTypes[198]
+func (x *GetTransactionCostAnalysisRequest) Reset() {
+	*x = GetTransactionCostAnalysisRequest{}
+	mi := &file_rpc_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralByPosition) String() string {
+func (x *GetTransactionCostAnalysisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralByPosition) ProtoMessage() {}
+func (*GetTransactionCostAnalysisRequest) ProtoMessage() {}
 
-func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[198]
+func (x *GetTransactionCostAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12925,89 +16173,103 @@ func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
-func (*CollateralByPosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{198}
+// Deprecated: Use GetTransactionCostAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionCostAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *CollateralByPosition) GetCurrency() string {
+func (x *GetTransactionCostAnalysisRequest) GetExchange() string {
 	if x != nil {
-		return x.Currency
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetSize() string {
+func (x *GetTransactionCostAnalysisRequest) GetAsset() string {
 	if x != nil {
-		return x.Size
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetOpenOrderSize() string {
+func (x *GetTransactionCostAnalysisRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.OpenOrderSize
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *CollateralByPosition) GetPositionSize() string {
+func (x *GetTransactionCostAnalysisRequest) GetStrategy() string {
 	if x != nil {
-		return x.PositionSize
+		return x.Strategy
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetMarkPrice() string {
+func (x *GetTransactionCostAnalysisRequest) GetStartDate() string {
 	if x != nil {
-		return x.MarkPrice
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetRequiredMargin() string {
+func (x *GetTransactionCostAnalysisRequest) GetEndDate() string {
 	if x != nil {
-		return x.RequiredMargin
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetTotalCollateralUsed() string {
+func (x *GetTransactionCostAnalysisRequest) GetGenerateReport() bool {
 	if x != nil {
-		return x.TotalCollateralUsed
+		return x.GenerateReport
 	}
-	return ""
-}
-
-type CollateralUsedBreakdown struct {
-	state                           protoimpl.MessageState `protogen:"open.v1"`
-	LockedInStakes                  string                 `protobuf:"bytes,1,opt,name=locked_in_stakes,json=lockedInStakes,proto3" json:"locked_in_stakes,omitempty"`
-	LockedInNftBids                 string                 `protobuf:"bytes,2,opt,name=locked_in_nft_bids,json=lockedInNftBids,proto3" json:"locked_in_nft_bids,omitempty"`
-	LockedInFeeVoucher              string                 `protobuf:"bytes,3,opt,name=locked_in_fee_voucher,json=lockedInFeeVoucher,proto3" json:"locked_in_fee_voucher,omitempty"`
-	LockedInSpotMarginFundingOffers string                 `protobuf:"bytes,4,opt,name=locked_in_spot_margin_funding_offers,json=lockedInSpotMarginFundingOffers,proto3" json:"locked_in_spot_margin_funding_offers,omitempty"`
-	LockedInSpotOrders              string                 `protobuf:"bytes,5,opt,name=locked_in_spot_orders,json=lockedInSpotOrders,proto3" json:"locked_in_spot_orders,omitempty"`
-	LockedAsCollateral              string                 `protobuf:"bytes,6,opt,name=locked_as_collateral,json=lockedAsCollateral,proto3" json:"locked_as_collateral,omitempty"`
-	UsedInFutures                   string                 `protobuf:"bytes,7,opt,name=used_in_futures,json=usedInFutures,proto3" json:"used_in_futures,omitempty"`
-	UsedInSpotMargin                string                 `protobuf:"bytes,8,opt,name=used_in_spot_margin,json=usedInSpotMargin,proto3" json:"used_in_spot_margin,omitempty"`
-	unknownFields                   protoimpl.UnknownFields
-	sizeCache                       protoimpl.SizeCache
+	return false
 }
 
-func (x *CollateralUsedBreakdown) Reset() {
-	*x = CollateralUsedBreakdown{}
-	mi := &file_rpc_proto_msgTypes[199]
+type OrderTransactionCost struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                   string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                      string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                       *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Strategy                   string                 `protobuf:"bytes,4,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	OrderId                    string                 `protobuf:"bytes,5,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	InternalOrderId            string                 `protobuf:"bytes,6,opt,name=internal_order_id,json=internalOrderId,proto3" json:"internal_order_id,omitempty"`
+	Side                       string                 `protobuf:"bytes,7,opt,name=side,proto3" json:"side,omitempty"`
+	Status                     string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	SubmittedAt                string                 `protobuf:"bytes,9,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	Amount                     float64                `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExecutedAmount             float64                `protobuf:"fixed64,11,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	ArrivalPrice               float64                `protobuf:"fixed64,12,opt,name=arrival_price,json=arrivalPrice,proto3" json:"arrival_price,omitempty"`
+	ArrivalSource              string                 `protobuf:"bytes,13,opt,name=arrival_source,json=arrivalSource,proto3" json:"arrival_source,omitempty"`
+	AverageFill                float64                `protobuf:"fixed64,14,opt,name=average_fill,json=averageFill,proto3" json:"average_fill,omitempty"`
+	PostTradeMid               float64                `protobuf:"fixed64,15,opt,name=post_trade_mid,json=postTradeMid,proto3" json:"post_trade_mid,omitempty"`
+	Notional                   float64                `protobuf:"fixed64,16,opt,name=notional,proto3" json:"notional,omitempty"`
+	Fees                       float64                `protobuf:"fixed64,17,opt,name=fees,proto3" json:"fees,omitempty"`
+	ArrivalSlippageBps         float64                `protobuf:"fixed64,18,opt,name=arrival_slippage_bps,json=arrivalSlippageBps,proto3" json:"arrival_slippage_bps,omitempty"`
+	ImplementationShortfall    float64                `protobuf:"fixed64,19,opt,name=implementation_shortfall,json=implementationShortfall,proto3" json:"implementation_shortfall,omitempty"`
+	ImplementationShortfallBps float64                `protobuf:"fixed64,20,opt,name=implementation_shortfall_bps,json=implementationShortfallBps,proto3" json:"implementation_shortfall_bps,omitempty"`
+	RealisedSpreadBps          float64                `protobuf:"fixed64,21,opt,name=realised_spread_bps,json=realisedSpreadBps,proto3" json:"realised_spread_bps,omitempty"`
+	FeeDragBps                 float64                `protobuf:"fixed64,22,opt,name=fee_drag_bps,json=feeDragBps,proto3" json:"fee_drag_bps,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *OrderTransactionCost) Reset() {
+	*x = OrderTransactionCost{}
+	mi := &file_rpc_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralUsedBreakdown) String() string {
+func (x *OrderTransactionCost) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralUsedBreakdown) ProtoMessage() {}
+func (*OrderTransactionCost) ProtoMessage() {}
 
-func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[199]
+func (x *OrderTransactionCost) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13018,197 +16280,195 @@ func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
-func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{199}
+// Deprecated: Use OrderTransactionCost.ProtoReflect.Descriptor instead.
+func (*OrderTransactionCost) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{239}
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
+func (x *OrderTransactionCost) GetExchange() string {
 	if x != nil {
-		return x.LockedInStakes
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInNftBids() string {
+func (x *OrderTransactionCost) GetAsset() string {
 	if x != nil {
-		return x.LockedInNftBids
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInFeeVoucher() string {
+func (x *OrderTransactionCost) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.LockedInFeeVoucher
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInSpotMarginFundingOffers() string {
+func (x *OrderTransactionCost) GetStrategy() string {
 	if x != nil {
-		return x.LockedInSpotMarginFundingOffers
+		return x.Strategy
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInSpotOrders() string {
+func (x *OrderTransactionCost) GetOrderId() string {
 	if x != nil {
-		return x.LockedInSpotOrders
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedAsCollateral() string {
+func (x *OrderTransactionCost) GetInternalOrderId() string {
 	if x != nil {
-		return x.LockedAsCollateral
+		return x.InternalOrderId
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetUsedInFutures() string {
+func (x *OrderTransactionCost) GetSide() string {
 	if x != nil {
-		return x.UsedInFutures
+		return x.Side
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetUsedInSpotMargin() string {
+func (x *OrderTransactionCost) GetStatus() string {
 	if x != nil {
-		return x.UsedInSpotMargin
+		return x.Status
 	}
 	return ""
 }
 
-type GetFundingRatesRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                 *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate            string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate              string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	PaymentCurrency      string                 `protobuf:"bytes,6,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
-	IncludePredicted     bool                   `protobuf:"varint,7,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
-	IncludePayments      bool                   `protobuf:"varint,8,opt,name=include_payments,json=includePayments,proto3" json:"include_payments,omitempty"`
-	RespectHistoryLimits bool                   `protobuf:"varint,9,opt,name=respect_history_limits,json=respectHistoryLimits,proto3" json:"respect_history_limits,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
-}
-
-func (x *GetFundingRatesRequest) Reset() {
-	*x = GetFundingRatesRequest{}
-	mi := &file_rpc_proto_msgTypes[200]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OrderTransactionCost) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
 }
 
-func (x *GetFundingRatesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderTransactionCost) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (*GetFundingRatesRequest) ProtoMessage() {}
-
-func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[200]
+func (x *OrderTransactionCost) GetExecutedAmount() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ExecutedAmount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
-func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{200}
+func (x *OrderTransactionCost) GetArrivalPrice() float64 {
+	if x != nil {
+		return x.ArrivalPrice
+	}
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetExchange() string {
+func (x *OrderTransactionCost) GetArrivalSource() string {
 	if x != nil {
-		return x.Exchange
+		return x.ArrivalSource
 	}
 	return ""
 }
 
-func (x *GetFundingRatesRequest) GetAsset() string {
+func (x *OrderTransactionCost) GetAverageFill() float64 {
 	if x != nil {
-		return x.Asset
+		return x.AverageFill
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetPair() *CurrencyPair {
+func (x *OrderTransactionCost) GetPostTradeMid() float64 {
 	if x != nil {
-		return x.Pair
+		return x.PostTradeMid
 	}
-	return nil
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetStartDate() string {
+func (x *OrderTransactionCost) GetNotional() float64 {
 	if x != nil {
-		return x.StartDate
+		return x.Notional
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetEndDate() string {
+func (x *OrderTransactionCost) GetFees() float64 {
 	if x != nil {
-		return x.EndDate
+		return x.Fees
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetPaymentCurrency() string {
+func (x *OrderTransactionCost) GetArrivalSlippageBps() float64 {
 	if x != nil {
-		return x.PaymentCurrency
+		return x.ArrivalSlippageBps
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetIncludePredicted() bool {
+func (x *OrderTransactionCost) GetImplementationShortfall() float64 {
 	if x != nil {
-		return x.IncludePredicted
+		return x.ImplementationShortfall
 	}
-	return false
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetIncludePayments() bool {
+func (x *OrderTransactionCost) GetImplementationShortfallBps() float64 {
 	if x != nil {
-		return x.IncludePayments
+		return x.ImplementationShortfallBps
 	}
-	return false
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetRespectHistoryLimits() bool {
+func (x *OrderTransactionCost) GetRealisedSpreadBps() float64 {
 	if x != nil {
-		return x.RespectHistoryLimits
+		return x.RealisedSpreadBps
 	}
-	return false
+	return 0
 }
 
-type GetFundingRatesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rates         *FundingData           `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderTransactionCost) GetFeeDragBps() float64 {
+	if x != nil {
+		return x.FeeDragBps
+	}
+	return 0
 }
 
-func (x *GetFundingRatesResponse) Reset() {
-	*x = GetFundingRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[201]
+type TransactionCostAggregate struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Key                        string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Orders                     int64                  `protobuf:"varint,2,opt,name=orders,proto3" json:"orders,omitempty"`
+	Notional                   float64                `protobuf:"fixed64,3,opt,name=notional,proto3" json:"notional,omitempty"`
+	Fees                       float64                `protobuf:"fixed64,4,opt,name=fees,proto3" json:"fees,omitempty"`
+	ImplementationShortfall    float64                `protobuf:"fixed64,5,opt,name=implementation_shortfall,json=implementationShortfall,proto3" json:"implementation_shortfall,omitempty"`
+	ArrivalSlippageBps         float64                `protobuf:"fixed64,6,opt,name=arrival_slippage_bps,json=arrivalSlippageBps,proto3" json:"arrival_slippage_bps,omitempty"`
+	ImplementationShortfallBps float64                `protobuf:"fixed64,7,opt,name=implementation_shortfall_bps,json=implementationShortfallBps,proto3" json:"implementation_shortfall_bps,omitempty"`
+	RealisedSpreadBps          float64                `protobuf:"fixed64,8,opt,name=realised_spread_bps,json=realisedSpreadBps,proto3" json:"realised_spread_bps,omitempty"`
+	FeeDragBps                 float64                `protobuf:"fixed64,9,opt,name=fee_drag_bps,json=feeDragBps,proto3" json:"fee_drag_bps,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *TransactionCostAggregate) Reset() {
+	*x = TransactionCostAggregate{}
+	mi := &file_rpc_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFundingRatesResponse) String() string {
+func (x *TransactionCostAggregate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFundingRatesResponse) ProtoMessage() {}
+func (*TransactionCostAggregate) ProtoMessage() {}
 
-func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[201]
+func (x *TransactionCostAggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13219,108 +16479,100 @@ func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
-func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{201}
+// Deprecated: Use TransactionCostAggregate.ProtoReflect.Descriptor instead.
+func (*TransactionCostAggregate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{240}
 }
 
-func (x *GetFundingRatesResponse) GetRates() *FundingData {
+func (x *TransactionCostAggregate) GetKey() string {
 	if x != nil {
-		return x.Rates
+		return x.Key
 	}
-	return nil
-}
-
-type GetLatestFundingRateRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset            string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair             *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	IncludePredicted bool                   `protobuf:"varint,4,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetLatestFundingRateRequest) Reset() {
-	*x = GetLatestFundingRateRequest{}
-	mi := &file_rpc_proto_msgTypes[202]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TransactionCostAggregate) GetOrders() int64 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
 }
 
-func (x *GetLatestFundingRateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TransactionCostAggregate) GetNotional() float64 {
+	if x != nil {
+		return x.Notional
+	}
+	return 0
 }
 
-func (*GetLatestFundingRateRequest) ProtoMessage() {}
-
-func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[202]
+func (x *TransactionCostAggregate) GetFees() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Fees
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
-func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{202}
+func (x *TransactionCostAggregate) GetImplementationShortfall() float64 {
+	if x != nil {
+		return x.ImplementationShortfall
+	}
+	return 0
 }
 
-func (x *GetLatestFundingRateRequest) GetExchange() string {
+func (x *TransactionCostAggregate) GetArrivalSlippageBps() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.ArrivalSlippageBps
 	}
-	return ""
+	return 0
 }
 
-func (x *GetLatestFundingRateRequest) GetAsset() string {
+func (x *TransactionCostAggregate) GetImplementationShortfallBps() float64 {
 	if x != nil {
-		return x.Asset
+		return x.ImplementationShortfallBps
 	}
-	return ""
+	return 0
 }
 
-func (x *GetLatestFundingRateRequest) GetPair() *CurrencyPair {
+func (x *TransactionCostAggregate) GetRealisedSpreadBps() float64 {
 	if x != nil {
-		return x.Pair
+		return x.RealisedSpreadBps
 	}
-	return nil
+	return 0
 }
 
-func (x *GetLatestFundingRateRequest) GetIncludePredicted() bool {
+func (x *TransactionCostAggregate) GetFeeDragBps() float64 {
 	if x != nil {
-		return x.IncludePredicted
+		return x.FeeDragBps
 	}
-	return false
+	return 0
 }
 
-type GetLatestFundingRateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rate          *FundingData           `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+type GetTransactionCostAnalysisResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Orders        []*OrderTransactionCost     `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	ByExchange    []*TransactionCostAggregate `protobuf:"bytes,2,rep,name=by_exchange,json=byExchange,proto3" json:"by_exchange,omitempty"`
+	ByPair        []*TransactionCostAggregate `protobuf:"bytes,3,rep,name=by_pair,json=byPair,proto3" json:"by_pair,omitempty"`
+	ByStrategy    []*TransactionCostAggregate `protobuf:"bytes,4,rep,name=by_strategy,json=byStrategy,proto3" json:"by_strategy,omitempty"`
+	ReportPath    string                      `protobuf:"bytes,5,opt,name=report_path,json=reportPath,proto3" json:"report_path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLatestFundingRateResponse) Reset() {
-	*x = GetLatestFundingRateResponse{}
-	mi := &file_rpc_proto_msgTypes[203]
+func (x *GetTransactionCostAnalysisResponse) Reset() {
+	*x = GetTransactionCostAnalysisResponse{}
+	mi := &file_rpc_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLatestFundingRateResponse) String() string {
+func (x *GetTransactionCostAnalysisResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLatestFundingRateResponse) ProtoMessage() {}
+func (*GetTransactionCostAnalysisResponse) ProtoMessage() {}
 
-func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[203]
+func (x *GetTransactionCostAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13331,75 +16583,67 @@ func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
-func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{203}
+// Deprecated: Use GetTransactionCostAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionCostAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{241}
 }
 
-func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
+func (x *GetTransactionCostAnalysisResponse) GetOrders() []*OrderTransactionCost {
 	if x != nil {
-		return x.Rate
+		return x.Orders
 	}
 	return nil
 }
 
-type ShutdownRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ShutdownRequest) Reset() {
-	*x = ShutdownRequest{}
-	mi := &file_rpc_proto_msgTypes[204]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetTransactionCostAnalysisResponse) GetByExchange() []*TransactionCostAggregate {
+	if x != nil {
+		return x.ByExchange
+	}
+	return nil
 }
 
-func (x *ShutdownRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTransactionCostAnalysisResponse) GetByPair() []*TransactionCostAggregate {
+	if x != nil {
+		return x.ByPair
+	}
+	return nil
 }
 
-func (*ShutdownRequest) ProtoMessage() {}
-
-func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[204]
+func (x *GetTransactionCostAnalysisResponse) GetByStrategy() []*TransactionCostAggregate {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ByStrategy
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
-func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{204}
+func (x *GetTransactionCostAnalysisResponse) GetReportPath() string {
+	if x != nil {
+		return x.ReportPath
+	}
+	return ""
 }
 
-type ShutdownResponse struct {
+type GetFuturesRiskRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShutdownResponse) Reset() {
-	*x = ShutdownResponse{}
-	mi := &file_rpc_proto_msgTypes[205]
+func (x *GetFuturesRiskRequest) Reset() {
+	*x = GetFuturesRiskRequest{}
+	mi := &file_rpc_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShutdownResponse) String() string {
+func (x *GetFuturesRiskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShutdownResponse) ProtoMessage() {}
+func (*GetFuturesRiskRequest) ProtoMessage() {}
 
-func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[205]
+func (x *GetFuturesRiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13410,48 +16654,43 @@ func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
-func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{205}
+// Deprecated: Use GetFuturesRiskRequest.ProtoReflect.Descriptor instead.
+func (*GetFuturesRiskRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{242}
 }
 
-type GetTechnicalAnalysisRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	AlgorithmType         string                 `protobuf:"bytes,4,opt,name=algorithm_type,json=algorithmType,proto3" json:"algorithm_type,omitempty"`
-	Interval              int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
-	Start                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
-	End                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
-	Period                int64                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
-	FastPeriod            int64                  `protobuf:"varint,9,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
-	SlowPeriod            int64                  `protobuf:"varint,10,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
-	StandardDeviationUp   float64                `protobuf:"fixed64,11,opt,name=standard_deviation_up,json=standardDeviationUp,proto3" json:"standard_deviation_up,omitempty"`
-	StandardDeviationDown float64                `protobuf:"fixed64,12,opt,name=standard_deviation_down,json=standardDeviationDown,proto3" json:"standard_deviation_down,omitempty"`
-	MovingAverageType     int64                  `protobuf:"varint,13,opt,name=moving_average_type,json=movingAverageType,proto3" json:"moving_average_type,omitempty"`
-	OtherExchange         string                 `protobuf:"bytes,14,opt,name=other_exchange,json=otherExchange,proto3" json:"other_exchange,omitempty"`
-	OtherPair             *CurrencyPair          `protobuf:"bytes,15,opt,name=other_pair,json=otherPair,proto3" json:"other_pair,omitempty"`
-	OtherAssetType        string                 `protobuf:"bytes,16,opt,name=other_asset_type,json=otherAssetType,proto3" json:"other_asset_type,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type FuturesPositionRisk struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Exchange            string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset               string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Underlying          string                 `protobuf:"bytes,4,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	Side                string                 `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	Size                float64                `protobuf:"fixed64,6,opt,name=size,proto3" json:"size,omitempty"`
+	MarkPrice           float64                `protobuf:"fixed64,7,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	Notional            float64                `protobuf:"fixed64,8,opt,name=notional,proto3" json:"notional,omitempty"`
+	UnrealisedPnl       float64                `protobuf:"fixed64,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	LiquidationPrice    float64                `protobuf:"fixed64,10,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	LiquidationDistance float64                `protobuf:"fixed64,11,opt,name=liquidation_distance,json=liquidationDistance,proto3" json:"liquidation_distance,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetTechnicalAnalysisRequest) Reset() {
-	*x = GetTechnicalAnalysisRequest{}
-	mi := &file_rpc_proto_msgTypes[206]
+func (x *FuturesPositionRisk) Reset() {
+	*x = FuturesPositionRisk{}
+	mi := &file_rpc_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTechnicalAnalysisRequest) String() string {
+func (x *FuturesPositionRisk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
+func (*FuturesPositionRisk) ProtoMessage() {}
 
-func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[206]
+func (x *FuturesPositionRisk) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13462,145 +16701,115 @@ func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
-func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{206}
+// Deprecated: Use FuturesPositionRisk.ProtoReflect.Descriptor instead.
+func (*FuturesPositionRisk) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{243}
 }
 
-func (x *GetTechnicalAnalysisRequest) GetExchange() string {
+func (x *FuturesPositionRisk) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *GetTechnicalAnalysisRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
-}
-
-func (x *GetTechnicalAnalysisRequest) GetAlgorithmType() string {
+func (x *FuturesPositionRisk) GetAsset() string {
 	if x != nil {
-		return x.AlgorithmType
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetInterval() int64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
-}
-
-func (x *GetTechnicalAnalysisRequest) GetStart() *timestamppb.Timestamp {
+func (x *FuturesPositionRisk) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Start
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetTechnicalAnalysisRequest) GetEnd() *timestamppb.Timestamp {
+func (x *FuturesPositionRisk) GetUnderlying() string {
 	if x != nil {
-		return x.End
+		return x.Underlying
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetPeriod() int64 {
+func (x *FuturesPositionRisk) GetSide() string {
 	if x != nil {
-		return x.Period
+		return x.Side
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetFastPeriod() int64 {
+func (x *FuturesPositionRisk) GetSize() float64 {
 	if x != nil {
-		return x.FastPeriod
+		return x.Size
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetSlowPeriod() int64 {
+func (x *FuturesPositionRisk) GetMarkPrice() float64 {
 	if x != nil {
-		return x.SlowPeriod
+		return x.MarkPrice
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStandardDeviationUp() float64 {
+func (x *FuturesPositionRisk) GetNotional() float64 {
 	if x != nil {
-		return x.StandardDeviationUp
+		return x.Notional
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStandardDeviationDown() float64 {
+func (x *FuturesPositionRisk) GetUnrealisedPnl() float64 {
 	if x != nil {
-		return x.StandardDeviationDown
+		return x.UnrealisedPnl
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetMovingAverageType() int64 {
+func (x *FuturesPositionRisk) GetLiquidationPrice() float64 {
 	if x != nil {
-		return x.MovingAverageType
+		return x.LiquidationPrice
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherExchange() string {
-	if x != nil {
-		return x.OtherExchange
-	}
-	return ""
-}
-
-func (x *GetTechnicalAnalysisRequest) GetOtherPair() *CurrencyPair {
-	if x != nil {
-		return x.OtherPair
-	}
-	return nil
-}
-
-func (x *GetTechnicalAnalysisRequest) GetOtherAssetType() string {
+func (x *FuturesPositionRisk) GetLiquidationDistance() float64 {
 	if x != nil {
-		return x.OtherAssetType
+		return x.LiquidationDistance
 	}
-	return ""
+	return 0
 }
 
-type ListOfSignals struct {
+type FuturesUnderlyingExposure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Signals       []float64              `protobuf:"fixed64,1,rep,packed,name=signals,proto3" json:"signals,omitempty"`
+	Underlying    string                 `protobuf:"bytes,1,opt,name=underlying,proto3" json:"underlying,omitempty"`
+	NetDelta      float64                `protobuf:"fixed64,2,opt,name=net_delta,json=netDelta,proto3" json:"net_delta,omitempty"`
+	NetNotional   float64                `protobuf:"fixed64,3,opt,name=net_notional,json=netNotional,proto3" json:"net_notional,omitempty"`
+	GrossNotional float64                `protobuf:"fixed64,4,opt,name=gross_notional,json=grossNotional,proto3" json:"gross_notional,omitempty"`
+	Concentration float64                `protobuf:"fixed64,5,opt,name=concentration,proto3" json:"concentration,omitempty"`
+	Exchanges     []string               `protobuf:"bytes,6,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOfSignals) Reset() {
-	*x = ListOfSignals{}
-	mi := &file_rpc_proto_msgTypes[207]
+func (x *FuturesUnderlyingExposure) Reset() {
+	*x = FuturesUnderlyingExposure{}
+	mi := &file_rpc_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOfSignals) String() string {
+func (x *FuturesUnderlyingExposure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfSignals) ProtoMessage() {}
+func (*FuturesUnderlyingExposure) ProtoMessage() {}
 
-func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[207]
+func (x *FuturesUnderlyingExposure) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13611,96 +16820,82 @@ func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
-func (*ListOfSignals) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{207}
+// Deprecated: Use FuturesUnderlyingExposure.ProtoReflect.Descriptor instead.
+func (*FuturesUnderlyingExposure) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{244}
 }
 
-func (x *ListOfSignals) GetSignals() []float64 {
+func (x *FuturesUnderlyingExposure) GetUnderlying() string {
 	if x != nil {
-		return x.Signals
+		return x.Underlying
 	}
-	return nil
-}
-
-type GetTechnicalAnalysisResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Signals       map[string]*ListOfSignals `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetTechnicalAnalysisResponse) Reset() {
-	*x = GetTechnicalAnalysisResponse{}
-	mi := &file_rpc_proto_msgTypes[208]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FuturesUnderlyingExposure) GetNetDelta() float64 {
+	if x != nil {
+		return x.NetDelta
+	}
+	return 0
 }
 
-func (x *GetTechnicalAnalysisResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FuturesUnderlyingExposure) GetNetNotional() float64 {
+	if x != nil {
+		return x.NetNotional
+	}
+	return 0
 }
 
-func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
-
-func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[208]
+func (x *FuturesUnderlyingExposure) GetGrossNotional() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.GrossNotional
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
-func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{208}
+func (x *FuturesUnderlyingExposure) GetConcentration() float64 {
+	if x != nil {
+		return x.Concentration
+	}
+	return 0
 }
 
-func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
+func (x *FuturesUnderlyingExposure) GetExchanges() []string {
 	if x != nil {
-		return x.Signals
+		return x.Exchanges
 	}
 	return nil
 }
 
-type GetMarginRatesHistoryRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Currency           string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	StartDate          string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate            string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	GetPredictedRate   bool                   `protobuf:"varint,6,opt,name=get_predicted_rate,json=getPredictedRate,proto3" json:"get_predicted_rate,omitempty"`
-	GetLendingPayments bool                   `protobuf:"varint,7,opt,name=get_lending_payments,json=getLendingPayments,proto3" json:"get_lending_payments,omitempty"`
-	GetBorrowRates     bool                   `protobuf:"varint,8,opt,name=get_borrow_rates,json=getBorrowRates,proto3" json:"get_borrow_rates,omitempty"`
-	GetBorrowCosts     bool                   `protobuf:"varint,9,opt,name=get_borrow_costs,json=getBorrowCosts,proto3" json:"get_borrow_costs,omitempty"`
-	IncludeAllRates    bool                   `protobuf:"varint,10,opt,name=include_all_rates,json=includeAllRates,proto3" json:"include_all_rates,omitempty"`
-	CalculateOffline   bool                   `protobuf:"varint,11,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
-	TakerFeeRate       string                 `protobuf:"bytes,12,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
-	Rates              []*MarginRate          `protobuf:"bytes,13,rep,name=rates,proto3" json:"rates,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type FuturesAccountMarginRisk struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Exchange            string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset               string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	CollateralCurrency  string                 `protobuf:"bytes,3,opt,name=collateral_currency,json=collateralCurrency,proto3" json:"collateral_currency,omitempty"`
+	TotalCollateral     float64                `protobuf:"fixed64,4,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
+	UsedCollateral      float64                `protobuf:"fixed64,5,opt,name=used_collateral,json=usedCollateral,proto3" json:"used_collateral,omitempty"`
+	AvailableCollateral float64                `protobuf:"fixed64,6,opt,name=available_collateral,json=availableCollateral,proto3" json:"available_collateral,omitempty"`
+	UnrealisedPnl       float64                `protobuf:"fixed64,7,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	MarginRatio         float64                `protobuf:"fixed64,8,opt,name=margin_ratio,json=marginRatio,proto3" json:"margin_ratio,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetMarginRatesHistoryRequest) Reset() {
-	*x = GetMarginRatesHistoryRequest{}
-	mi := &file_rpc_proto_msgTypes[209]
+func (x *FuturesAccountMarginRisk) Reset() {
+	*x = FuturesAccountMarginRisk{}
+	mi := &file_rpc_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginRatesHistoryRequest) String() string {
+func (x *FuturesAccountMarginRisk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
+func (*FuturesAccountMarginRisk) ProtoMessage() {}
 
-func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[209]
+func (x *FuturesAccountMarginRisk) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13711,125 +16906,170 @@ func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{209}
+// Deprecated: Use FuturesAccountMarginRisk.ProtoReflect.Descriptor instead.
+func (*FuturesAccountMarginRisk) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{245}
 }
 
-func (x *GetMarginRatesHistoryRequest) GetExchange() string {
+func (x *FuturesAccountMarginRisk) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetAsset() string {
+func (x *FuturesAccountMarginRisk) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetCurrency() string {
+func (x *FuturesAccountMarginRisk) GetCollateralCurrency() string {
 	if x != nil {
-		return x.Currency
+		return x.CollateralCurrency
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetStartDate() string {
+func (x *FuturesAccountMarginRisk) GetTotalCollateral() float64 {
 	if x != nil {
-		return x.StartDate
+		return x.TotalCollateral
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetEndDate() string {
+func (x *FuturesAccountMarginRisk) GetUsedCollateral() float64 {
 	if x != nil {
-		return x.EndDate
+		return x.UsedCollateral
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetPredictedRate() bool {
+func (x *FuturesAccountMarginRisk) GetAvailableCollateral() float64 {
 	if x != nil {
-		return x.GetPredictedRate
+		return x.AvailableCollateral
 	}
-	return false
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetLendingPayments() bool {
+func (x *FuturesAccountMarginRisk) GetUnrealisedPnl() float64 {
 	if x != nil {
-		return x.GetLendingPayments
+		return x.UnrealisedPnl
 	}
-	return false
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetBorrowRates() bool {
+func (x *FuturesAccountMarginRisk) GetMarginRatio() float64 {
 	if x != nil {
-		return x.GetBorrowRates
+		return x.MarginRatio
 	}
-	return false
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetBorrowCosts() bool {
+type FuturesRiskAlert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuturesRiskAlert) Reset() {
+	*x = FuturesRiskAlert{}
+	mi := &file_rpc_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuturesRiskAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuturesRiskAlert) ProtoMessage() {}
+
+func (x *FuturesRiskAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[246]
 	if x != nil {
-		return x.GetBorrowCosts
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetMarginRatesHistoryRequest) GetIncludeAllRates() bool {
+// Deprecated: Use FuturesRiskAlert.ProtoReflect.Descriptor instead.
+func (*FuturesRiskAlert) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *FuturesRiskAlert) GetType() string {
 	if x != nil {
-		return x.IncludeAllRates
+		return x.Type
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetCalculateOffline() bool {
+func (x *FuturesRiskAlert) GetKey() string {
 	if x != nil {
-		return x.CalculateOffline
+		return x.Key
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetTakerFeeRate() string {
+func (x *FuturesRiskAlert) GetValue() float64 {
 	if x != nil {
-		return x.TakerFeeRate
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetRates() []*MarginRate {
+func (x *FuturesRiskAlert) GetThreshold() float64 {
 	if x != nil {
-		return x.Rates
+		return x.Threshold
 	}
-	return nil
+	return 0
 }
 
-type LendingPayment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payment       string                 `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
-	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+func (x *FuturesRiskAlert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetFuturesRiskResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Timestamp     string                       `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	GrossNotional float64                      `protobuf:"fixed64,2,opt,name=gross_notional,json=grossNotional,proto3" json:"gross_notional,omitempty"`
+	Positions     []*FuturesPositionRisk       `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions,omitempty"`
+	Underlyings   []*FuturesUnderlyingExposure `protobuf:"bytes,4,rep,name=underlyings,proto3" json:"underlyings,omitempty"`
+	Accounts      []*FuturesAccountMarginRisk  `protobuf:"bytes,5,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Alerts        []*FuturesRiskAlert          `protobuf:"bytes,6,rep,name=alerts,proto3" json:"alerts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LendingPayment) Reset() {
-	*x = LendingPayment{}
-	mi := &file_rpc_proto_msgTypes[210]
+func (x *GetFuturesRiskResponse) Reset() {
+	*x = GetFuturesRiskResponse{}
+	mi := &file_rpc_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LendingPayment) String() string {
+func (x *GetFuturesRiskResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LendingPayment) ProtoMessage() {}
+func (*GetFuturesRiskResponse) ProtoMessage() {}
 
-func (x *LendingPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[210]
+func (x *GetFuturesRiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13840,48 +17080,79 @@ func (x *LendingPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
-func (*LendingPayment) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{210}
+// Deprecated: Use GetFuturesRiskResponse.ProtoReflect.Descriptor instead.
+func (*GetFuturesRiskResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{247}
 }
 
-func (x *LendingPayment) GetPayment() string {
+func (x *GetFuturesRiskResponse) GetTimestamp() string {
 	if x != nil {
-		return x.Payment
+		return x.Timestamp
 	}
 	return ""
 }
 
-func (x *LendingPayment) GetSize() string {
+func (x *GetFuturesRiskResponse) GetGrossNotional() float64 {
 	if x != nil {
-		return x.Size
+		return x.GrossNotional
 	}
-	return ""
+	return 0
 }
 
-type BorrowCost struct {
+func (x *GetFuturesRiskResponse) GetPositions() []*FuturesPositionRisk {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *GetFuturesRiskResponse) GetUnderlyings() []*FuturesUnderlyingExposure {
+	if x != nil {
+		return x.Underlyings
+	}
+	return nil
+}
+
+func (x *GetFuturesRiskResponse) GetAccounts() []*FuturesAccountMarginRisk {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetFuturesRiskResponse) GetAlerts() []*FuturesRiskAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type CreateTransferRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cost          string                 `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
-	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination   string                 `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Chain         string                 `protobuf:"bytes,5,opt,name=chain,proto3" json:"chain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BorrowCost) Reset() {
-	*x = BorrowCost{}
-	mi := &file_rpc_proto_msgTypes[211]
+func (x *CreateTransferRequest) Reset() {
+	*x = CreateTransferRequest{}
+	mi := &file_rpc_proto_msgTypes[248]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BorrowCost) String() string {
+func (x *CreateTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BorrowCost) ProtoMessage() {}
+func (*CreateTransferRequest) ProtoMessage() {}
 
-func (x *BorrowCost) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[211]
+func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[248]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13892,54 +17163,85 @@ func (x *BorrowCost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
-func (*BorrowCost) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{211}
+// Deprecated: Use CreateTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{248}
 }
 
-func (x *BorrowCost) GetCost() string {
+func (x *CreateTransferRequest) GetSource() string {
 	if x != nil {
-		return x.Cost
+		return x.Source
 	}
 	return ""
 }
 
-func (x *BorrowCost) GetSize() string {
+func (x *CreateTransferRequest) GetDestination() string {
 	if x != nil {
-		return x.Size
+		return x.Destination
 	}
 	return ""
 }
 
-type MarginRate struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Time             string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	MarketBorrowSize string                 `protobuf:"bytes,2,opt,name=market_borrow_size,json=marketBorrowSize,proto3" json:"market_borrow_size,omitempty"`
-	HourlyRate       string                 `protobuf:"bytes,3,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
-	YearlyRate       string                 `protobuf:"bytes,4,opt,name=yearly_rate,json=yearlyRate,proto3" json:"yearly_rate,omitempty"`
-	HourlyBorrowRate string                 `protobuf:"bytes,5,opt,name=hourly_borrow_rate,json=hourlyBorrowRate,proto3" json:"hourly_borrow_rate,omitempty"`
-	YearlyBorrowRate string                 `protobuf:"bytes,6,opt,name=yearly_borrow_rate,json=yearlyBorrowRate,proto3" json:"yearly_borrow_rate,omitempty"`
-	LendingPayment   *LendingPayment        `protobuf:"bytes,7,opt,name=lending_payment,json=lendingPayment,proto3" json:"lending_payment,omitempty"`
-	BorrowCost       *BorrowCost            `protobuf:"bytes,8,opt,name=borrow_cost,json=borrowCost,proto3" json:"borrow_cost,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *CreateTransferRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *MarginRate) Reset() {
-	*x = MarginRate{}
-	mi := &file_rpc_proto_msgTypes[212]
+func (x *CreateTransferRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+type Transfer struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source               string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Destination          string                 `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Currency             string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Chain                string                 `protobuf:"bytes,6,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address              string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag           string                 `protobuf:"bytes,8,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	EstimatedFee         float64                `protobuf:"fixed64,9,opt,name=estimated_fee,json=estimatedFee,proto3" json:"estimated_fee,omitempty"`
+	Status               string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	WithdrawalId         string                 `protobuf:"bytes,11,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	ExchangeWithdrawalId string                 `protobuf:"bytes,12,opt,name=exchange_withdrawal_id,json=exchangeWithdrawalId,proto3" json:"exchange_withdrawal_id,omitempty"`
+	TxId                 string                 `protobuf:"bytes,13,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Error                string                 `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt            string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SubmittedAt          string                 `protobuf:"bytes,16,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	UpdatedAt            string                 `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt          string                 `protobuf:"bytes,18,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_rpc_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarginRate) String() string {
+func (x *Transfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarginRate) ProtoMessage() {}
+func (*Transfer) ProtoMessage() {}
 
-func (x *MarginRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[212]
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13950,203 +17252,160 @@ func (x *MarginRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
-func (*MarginRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{212}
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{249}
 }
 
-func (x *MarginRate) GetTime() string {
+func (x *Transfer) GetId() string {
 	if x != nil {
-		return x.Time
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MarginRate) GetMarketBorrowSize() string {
+func (x *Transfer) GetSource() string {
 	if x != nil {
-		return x.MarketBorrowSize
+		return x.Source
 	}
 	return ""
 }
 
-func (x *MarginRate) GetHourlyRate() string {
+func (x *Transfer) GetDestination() string {
 	if x != nil {
-		return x.HourlyRate
+		return x.Destination
 	}
 	return ""
 }
 
-func (x *MarginRate) GetYearlyRate() string {
+func (x *Transfer) GetCurrency() string {
 	if x != nil {
-		return x.YearlyRate
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *MarginRate) GetHourlyBorrowRate() string {
+func (x *Transfer) GetAmount() float64 {
 	if x != nil {
-		return x.HourlyBorrowRate
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *MarginRate) GetYearlyBorrowRate() string {
+func (x *Transfer) GetChain() string {
 	if x != nil {
-		return x.YearlyBorrowRate
+		return x.Chain
 	}
 	return ""
 }
 
-func (x *MarginRate) GetLendingPayment() *LendingPayment {
-	if x != nil {
-		return x.LendingPayment
-	}
-	return nil
-}
-
-func (x *MarginRate) GetBorrowCost() *BorrowCost {
+func (x *Transfer) GetAddress() string {
 	if x != nil {
-		return x.BorrowCost
+		return x.Address
 	}
-	return nil
-}
-
-type GetMarginRatesHistoryResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Rates              []*MarginRate          `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
-	TotalRates         int64                  `protobuf:"varint,2,opt,name=total_rates,json=totalRates,proto3" json:"total_rates,omitempty"`
-	SumBorrowCosts     string                 `protobuf:"bytes,3,opt,name=sum_borrow_costs,json=sumBorrowCosts,proto3" json:"sum_borrow_costs,omitempty"`
-	AvgBorrowSize      string                 `protobuf:"bytes,4,opt,name=avg_borrow_size,json=avgBorrowSize,proto3" json:"avg_borrow_size,omitempty"`
-	SumLendingPayments string                 `protobuf:"bytes,5,opt,name=sum_lending_payments,json=sumLendingPayments,proto3" json:"sum_lending_payments,omitempty"`
-	AvgLendingSize     string                 `protobuf:"bytes,6,opt,name=avg_lending_size,json=avgLendingSize,proto3" json:"avg_lending_size,omitempty"`
-	LatestRate         *MarginRate            `protobuf:"bytes,7,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
-	PredictedRate      *MarginRate            `protobuf:"bytes,8,opt,name=predicted_rate,json=predictedRate,proto3" json:"predicted_rate,omitempty"`
-	TakerFeeRate       string                 `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
-}
-
-func (x *GetMarginRatesHistoryResponse) Reset() {
-	*x = GetMarginRatesHistoryResponse{}
-	mi := &file_rpc_proto_msgTypes[213]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetMarginRatesHistoryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
-
-func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[213]
+func (x *Transfer) GetAddressTag() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AddressTag
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{213}
+func (x *Transfer) GetEstimatedFee() float64 {
+	if x != nil {
+		return x.EstimatedFee
+	}
+	return 0
 }
 
-func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
+func (x *Transfer) GetStatus() string {
 	if x != nil {
-		return x.Rates
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetTotalRates() int64 {
+func (x *Transfer) GetWithdrawalId() string {
 	if x != nil {
-		return x.TotalRates
+		return x.WithdrawalId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetSumBorrowCosts() string {
+func (x *Transfer) GetExchangeWithdrawalId() string {
 	if x != nil {
-		return x.SumBorrowCosts
+		return x.ExchangeWithdrawalId
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetAvgBorrowSize() string {
+func (x *Transfer) GetTxId() string {
 	if x != nil {
-		return x.AvgBorrowSize
+		return x.TxId
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetSumLendingPayments() string {
+func (x *Transfer) GetError() string {
 	if x != nil {
-		return x.SumLendingPayments
+		return x.Error
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetAvgLendingSize() string {
+func (x *Transfer) GetCreatedAt() string {
 	if x != nil {
-		return x.AvgLendingSize
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetLatestRate() *MarginRate {
+func (x *Transfer) GetSubmittedAt() string {
 	if x != nil {
-		return x.LatestRate
+		return x.SubmittedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetPredictedRate() *MarginRate {
+func (x *Transfer) GetUpdatedAt() string {
 	if x != nil {
-		return x.PredictedRate
+		return x.UpdatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetTakerFeeRate() string {
+func (x *Transfer) GetCompletedAt() string {
 	if x != nil {
-		return x.TakerFeeRate
+		return x.CompletedAt
 	}
 	return ""
 }
 
-type GetOrderbookMovementRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Amount                float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	Purchase              bool                   `protobuf:"varint,9,opt,name=purchase,proto3" json:"purchase,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type GetTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementRequest) Reset() {
-	*x = GetOrderbookMovementRequest{}
-	mi := &file_rpc_proto_msgTypes[214]
+func (x *GetTransfersRequest) Reset() {
+	*x = GetTransfersRequest{}
+	mi := &file_rpc_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementRequest) String() string {
+func (x *GetTransfersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookMovementRequest) ProtoMessage() {}
+func (*GetTransfersRequest) ProtoMessage() {}
 
-func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[214]
+func (x *GetTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14157,95 +17416,93 @@ func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{214}
+// Deprecated: Use GetTransfersRequest.ProtoReflect.Descriptor instead.
+func (*GetTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{250}
 }
 
-func (x *GetOrderbookMovementRequest) GetExchange() string {
+func (x *GetTransfersRequest) GetId() string {
 	if x != nil {
-		return x.Exchange
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetAsset() string {
+func (x *GetTransfersRequest) GetStatus() string {
 	if x != nil {
-		return x.Asset
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+type GetTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+func (x *GetTransfersResponse) Reset() {
+	*x = GetTransfersResponse{}
+	mi := &file_rpc_proto_msgTypes[251]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementRequest) GetSell() bool {
-	if x != nil {
-		return x.Sell
-	}
-	return false
+func (x *GetTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetOrderbookMovementRequest) GetRequiresRestOrderbook() bool {
+func (*GetTransfersResponse) ProtoMessage() {}
+
+func (x *GetTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[251]
 	if x != nil {
-		return x.RequiresRestOrderbook
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookMovementRequest) GetPurchase() bool {
+// Deprecated: Use GetTransfersResponse.ProtoReflect.Descriptor instead.
+func (*GetTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{251}
+}
+
+func (x *GetTransfersResponse) GetTransfers() []*Transfer {
 	if x != nil {
-		return x.Purchase
+		return x.Transfers
 	}
-	return false
+	return nil
 }
 
-type GetOrderbookMovementResponse struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	NominalPercentage         float64                `protobuf:"fixed64,1,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
-	ImpactPercentage          float64                `protobuf:"fixed64,2,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
-	SlippageCost              float64                `protobuf:"fixed64,3,opt,name=slippage_cost,json=slippageCost,proto3" json:"slippage_cost,omitempty"`
-	CurrencyBought            string                 `protobuf:"bytes,4,opt,name=currency_bought,json=currencyBought,proto3" json:"currency_bought,omitempty"`
-	Bought                    float64                `protobuf:"fixed64,5,opt,name=bought,proto3" json:"bought,omitempty"`
-	CurrencySold              string                 `protobuf:"bytes,6,opt,name=currency_sold,json=currencySold,proto3" json:"currency_sold,omitempty"`
-	Sold                      float64                `protobuf:"fixed64,7,opt,name=sold,proto3" json:"sold,omitempty"`
-	SideAffected              string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	UpdateProtocol            string                 `protobuf:"bytes,9,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed bool                   `protobuf:"varint,10,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	StartPrice                float64                `protobuf:"fixed64,11,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                  float64                `protobuf:"fixed64,12,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	NoSlippageOccurred        bool                   `protobuf:"varint,13,opt,name=no_slippage_occurred,json=noSlippageOccurred,proto3" json:"no_slippage_occurred,omitempty"`
-	AverageOrderCost          float64                `protobuf:"fixed64,14,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type UnlockCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Account       string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Passphrase    string                 `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementResponse) Reset() {
-	*x = GetOrderbookMovementResponse{}
-	mi := &file_rpc_proto_msgTypes[215]
+func (x *UnlockCredentialsRequest) Reset() {
+	*x = UnlockCredentialsRequest{}
+	mi := &file_rpc_proto_msgTypes[252]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementResponse) String() string {
+func (x *UnlockCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookMovementResponse) ProtoMessage() {}
+func (*UnlockCredentialsRequest) ProtoMessage() {}
 
-func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[215]
+func (x *UnlockCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[252]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14256,136 +17513,202 @@ func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{215}
+// Deprecated: Use UnlockCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*UnlockCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{252}
 }
 
-func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
+func (x *UnlockCredentialsRequest) GetExchange() string {
 	if x != nil {
-		return x.NominalPercentage
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetImpactPercentage() float64 {
+func (x *UnlockCredentialsRequest) GetAccount() string {
 	if x != nil {
-		return x.ImpactPercentage
+		return x.Account
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetSlippageCost() float64 {
+func (x *UnlockCredentialsRequest) GetPassphrase() string {
 	if x != nil {
-		return x.SlippageCost
+		return x.Passphrase
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetCurrencyBought() string {
+type ReloadCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadCredentialsRequest) Reset() {
+	*x = ReloadCredentialsRequest{}
+	mi := &file_rpc_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadCredentialsRequest) ProtoMessage() {}
+
+func (x *ReloadCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[253]
 	if x != nil {
-		return x.CurrencyBought
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookMovementResponse) GetBought() float64 {
+// Deprecated: Use ReloadCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*ReloadCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{253}
+}
+
+func (x *ReloadCredentialsRequest) GetExchange() string {
 	if x != nil {
-		return x.Bought
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetCurrencySold() string {
+type APIToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Exchanges     []string               `protobuf:"bytes,4,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	Pairs         []string               `protobuf:"bytes,5,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIToken) Reset() {
+	*x = APIToken{}
+	mi := &file_rpc_proto_msgTypes[254]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIToken) ProtoMessage() {}
+
+func (x *APIToken) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[254]
 	if x != nil {
-		return x.CurrencySold
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookMovementResponse) GetSold() float64 {
+// Deprecated: Use APIToken.ProtoReflect.Descriptor instead.
+func (*APIToken) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *APIToken) GetId() string {
 	if x != nil {
-		return x.Sold
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetSideAffected() string {
+func (x *APIToken) GetName() string {
 	if x != nil {
-		return x.SideAffected
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetUpdateProtocol() string {
+func (x *APIToken) GetRole() string {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.Role
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetFullOrderbookSideConsumed() bool {
+func (x *APIToken) GetExchanges() []string {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.Exchanges
 	}
-	return false
+	return nil
 }
 
-func (x *GetOrderbookMovementResponse) GetStartPrice() float64 {
+func (x *APIToken) GetPairs() []string {
 	if x != nil {
-		return x.StartPrice
+		return x.Pairs
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookMovementResponse) GetEndPrice() float64 {
+func (x *APIToken) GetCreatedBy() string {
 	if x != nil {
-		return x.EndPrice
+		return x.CreatedBy
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetNoSlippageOccurred() bool {
+func (x *APIToken) GetCreatedAt() string {
 	if x != nil {
-		return x.NoSlippageOccurred
+		return x.CreatedAt
 	}
-	return false
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetAverageOrderCost() float64 {
+func (x *APIToken) GetExpiresAt() string {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.ExpiresAt
 	}
-	return 0
+	return ""
 }
 
-type GetOrderbookAmountByNominalRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	NominalPercentage     float64                `protobuf:"fixed64,6,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type CreateAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Exchanges     []string               `protobuf:"bytes,3,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	Pairs         []string               `protobuf:"bytes,4,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	Expiry        string                 `protobuf:"bytes,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByNominalRequest) Reset() {
-	*x = GetOrderbookAmountByNominalRequest{}
-	mi := &file_rpc_proto_msgTypes[216]
+func (x *CreateAPITokenRequest) Reset() {
+	*x = CreateAPITokenRequest{}
+	mi := &file_rpc_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalRequest) String() string {
+func (x *CreateAPITokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
+func (*CreateAPITokenRequest) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[216]
+func (x *CreateAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14396,85 +17719,69 @@ func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{216}
+// Deprecated: Use CreateAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{255}
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
+func (x *CreateAPITokenRequest) GetName() string {
 	if x != nil {
-		return x.Exchange
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetAsset() string {
+func (x *CreateAPITokenRequest) GetRole() string {
 	if x != nil {
-		return x.Asset
+		return x.Role
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetPair() *CurrencyPair {
+func (x *CreateAPITokenRequest) GetExchanges() []string {
 	if x != nil {
-		return x.Pair
+		return x.Exchanges
 	}
 	return nil
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetNominalPercentage() float64 {
-	if x != nil {
-		return x.NominalPercentage
-	}
-	return 0
-}
-
-func (x *GetOrderbookAmountByNominalRequest) GetSell() bool {
+func (x *CreateAPITokenRequest) GetPairs() []string {
 	if x != nil {
-		return x.Sell
+		return x.Pairs
 	}
-	return false
+	return nil
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetRequiresRestOrderbook() bool {
+func (x *CreateAPITokenRequest) GetExpiry() string {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.Expiry
 	}
-	return false
+	return ""
 }
 
-type GetOrderbookAmountByNominalResponse struct {
-	state                                protoimpl.MessageState `protogen:"open.v1"`
-	AmountRequired                       float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
-	CurrencySelling                      string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
-	AmountReceived                       float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
-	CurrencyBuying                       string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
-	StartPrice                           float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                             float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	AverageOrderCost                     float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	SideAffected                         string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	ApproximateNominalSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_nominal_slippage_percentage,json=approximateNominalSlippagePercentage,proto3" json:"approximate_nominal_slippage_percentage,omitempty"`
-	UpdateProtocol                       string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed            bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	unknownFields                        protoimpl.UnknownFields
-	sizeCache                            protoimpl.SizeCache
+type CreateAPITokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *APIToken              `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByNominalResponse) Reset() {
-	*x = GetOrderbookAmountByNominalResponse{}
-	mi := &file_rpc_proto_msgTypes[217]
+func (x *CreateAPITokenResponse) Reset() {
+	*x = CreateAPITokenResponse{}
+	mi := &file_rpc_proto_msgTypes[256]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) String() string {
+func (x *CreateAPITokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
+func (*CreateAPITokenResponse) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[217]
+func (x *CreateAPITokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[256]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14485,115 +17792,127 @@ func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{217}
+// Deprecated: Use CreateAPITokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPITokenResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{256}
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
+func (x *CreateAPITokenResponse) GetToken() *APIToken {
 	if x != nil {
-		return x.AmountRequired
+		return x.Token
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetCurrencySelling() string {
+func (x *CreateAPITokenResponse) GetSecret() string {
 	if x != nil {
-		return x.CurrencySelling
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAmountReceived() float64 {
-	if x != nil {
-		return x.AmountReceived
-	}
-	return 0
+type GetAPITokensRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetCurrencyBuying() string {
-	if x != nil {
-		return x.CurrencyBuying
-	}
-	return ""
+func (x *GetAPITokensRequest) Reset() {
+	*x = GetAPITokensRequest{}
+	mi := &file_rpc_proto_msgTypes[257]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetStartPrice() float64 {
-	if x != nil {
-		return x.StartPrice
-	}
-	return 0
+func (x *GetAPITokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetEndPrice() float64 {
+func (*GetAPITokensRequest) ProtoMessage() {}
+
+func (x *GetAPITokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[257]
 	if x != nil {
-		return x.EndPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAverageOrderCost() float64 {
-	if x != nil {
-		return x.AverageOrderCost
-	}
-	return 0
+// Deprecated: Use GetAPITokensRequest.ProtoReflect.Descriptor instead.
+func (*GetAPITokensRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{257}
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetSideAffected() string {
-	if x != nil {
-		return x.SideAffected
-	}
-	return ""
+type GetAPITokensResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*APIToken            `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetApproximateNominalSlippagePercentage() float64 {
-	if x != nil {
-		return x.ApproximateNominalSlippagePercentage
-	}
-	return 0
+func (x *GetAPITokensResponse) Reset() {
+	*x = GetAPITokensResponse{}
+	mi := &file_rpc_proto_msgTypes[258]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetUpdateProtocol() string {
+func (x *GetAPITokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPITokensResponse) ProtoMessage() {}
+
+func (x *GetAPITokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[258]
 	if x != nil {
-		return x.UpdateProtocol
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetFullOrderbookSideConsumed() bool {
+// Deprecated: Use GetAPITokensResponse.ProtoReflect.Descriptor instead.
+func (*GetAPITokensResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *GetAPITokensResponse) GetTokens() []*APIToken {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.Tokens
 	}
-	return false
+	return nil
 }
 
-type GetOrderbookAmountByImpactRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	ImpactPercentage      float64                `protobuf:"fixed64,6,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type RevokeAPITokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactRequest) Reset() {
-	*x = GetOrderbookAmountByImpactRequest{}
-	mi := &file_rpc_proto_msgTypes[218]
+func (x *RevokeAPITokenRequest) Reset() {
+	*x = RevokeAPITokenRequest{}
+	mi := &file_rpc_proto_msgTypes[259]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactRequest) String() string {
+func (x *RevokeAPITokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
+func (*RevokeAPITokenRequest) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[218]
+func (x *RevokeAPITokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[259]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14604,85 +17923,93 @@ func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{218}
+// Deprecated: Use RevokeAPITokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPITokenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{259}
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
+func (x *RevokeAPITokenRequest) GetId() string {
 	if x != nil {
-		return x.Exchange
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+type ReloadConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConfigPath    string                 `protobuf:"bytes,1,opt,name=config_path,json=configPath,proto3" json:"config_path,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *ReloadConfigRequest) Reset() {
+	*x = ReloadConfigRequest{}
+	mi := &file_rpc_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetImpactPercentage() float64 {
+func (x *ReloadConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigRequest) ProtoMessage() {}
+
+func (x *ReloadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[260]
 	if x != nil {
-		return x.ImpactPercentage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetSell() bool {
+// Deprecated: Use ReloadConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{260}
+}
+
+func (x *ReloadConfigRequest) GetConfigPath() string {
 	if x != nil {
-		return x.Sell
+		return x.ConfigPath
 	}
-	return false
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetRequiresRestOrderbook() bool {
+func (x *ReloadConfigRequest) GetDryRun() bool {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.DryRun
 	}
 	return false
 }
 
-type GetOrderbookAmountByImpactResponse struct {
-	state                               protoimpl.MessageState `protogen:"open.v1"`
-	AmountRequired                      float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
-	CurrencySelling                     string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
-	AmountReceived                      float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
-	CurrencyBuying                      string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
-	StartPrice                          float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                            float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	AverageOrderCost                    float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	SideAffected                        string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	ApproximateImpactSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_impact_slippage_percentage,json=approximateImpactSlippagePercentage,proto3" json:"approximate_impact_slippage_percentage,omitempty"`
-	UpdateProtocol                      string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed           bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	unknownFields                       protoimpl.UnknownFields
-	sizeCache                           protoimpl.SizeCache
+type ReloadConfigResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Applied         []string               `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	RestartRequired []string               `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactResponse) Reset() {
-	*x = GetOrderbookAmountByImpactResponse{}
-	mi := &file_rpc_proto_msgTypes[219]
+func (x *ReloadConfigResponse) Reset() {
+	*x = ReloadConfigResponse{}
+	mi := &file_rpc_proto_msgTypes[261]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactResponse) String() string {
+func (x *ReloadConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
+func (*ReloadConfigResponse) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[219]
+func (x *ReloadConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[261]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14693,111 +18020,158 @@ func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{219}
+// Deprecated: Use ReloadConfigResponse.ProtoReflect.Descriptor instead.
+func (*ReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{261}
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
+func (x *ReloadConfigResponse) GetApplied() []string {
 	if x != nil {
-		return x.AmountRequired
+		return x.Applied
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetCurrencySelling() string {
+func (x *ReloadConfigResponse) GetRestartRequired() []string {
 	if x != nil {
-		return x.CurrencySelling
+		return x.RestartRequired
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAmountReceived() float64 {
+type PatchConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Patch         string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PatchConfigRequest) Reset() {
+	*x = PatchConfigRequest{}
+	mi := &file_rpc_proto_msgTypes[262]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PatchConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PatchConfigRequest) ProtoMessage() {}
+
+func (x *PatchConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[262]
 	if x != nil {
-		return x.AmountReceived
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PatchConfigRequest.ProtoReflect.Descriptor instead.
+func (*PatchConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{262}
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetCurrencyBuying() string {
+func (x *PatchConfigRequest) GetPatch() string {
 	if x != nil {
-		return x.CurrencyBuying
+		return x.Patch
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetStartPrice() float64 {
+func (x *PatchConfigRequest) GetDryRun() bool {
 	if x != nil {
-		return x.StartPrice
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetEndPrice() float64 {
-	if x != nil {
-		return x.EndPrice
-	}
-	return 0
+type ConfigChangeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Applied         []string               `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	RestartRequired []string               `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	Version         uint64                 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAverageOrderCost() float64 {
-	if x != nil {
-		return x.AverageOrderCost
-	}
-	return 0
+func (x *ConfigChangeResponse) Reset() {
+	*x = ConfigChangeResponse{}
+	mi := &file_rpc_proto_msgTypes[263]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetSideAffected() string {
+func (x *ConfigChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigChangeResponse) ProtoMessage() {}
+
+func (x *ConfigChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[263]
 	if x != nil {
-		return x.SideAffected
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetApproximateImpactSlippagePercentage() float64 {
+// Deprecated: Use ConfigChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfigChangeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{263}
+}
+
+func (x *ConfigChangeResponse) GetApplied() []string {
 	if x != nil {
-		return x.ApproximateImpactSlippagePercentage
+		return x.Applied
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
+func (x *ConfigChangeResponse) GetRestartRequired() []string {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.RestartRequired
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
+func (x *ConfigChangeResponse) GetVersion() uint64 {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.Version
 	}
-	return false
+	return 0
 }
 
-type GetOpenInterestRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+type GetConfigHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestRequest) Reset() {
-	*x = GetOpenInterestRequest{}
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *GetConfigHistoryRequest) Reset() {
+	*x = GetConfigHistoryRequest{}
+	mi := &file_rpc_proto_msgTypes[264]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestRequest) String() string {
+func (x *GetConfigHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestRequest) ProtoMessage() {}
+func (*GetConfigHistoryRequest) ProtoMessage() {}
 
-func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *GetConfigHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[264]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14808,48 +18182,35 @@ func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{220}
-}
-
-func (x *GetOpenInterestRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use GetConfigHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{264}
 }
 
-type OpenInterestDataRequest struct {
+type ConfigVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Version       uint64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	SavedAt       string                 `protobuf:"bytes,2,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+	Encrypted     bool                   `protobuf:"varint,3,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpenInterestDataRequest) Reset() {
-	*x = OpenInterestDataRequest{}
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *ConfigVersion) Reset() {
+	*x = ConfigVersion{}
+	mi := &file_rpc_proto_msgTypes[265]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataRequest) String() string {
+func (x *ConfigVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataRequest) ProtoMessage() {}
+func (*ConfigVersion) ProtoMessage() {}
 
-func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *ConfigVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[265]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14860,47 +18221,54 @@ func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{221}
+// Deprecated: Use ConfigVersion.ProtoReflect.Descriptor instead.
+func (*ConfigVersion) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{265}
 }
 
-func (x *OpenInterestDataRequest) GetAsset() string {
+func (x *ConfigVersion) GetVersion() uint64 {
 	if x != nil {
-		return x.Asset
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ConfigVersion) GetSavedAt() string {
+	if x != nil {
+		return x.SavedAt
 	}
 	return ""
 }
 
-func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
+func (x *ConfigVersion) GetEncrypted() bool {
 	if x != nil {
-		return x.Pair
+		return x.Encrypted
 	}
-	return nil
+	return false
 }
 
-type GetOpenInterestResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+type GetConfigHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*ConfigVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestResponse) Reset() {
-	*x = GetOpenInterestResponse{}
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *GetConfigHistoryResponse) Reset() {
+	*x = GetConfigHistoryResponse{}
+	mi := &file_rpc_proto_msgTypes[266]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestResponse) String() string {
+func (x *GetConfigHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestResponse) ProtoMessage() {}
+func (*GetConfigHistoryResponse) ProtoMessage() {}
 
-func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *GetConfigHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[266]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14911,43 +18279,41 @@ func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{222}
+// Deprecated: Use GetConfigHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{266}
 }
 
-func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
+func (x *GetConfigHistoryResponse) GetVersions() []*ConfigVersion {
 	if x != nil {
-		return x.Data
+		return x.Versions
 	}
 	return nil
 }
 
-type OpenInterestDataResponse struct {
+type DiffConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	FromVersion   uint64                 `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     uint64                 `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OpenInterestDataResponse) Reset() {
-	*x = OpenInterestDataResponse{}
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *DiffConfigRequest) Reset() {
+	*x = DiffConfigRequest{}
+	mi := &file_rpc_proto_msgTypes[267]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataResponse) String() string {
+func (x *DiffConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataResponse) ProtoMessage() {}
+func (*DiffConfigRequest) ProtoMessage() {}
 
-func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *DiffConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[267]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14958,63 +18324,47 @@ func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{223}
-}
-
-func (x *OpenInterestDataResponse) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *OpenInterestDataResponse) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+// Deprecated: Use DiffConfigRequest.ProtoReflect.Descriptor instead.
+func (*DiffConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{267}
 }
 
-func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
+func (x *DiffConfigRequest) GetFromVersion() uint64 {
 	if x != nil {
-		return x.Pair
+		return x.FromVersion
 	}
-	return nil
+	return 0
 }
 
-func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
+func (x *DiffConfigRequest) GetToVersion() uint64 {
 	if x != nil {
-		return x.OpenInterest
+		return x.ToVersion
 	}
 	return 0
 }
 
-type GetCurrencyTradeURLRequest struct {
+type DiffConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Patch         string                 `protobuf:"bytes,1,opt,name=patch,proto3" json:"patch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrencyTradeURLRequest) Reset() {
-	*x = GetCurrencyTradeURLRequest{}
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *DiffConfigResponse) Reset() {
+	*x = DiffConfigResponse{}
+	mi := &file_rpc_proto_msgTypes[268]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyTradeURLRequest) String() string {
+func (x *DiffConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
+func (*DiffConfigResponse) ProtoMessage() {}
 
-func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *DiffConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[268]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15025,54 +18375,41 @@ func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{224}
-}
-
-func (x *GetCurrencyTradeURLRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use DiffConfigResponse.ProtoReflect.Descriptor instead.
+func (*DiffConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{268}
 }
 
-func (x *GetCurrencyTradeURLRequest) GetAsset() string {
+func (x *DiffConfigResponse) GetPatch() string {
 	if x != nil {
-		return x.Asset
+		return x.Patch
 	}
 	return ""
 }
 
-func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-type GetCurrencyTradeURLResponse struct {
+type RollbackConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Version       uint64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrencyTradeURLResponse) Reset() {
-	*x = GetCurrencyTradeURLResponse{}
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *RollbackConfigRequest) Reset() {
+	*x = RollbackConfigRequest{}
+	mi := &file_rpc_proto_msgTypes[269]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyTradeURLResponse) String() string {
+func (x *RollbackConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
+func (*RollbackConfigRequest) ProtoMessage() {}
 
-func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *RollbackConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[269]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15083,16 +18420,23 @@ func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{225}
+// Deprecated: Use RollbackConfigRequest.ProtoReflect.Descriptor instead.
+func (*RollbackConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{269}
 }
 
-func (x *GetCurrencyTradeURLResponse) GetUrl() string {
+func (x *RollbackConfigRequest) GetVersion() uint64 {
 	if x != nil {
-		return x.Url
+		return x.Version
 	}
-	return ""
+	return 0
+}
+
+func (x *RollbackConfigRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
 }
 
 var File_rpc_proto protoreflect.FileDescriptor
@@ -15231,11 +18575,12 @@ const file_rpc_proto_rawDesc = "" +
 	"\x15GetOrderbooksResponse\x122\n" +
 	"\n" +
 	"orderbooks\x18\x01 \x03(\v2\x12.gctrpc.OrderbooksR\n" +
-	"orderbooks\"V\n" +
+	"orderbooks\"p\n" +
 	"\x19GetAccountBalancesRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
 	"\n" +
-	"asset_type\x18\x02 \x01(\tR\tassetType\"V\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x18\n" +
+	"\aaccount\x18\x03 \x01(\tR\aaccount\"V\n" +
 	"\aAccount\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
 	"\n" +
@@ -15291,14 +18636,21 @@ const file_rpc_proto_rawDesc = "" +
 	"\n" +
 	"CoinsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.gctrpc.OnlineCoinSummaryR\x05value:\x028\x01\"\xcb\x04\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.gctrpc.OnlineCoinSummaryR\x05value:\x028\x01\"\x84\x01\n" +
+	"\x0fAccountHoldings\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\x12;\n" +
+	"\n" +
+	"currencies\x18\x03 \x03(\v2\x1b.gctrpc.AccountCurrencyInfoR\n" +
+	"currencies\"\x8f\x05\n" +
 	"\x1bGetPortfolioSummaryResponse\x12-\n" +
 	"\vcoin_totals\x18\x01 \x03(\v2\f.gctrpc.CoinR\n" +
 	"coinTotals\x121\n" +
 	"\rcoins_offline\x18\x02 \x03(\v2\f.gctrpc.CoinR\fcoinsOffline\x12p\n" +
 	"\x15coins_offline_summary\x18\x03 \x03(\v2<.gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntryR\x13coinsOfflineSummary\x12/\n" +
 	"\fcoins_online\x18\x04 \x03(\v2\f.gctrpc.CoinR\vcoinsOnline\x12m\n" +
-	"\x14coins_online_summary\x18\x05 \x03(\v2;.gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntryR\x12coinsOnlineSummary\x1a\\\n" +
+	"\x14coins_online_summary\x18\x05 \x03(\v2;.gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntryR\x12coinsOnlineSummary\x12B\n" +
+	"\x10account_holdings\x18\x06 \x03(\v2\x17.gctrpc.AccountHoldingsR\x0faccountHoldings\x1a\\\n" +
 	"\x18CoinsOfflineSummaryEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
 	"\x05value\x18\x02 \x01(\v2\x14.gctrpc.OfflineCoinsR\x05value:\x028\x01\x1aZ\n" +
@@ -15335,7 +18687,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\finverse_rate\x18\x04 \x01(\x01R\vinverseRate\"V\n" +
 	"\x15GetForexRatesResponse\x12=\n" +
 	"\vforex_rates\x18\x01 \x03(\v2\x1c.gctrpc.ForexRatesConversionR\n" +
-	"forexRates\"\xb5\x04\n" +
+	"forexRates\"\xcf\x04\n" +
 	"\fOrderDetails\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12&\n" +
@@ -15360,7 +18712,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x03fee\x18\x0f \x01(\x01R\x03fee\x12\x12\n" +
 	"\x04cost\x18\x10 \x01(\x01R\x04cost\x12,\n" +
 	"\x06trades\x18\x11 \x03(\v2\x14.gctrpc.TradeHistoryR\x06trades\x12'\n" +
-	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\"\xf3\x01\n" +
+	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\x12\x18\n" +
+	"\aaccount\x18\x13 \x01(\tR\aaccount\"\xf3\x01\n" +
 	"\fTradeHistory\x12#\n" +
 	"\rcreation_time\x18\x01 \x01(\x03R\fcreationTime\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
@@ -15372,7 +18725,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\n" +
 	"order_side\x18\a \x01(\tR\torderSide\x12\x10\n" +
 	"\x03fee\x18\b \x01(\x01R\x03fee\x12\x14\n" +
-	"\x05total\x18\t \x01(\x01R\x05total\"\xb1\x01\n" +
+	"\x05total\x18\t \x01(\x01R\x05total\"\xcb\x01\n" +
 	"\x10GetOrdersRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
 	"\n" +
@@ -15380,14 +18733,16 @@ const file_rpc_proto_rawDesc = "" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
 	"\n" +
 	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
-	"\bend_date\x18\x05 \x01(\tR\aendDate\"A\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x18\n" +
+	"\aaccount\x18\x06 \x01(\tR\aaccount\"A\n" +
 	"\x11GetOrdersResponse\x12,\n" +
-	"\x06orders\x18\x01 \x03(\v2\x14.gctrpc.OrderDetailsR\x06orders\"\x88\x01\n" +
+	"\x06orders\x18\x01 \x03(\v2\x14.gctrpc.OrderDetailsR\x06orders\"\xa2\x01\n" +
 	"\x0fGetOrderRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12(\n" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
-	"\x05asset\x18\x04 \x01(\tR\x05asset\"\x98\x02\n" +
+	"\x05asset\x18\x04 \x01(\tR\x05asset\x12\x18\n" +
+	"\aaccount\x18\x05 \x01(\tR\aaccount\"\xce\x02\n" +
 	"\x12SubmitOrderRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
 	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x12\n" +
@@ -15400,7 +18755,10 @@ const file_rpc_proto_rawDesc = "" +
 	"\n" +
 	"asset_type\x18\b \x01(\tR\tassetType\x12\x1f\n" +
 	"\vmargin_type\x18\t \x01(\tR\n" +
-	"marginType\"e\n" +
+	"marginType\x12\x1a\n" +
+	"\bstrategy\x18\n" +
+	" \x01(\tR\bstrategy\x12\x18\n" +
+	"\aaccount\x18\v \x01(\tR\aaccount\"e\n" +
 	"\x06Trades\x12\x16\n" +
 	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x10\n" +
@@ -15430,7 +18788,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\fprice_target\x18\x03 \x01(\x01R\vpriceTarget\x12\x12\n" +
 	"\x04side\x18\x04 \x01(\tR\x04side\x12\x1d\n" +
 	"\n" +
-	"asset_type\x18\x05 \x01(\tR\tassetType\"\xc7\x01\n" +
+	"asset_type\x18\x05 \x01(\tR\tassetType\"\xe1\x01\n" +
 	"\x12CancelOrderRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
 	"\n" +
@@ -15439,7 +18797,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
 	"\n" +
 	"asset_type\x18\x05 \x01(\tR\tassetType\x12\x12\n" +
-	"\x04side\x18\x06 \x01(\tR\x04side\"\xcf\x01\n" +
+	"\x04side\x18\x06 \x01(\tR\x04side\x12\x18\n" +
+	"\aaccount\x18\a \x01(\tR\aaccount\"\xe9\x01\n" +
 	"\x18CancelBatchOrdersRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
 	"\n" +
@@ -15448,7 +18807,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
 	"\n" +
 	"asset_type\x18\x05 \x01(\tR\tassetType\x12\x12\n" +
-	"\x04side\x18\x06 \x01(\tR\x04side\"\xa8\x01\n" +
+	"\x04side\x18\x06 \x01(\tR\x04side\x12\x18\n" +
+	"\aaccount\x18\a \x01(\tR\aaccount\"\xa8\x01\n" +
 	"\x06Orders\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12B\n" +
 	"\forder_status\x18\x02 \x03(\v2\x1f.gctrpc.Orders.OrderStatusEntryR\vorderStatus\x1a>\n" +
@@ -15456,9 +18816,10 @@ const file_rpc_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"C\n" +
 	"\x19CancelBatchOrdersResponse\x12&\n" +
-	"\x06orders\x18\x01 \x03(\v2\x0e.gctrpc.OrdersR\x06orders\"4\n" +
+	"\x06orders\x18\x01 \x03(\v2\x0e.gctrpc.OrdersR\x06orders\"N\n" +
 	"\x16CancelAllOrdersRequest\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\"W\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\"W\n" +
 	"\x17CancelAllOrdersResponse\x12&\n" +
 	"\x06orders\x18\x01 \x03(\v2\x0e.gctrpc.OrdersR\x06orders\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x03R\x05count\"\x12\n" +
@@ -15516,13 +18877,14 @@ const file_rpc_proto_rawDesc = "" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12&\n" +
 	"\x0ecryptocurrency\x18\x02 \x01(\tR\x0ecryptocurrency\"<\n" +
 	"\"GetAvailableTransferChainsResponse\x12\x16\n" +
-	"\x06chains\x18\x01 \x03(\tR\x06chains\"\xaf\x01\n" +
+	"\x06chains\x18\x01 \x03(\tR\x06chains\"\xc9\x01\n" +
 	"\x13WithdrawFiatRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12&\n" +
-	"\x0fbank_account_id\x18\x05 \x01(\tR\rbankAccountId\"\xec\x01\n" +
+	"\x0fbank_account_id\x18\x05 \x01(\tR\rbankAccountId\x12\x18\n" +
+	"\aaccount\x18\x06 \x01(\tR\aaccount\"\x86\x02\n" +
 	"\x15WithdrawCryptoRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1f\n" +
@@ -15532,7 +18894,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x10\n" +
 	"\x03fee\x18\x06 \x01(\x01R\x03fee\x12 \n" +
 	"\vdescription\x18\a \x01(\tR\vdescription\x12\x14\n" +
-	"\x05chain\x18\b \x01(\tR\x05chain\":\n" +
+	"\x05chain\x18\b \x01(\tR\x05chain\x12\x18\n" +
+	"\aaccount\x18\t \x01(\tR\aaccount\":\n" +
 	"\x10WithdrawResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\",\n" +
@@ -15660,7 +19023,63 @@ const file_rpc_proto_rawDesc = "" +
 	"\x03end\x18\x05 \x01(\tR\x03end\x12#\n" +
 	"\rtime_interval\x18\x06 \x01(\x03R\ftimeInterval\x12\x12\n" +
 	"\x04sync\x18\a \x01(\bR\x04sync\x12\x14\n" +
-	"\x05force\x18\b \x01(\bR\x05force\"\xe6\x02\n" +
+	"\x05force\x18\b \x01(\bR\x05force\"\x9d\x02\n" +
+	"\x19MarketDataTransferRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\tR\x03end\x12\x1b\n" +
+	"\tdata_type\x18\x06 \x01(\tR\bdataType\x12\x1a\n" +
+	"\binterval\x18\a \x01(\x03R\binterval\x12\x1c\n" +
+	"\tdirectory\x18\b \x01(\tR\tdirectory\x12\x1c\n" +
+	"\toverwrite\x18\t \x01(\bR\toverwrite\"P\n" +
+	"\x1aMarketDataTransferResponse\x12\x1c\n" +
+	"\tdirectory\x18\x01 \x01(\tR\tdirectory\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x04R\x05count\"\x95\x02\n" +
+	"\x1aRunDataQualityCheckRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12\x1b\n" +
+	"\tdata_type\x18\x04 \x01(\tR\bdataType\x12\x1a\n" +
+	"\binterval\x18\x05 \x01(\x03R\binterval\x12\x14\n" +
+	"\x05start\x18\x06 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\a \x01(\tR\x03end\x121\n" +
+	"\x14comparison_exchanges\x18\b \x03(\tR\x13comparisonExchanges\"\xdb\x01\n" +
+	"\x10DataQualityIssue\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x12/\n" +
+	"\x13comparison_exchange\x18\x05 \x01(\tR\x12comparisonExchange\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\"\n" +
+	"\rrepair_job_id\x18\a \x01(\tR\vrepairJobId\"\xd4\x02\n" +
+	"\x11DataQualityReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x04 \x01(\tR\tassetType\x12\x1b\n" +
+	"\tdata_type\x18\x05 \x01(\tR\bdataType\x12\x1a\n" +
+	"\binterval\x18\x06 \x01(\x03R\binterval\x12\x14\n" +
+	"\x05start\x18\a \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\b \x01(\tR\x03end\x12\x18\n" +
+	"\arecords\x18\t \x01(\x03R\arecords\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x120\n" +
+	"\x06issues\x18\v \x03(\v2\x18.gctrpc.DataQualityIssueR\x06issues\"\xab\x01\n" +
+	"\x1cGetDataQualityReportsRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\tR\x03end\"T\n" +
+	"\x1dGetDataQualityReportsResponse\x123\n" +
+	"\areports\x18\x01 \x03(\v2\x19.gctrpc.DataQualityReportR\areports\"\xe6\x02\n" +
 	"\x19GetHistoricCandlesRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
 	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
@@ -15704,9 +19123,32 @@ const file_rpc_proto_rawDesc = "" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04path\x18\x03 \x01(\tR\x04path\x12\x19\n" +
-	"\bnext_run\x18\x04 \x01(\tR\anextRun\"D\n" +
+	"\bnext_run\x18\x04 \x01(\tR\anextRun\"|\n" +
 	"\x17GCTScriptExecuteRequest\x12)\n" +
-	"\x06script\x18\x01 \x01(\v2\x11.gctrpc.GCTScriptR\x06script\"A\n" +
+	"\x06script\x18\x01 \x01(\v2\x11.gctrpc.GCTScriptR\x06script\x12\x14\n" +
+	"\x05debug\x18\x02 \x01(\bR\x05debug\x12 \n" +
+	"\vbreakpoints\x18\x03 \x03(\tR\vbreakpoints\"\xe5\x01\n" +
+	"\x13GCTScriptTraceEntry\x12\x16\n" +
+	"\x06module\x18\x01 \x01(\tR\x06module\x12\x1a\n" +
+	"\bfunction\x18\x02 \x01(\tR\bfunction\x12\x12\n" +
+	"\x04args\x18\x03 \x03(\tR\x04args\x12\x16\n" +
+	"\x06result\x18\x04 \x01(\tR\x06result\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\tR\ttimestamp\x12\x1a\n" +
+	"\bduration\x18\a \x01(\tR\bduration\x12\x1e\n" +
+	"\n" +
+	"breakpoint\x18\b \x01(\bR\n" +
+	"breakpoint\"y\n" +
+	"\x18GCTScriptExecuteResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\tR\x04data\x121\n" +
+	"\x05trace\x18\x03 \x03(\v2\x1b.gctrpc.GCTScriptTraceEntryR\x05trace\"C\n" +
+	"\x14GCTScriptREPLRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"E\n" +
+	"\x15GCTScriptREPLResponse\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"A\n" +
 	"\x14GCTScriptStopRequest\x12)\n" +
 	"\x06script\x18\x01 \x01(\v2\x11.gctrpc.GCTScriptR\x06script\"\x19\n" +
 	"\x17GCTScriptStopAllRequest\"\x18\n" +
@@ -15886,14 +19328,15 @@ const file_rpc_proto_rawDesc = "" +
 	"\x06status\x18\x03 \x01(\x03R\x06status\"\x81\x01\n" +
 	"'UpdateDataHistoryJobPrerequisiteRequest\x12\x1a\n" +
 	"\bnickname\x18\x01 \x01(\tR\bnickname\x12:\n" +
-	"\x19prerequisite_job_nickname\x18\x02 \x01(\tR\x17prerequisiteJobNickname\"\xb9\x01\n" +
+	"\x19prerequisite_job_nickname\x18\x02 \x01(\tR\x17prerequisiteJobNickname\"\xd3\x01\n" +
 	"\x12ModifyOrderRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\tR\aorderId\x12(\n" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
 	"\x05asset\x18\x04 \x01(\tR\x05asset\x12\x16\n" +
 	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x14\n" +
-	"\x05price\x18\x06 \x01(\x01R\x05price\"A\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x18\n" +
+	"\aaccount\x18\a \x01(\tR\aaccount\"A\n" +
 	"\x13ModifyOrderResponse\x12*\n" +
 	"\x11modified_order_id\x18\x01 \x01(\tR\x0fmodifiedOrderId\"8\n" +
 	"\x1aCurrencyStateGetAllRequest\x12\x1a\n" +
@@ -16367,7 +19810,207 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"/\n" +
 	"\x1bGetCurrencyTradeURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url2\xccl\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xfe\x01\n" +
+	"!GetTransactionCostAnalysisRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1a\n" +
+	"\bstrategy\x18\x04 \x01(\tR\bstrategy\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x06 \x01(\tR\aendDate\x12'\n" +
+	"\x0fgenerate_report\x18\a \x01(\bR\x0egenerateReport\"\xab\x06\n" +
+	"\x14OrderTransactionCost\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1a\n" +
+	"\bstrategy\x18\x04 \x01(\tR\bstrategy\x12\x19\n" +
+	"\border_id\x18\x05 \x01(\tR\aorderId\x12*\n" +
+	"\x11internal_order_id\x18\x06 \x01(\tR\x0finternalOrderId\x12\x12\n" +
+	"\x04side\x18\a \x01(\tR\x04side\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12!\n" +
+	"\fsubmitted_at\x18\t \x01(\tR\vsubmittedAt\x12\x16\n" +
+	"\x06amount\x18\n" +
+	" \x01(\x01R\x06amount\x12'\n" +
+	"\x0fexecuted_amount\x18\v \x01(\x01R\x0eexecutedAmount\x12#\n" +
+	"\rarrival_price\x18\f \x01(\x01R\farrivalPrice\x12%\n" +
+	"\x0earrival_source\x18\r \x01(\tR\rarrivalSource\x12!\n" +
+	"\faverage_fill\x18\x0e \x01(\x01R\vaverageFill\x12$\n" +
+	"\x0epost_trade_mid\x18\x0f \x01(\x01R\fpostTradeMid\x12\x1a\n" +
+	"\bnotional\x18\x10 \x01(\x01R\bnotional\x12\x12\n" +
+	"\x04fees\x18\x11 \x01(\x01R\x04fees\x120\n" +
+	"\x14arrival_slippage_bps\x18\x12 \x01(\x01R\x12arrivalSlippageBps\x129\n" +
+	"\x18implementation_shortfall\x18\x13 \x01(\x01R\x17implementationShortfall\x12@\n" +
+	"\x1cimplementation_shortfall_bps\x18\x14 \x01(\x01R\x1aimplementationShortfallBps\x12.\n" +
+	"\x13realised_spread_bps\x18\x15 \x01(\x01R\x11realisedSpreadBps\x12 \n" +
+	"\ffee_drag_bps\x18\x16 \x01(\x01R\n" +
+	"feeDragBps\"\xf5\x02\n" +
+	"\x18TransactionCostAggregate\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x16\n" +
+	"\x06orders\x18\x02 \x01(\x03R\x06orders\x12\x1a\n" +
+	"\bnotional\x18\x03 \x01(\x01R\bnotional\x12\x12\n" +
+	"\x04fees\x18\x04 \x01(\x01R\x04fees\x129\n" +
+	"\x18implementation_shortfall\x18\x05 \x01(\x01R\x17implementationShortfall\x120\n" +
+	"\x14arrival_slippage_bps\x18\x06 \x01(\x01R\x12arrivalSlippageBps\x12@\n" +
+	"\x1cimplementation_shortfall_bps\x18\a \x01(\x01R\x1aimplementationShortfallBps\x12.\n" +
+	"\x13realised_spread_bps\x18\b \x01(\x01R\x11realisedSpreadBps\x12 \n" +
+	"\ffee_drag_bps\x18\t \x01(\x01R\n" +
+	"feeDragBps\"\xbc\x02\n" +
+	"\"GetTransactionCostAnalysisResponse\x124\n" +
+	"\x06orders\x18\x01 \x03(\v2\x1c.gctrpc.OrderTransactionCostR\x06orders\x12A\n" +
+	"\vby_exchange\x18\x02 \x03(\v2 .gctrpc.TransactionCostAggregateR\n" +
+	"byExchange\x129\n" +
+	"\aby_pair\x18\x03 \x03(\v2 .gctrpc.TransactionCostAggregateR\x06byPair\x12A\n" +
+	"\vby_strategy\x18\x04 \x03(\v2 .gctrpc.TransactionCostAggregateR\n" +
+	"byStrategy\x12\x1f\n" +
+	"\vreport_path\x18\x05 \x01(\tR\n" +
+	"reportPath\"\x17\n" +
+	"\x15GetFuturesRiskRequest\"\xfb\x02\n" +
+	"\x13FuturesPositionRisk\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x04 \x01(\tR\n" +
+	"underlying\x12\x12\n" +
+	"\x04side\x18\x05 \x01(\tR\x04side\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x01R\x04size\x12\x1d\n" +
+	"\n" +
+	"mark_price\x18\a \x01(\x01R\tmarkPrice\x12\x1a\n" +
+	"\bnotional\x18\b \x01(\x01R\bnotional\x12%\n" +
+	"\x0eunrealised_pnl\x18\t \x01(\x01R\runrealisedPnl\x12+\n" +
+	"\x11liquidation_price\x18\n" +
+	" \x01(\x01R\x10liquidationPrice\x121\n" +
+	"\x14liquidation_distance\x18\v \x01(\x01R\x13liquidationDistance\"\xe6\x01\n" +
+	"\x19FuturesUnderlyingExposure\x12\x1e\n" +
+	"\n" +
+	"underlying\x18\x01 \x01(\tR\n" +
+	"underlying\x12\x1b\n" +
+	"\tnet_delta\x18\x02 \x01(\x01R\bnetDelta\x12!\n" +
+	"\fnet_notional\x18\x03 \x01(\x01R\vnetNotional\x12%\n" +
+	"\x0egross_notional\x18\x04 \x01(\x01R\rgrossNotional\x12$\n" +
+	"\rconcentration\x18\x05 \x01(\x01R\rconcentration\x12\x1c\n" +
+	"\texchanges\x18\x06 \x03(\tR\texchanges\"\xce\x02\n" +
+	"\x18FuturesAccountMarginRisk\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12/\n" +
+	"\x13collateral_currency\x18\x03 \x01(\tR\x12collateralCurrency\x12)\n" +
+	"\x10total_collateral\x18\x04 \x01(\x01R\x0ftotalCollateral\x12'\n" +
+	"\x0fused_collateral\x18\x05 \x01(\x01R\x0eusedCollateral\x121\n" +
+	"\x14available_collateral\x18\x06 \x01(\x01R\x13availableCollateral\x12%\n" +
+	"\x0eunrealised_pnl\x18\a \x01(\x01R\runrealisedPnl\x12!\n" +
+	"\fmargin_ratio\x18\b \x01(\x01R\vmarginRatio\"\x86\x01\n" +
+	"\x10FuturesRiskAlert\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\xcd\x02\n" +
+	"\x16GetFuturesRiskResponse\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12%\n" +
+	"\x0egross_notional\x18\x02 \x01(\x01R\rgrossNotional\x129\n" +
+	"\tpositions\x18\x03 \x03(\v2\x1b.gctrpc.FuturesPositionRiskR\tpositions\x12C\n" +
+	"\vunderlyings\x18\x04 \x03(\v2!.gctrpc.FuturesUnderlyingExposureR\vunderlyings\x12<\n" +
+	"\baccounts\x18\x05 \x03(\v2 .gctrpc.FuturesAccountMarginRiskR\baccounts\x120\n" +
+	"\x06alerts\x18\x06 \x03(\v2\x18.gctrpc.FuturesRiskAlertR\x06alerts\"\x9b\x01\n" +
+	"\x15CreateTransferRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12 \n" +
+	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05chain\x18\x05 \x01(\tR\x05chain\"\xa0\x04\n" +
+	"\bTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12 \n" +
+	"\vdestination\x18\x03 \x01(\tR\vdestination\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05chain\x18\x06 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x12\x1f\n" +
+	"\vaddress_tag\x18\b \x01(\tR\n" +
+	"addressTag\x12#\n" +
+	"\restimated_fee\x18\t \x01(\x01R\festimatedFee\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12#\n" +
+	"\rwithdrawal_id\x18\v \x01(\tR\fwithdrawalId\x124\n" +
+	"\x16exchange_withdrawal_id\x18\f \x01(\tR\x14exchangeWithdrawalId\x12\x13\n" +
+	"\x05tx_id\x18\r \x01(\tR\x04txId\x12\x14\n" +
+	"\x05error\x18\x0e \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\x12!\n" +
+	"\fsubmitted_at\x18\x10 \x01(\tR\vsubmittedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\tR\tupdatedAt\x12!\n" +
+	"\fcompleted_at\x18\x12 \x01(\tR\vcompletedAt\"=\n" +
+	"\x13GetTransfersRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"F\n" +
+	"\x14GetTransfersResponse\x12.\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x10.gctrpc.TransferR\ttransfers\"p\n" +
+	"\x18UnlockCredentialsRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\tR\aaccount\x12\x1e\n" +
+	"\n" +
+	"passphrase\x18\x03 \x01(\tR\n" +
+	"passphrase\"6\n" +
+	"\x18ReloadCredentialsRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"\xd3\x01\n" +
+	"\bAPIToken\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x1c\n" +
+	"\texchanges\x18\x04 \x03(\tR\texchanges\x12\x14\n" +
+	"\x05pairs\x18\x05 \x03(\tR\x05pairs\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x06 \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\tR\texpiresAt\"\x8b\x01\n" +
+	"\x15CreateAPITokenRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1c\n" +
+	"\texchanges\x18\x03 \x03(\tR\texchanges\x12\x14\n" +
+	"\x05pairs\x18\x04 \x03(\tR\x05pairs\x12\x16\n" +
+	"\x06expiry\x18\x05 \x01(\tR\x06expiry\"X\n" +
+	"\x16CreateAPITokenResponse\x12&\n" +
+	"\x05token\x18\x01 \x01(\v2\x10.gctrpc.APITokenR\x05token\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x15\n" +
+	"\x13GetAPITokensRequest\"@\n" +
+	"\x14GetAPITokensResponse\x12(\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x10.gctrpc.APITokenR\x06tokens\"'\n" +
+	"\x15RevokeAPITokenRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x13ReloadConfigRequest\x12\x1f\n" +
+	"\vconfig_path\x18\x01 \x01(\tR\n" +
+	"configPath\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"[\n" +
+	"\x14ReloadConfigResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x03(\tR\aapplied\x12)\n" +
+	"\x10restart_required\x18\x02 \x03(\tR\x0frestartRequired\"C\n" +
+	"\x12PatchConfigRequest\x12\x14\n" +
+	"\x05patch\x18\x01 \x01(\tR\x05patch\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"u\n" +
+	"\x14ConfigChangeResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x03(\tR\aapplied\x12)\n" +
+	"\x10restart_required\x18\x02 \x03(\tR\x0frestartRequired\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"\x19\n" +
+	"\x17GetConfigHistoryRequest\"b\n" +
+	"\rConfigVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x19\n" +
+	"\bsaved_at\x18\x02 \x01(\tR\asavedAt\x12\x1c\n" +
+	"\tencrypted\x18\x03 \x01(\bR\tencrypted\"M\n" +
+	"\x18GetConfigHistoryResponse\x121\n" +
+	"\bversions\x18\x01 \x03(\v2\x15.gctrpc.ConfigVersionR\bversions\"U\n" +
+	"\x11DiffConfigRequest\x12!\n" +
+	"\ffrom_version\x18\x01 \x01(\x04R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x02 \x01(\x04R\ttoVersion\"*\n" +
+	"\x12DiffConfigResponse\x12\x14\n" +
+	"\x05patch\x18\x01 \x01(\tR\x05patch\"J\n" +
+	"\x15RollbackConfigRequest\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun2\xbe~\n" +
 	"\x15GoCryptoTraderService\x12O\n" +
 	"\aGetInfo\x12\x16.gctrpc.GetInfoRequest\x1a\x17.gctrpc.GetInfoResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/getinfo\x12g\n" +
 	"\rGetSubsystems\x12\x1c.gctrpc.GetSubsystemsRequest\x1a\x1d.gctrpc.GetSubsystemsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getsubsystems\x12h\n" +
@@ -16423,8 +20066,9 @@ const file_rpc_proto_rawDesc = "" +
 	"\x1aGetExchangeOrderbookStream\x12).gctrpc.GetExchangeOrderbookStreamRequest\x1a\x19.gctrpc.OrderbookResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/getexchangeorderbookstream0\x01\x12h\n" +
 	"\x0fGetTickerStream\x12\x1e.gctrpc.GetTickerStreamRequest\x1a\x16.gctrpc.TickerResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/gettickerstream0\x01\x12\x80\x01\n" +
 	"\x17GetExchangeTickerStream\x12&.gctrpc.GetExchangeTickerStreamRequest\x1a\x16.gctrpc.TickerResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/getexchangetickerstream0\x01\x12g\n" +
-	"\rGetAuditEvent\x12\x1c.gctrpc.GetAuditEventRequest\x1a\x1d.gctrpc.GetAuditEventResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getauditevent\x12k\n" +
-	"\x10GCTScriptExecute\x12\x1f.gctrpc.GCTScriptExecuteRequest\x1a\x17.gctrpc.GenericResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/gctscript/execute\x12k\n" +
+	"\rGetAuditEvent\x12\x1c.gctrpc.GetAuditEventRequest\x1a\x1d.gctrpc.GetAuditEventResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getauditevent\x12t\n" +
+	"\x10GCTScriptExecute\x12\x1f.gctrpc.GCTScriptExecuteRequest\x1a .gctrpc.GCTScriptExecuteResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/gctscript/execute\x12o\n" +
+	"\rGCTScriptREPL\x12\x1c.gctrpc.GCTScriptREPLRequest\x1a\x1d.gctrpc.GCTScriptREPLResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/gctscript/repl(\x010\x01\x12k\n" +
 	"\x0fGCTScriptUpload\x12\x1e.gctrpc.GCTScriptUploadRequest\x1a\x17.gctrpc.GenericResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/gctscript/upload\x12x\n" +
 	"\x13GCTScriptReadScript\x12\".gctrpc.GCTScriptReadScriptRequest\x1a\x1e.gctrpc.GCTScriptQueryResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/gctscript/read\x12p\n" +
 	"\x0fGCTScriptStatus\x12\x1e.gctrpc.GCTScriptStatusRequest\x1a\x1f.gctrpc.GCTScriptStatusResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/gctscript/status\x12l\n" +
@@ -16448,7 +20092,11 @@ const file_rpc_proto_rawDesc = "" +
 	"\x0eGetSavedTrades\x12\x1d.gctrpc.GetSavedTradesRequest\x1a\x1b.gctrpc.SavedTradesResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/getsavedtrades\x12\x87\x01\n" +
 	"\x16ConvertTradesToCandles\x12%.gctrpc.ConvertTradesToCandlesRequest\x1a\".gctrpc.GetHistoricCandlesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/converttradestocandles\x12\x9d\x01\n" +
 	"\x1fFindMissingSavedCandleIntervals\x12'.gctrpc.FindMissingCandlePeriodsRequest\x1a$.gctrpc.FindMissingIntervalsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/findmissingsavedcandleintervals\x12\x9a\x01\n" +
-	"\x1eFindMissingSavedTradeIntervals\x12&.gctrpc.FindMissingTradePeriodsRequest\x1a$.gctrpc.FindMissingIntervalsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/findmissingsavedtradeintervals\x12\x88\x01\n" +
+	"\x1eFindMissingSavedTradeIntervals\x12&.gctrpc.FindMissingTradePeriodsRequest\x1a$.gctrpc.FindMissingIntervalsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/findmissingsavedtradeintervals\x12z\n" +
+	"\x10ExportMarketData\x12!.gctrpc.MarketDataTransferRequest\x1a\".gctrpc.MarketDataTransferResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/exportmarketdata\x12z\n" +
+	"\x10ImportMarketData\x12!.gctrpc.MarketDataTransferRequest\x1a\".gctrpc.MarketDataTransferResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/importmarketdata\x12x\n" +
+	"\x13RunDataQualityCheck\x12\".gctrpc.RunDataQualityCheckRequest\x1a\x19.gctrpc.DataQualityReport\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/rundataqualitycheck\x12\x87\x01\n" +
+	"\x15GetDataQualityReports\x12$.gctrpc.GetDataQualityReportsRequest\x1a%.gctrpc.GetDataQualityReportsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getdataqualityreports\x12\x88\x01\n" +
 	"\x1aSetExchangeTradeProcessing\x12).gctrpc.SetExchangeTradeProcessingRequest\x1a\x17.gctrpc.GenericResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/setexchangetradeprocessing\x12\x86\x01\n" +
 	"\x14UpsertDataHistoryJob\x12#.gctrpc.UpsertDataHistoryJobRequest\x1a$.gctrpc.UpsertDataHistoryJobResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/upsertdatahistoryjob\x12\x81\x01\n" +
 	"\x18GetDataHistoryJobDetails\x12'.gctrpc.GetDataHistoryJobDetailsRequest\x1a\x16.gctrpc.DataHistoryJob\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/getdatahistoryjobdetails\x12q\n" +
@@ -16484,7 +20132,23 @@ const file_rpc_proto_rawDesc = "" +
 	"\vSetLeverage\x12\x1a.gctrpc.SetLeverageRequest\x1a\x1b.gctrpc.SetLeverageResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/getleverage\x12\x86\x01\n" +
 	"\x14ChangePositionMargin\x12#.gctrpc.ChangePositionMarginRequest\x1a$.gctrpc.ChangePositionMarginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/changepositionmargin\x12o\n" +
 	"\x0fGetOpenInterest\x12\x1e.gctrpc.GetOpenInterestRequest\x1a\x1f.gctrpc.GetOpenInterestResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getopeninterest\x12\x7f\n" +
-	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurlB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
+	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurl\x12\x9b\x01\n" +
+	"\x1aGetTransactionCostAnalysis\x12).gctrpc.GetTransactionCostAnalysisRequest\x1a*.gctrpc.GetTransactionCostAnalysisResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/gettransactioncostanalysis\x12k\n" +
+	"\x0eGetFuturesRisk\x12\x1d.gctrpc.GetFuturesRiskRequest\x1a\x1e.gctrpc.GetFuturesRiskResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/getfuturesrisk\x12y\n" +
+	"\x14GetFuturesRiskStream\x12\x1d.gctrpc.GetFuturesRiskRequest\x1a\x1e.gctrpc.GetFuturesRiskResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/getfuturesriskstream0\x01\x12`\n" +
+	"\x0eCreateTransfer\x12\x1d.gctrpc.CreateTransferRequest\x1a\x10.gctrpc.Transfer\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/createtransfer\x12c\n" +
+	"\fGetTransfers\x12\x1b.gctrpc.GetTransfersRequest\x1a\x1c.gctrpc.GetTransfersResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/gettransfers\x12p\n" +
+	"\x11UnlockCredentials\x12 .gctrpc.UnlockCredentialsRequest\x1a\x17.gctrpc.GenericResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/unlockcredentials\x12p\n" +
+	"\x11ReloadCredentials\x12 .gctrpc.ReloadCredentialsRequest\x1a\x17.gctrpc.GenericResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/reloadcredentials\x12n\n" +
+	"\x0eCreateAPIToken\x12\x1d.gctrpc.CreateAPITokenRequest\x1a\x1e.gctrpc.CreateAPITokenResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/createapitoken\x12c\n" +
+	"\fGetAPITokens\x12\x1b.gctrpc.GetAPITokensRequest\x1a\x1c.gctrpc.GetAPITokensResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/getapitokens\x12g\n" +
+	"\x0eRevokeAPIToken\x12\x1d.gctrpc.RevokeAPITokenRequest\x1a\x17.gctrpc.GenericResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/revokeapitoken\x12f\n" +
+	"\fReloadConfig\x12\x1b.gctrpc.ReloadConfigRequest\x1a\x1c.gctrpc.ReloadConfigResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/reloadconfig\x12c\n" +
+	"\vPatchConfig\x12\x1a.gctrpc.PatchConfigRequest\x1a\x1c.gctrpc.ConfigChangeResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/patchconfig\x12s\n" +
+	"\x10GetConfigHistory\x12\x1f.gctrpc.GetConfigHistoryRequest\x1a .gctrpc.GetConfigHistoryResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/getconfighistory\x12[\n" +
+	"\n" +
+	"DiffConfig\x12\x19.gctrpc.DiffConfigRequest\x1a\x1a.gctrpc.DiffConfigResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/diffconfig\x12l\n" +
+	"\x0eRollbackConfig\x12\x1d.gctrpc.RollbackConfigRequest\x1a\x1c.gctrpc.ConfigChangeResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/rollbackconfigB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
 
 var (
 	file_rpc_proto_rawDescOnce sync.Once
@@ -16498,7 +20162,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 240)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 284)
 var file_rpc_proto_goTypes = []any{
 	(*GetInfoRequest)(nil),                            // 0: gctrpc.GetInfoRequest
 	(*GetInfoResponse)(nil),                           // 1: gctrpc.GetInfoResponse
@@ -16547,209 +20211,253 @@ var file_rpc_proto_goTypes = []any{
 	(*OnlineCoinSummary)(nil),                         // 44: gctrpc.OnlineCoinSummary
 	(*OfflineCoins)(nil),                              // 45: gctrpc.OfflineCoins
 	(*OnlineCoins)(nil),                               // 46: gctrpc.OnlineCoins
-	(*GetPortfolioSummaryResponse)(nil),               // 47: gctrpc.GetPortfolioSummaryResponse
-	(*AddPortfolioAddressRequest)(nil),                // 48: gctrpc.AddPortfolioAddressRequest
-	(*RemovePortfolioAddressRequest)(nil),             // 49: gctrpc.RemovePortfolioAddressRequest
-	(*GetForexProvidersRequest)(nil),                  // 50: gctrpc.GetForexProvidersRequest
-	(*ForexProvider)(nil),                             // 51: gctrpc.ForexProvider
-	(*GetForexProvidersResponse)(nil),                 // 52: gctrpc.GetForexProvidersResponse
-	(*GetForexRatesRequest)(nil),                      // 53: gctrpc.GetForexRatesRequest
-	(*ForexRatesConversion)(nil),                      // 54: gctrpc.ForexRatesConversion
-	(*GetForexRatesResponse)(nil),                     // 55: gctrpc.GetForexRatesResponse
-	(*OrderDetails)(nil),                              // 56: gctrpc.OrderDetails
-	(*TradeHistory)(nil),                              // 57: gctrpc.TradeHistory
-	(*GetOrdersRequest)(nil),                          // 58: gctrpc.GetOrdersRequest
-	(*GetOrdersResponse)(nil),                         // 59: gctrpc.GetOrdersResponse
-	(*GetOrderRequest)(nil),                           // 60: gctrpc.GetOrderRequest
-	(*SubmitOrderRequest)(nil),                        // 61: gctrpc.SubmitOrderRequest
-	(*Trades)(nil),                                    // 62: gctrpc.Trades
-	(*SubmitOrderResponse)(nil),                       // 63: gctrpc.SubmitOrderResponse
-	(*SimulateOrderRequest)(nil),                      // 64: gctrpc.SimulateOrderRequest
-	(*SimulateOrderResponse)(nil),                     // 65: gctrpc.SimulateOrderResponse
-	(*WhaleBombRequest)(nil),                          // 66: gctrpc.WhaleBombRequest
-	(*CancelOrderRequest)(nil),                        // 67: gctrpc.CancelOrderRequest
-	(*CancelBatchOrdersRequest)(nil),                  // 68: gctrpc.CancelBatchOrdersRequest
-	(*Orders)(nil),                                    // 69: gctrpc.Orders
-	(*CancelBatchOrdersResponse)(nil),                 // 70: gctrpc.CancelBatchOrdersResponse
-	(*CancelAllOrdersRequest)(nil),                    // 71: gctrpc.CancelAllOrdersRequest
-	(*CancelAllOrdersResponse)(nil),                   // 72: gctrpc.CancelAllOrdersResponse
-	(*GetEventsRequest)(nil),                          // 73: gctrpc.GetEventsRequest
-	(*ConditionParams)(nil),                           // 74: gctrpc.ConditionParams
-	(*GetEventsResponse)(nil),                         // 75: gctrpc.GetEventsResponse
-	(*AddEventRequest)(nil),                           // 76: gctrpc.AddEventRequest
-	(*AddEventResponse)(nil),                          // 77: gctrpc.AddEventResponse
-	(*RemoveEventRequest)(nil),                        // 78: gctrpc.RemoveEventRequest
-	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 79: gctrpc.GetCryptocurrencyDepositAddressesRequest
-	(*DepositAddress)(nil),                            // 80: gctrpc.DepositAddress
-	(*DepositAddresses)(nil),                          // 81: gctrpc.DepositAddresses
-	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 82: gctrpc.GetCryptocurrencyDepositAddressesResponse
-	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 83: gctrpc.GetCryptocurrencyDepositAddressRequest
-	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 84: gctrpc.GetCryptocurrencyDepositAddressResponse
-	(*GetAvailableTransferChainsRequest)(nil),         // 85: gctrpc.GetAvailableTransferChainsRequest
-	(*GetAvailableTransferChainsResponse)(nil),        // 86: gctrpc.GetAvailableTransferChainsResponse
-	(*WithdrawFiatRequest)(nil),                       // 87: gctrpc.WithdrawFiatRequest
-	(*WithdrawCryptoRequest)(nil),                     // 88: gctrpc.WithdrawCryptoRequest
-	(*WithdrawResponse)(nil),                          // 89: gctrpc.WithdrawResponse
-	(*WithdrawalEventByIDRequest)(nil),                // 90: gctrpc.WithdrawalEventByIDRequest
-	(*WithdrawalEventByIDResponse)(nil),               // 91: gctrpc.WithdrawalEventByIDResponse
-	(*WithdrawalEventsByExchangeRequest)(nil),         // 92: gctrpc.WithdrawalEventsByExchangeRequest
-	(*WithdrawalEventsByDateRequest)(nil),             // 93: gctrpc.WithdrawalEventsByDateRequest
-	(*WithdrawalEventsByExchangeResponse)(nil),        // 94: gctrpc.WithdrawalEventsByExchangeResponse
-	(*WithdrawalEventResponse)(nil),                   // 95: gctrpc.WithdrawalEventResponse
-	(*WithdrawalExchangeEvent)(nil),                   // 96: gctrpc.WithdrawalExchangeEvent
-	(*WithdrawalRequestEvent)(nil),                    // 97: gctrpc.WithdrawalRequestEvent
-	(*FiatWithdrawalEvent)(nil),                       // 98: gctrpc.FiatWithdrawalEvent
-	(*CryptoWithdrawalEvent)(nil),                     // 99: gctrpc.CryptoWithdrawalEvent
-	(*GetLoggerDetailsRequest)(nil),                   // 100: gctrpc.GetLoggerDetailsRequest
-	(*GetLoggerDetailsResponse)(nil),                  // 101: gctrpc.GetLoggerDetailsResponse
-	(*SetLoggerDetailsRequest)(nil),                   // 102: gctrpc.SetLoggerDetailsRequest
-	(*GetExchangePairsRequest)(nil),                   // 103: gctrpc.GetExchangePairsRequest
-	(*GetExchangePairsResponse)(nil),                  // 104: gctrpc.GetExchangePairsResponse
-	(*SetExchangePairRequest)(nil),                    // 105: gctrpc.SetExchangePairRequest
-	(*GetOrderbookStreamRequest)(nil),                 // 106: gctrpc.GetOrderbookStreamRequest
-	(*GetExchangeOrderbookStreamRequest)(nil),         // 107: gctrpc.GetExchangeOrderbookStreamRequest
-	(*GetTickerStreamRequest)(nil),                    // 108: gctrpc.GetTickerStreamRequest
-	(*GetExchangeTickerStreamRequest)(nil),            // 109: gctrpc.GetExchangeTickerStreamRequest
-	(*GetAuditEventRequest)(nil),                      // 110: gctrpc.GetAuditEventRequest
-	(*GetAuditEventResponse)(nil),                     // 111: gctrpc.GetAuditEventResponse
-	(*GetSavedTradesRequest)(nil),                     // 112: gctrpc.GetSavedTradesRequest
-	(*SavedTrades)(nil),                               // 113: gctrpc.SavedTrades
-	(*SavedTradesResponse)(nil),                       // 114: gctrpc.SavedTradesResponse
-	(*ConvertTradesToCandlesRequest)(nil),             // 115: gctrpc.ConvertTradesToCandlesRequest
-	(*GetHistoricCandlesRequest)(nil),                 // 116: gctrpc.GetHistoricCandlesRequest
-	(*GetHistoricCandlesResponse)(nil),                // 117: gctrpc.GetHistoricCandlesResponse
-	(*Candle)(nil),                                    // 118: gctrpc.Candle
-	(*AuditEvent)(nil),                                // 119: gctrpc.AuditEvent
-	(*GCTScript)(nil),                                 // 120: gctrpc.GCTScript
-	(*GCTScriptExecuteRequest)(nil),                   // 121: gctrpc.GCTScriptExecuteRequest
-	(*GCTScriptStopRequest)(nil),                      // 122: gctrpc.GCTScriptStopRequest
-	(*GCTScriptStopAllRequest)(nil),                   // 123: gctrpc.GCTScriptStopAllRequest
-	(*GCTScriptStatusRequest)(nil),                    // 124: gctrpc.GCTScriptStatusRequest
-	(*GCTScriptListAllRequest)(nil),                   // 125: gctrpc.GCTScriptListAllRequest
-	(*GCTScriptUploadRequest)(nil),                    // 126: gctrpc.GCTScriptUploadRequest
-	(*GCTScriptReadScriptRequest)(nil),                // 127: gctrpc.GCTScriptReadScriptRequest
-	(*GCTScriptQueryRequest)(nil),                     // 128: gctrpc.GCTScriptQueryRequest
-	(*GCTScriptAutoLoadRequest)(nil),                  // 129: gctrpc.GCTScriptAutoLoadRequest
-	(*GCTScriptStatusResponse)(nil),                   // 130: gctrpc.GCTScriptStatusResponse
-	(*GCTScriptQueryResponse)(nil),                    // 131: gctrpc.GCTScriptQueryResponse
-	(*GenericResponse)(nil),                           // 132: gctrpc.GenericResponse
-	(*SetExchangeAssetRequest)(nil),                   // 133: gctrpc.SetExchangeAssetRequest
-	(*SetExchangeAllPairsRequest)(nil),                // 134: gctrpc.SetExchangeAllPairsRequest
-	(*UpdateExchangeSupportedPairsRequest)(nil),       // 135: gctrpc.UpdateExchangeSupportedPairsRequest
-	(*GetExchangeAssetsRequest)(nil),                  // 136: gctrpc.GetExchangeAssetsRequest
-	(*GetExchangeAssetsResponse)(nil),                 // 137: gctrpc.GetExchangeAssetsResponse
-	(*WebsocketGetInfoRequest)(nil),                   // 138: gctrpc.WebsocketGetInfoRequest
-	(*WebsocketGetInfoResponse)(nil),                  // 139: gctrpc.WebsocketGetInfoResponse
-	(*WebsocketSetEnabledRequest)(nil),                // 140: gctrpc.WebsocketSetEnabledRequest
-	(*WebsocketGetSubscriptionsRequest)(nil),          // 141: gctrpc.WebsocketGetSubscriptionsRequest
-	(*WebsocketSubscription)(nil),                     // 142: gctrpc.WebsocketSubscription
-	(*WebsocketGetSubscriptionsResponse)(nil),         // 143: gctrpc.WebsocketGetSubscriptionsResponse
-	(*WebsocketSetProxyRequest)(nil),                  // 144: gctrpc.WebsocketSetProxyRequest
-	(*WebsocketSetURLRequest)(nil),                    // 145: gctrpc.WebsocketSetURLRequest
-	(*FindMissingCandlePeriodsRequest)(nil),           // 146: gctrpc.FindMissingCandlePeriodsRequest
-	(*FindMissingTradePeriodsRequest)(nil),            // 147: gctrpc.FindMissingTradePeriodsRequest
-	(*FindMissingIntervalsResponse)(nil),              // 148: gctrpc.FindMissingIntervalsResponse
-	(*SetExchangeTradeProcessingRequest)(nil),         // 149: gctrpc.SetExchangeTradeProcessingRequest
-	(*UpsertDataHistoryJobRequest)(nil),               // 150: gctrpc.UpsertDataHistoryJobRequest
-	(*InsertSequentialJobsRequest)(nil),               // 151: gctrpc.InsertSequentialJobsRequest
-	(*InsertSequentialJobsResponse)(nil),              // 152: gctrpc.InsertSequentialJobsResponse
-	(*UpsertDataHistoryJobResponse)(nil),              // 153: gctrpc.UpsertDataHistoryJobResponse
-	(*GetDataHistoryJobDetailsRequest)(nil),           // 154: gctrpc.GetDataHistoryJobDetailsRequest
-	(*DataHistoryJob)(nil),                            // 155: gctrpc.DataHistoryJob
-	(*DataHistoryJobResult)(nil),                      // 156: gctrpc.DataHistoryJobResult
-	(*DataHistoryJobs)(nil),                           // 157: gctrpc.DataHistoryJobs
-	(*GetDataHistoryJobsBetweenRequest)(nil),          // 158: gctrpc.GetDataHistoryJobsBetweenRequest
-	(*SetDataHistoryJobStatusRequest)(nil),            // 159: gctrpc.SetDataHistoryJobStatusRequest
-	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 160: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	(*ModifyOrderRequest)(nil),                        // 161: gctrpc.ModifyOrderRequest
-	(*ModifyOrderResponse)(nil),                       // 162: gctrpc.ModifyOrderResponse
-	(*CurrencyStateGetAllRequest)(nil),                // 163: gctrpc.CurrencyStateGetAllRequest
-	(*CurrencyStateTradingRequest)(nil),               // 164: gctrpc.CurrencyStateTradingRequest
-	(*CurrencyStateTradingPairRequest)(nil),           // 165: gctrpc.CurrencyStateTradingPairRequest
-	(*CurrencyStateWithdrawRequest)(nil),              // 166: gctrpc.CurrencyStateWithdrawRequest
-	(*CurrencyStateDepositRequest)(nil),               // 167: gctrpc.CurrencyStateDepositRequest
-	(*CurrencyStateResponse)(nil),                     // 168: gctrpc.CurrencyStateResponse
-	(*CurrencyState)(nil),                             // 169: gctrpc.CurrencyState
-	(*FundingRate)(nil),                               // 170: gctrpc.FundingRate
-	(*FundingData)(nil),                               // 171: gctrpc.FundingData
-	(*FuturesPositionStats)(nil),                      // 172: gctrpc.FuturesPositionStats
-	(*FuturePosition)(nil),                            // 173: gctrpc.FuturePosition
-	(*GetManagedPositionRequest)(nil),                 // 174: gctrpc.GetManagedPositionRequest
-	(*GetAllManagedPositionsRequest)(nil),             // 175: gctrpc.GetAllManagedPositionsRequest
-	(*GetManagedPositionsResponse)(nil),               // 176: gctrpc.GetManagedPositionsResponse
-	(*GetFuturesPositionsSummaryRequest)(nil),         // 177: gctrpc.GetFuturesPositionsSummaryRequest
-	(*GetFuturesPositionsSummaryResponse)(nil),        // 178: gctrpc.GetFuturesPositionsSummaryResponse
-	(*GetFuturesPositionsOrdersRequest)(nil),          // 179: gctrpc.GetFuturesPositionsOrdersRequest
-	(*GetFuturesPositionsOrdersResponse)(nil),         // 180: gctrpc.GetFuturesPositionsOrdersResponse
-	(*GetCollateralModeRequest)(nil),                  // 181: gctrpc.GetCollateralModeRequest
-	(*GetCollateralModeResponse)(nil),                 // 182: gctrpc.GetCollateralModeResponse
-	(*SetCollateralModeRequest)(nil),                  // 183: gctrpc.SetCollateralModeRequest
-	(*SetCollateralModeResponse)(nil),                 // 184: gctrpc.SetCollateralModeResponse
-	(*GetMarginTypeRequest)(nil),                      // 185: gctrpc.GetMarginTypeRequest
-	(*GetMarginTypeResponse)(nil),                     // 186: gctrpc.GetMarginTypeResponse
-	(*ChangePositionMarginRequest)(nil),               // 187: gctrpc.ChangePositionMarginRequest
-	(*ChangePositionMarginResponse)(nil),              // 188: gctrpc.ChangePositionMarginResponse
-	(*SetMarginTypeRequest)(nil),                      // 189: gctrpc.SetMarginTypeRequest
-	(*SetMarginTypeResponse)(nil),                     // 190: gctrpc.SetMarginTypeResponse
-	(*GetLeverageRequest)(nil),                        // 191: gctrpc.GetLeverageRequest
-	(*GetLeverageResponse)(nil),                       // 192: gctrpc.GetLeverageResponse
-	(*SetLeverageRequest)(nil),                        // 193: gctrpc.SetLeverageRequest
-	(*SetLeverageResponse)(nil),                       // 194: gctrpc.SetLeverageResponse
-	(*GetCollateralRequest)(nil),                      // 195: gctrpc.GetCollateralRequest
-	(*GetCollateralResponse)(nil),                     // 196: gctrpc.GetCollateralResponse
-	(*CollateralForCurrency)(nil),                     // 197: gctrpc.CollateralForCurrency
-	(*CollateralByPosition)(nil),                      // 198: gctrpc.CollateralByPosition
-	(*CollateralUsedBreakdown)(nil),                   // 199: gctrpc.CollateralUsedBreakdown
-	(*GetFundingRatesRequest)(nil),                    // 200: gctrpc.GetFundingRatesRequest
-	(*GetFundingRatesResponse)(nil),                   // 201: gctrpc.GetFundingRatesResponse
-	(*GetLatestFundingRateRequest)(nil),               // 202: gctrpc.GetLatestFundingRateRequest
-	(*GetLatestFundingRateResponse)(nil),              // 203: gctrpc.GetLatestFundingRateResponse
-	(*ShutdownRequest)(nil),                           // 204: gctrpc.ShutdownRequest
-	(*ShutdownResponse)(nil),                          // 205: gctrpc.ShutdownResponse
-	(*GetTechnicalAnalysisRequest)(nil),               // 206: gctrpc.GetTechnicalAnalysisRequest
-	(*ListOfSignals)(nil),                             // 207: gctrpc.ListOfSignals
-	(*GetTechnicalAnalysisResponse)(nil),              // 208: gctrpc.GetTechnicalAnalysisResponse
-	(*GetMarginRatesHistoryRequest)(nil),              // 209: gctrpc.GetMarginRatesHistoryRequest
-	(*LendingPayment)(nil),                            // 210: gctrpc.LendingPayment
-	(*BorrowCost)(nil),                                // 211: gctrpc.BorrowCost
-	(*MarginRate)(nil),                                // 212: gctrpc.MarginRate
-	(*GetMarginRatesHistoryResponse)(nil),             // 213: gctrpc.GetMarginRatesHistoryResponse
-	(*GetOrderbookMovementRequest)(nil),               // 214: gctrpc.GetOrderbookMovementRequest
-	(*GetOrderbookMovementResponse)(nil),              // 215: gctrpc.GetOrderbookMovementResponse
-	(*GetOrderbookAmountByNominalRequest)(nil),        // 216: gctrpc.GetOrderbookAmountByNominalRequest
-	(*GetOrderbookAmountByNominalResponse)(nil),       // 217: gctrpc.GetOrderbookAmountByNominalResponse
-	(*GetOrderbookAmountByImpactRequest)(nil),         // 218: gctrpc.GetOrderbookAmountByImpactRequest
-	(*GetOrderbookAmountByImpactResponse)(nil),        // 219: gctrpc.GetOrderbookAmountByImpactResponse
-	(*GetOpenInterestRequest)(nil),                    // 220: gctrpc.GetOpenInterestRequest
-	(*OpenInterestDataRequest)(nil),                   // 221: gctrpc.OpenInterestDataRequest
-	(*GetOpenInterestResponse)(nil),                   // 222: gctrpc.GetOpenInterestResponse
-	(*OpenInterestDataResponse)(nil),                  // 223: gctrpc.OpenInterestDataResponse
-	(*GetCurrencyTradeURLRequest)(nil),                // 224: gctrpc.GetCurrencyTradeURLRequest
-	(*GetCurrencyTradeURLResponse)(nil),               // 225: gctrpc.GetCurrencyTradeURLResponse
-	nil,                                               // 226: gctrpc.GetInfoResponse.SubsystemStatusEntry
-	nil,                                               // 227: gctrpc.GetInfoResponse.RpcEndpointsEntry
-	nil,                                               // 228: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	nil,                                               // 229: gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
-	nil,                                               // 230: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	nil,                                               // 231: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	nil,                                               // 232: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
-	nil,                                               // 233: gctrpc.OnlineCoins.CoinsEntry
-	nil,                                               // 234: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
-	nil,                                               // 235: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	nil,                                               // 236: gctrpc.Orders.OrderStatusEntry
-	nil,                                               // 237: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	nil,                                               // 238: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	nil,                                               // 239: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	(*timestamppb.Timestamp)(nil),                     // 240: google.protobuf.Timestamp
+	(*AccountHoldings)(nil),                           // 47: gctrpc.AccountHoldings
+	(*GetPortfolioSummaryResponse)(nil),               // 48: gctrpc.GetPortfolioSummaryResponse
+	(*AddPortfolioAddressRequest)(nil),                // 49: gctrpc.AddPortfolioAddressRequest
+	(*RemovePortfolioAddressRequest)(nil),             // 50: gctrpc.RemovePortfolioAddressRequest
+	(*GetForexProvidersRequest)(nil),                  // 51: gctrpc.GetForexProvidersRequest
+	(*ForexProvider)(nil),                             // 52: gctrpc.ForexProvider
+	(*GetForexProvidersResponse)(nil),                 // 53: gctrpc.GetForexProvidersResponse
+	(*GetForexRatesRequest)(nil),                      // 54: gctrpc.GetForexRatesRequest
+	(*ForexRatesConversion)(nil),                      // 55: gctrpc.ForexRatesConversion
+	(*GetForexRatesResponse)(nil),                     // 56: gctrpc.GetForexRatesResponse
+	(*OrderDetails)(nil),                              // 57: gctrpc.OrderDetails
+	(*TradeHistory)(nil),                              // 58: gctrpc.TradeHistory
+	(*GetOrdersRequest)(nil),                          // 59: gctrpc.GetOrdersRequest
+	(*GetOrdersResponse)(nil),                         // 60: gctrpc.GetOrdersResponse
+	(*GetOrderRequest)(nil),                           // 61: gctrpc.GetOrderRequest
+	(*SubmitOrderRequest)(nil),                        // 62: gctrpc.SubmitOrderRequest
+	(*Trades)(nil),                                    // 63: gctrpc.Trades
+	(*SubmitOrderResponse)(nil),                       // 64: gctrpc.SubmitOrderResponse
+	(*SimulateOrderRequest)(nil),                      // 65: gctrpc.SimulateOrderRequest
+	(*SimulateOrderResponse)(nil),                     // 66: gctrpc.SimulateOrderResponse
+	(*WhaleBombRequest)(nil),                          // 67: gctrpc.WhaleBombRequest
+	(*CancelOrderRequest)(nil),                        // 68: gctrpc.CancelOrderRequest
+	(*CancelBatchOrdersRequest)(nil),                  // 69: gctrpc.CancelBatchOrdersRequest
+	(*Orders)(nil),                                    // 70: gctrpc.Orders
+	(*CancelBatchOrdersResponse)(nil),                 // 71: gctrpc.CancelBatchOrdersResponse
+	(*CancelAllOrdersRequest)(nil),                    // 72: gctrpc.CancelAllOrdersRequest
+	(*CancelAllOrdersResponse)(nil),                   // 73: gctrpc.CancelAllOrdersResponse
+	(*GetEventsRequest)(nil),                          // 74: gctrpc.GetEventsRequest
+	(*ConditionParams)(nil),                           // 75: gctrpc.ConditionParams
+	(*GetEventsResponse)(nil),                         // 76: gctrpc.GetEventsResponse
+	(*AddEventRequest)(nil),                           // 77: gctrpc.AddEventRequest
+	(*AddEventResponse)(nil),                          // 78: gctrpc.AddEventResponse
+	(*RemoveEventRequest)(nil),                        // 79: gctrpc.RemoveEventRequest
+	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 80: gctrpc.GetCryptocurrencyDepositAddressesRequest
+	(*DepositAddress)(nil),                            // 81: gctrpc.DepositAddress
+	(*DepositAddresses)(nil),                          // 82: gctrpc.DepositAddresses
+	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 83: gctrpc.GetCryptocurrencyDepositAddressesResponse
+	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 84: gctrpc.GetCryptocurrencyDepositAddressRequest
+	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 85: gctrpc.GetCryptocurrencyDepositAddressResponse
+	(*GetAvailableTransferChainsRequest)(nil),         // 86: gctrpc.GetAvailableTransferChainsRequest
+	(*GetAvailableTransferChainsResponse)(nil),        // 87: gctrpc.GetAvailableTransferChainsResponse
+	(*WithdrawFiatRequest)(nil),                       // 88: gctrpc.WithdrawFiatRequest
+	(*WithdrawCryptoRequest)(nil),                     // 89: gctrpc.WithdrawCryptoRequest
+	(*WithdrawResponse)(nil),                          // 90: gctrpc.WithdrawResponse
+	(*WithdrawalEventByIDRequest)(nil),                // 91: gctrpc.WithdrawalEventByIDRequest
+	(*WithdrawalEventByIDResponse)(nil),               // 92: gctrpc.WithdrawalEventByIDResponse
+	(*WithdrawalEventsByExchangeRequest)(nil),         // 93: gctrpc.WithdrawalEventsByExchangeRequest
+	(*WithdrawalEventsByDateRequest)(nil),             // 94: gctrpc.WithdrawalEventsByDateRequest
+	(*WithdrawalEventsByExchangeResponse)(nil),        // 95: gctrpc.WithdrawalEventsByExchangeResponse
+	(*WithdrawalEventResponse)(nil),                   // 96: gctrpc.WithdrawalEventResponse
+	(*WithdrawalExchangeEvent)(nil),                   // 97: gctrpc.WithdrawalExchangeEvent
+	(*WithdrawalRequestEvent)(nil),                    // 98: gctrpc.WithdrawalRequestEvent
+	(*FiatWithdrawalEvent)(nil),                       // 99: gctrpc.FiatWithdrawalEvent
+	(*CryptoWithdrawalEvent)(nil),                     // 100: gctrpc.CryptoWithdrawalEvent
+	(*GetLoggerDetailsRequest)(nil),                   // 101: gctrpc.GetLoggerDetailsRequest
+	(*GetLoggerDetailsResponse)(nil),                  // 102: gctrpc.GetLoggerDetailsResponse
+	(*SetLoggerDetailsRequest)(nil),                   // 103: gctrpc.SetLoggerDetailsRequest
+	(*GetExchangePairsRequest)(nil),                   // 104: gctrpc.GetExchangePairsRequest
+	(*GetExchangePairsResponse)(nil),                  // 105: gctrpc.GetExchangePairsResponse
+	(*SetExchangePairRequest)(nil),                    // 106: gctrpc.SetExchangePairRequest
+	(*GetOrderbookStreamRequest)(nil),                 // 107: gctrpc.GetOrderbookStreamRequest
+	(*GetExchangeOrderbookStreamRequest)(nil),         // 108: gctrpc.GetExchangeOrderbookStreamRequest
+	(*GetTickerStreamRequest)(nil),                    // 109: gctrpc.GetTickerStreamRequest
+	(*GetExchangeTickerStreamRequest)(nil),            // 110: gctrpc.GetExchangeTickerStreamRequest
+	(*GetAuditEventRequest)(nil),                      // 111: gctrpc.GetAuditEventRequest
+	(*GetAuditEventResponse)(nil),                     // 112: gctrpc.GetAuditEventResponse
+	(*GetSavedTradesRequest)(nil),                     // 113: gctrpc.GetSavedTradesRequest
+	(*SavedTrades)(nil),                               // 114: gctrpc.SavedTrades
+	(*SavedTradesResponse)(nil),                       // 115: gctrpc.SavedTradesResponse
+	(*ConvertTradesToCandlesRequest)(nil),             // 116: gctrpc.ConvertTradesToCandlesRequest
+	(*MarketDataTransferRequest)(nil),                 // 117: gctrpc.MarketDataTransferRequest
+	(*MarketDataTransferResponse)(nil),                // 118: gctrpc.MarketDataTransferResponse
+	(*RunDataQualityCheckRequest)(nil),                // 119: gctrpc.RunDataQualityCheckRequest
+	(*DataQualityIssue)(nil),                          // 120: gctrpc.DataQualityIssue
+	(*DataQualityReport)(nil),                         // 121: gctrpc.DataQualityReport
+	(*GetDataQualityReportsRequest)(nil),              // 122: gctrpc.GetDataQualityReportsRequest
+	(*GetDataQualityReportsResponse)(nil),             // 123: gctrpc.GetDataQualityReportsResponse
+	(*GetHistoricCandlesRequest)(nil),                 // 124: gctrpc.GetHistoricCandlesRequest
+	(*GetHistoricCandlesResponse)(nil),                // 125: gctrpc.GetHistoricCandlesResponse
+	(*Candle)(nil),                                    // 126: gctrpc.Candle
+	(*AuditEvent)(nil),                                // 127: gctrpc.AuditEvent
+	(*GCTScript)(nil),                                 // 128: gctrpc.GCTScript
+	(*GCTScriptExecuteRequest)(nil),                   // 129: gctrpc.GCTScriptExecuteRequest
+	(*GCTScriptTraceEntry)(nil),                       // 130: gctrpc.GCTScriptTraceEntry
+	(*GCTScriptExecuteResponse)(nil),                  // 131: gctrpc.GCTScriptExecuteResponse
+	(*GCTScriptREPLRequest)(nil),                      // 132: gctrpc.GCTScriptREPLRequest
+	(*GCTScriptREPLResponse)(nil),                     // 133: gctrpc.GCTScriptREPLResponse
+	(*GCTScriptStopRequest)(nil),                      // 134: gctrpc.GCTScriptStopRequest
+	(*GCTScriptStopAllRequest)(nil),                   // 135: gctrpc.GCTScriptStopAllRequest
+	(*GCTScriptStatusRequest)(nil),                    // 136: gctrpc.GCTScriptStatusRequest
+	(*GCTScriptListAllRequest)(nil),                   // 137: gctrpc.GCTScriptListAllRequest
+	(*GCTScriptUploadRequest)(nil),                    // 138: gctrpc.GCTScriptUploadRequest
+	(*GCTScriptReadScriptRequest)(nil),                // 139: gctrpc.GCTScriptReadScriptRequest
+	(*GCTScriptQueryRequest)(nil),                     // 140: gctrpc.GCTScriptQueryRequest
+	(*GCTScriptAutoLoadRequest)(nil),                  // 141: gctrpc.GCTScriptAutoLoadRequest
+	(*GCTScriptStatusResponse)(nil),                   // 142: gctrpc.GCTScriptStatusResponse
+	(*GCTScriptQueryResponse)(nil),                    // 143: gctrpc.GCTScriptQueryResponse
+	(*GenericResponse)(nil),                           // 144: gctrpc.GenericResponse
+	(*SetExchangeAssetRequest)(nil),                   // 145: gctrpc.SetExchangeAssetRequest
+	(*SetExchangeAllPairsRequest)(nil),                // 146: gctrpc.SetExchangeAllPairsRequest
+	(*UpdateExchangeSupportedPairsRequest)(nil),       // 147: gctrpc.UpdateExchangeSupportedPairsRequest
+	(*GetExchangeAssetsRequest)(nil),                  // 148: gctrpc.GetExchangeAssetsRequest
+	(*GetExchangeAssetsResponse)(nil),                 // 149: gctrpc.GetExchangeAssetsResponse
+	(*WebsocketGetInfoRequest)(nil),                   // 150: gctrpc.WebsocketGetInfoRequest
+	(*WebsocketGetInfoResponse)(nil),                  // 151: gctrpc.WebsocketGetInfoResponse
+	(*WebsocketSetEnabledRequest)(nil),                // 152: gctrpc.WebsocketSetEnabledRequest
+	(*WebsocketGetSubscriptionsRequest)(nil),          // 153: gctrpc.WebsocketGetSubscriptionsRequest
+	(*WebsocketSubscription)(nil),                     // 154: gctrpc.WebsocketSubscription
+	(*WebsocketGetSubscriptionsResponse)(nil),         // 155: gctrpc.WebsocketGetSubscriptionsResponse
+	(*WebsocketSetProxyRequest)(nil),                  // 156: gctrpc.WebsocketSetProxyRequest
+	(*WebsocketSetURLRequest)(nil),                    // 157: gctrpc.WebsocketSetURLRequest
+	(*FindMissingCandlePeriodsRequest)(nil),           // 158: gctrpc.FindMissingCandlePeriodsRequest
+	(*FindMissingTradePeriodsRequest)(nil),            // 159: gctrpc.FindMissingTradePeriodsRequest
+	(*FindMissingIntervalsResponse)(nil),              // 160: gctrpc.FindMissingIntervalsResponse
+	(*SetExchangeTradeProcessingRequest)(nil),         // 161: gctrpc.SetExchangeTradeProcessingRequest
+	(*UpsertDataHistoryJobRequest)(nil),               // 162: gctrpc.UpsertDataHistoryJobRequest
+	(*InsertSequentialJobsRequest)(nil),               // 163: gctrpc.InsertSequentialJobsRequest
+	(*InsertSequentialJobsResponse)(nil),              // 164: gctrpc.InsertSequentialJobsResponse
+	(*UpsertDataHistoryJobResponse)(nil),              // 165: gctrpc.UpsertDataHistoryJobResponse
+	(*GetDataHistoryJobDetailsRequest)(nil),           // 166: gctrpc.GetDataHistoryJobDetailsRequest
+	(*DataHistoryJob)(nil),                            // 167: gctrpc.DataHistoryJob
+	(*DataHistoryJobResult)(nil),                      // 168: gctrpc.DataHistoryJobResult
+	(*DataHistoryJobs)(nil),                           // 169: gctrpc.DataHistoryJobs
+	(*GetDataHistoryJobsBetweenRequest)(nil),          // 170: gctrpc.GetDataHistoryJobsBetweenRequest
+	(*SetDataHistoryJobStatusRequest)(nil),            // 171: gctrpc.SetDataHistoryJobStatusRequest
+	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 172: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	(*ModifyOrderRequest)(nil),                        // 173: gctrpc.ModifyOrderRequest
+	(*ModifyOrderResponse)(nil),                       // 174: gctrpc.ModifyOrderResponse
+	(*CurrencyStateGetAllRequest)(nil),                // 175: gctrpc.CurrencyStateGetAllRequest
+	(*CurrencyStateTradingRequest)(nil),               // 176: gctrpc.CurrencyStateTradingRequest
+	(*CurrencyStateTradingPairRequest)(nil),           // 177: gctrpc.CurrencyStateTradingPairRequest
+	(*CurrencyStateWithdrawRequest)(nil),              // 178: gctrpc.CurrencyStateWithdrawRequest
+	(*CurrencyStateDepositRequest)(nil),               // 179: gctrpc.CurrencyStateDepositRequest
+	(*CurrencyStateResponse)(nil),                     // 180: gctrpc.CurrencyStateResponse
+	(*CurrencyState)(nil),                             // 181: gctrpc.CurrencyState
+	(*FundingRate)(nil),                               // 182: gctrpc.FundingRate
+	(*FundingData)(nil),                               // 183: gctrpc.FundingData
+	(*FuturesPositionStats)(nil),                      // 184: gctrpc.FuturesPositionStats
+	(*FuturePosition)(nil),                            // 185: gctrpc.FuturePosition
+	(*GetManagedPositionRequest)(nil),                 // 186: gctrpc.GetManagedPositionRequest
+	(*GetAllManagedPositionsRequest)(nil),             // 187: gctrpc.GetAllManagedPositionsRequest
+	(*GetManagedPositionsResponse)(nil),               // 188: gctrpc.GetManagedPositionsResponse
+	(*GetFuturesPositionsSummaryRequest)(nil),         // 189: gctrpc.GetFuturesPositionsSummaryRequest
+	(*GetFuturesPositionsSummaryResponse)(nil),        // 190: gctrpc.GetFuturesPositionsSummaryResponse
+	(*GetFuturesPositionsOrdersRequest)(nil),          // 191: gctrpc.GetFuturesPositionsOrdersRequest
+	(*GetFuturesPositionsOrdersResponse)(nil),         // 192: gctrpc.GetFuturesPositionsOrdersResponse
+	(*GetCollateralModeRequest)(nil),                  // 193: gctrpc.GetCollateralModeRequest
+	(*GetCollateralModeResponse)(nil),                 // 194: gctrpc.GetCollateralModeResponse
+	(*SetCollateralModeRequest)(nil),                  // 195: gctrpc.SetCollateralModeRequest
+	(*SetCollateralModeResponse)(nil),                 // 196: gctrpc.SetCollateralModeResponse
+	(*GetMarginTypeRequest)(nil),                      // 197: gctrpc.GetMarginTypeRequest
+	(*GetMarginTypeResponse)(nil),                     // 198: gctrpc.GetMarginTypeResponse
+	(*ChangePositionMarginRequest)(nil),               // 199: gctrpc.ChangePositionMarginRequest
+	(*ChangePositionMarginResponse)(nil),              // 200: gctrpc.ChangePositionMarginResponse
+	(*SetMarginTypeRequest)(nil),                      // 201: gctrpc.SetMarginTypeRequest
+	(*SetMarginTypeResponse)(nil),                     // 202: gctrpc.SetMarginTypeResponse
+	(*GetLeverageRequest)(nil),                        // 203: gctrpc.GetLeverageRequest
+	(*GetLeverageResponse)(nil),                       // 204: gctrpc.GetLeverageResponse
+	(*SetLeverageRequest)(nil),                        // 205: gctrpc.SetLeverageRequest
+	(*SetLeverageResponse)(nil),                       // 206: gctrpc.SetLeverageResponse
+	(*GetCollateralRequest)(nil),                      // 207: gctrpc.GetCollateralRequest
+	(*GetCollateralResponse)(nil),                     // 208: gctrpc.GetCollateralResponse
+	(*CollateralForCurrency)(nil),                     // 209: gctrpc.CollateralForCurrency
+	(*CollateralByPosition)(nil),                      // 210: gctrpc.CollateralByPosition
+	(*CollateralUsedBreakdown)(nil),                   // 211: gctrpc.CollateralUsedBreakdown
+	(*GetFundingRatesRequest)(nil),                    // 212: gctrpc.GetFundingRatesRequest
+	(*GetFundingRatesResponse)(nil),                   // 213: gctrpc.GetFundingRatesResponse
+	(*GetLatestFundingRateRequest)(nil),               // 214: gctrpc.GetLatestFundingRateRequest
+	(*GetLatestFundingRateResponse)(nil),              // 215: gctrpc.GetLatestFundingRateResponse
+	(*ShutdownRequest)(nil),                           // 216: gctrpc.ShutdownRequest
+	(*ShutdownResponse)(nil),                          // 217: gctrpc.ShutdownResponse
+	(*GetTechnicalAnalysisRequest)(nil),               // 218: gctrpc.GetTechnicalAnalysisRequest
+	(*ListOfSignals)(nil),                             // 219: gctrpc.ListOfSignals
+	(*GetTechnicalAnalysisResponse)(nil),              // 220: gctrpc.GetTechnicalAnalysisResponse
+	(*GetMarginRatesHistoryRequest)(nil),              // 221: gctrpc.GetMarginRatesHistoryRequest
+	(*LendingPayment)(nil),                            // 222: gctrpc.LendingPayment
+	(*BorrowCost)(nil),                                // 223: gctrpc.BorrowCost
+	(*MarginRate)(nil),                                // 224: gctrpc.MarginRate
+	(*GetMarginRatesHistoryResponse)(nil),             // 225: gctrpc.GetMarginRatesHistoryResponse
+	(*GetOrderbookMovementRequest)(nil),               // 226: gctrpc.GetOrderbookMovementRequest
+	(*GetOrderbookMovementResponse)(nil),              // 227: gctrpc.GetOrderbookMovementResponse
+	(*GetOrderbookAmountByNominalRequest)(nil),        // 228: gctrpc.GetOrderbookAmountByNominalRequest
+	(*GetOrderbookAmountByNominalResponse)(nil),       // 229: gctrpc.GetOrderbookAmountByNominalResponse
+	(*GetOrderbookAmountByImpactRequest)(nil),         // 230: gctrpc.GetOrderbookAmountByImpactRequest
+	(*GetOrderbookAmountByImpactResponse)(nil),        // 231: gctrpc.GetOrderbookAmountByImpactResponse
+	(*GetOpenInterestRequest)(nil),                    // 232: gctrpc.GetOpenInterestRequest
+	(*OpenInterestDataRequest)(nil),                   // 233: gctrpc.OpenInterestDataRequest
+	(*GetOpenInterestResponse)(nil),                   // 234: gctrpc.GetOpenInterestResponse
+	(*OpenInterestDataResponse)(nil),                  // 235: gctrpc.OpenInterestDataResponse
+	(*GetCurrencyTradeURLRequest)(nil),                // 236: gctrpc.GetCurrencyTradeURLRequest
+	(*GetCurrencyTradeURLResponse)(nil),               // 237: gctrpc.GetCurrencyTradeURLResponse
+	(*GetTransactionCostAnalysisRequest)(nil),         // 238: gctrpc.GetTransactionCostAnalysisRequest
+	(*OrderTransactionCost)(nil),                      // 239: gctrpc.OrderTransactionCost
+	(*TransactionCostAggregate)(nil),                  // 240: gctrpc.TransactionCostAggregate
+	(*GetTransactionCostAnalysisResponse)(nil),        // 241: gctrpc.GetTransactionCostAnalysisResponse
+	(*GetFuturesRiskRequest)(nil),                     // 242: gctrpc.GetFuturesRiskRequest
+	(*FuturesPositionRisk)(nil),                       // 243: gctrpc.FuturesPositionRisk
+	(*FuturesUnderlyingExposure)(nil),                 // 244: gctrpc.FuturesUnderlyingExposure
+	(*FuturesAccountMarginRisk)(nil),                  // 245: gctrpc.FuturesAccountMarginRisk
+	(*FuturesRiskAlert)(nil),                          // 246: gctrpc.FuturesRiskAlert
+	(*GetFuturesRiskResponse)(nil),                    // 247: gctrpc.GetFuturesRiskResponse
+	(*CreateTransferRequest)(nil),                     // 248: gctrpc.CreateTransferRequest
+	(*Transfer)(nil),                                  // 249: gctrpc.Transfer
+	(*GetTransfersRequest)(nil),                       // 250: gctrpc.GetTransfersRequest
+	(*GetTransfersResponse)(nil),                      // 251: gctrpc.GetTransfersResponse
+	(*UnlockCredentialsRequest)(nil),                  // 252: gctrpc.UnlockCredentialsRequest
+	(*ReloadCredentialsRequest)(nil),                  // 253: gctrpc.ReloadCredentialsRequest
+	(*APIToken)(nil),                                  // 254: gctrpc.APIToken
+	(*CreateAPITokenRequest)(nil),                     // 255: gctrpc.CreateAPITokenRequest
+	(*CreateAPITokenResponse)(nil),                    // 256: gctrpc.CreateAPITokenResponse
+	(*GetAPITokensRequest)(nil),                       // 257: gctrpc.GetAPITokensRequest
+	(*GetAPITokensResponse)(nil),                      // 258: gctrpc.GetAPITokensResponse
+	(*RevokeAPITokenRequest)(nil),                     // 259: gctrpc.RevokeAPITokenRequest
+	(*ReloadConfigRequest)(nil),                       // 260: gctrpc.ReloadConfigRequest
+	(*ReloadConfigResponse)(nil),                      // 261: gctrpc.ReloadConfigResponse
+	(*PatchConfigRequest)(nil),                        // 262: gctrpc.PatchConfigRequest
+	(*ConfigChangeResponse)(nil),                      // 263: gctrpc.ConfigChangeResponse
+	(*GetConfigHistoryRequest)(nil),                   // 264: gctrpc.GetConfigHistoryRequest
+	(*ConfigVersion)(nil),                             // 265: gctrpc.ConfigVersion
+	(*GetConfigHistoryResponse)(nil),                  // 266: gctrpc.GetConfigHistoryResponse
+	(*DiffConfigRequest)(nil),                         // 267: gctrpc.DiffConfigRequest
+	(*DiffConfigResponse)(nil),                        // 268: gctrpc.DiffConfigResponse
+	(*RollbackConfigRequest)(nil),                     // 269: gctrpc.RollbackConfigRequest
+	nil,                                               // 270: gctrpc.GetInfoResponse.SubsystemStatusEntry
+	nil,                                               // 271: gctrpc.GetInfoResponse.RpcEndpointsEntry
+	nil,                                               // 272: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	nil,                                               // 273: gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
+	nil,                                               // 274: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	nil,                                               // 275: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	nil,                                               // 276: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	nil,                                               // 277: gctrpc.OnlineCoins.CoinsEntry
+	nil,                                               // 278: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	nil,                                               // 279: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	nil,                                               // 280: gctrpc.Orders.OrderStatusEntry
+	nil,                                               // 281: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	nil,                                               // 282: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	nil,                                               // 283: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	(*timestamppb.Timestamp)(nil),                     // 284: google.protobuf.Timestamp
 }
 var file_rpc_proto_depIdxs = []int32{
-	226, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
-	227, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
-	228, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	229, // 3: gctrpc.GetSubsystemsResponse.subsystems_status:type_name -> gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
-	230, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	231, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	232, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	270, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
+	271, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
+	272, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	273, // 3: gctrpc.GetSubsystemsResponse.subsystems_status:type_name -> gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
+	274, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	275, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	276, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
 	21,  // 7: gctrpc.GetTickerRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 8: gctrpc.TickerResponse.pair:type_name -> gctrpc.CurrencyPair
 	22,  // 9: gctrpc.Tickers.tickers:type_name -> gctrpc.TickerResponse
@@ -16761,379 +20469,443 @@ var file_rpc_proto_depIdxs = []int32{
 	28,  // 15: gctrpc.Orderbooks.orderbooks:type_name -> gctrpc.OrderbookResponse
 	30,  // 16: gctrpc.GetOrderbooksResponse.orderbooks:type_name -> gctrpc.Orderbooks
 	34,  // 17: gctrpc.Account.currencies:type_name -> gctrpc.AccountCurrencyInfo
-	240, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
+	284, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
 	33,  // 19: gctrpc.GetAccountBalancesResponse.accounts:type_name -> gctrpc.Account
 	38,  // 20: gctrpc.GetPortfolioResponse.portfolio:type_name -> gctrpc.PortfolioAddress
 	43,  // 21: gctrpc.OfflineCoins.addresses:type_name -> gctrpc.OfflineCoinSummary
-	233, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
-	42,  // 23: gctrpc.GetPortfolioSummaryResponse.coin_totals:type_name -> gctrpc.Coin
-	42,  // 24: gctrpc.GetPortfolioSummaryResponse.coins_offline:type_name -> gctrpc.Coin
-	234, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
-	42,  // 26: gctrpc.GetPortfolioSummaryResponse.coins_online:type_name -> gctrpc.Coin
-	235, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	51,  // 28: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
-	54,  // 29: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
-	57,  // 30: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
-	21,  // 31: gctrpc.GetOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 32: gctrpc.GetOrdersResponse.orders:type_name -> gctrpc.OrderDetails
-	21,  // 33: gctrpc.GetOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 34: gctrpc.SubmitOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	62,  // 35: gctrpc.SubmitOrderResponse.trades:type_name -> gctrpc.Trades
-	21,  // 36: gctrpc.SimulateOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	27,  // 37: gctrpc.SimulateOrderResponse.orders:type_name -> gctrpc.OrderbookItem
-	21,  // 38: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 39: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 40: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	236, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
-	69,  // 42: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
-	69,  // 43: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
-	74,  // 44: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 45: gctrpc.GetEventsResponse.pair:type_name -> gctrpc.CurrencyPair
-	74,  // 46: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 47: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
-	80,  // 48: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
-	237, // 49: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	95,  // 50: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	95,  // 51: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	96,  // 52: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawalExchangeEvent
-	97,  // 53: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
-	240, // 54: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
-	240, // 55: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
-	98,  // 56: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
-	99,  // 57: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
-	238, // 58: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	21,  // 59: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
-	21,  // 60: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 61: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	119, // 62: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
-	21,  // 63: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 64: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
-	113, // 65: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
-	21,  // 66: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 67: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 68: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
-	118, // 69: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
-	120, // 70: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
-	120, // 71: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
-	120, // 72: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
-	120, // 73: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
-	120, // 74: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
-	120, // 75: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
-	142, // 76: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
-	21,  // 77: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 78: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 79: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 80: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
-	150, // 81: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
-	153, // 82: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
-	21,  // 83: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
-	156, // 84: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
-	155, // 85: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
-	21,  // 86: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	169, // 87: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
-	21,  // 88: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
-	170, // 89: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
-	170, // 90: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
-	170, // 91: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
-	21,  // 92: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 93: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
-	172, // 94: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
-	171, // 95: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
-	21,  // 96: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
-	173, // 97: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 98: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 99: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 100: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
-	172, // 101: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
-	21,  // 102: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 103: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	173, // 104: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 105: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 106: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 107: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 108: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 109: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 110: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 111: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 112: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 113: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 114: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 115: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 116: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 117: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 118: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	199, // 119: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	197, // 120: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
-	198, // 121: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
-	199, // 122: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	21,  // 123: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 124: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
-	21,  // 125: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 126: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
-	21,  // 127: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
-	240, // 128: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
-	240, // 129: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
-	21,  // 130: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
-	239, // 131: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	212, // 132: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
-	210, // 133: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
-	211, // 134: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
-	212, // 135: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
-	212, // 136: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
-	212, // 137: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
-	21,  // 138: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 139: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 140: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
-	221, // 141: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
-	21,  // 142: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
-	223, // 143: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
-	21,  // 144: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 145: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
-	9,   // 146: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	3,   // 147: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
-	9,   // 148: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	18,  // 149: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	44,  // 150: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
-	45,  // 151: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
-	46,  // 152: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
-	81,  // 153: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
-	18,  // 154: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	207, // 155: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
-	0,   // 156: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
-	6,   // 157: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
-	5,   // 158: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	5,   // 159: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	8,   // 160: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
-	2,   // 161: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
-	12,  // 162: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
-	11,  // 163: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 164: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 165: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
-	15,  // 166: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
-	11,  // 167: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	20,  // 168: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
-	23,  // 169: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
-	26,  // 170: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
-	29,  // 171: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
-	32,  // 172: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 173: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 174: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
-	36,  // 175: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
-	39,  // 176: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
-	41,  // 177: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
-	48,  // 178: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
-	49,  // 179: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
-	50,  // 180: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
-	53,  // 181: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
-	58,  // 182: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
-	60,  // 183: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
-	61,  // 184: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
-	64,  // 185: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
-	66,  // 186: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
-	67,  // 187: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
-	68,  // 188: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
-	71,  // 189: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
-	73,  // 190: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
-	76,  // 191: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
-	78,  // 192: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
-	79,  // 193: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
-	83,  // 194: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
-	85,  // 195: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
-	87,  // 196: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
-	88,  // 197: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
-	90,  // 198: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
-	92,  // 199: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
-	93,  // 200: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
-	100, // 201: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
-	102, // 202: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
-	103, // 203: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
-	105, // 204: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
-	106, // 205: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
-	107, // 206: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
-	108, // 207: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
-	109, // 208: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
-	110, // 209: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
-	121, // 210: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
-	126, // 211: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
-	127, // 212: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
-	124, // 213: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
-	128, // 214: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
-	122, // 215: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
-	123, // 216: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
-	125, // 217: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
-	129, // 218: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
-	116, // 219: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
-	133, // 220: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
-	134, // 221: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
-	135, // 222: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
-	136, // 223: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
-	138, // 224: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
-	140, // 225: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
-	141, // 226: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
-	144, // 227: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
-	145, // 228: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
-	112, // 229: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 230: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 231: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
-	115, // 232: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
-	146, // 233: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
-	147, // 234: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
-	149, // 235: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
-	150, // 236: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
-	154, // 237: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	0,   // 238: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
-	158, // 239: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
-	154, // 240: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	159, // 241: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
-	160, // 242: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	58,  // 243: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
-	161, // 244: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
-	163, // 245: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
-	164, // 246: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
-	167, // 247: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
-	166, // 248: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
-	165, // 249: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
-	177, // 250: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
-	179, // 251: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
-	195, // 252: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
-	204, // 253: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
-	206, // 254: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
-	209, // 255: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
-	174, // 256: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
-	175, // 257: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
-	200, // 258: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
-	202, // 259: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
-	214, // 260: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
-	216, // 261: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
-	218, // 262: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
-	181, // 263: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
-	191, // 264: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
-	183, // 265: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
-	189, // 266: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
-	193, // 267: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
-	187, // 268: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
-	220, // 269: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
-	224, // 270: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
-	1,   // 271: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
-	7,   // 272: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSubsystemsResponse
-	132, // 273: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
-	132, // 274: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
-	10,  // 275: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
-	4,   // 276: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
-	13,  // 277: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
-	132, // 278: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
-	19,  // 279: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
-	14,  // 280: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
-	16,  // 281: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
-	132, // 282: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
-	22,  // 283: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
-	25,  // 284: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
-	28,  // 285: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
-	31,  // 286: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
-	35,  // 287: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 288: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 289: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
-	37,  // 290: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
-	40,  // 291: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
-	47,  // 292: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
-	132, // 293: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
-	132, // 294: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
-	52,  // 295: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
-	55,  // 296: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
-	59,  // 297: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
-	56,  // 298: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
-	63,  // 299: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
-	65,  // 300: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
-	65,  // 301: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
-	132, // 302: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
-	70,  // 303: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
-	72,  // 304: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
-	75,  // 305: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
-	77,  // 306: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
-	132, // 307: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
-	82,  // 308: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
-	84,  // 309: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
-	86,  // 310: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
-	89,  // 311: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
-	89,  // 312: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
-	91,  // 313: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
-	94,  // 314: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	94,  // 315: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	101, // 316: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	101, // 317: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	104, // 318: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
-	132, // 319: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
-	28,  // 320: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	28,  // 321: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	22,  // 322: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
-	22,  // 323: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
-	111, // 324: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
-	132, // 325: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
-	132, // 326: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
-	131, // 327: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
-	130, // 328: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
-	131, // 329: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
-	132, // 330: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
-	132, // 331: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
-	130, // 332: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
-	132, // 333: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
-	117, // 334: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	132, // 335: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
-	132, // 336: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
-	132, // 337: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
-	137, // 338: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
-	139, // 339: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
-	132, // 340: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
-	143, // 341: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
-	132, // 342: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
-	132, // 343: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
-	114, // 344: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 345: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 346: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
-	117, // 347: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	148, // 348: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	148, // 349: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	132, // 350: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
-	153, // 351: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
-	155, // 352: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
-	157, // 353: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
-	157, // 354: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
-	155, // 355: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
-	132, // 356: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
-	132, // 357: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
-	59,  // 358: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
-	162, // 359: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
-	168, // 360: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
-	132, // 361: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
-	132, // 362: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
-	132, // 363: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
-	132, // 364: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
-	178, // 365: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
-	180, // 366: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
-	196, // 367: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
-	205, // 368: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
-	208, // 369: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
-	213, // 370: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
-	176, // 371: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
-	176, // 372: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
-	201, // 373: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
-	203, // 374: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
-	215, // 375: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
-	217, // 376: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
-	219, // 377: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
-	182, // 378: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
-	192, // 379: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
-	184, // 380: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
-	190, // 381: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
-	194, // 382: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
-	188, // 383: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
-	222, // 384: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
-	225, // 385: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
-	271, // [271:386] is the sub-list for method output_type
-	156, // [156:271] is the sub-list for method input_type
-	156, // [156:156] is the sub-list for extension type_name
-	156, // [156:156] is the sub-list for extension extendee
-	0,   // [0:156] is the sub-list for field type_name
+	277, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
+	34,  // 23: gctrpc.AccountHoldings.currencies:type_name -> gctrpc.AccountCurrencyInfo
+	42,  // 24: gctrpc.GetPortfolioSummaryResponse.coin_totals:type_name -> gctrpc.Coin
+	42,  // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline:type_name -> gctrpc.Coin
+	278, // 26: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	42,  // 27: gctrpc.GetPortfolioSummaryResponse.coins_online:type_name -> gctrpc.Coin
+	279, // 28: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	47,  // 29: gctrpc.GetPortfolioSummaryResponse.account_holdings:type_name -> gctrpc.AccountHoldings
+	52,  // 30: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
+	55,  // 31: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
+	58,  // 32: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
+	21,  // 33: gctrpc.GetOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	57,  // 34: gctrpc.GetOrdersResponse.orders:type_name -> gctrpc.OrderDetails
+	21,  // 35: gctrpc.GetOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 36: gctrpc.SubmitOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	63,  // 37: gctrpc.SubmitOrderResponse.trades:type_name -> gctrpc.Trades
+	21,  // 38: gctrpc.SimulateOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	27,  // 39: gctrpc.SimulateOrderResponse.orders:type_name -> gctrpc.OrderbookItem
+	21,  // 40: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 41: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 42: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	280, // 43: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
+	70,  // 44: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
+	70,  // 45: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
+	75,  // 46: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 47: gctrpc.GetEventsResponse.pair:type_name -> gctrpc.CurrencyPair
+	75,  // 48: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 49: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
+	81,  // 50: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
+	281, // 51: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	96,  // 52: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	96,  // 53: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	97,  // 54: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawalExchangeEvent
+	98,  // 55: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
+	284, // 56: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
+	284, // 57: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
+	99,  // 58: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
+	100, // 59: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
+	282, // 60: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	21,  // 61: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
+	21,  // 62: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 63: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	127, // 64: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
+	21,  // 65: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 66: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
+	114, // 67: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
+	21,  // 68: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 69: gctrpc.MarketDataTransferRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 70: gctrpc.RunDataQualityCheckRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 71: gctrpc.DataQualityReport.pair:type_name -> gctrpc.CurrencyPair
+	120, // 72: gctrpc.DataQualityReport.issues:type_name -> gctrpc.DataQualityIssue
+	21,  // 73: gctrpc.GetDataQualityReportsRequest.pair:type_name -> gctrpc.CurrencyPair
+	121, // 74: gctrpc.GetDataQualityReportsResponse.reports:type_name -> gctrpc.DataQualityReport
+	21,  // 75: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 76: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
+	126, // 77: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
+	128, // 78: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
+	130, // 79: gctrpc.GCTScriptExecuteResponse.trace:type_name -> gctrpc.GCTScriptTraceEntry
+	128, // 80: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
+	128, // 81: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
+	128, // 82: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
+	128, // 83: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
+	128, // 84: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
+	154, // 85: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
+	21,  // 86: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 87: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 88: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 89: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
+	162, // 90: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
+	165, // 91: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
+	21,  // 92: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
+	168, // 93: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
+	167, // 94: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
+	21,  // 95: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	181, // 96: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
+	21,  // 97: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
+	182, // 98: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
+	182, // 99: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
+	182, // 100: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
+	21,  // 101: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
+	57,  // 102: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
+	184, // 103: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
+	183, // 104: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
+	21,  // 105: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
+	185, // 106: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 107: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 108: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 109: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
+	184, // 110: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
+	21,  // 111: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 112: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	185, // 113: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 114: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 115: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 116: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 117: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 118: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 119: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 120: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 121: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 122: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 123: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 124: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 125: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 126: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 127: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	211, // 128: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	209, // 129: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
+	210, // 130: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
+	211, // 131: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	21,  // 132: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
+	183, // 133: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
+	21,  // 134: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
+	183, // 135: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
+	21,  // 136: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
+	284, // 137: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
+	284, // 138: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
+	21,  // 139: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
+	283, // 140: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	224, // 141: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
+	222, // 142: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
+	223, // 143: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
+	224, // 144: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
+	224, // 145: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
+	224, // 146: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
+	21,  // 147: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 148: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 149: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
+	233, // 150: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
+	21,  // 151: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
+	235, // 152: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
+	21,  // 153: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 154: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 155: gctrpc.GetTransactionCostAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 156: gctrpc.OrderTransactionCost.pair:type_name -> gctrpc.CurrencyPair
+	239, // 157: gctrpc.GetTransactionCostAnalysisResponse.orders:type_name -> gctrpc.OrderTransactionCost
+	240, // 158: gctrpc.GetTransactionCostAnalysisResponse.by_exchange:type_name -> gctrpc.TransactionCostAggregate
+	240, // 159: gctrpc.GetTransactionCostAnalysisResponse.by_pair:type_name -> gctrpc.TransactionCostAggregate
+	240, // 160: gctrpc.GetTransactionCostAnalysisResponse.by_strategy:type_name -> gctrpc.TransactionCostAggregate
+	21,  // 161: gctrpc.FuturesPositionRisk.pair:type_name -> gctrpc.CurrencyPair
+	243, // 162: gctrpc.GetFuturesRiskResponse.positions:type_name -> gctrpc.FuturesPositionRisk
+	244, // 163: gctrpc.GetFuturesRiskResponse.underlyings:type_name -> gctrpc.FuturesUnderlyingExposure
+	245, // 164: gctrpc.GetFuturesRiskResponse.accounts:type_name -> gctrpc.FuturesAccountMarginRisk
+	246, // 165: gctrpc.GetFuturesRiskResponse.alerts:type_name -> gctrpc.FuturesRiskAlert
+	249, // 166: gctrpc.GetTransfersResponse.transfers:type_name -> gctrpc.Transfer
+	254, // 167: gctrpc.CreateAPITokenResponse.token:type_name -> gctrpc.APIToken
+	254, // 168: gctrpc.GetAPITokensResponse.tokens:type_name -> gctrpc.APIToken
+	265, // 169: gctrpc.GetConfigHistoryResponse.versions:type_name -> gctrpc.ConfigVersion
+	9,   // 170: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	3,   // 171: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
+	9,   // 172: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	18,  // 173: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	44,  // 174: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
+	45,  // 175: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
+	46,  // 176: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
+	82,  // 177: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
+	18,  // 178: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	219, // 179: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
+	0,   // 180: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
+	6,   // 181: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
+	5,   // 182: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	5,   // 183: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	8,   // 184: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
+	2,   // 185: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
+	12,  // 186: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
+	11,  // 187: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 188: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 189: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
+	15,  // 190: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
+	11,  // 191: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	20,  // 192: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
+	23,  // 193: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
+	26,  // 194: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
+	29,  // 195: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
+	32,  // 196: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 197: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 198: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
+	36,  // 199: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
+	39,  // 200: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
+	41,  // 201: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
+	49,  // 202: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
+	50,  // 203: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
+	51,  // 204: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
+	54,  // 205: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
+	59,  // 206: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
+	61,  // 207: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
+	62,  // 208: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
+	65,  // 209: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
+	67,  // 210: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
+	68,  // 211: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
+	69,  // 212: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
+	72,  // 213: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
+	74,  // 214: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
+	77,  // 215: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
+	79,  // 216: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
+	80,  // 217: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
+	84,  // 218: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
+	86,  // 219: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
+	88,  // 220: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
+	89,  // 221: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
+	91,  // 222: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
+	93,  // 223: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
+	94,  // 224: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
+	101, // 225: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
+	103, // 226: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
+	104, // 227: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
+	106, // 228: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
+	107, // 229: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
+	108, // 230: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
+	109, // 231: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
+	110, // 232: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
+	111, // 233: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
+	129, // 234: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
+	132, // 235: gctrpc.GoCryptoTraderService.GCTScriptREPL:input_type -> gctrpc.GCTScriptREPLRequest
+	138, // 236: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
+	139, // 237: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
+	136, // 238: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
+	140, // 239: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
+	134, // 240: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
+	135, // 241: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
+	137, // 242: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
+	141, // 243: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
+	124, // 244: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
+	145, // 245: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
+	146, // 246: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
+	147, // 247: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
+	148, // 248: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
+	150, // 249: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
+	152, // 250: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
+	153, // 251: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
+	156, // 252: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
+	157, // 253: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
+	113, // 254: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
+	113, // 255: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
+	113, // 256: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
+	116, // 257: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
+	158, // 258: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
+	159, // 259: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
+	117, // 260: gctrpc.GoCryptoTraderService.ExportMarketData:input_type -> gctrpc.MarketDataTransferRequest
+	117, // 261: gctrpc.GoCryptoTraderService.ImportMarketData:input_type -> gctrpc.MarketDataTransferRequest
+	119, // 262: gctrpc.GoCryptoTraderService.RunDataQualityCheck:input_type -> gctrpc.RunDataQualityCheckRequest
+	122, // 263: gctrpc.GoCryptoTraderService.GetDataQualityReports:input_type -> gctrpc.GetDataQualityReportsRequest
+	161, // 264: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
+	162, // 265: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
+	166, // 266: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	0,   // 267: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
+	170, // 268: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
+	166, // 269: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	171, // 270: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
+	172, // 271: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	59,  // 272: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
+	173, // 273: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
+	175, // 274: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
+	176, // 275: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
+	179, // 276: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
+	178, // 277: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
+	177, // 278: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
+	189, // 279: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
+	191, // 280: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
+	207, // 281: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
+	216, // 282: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
+	218, // 283: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
+	221, // 284: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
+	186, // 285: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
+	187, // 286: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
+	212, // 287: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
+	214, // 288: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
+	226, // 289: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
+	228, // 290: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
+	230, // 291: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
+	193, // 292: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
+	203, // 293: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
+	195, // 294: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
+	201, // 295: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
+	205, // 296: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
+	199, // 297: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
+	232, // 298: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
+	236, // 299: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
+	238, // 300: gctrpc.GoCryptoTraderService.GetTransactionCostAnalysis:input_type -> gctrpc.GetTransactionCostAnalysisRequest
+	242, // 301: gctrpc.GoCryptoTraderService.GetFuturesRisk:input_type -> gctrpc.GetFuturesRiskRequest
+	242, // 302: gctrpc.GoCryptoTraderService.GetFuturesRiskStream:input_type -> gctrpc.GetFuturesRiskRequest
+	248, // 303: gctrpc.GoCryptoTraderService.CreateTransfer:input_type -> gctrpc.CreateTransferRequest
+	250, // 304: gctrpc.GoCryptoTraderService.GetTransfers:input_type -> gctrpc.GetTransfersRequest
+	252, // 305: gctrpc.GoCryptoTraderService.UnlockCredentials:input_type -> gctrpc.UnlockCredentialsRequest
+	253, // 306: gctrpc.GoCryptoTraderService.ReloadCredentials:input_type -> gctrpc.ReloadCredentialsRequest
+	255, // 307: gctrpc.GoCryptoTraderService.CreateAPIToken:input_type -> gctrpc.CreateAPITokenRequest
+	257, // 308: gctrpc.GoCryptoTraderService.GetAPITokens:input_type -> gctrpc.GetAPITokensRequest
+	259, // 309: gctrpc.GoCryptoTraderService.RevokeAPIToken:input_type -> gctrpc.RevokeAPITokenRequest
+	260, // 310: gctrpc.GoCryptoTraderService.ReloadConfig:input_type -> gctrpc.ReloadConfigRequest
+	262, // 311: gctrpc.GoCryptoTraderService.PatchConfig:input_type -> gctrpc.PatchConfigRequest
+	264, // 312: gctrpc.GoCryptoTraderService.GetConfigHistory:input_type -> gctrpc.GetConfigHistoryRequest
+	267, // 313: gctrpc.GoCryptoTraderService.DiffConfig:input_type -> gctrpc.DiffConfigRequest
+	269, // 314: gctrpc.GoCryptoTraderService.RollbackConfig:input_type -> gctrpc.RollbackConfigRequest
+	1,   // 315: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
+	7,   // 316: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSubsystemsResponse
+	144, // 317: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
+	144, // 318: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
+	10,  // 319: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
+	4,   // 320: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
+	13,  // 321: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
+	144, // 322: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
+	19,  // 323: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
+	14,  // 324: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
+	16,  // 325: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
+	144, // 326: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
+	22,  // 327: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
+	25,  // 328: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
+	28,  // 329: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
+	31,  // 330: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
+	35,  // 331: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	35,  // 332: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	35,  // 333: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
+	37,  // 334: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
+	40,  // 335: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
+	48,  // 336: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
+	144, // 337: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
+	144, // 338: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
+	53,  // 339: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
+	56,  // 340: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
+	60,  // 341: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
+	57,  // 342: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
+	64,  // 343: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
+	66,  // 344: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
+	66,  // 345: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
+	144, // 346: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
+	71,  // 347: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
+	73,  // 348: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
+	76,  // 349: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
+	78,  // 350: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
+	144, // 351: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
+	83,  // 352: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
+	85,  // 353: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
+	87,  // 354: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
+	90,  // 355: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
+	90,  // 356: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
+	92,  // 357: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
+	95,  // 358: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	95,  // 359: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	102, // 360: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	102, // 361: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	105, // 362: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
+	144, // 363: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
+	28,  // 364: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	28,  // 365: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	22,  // 366: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
+	22,  // 367: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
+	112, // 368: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
+	131, // 369: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GCTScriptExecuteResponse
+	133, // 370: gctrpc.GoCryptoTraderService.GCTScriptREPL:output_type -> gctrpc.GCTScriptREPLResponse
+	144, // 371: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
+	143, // 372: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
+	142, // 373: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
+	143, // 374: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
+	144, // 375: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
+	144, // 376: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
+	142, // 377: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
+	144, // 378: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
+	125, // 379: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	144, // 380: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
+	144, // 381: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
+	144, // 382: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
+	149, // 383: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
+	151, // 384: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
+	144, // 385: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
+	155, // 386: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
+	144, // 387: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
+	144, // 388: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
+	115, // 389: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
+	115, // 390: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
+	115, // 391: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
+	125, // 392: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	160, // 393: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	160, // 394: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	118, // 395: gctrpc.GoCryptoTraderService.ExportMarketData:output_type -> gctrpc.MarketDataTransferResponse
+	118, // 396: gctrpc.GoCryptoTraderService.ImportMarketData:output_type -> gctrpc.MarketDataTransferResponse
+	121, // 397: gctrpc.GoCryptoTraderService.RunDataQualityCheck:output_type -> gctrpc.DataQualityReport
+	123, // 398: gctrpc.GoCryptoTraderService.GetDataQualityReports:output_type -> gctrpc.GetDataQualityReportsResponse
+	144, // 399: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
+	165, // 400: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
+	167, // 401: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
+	169, // 402: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
+	169, // 403: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
+	167, // 404: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
+	144, // 405: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
+	144, // 406: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
+	60,  // 407: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
+	174, // 408: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
+	180, // 409: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
+	144, // 410: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
+	144, // 411: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
+	144, // 412: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
+	144, // 413: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
+	190, // 414: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
+	192, // 415: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
+	208, // 416: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
+	217, // 417: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
+	220, // 418: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
+	225, // 419: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
+	188, // 420: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
+	188, // 421: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
+	213, // 422: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
+	215, // 423: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
+	227, // 424: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
+	229, // 425: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
+	231, // 426: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
+	194, // 427: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
+	204, // 428: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
+	196, // 429: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
+	202, // 430: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
+	206, // 431: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
+	200, // 432: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
+	234, // 433: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
+	237, // 434: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
+	241, // 435: gctrpc.GoCryptoTraderService.GetTransactionCostAnalysis:output_type -> gctrpc.GetTransactionCostAnalysisResponse
+	247, // 436: gctrpc.GoCryptoTraderService.GetFuturesRisk:output_type -> gctrpc.GetFuturesRiskResponse
+	247, // 437: gctrpc.GoCryptoTraderService.GetFuturesRiskStream:output_type -> gctrpc.GetFuturesRiskResponse
+	249, // 438: gctrpc.GoCryptoTraderService.CreateTransfer:output_type -> gctrpc.Transfer
+	251, // 439: gctrpc.GoCryptoTraderService.GetTransfers:output_type -> gctrpc.GetTransfersResponse
+	144, // 440: gctrpc.GoCryptoTraderService.UnlockCredentials:output_type -> gctrpc.GenericResponse
+	144, // 441: gctrpc.GoCryptoTraderService.ReloadCredentials:output_type -> gctrpc.GenericResponse
+	256, // 442: gctrpc.GoCryptoTraderService.CreateAPIToken:output_type -> gctrpc.CreateAPITokenResponse
+	258, // 443: gctrpc.GoCryptoTraderService.GetAPITokens:output_type -> gctrpc.GetAPITokensResponse
+	144, // 444: gctrpc.GoCryptoTraderService.RevokeAPIToken:output_type -> gctrpc.GenericResponse
+	261, // 445: gctrpc.GoCryptoTraderService.ReloadConfig:output_type -> gctrpc.ReloadConfigResponse
+	263, // 446: gctrpc.GoCryptoTraderService.PatchConfig:output_type -> gctrpc.ConfigChangeResponse
+	266, // 447: gctrpc.GoCryptoTraderService.GetConfigHistory:output_type -> gctrpc.GetConfigHistoryResponse
+	268, // 448: gctrpc.GoCryptoTraderService.DiffConfig:output_type -> gctrpc.DiffConfigResponse
+	263, // 449: gctrpc.GoCryptoTraderService.RollbackConfig:output_type -> gctrpc.ConfigChangeResponse
+	315, // [315:450] is the sub-list for method output_type
+	180, // [180:315] is the sub-list for method input_type
+	180, // [180:180] is the sub-list for extension type_name
+	180, // [180:180] is the sub-list for extension extendee
+	0,   // [0:180] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -17147,7 +20919,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   240,
+			NumMessages:   284,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -74,6 +74,7 @@ const (
 	GoCryptoTraderService_GetExchangeTickerStream_FullMethodName           = "/gctrpc.GoCryptoTraderService/GetExchangeTickerStream"
 	GoCryptoTraderService_GetAuditEvent_FullMethodName                     = "/gctrpc.GoCryptoTraderService/GetAuditEvent"
 	GoCryptoTraderService_GCTScriptExecute_FullMethodName                  = "/gctrpc.GoCryptoTraderService/GCTScriptExecute"
+	GoCryptoTraderService_GCTScriptREPL_FullMethodName                     = "/gctrpc.GoCryptoTraderService/GCTScriptREPL"
 	GoCryptoTraderService_GCTScriptUpload_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GCTScriptUpload"
 	GoCryptoTraderService_GCTScriptReadScript_FullMethodName               = "/gctrpc.GoCryptoTraderService/GCTScriptReadScript"
 	GoCryptoTraderService_GCTScriptStatus_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GCTScriptStatus"
@@ -98,6 +99,10 @@ const (
 	GoCryptoTraderService_ConvertTradesToCandles_FullMethodName            = "/gctrpc.GoCryptoTraderService/ConvertTradesToCandles"
 	GoCryptoTraderService_FindMissingSavedCandleIntervals_FullMethodName   = "/gctrpc.GoCryptoTraderService/FindMissingSavedCandleIntervals"
 	GoCryptoTraderService_FindMissingSavedTradeIntervals_FullMethodName    = "/gctrpc.GoCryptoTraderService/FindMissingSavedTradeIntervals"
+	GoCryptoTraderService_ExportMarketData_FullMethodName                  = "/gctrpc.GoCryptoTraderService/ExportMarketData"
+	GoCryptoTraderService_ImportMarketData_FullMethodName                  = "/gctrpc.GoCryptoTraderService/ImportMarketData"
+	GoCryptoTraderService_RunDataQualityCheck_FullMethodName               = "/gctrpc.GoCryptoTraderService/RunDataQualityCheck"
+	GoCryptoTraderService_GetDataQualityReports_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetDataQualityReports"
 	GoCryptoTraderService_SetExchangeTradeProcessing_FullMethodName        = "/gctrpc.GoCryptoTraderService/SetExchangeTradeProcessing"
 	GoCryptoTraderService_UpsertDataHistoryJob_FullMethodName              = "/gctrpc.GoCryptoTraderService/UpsertDataHistoryJob"
 	GoCryptoTraderService_GetDataHistoryJobDetails_FullMethodName          = "/gctrpc.GoCryptoTraderService/GetDataHistoryJobDetails"
@@ -134,6 +139,21 @@ const (
 	GoCryptoTraderService_ChangePositionMargin_FullMethodName              = "/gctrpc.GoCryptoTraderService/ChangePositionMargin"
 	GoCryptoTraderService_GetOpenInterest_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetOpenInterest"
 	GoCryptoTraderService_GetCurrencyTradeURL_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetCurrencyTradeURL"
+	GoCryptoTraderService_GetTransactionCostAnalysis_FullMethodName        = "/gctrpc.GoCryptoTraderService/GetTransactionCostAnalysis"
+	GoCryptoTraderService_GetFuturesRisk_FullMethodName                    = "/gctrpc.GoCryptoTraderService/GetFuturesRisk"
+	GoCryptoTraderService_GetFuturesRiskStream_FullMethodName              = "/gctrpc.GoCryptoTraderService/GetFuturesRiskStream"
+	GoCryptoTraderService_CreateTransfer_FullMethodName                    = "/gctrpc.GoCryptoTraderService/CreateTransfer"
+	GoCryptoTraderService_GetTransfers_FullMethodName                      = "/gctrpc.GoCryptoTraderService/GetTransfers"
+	GoCryptoTraderService_UnlockCredentials_FullMethodName                 = "/gctrpc.GoCryptoTraderService/UnlockCredentials"
+	GoCryptoTraderService_ReloadCredentials_FullMethodName                 = "/gctrpc.GoCryptoTraderService/ReloadCredentials"
+	GoCryptoTraderService_CreateAPIToken_FullMethodName                    = "/gctrpc.GoCryptoTraderService/CreateAPIToken"
+	GoCryptoTraderService_GetAPITokens_FullMethodName                      = "/gctrpc.GoCryptoTraderService/GetAPITokens"
+	GoCryptoTraderService_RevokeAPIToken_FullMethodName                    = "/gctrpc.GoCryptoTraderService/RevokeAPIToken"
+	GoCryptoTraderService_ReloadConfig_FullMethodName                      = "/gctrpc.GoCryptoTraderService/ReloadConfig"
+	GoCryptoTraderService_PatchConfig_FullMethodName                       = "/gctrpc.GoCryptoTraderService/PatchConfig"
+	GoCryptoTraderService_GetConfigHistory_FullMethodName                  = "/gctrpc.GoCryptoTraderService/GetConfigHistory"
+	GoCryptoTraderService_DiffConfig_FullMethodName                        = "/gctrpc.GoCryptoTraderService/DiffConfig"
+	GoCryptoTraderService_RollbackConfig_FullMethodName                    = "/gctrpc.GoCryptoTraderService/RollbackConfig"
 )
 
 // GoCryptoTraderServiceClient is the client API for GoCryptoTraderService service.
@@ -194,7 +214,8 @@ type GoCryptoTraderServiceClient interface {
 	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TickerResponse], error)
 	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TickerResponse], error)
 	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
-	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptExecuteResponse, error)
+	GCTScriptREPL(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GCTScriptREPLRequest, GCTScriptREPLResponse], error)
 	GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
 	GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
@@ -219,6 +240,10 @@ type GoCryptoTraderServiceClient interface {
 	ConvertTradesToCandles(ctx context.Context, in *ConvertTradesToCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
 	FindMissingSavedCandleIntervals(ctx context.Context, in *FindMissingCandlePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
 	FindMissingSavedTradeIntervals(ctx context.Context, in *FindMissingTradePeriodsRequest, opts ...grpc.CallOption) (*FindMissingIntervalsResponse, error)
+	ExportMarketData(ctx context.Context, in *MarketDataTransferRequest, opts ...grpc.CallOption) (*MarketDataTransferResponse, error)
+	ImportMarketData(ctx context.Context, in *MarketDataTransferRequest, opts ...grpc.CallOption) (*MarketDataTransferResponse, error)
+	RunDataQualityCheck(ctx context.Context, in *RunDataQualityCheckRequest, opts ...grpc.CallOption) (*DataQualityReport, error)
+	GetDataQualityReports(ctx context.Context, in *GetDataQualityReportsRequest, opts ...grpc.CallOption) (*GetDataQualityReportsResponse, error)
 	SetExchangeTradeProcessing(ctx context.Context, in *SetExchangeTradeProcessingRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	UpsertDataHistoryJob(ctx context.Context, in *UpsertDataHistoryJobRequest, opts ...grpc.CallOption) (*UpsertDataHistoryJobResponse, error)
 	GetDataHistoryJobDetails(ctx context.Context, in *GetDataHistoryJobDetailsRequest, opts ...grpc.CallOption) (*DataHistoryJob, error)
@@ -255,6 +280,21 @@ type GoCryptoTraderServiceClient interface {
 	ChangePositionMargin(ctx context.Context, in *ChangePositionMarginRequest, opts ...grpc.CallOption) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(ctx context.Context, in *GetCurrencyTradeURLRequest, opts ...grpc.CallOption) (*GetCurrencyTradeURLResponse, error)
+	GetTransactionCostAnalysis(ctx context.Context, in *GetTransactionCostAnalysisRequest, opts ...grpc.CallOption) (*GetTransactionCostAnalysisResponse, error)
+	GetFuturesRisk(ctx context.Context, in *GetFuturesRiskRequest, opts ...grpc.CallOption) (*GetFuturesRiskResponse, error)
+	GetFuturesRiskStream(ctx context.Context, in *GetFuturesRiskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetFuturesRiskResponse], error)
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*Transfer, error)
+	GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error)
+	UnlockCredentials(ctx context.Context, in *UnlockCredentialsRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ReloadCredentials(ctx context.Context, in *ReloadCredentialsRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error)
+	GetAPITokens(ctx context.Context, in *GetAPITokensRequest, opts ...grpc.CallOption) (*GetAPITokensResponse, error)
+	RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	PatchConfig(ctx context.Context, in *PatchConfigRequest, opts ...grpc.CallOption) (*ConfigChangeResponse, error)
+	GetConfigHistory(ctx context.Context, in *GetConfigHistoryRequest, opts ...grpc.CallOption) (*GetConfigHistoryResponse, error)
+	DiffConfig(ctx context.Context, in *DiffConfigRequest, opts ...grpc.CallOption) (*DiffConfigResponse, error)
+	RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*ConfigChangeResponse, error)
 }
 
 type goCryptoTraderServiceClient struct {
@@ -850,9 +890,9 @@ func (c *goCryptoTraderServiceClient) GetAuditEvent(ctx context.Context, in *Get
 	return out, nil
 }
 
-func (c *goCryptoTraderServiceClient) GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+func (c *goCryptoTraderServiceClient) GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptExecuteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GenericResponse)
+	out := new(GCTScriptExecuteResponse)
 	err := c.cc.Invoke(ctx, GoCryptoTraderService_GCTScriptExecute_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -860,6 +900,19 @@ func (c *goCryptoTraderServiceClient) GCTScriptExecute(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) GCTScriptREPL(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[GCTScriptREPLRequest, GCTScriptREPLResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[5], GoCryptoTraderService_GCTScriptREPL_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GCTScriptREPLRequest, GCTScriptREPLResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GCTScriptREPLClient = grpc.BidiStreamingClient[GCTScriptREPLRequest, GCTScriptREPLResponse]
+
 func (c *goCryptoTraderServiceClient) GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenericResponse)
@@ -1052,7 +1105,7 @@ func (c *goCryptoTraderServiceClient) GetRecentTrades(ctx context.Context, in *G
 
 func (c *goCryptoTraderServiceClient) GetHistoricTrades(ctx context.Context, in *GetSavedTradesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SavedTradesResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[5], GoCryptoTraderService_GetHistoricTrades_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[6], GoCryptoTraderService_GetHistoricTrades_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1109,6 +1162,46 @@ func (c *goCryptoTraderServiceClient) FindMissingSavedTradeIntervals(ctx context
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) ExportMarketData(ctx context.Context, in *MarketDataTransferRequest, opts ...grpc.CallOption) (*MarketDataTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketDataTransferResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ExportMarketData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ImportMarketData(ctx context.Context, in *MarketDataTransferRequest, opts ...grpc.CallOption) (*MarketDataTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketDataTransferResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ImportMarketData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RunDataQualityCheck(ctx context.Context, in *RunDataQualityCheckRequest, opts ...grpc.CallOption) (*DataQualityReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataQualityReport)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RunDataQualityCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetDataQualityReports(ctx context.Context, in *GetDataQualityReportsRequest, opts ...grpc.CallOption) (*GetDataQualityReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataQualityReportsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetDataQualityReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderServiceClient) SetExchangeTradeProcessing(ctx context.Context, in *SetExchangeTradeProcessingRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenericResponse)
@@ -1469,6 +1562,165 @@ func (c *goCryptoTraderServiceClient) GetCurrencyTradeURL(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) GetTransactionCostAnalysis(ctx context.Context, in *GetTransactionCostAnalysisRequest, opts ...grpc.CallOption) (*GetTransactionCostAnalysisResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionCostAnalysisResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetTransactionCostAnalysis_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetFuturesRisk(ctx context.Context, in *GetFuturesRiskRequest, opts ...grpc.CallOption) (*GetFuturesRiskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFuturesRiskResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetFuturesRisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetFuturesRiskStream(ctx context.Context, in *GetFuturesRiskRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetFuturesRiskResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[7], GoCryptoTraderService_GetFuturesRiskStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetFuturesRiskRequest, GetFuturesRiskResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetFuturesRiskStreamClient = grpc.ServerStreamingClient[GetFuturesRiskResponse]
+
+func (c *goCryptoTraderServiceClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*Transfer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Transfer)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CreateTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransfersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) UnlockCredentials(ctx context.Context, in *UnlockCredentialsRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_UnlockCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ReloadCredentials(ctx context.Context, in *ReloadCredentialsRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ReloadCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) CreateAPIToken(ctx context.Context, in *CreateAPITokenRequest, opts ...grpc.CallOption) (*CreateAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPITokenResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CreateAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetAPITokens(ctx context.Context, in *GetAPITokensRequest, opts ...grpc.CallOption) (*GetAPITokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAPITokensResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetAPITokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RevokeAPIToken(ctx context.Context, in *RevokeAPITokenRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RevokeAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ReloadConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) PatchConfig(ctx context.Context, in *PatchConfigRequest, opts ...grpc.CallOption) (*ConfigChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigChangeResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_PatchConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetConfigHistory(ctx context.Context, in *GetConfigHistoryRequest, opts ...grpc.CallOption) (*GetConfigHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigHistoryResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetConfigHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) DiffConfig(ctx context.Context, in *DiffConfigRequest, opts ...grpc.CallOption) (*DiffConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffConfigResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_DiffConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RollbackConfig(ctx context.Context, in *RollbackConfigRequest, opts ...grpc.CallOption) (*ConfigChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigChangeResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RollbackConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServiceServer is the server API for GoCryptoTraderService service.
 // All implementations must embed UnimplementedGoCryptoTraderServiceServer
 // for forward compatibility.
@@ -1527,7 +1779,8 @@ type GoCryptoTraderServiceServer interface {
 	GetTickerStream(*GetTickerStreamRequest, grpc.ServerStreamingServer[TickerResponse]) error
 	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, grpc.ServerStreamingServer[TickerResponse]) error
 	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
-	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error)
+	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GCTScriptExecuteResponse, error)
+	GCTScriptREPL(grpc.BidiStreamingServer[GCTScriptREPLRequest, GCTScriptREPLResponse]) error
 	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error)
 	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
 	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
@@ -1552,6 +1805,10 @@ type GoCryptoTraderServiceServer interface {
 	ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error)
 	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
 	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
+	ExportMarketData(context.Context, *MarketDataTransferRequest) (*MarketDataTransferResponse, error)
+	ImportMarketData(context.Context, *MarketDataTransferRequest) (*MarketDataTransferResponse, error)
+	RunDataQualityCheck(context.Context, *RunDataQualityCheckRequest) (*DataQualityReport, error)
+	GetDataQualityReports(context.Context, *GetDataQualityReportsRequest) (*GetDataQualityReportsResponse, error)
 	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
 	UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error)
 	GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
@@ -1588,6 +1845,21 @@ type GoCryptoTraderServiceServer interface {
 	ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error)
+	GetTransactionCostAnalysis(context.Context, *GetTransactionCostAnalysisRequest) (*GetTransactionCostAnalysisResponse, error)
+	GetFuturesRisk(context.Context, *GetFuturesRiskRequest) (*GetFuturesRiskResponse, error)
+	GetFuturesRiskStream(*GetFuturesRiskRequest, grpc.ServerStreamingServer[GetFuturesRiskResponse]) error
+	CreateTransfer(context.Context, *CreateTransferRequest) (*Transfer, error)
+	GetTransfers(context.Context, *GetTransfersRequest) (*GetTransfersResponse, error)
+	UnlockCredentials(context.Context, *UnlockCredentialsRequest) (*GenericResponse, error)
+	ReloadCredentials(context.Context, *ReloadCredentialsRequest) (*GenericResponse, error)
+	CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error)
+	GetAPITokens(context.Context, *GetAPITokensRequest) (*GetAPITokensResponse, error)
+	RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*GenericResponse, error)
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	PatchConfig(context.Context, *PatchConfigRequest) (*ConfigChangeResponse, error)
+	GetConfigHistory(context.Context, *GetConfigHistoryRequest) (*GetConfigHistoryResponse, error)
+	DiffConfig(context.Context, *DiffConfigRequest) (*DiffConfigResponse, error)
+	RollbackConfig(context.Context, *RollbackConfigRequest) (*ConfigChangeResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServiceServer()
 }
 
@@ -1760,9 +2032,12 @@ func (UnimplementedGoCryptoTraderServiceServer) GetExchangeTickerStream(*GetExch
 func (UnimplementedGoCryptoTraderServiceServer) GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetAuditEvent not implemented")
 }
-func (UnimplementedGoCryptoTraderServiceServer) GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error) {
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GCTScriptExecuteResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GCTScriptExecute not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GCTScriptREPL(grpc.BidiStreamingServer[GCTScriptREPLRequest, GCTScriptREPLResponse]) error {
+	return status.Error(codes.Unimplemented, "method GCTScriptREPL not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GCTScriptUpload not implemented")
 }
@@ -1835,6 +2110,18 @@ func (UnimplementedGoCryptoTraderServiceServer) FindMissingSavedCandleIntervals(
 func (UnimplementedGoCryptoTraderServiceServer) FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method FindMissingSavedTradeIntervals not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) ExportMarketData(context.Context, *MarketDataTransferRequest) (*MarketDataTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportMarketData not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ImportMarketData(context.Context, *MarketDataTransferRequest) (*MarketDataTransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportMarketData not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RunDataQualityCheck(context.Context, *RunDataQualityCheckRequest) (*DataQualityReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunDataQualityCheck not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetDataQualityReports(context.Context, *GetDataQualityReportsRequest) (*GetDataQualityReportsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDataQualityReports not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
 }
@@ -1943,6 +2230,51 @@ func (UnimplementedGoCryptoTraderServiceServer) GetOpenInterest(context.Context,
 func (UnimplementedGoCryptoTraderServiceServer) GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCurrencyTradeURL not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) GetTransactionCostAnalysis(context.Context, *GetTransactionCostAnalysisRequest) (*GetTransactionCostAnalysisResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransactionCostAnalysis not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetFuturesRisk(context.Context, *GetFuturesRiskRequest) (*GetFuturesRiskResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFuturesRisk not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetFuturesRiskStream(*GetFuturesRiskRequest, grpc.ServerStreamingServer[GetFuturesRiskResponse]) error {
+	return status.Error(codes.Unimplemented, "method GetFuturesRiskStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CreateTransfer(context.Context, *CreateTransferRequest) (*Transfer, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetTransfers(context.Context, *GetTransfersRequest) (*GetTransfersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTransfers not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) UnlockCredentials(context.Context, *UnlockCredentialsRequest) (*GenericResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockCredentials not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ReloadCredentials(context.Context, *ReloadCredentialsRequest) (*GenericResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReloadCredentials not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CreateAPIToken(context.Context, *CreateAPITokenRequest) (*CreateAPITokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateAPIToken not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetAPITokens(context.Context, *GetAPITokensRequest) (*GetAPITokensResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAPITokens not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RevokeAPIToken(context.Context, *RevokeAPITokenRequest) (*GenericResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeAPIToken not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) PatchConfig(context.Context, *PatchConfigRequest) (*ConfigChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PatchConfig not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetConfigHistory(context.Context, *GetConfigHistoryRequest) (*GetConfigHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConfigHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) DiffConfig(context.Context, *DiffConfigRequest) (*DiffConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffConfig not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RollbackConfig(context.Context, *RollbackConfigRequest) (*ConfigChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackConfig not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) mustEmbedUnimplementedGoCryptoTraderServiceServer() {}
 func (UnimplementedGoCryptoTraderServiceServer) testEmbeddedByValue()                               {}
 
@@ -2919,6 +3251,13 @@ func _GoCryptoTraderService_GCTScriptExecute_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_GCTScriptREPL_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GoCryptoTraderServiceServer).GCTScriptREPL(&grpc.GenericServerStream[GCTScriptREPLRequest, GCTScriptREPLResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GCTScriptREPLServer = grpc.BidiStreamingServer[GCTScriptREPLRequest, GCTScriptREPLResponse]
+
 func _GoCryptoTraderService_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GCTScriptUploadRequest)
 	if err := dec(in); err != nil {
@@ -3344,6 +3683,78 @@ func _GoCryptoTraderService_FindMissingSavedTradeIntervals_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_ExportMarketData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketDataTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ExportMarketData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ExportMarketData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ExportMarketData(ctx, req.(*MarketDataTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ImportMarketData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketDataTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ImportMarketData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ImportMarketData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ImportMarketData(ctx, req.(*MarketDataTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RunDataQualityCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDataQualityCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RunDataQualityCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RunDataQualityCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RunDataQualityCheck(ctx, req.(*RunDataQualityCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetDataQualityReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataQualityReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetDataQualityReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetDataQualityReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetDataQualityReports(ctx, req.(*GetDataQualityReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTraderService_SetExchangeTradeProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetExchangeTradeProcessingRequest)
 	if err := dec(in); err != nil {
@@ -3992,6 +4403,269 @@ func _GoCryptoTraderService_GetCurrencyTradeURL_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_GetTransactionCostAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionCostAnalysisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetTransactionCostAnalysis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetTransactionCostAnalysis_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetTransactionCostAnalysis(ctx, req.(*GetTransactionCostAnalysisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetFuturesRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFuturesRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetFuturesRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetFuturesRisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetFuturesRisk(ctx, req.(*GetFuturesRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetFuturesRiskStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFuturesRiskRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetFuturesRiskStream(m, &grpc.GenericServerStream[GetFuturesRiskRequest, GetFuturesRiskResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetFuturesRiskStreamServer = grpc.ServerStreamingServer[GetFuturesRiskResponse]
+
+func _GoCryptoTraderService_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CreateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetTransfers(ctx, req.(*GetTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_UnlockCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).UnlockCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_UnlockCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).UnlockCredentials(ctx, req.(*UnlockCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ReloadCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ReloadCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ReloadCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ReloadCredentials(ctx, req.(*ReloadCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_CreateAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CreateAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CreateAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CreateAPIToken(ctx, req.(*CreateAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAPITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetAPITokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetAPITokens(ctx, req.(*GetAPITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RevokeAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RevokeAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RevokeAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RevokeAPIToken(ctx, req.(*RevokeAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ReloadConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_PatchConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).PatchConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_PatchConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).PatchConfig(ctx, req.(*PatchConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetConfigHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetConfigHistory(ctx, req.(*GetConfigHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_DiffConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).DiffConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_DiffConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).DiffConfig(ctx, req.(*DiffConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RollbackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RollbackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RollbackConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RollbackConfig(ctx, req.(*RollbackConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoCryptoTraderService_ServiceDesc is the grpc.ServiceDesc for GoCryptoTraderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -4291,6 +4965,22 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindMissingSavedTradeIntervals",
 			Handler:    _GoCryptoTraderService_FindMissingSavedTradeIntervals_Handler,
 		},
+		{
+			MethodName: "ExportMarketData",
+			Handler:    _GoCryptoTraderService_ExportMarketData_Handler,
+		},
+		{
+			MethodName: "ImportMarketData",
+			Handler:    _GoCryptoTraderService_ImportMarketData_Handler,
+		},
+		{
+			MethodName: "RunDataQualityCheck",
+			Handler:    _GoCryptoTraderService_RunDataQualityCheck_Handler,
+		},
+		{
+			MethodName: "GetDataQualityReports",
+			Handler:    _GoCryptoTraderService_GetDataQualityReports_Handler,
+		},
 		{
 			MethodName: "SetExchangeTradeProcessing",
 			Handler:    _GoCryptoTraderService_SetExchangeTradeProcessing_Handler,
@@ -4435,6 +5125,62 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyTradeURL",
 			Handler:    _GoCryptoTraderService_GetCurrencyTradeURL_Handler,
 		},
+		{
+			MethodName: "GetTransactionCostAnalysis",
+			Handler:    _GoCryptoTraderService_GetTransactionCostAnalysis_Handler,
+		},
+		{
+			MethodName: "GetFuturesRisk",
+			Handler:    _GoCryptoTraderService_GetFuturesRisk_Handler,
+		},
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _GoCryptoTraderService_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "GetTransfers",
+			Handler:    _GoCryptoTraderService_GetTransfers_Handler,
+		},
+		{
+			MethodName: "UnlockCredentials",
+			Handler:    _GoCryptoTraderService_UnlockCredentials_Handler,
+		},
+		{
+			MethodName: "ReloadCredentials",
+			Handler:    _GoCryptoTraderService_ReloadCredentials_Handler,
+		},
+		{
+			MethodName: "CreateAPIToken",
+			Handler:    _GoCryptoTraderService_CreateAPIToken_Handler,
+		},
+		{
+			MethodName: "GetAPITokens",
+			Handler:    _GoCryptoTraderService_GetAPITokens_Handler,
+		},
+		{
+			MethodName: "RevokeAPIToken",
+			Handler:    _GoCryptoTraderService_RevokeAPIToken_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _GoCryptoTraderService_ReloadConfig_Handler,
+		},
+		{
+			MethodName: "PatchConfig",
+			Handler:    _GoCryptoTraderService_PatchConfig_Handler,
+		},
+		{
+			MethodName: "GetConfigHistory",
+			Handler:    _GoCryptoTraderService_GetConfigHistory_Handler,
+		},
+		{
+			MethodName: "DiffConfig",
+			Handler:    _GoCryptoTraderService_DiffConfig_Handler,
+		},
+		{
+			MethodName: "RollbackConfig",
+			Handler:    _GoCryptoTraderService_RollbackConfig_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4462,11 +5208,22 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetExchangeTickerStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GCTScriptREPL",
+			Handler:       _GoCryptoTraderService_GCTScriptREPL_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "GetHistoricTrades",
 			Handler:       _GoCryptoTraderService_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetFuturesRiskStream",
+			Handler:       _GoCryptoTraderService_GetFuturesRiskStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -0,0 +1,206 @@
+package gct
+
+import (
+	"errors"
+	"sync"
+
+	objects "github.com/d5/tengo/v2"
+)
+
+const (
+	channelPublishFunc     = "publish"
+	channelSubscribeFunc   = "subscribe"
+	channelUnsubscribeFunc = "unsubscribe"
+	channelReceiveFunc     = "receive"
+
+	// MaxQueuedMessages is the number of messages held for each subscriber
+	// and topic, the oldest message is dropped when the queue is full
+	MaxQueuedMessages = 100
+)
+
+var (
+	errNoScriptID    = errors.New("script context has no script id")
+	errEmptyTopic    = errors.New("topic cannot be empty")
+	errNotSubscribed = errors.New("script is not subscribed to topic")
+)
+
+var channelModule = map[string]objects.Object{
+	channelPublishFunc:     &objects.UserFunction{Name: channelPublishFunc, Value: ChannelPublish},
+	channelSubscribeFunc:   &objects.UserFunction{Name: channelSubscribeFunc, Value: ChannelSubscribe},
+	channelUnsubscribeFunc: &objects.UserFunction{Name: channelUnsubscribeFunc, Value: ChannelUnsubscribe},
+	channelReceiveFunc:     &objects.UserFunction{Name: channelReceiveFunc, Value: ChannelReceive},
+}
+
+// message is a value published to a topic by a script
+type message struct {
+	topic string
+	from  string
+	data  any
+}
+
+// broker holds the queued messages of each subscribed script by topic
+type broker struct {
+	mtx    sync.Mutex
+	topics map[string]map[string][]message
+}
+
+var channels = broker{topics: make(map[string]map[string][]message)}
+
+// ChannelPublish sends a value to every other script subscribed to the topic
+// and returns the number of scripts it was sent to
+// Params: scriptCTX, topic string, value any
+func ChannelPublish(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	id, topic, err := channelParams(channelPublishFunc, args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	return &objects.Int{Value: int64(channels.publish(topic, id, objects.ToInterface(args[2])))}, nil
+}
+
+// ChannelSubscribe starts queueing messages published to the topic for the
+// script
+// Params: scriptCTX, topic string
+func ChannelSubscribe(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	id, topic, err := channelParams(channelSubscribeFunc, args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	channels.subscribe(topic, id)
+	return objects.TrueValue, nil
+}
+
+// ChannelUnsubscribe stops queueing messages published to the topic for the
+// script and discards any which have not been received
+// Params: scriptCTX, topic string
+func ChannelUnsubscribe(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	id, topic, err := channelParams(channelUnsubscribeFunc, args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	channels.unsubscribe(topic, id)
+	return objects.TrueValue, nil
+}
+
+// ChannelReceive returns and removes the script's queued messages for the
+// topic without waiting. Each message is a map of topic, from and data
+// Params: scriptCTX, topic string
+func ChannelReceive(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	id, topic, err := channelParams(channelReceiveFunc, args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+	msgs, err := channels.receive(topic, id)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	out := &objects.Array{Value: make([]objects.Object, 0, len(msgs))}
+	for i := range msgs {
+		data, err := objects.FromInterface(msgs[i].data)
+		if err != nil {
+			return errorResponsef(standardFormatting, err)
+		}
+		out.Value = append(out.Value, &objects.Map{Value: map[string]objects.Object{
+			"topic": &objects.String{Value: msgs[i].topic},
+			"from":  &objects.String{Value: msgs[i].from},
+			"data":  data,
+		}})
+	}
+	return out, nil
+}
+
+// ReleaseChannels removes all of a script's subscriptions, it is called when
+// the script is shut down
+func ReleaseChannels(scriptID string) {
+	channels.mtx.Lock()
+	defer channels.mtx.Unlock()
+	for topic, subscribers := range channels.topics {
+		delete(subscribers, scriptID)
+		if len(subscribers) == 0 {
+			delete(channels.topics, topic)
+		}
+	}
+}
+
+// channelParams returns the script id and topic of a channel function call
+func channelParams(funcName string, ctxArg, topicArg objects.Object) (id, topic string, err error) {
+	scriptCtx, ok := objects.ToInterface(ctxArg).(*Context)
+	if !ok {
+		return "", "", constructRuntimeError(1, funcName, "*gct.Context", ctxArg)
+	}
+	idObj, ok := scriptCtx.Value["script"]
+	if !ok {
+		return "", "", errNoScriptID
+	}
+	if id, ok = objects.ToString(idObj); !ok || id == "" {
+		return "", "", errNoScriptID
+	}
+	if topic, ok = objects.ToString(topicArg); !ok {
+		return "", "", constructRuntimeError(2, funcName, "string", topicArg)
+	}
+	if topic == "" {
+		return "", "", errEmptyTopic
+	}
+	return id, topic, nil
+}
+
+func (b *broker) publish(topic, from string, data any) int {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	var sent int
+	for id, queue := range b.topics[topic] {
+		if id == from {
+			continue
+		}
+		if len(queue) >= MaxQueuedMessages {
+			queue = queue[1:]
+		}
+		b.topics[topic][id] = append(queue, message{topic: topic, from: from, data: data})
+		sent++
+	}
+	return sent
+}
+
+func (b *broker) subscribe(topic, id string) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	subscribers, ok := b.topics[topic]
+	if !ok {
+		subscribers = make(map[string][]message)
+		b.topics[topic] = subscribers
+	}
+	if _, ok := subscribers[id]; !ok {
+		subscribers[id] = nil
+	}
+}
+
+func (b *broker) unsubscribe(topic, id string) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	delete(b.topics[topic], id)
+	if len(b.topics[topic]) == 0 {
+		delete(b.topics, topic)
+	}
+}
+
+func (b *broker) receive(topic, id string) ([]message, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	queue, ok := b.topics[topic][id]
+	if !ok {
+		return nil, errNotSubscribed
+	}
+	b.topics[topic][id] = nil
+	return queue, nil
+}
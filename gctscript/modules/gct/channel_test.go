@@ -0,0 +1,74 @@
+package gct
+
+import (
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func channelTestContext(id string) *Context {
+	c := &Context{}
+	c.Value = map[string]objects.Object{"script": &objects.String{Value: id}}
+	return c
+}
+
+func TestChannel(t *testing.T) {
+	t.Parallel()
+	topic := &objects.String{Value: "TestChannel"}
+	publisher, subscriber := channelTestContext("publisher"), channelTestContext("subscriber")
+
+	_, err := ChannelPublish()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = ChannelSubscribe(ctx, topic)
+	assert.ErrorIs(t, err, errNoScriptID)
+	_, err = ChannelSubscribe(subscriber, blank)
+	assert.ErrorIs(t, err, errEmptyTopic)
+
+	v, err := ChannelReceive(subscriber, topic)
+	require.NoError(t, err, "ChannelReceive must not error")
+	assert.IsType(t, &objects.Error{}, v, "ChannelReceive should return an error object when not subscribed")
+
+	_, err = ChannelSubscribe(subscriber, topic)
+	require.NoError(t, err, "ChannelSubscribe must not error")
+	_, err = ChannelSubscribe(publisher, topic)
+	require.NoError(t, err, "ChannelSubscribe must not error")
+
+	v, err = ChannelPublish(publisher, topic, &objects.Map{Value: map[string]objects.Object{"side": &objects.String{Value: "buy"}}})
+	require.NoError(t, err, "ChannelPublish must not error")
+	assert.Equal(t, &objects.Int{Value: 1}, v, "ChannelPublish should not send to the publishing script")
+
+	v, err = ChannelReceive(subscriber, topic)
+	require.NoError(t, err, "ChannelReceive must not error")
+	msgs, ok := v.(*objects.Array)
+	require.True(t, ok, "ChannelReceive must return an array")
+	require.Len(t, msgs.Value, 1, "ChannelReceive must return the published message")
+	msg, ok := msgs.Value[0].(*objects.Map)
+	require.True(t, ok, "message must be a map")
+	assert.Equal(t, &objects.String{Value: "publisher"}, msg.Value["from"], "message should contain the publisher")
+	assert.Equal(t, &objects.Map{Value: map[string]objects.Object{"side": &objects.String{Value: "buy"}}}, msg.Value["data"], "message should contain the data")
+
+	v, err = ChannelReceive(subscriber, topic)
+	require.NoError(t, err, "ChannelReceive must not error")
+	assert.Empty(t, v.(*objects.Array).Value, "ChannelReceive should remove received messages")
+
+	for range MaxQueuedMessages + 1 {
+		_, err = ChannelPublish(publisher, topic, tv)
+		require.NoError(t, err, "ChannelPublish must not error")
+	}
+	v, err = ChannelReceive(subscriber, topic)
+	require.NoError(t, err, "ChannelReceive must not error")
+	assert.Len(t, v.(*objects.Array).Value, MaxQueuedMessages, "ChannelReceive should return at most MaxQueuedMessages")
+
+	_, err = ChannelUnsubscribe(subscriber, topic)
+	require.NoError(t, err, "ChannelUnsubscribe must not error")
+	v, err = ChannelPublish(publisher, topic, tv)
+	require.NoError(t, err, "ChannelPublish must not error")
+	assert.Equal(t, &objects.Int{Value: 0}, v, "ChannelPublish should not send to unsubscribed scripts")
+
+	ReleaseChannels("publisher")
+	channels.mtx.Lock()
+	assert.NotContains(t, channels.topics, topic.Value, "ReleaseChannels should remove empty topics")
+	channels.mtx.Unlock()
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
+const writeAsCSVFunc = "writeascsv"
+
 var commonModule = map[string]objects.Object{
-	"writeascsv": &objects.UserFunction{Name: "writeascsv", Value: WriteAsCSV},
+	writeAsCSVFunc: &objects.UserFunction{Name: writeAsCSVFunc, Value: WriteAsCSV},
 }
 
 // OutputDir is the default script output directory
@@ -7,9 +7,14 @@ import (
 	objects "github.com/d5/tengo/v2"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta/indicators"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -30,23 +35,43 @@ const (
 	ohlcvFunc           = "ohlcv"
 )
 
-var exchangeModule = map[string]objects.Object{
-	orderbookFunc:       &objects.UserFunction{Name: orderbookFunc, Value: ExchangeOrderbook},
-	tickerFunc:          &objects.UserFunction{Name: tickerFunc, Value: ExchangeTicker},
-	exchangesFunc:       &objects.UserFunction{Name: exchangesFunc, Value: ExchangeExchanges},
-	pairsFunc:           &objects.UserFunction{Name: pairsFunc, Value: ExchangePairs},
-	accountBalancesFunc: &objects.UserFunction{Name: accountBalancesFunc, Value: ExchangeAccountBalances},
-	depositAddressFunc:  &objects.UserFunction{Name: depositAddressFunc, Value: ExchangeDepositAddress},
-	orderQueryFunc:      &objects.UserFunction{Name: orderQueryFunc, Value: ExchangeOrderQuery},
-	orderCancelFunc:     &objects.UserFunction{Name: orderCancelFunc, Value: ExchangeOrderCancel},
-	orderSubmitFunc:     &objects.UserFunction{Name: orderSubmitFunc, Value: ExchangeOrderSubmit},
-	withdrawCryptoFunc:  &objects.UserFunction{Name: withdrawCryptoFunc, Value: ExchangeWithdrawCrypto},
-	withdrawFiatFunc:    &objects.UserFunction{Name: withdrawFiatFunc, Value: ExchangeWithdrawFiat},
-	ohlcvFunc:           &objects.UserFunction{Name: ohlcvFunc, Value: exchangeOHLCV},
+var exchangeModule = exchangeFunctions(wrappers.GetWrapper)
+
+// exchangeFunctions returns the exchange module functions, which call the
+// wrapper returned by w
+func exchangeFunctions(w func() modules.GCTExchange) map[string]objects.Object {
+	return map[string]objects.Object{
+		orderbookFunc:        wrapperFunction(orderbookFunc, exchangeOrderbook, w),
+		tickerFunc:           wrapperFunction(tickerFunc, exchangeTicker, w),
+		exchangesFunc:        wrapperFunction(exchangesFunc, exchangeExchanges, w),
+		pairsFunc:            wrapperFunction(pairsFunc, exchangePairs, w),
+		accountBalancesFunc:  wrapperFunction(accountBalancesFunc, exchangeAccountBalances, w),
+		depositAddressFunc:   wrapperFunction(depositAddressFunc, exchangeDepositAddress, w),
+		orderQueryFunc:       wrapperFunction(orderQueryFunc, exchangeOrderQuery, w),
+		orderCancelFunc:      wrapperFunction(orderCancelFunc, exchangeOrderCancel, w),
+		orderSubmitFunc:      wrapperFunction(orderSubmitFunc, exchangeOrderSubmit, w),
+		withdrawCryptoFunc:   wrapperFunction(withdrawCryptoFunc, exchangeWithdrawCrypto, w),
+		withdrawFiatFunc:     wrapperFunction(withdrawFiatFunc, exchangeWithdrawFiat, w),
+		ohlcvFunc:            wrapperFunction(ohlcvFunc, exchangeOHLCV, w),
+		orderModifyFunc:      wrapperFunction(orderModifyFunc, exchangeOrderModify, w),
+		orderCancelBatchFunc: wrapperFunction(orderCancelBatchFunc, exchangeOrderCancelBatch, w),
+		orderCancelAllFunc:   wrapperFunction(orderCancelAllFunc, exchangeOrderCancelAll, w),
+		activeOrdersFunc:     wrapperFunction(activeOrdersFunc, exchangeActiveOrders, w),
+		orderHistoryFunc:     wrapperFunction(orderHistoryFunc, exchangeOrderHistory, w),
+		historicTradesFunc:   wrapperFunction(historicTradesFunc, exchangeHistoricTrades, w),
+		positionsFunc:        wrapperFunction(positionsFunc, exchangeFuturesPositions, w),
+		setLeverageFunc:      wrapperFunction(setLeverageFunc, exchangeSetLeverage, w),
+		fundingRatesFunc:     wrapperFunction(fundingRatesFunc, exchangeFundingRates, w),
+		openInterestFunc:     wrapperFunction(openInterestFunc, exchangeOpenInterest, w),
+	}
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
 func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderbook(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderbook(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 5 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -83,39 +108,20 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	ob, err := wrappers.GetWrapper().Orderbook(ctx, exchangeName, pair, assetType)
+	ob, err := w.Orderbook(ctx, exchangeName, pair, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	asks := objects.Array{Value: make([]objects.Object, len(ob.Asks))}
-	for x := range ob.Asks {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Asks[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Asks[x].Price}
-		asks.Value[x] = &objects.Map{Value: temp}
-	}
-
-	bids := objects.Array{Value: make([]objects.Object, len(ob.Bids))}
-	for x := range ob.Bids {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Bids[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Bids[x].Price}
-		bids.Value[x] = &objects.Map{Value: temp}
-	}
-
-	data := make(map[string]objects.Object, 5)
-	data["exchange"] = &objects.String{Value: ob.Exchange}
-	data["pair"] = &objects.String{Value: ob.Pair.String()}
-	data["asks"] = &asks
-	data["bids"] = &bids
-	data["asset"] = &objects.String{Value: ob.Asset.String()}
-
-	return &objects.Map{Value: data}, nil
+	return OrderbookObject(ob), nil
 }
 
 // ExchangeTicker returns ticker data for requested exchange and currency pair
 func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
+	return exchangeTicker(wrappers.GetWrapper(), args...)
+}
+
+func exchangeTicker(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 5 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -153,34 +159,20 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	tx, err := wrappers.GetWrapper().Ticker(ctx, exchangeName, pair, assetType)
+	tx, err := w.Ticker(ctx, exchangeName, pair, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	data := make(map[string]objects.Object, 14)
-	data["exchange"] = &objects.String{Value: tx.ExchangeName}
-	data["last"] = &objects.Float{Value: tx.Last}
-	data["High"] = &objects.Float{Value: tx.High}
-	data["Low"] = &objects.Float{Value: tx.Low}
-	data["bid"] = &objects.Float{Value: tx.Bid}
-	data["ask"] = &objects.Float{Value: tx.Ask}
-	data["volume"] = &objects.Float{Value: tx.Volume}
-	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
-	data["priceath"] = &objects.Float{Value: tx.PriceATH}
-	data["open"] = &objects.Float{Value: tx.Open}
-	data["close"] = &objects.Float{Value: tx.Close}
-	data["pair"] = &objects.String{Value: tx.Pair.String()}
-	data["asset"] = &objects.String{Value: tx.AssetType.String()}
-	data["updated"] = &objects.Time{Value: tx.LastUpdated}
-
-	return &objects.Map{
-		Value: data,
-	}, nil
+	return TickerObject(tx), nil
 }
 
 // ExchangeExchanges returns list of exchanges either enabled or all
 func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
+	return exchangeExchanges(wrappers.GetWrapper(), args...)
+}
+
+func exchangeExchanges(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 1 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -189,7 +181,7 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, constructRuntimeError(1, exchangesFunc, "bool", args[0])
 	}
-	rtnValue := wrappers.GetWrapper().Exchanges(enabledOnly)
+	rtnValue := w.Exchanges(enabledOnly)
 
 	r := objects.Array{
 		Value: make([]objects.Object, len(rtnValue)),
@@ -203,6 +195,10 @@ func ExchangeExchanges(args ...objects.Object) (objects.Object, error) {
 
 // ExchangePairs returns currency pairs for requested exchange
 func ExchangePairs(args ...objects.Object) (objects.Object, error) {
+	return exchangePairs(wrappers.GetWrapper(), args...)
+}
+
+func exchangePairs(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -224,7 +220,7 @@ func ExchangePairs(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	pairs, err := wrappers.GetWrapper().Pairs(exchangeName, enabledOnly, assetType)
+	pairs, err := w.Pairs(exchangeName, enabledOnly, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -241,6 +237,10 @@ func ExchangePairs(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeAccountBalances returns account balances for requested exchange
 func ExchangeAccountBalances(args ...objects.Object) (objects.Object, error) {
+	return exchangeAccountBalances(wrappers.GetWrapper(), args...)
+}
+
+func exchangeAccountBalances(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -263,20 +263,14 @@ func ExchangeAccountBalances(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtnValue, err := wrappers.GetWrapper().AccountBalances(ctx, exchangeName, assetType)
+	rtnValue, err := w.AccountBalances(ctx, exchangeName, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
 
 	var funds objects.Array
 	for i := range rtnValue {
-		for curr, bal := range rtnValue[i].Balances {
-			funds.Value = append(funds.Value, &objects.Map{Value: map[string]objects.Object{
-				"name":  &objects.String{Value: curr.String()},
-				"total": &objects.Float{Value: bal.Total},
-				"hold":  &objects.Float{Value: bal.Hold},
-			}})
-		}
+		funds.Value = append(funds.Value, balancesObject(rtnValue[i].Balances).Value...)
 	}
 
 	data := make(map[string]objects.Object, 2)
@@ -287,6 +281,10 @@ func ExchangeAccountBalances(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeOrderQuery query order on exchange
 func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderQuery(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderQuery(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) < 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -333,7 +331,7 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	orderDetails, err := wrappers.GetWrapper().
+	orderDetails, err := w.
 		QueryOrder(ctx, exchangeName, orderID, pair, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -374,6 +372,10 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeOrderCancel cancels order on requested exchange
 func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderCancel(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderCancel(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) < 3 || len(args) > 5 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -424,7 +426,7 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	isCancelled, err := wrappers.GetWrapper().
+	isCancelled, err := w.
 		CancelOrder(ctx, exchangeName, orderID, cp, a)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -438,6 +440,10 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeOrderSubmit submit order on exchange
 func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderSubmit(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderSubmit(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 10 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -515,7 +521,7 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtn, err := wrappers.GetWrapper().SubmitOrder(ctx, tempSubmit)
+	rtn, err := w.SubmitOrder(ctx, tempSubmit)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -529,6 +535,10 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeDepositAddress returns deposit address (if supported by exchange)
 func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
+	return exchangeDepositAddress(wrappers.GetWrapper(), args...)
+}
+
+func exchangeDepositAddress(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -548,7 +558,7 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 
 	currCode := currency.NewCode(currencyCode)
 
-	rtn, err := wrappers.GetWrapper().DepositAddress(exchangeName, chain, currCode)
+	rtn, err := w.DepositAddress(exchangeName, chain, currCode)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -561,6 +571,10 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeWithdrawCrypto submit request to withdraw crypto assets
 func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
+	return exchangeWithdrawCrypto(wrappers.GetWrapper(), args...)
+}
+
+func exchangeWithdrawCrypto(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 8 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -611,7 +625,7 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtn, err := wrappers.GetWrapper().WithdrawalCryptoFunds(ctx, withdrawRequest)
+	rtn, err := w.WithdrawalCryptoFunds(ctx, withdrawRequest)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -621,6 +635,10 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 
 // ExchangeWithdrawFiat submit request to withdraw fiat assets
 func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
+	return exchangeWithdrawFiat(wrappers.GetWrapper(), args...)
+}
+
+func exchangeWithdrawFiat(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 6 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -658,7 +676,7 @@ func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtn, err := wrappers.GetWrapper().
+	rtn, err := w.
 		WithdrawalFiatFunds(ctx, bankAccountID, withdrawRequest)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -672,12 +690,125 @@ type OHLCV struct {
 	objects.Map
 }
 
+// OrderbookObject returns the script representation of an orderbook
+func OrderbookObject(ob *orderbook.Book) objects.Object {
+	asks := objects.Array{Value: make([]objects.Object, len(ob.Asks))}
+	for x := range ob.Asks {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: ob.Asks[x].Amount}
+		temp["price"] = &objects.Float{Value: ob.Asks[x].Price}
+		asks.Value[x] = &objects.Map{Value: temp}
+	}
+
+	bids := objects.Array{Value: make([]objects.Object, len(ob.Bids))}
+	for x := range ob.Bids {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: ob.Bids[x].Amount}
+		temp["price"] = &objects.Float{Value: ob.Bids[x].Price}
+		bids.Value[x] = &objects.Map{Value: temp}
+	}
+
+	data := make(map[string]objects.Object, 5)
+	data["exchange"] = &objects.String{Value: ob.Exchange}
+	data["pair"] = &objects.String{Value: ob.Pair.String()}
+	data["asks"] = &asks
+	data["bids"] = &bids
+	data["asset"] = &objects.String{Value: ob.Asset.String()}
+
+	return &objects.Map{Value: data}
+}
+
+// TickerObject returns the script representation of a ticker
+func TickerObject(tx *ticker.Price) objects.Object {
+	data := make(map[string]objects.Object, 14)
+	data["exchange"] = &objects.String{Value: tx.ExchangeName}
+	data["last"] = &objects.Float{Value: tx.Last}
+	data["High"] = &objects.Float{Value: tx.High}
+	data["Low"] = &objects.Float{Value: tx.Low}
+	data["bid"] = &objects.Float{Value: tx.Bid}
+	data["ask"] = &objects.Float{Value: tx.Ask}
+	data["volume"] = &objects.Float{Value: tx.Volume}
+	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
+	data["priceath"] = &objects.Float{Value: tx.PriceATH}
+	data["open"] = &objects.Float{Value: tx.Open}
+	data["close"] = &objects.Float{Value: tx.Close}
+	data["pair"] = &objects.String{Value: tx.Pair.String()}
+	data["asset"] = &objects.String{Value: tx.AssetType.String()}
+	data["updated"] = &objects.Time{Value: tx.LastUpdated}
+
+	return &objects.Map{Value: data}
+}
+
+// OrderObject returns the script representation of an order update
+func OrderObject(o *order.Detail) objects.Object {
+	data := make(map[string]objects.Object, 15)
+	data["exchange"] = &objects.String{Value: o.Exchange}
+	data["id"] = &objects.String{Value: o.OrderID}
+	data["clientid"] = &objects.String{Value: o.ClientOrderID}
+	data["pair"] = &objects.String{Value: o.Pair.String()}
+	data["asset"] = &objects.String{Value: o.AssetType.String()}
+	data["price"] = &objects.Float{Value: o.Price}
+	data["amount"] = &objects.Float{Value: o.Amount}
+	data["amountexecuted"] = &objects.Float{Value: o.ExecutedAmount}
+	data["amountremaining"] = &objects.Float{Value: o.RemainingAmount}
+	data["fee"] = &objects.Float{Value: o.Fee}
+	data["side"] = &objects.String{Value: o.Side.String()}
+	data["type"] = &objects.String{Value: o.Type.String()}
+	data["status"] = &objects.String{Value: o.Status.String()}
+	data["date"] = &objects.Time{Value: o.Date}
+	data["updated"] = &objects.Time{Value: o.LastUpdated}
+
+	return &objects.Map{Value: data}
+}
+
+// FillObject returns the script representation of a fill
+func FillObject(f *fill.Data) objects.Object {
+	data := make(map[string]objects.Object, 11)
+	data["exchange"] = &objects.String{Value: f.Exchange}
+	data["id"] = &objects.String{Value: f.ID}
+	data["orderid"] = &objects.String{Value: f.OrderID}
+	data["clientorderid"] = &objects.String{Value: f.ClientOrderID}
+	data["tradeid"] = &objects.String{Value: f.TradeID}
+	data["pair"] = &objects.String{Value: f.CurrencyPair.String()}
+	data["asset"] = &objects.String{Value: f.AssetType.String()}
+	data["side"] = &objects.String{Value: f.Side.String()}
+	data["price"] = &objects.Float{Value: f.Price}
+	data["amount"] = &objects.Float{Value: f.Amount}
+	data["timestamp"] = &objects.Time{Value: f.Timestamp}
+
+	return &objects.Map{Value: data}
+}
+
+// BalanceObject returns the script representation of an account balance
+// update
+func BalanceObject(exch string, s *accounts.SubAccount) objects.Object {
+	data := make(map[string]objects.Object, 4)
+	data["exchange"] = &objects.String{Value: exch}
+	data["id"] = &objects.String{Value: s.ID}
+	data["asset"] = &objects.String{Value: s.AssetType.String()}
+	data["currencies"] = balancesObject(s.Balances)
+
+	return &objects.Map{Value: data}
+}
+
+func balancesObject(balances accounts.CurrencyBalances) *objects.Array {
+	funds := &objects.Array{Value: make([]objects.Object, 0, len(balances))}
+	for curr, bal := range balances {
+		funds.Value = append(funds.Value, &objects.Map{Value: map[string]objects.Object{
+			"name":  &objects.String{Value: curr.String()},
+			"total": &objects.Float{Value: bal.Total},
+			"hold":  &objects.Float{Value: bal.Hold},
+		}})
+	}
+	return funds
+}
+
 // TypeName returns the name of the custom type.
 func (o *OHLCV) TypeName() string {
 	return indicators.OHLCV
 }
 
-func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
+func exchangeOHLCV(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
 	if len(args) != 8 {
 		return nil, objects.ErrWrongNumArguments
 	}
@@ -731,7 +862,7 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	ret, err := wrappers.GetWrapper().
+	ret, err := w.
 		OHLCV(ctx,
 			exchangeName,
 			pair,
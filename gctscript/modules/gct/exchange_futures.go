@@ -0,0 +1,283 @@
+package gct
+
+import (
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+)
+
+const (
+	positionsFunc    = "positions"
+	setLeverageFunc  = "setleverage"
+	fundingRatesFunc = "fundingrates"
+	openInterestFunc = "openinterest"
+)
+
+// ExchangeFuturesPositions returns futures positions for a currency pair
+// since the start time, each with the orders which make up the position
+// Params: scriptCTX, exchange, currency pair, delimiter, asset string, start time.Time
+func ExchangeFuturesPositions(args ...objects.Object) (objects.Object, error) {
+	return exchangeFuturesPositions(wrappers.GetWrapper(), args...)
+}
+
+func exchangeFuturesPositions(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, positionsFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, positionsFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, positionsFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, positionsFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, positionsFunc, "string", args[4])
+	}
+	startTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, positionsFunc, "time.Time", args[5])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, false)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := w.FuturesPositions(ctx, exchangeName, &futures.PositionsRequest{
+		Asset:     assetType,
+		Pairs:     currency.Pairs{pair},
+		StartDate: startTime,
+		EndDate:   time.Now(),
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	positions := objects.Array{Value: make([]objects.Object, len(rtn))}
+	for x := range rtn {
+		temp := make(map[string]objects.Object, 4)
+		temp["exchange"] = &objects.String{Value: rtn[x].Exchange}
+		temp["pair"] = &objects.String{Value: rtn[x].Pair.String()}
+		temp["asset"] = &objects.String{Value: rtn[x].Asset.String()}
+		temp["orders"] = ordersObject(rtn[x].Orders)
+		positions.Value[x] = &objects.Map{Value: temp}
+	}
+	return &positions, nil
+}
+
+// ExchangeSetLeverage sets the leverage for a currency pair. Margin type and
+// side may be empty when the exchange does not require them
+// Params: scriptCTX, exchange, currency pair, delimiter, asset, margin type string, leverage float64, side string
+func ExchangeSetLeverage(args ...objects.Object) (objects.Object, error) {
+	return exchangeSetLeverage(wrappers.GetWrapper(), args...)
+}
+
+func exchangeSetLeverage(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 8 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, setLeverageFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, setLeverageFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, setLeverageFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, setLeverageFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, setLeverageFunc, "string", args[4])
+	}
+	marginTypeParam, ok := objects.ToString(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, setLeverageFunc, "string", args[5])
+	}
+	leverage, ok := objects.ToFloat64(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, setLeverageFunc, "float64", args[6])
+	}
+	sideParam, ok := objects.ToString(args[7])
+	if !ok {
+		return nil, constructRuntimeError(8, setLeverageFunc, "string", args[7])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, false)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	marginType, err := margin.StringToMarginType(marginTypeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	side := order.UnknownSide
+	if sideParam != "" {
+		side, err = order.StringToOrderSide(sideParam)
+		if err != nil {
+			return errorResponsef(standardFormatting, err)
+		}
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	err = w.SetLeverage(ctx, exchangeName, assetType, pair, marginType, leverage, side)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// ExchangeFundingRates returns the latest funding rates for an asset,
+// optionally limited to a currency pair
+// Params: scriptCTX, exchange, currency pair, delimiter, asset string
+func ExchangeFundingRates(args ...objects.Object) (objects.Object, error) {
+	return exchangeFundingRates(wrappers.GetWrapper(), args...)
+}
+
+func exchangeFundingRates(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, fundingRatesFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, fundingRatesFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, fundingRatesFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, fundingRatesFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, fundingRatesFunc, "string", args[4])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, true)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := w.LatestFundingRates(ctx, exchangeName, &fundingrate.LatestRateRequest{
+		Asset:                assetType,
+		Pair:                 pair,
+		IncludePredictedRate: true,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	rates := objects.Array{Value: make([]objects.Object, len(rtn))}
+	for x := range rtn {
+		temp := make(map[string]objects.Object, 8)
+		temp["exchange"] = &objects.String{Value: rtn[x].Exchange}
+		temp["pair"] = &objects.String{Value: rtn[x].Pair.String()}
+		temp["asset"] = &objects.String{Value: rtn[x].Asset.String()}
+		temp["rate"] = &objects.Float{Value: rtn[x].LatestRate.Rate.InexactFloat64()}
+		temp["ratetime"] = &objects.Time{Value: rtn[x].LatestRate.Time}
+		temp["predictedrate"] = &objects.Float{Value: rtn[x].PredictedUpcomingRate.Rate.InexactFloat64()}
+		temp["nextrate"] = &objects.Time{Value: rtn[x].TimeOfNextRate}
+		temp["checked"] = &objects.Time{Value: rtn[x].TimeChecked}
+		rates.Value[x] = &objects.Map{Value: temp}
+	}
+	return &rates, nil
+}
+
+// ExchangeOpenInterest returns open interest for an asset, for every
+// supported currency pair when the currency pair is empty
+// Params: scriptCTX, exchange, currency pair, delimiter, asset string
+func ExchangeOpenInterest(args ...objects.Object) (objects.Object, error) {
+	return exchangeOpenInterest(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOpenInterest(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, openInterestFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, openInterestFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, openInterestFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, openInterestFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, openInterestFunc, "string", args[4])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, true)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	var keys []key.PairAsset
+	if !pair.IsEmpty() {
+		keys = append(keys, key.PairAsset{Base: pair.Base.Item, Quote: pair.Quote.Item, Asset: assetType})
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := w.OpenInterest(ctx, exchangeName, keys...)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	interest := objects.Array{Value: make([]objects.Object, 0, len(rtn))}
+	for x := range rtn {
+		if rtn[x].Key.Asset != assetType {
+			continue
+		}
+		temp := make(map[string]objects.Object, 4)
+		temp["exchange"] = &objects.String{Value: rtn[x].Key.Exchange}
+		temp["pair"] = &objects.String{Value: rtn[x].Key.Pair().String()}
+		temp["asset"] = &objects.String{Value: rtn[x].Key.Asset.String()}
+		temp["openinterest"] = &objects.Float{Value: rtn[x].OpenInterest}
+		interest.Value = append(interest.Value, &objects.Map{Value: temp})
+	}
+	return &interest, nil
+}
@@ -0,0 +1,81 @@
+package gct
+
+import (
+	"testing"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var futuresAsset = &objects.String{Value: "perpetualswap"}
+
+func TestExchangeFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFuturesPositions()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	v, err := ExchangeFuturesPositions(ctx, exch, currencyPair, delimiter, futuresAsset, start)
+	require.NoError(t, err, "ExchangeFuturesPositions must not error")
+	positions, ok := v.(*objects.Array)
+	require.True(t, ok, "ExchangeFuturesPositions must return an array")
+	require.Len(t, positions.Value, 1, "ExchangeFuturesPositions must return a position")
+	assert.IsType(t, &objects.Array{}, positions.Value[0].(*objects.Map).Value["orders"], "ExchangeFuturesPositions should return position orders")
+}
+
+func TestExchangeSetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetLeverage()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	leverage := &objects.Float{Value: 10}
+	v, err := ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, futuresAsset, &objects.String{Value: "isolated"}, leverage, blank)
+	require.NoError(t, err, "ExchangeSetLeverage must not error")
+	assert.Equal(t, tv, v, "ExchangeSetLeverage should return true")
+
+	v, err = ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, futuresAsset, &objects.String{Value: "invalid"}, leverage, blank)
+	require.NoError(t, err, "ExchangeSetLeverage must not error")
+	assert.IsType(t, &objects.Error{}, v, "ExchangeSetLeverage should return an error object on an invalid margin type")
+
+	v, err = ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, futuresAsset, blank, leverage, &objects.String{Value: "invalid"})
+	require.NoError(t, err, "ExchangeSetLeverage must not error")
+	assert.IsType(t, &objects.Error{}, v, "ExchangeSetLeverage should return an error object on an invalid side")
+
+	v, err = ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, assetType, blank, leverage, blank)
+	require.NoError(t, err, "ExchangeSetLeverage must not error")
+	assert.IsType(t, &objects.Error{}, v, "ExchangeSetLeverage should return an error object for spot")
+}
+
+func TestExchangeFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFundingRates()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	v, err := ExchangeFundingRates(ctx, exch, currencyPair, delimiter, futuresAsset)
+	require.NoError(t, err, "ExchangeFundingRates must not error")
+	rates, ok := v.(*objects.Array)
+	require.True(t, ok, "ExchangeFundingRates must return an array")
+	require.Len(t, rates.Value, 1, "ExchangeFundingRates must return a rate")
+	assert.Equal(t, &objects.Float{Value: 0.0001}, rates.Value[0].(*objects.Map).Value["rate"], "ExchangeFundingRates should return the latest rate")
+}
+
+func TestExchangeOpenInterest(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOpenInterest()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	v, err := ExchangeOpenInterest(ctx, exch, blank, delimiter, futuresAsset)
+	require.NoError(t, err, "ExchangeOpenInterest must not error")
+	interest, ok := v.(*objects.Array)
+	require.True(t, ok, "ExchangeOpenInterest must return an array")
+	assert.Len(t, interest.Value, 1, "ExchangeOpenInterest should return all pairs without a pair")
+
+	v, err = ExchangeOpenInterest(ctx, exch, currencyPair, delimiter, futuresAsset)
+	require.NoError(t, err, "ExchangeOpenInterest must not error")
+	interest, ok = v.(*objects.Array)
+	require.True(t, ok, "ExchangeOpenInterest must return an array")
+	require.Len(t, interest.Value, 1, "ExchangeOpenInterest must return the pair")
+	assert.Equal(t, &objects.String{Value: "BTCAUD"}, interest.Value[0].(*objects.Map).Value["pair"], "ExchangeOpenInterest should return the requested pair")
+}
@@ -0,0 +1,433 @@
+package gct
+
+import (
+	"fmt"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+)
+
+const (
+	orderModifyFunc      = "ordermodify"
+	orderCancelBatchFunc = "ordercancelbatch"
+	orderCancelAllFunc   = "ordercancelall"
+	activeOrdersFunc     = "activeorders"
+	orderHistoryFunc     = "orderhistory"
+	historicTradesFunc   = "historictrades"
+)
+
+// ExchangeOrderModify modifies the price and amount of an open order
+// Params: scriptCTX, exchange, order id, currency pair, delimiter, asset string, price, amount float64
+func ExchangeOrderModify(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderModify(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderModify(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 8 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, orderModifyFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, orderModifyFunc, "string", args[1])
+	}
+	orderID, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, orderModifyFunc, "string", args[2])
+	}
+	if orderID == "" {
+		return nil, fmt.Errorf(ErrEmptyParameter, "orderID")
+	}
+	currencyPair, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, orderModifyFunc, "string", args[3])
+	}
+	delimiter, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, orderModifyFunc, "string", args[4])
+	}
+	assetTypeParam, ok := objects.ToString(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, orderModifyFunc, "string", args[5])
+	}
+	price, ok := objects.ToFloat64(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, orderModifyFunc, "float64", args[6])
+	}
+	amount, ok := objects.ToFloat64(args[7])
+	if !ok {
+		return nil, constructRuntimeError(8, orderModifyFunc, "float64", args[7])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, false)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := w.ModifyOrder(ctx, &order.Modify{
+		Exchange:  exchangeName,
+		OrderID:   orderID,
+		Pair:      pair,
+		AssetType: assetType,
+		Price:     price,
+		Amount:    amount,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	data := make(map[string]objects.Object, 9)
+	data["exchange"] = &objects.String{Value: rtn.Exchange}
+	data["id"] = &objects.String{Value: rtn.OrderID}
+	data["clientid"] = &objects.String{Value: rtn.ClientOrderID}
+	data["pair"] = &objects.String{Value: rtn.Pair.String()}
+	data["asset"] = &objects.String{Value: rtn.AssetType.String()}
+	data["price"] = &objects.Float{Value: rtn.Price}
+	data["amount"] = &objects.Float{Value: rtn.Amount}
+	data["amountremaining"] = &objects.Float{Value: rtn.RemainingAmount}
+	data["status"] = &objects.String{Value: rtn.Status.String()}
+	return &objects.Map{Value: data}, nil
+}
+
+// ExchangeOrderCancelBatch cancels a batch of orders and returns a map of
+// order id to cancellation status
+// Params: scriptCTX, exchange string, order ids []string, currency pair, delimiter, asset string
+func ExchangeOrderCancelBatch(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderCancelBatch(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderCancelBatch(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, orderCancelBatchFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, orderCancelBatchFunc, "string", args[1])
+	}
+	orderIDs, ok := args[2].(*objects.Array)
+	if !ok {
+		return nil, constructRuntimeError(3, orderCancelBatchFunc, "[]string", args[2])
+	}
+	if len(orderIDs.Value) == 0 {
+		return nil, fmt.Errorf(ErrEmptyParameter, "order ids")
+	}
+	currencyPair, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, orderCancelBatchFunc, "string", args[3])
+	}
+	delimiter, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, orderCancelBatchFunc, "string", args[4])
+	}
+	assetTypeParam, ok := objects.ToString(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, orderCancelBatchFunc, "string", args[5])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, false)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	cancels := make([]order.Cancel, len(orderIDs.Value))
+	for i := range orderIDs.Value {
+		orderID, ok := objects.ToString(orderIDs.Value[i])
+		if !ok || orderID == "" {
+			return nil, constructRuntimeError(3, orderCancelBatchFunc, "[]string", orderIDs.Value[i])
+		}
+		cancels[i] = order.Cancel{
+			Exchange:  exchangeName,
+			OrderID:   orderID,
+			Pair:      pair,
+			AssetType: assetType,
+		}
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := w.CancelBatchOrders(ctx, exchangeName, cancels)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return statusObject(rtn.Status), nil
+}
+
+// ExchangeOrderCancelAll cancels all open orders for an asset, optionally
+// limited to a currency pair, and returns a map of order id to cancellation
+// status
+// Params: scriptCTX, exchange, currency pair, delimiter, asset string
+func ExchangeOrderCancelAll(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderCancelAll(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderCancelAll(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, orderCancelAllFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, orderCancelAllFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, orderCancelAllFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, orderCancelAllFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, orderCancelAllFunc, "string", args[4])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, true)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := w.CancelAllOrders(ctx, exchangeName, &order.Cancel{
+		Exchange:  exchangeName,
+		Pair:      pair,
+		AssetType: assetType,
+	})
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return statusObject(rtn.Status), nil
+}
+
+// ExchangeActiveOrders returns open orders for an asset, optionally limited to
+// a currency pair
+// Params: scriptCTX, exchange, currency pair, delimiter, asset string
+func ExchangeActiveOrders(args ...objects.Object) (objects.Object, error) {
+	return exchangeActiveOrders(wrappers.GetWrapper(), args...)
+}
+
+func exchangeActiveOrders(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, activeOrdersFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, activeOrdersFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, activeOrdersFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, activeOrdersFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, activeOrdersFunc, "string", args[4])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, true)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := w.ActiveOrders(ctx, exchangeName, multiOrderRequest(pair, assetType, time.Time{}, time.Time{}))
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return ordersObject(rtn), nil
+}
+
+// ExchangeOrderHistory returns historic orders for an asset between the start
+// and end times, optionally limited to a currency pair
+// Params: scriptCTX, exchange, currency pair, delimiter, asset string, start, end time.Time
+func ExchangeOrderHistory(args ...objects.Object) (objects.Object, error) {
+	return exchangeOrderHistory(wrappers.GetWrapper(), args...)
+}
+
+func exchangeOrderHistory(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, orderHistoryFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, orderHistoryFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, orderHistoryFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, orderHistoryFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, orderHistoryFunc, "string", args[4])
+	}
+	startTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, orderHistoryFunc, "time.Time", args[5])
+	}
+	endTime, ok := objects.ToTime(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, orderHistoryFunc, "time.Time", args[6])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, true)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := w.OrderHistory(ctx, exchangeName, multiOrderRequest(pair, assetType, startTime, endTime))
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return ordersObject(rtn), nil
+}
+
+// ExchangeHistoricTrades returns public trades for a currency pair between the
+// start and end times
+// Params: scriptCTX, exchange, currency pair, delimiter, asset string, start, end time.Time
+func ExchangeHistoricTrades(args ...objects.Object) (objects.Object, error) {
+	return exchangeHistoricTrades(wrappers.GetWrapper(), args...)
+}
+
+func exchangeHistoricTrades(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, constructRuntimeError(1, historicTradesFunc, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, historicTradesFunc, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, constructRuntimeError(3, historicTradesFunc, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, historicTradesFunc, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, historicTradesFunc, "string", args[4])
+	}
+	startTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, historicTradesFunc, "time.Time", args[5])
+	}
+	endTime, ok := objects.ToTime(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, historicTradesFunc, "time.Time", args[6])
+	}
+
+	pair, assetType, err := parsePairAsset(currencyPair, delimiter, assetTypeParam, false)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ctx := processScriptContext(scriptCtx)
+	rtn, err := w.HistoricTrades(ctx, exchangeName, pair, assetType, startTime, endTime)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	trades := objects.Array{Value: make([]objects.Object, len(rtn))}
+	for x := range rtn {
+		temp := make(map[string]objects.Object, 5)
+		temp["id"] = &objects.String{Value: rtn[x].TID}
+		temp["price"] = &objects.Float{Value: rtn[x].Price}
+		temp["amount"] = &objects.Float{Value: rtn[x].Amount}
+		temp["side"] = &objects.String{Value: rtn[x].Side.String()}
+		temp["timestamp"] = &objects.Time{Value: rtn[x].Timestamp}
+		trades.Value[x] = &objects.Map{Value: temp}
+	}
+	return &trades, nil
+}
+
+// parsePairAsset parses a currency pair and asset type. An empty currency pair
+// is allowed when the request applies to every pair of the asset
+func parsePairAsset(currencyPair, delimiter, assetType string, allowEmptyPair bool) (currency.Pair, asset.Item, error) {
+	a, err := asset.New(assetType)
+	if err != nil {
+		return currency.EMPTYPAIR, asset.Empty, err
+	}
+	if currencyPair == "" && allowEmptyPair {
+		return currency.EMPTYPAIR, a, nil
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return currency.EMPTYPAIR, asset.Empty, err
+	}
+	return p, a, nil
+}
+
+// multiOrderRequest returns a request for orders of any type and side
+func multiOrderRequest(pair currency.Pair, assetType asset.Item, start, end time.Time) *order.MultiOrderRequest {
+	req := &order.MultiOrderRequest{
+		AssetType: assetType,
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+		StartTime: start,
+		EndTime:   end,
+	}
+	if !pair.IsEmpty() {
+		req.Pairs = currency.Pairs{pair}
+	}
+	return req
+}
+
+// ordersObject returns the script representation of a list of orders
+func ordersObject(orders []order.Detail) objects.Object {
+	r := objects.Array{Value: make([]objects.Object, len(orders))}
+	for x := range orders {
+		r.Value[x] = OrderObject(&orders[x])
+	}
+	return &r
+}
+
+// statusObject returns the script representation of an order id to status map
+func statusObject(status map[string]string) objects.Object {
+	data := make(map[string]objects.Object, len(status))
+	for id, s := range status {
+		data[id] = &objects.String{Value: s}
+	}
+	return &objects.Map{Value: data}
+}
@@ -0,0 +1,134 @@
+package gct
+
+import (
+	"testing"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var validatorError = &objects.String{Value: `""`}
+
+func TestExchangeOrderModify(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderModify()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	price, amount := &objects.Float{Value: 1}, &objects.Float{Value: 2}
+	_, err = ExchangeOrderModify(ctx, exch, blank, currencyPair, delimiter, assetType, price, amount)
+	assert.Error(t, err, "ExchangeOrderModify should error on an empty order id")
+
+	v, err := ExchangeOrderModify(ctx, exch, orderID, currencyPair, delimiter, assetType, price, amount)
+	require.NoError(t, err, "ExchangeOrderModify must not error")
+	m, ok := v.(*objects.Map)
+	require.True(t, ok, "ExchangeOrderModify must return a map")
+	assert.Equal(t, price, m.Value["price"], "ExchangeOrderModify should return the new price")
+
+	v, err = ExchangeOrderModify(ctx, validatorError, orderID, currencyPair, delimiter, assetType, price, amount)
+	require.NoError(t, err, "ExchangeOrderModify must not error")
+	assert.IsType(t, &objects.Error{}, v, "ExchangeOrderModify should return an error object")
+}
+
+func TestExchangeOrderCancelBatch(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderCancelBatch()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = ExchangeOrderCancelBatch(ctx, exch, orderID, currencyPair, delimiter, assetType)
+	assert.Error(t, err, "ExchangeOrderCancelBatch should error when order ids is not an array")
+
+	_, err = ExchangeOrderCancelBatch(ctx, exch, &objects.Array{}, currencyPair, delimiter, assetType)
+	assert.Error(t, err, "ExchangeOrderCancelBatch should error on empty order ids")
+
+	ids := &objects.Array{Value: []objects.Object{orderID, &objects.String{Value: "1236"}}}
+	v, err := ExchangeOrderCancelBatch(ctx, exch, ids, currencyPair, delimiter, assetType)
+	require.NoError(t, err, "ExchangeOrderCancelBatch must not error")
+	m, ok := v.(*objects.Map)
+	require.True(t, ok, "ExchangeOrderCancelBatch must return a map")
+	assert.Len(t, m.Value, 2, "ExchangeOrderCancelBatch should return a status for each order")
+}
+
+func TestExchangeOrderCancelAll(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderCancelAll()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	v, err := ExchangeOrderCancelAll(ctx, exch, blank, delimiter, assetType)
+	require.NoError(t, err, "ExchangeOrderCancelAll must not error")
+	assert.IsType(t, &objects.Map{}, v, "ExchangeOrderCancelAll should return a map")
+
+	v, err = ExchangeOrderCancelAll(ctx, validatorError, currencyPair, delimiter, assetType)
+	require.NoError(t, err, "ExchangeOrderCancelAll must not error")
+	assert.IsType(t, &objects.Error{}, v, "ExchangeOrderCancelAll should return an error object")
+}
+
+func TestExchangeActiveOrders(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeActiveOrders()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	v, err := ExchangeActiveOrders(ctx, exch, blank, delimiter, assetType)
+	require.NoError(t, err, "ExchangeActiveOrders must not error")
+	orders, ok := v.(*objects.Array)
+	require.True(t, ok, "ExchangeActiveOrders must return an array")
+	require.Len(t, orders.Value, 1, "ExchangeActiveOrders must return an order")
+	assert.Equal(t, &objects.String{Value: order.Active.String()}, orders.Value[0].(*objects.Map).Value["status"], "ExchangeActiveOrders should return active orders")
+
+	v, err = ExchangeActiveOrders(ctx, exch, currencyPair, delimiter, &objects.String{Value: "invalid"})
+	require.NoError(t, err, "ExchangeActiveOrders must not error")
+	assert.IsType(t, &objects.Error{}, v, "ExchangeActiveOrders should return an error object on an invalid asset")
+}
+
+func TestExchangeOrderHistory(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderHistory()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	start, end := &objects.Time{Value: time.Now().Add(-time.Hour)}, &objects.Time{Value: time.Now()}
+	_, err = ExchangeOrderHistory(ctx, exch, currencyPair, delimiter, assetType, blank, end)
+	assert.Error(t, err, "ExchangeOrderHistory should error on an invalid start time")
+
+	v, err := ExchangeOrderHistory(ctx, exch, currencyPair, delimiter, assetType, start, end)
+	require.NoError(t, err, "ExchangeOrderHistory must not error")
+	assert.IsType(t, &objects.Array{}, v, "ExchangeOrderHistory should return an array")
+}
+
+func TestExchangeHistoricTrades(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeHistoricTrades()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	start, end := &objects.Time{Value: time.Now().Add(-time.Hour)}, &objects.Time{Value: time.Now()}
+	v, err := ExchangeHistoricTrades(ctx, exch, blank, delimiter, assetType, start, end)
+	require.NoError(t, err, "ExchangeHistoricTrades must not error")
+	assert.IsType(t, &objects.Error{}, v, "ExchangeHistoricTrades should return an error object without a pair")
+
+	v, err = ExchangeHistoricTrades(ctx, exch, currencyPair, delimiter, assetType, start, end)
+	require.NoError(t, err, "ExchangeHistoricTrades must not error")
+	trades, ok := v.(*objects.Array)
+	require.True(t, ok, "ExchangeHistoricTrades must return an array")
+	assert.Len(t, trades.Value, 1, "ExchangeHistoricTrades should return trades")
+}
+
+func TestParsePairAsset(t *testing.T) {
+	t.Parallel()
+	p, a, err := parsePairAsset("", "", "spot", true)
+	require.NoError(t, err, "parsePairAsset must not error")
+	assert.True(t, p.IsEmpty(), "parsePairAsset should allow an empty pair")
+	assert.Equal(t, asset.Spot, a, "parsePairAsset should parse the asset")
+
+	_, _, err = parsePairAsset("", "", "spot", false)
+	assert.Error(t, err, "parsePairAsset should error on an empty pair")
+
+	_, _, err = parsePairAsset("BTC-USD", "-", "invalid", false)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	p, _, err = parsePairAsset("BTC-USD", "-", "spot", false)
+	require.NoError(t, err, "parsePairAsset must not error")
+	assert.True(t, p.Equal(currency.NewBTCUSD()), "parsePairAsset should parse the pair")
+}
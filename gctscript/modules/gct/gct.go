@@ -2,10 +2,12 @@ package gct
 
 import (
 	"context"
+	"maps"
 
 	objects "github.com/d5/tengo/v2"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 const (
@@ -29,6 +31,23 @@ func AllModuleNames() []string {
 	return names
 }
 
+// ModulesWithWrapper returns a copy of Modules whose functions call the
+// wrapper returned by w in place of the default wrapper
+func ModulesWithWrapper(w func() modules.GCTExchange) map[string]map[string]objects.Object {
+	out := maps.Clone(Modules)
+	out["exchange"] = exchangeFunctions(w)
+	out["state"] = stateFunctions(w)
+	return out
+}
+
+// wrapperFunction returns a module function which calls fn with the wrapper
+// returned by w
+func wrapperFunction(name string, fn func(modules.GCTExchange, ...objects.Object) (objects.Object, error), w func() modules.GCTExchange) *objects.UserFunction {
+	return &objects.UserFunction{Name: name, Value: func(args ...objects.Object) (objects.Object, error) {
+		return fn(w(), args...)
+	}}
+}
+
 // setVerbose specifically sets verbosity for http rest requests for this script
 // Params: scriptCTX
 func setVerbose(args ...objects.Object) (objects.Object, error) {
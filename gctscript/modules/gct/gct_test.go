@@ -188,6 +188,29 @@ func TestAllModuleNames(t *testing.T) {
 	require.NotEmpty(t, AllModuleNames(), "AllModuleNames must not return an empty slice")
 }
 
+// exchangesWrapper records calls to Exchanges
+type exchangesWrapper struct {
+	validator.Wrapper
+	called bool
+}
+
+func (w *exchangesWrapper) Exchanges(bool) []string {
+	w.called = true
+	return nil
+}
+
+func TestModulesWithWrapper(t *testing.T) {
+	t.Parallel()
+	w := &exchangesWrapper{}
+	m := ModulesWithWrapper(func() modules.GCTExchange { return w })
+	require.Len(t, m, len(Modules), "ModulesWithWrapper must return every module")
+	_, err := m["exchange"][exchangesFunc].(*objects.UserFunction).Value(objects.TrueValue)
+	require.NoError(t, err, "exchanges must not error")
+	assert.True(t, w.called, "ModulesWithWrapper should call the bound wrapper")
+	assert.NotSame(t, Modules["state"][stateGetFunc], m["state"][stateGetFunc], "state functions should be bound to the wrapper")
+	assert.Same(t, Modules["common"][writeAsCSVFunc], m["common"][writeAsCSVFunc], "modules which do not call a wrapper should be shared")
+}
+
 func TestExchangeDepositAddress(t *testing.T) {
 	t.Parallel()
 	_, err := ExchangeDepositAddress()
@@ -0,0 +1,216 @@
+package gct
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+)
+
+// Capability is a group of module functions a script may be permitted to call
+type Capability string
+
+// Capabilities which can be granted to scripts
+const (
+	// CapabilityMarketData allows reading public market data
+	CapabilityMarketData Capability = "marketdata"
+	// CapabilityAccount allows reading account balances, deposit addresses,
+	// orders and positions
+	CapabilityAccount Capability = "account"
+	// CapabilityTrading allows submitting, modifying and cancelling orders and
+	// changing leverage
+	CapabilityTrading Capability = "trading"
+	// CapabilityWithdraw allows withdrawing funds
+	CapabilityWithdraw Capability = "withdraw"
+	// CapabilityFileIO allows writing files and importing the tengo os module
+	CapabilityFileIO Capability = "fileio"
+)
+
+// AllCapabilities lists every capability
+var AllCapabilities = []Capability{CapabilityMarketData, CapabilityAccount, CapabilityTrading, CapabilityWithdraw, CapabilityFileIO}
+
+var (
+	// ErrCapabilityDenied is returned when a script calls a function it has
+	// not been granted the capability for
+	ErrCapabilityDenied = errors.New("script capability denied")
+	// ErrOrderRateExceeded is returned when a script submits or modifies more
+	// orders than its rate limit allows
+	ErrOrderRateExceeded = errors.New("script order rate exceeded")
+	// ErrOrderNotionalExceeded is returned when a script order's price
+	// multiplied by its amount exceeds its notional cap
+	ErrOrderNotionalExceeded = errors.New("script order notional exceeded")
+
+	errUnknownCapability = errors.New("unknown script capability")
+	errInvalidLimit      = errors.New("script limits cannot be negative")
+)
+
+// capabilities maps each restricted module function to the capability it
+// requires. Functions which are not listed are available to every script
+var capabilities = map[string]map[string]Capability{
+	"exchange": {
+		orderbookFunc:        CapabilityMarketData,
+		tickerFunc:           CapabilityMarketData,
+		exchangesFunc:        CapabilityMarketData,
+		pairsFunc:            CapabilityMarketData,
+		ohlcvFunc:            CapabilityMarketData,
+		historicTradesFunc:   CapabilityMarketData,
+		fundingRatesFunc:     CapabilityMarketData,
+		openInterestFunc:     CapabilityMarketData,
+		accountBalancesFunc:  CapabilityAccount,
+		depositAddressFunc:   CapabilityAccount,
+		orderQueryFunc:       CapabilityAccount,
+		activeOrdersFunc:     CapabilityAccount,
+		orderHistoryFunc:     CapabilityAccount,
+		positionsFunc:        CapabilityAccount,
+		orderSubmitFunc:      CapabilityTrading,
+		orderModifyFunc:      CapabilityTrading,
+		orderCancelFunc:      CapabilityTrading,
+		orderCancelBatchFunc: CapabilityTrading,
+		orderCancelAllFunc:   CapabilityTrading,
+		setLeverageFunc:      CapabilityTrading,
+		withdrawCryptoFunc:   CapabilityWithdraw,
+		withdrawFiatFunc:     CapabilityWithdraw,
+	},
+	"common": {
+		writeAsCSVFunc: CapabilityFileIO,
+	},
+}
+
+// Permissions defines what a script may do. Scripts are granted every
+// capability when Capabilities is nil and none when it is empty. Zero limits
+// are unlimited
+type Permissions struct {
+	Capabilities       []Capability `json:"capabilities,omitempty"`
+	MaxAllocs          int64        `json:"max_allocs,omitempty"`
+	MaxOrdersPerMinute int64        `json:"max_orders_per_minute,omitempty"`
+	MaxOrderNotional   float64      `json:"max_order_notional,omitempty"`
+}
+
+// Allows returns whether the permissions grant the capability
+func (p *Permissions) Allows(c Capability) bool {
+	return p == nil || p.Capabilities == nil || slices.Contains(p.Capabilities, c)
+}
+
+// Validate checks the capabilities are known and the limits are not negative
+func (p *Permissions) Validate() error {
+	if p == nil {
+		return nil
+	}
+	for _, c := range p.Capabilities {
+		if !slices.Contains(AllCapabilities, c) {
+			return fmt.Errorf("%w: %q", errUnknownCapability, c)
+		}
+	}
+	if p.MaxAllocs < 0 || p.MaxOrdersPerMinute < 0 || p.MaxOrderNotional < 0 {
+		return errInvalidLimit
+	}
+	return nil
+}
+
+// ModulesWithPermissions returns a copy of the modules for a single script
+// which enforces its permissions. Functions the script lacks the capability
+// for return ErrCapabilityDenied, and order submission and modification are
+// limited by the script's notional cap and order limiter. A nil limiter does
+// not limit the order rate
+func ModulesWithPermissions(m map[string]map[string]objects.Object, p *Permissions, limiter *OrderLimiter) map[string]map[string]objects.Object {
+	out := make(map[string]map[string]objects.Object, len(m))
+	for name, module := range m {
+		restricted := maps.Clone(module)
+		for funcName, required := range capabilities[name] {
+			fn, ok := restricted[funcName].(*objects.UserFunction)
+			if !ok {
+				continue
+			}
+			switch {
+			case !p.Allows(required):
+				restricted[funcName] = deniedFunction(funcName, required)
+			case funcName == orderSubmitFunc || funcName == orderModifyFunc:
+				restricted[funcName] = limitedOrderFunction(fn, p, limiter)
+			}
+		}
+		out[name] = restricted
+	}
+	return out
+}
+
+// deniedFunction returns a function which errors because the script lacks
+// the capability to call it
+func deniedFunction(funcName string, required Capability) objects.Object {
+	return &objects.UserFunction{Name: funcName, Value: func(...objects.Object) (objects.Object, error) {
+		return nil, fmt.Errorf("%w: %s requires the %s capability", ErrCapabilityDenied, funcName, required)
+	}}
+}
+
+// limitedOrderFunction wraps ordersubmit or ordermodify to enforce the order
+// rate and notional caps. Both take price and amount at the same positions. A
+// price is required when a notional cap is set
+func limitedOrderFunction(fn *objects.UserFunction, p *Permissions, limiter *OrderLimiter) objects.Object {
+	return &objects.UserFunction{Name: fn.Name, Value: func(args ...objects.Object) (objects.Object, error) {
+		if p != nil && p.MaxOrderNotional > 0 && len(args) > 7 {
+			price, priceOK := objects.ToFloat64(args[6])
+			amount, amountOK := objects.ToFloat64(args[7])
+			if !priceOK || !amountOK || price <= 0 {
+				return nil, fmt.Errorf("%w: %s requires a price and amount when the notional is capped at %v", ErrOrderNotionalExceeded, fn.Name, p.MaxOrderNotional)
+			}
+			if notional := price * amount; notional > p.MaxOrderNotional {
+				return nil, fmt.Errorf("%w: %s notional %v exceeds %v", ErrOrderNotionalExceeded, fn.Name, notional, p.MaxOrderNotional)
+			}
+		}
+		if !limiter.allow(time.Now()) {
+			return nil, fmt.Errorf("%w: %s exceeds %d orders per minute", ErrOrderRateExceeded, fn.Name, limiter.Limit())
+		}
+		return fn.Value(args...)
+	}}
+}
+
+// OrderLimiter limits the number of orders a script submits or modifies in any
+// one minute window. A limiter is shared by every run of the same script
+type OrderLimiter struct {
+	mtx   sync.Mutex
+	max   int64
+	times []time.Time
+}
+
+// SetLimit sets the maximum number of orders per minute. Zero is unlimited
+func (l *OrderLimiter) SetLimit(maxPerMinute int64) {
+	l.mtx.Lock()
+	l.max = maxPerMinute
+	l.mtx.Unlock()
+}
+
+// Limit returns the maximum number of orders per minute
+func (l *OrderLimiter) Limit() int64 {
+	if l == nil {
+		return 0
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.max
+}
+
+// allow records an order at now and returns whether it is within the limit
+func (l *OrderLimiter) allow(now time.Time) bool {
+	if l == nil {
+		return true
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.max <= 0 {
+		return true
+	}
+	cutoff := now.Add(-time.Minute)
+	i := 0
+	for i < len(l.times) && !l.times[i].After(cutoff) {
+		i++
+	}
+	l.times = l.times[i:]
+	if int64(len(l.times)) >= l.max {
+		return false
+	}
+	l.times = append(l.times, now)
+	return true
+}
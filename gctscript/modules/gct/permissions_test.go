@@ -0,0 +1,98 @@
+package gct
+
+import (
+	"testing"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestCapabilitiesCoverModules(t *testing.T) {
+	t.Parallel()
+	for _, name := range []string{"exchange", "common"} {
+		for funcName := range Modules[name] {
+			assert.Containsf(t, capabilities[name], funcName, "%s.%s should require a capability", name, funcName)
+		}
+	}
+}
+
+func TestPermissionsAllows(t *testing.T) {
+	t.Parallel()
+	var p *Permissions
+	assert.True(t, p.Allows(CapabilityWithdraw), "nil permissions should allow everything")
+	assert.True(t, (&Permissions{}).Allows(CapabilityWithdraw), "nil capabilities should allow everything")
+	p = &Permissions{Capabilities: []Capability{CapabilityMarketData}}
+	assert.True(t, p.Allows(CapabilityMarketData), "granted capability should be allowed")
+	assert.False(t, p.Allows(CapabilityTrading), "capability not granted should not be allowed")
+	assert.False(t, (&Permissions{Capabilities: []Capability{}}).Allows(CapabilityMarketData), "empty capabilities should allow nothing")
+}
+
+func TestPermissionsValidate(t *testing.T) {
+	t.Parallel()
+	var p *Permissions
+	assert.NoError(t, p.Validate())
+	assert.NoError(t, (&Permissions{Capabilities: AllCapabilities, MaxAllocs: 1}).Validate())
+	assert.ErrorIs(t, (&Permissions{Capabilities: []Capability{"teleport"}}).Validate(), errUnknownCapability)
+	assert.ErrorIs(t, (&Permissions{MaxOrderNotional: -1}).Validate(), errInvalidLimit)
+}
+
+func TestModulesWithPermissions(t *testing.T) {
+	t.Parallel()
+	m := ModulesWithPermissions(Modules, &Permissions{Capabilities: []Capability{CapabilityMarketData}}, nil)
+	require.Len(t, m, len(Modules), "ModulesWithPermissions must return every module")
+
+	_, err := m["exchange"][tickerFunc].(*objects.UserFunction).Value(ctx, exch, currencyPair, delimiter, assetType)
+	assert.NoError(t, err, "permitted function should not error")
+	_, err = m["exchange"][withdrawCryptoFunc].(*objects.UserFunction).Value()
+	assert.ErrorIs(t, err, ErrCapabilityDenied)
+	_, err = m["common"][writeAsCSVFunc].(*objects.UserFunction).Value()
+	assert.ErrorIs(t, err, ErrCapabilityDenied)
+	assert.Same(t, Modules["exchange"][withdrawCryptoFunc], exchangeModule[withdrawCryptoFunc], "Modules should not be modified")
+}
+
+func TestOrderLimits(t *testing.T) {
+	t.Parallel()
+	p := &Permissions{MaxOrderNotional: 100}
+	limiter := &OrderLimiter{}
+	limiter.SetLimit(1)
+	submit := ModulesWithPermissions(Modules, p, limiter)["exchange"][orderSubmitFunc].(*objects.UserFunction)
+	order := func(price, amount float64) []objects.Object {
+		return []objects.Object{
+			ctx, exch, currencyPair, delimiter, &objects.String{Value: "LIMIT"}, &objects.String{Value: "ASK"},
+			&objects.Float{Value: price}, &objects.Float{Value: amount}, orderID, &objects.String{Value: asset.Spot.String()},
+		}
+	}
+
+	_, err := submit.Value(order(50, 3)...)
+	assert.ErrorIs(t, err, ErrOrderNotionalExceeded)
+	_, err = submit.Value(order(0, 1)...)
+	assert.ErrorIs(t, err, ErrOrderNotionalExceeded, "market orders should be denied when the notional is capped")
+	_, err = submit.Value(order(50, 2)...)
+	assert.NoError(t, err)
+	_, err = submit.Value(order(50, 1)...)
+	assert.ErrorIs(t, err, ErrOrderRateExceeded)
+
+	submit = ModulesWithPermissions(Modules, p, limiter)["exchange"][orderSubmitFunc].(*objects.UserFunction)
+	_, err = submit.Value(order(50, 1)...)
+	assert.ErrorIs(t, err, ErrOrderRateExceeded, "module maps sharing a limiter should share the order rate")
+}
+
+func TestOrderLimiterAllow(t *testing.T) {
+	t.Parallel()
+	var nilLimiter *OrderLimiter
+	assert.True(t, nilLimiter.allow(time.Now()), "nil limiter should be unlimited")
+	assert.True(t, (&OrderLimiter{}).allow(time.Now()), "zero limit should be unlimited")
+
+	l := &OrderLimiter{}
+	l.SetLimit(2)
+	assert.Equal(t, int64(2), l.Limit(), "Limit should return the limit set")
+	now := time.Now()
+	assert.True(t, l.allow(now))
+	assert.True(t, l.allow(now.Add(time.Second)))
+	assert.False(t, l.allow(now.Add(2*time.Second)), "allow should deny orders over the limit")
+	assert.True(t, l.allow(now.Add(time.Minute)), "allow should permit orders once the window has passed")
+	assert.False(t, l.allow(now.Add(time.Minute+time.Second/2)), "allow should count orders still inside the window")
+}
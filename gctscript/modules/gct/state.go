@@ -0,0 +1,172 @@
+package gct
+
+import (
+	"bytes"
+	"errors"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+)
+
+const (
+	stateGetFunc    = "get"
+	stateSetFunc    = "set"
+	stateDeleteFunc = "delete"
+)
+
+var errNoScriptName = errors.New("script context has no script name")
+
+var stateModule = stateFunctions(wrappers.GetWrapper)
+
+// stateFunctions returns the state module functions, which call the wrapper
+// returned by w
+func stateFunctions(w func() modules.GCTExchange) map[string]objects.Object {
+	return map[string]objects.Object{
+		stateGetFunc:    wrapperFunction(stateGetFunc, stateGet, w),
+		stateSetFunc:    wrapperFunction(stateSetFunc, stateSet, w),
+		stateDeleteFunc: wrapperFunction(stateDeleteFunc, stateDelete, w),
+	}
+}
+
+// StateGet returns the value stored for the script's key, or undefined when
+// no value is stored
+// Params: scriptCTX, key string
+func StateGet(args ...objects.Object) (objects.Object, error) {
+	return stateGet(wrappers.GetWrapper(), args...)
+}
+
+func stateGet(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, name, err := stateContext(stateGetFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	key, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, stateGetFunc, "string", args[1])
+	}
+
+	value, found, err := w.GetState(processScriptContext(scriptCtx), name, key)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	if !found {
+		return objects.UndefinedValue, nil
+	}
+	obj, err := decodeState(value)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return obj, nil
+}
+
+// StateSet stores a value for the script's key, replacing any existing value.
+// Values are stored as JSON so survive script and engine restarts
+// Params: scriptCTX, key string, value any
+func StateSet(args ...objects.Object) (objects.Object, error) {
+	return stateSet(wrappers.GetWrapper(), args...)
+}
+
+func stateSet(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, name, err := stateContext(stateSetFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	key, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, stateSetFunc, "string", args[1])
+	}
+
+	value, err := json.Marshal(objects.ToInterface(args[2]))
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	err = w.SetState(processScriptContext(scriptCtx), name, key, string(value))
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return args[2], nil
+}
+
+// StateDelete removes the script's key
+// Params: scriptCTX, key string
+func StateDelete(args ...objects.Object) (objects.Object, error) {
+	return stateDelete(wrappers.GetWrapper(), args...)
+}
+
+func stateDelete(w modules.GCTExchange, args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	scriptCtx, name, err := stateContext(stateDeleteFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	key, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, stateDeleteFunc, "string", args[1])
+	}
+
+	err = w.DeleteState(processScriptContext(scriptCtx), name, key)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// stateContext returns the script context and the script name which scopes
+// its state
+func stateContext(funcName string, arg objects.Object) (*Context, string, error) {
+	scriptCtx, ok := objects.ToInterface(arg).(*Context)
+	if !ok {
+		return nil, "", constructRuntimeError(1, funcName, "*gct.Context", arg)
+	}
+	nameObj, ok := scriptCtx.Value["name"]
+	if !ok {
+		return nil, "", errNoScriptName
+	}
+	name, ok := objects.ToString(nameObj)
+	if !ok || name == "" {
+		return nil, "", errNoScriptName
+	}
+	return scriptCtx, name, nil
+}
+
+// decodeState converts a stored JSON value to a tengo object, keeping whole
+// numbers as integers
+func decodeState(value string) (objects.Object, error) {
+	d := json.NewDecoder(bytes.NewBufferString(value))
+	d.UseNumber()
+	var v any
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return objects.FromInterface(convertNumbers(v))
+}
+
+func convertNumbers(v any) any {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]any:
+		for k := range t {
+			t[k] = convertNumbers(t[k])
+		}
+	case []any:
+		for i := range t {
+			t[i] = convertNumbers(t[i])
+		}
+	}
+	return v
+}
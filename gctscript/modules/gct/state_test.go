@@ -0,0 +1,84 @@
+package gct
+
+import (
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateGet(t *testing.T) {
+	t.Parallel()
+	scriptCtx := &Context{}
+	scriptCtx.Value = map[string]objects.Object{"name": &objects.String{Value: "test.gct"}}
+
+	_, err := StateGet()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = StateGet(ctx, &objects.String{Value: "exists"})
+	assert.ErrorIs(t, err, errNoScriptName)
+
+	v, err := StateGet(scriptCtx, &objects.String{Value: "missing"})
+	require.NoError(t, err, "StateGet must not error")
+	assert.Equal(t, objects.UndefinedValue, v, "StateGet should return undefined for a missing key")
+
+	v, err = StateGet(scriptCtx, &objects.String{Value: "exists"})
+	require.NoError(t, err, "StateGet must not error")
+	m, ok := v.(*objects.Map)
+	require.True(t, ok, "StateGet must return a map")
+	assert.Equal(t, &objects.Int{Value: 1}, m.Value["counter"], "StateGet should decode whole numbers as integers")
+
+	errCtx := &Context{}
+	errCtx.Value = map[string]objects.Object{"name": &objects.String{Value: `""`}} // validator failure name
+	v, err = StateGet(errCtx, &objects.String{Value: "exists"})
+	require.NoError(t, err, "StateGet must not error")
+	assert.IsType(t, &objects.Error{}, v, "StateGet should return an error object")
+}
+
+func TestStateSet(t *testing.T) {
+	t.Parallel()
+	scriptCtx := &Context{}
+	scriptCtx.Value = map[string]objects.Object{"name": &objects.String{Value: "test.gct"}}
+
+	_, err := StateSet()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	_, err = StateSet(ctx, &objects.String{Value: "key"}, tv)
+	assert.ErrorIs(t, err, errNoScriptName)
+
+	value := &objects.Map{Value: map[string]objects.Object{"counter": &objects.Int{Value: 2}}}
+	v, err := StateSet(scriptCtx, &objects.String{Value: "key"}, value)
+	require.NoError(t, err, "StateSet must not error")
+	assert.Equal(t, value, v, "StateSet should return the stored value")
+}
+
+func TestStateDelete(t *testing.T) {
+	t.Parallel()
+	scriptCtx := &Context{}
+	scriptCtx.Value = map[string]objects.Object{"name": &objects.String{Value: "test.gct"}}
+
+	_, err := StateDelete()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	v, err := StateDelete(scriptCtx, &objects.String{Value: "key"})
+	require.NoError(t, err, "StateDelete must not error")
+	assert.Equal(t, tv, v, "StateDelete should return true")
+}
+
+func TestDecodeState(t *testing.T) {
+	t.Parallel()
+	v, err := decodeState(`{"a":[1,1.5,"b"],"c":null}`)
+	require.NoError(t, err, "decodeState must not error")
+	m, ok := v.(*objects.Map)
+	require.True(t, ok, "decodeState must return a map")
+	assert.Equal(t, &objects.Array{Value: []objects.Object{
+		&objects.Int{Value: 1},
+		&objects.Float{Value: 1.5},
+		&objects.String{Value: "b"},
+	}}, m.Value["a"], "decodeState should convert nested numbers")
+	assert.Equal(t, objects.UndefinedValue, m.Value["c"], "decodeState should convert null to undefined")
+
+	_, err = decodeState("{")
+	assert.Error(t, err, "decodeState should error on invalid JSON")
+}
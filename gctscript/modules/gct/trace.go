@@ -0,0 +1,164 @@
+package gct
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+)
+
+const (
+	// MaxTraceEntries is the number of module function calls recorded for a
+	// debugged script, further calls are counted but not recorded
+	MaxTraceEntries = 1000
+
+	maxTraceValueLength = 512
+	redactedValue       = "<redacted>"
+)
+
+var (
+	// ErrBreakpoint is returned when a debugged script calls a function with a
+	// breakpoint, halting the script before the call is made
+	ErrBreakpoint = errors.New("script breakpoint hit")
+
+	errUnknownBreakpoint = errors.New("unknown breakpoint function, expected module.function")
+)
+
+// TraceEntry is a single module function call made by a debugged script
+type TraceEntry struct {
+	Module     string
+	Function   string
+	Args       []string
+	Result     string
+	Error      string
+	Time       time.Time
+	Duration   time.Duration
+	Breakpoint bool
+}
+
+// Tracer records the module function calls made by a debugged script and
+// halts the script at breakpoints. Breakpoints are module.function names,
+// e.g. exchange.ordersubmit
+type Tracer struct {
+	mtx         sync.Mutex
+	entries     []TraceEntry
+	dropped     int
+	breakpoints map[string]struct{}
+}
+
+// NewTracer returns a tracer which halts scripts at the breakpoints
+func NewTracer(breakpoints []string) (*Tracer, error) {
+	t := &Tracer{breakpoints: make(map[string]struct{}, len(breakpoints))}
+	for _, b := range breakpoints {
+		b = strings.ToLower(strings.TrimSpace(b))
+		module, funcName, ok := strings.Cut(b, ".")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", errUnknownBreakpoint, b)
+		}
+		if _, ok = Modules[module][funcName]; !ok {
+			return nil, fmt.Errorf("%w: %q", errUnknownBreakpoint, b)
+		}
+		t.breakpoints[b] = struct{}{}
+	}
+	return t, nil
+}
+
+// Entries returns the recorded calls in the order they were made
+func (t *Tracer) Entries() []TraceEntry {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	entries := make([]TraceEntry, len(t.entries))
+	copy(entries, t.entries)
+	return entries
+}
+
+// Dropped returns the number of calls which were not recorded as the trace
+// was full
+func (t *Tracer) Dropped() int {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	return t.dropped
+}
+
+// record adds an entry to the trace
+func (t *Tracer) record(e *TraceEntry) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if len(t.entries) >= MaxTraceEntries {
+		t.dropped++
+		return
+	}
+	t.entries = append(t.entries, *e)
+}
+
+// ModulesWithTracer returns a copy of the modules whose functions record
+// their calls to the tracer
+func ModulesWithTracer(m map[string]map[string]objects.Object, t *Tracer) map[string]map[string]objects.Object {
+	out := make(map[string]map[string]objects.Object, len(m))
+	for name, module := range m {
+		traced := maps.Clone(module)
+		for funcName, obj := range traced {
+			if fn, ok := obj.(*objects.UserFunction); ok {
+				traced[funcName] = tracedFunction(name, funcName, fn, t)
+			}
+		}
+		out[name] = traced
+	}
+	return out
+}
+
+// tracedFunction wraps a module function to record its arguments and result.
+// Account credentials passed to set_account are redacted
+func tracedFunction(module, funcName string, fn *objects.UserFunction, t *Tracer) objects.Object {
+	_, breakpoint := t.breakpoints[module+"."+funcName]
+	redact := module == "global" && funcName == setAccountFunc
+	return &objects.UserFunction{Name: fn.Name, Value: func(args ...objects.Object) (objects.Object, error) {
+		e := &TraceEntry{
+			Module:     module,
+			Function:   funcName,
+			Args:       make([]string, len(args)),
+			Time:       time.Now(),
+			Breakpoint: breakpoint,
+		}
+		for i := range args {
+			if redact && i > 0 {
+				e.Args[i] = redactedValue
+				continue
+			}
+			e.Args[i] = traceValue(args[i])
+		}
+		if breakpoint {
+			t.record(e)
+			return nil, fmt.Errorf("%w: %s.%s", ErrBreakpoint, module, funcName)
+		}
+		ret, err := fn.Value(args...)
+		e.Duration = time.Since(e.Time)
+		if err != nil {
+			e.Error = err.Error()
+		} else {
+			e.Result = traceValue(ret)
+		}
+		t.record(e)
+		return ret, err
+	}}
+}
+
+// traceValue returns the string representation of a script value. Script
+// contexts are not expanded as they can hold account credentials
+func traceValue(o objects.Object) string {
+	if o == nil {
+		return objects.UndefinedValue.String()
+	}
+	if c, ok := o.(*Context); ok {
+		return c.TypeName()
+	}
+	s := o.String()
+	if len(s) > maxTraceValueLength {
+		s = s[:maxTraceValueLength] + "..."
+	}
+	return s
+}
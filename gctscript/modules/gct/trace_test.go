@@ -0,0 +1,71 @@
+package gct
+
+import (
+	"strings"
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTracer(t *testing.T) {
+	t.Parallel()
+	_, err := NewTracer([]string{"ordersubmit"})
+	assert.ErrorIs(t, err, errUnknownBreakpoint)
+	_, err = NewTracer([]string{"exchange.teleport"})
+	assert.ErrorIs(t, err, errUnknownBreakpoint)
+	tracer, err := NewTracer([]string{" Exchange.OrderSubmit "})
+	require.NoError(t, err, "NewTracer must not error")
+	assert.Contains(t, tracer.breakpoints, "exchange.ordersubmit", "NewTracer should normalise breakpoints")
+}
+
+func TestModulesWithTracer(t *testing.T) {
+	t.Parallel()
+	tracer, err := NewTracer([]string{"exchange.ordersubmit"})
+	require.NoError(t, err, "NewTracer must not error")
+	m := ModulesWithTracer(Modules, tracer)
+	require.Len(t, m, len(Modules), "ModulesWithTracer must return every module")
+	assert.Same(t, Modules["exchange"][tickerFunc], exchangeModule[tickerFunc], "Modules should not be modified")
+
+	_, err = m["exchange"][tickerFunc].(*objects.UserFunction).Value(ctx, exch, currencyPair, delimiter, assetType)
+	require.NoError(t, err, "ticker must not error")
+	_, err = m["exchange"][tickerFunc].(*objects.UserFunction).Value()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = m["global"][setAccountFunc].(*objects.UserFunction).Value(&Context{}, &objects.String{Value: "key"}, &objects.String{Value: "secret"})
+	require.NoError(t, err, "set_account must not error")
+	_, err = m["exchange"][orderSubmitFunc].(*objects.UserFunction).Value(ctx, exch)
+	assert.ErrorIs(t, err, ErrBreakpoint)
+
+	entries := tracer.Entries()
+	require.Len(t, entries, 4, "Entries must return every call")
+	assert.Equal(t, "exchange", entries[0].Module)
+	assert.Equal(t, tickerFunc, entries[0].Function)
+	assert.Equal(t, []string{"scriptContext", `"BTC Markets"`, `"BTC-AUD"`, `"-"`, `"SPOT"`}, entries[0].Args, "Args should not expand the script context")
+	assert.NotEmpty(t, entries[0].Result, "Result should be recorded")
+	assert.Empty(t, entries[0].Error)
+	assert.Equal(t, objects.ErrWrongNumArguments.Error(), entries[1].Error, "Error should be recorded")
+	assert.Equal(t, []string{"scriptContext", redactedValue, redactedValue}, entries[2].Args, "set_account credentials should be redacted")
+	assert.Equal(t, "scriptContext", entries[2].Result, "Result should not expand the script context")
+	assert.True(t, entries[3].Breakpoint, "Breakpoint should be set when a breakpoint is hit")
+	assert.Empty(t, entries[3].Result, "Breakpoints should halt before the call is made")
+}
+
+func TestTracerRecord(t *testing.T) {
+	t.Parallel()
+	tracer := &Tracer{}
+	for range MaxTraceEntries + 2 {
+		tracer.record(&TraceEntry{})
+	}
+	assert.Len(t, tracer.Entries(), MaxTraceEntries, "record should not exceed MaxTraceEntries")
+	assert.Equal(t, 2, tracer.Dropped(), "Dropped should count calls which were not recorded")
+}
+
+func TestTraceValue(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "<undefined>", traceValue(nil))
+	assert.Equal(t, "scriptContext", traceValue(&Context{}))
+	assert.Equal(t, "1", traceValue(&objects.Int{Value: 1}))
+	long := traceValue(&objects.String{Value: strings.Repeat("a", maxTraceValueLength*2)})
+	assert.Len(t, long, maxTraceValueLength+3, "traceValue should truncate long values")
+}
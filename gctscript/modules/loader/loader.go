@@ -3,6 +3,7 @@ package loader
 import (
 	"github.com/d5/tengo/v2"
 	"github.com/d5/tengo/v2/stdlib"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta"
 )
@@ -10,32 +11,65 @@ import (
 // GetModuleMap returns the module map that includes all modules
 // for the given module names.
 func GetModuleMap() *tengo.ModuleMap {
-	modules := tengo.NewModuleMap()
+	return GetModuleMapWithPermissions(nil, nil)
+}
+
+// GetModuleMapWithPermissions returns the module map that includes all
+// modules, restricted to the given script permissions and order limiter. The
+// stdlib os module is only included when file I/O is permitted. Nil
+// permissions allow everything
+func GetModuleMapWithPermissions(p *gct.Permissions, limiter *gct.OrderLimiter) *tengo.ModuleMap {
+	return GetModuleMapWithTracer(p, limiter, nil)
+}
+
+// GetModuleMapWithTracer returns the module map restricted to the given script
+// permissions and order limiter, with the gct module function calls recorded
+// by the tracer. A nil tracer records nothing
+func GetModuleMapWithTracer(p *gct.Permissions, limiter *gct.OrderLimiter, t *gct.Tracer) *tengo.ModuleMap {
+	return getModuleMap(gct.Modules, p, limiter, t)
+}
 
+// GetModuleMapWithWrapper returns the module map restricted to the given
+// script permissions and order limiter, with the gct module functions calling
+// the wrapper returned by w in place of the default wrapper
+func GetModuleMapWithWrapper(p *gct.Permissions, limiter *gct.OrderLimiter, w func() modules.GCTExchange) *tengo.ModuleMap {
+	return getModuleMap(gct.ModulesWithWrapper(w), p, limiter, nil)
+}
+
+func getModuleMap(m map[string]map[string]tengo.Object, p *gct.Permissions, limiter *gct.OrderLimiter, t *gct.Tracer) *tengo.ModuleMap {
+	moduleMap := tengo.NewModuleMap()
+
+	gctModules := gct.ModulesWithPermissions(m, p, limiter)
+	if t != nil {
+		gctModules = gct.ModulesWithTracer(gctModules, t)
+	}
 	gctModuleList := gct.AllModuleNames()
 	for _, name := range gctModuleList {
-		if mod := gct.Modules[name]; mod != nil {
-			modules.AddBuiltinModule(name, mod)
+		if mod := gctModules[name]; mod != nil {
+			moduleMap.AddBuiltinModule(name, mod)
 		}
 	}
 
 	taModuleList := ta.AllModuleNames()
 	for _, name := range taModuleList {
 		if mod := ta.Modules[name]; mod != nil {
-			modules.AddBuiltinModule(name, mod)
+			moduleMap.AddBuiltinModule(name, mod)
 		}
 	}
 
 	stdLib := stdlib.AllModuleNames()
 	for _, name := range stdLib {
+		if name == "os" && !p.Allows(gct.CapabilityFileIO) {
+			continue
+		}
 		if mod := stdlib.BuiltinModules[name]; mod != nil {
-			modules.AddBuiltinModule(name, mod)
+			moduleMap.AddBuiltinModule(name, mod)
 		}
 		if mod := stdlib.SourceModules[name]; mod != "" {
-			modules.AddSourceModule(name, []byte(mod))
+			moduleMap.AddSourceModule(name, []byte(mod))
 		}
 	}
-	return modules
+	return moduleMap
 }
 
 // SetDefaultScriptOutput sets the output folder
@@ -3,8 +3,12 @@ package loader
 import (
 	"testing"
 
+	"github.com/d5/tengo/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 )
 
 func TestGetModuleMap(t *testing.T) {
@@ -12,3 +16,31 @@ func TestGetModuleMap(t *testing.T) {
 	require.NotNil(t, x, "GetModuleMap must not return nil")
 	assert.NotZero(t, x.Len(), "GetModuleMap should return a map with entries")
 }
+
+func TestGetModuleMapWithPermissions(t *testing.T) {
+	x := GetModuleMapWithPermissions(&gct.Permissions{Capabilities: []gct.Capability{gct.CapabilityMarketData}}, nil)
+	require.NotNil(t, x, "GetModuleMapWithPermissions must not return nil")
+	assert.Nil(t, x.Get("os"), "os module should not be loaded without file I/O")
+	assert.NotNil(t, x.Get("exchange"), "exchange module should be loaded")
+	assert.NotNil(t, GetModuleMap().Get("os"), "os module should be loaded without permissions")
+}
+
+func TestGetModuleMapWithTracer(t *testing.T) {
+	tracer, err := gct.NewTracer(nil)
+	require.NoError(t, err, "NewTracer must not error")
+	x := GetModuleMapWithTracer(nil, nil, tracer)
+	require.NotNil(t, x, "GetModuleMapWithTracer must not return nil")
+	global, ok := x.Get("global").(*tengo.BuiltinModule)
+	require.True(t, ok, "global module must be a builtin module")
+	fn, ok := global.Attrs["set_verbose"].(*tengo.UserFunction)
+	require.True(t, ok, "set_verbose must be a user function")
+	_, err = fn.Value(&gct.Context{})
+	require.NoError(t, err, "set_verbose must not error")
+	assert.Len(t, tracer.Entries(), 1, "GetModuleMapWithTracer should trace gct module calls")
+}
+
+func TestGetModuleMapWithWrapper(t *testing.T) {
+	x := GetModuleMapWithWrapper(nil, nil, func() modules.GCTExchange { return validator.Wrapper{} })
+	require.NotNil(t, x, "GetModuleMapWithWrapper must not return nil")
+	assert.NotNil(t, x.Get("exchange"), "exchange module should be loaded")
+}
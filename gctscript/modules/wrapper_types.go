@@ -4,14 +4,20 @@ import (
 	"context"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -40,6 +46,20 @@ type GCTExchange interface {
 	WithdrawalFiatFunds(ctx context.Context, bankAccountID string, request *withdraw.Request) (out string, err error)
 	WithdrawalCryptoFunds(ctx context.Context, request *withdraw.Request) (out string, err error)
 	OHLCV(ctx context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error)
+	ModifyOrder(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error)
+	CancelBatchOrders(ctx context.Context, exch string, cancels []order.Cancel) (*order.CancelBatchResponse, error)
+	CancelAllOrders(ctx context.Context, exch string, cancel *order.Cancel) (order.CancelAllResponse, error)
+	ActiveOrders(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error)
+	OrderHistory(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error)
+	HistoricTrades(ctx context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error)
+	FuturesPositions(ctx context.Context, exch string, req *futures.PositionsRequest) ([]futures.PositionDetails, error)
+	SetLeverage(ctx context.Context, exch string, item asset.Item, pair currency.Pair, marginType margin.Type, amount float64, side order.Side) error
+	LatestFundingRates(ctx context.Context, exch string, req *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error)
+	OpenInterest(ctx context.Context, exch string, keys ...key.PairAsset) ([]futures.OpenInterest, error)
+	SubscribeAccountBalances(exch string) (dispatch.Pipe, error)
+	GetState(ctx context.Context, scriptName, key string) (value string, found bool, err error)
+	SetState(ctx context.Context, scriptName, key, value string) error
+	DeleteState(ctx context.Context, scriptName, key string) error
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
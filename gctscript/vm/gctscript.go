@@ -5,6 +5,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -34,6 +35,15 @@ func (g *GctScriptManager) Validate(file string) (err error) {
 	validator.IsTestExecution.Store(true)
 	defer validator.IsTestExecution.Store(false)
 	tempVM := g.NewVM()
+	defer func() {
+		if tempVM != nil {
+			gct.ReleaseChannels(tempVM.scriptID())
+		}
+	}()
+	if tempVM != nil {
+		// Validation runs do not count towards the script's order rate
+		tempVM.manager = nil
+	}
 	err = tempVM.Load(file)
 	if err != nil {
 		return err
@@ -45,6 +55,39 @@ func (g *GctScriptManager) Validate(file string) (err error) {
 	return tempVM.RunCtx()
 }
 
+// Debug runs a script once and returns a trace of its gct module calls. The
+// script halts when it calls a breakpoint function. Event callbacks and
+// repeat timers are not run
+func (g *GctScriptManager) Debug(file string, breakpoints []string) ([]gct.TraceEntry, error) {
+	tracer, err := gct.NewTracer(breakpoints)
+	if err != nil {
+		return nil, err
+	}
+	debugVM := g.New()
+	if debugVM == nil {
+		return nil, ErrNoVMLoaded
+	}
+	debugVM.tracer = tracer
+	if err = debugVM.Load(file); err != nil {
+		if unregisterErr := debugVM.unregister(); unregisterErr != nil {
+			log.Errorln(log.GCTScriptMgr, unregisterErr)
+		}
+		return nil, err
+	}
+	defer func() {
+		if shutdownErr := debugVM.Shutdown(); shutdownErr != nil {
+			log.Errorln(log.GCTScriptMgr, shutdownErr)
+		}
+	}()
+	if err = debugVM.Compile(); err == nil {
+		err = debugVM.RunCtx()
+	}
+	if dropped := tracer.Dropped(); dropped > 0 {
+		log.Warnf(log.GCTScriptMgr, "Debug trace of %s exceeded %d calls, %d calls not recorded", debugVM.ShortName(), gct.MaxTraceEntries, dropped)
+	}
+	return tracer.Entries(), err
+}
+
 // ShutdownAll shutdown all
 func (g *GctScriptManager) ShutdownAll() (err error) {
 	if g.config.Verbose {
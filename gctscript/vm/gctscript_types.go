@@ -3,6 +3,8 @@ package vm
 import (
 	"errors"
 	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 )
 
 const (
@@ -19,6 +21,11 @@ type Config struct {
 	AllowImports       bool          `json:"allow_imports"`
 	AutoLoad           []string      `json:"auto_load"`
 	Verbose            bool          `json:"verbose"`
+	MaxQueuedEvents    uint64        `json:"max_queued_events"`
+	// DefaultPermissions apply to scripts without an entry in
+	// ScriptPermissions, which is keyed by script file name
+	DefaultPermissions gct.Permissions            `json:"default_permissions"`
+	ScriptPermissions  map[string]gct.Permissions `json:"script_permissions,omitempty"`
 }
 
 // Error interface to meet error requirements
@@ -36,4 +43,7 @@ var (
 	ErrScriptingDisabled = errors.New("scripting is disabled")
 	// ErrNoVMLoaded error message displayed if a virtual machine has not been initialised
 	ErrNoVMLoaded = errors.New("no virtual machine loaded")
+
+	errNoSubscriptions     = errors.New("event callbacks require a subscriptions variable")
+	errInvalidSubscription = errors.New("invalid subscription")
 )
@@ -7,6 +7,7 @@ import (
 	"sync/atomic"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -26,6 +27,11 @@ type GctScriptManager struct {
 	shutdown chan struct{}
 	// Optional values to override stored config ('nil' if not overridden)
 	MaxVirtualMachines *uint64
+
+	// orderLimiters holds each script's order limiter by script name so
+	// the order rate applies across runs of the same script
+	orderLimitersMtx sync.Mutex
+	orderLimiters    map[string]*gct.OrderLimiter
 }
 
 // NewManager creates a new instance of script manager
@@ -89,3 +95,23 @@ func (g *GctScriptManager) GetMaxVirtualMachines() uint64 {
 	}
 	return g.config.MaxVirtualMachines
 }
+
+// orderLimiter returns the order limiter for a script by name, updating its
+// limit to maxPerMinute
+func (g *GctScriptManager) orderLimiter(name string, maxPerMinute int64) *gct.OrderLimiter {
+	if g == nil {
+		return nil
+	}
+	g.orderLimitersMtx.Lock()
+	defer g.orderLimitersMtx.Unlock()
+	l, ok := g.orderLimiters[name]
+	if !ok {
+		if g.orderLimiters == nil {
+			g.orderLimiters = make(map[string]*gct.OrderLimiter)
+		}
+		l = &gct.OrderLimiter{}
+		g.orderLimiters[name] = l
+	}
+	l.SetLimit(maxPerMinute)
+	return l
+}
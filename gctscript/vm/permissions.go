@@ -0,0 +1,36 @@
+package vm
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+)
+
+// Permissions returns the permissions for a script by file name, with or
+// without its extension. A script's own entry replaces the default
+// permissions entirely
+func (c *Config) Permissions(name string) *gct.Permissions {
+	name = strings.TrimSuffix(filepath.Base(name), common.GctExt)
+	for _, k := range []string{name, name + common.GctExt} {
+		if p, ok := c.ScriptPermissions[k]; ok {
+			return &p
+		}
+	}
+	return &c.DefaultPermissions
+}
+
+// ValidatePermissions checks the default and every script's permissions
+func (c *Config) ValidatePermissions() error {
+	if err := c.DefaultPermissions.Validate(); err != nil {
+		return fmt.Errorf("default permissions: %w", err)
+	}
+	for name, p := range c.ScriptPermissions {
+		if err := p.Validate(); err != nil {
+			return fmt.Errorf("script %q permissions: %w", name, err)
+		}
+	}
+	return nil
+}
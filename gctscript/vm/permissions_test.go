@@ -0,0 +1,88 @@
+package vm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+)
+
+func TestConfigPermissions(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		DefaultPermissions: gct.Permissions{Capabilities: []gct.Capability{gct.CapabilityMarketData}},
+		ScriptPermissions: map[string]gct.Permissions{
+			"trader.gct": {Capabilities: []gct.Capability{gct.CapabilityTrading}, MaxOrdersPerMinute: 5},
+			"watcher":    {Capabilities: []gct.Capability{}},
+		},
+	}
+	assert.Equal(t, &c.DefaultPermissions, c.Permissions("other.gct"), "Permissions should return the defaults")
+	assert.Equal(t, int64(5), c.Permissions(filepath.Join("scripts", "trader.gct")).MaxOrdersPerMinute, "Permissions should match the script file name")
+	assert.Empty(t, c.Permissions("watcher.gct").Capabilities, "Permissions should match the script name without extension")
+	assert.NotNil(t, c.Permissions("watcher").Capabilities, "script permissions should replace the defaults")
+
+	require.NoError(t, c.ValidatePermissions())
+	c.ScriptPermissions["bad"] = gct.Permissions{MaxAllocs: -1}
+	assert.Error(t, c.ValidatePermissions(), "ValidatePermissions should error on invalid script permissions")
+	c.DefaultPermissions.Capabilities = []gct.Capability{"teleport"}
+	assert.Error(t, c.ValidatePermissions(), "ValidatePermissions should error on invalid default permissions")
+}
+
+func TestVMLoadPermissions(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	writeScript := func(name, code string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(code), 0o600), "WriteFile must not error")
+		return path
+	}
+	withdraw := writeScript("withdraw.gct", `exch := import("exchange")
+exch.withdrawcrypto(ctx, "binance", "BTC", "address", "", 1, 0, "")`)
+	osImport := writeScript("os.gct", `os := import("os")`)
+	allocs := writeScript("allocs.gct", `a := []
+for i := 0; i < 1000; i++ { a = append(a, i) }`)
+
+	cfg := configHelper(true, false, maxTestVirtualMachines)
+	cfg.DefaultPermissions.Capabilities = []gct.Capability{gct.CapabilityMarketData}
+	cfg.ScriptPermissions = map[string]gct.Permissions{"allocs": {MaxAllocs: 100}}
+	manager := GctScriptManager{config: cfg, started: 1}
+
+	testVM := manager.New()
+	require.NoError(t, testVM.Load(withdraw))
+	require.NoError(t, testVM.Compile())
+	assert.ErrorIs(t, testVM.RunCtx(), gct.ErrCapabilityDenied)
+
+	testVM = manager.New()
+	require.NoError(t, testVM.Load(osImport))
+	assert.Error(t, testVM.Compile(), "Compile should error importing os without file I/O")
+
+	testVM = manager.New()
+	require.NoError(t, testVM.Load(allocs))
+	require.NoError(t, testVM.Compile())
+	assert.Error(t, testVM.RunCtx(), "RunCtx should error when exceeding max allocations")
+
+	balance := writeScript("balance.gct", `subscriptions := [{exchange: "binance"}]
+on_balance := func(b) {}`)
+	ticker := writeScript("ticker.gct", `subscriptions := [{exchange: "binance"}]
+on_ticker := func(t) {}`)
+	testVM = manager.New()
+	assert.ErrorIs(t, testVM.Load(balance), gct.ErrCapabilityDenied, "Load should reject callbacks without the account capability")
+	testVM = manager.New()
+	assert.NoError(t, testVM.Load(ticker), "Load should accept callbacks with the market data capability")
+}
+
+func TestOrderLimiter(t *testing.T) {
+	t.Parallel()
+	var nilManager *GctScriptManager
+	assert.Nil(t, nilManager.orderLimiter("trader", 1), "orderLimiter should return nil for a nil manager")
+
+	manager := &GctScriptManager{}
+	l := manager.orderLimiter("trader", 1)
+	require.NotNil(t, l, "orderLimiter must return a limiter")
+	assert.Same(t, l, manager.orderLimiter("trader", 2), "orderLimiter should return the same limiter for a script name")
+	assert.Equal(t, int64(2), l.Limit(), "orderLimiter should update the limit")
+	assert.NotSame(t, l, manager.orderLimiter("other", 2), "orderLimiter should return a limiter per script name")
+}
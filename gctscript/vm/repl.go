@@ -0,0 +1,168 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/parser"
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/loader"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewREPL returns a REPL which evaluates snippets against the live engine
+// with the permissions configured for the "repl" script name
+func (g *GctScriptManager) NewREPL() (*REPL, error) {
+	if !g.IsRunning() {
+		return nil, ErrScriptingDisabled
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	perms := g.config.Permissions(replName)
+	r := &REPL{
+		id:        replName + "-" + id.String(),
+		config:    g.config,
+		maxAllocs: -1,
+		fileSet:   parser.NewFileSet(),
+		symbols:   tengo.NewSymbolTable(),
+		globals:   make([]tengo.Object, tengo.GlobalsSize),
+	}
+	r.modules = loader.GetModuleMapWithWrapper(perms, g.orderLimiter(replName, perms.MaxOrdersPerMinute), r.wrapper)
+	if perms.MaxAllocs > 0 {
+		r.maxAllocs = perms.MaxAllocs
+	}
+	for i, fn := range tengo.GetAllBuiltinFunctions() {
+		r.symbols.DefineBuiltin(i, fn.Name)
+	}
+	scriptCtx := &gct.Context{}
+	scriptCtx.Value = map[string]tengo.Object{
+		"script": &tengo.String{Value: r.id},
+		"name":   &tengo.String{Value: replName},
+	}
+	r.globals[r.symbols.Define("ctx").Index] = scriptCtx
+	r.globals[r.symbols.Define(replOutputFunc).Index] = &tengo.UserFunction{Name: replOutputFunc, Value: r.appendOutput}
+	if g.config.Verbose {
+		log.Debugf(log.GCTScriptMgr, "REPL %s started", r.id)
+	}
+	return r, nil
+}
+
+// Eval compiles and runs a snippet, keeping its variables for later snippets.
+// The values of expressions and assignments are returned. Dry runs use the
+// validator wrapper in place of the live exchanges
+func (r *REPL) Eval(ctx context.Context, code string, dryRun bool) (string, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.output = r.output[:0]
+
+	srcFile := r.fileSet.AddFile(replName, -1, len(code))
+	file, err := parser.NewParser(srcFile, []byte(code), nil).ParseFile()
+	if err != nil {
+		return "", err
+	}
+	c := tengo.NewCompiler(srcFile, r.symbols, r.constants, r.modules, nil)
+	if err = c.Compile(replOutputs(file)); err != nil {
+		return "", err
+	}
+	bytecode := c.Bytecode()
+
+	r.dryRun = dryRun
+	timeout := r.config.ScriptTimeout
+	if timeout <= 0 {
+		timeout = DefaultTimeoutValue
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	if err = r.run(ctx, tengo.NewVM(bytecode, r.globals, r.maxAllocs)); err != nil {
+		return strings.Join(r.output, "\n"), err
+	}
+	r.constants = bytecode.Constants
+	return strings.Join(r.output, "\n"), nil
+}
+
+// Close releases the REPL's script channels
+func (r *REPL) Close() {
+	gct.ReleaseChannels(r.id)
+	if r.config.Verbose {
+		log.Debugf(log.GCTScriptMgr, "REPL %s closed", r.id)
+	}
+}
+
+// wrapper returns the wrapper the REPL's module functions call, which is the
+// validator wrapper during dry runs
+func (r *REPL) wrapper() modules.GCTExchange {
+	if r.dryRun {
+		return validator.Wrapper{}
+	}
+	return wrappers.GetWrapper()
+}
+
+// run runs the machine until it completes or the context is done
+func (r *REPL) run(ctx context.Context, machine *tengo.VM) (err error) {
+	ch := make(chan error, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				ch <- fmt.Errorf("%s panic: %v", replName, p)
+			}
+		}()
+		ch <- machine.Run()
+	}()
+	select {
+	case <-ctx.Done():
+		machine.Abort()
+		<-ch
+		err = ctx.Err()
+	case err = <-ch:
+	}
+	return err
+}
+
+// appendOutput records the values of the snippet's expressions
+func (r *REPL) appendOutput(args ...tengo.Object) (tengo.Object, error) {
+	values := make([]string, len(args))
+	for i := range args {
+		switch v := args[i].(type) {
+		case *tengo.Undefined:
+			values[i] = v.String()
+		case *gct.Context:
+			// script contexts can hold account credentials
+			values[i] = v.TypeName()
+		default:
+			values[i], _ = tengo.ToString(v)
+		}
+	}
+	r.output = append(r.output, strings.Join(values, " "))
+	return tengo.UndefinedValue, nil
+}
+
+// replOutputs rewrites the snippet to output the values of its expression
+// statements and assigned variables
+func replOutputs(file *parser.File) *parser.File {
+	stmts := make([]parser.Stmt, 0, len(file.Stmts))
+	for _, s := range file.Stmts {
+		switch s := s.(type) {
+		case *parser.ExprStmt:
+			stmts = append(stmts, &parser.ExprStmt{Expr: &parser.CallExpr{
+				Func: &parser.Ident{Name: replOutputFunc},
+				Args: []parser.Expr{s.Expr},
+			}})
+		case *parser.AssignStmt:
+			stmts = append(stmts, s, &parser.ExprStmt{Expr: &parser.CallExpr{
+				Func: &parser.Ident{Name: replOutputFunc},
+				Args: s.LHS,
+			}})
+		default:
+			stmts = append(stmts, s)
+		}
+	}
+	return &parser.File{InputFile: file.InputFile, Stmts: stmts}
+}
@@ -0,0 +1,71 @@
+package vm
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+func TestNewREPL(t *testing.T) {
+	manager := GctScriptManager{config: configHelper(true, false, maxTestVirtualMachines)}
+	_, err := manager.NewREPL()
+	require.ErrorIs(t, err, ErrScriptingDisabled)
+
+	manager.started = 1
+	r, err := manager.NewREPL()
+	require.NoError(t, err, "NewREPL must not error")
+	require.NotNil(t, r, "NewREPL must return a REPL")
+	assert.Equal(t, int64(-1), r.maxAllocs, "NewREPL should not limit allocations by default")
+	r.Close()
+
+	manager.config.ScriptPermissions = map[string]gct.Permissions{replName: {MaxAllocs: 100}}
+	r, err = manager.NewREPL()
+	require.NoError(t, err, "NewREPL must not error")
+	assert.Equal(t, int64(100), r.maxAllocs, "NewREPL should apply the repl permissions")
+	r.Close()
+}
+
+func TestREPLEval(t *testing.T) {
+	manager := GctScriptManager{config: configHelper(true, false, maxTestVirtualMachines), started: 1}
+	r, err := manager.NewREPL()
+	require.NoError(t, err, "NewREPL must not error")
+	defer r.Close()
+
+	out, err := r.Eval(t.Context(), "a := 1", false)
+	require.NoError(t, err, "Eval must not error")
+	assert.Equal(t, "1", out, "Eval should output assigned values")
+	out, err = r.Eval(t.Context(), "a + 1\nb := \"x\"", false)
+	require.NoError(t, err, "Eval must not error")
+	assert.Equal(t, "2\nx", out, "Eval should keep variables between snippets")
+	out, err = r.Eval(t.Context(), "ctx", false)
+	require.NoError(t, err, "Eval must not error")
+	assert.Equal(t, "scriptContext", out, "Eval should not expand the script context")
+
+	_, err = r.Eval(t.Context(), "a := ", false)
+	assert.Error(t, err, "Eval should error on a parse error")
+	_, err = r.Eval(t.Context(), "c := d", false)
+	assert.Error(t, err, "Eval should error on a compile error")
+	_, err = r.Eval(t.Context(), "a / 0", false)
+	assert.Error(t, err, "Eval should error on a runtime error")
+	out, err = r.Eval(t.Context(), "a", false)
+	require.NoError(t, err, "Eval must not error after a failed snippet")
+	assert.Equal(t, "1", out)
+
+	out, err = r.Eval(t.Context(), `exch := import("exchange")
+is_error(exch.ticker(ctx, "binance", "BTC-USDT", "-", "spot"))`, true)
+	require.NoError(t, err, "Eval must not error on a dry run")
+	assert.True(t, strings.HasSuffix(out, "\nfalse"), "Eval should use the validator wrapper on a dry run")
+	assert.NotEqual(t, true, validator.IsTestExecution.Load(), "Eval should not flip the process wide test execution flag")
+	_, err = r.Eval(t.Context(), `exch.ticker(ctx, "binance", "BTC-USDT", "-", "spot")`, false)
+	assert.Error(t, err, "Eval should call the live wrapper from modules imported during a dry run")
+
+	r.config = &Config{ScriptTimeout: time.Millisecond}
+	_, err = r.Eval(t.Context(), "for {}", false)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -6,6 +6,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
 	"os"
 	"path/filepath"
 	"sync/atomic"
@@ -55,6 +56,7 @@ func (g *GctScriptManager) NewVM() *VM {
 		Script:     s,
 		config:     g.config,
 		unregister: func() error { return g.RemoveVM(newUUID) },
+		manager:    g,
 	}
 }
 
@@ -82,13 +84,32 @@ func (vm *VM) Load(file string) error {
 		return &Error{Action: "Load: ReadFile", Script: file, Cause: err}
 	}
 
+	vm.callbacks, err = scriptCallbacks(code)
+	if err != nil {
+		return &Error{Action: "Load: Callbacks", Script: file, Cause: err}
+	}
+	if vm.tracer != nil {
+		// debug runs execute the script body once without waiting for events
+		vm.callbacks = nil
+	}
+	vm.permissions = vm.config.Permissions(file)
+	for _, callback := range vm.callbacks {
+		if required := callbackCapabilities[callback]; !vm.permissions.Allows(required) {
+			return &Error{Action: "Load: Callbacks", Script: file, Cause: fmt.Errorf("%w: %s requires the %s capability", gct.ErrCapabilityDenied, callback, required)}
+		}
+	}
+	if len(vm.callbacks) > 0 {
+		code = append(code, eventLoop(vm.callbacks)...)
+	}
+
 	vm.File = file
 	vm.Path = filepath.Dir(file)
 	vm.Script = tengo.NewScript(code)
 
 	scriptCtx := &gct.Context{}
 	scriptCtx.Value = map[string]tengo.Object{
-		"script": &tengo.String{Value: vm.ShortName() + "-" + vm.ID.String()},
+		"script": &tengo.String{Value: vm.scriptID()},
+		"name":   &tengo.String{Value: vm.ShortName()},
 	}
 
 	err = vm.Script.Add("ctx", scriptCtx)
@@ -96,7 +117,23 @@ func (vm *VM) Load(file string) error {
 		return err
 	}
 
-	vm.Script.SetImports(loader.GetModuleMap())
+	if len(vm.callbacks) > 0 {
+		maxQueued := vm.config.MaxQueuedEvents
+		if maxQueued == 0 {
+			maxQueued = DefaultMaxQueuedEvents
+		}
+		vm.S = make(chan struct{})
+		vm.events = make(chan scriptEvent, maxQueued)
+		err = vm.Script.Add(nextEventFunc, &tengo.UserFunction{Name: nextEventFunc, Value: vm.nextEvent})
+		if err != nil {
+			return err
+		}
+	}
+
+	vm.Script.SetImports(loader.GetModuleMapWithTracer(vm.permissions, vm.manager.orderLimiter(vm.ShortName(), vm.permissions.MaxOrdersPerMinute), vm.tracer))
+	if vm.permissions.MaxAllocs > 0 {
+		vm.Script.SetMaxAllocs(vm.permissions.MaxAllocs)
+	}
 	vm.Hash = vm.getHash()
 
 	if vm.config.AllowImports {
@@ -151,6 +188,19 @@ func (vm *VM) CompileAndRun() {
 		return
 	}
 
+	if len(vm.callbacks) > 0 {
+		if err = vm.runEvents(); err != nil {
+			log.Errorln(log.GCTScriptMgr, err)
+		}
+		if vm.stopped() {
+			return
+		}
+		if err = vm.Shutdown(); err != nil {
+			log.Errorln(log.GCTScriptMgr, err)
+		}
+		return
+	}
+
 	err = vm.RunCtx()
 	if err != nil {
 		log.Errorln(log.GCTScriptMgr, err)
@@ -191,8 +241,9 @@ func (vm *VM) Shutdown() error {
 		return ErrNoVMLoaded
 	}
 	if vm.S != nil {
-		close(vm.S)
+		vm.stopOnce.Do(func() { close(vm.S) })
 	}
+	gct.ReleaseChannels(vm.scriptID())
 	if vm.config.Verbose {
 		log.Debugf(log.GCTScriptMgr, "Shutting down script: %s ID: %v", vm.ShortName(), vm.ID)
 	}
@@ -221,6 +272,11 @@ func (vm *VM) ShortName() string {
 	return filepath.Base(vm.File)
 }
 
+// scriptID returns the script context identifier of the running script
+func (vm *VM) scriptID() string {
+	return vm.ShortName() + "-" + vm.ID.String()
+}
+
 func (vm *VM) event(status, executionType string) {
 	if validator.IsTestExecution.Load() == true {
 		return
@@ -0,0 +1,357 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/parser"
+	"github.com/d5/tengo/v2/token"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// scriptCallbacks returns the event callbacks defined at the top level of the
+// script. Scripts which do not parse return no callbacks so compilation can
+// report the error
+func scriptCallbacks(code []byte) ([]string, error) {
+	fileSet := parser.NewFileSet()
+	file, err := parser.NewParser(fileSet.AddFile("(main)", -1, len(code)), code, nil).ParseFile()
+	if err != nil {
+		return nil, nil //nolint:nilerr // compilation reports parse errors
+	}
+	var defined []string
+	var hasSubscriptions bool
+	for _, stmt := range file.Stmts {
+		assign, ok := stmt.(*parser.AssignStmt)
+		if !ok || (assign.Token != token.Define && assign.Token != token.Assign) || len(assign.LHS) != 1 {
+			continue
+		}
+		ident, ok := assign.LHS[0].(*parser.Ident)
+		if !ok {
+			continue
+		}
+		if ident.Name == subscriptionsVar {
+			hasSubscriptions = true
+			continue
+		}
+		if _, ok := assign.RHS[0].(*parser.FuncLit); ok && slices.Contains(callbacks, ident.Name) && !slices.Contains(defined, ident.Name) {
+			defined = append(defined, ident.Name)
+		}
+	}
+	if len(defined) > 0 && !hasSubscriptions {
+		return nil, errNoSubscriptions
+	}
+	return defined, nil
+}
+
+// eventLoop returns script code which calls the callbacks with each event
+// until the VM is shut down
+func eventLoop(defined []string) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "\nfor __gct_event := %[1]s(%[2]s); __gct_event != undefined; __gct_event = %[1]s(%[2]s) {\n", nextEventFunc, subscriptionsVar)
+	for _, callback := range defined {
+		fmt.Fprintf(&b, "\tif __gct_event.type == %q { %s(__gct_event.data) }\n", callback, callback)
+	}
+	b.WriteString("}\n")
+	return []byte(b.String())
+}
+
+// runEvents runs the compiled script, which waits for events once its top
+// level code has run. The script timeout applies to the top level code and to
+// each callback
+func (vm *VM) runEvents() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	vm.watchdog = time.AfterFunc(vm.config.ScriptTimeout, cancel)
+	defer vm.watchdog.Stop()
+
+	if vm.config.Verbose {
+		log.Debugf(log.GCTScriptMgr, "Running event driven script: %s ID: %v", vm.ShortName(), vm.ID)
+	}
+
+	if err := vm.Compiled.RunContext(ctx); err != nil {
+		vm.event(StatusFailure, TypeExecute)
+		return Error{Action: "RunEvents", Cause: err}
+	}
+	vm.event(StatusSuccess, TypeExecute)
+	return nil
+}
+
+// nextEvent blocks until an event is available for the script and returns it,
+// or returns undefined when the VM is shut down. The subscriptions are read on
+// the first call, once the script's top level code has run
+func (vm *VM) nextEvent(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	if vm.watchdog != nil {
+		vm.watchdog.Stop()
+	}
+
+	vm.eventsMtx.RLock()
+	subscribed := vm.subscriptions != nil
+	vm.eventsMtx.RUnlock()
+	if !subscribed {
+		subs, err := parseSubscriptions(args[0])
+		if err != nil {
+			return nil, err
+		}
+		if validator.IsTestExecution.Load() == true {
+			return tengo.UndefinedValue, nil
+		}
+		vm.eventsMtx.Lock()
+		vm.subscriptions = subs
+		vm.eventsMtx.Unlock()
+		vm.subscribe()
+	}
+
+	select {
+	case <-vm.S:
+		return tengo.UndefinedValue, nil
+	case e := <-vm.events:
+		if vm.watchdog != nil {
+			vm.watchdog.Reset(vm.config.ScriptTimeout)
+		}
+		return &tengo.Map{Value: map[string]tengo.Object{
+			"type": &tengo.String{Value: e.callback},
+			"data": e.data,
+		}}, nil
+	}
+}
+
+// parseSubscriptions parses the script's subscriptions, an array of maps with
+// an exchange name and optional pair and asset
+func parseSubscriptions(obj tengo.Object) ([]eventSubscription, error) {
+	arr, ok := obj.(*tengo.Array)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s must be an array, got %s", errInvalidSubscription, subscriptionsVar, obj.TypeName())
+	}
+	subs := make([]eventSubscription, 0, len(arr.Value))
+	for i := range arr.Value {
+		m, ok := tengo.ToInterface(arr.Value[i]).(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s[%d] must be a map", errInvalidSubscription, subscriptionsVar, i)
+		}
+		var sub eventSubscription
+		if sub.exchange, ok = m["exchange"].(string); !ok || sub.exchange == "" {
+			return nil, fmt.Errorf("%w: %s[%d] requires an exchange", errInvalidSubscription, subscriptionsVar, i)
+		}
+		if p, ok := m["pair"].(string); ok && p != "" {
+			var err error
+			if sub.pair, err = currency.NewPairFromString(p); err != nil {
+				return nil, fmt.Errorf("%w: %s[%d]: %w", errInvalidSubscription, subscriptionsVar, i, err)
+			}
+		}
+		if a, ok := m["asset"].(string); ok && a != "" {
+			var err error
+			if sub.asset, err = asset.New(a); err != nil {
+				return nil, fmt.Errorf("%w: %s[%d]: %w", errInvalidSubscription, subscriptionsVar, i, err)
+			}
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
+// subscribe starts relaying ticker, orderbook and account balance updates from
+// the dispatch system for each subscribed exchange
+func (vm *VM) subscribe() {
+	var exchanges []string
+	for i := range vm.subscriptions {
+		if !slices.ContainsFunc(exchanges, func(e string) bool { return strings.EqualFold(e, vm.subscriptions[i].exchange) }) {
+			exchanges = append(exchanges, vm.subscriptions[i].exchange)
+		}
+	}
+	for _, exch := range exchanges {
+		if vm.hasCallback(CallbackTicker) {
+			go vm.relay(exch, ticker.SubscribeToExchangeTickers, vm.handleData)
+		}
+		if vm.hasCallback(CallbackOrderbook) {
+			go vm.relay(exch, orderbook.SubscribeToExchangeOrderbooks, vm.handleData)
+		}
+		if vm.hasCallback(CallbackBalance) {
+			go vm.relay(exch, wrappers.GetWrapper().SubscribeAccountBalances, func(data any) { vm.handleBalance(exch, data) })
+		}
+	}
+}
+
+// relay subscribes to an exchange's dispatch feed, retrying until the feed
+// exists, and passes its updates to handle until the VM is shut down
+func (vm *VM) relay(exch string, subscribe func(string) (dispatch.Pipe, error), handle func(any)) {
+	pipe, err := subscribe(exch)
+	for err != nil {
+		if vm.config.Verbose {
+			log.Debugf(log.GCTScriptMgr, "Script %s ID: %v unable to subscribe to %s, retrying: %v", vm.ShortName(), vm.ID, exch, err)
+		}
+		select {
+		case <-vm.S:
+			return
+		case <-time.After(eventSubscribeRetryDelay):
+		}
+		pipe, err = subscribe(exch)
+	}
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.GCTScriptMgr, "Script %s ID: %v unable to release %s subscription: %v", vm.ShortName(), vm.ID, exch, err)
+		}
+	}()
+	for {
+		select {
+		case <-vm.S:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return
+			}
+			handle(data)
+		}
+	}
+}
+
+// handleData queues ticker, orderbook, order and fill updates which match the
+// script's subscriptions and callbacks
+func (vm *VM) handleData(data any) {
+	vm.eventsMtx.RLock()
+	subscribed := vm.subscriptions != nil
+	vm.eventsMtx.RUnlock()
+	if !subscribed {
+		return
+	}
+	switch d := data.(type) {
+	case *ticker.Price:
+		if vm.wants(CallbackTicker, d.ExchangeName, d.Pair, d.AssetType) {
+			vm.publish(CallbackTicker, gct.TickerObject(d))
+		}
+	case orderbook.Outbound:
+		if !vm.hasCallback(CallbackOrderbook) {
+			return
+		}
+		b, err := d.Retrieve()
+		if err != nil {
+			return
+		}
+		if vm.wants(CallbackOrderbook, b.Exchange, b.Pair, b.Asset) {
+			vm.publish(CallbackOrderbook, gct.OrderbookObject(b))
+		}
+	case *order.Detail:
+		if vm.wants(CallbackOrderUpdate, d.Exchange, d.Pair, d.AssetType) {
+			vm.publish(CallbackOrderUpdate, gct.OrderObject(d))
+		}
+	case []order.Detail:
+		for i := range d {
+			vm.handleData(&d[i])
+		}
+	case fill.Data:
+		if vm.wants(CallbackFill, d.Exchange, d.CurrencyPair, d.AssetType) {
+			vm.publish(CallbackFill, gct.FillObject(&d))
+		}
+	case []fill.Data:
+		for i := range d {
+			vm.handleData(d[i])
+		}
+	}
+}
+
+// handleBalance queues account balance updates for the exchange which match
+// the script's subscribed exchanges and assets. Pairs do not apply to balances
+func (vm *VM) handleBalance(exch string, data any) {
+	s, ok := data.(*accounts.SubAccount)
+	if !ok || !vm.hasCallback(CallbackBalance) {
+		return
+	}
+	vm.eventsMtx.RLock()
+	wanted := slices.ContainsFunc(vm.subscriptions, func(sub eventSubscription) bool {
+		return strings.EqualFold(sub.exchange, exch) && (sub.asset == asset.Empty || sub.asset == s.AssetType)
+	})
+	vm.eventsMtx.RUnlock()
+	if wanted {
+		vm.publish(CallbackBalance, gct.BalanceObject(exch, s))
+	}
+}
+
+// hasCallback returns whether the script defines the callback and has been
+// granted the capability its events require
+func (vm *VM) hasCallback(callback string) bool {
+	return slices.Contains(vm.callbacks, callback) && vm.permissions.Allows(callbackCapabilities[callback])
+}
+
+// wants returns whether the script has the callback and subscribes to the
+// exchange, pair and asset
+func (vm *VM) wants(callback, exch string, p currency.Pair, a asset.Item) bool {
+	if !vm.hasCallback(callback) {
+		return false
+	}
+	vm.eventsMtx.RLock()
+	defer vm.eventsMtx.RUnlock()
+	for i := range vm.subscriptions {
+		s := &vm.subscriptions[i]
+		if strings.EqualFold(s.exchange, exch) &&
+			(s.pair.IsEmpty() || s.pair.Equal(p)) &&
+			(s.asset == asset.Empty || s.asset == a) {
+			return true
+		}
+	}
+	return false
+}
+
+// publish queues an event for the script without blocking. Events are dropped
+// when the queue is full so a slow script cannot hold up the feeds
+func (vm *VM) publish(callback string, data tengo.Object) {
+	select {
+	case vm.events <- scriptEvent{callback: callback, data: data}:
+	default:
+		if dropped := vm.droppedEvents.Add(1); dropped == 1 || dropped%1000 == 0 {
+			log.Warnf(log.GCTScriptMgr, "Script %s ID: %v event queue full, %d events dropped", vm.ShortName(), vm.ID, dropped)
+		}
+	}
+}
+
+// DroppedEvents returns the number of events dropped because the script's
+// event queue was full
+func (vm *VM) DroppedEvents() uint64 {
+	return vm.droppedEvents.Load()
+}
+
+// stopped returns whether the VM has been shut down
+func (vm *VM) stopped() bool {
+	select {
+	case <-vm.S:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebsocketDataHandler passes order updates and fills from exchange websocket
+// feeds to event driven scripts
+func (g *GctScriptManager) WebsocketDataHandler(_ string, data any) error {
+	if !g.IsRunning() {
+		return nil
+	}
+	switch data.(type) {
+	case *order.Detail, []order.Detail, fill.Data, []fill.Data:
+	default:
+		return nil
+	}
+	AllVMSync.Range(func(_, v any) bool {
+		if vm, ok := v.(*VM); ok {
+			vm.handleData(data)
+		}
+		return true
+	})
+	return nil
+}
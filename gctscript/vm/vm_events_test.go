@@ -0,0 +1,168 @@
+package vm
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+)
+
+var testEventScript = filepath.Join("..", "..", "testdata", "gctscript", "events.gct")
+
+func TestScriptCallbacks(t *testing.T) {
+	t.Parallel()
+	defined, err := scriptCallbacks([]byte(`on_fill := func(f) {}; on_ticker := func(t) {}; on_unknown := func() {}; subscriptions := []`))
+	require.NoError(t, err, "scriptCallbacks must not error")
+	assert.Equal(t, []string{CallbackFill, CallbackTicker}, defined)
+
+	_, err = scriptCallbacks([]byte(`on_fill := func(f) {}`))
+	assert.ErrorIs(t, err, errNoSubscriptions)
+
+	defined, err = scriptCallbacks([]byte(`on_fill := 1; subscriptions := []`))
+	require.NoError(t, err, "scriptCallbacks must not error")
+	assert.Empty(t, defined, "non function values should not be callbacks")
+
+	defined, err = scriptCallbacks([]byte(`on_fill := func(`))
+	require.NoError(t, err, "scriptCallbacks must not error on parse errors")
+	assert.Empty(t, defined)
+
+	_, err = tengo.NewScript(append([]byte(`on_fill := func(f) {}; subscriptions := []`), eventLoop([]string{CallbackFill})...)).Compile()
+	assert.ErrorContains(t, err, nextEventFunc, "the event loop should only reference the callbacks and the next event function")
+}
+
+func TestParseSubscriptions(t *testing.T) {
+	t.Parallel()
+	_, err := parseSubscriptions(&tengo.Map{})
+	assert.ErrorIs(t, err, errInvalidSubscription)
+
+	for _, s := range []tengo.Object{
+		&tengo.String{Value: "binance"},
+		&tengo.Map{Value: map[string]tengo.Object{"pair": &tengo.String{Value: "BTC-USDT"}}},
+		&tengo.Map{Value: map[string]tengo.Object{"exchange": &tengo.String{Value: "binance"}, "asset": &tengo.String{Value: "moon"}}},
+	} {
+		_, err = parseSubscriptions(&tengo.Array{Value: []tengo.Object{s}})
+		assert.ErrorIs(t, err, errInvalidSubscription)
+	}
+
+	subs, err := parseSubscriptions(&tengo.Array{Value: []tengo.Object{
+		&tengo.Map{Value: map[string]tengo.Object{"exchange": &tengo.String{Value: "binance"}, "pair": &tengo.String{Value: "BTC-USDT"}, "asset": &tengo.String{Value: "spot"}}},
+		&tengo.Map{Value: map[string]tengo.Object{"exchange": &tengo.String{Value: "okx"}}},
+	}})
+	require.NoError(t, err, "parseSubscriptions must not error")
+	assert.Equal(t, []eventSubscription{
+		{exchange: "binance", pair: currency.NewPairWithDelimiter("BTC", "USDT", "-"), asset: asset.Spot},
+		{exchange: "okx"},
+	}, subs)
+}
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+	vm := &VM{
+		config:        configHelper(true, false, maxTestVirtualMachines),
+		callbacks:     []string{CallbackFill},
+		events:        make(chan scriptEvent, 1),
+		subscriptions: []eventSubscription{{exchange: "binance"}},
+	}
+	f := fill.Data{Exchange: "Binance", CurrencyPair: currency.NewPair(currency.BTC, currency.USDT), AssetType: asset.Spot, Price: 1}
+	vm.handleData([]fill.Data{f, f})
+	assert.Len(t, vm.events, 1, "events should be queued up to the queue size")
+	assert.Equal(t, uint64(1), vm.DroppedEvents(), "events should be dropped when the queue is full")
+
+	vm.handleData(&ticker.Price{ExchangeName: "binance"})
+	assert.Equal(t, uint64(1), vm.DroppedEvents(), "events without a callback should be ignored")
+
+	<-vm.events
+	f.Exchange = "okx"
+	vm.handleData(f)
+	assert.Empty(t, vm.events, "events of other exchanges should be ignored")
+}
+
+func TestHandleBalance(t *testing.T) {
+	t.Parallel()
+	vm := &VM{
+		config:        configHelper(true, false, maxTestVirtualMachines),
+		callbacks:     []string{CallbackBalance},
+		events:        make(chan scriptEvent, 2),
+		subscriptions: []eventSubscription{{exchange: "binance", pair: currency.NewPair(currency.BTC, currency.USDT), asset: asset.Spot}},
+	}
+	vm.handleBalance("Binance", &accounts.SubAccount{AssetType: asset.Spot, Balances: accounts.CurrencyBalances{}})
+	require.Len(t, vm.events, 1, "balance updates must ignore the subscribed pair")
+	e := <-vm.events
+	assert.Equal(t, CallbackBalance, e.callback)
+	assert.Equal(t, &tengo.String{Value: "Binance"}, e.data.(*tengo.Map).Value["exchange"], "balance updates should include the exchange")
+
+	vm.handleBalance("Binance", &accounts.SubAccount{AssetType: asset.Futures})
+	vm.handleBalance("okx", &accounts.SubAccount{AssetType: asset.Spot})
+	vm.handleBalance("Binance", "not a balance")
+	assert.Empty(t, vm.events, "balance updates of other assets and exchanges should be ignored")
+
+	vm.permissions = &gct.Permissions{Capabilities: []gct.Capability{gct.CapabilityMarketData}}
+	vm.handleBalance("Binance", &accounts.SubAccount{AssetType: asset.Spot, Balances: accounts.CurrencyBalances{}})
+	assert.Empty(t, vm.events, "balance updates should be ignored without the account capability")
+}
+
+func TestEventDrivenScript(t *testing.T) {
+	manager := GctScriptManager{
+		config:  configHelper(true, false, maxTestVirtualMachines),
+		started: 1,
+	}
+	require.NoError(t, manager.Validate(testEventScript), "Validate must not wait for events")
+
+	manager.config.ScriptTimeout = 100 * time.Millisecond
+	testVM := manager.New()
+	require.NotNil(t, testVM, "New must create a VM")
+	require.NoError(t, testVM.Load(testEventScript), "Load must not error")
+	assert.Equal(t, []string{CallbackTicker, CallbackFill}, testVM.callbacks)
+
+	go testVM.CompileAndRun()
+	require.Eventually(t, func() bool {
+		testVM.eventsMtx.RLock()
+		defer testVM.eventsMtx.RUnlock()
+		return testVM.subscriptions != nil
+	}, time.Second, time.Millisecond, "script must subscribe to events")
+
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	require.NoError(t, manager.WebsocketDataHandler("Binance", []fill.Data{{Exchange: "Binance", CurrencyPair: pair, AssetType: asset.Spot, Price: 1, Amount: 1}}), "WebsocketDataHandler must not error")
+	testVM.handleData(&ticker.Price{ExchangeName: "binance", Pair: pair, AssetType: asset.Spot, Last: 1})
+	testVM.handleData(&ticker.Price{ExchangeName: "binance", Pair: currency.NewPair(currency.ETH, currency.USDT), AssetType: asset.Spot, Last: 2})
+	time.Sleep(3 * manager.config.ScriptTimeout)
+	assert.False(t, testVM.stopped(), "callbacks within the timeout should not stop the script")
+
+	testVM.handleData(&ticker.Price{ExchangeName: "binance", Pair: pair, AssetType: asset.Spot, Last: 2})
+	assert.Eventually(t, testVM.stopped, time.Second, time.Millisecond, "a callback exceeding the timeout should stop the script")
+}
+
+func TestEventDrivenScriptShutdown(t *testing.T) {
+	manager := GctScriptManager{
+		config:  configHelper(true, false, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM := manager.New()
+	require.NotNil(t, testVM, "New must create a VM")
+	require.NoError(t, testVM.Load(testEventScript), "Load must not error")
+	done := make(chan struct{})
+	go func() {
+		testVM.CompileAndRun()
+		close(done)
+	}()
+	require.Eventually(t, func() bool {
+		testVM.eventsMtx.RLock()
+		defer testVM.eventsMtx.RUnlock()
+		return testVM.subscriptions != nil
+	}, time.Second, time.Millisecond, "script must subscribe to events")
+	require.NoError(t, testVM.Shutdown(), "Shutdown must not error")
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		require.Fail(t, "CompileAndRun must return after Shutdown")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 )
 
 const (
@@ -26,6 +27,7 @@ var (
 	testScriptRunner1s       = filepath.Join("..", "..", "testdata", "gctscript", "1s_timer.gct")
 	testScriptRunnerNegative = filepath.Join("..", "..", "testdata", "gctscript", "negative_timer.gct")
 	testScriptRunnerInvalid  = filepath.Join("..", "..", "testdata", "gctscript", "invalid_timer.gct")
+	testDebugScript          = filepath.Join("..", "..", "testdata", "gctscript", "debug.gct")
 )
 
 func TestNewVM(t *testing.T) {
@@ -454,6 +456,39 @@ func TestValidate(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	err = manager.Validate(filepath.Join("..", "examples", "state.gct"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = manager.Validate(filepath.Join("..", "examples", "exchange", "futures.gct"))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDebug(t *testing.T) {
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	count := VMSCount.Len()
+	_, err := manager.Debug(testDebugScript, []string{"ordersubmit"})
+	require.Error(t, err, "Debug must error with an invalid breakpoint")
+	_, err = manager.Debug(testBrokenScript, nil)
+	require.Error(t, err, "Debug must error with a broken script")
+
+	trace, err := manager.Debug(testDebugScript, []string{"exchange.ordersubmit"})
+	require.ErrorIs(t, err, gct.ErrBreakpoint)
+	require.Len(t, trace, 2, "Debug must return the calls made before the breakpoint")
+	assert.Equal(t, "set_verbose", trace[0].Function)
+	assert.Equal(t, "ordersubmit", trace[1].Function)
+	assert.True(t, trace[1].Breakpoint, "Debug should mark the breakpoint call")
+	assert.Len(t, trace[1].Args, 10, "Debug should record the breakpoint call's arguments")
+
+	trace, err = manager.Debug(filepath.Join("..", "..", "testdata", "gctscript", "events.gct"), nil)
+	require.NoError(t, err, "Debug must not wait for events")
+	assert.Empty(t, trace)
+	assert.Equal(t, count, VMSCount.Len(), "Debug should remove its VMs")
 }
 
 func TestVMLimit(t *testing.T) {
@@ -2,10 +2,15 @@ package vm
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/parser"
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 )
 
 const (
@@ -13,6 +18,9 @@ const (
 	DefaultTimeoutValue = 30 * time.Second
 	// DefaultMaxVirtualMachines max number of virtual machines that can be loaded at one time
 	DefaultMaxVirtualMachines uint64 = 10
+	// DefaultMaxQueuedEvents max number of events queued for a virtual machine's callbacks
+	// before further events are dropped
+	DefaultMaxQueuedEvents uint64 = 100
 
 	// TypeLoad text to display in script_event table when a VM is loaded
 	TypeLoad = "load"
@@ -29,8 +37,39 @@ const (
 	StatusSuccess = "success"
 	// StatusFailure text to display in script_event table when script execution fails
 	StatusFailure = "failure"
+
+	// CallbackTicker is the script function called with ticker updates
+	CallbackTicker = "on_ticker"
+	// CallbackOrderbook is the script function called with orderbook updates
+	CallbackOrderbook = "on_orderbook"
+	// CallbackOrderUpdate is the script function called with order updates
+	CallbackOrderUpdate = "on_order_update"
+	// CallbackFill is the script function called with order fills
+	CallbackFill = "on_fill"
+	// CallbackBalance is the script function called with account balance
+	// updates
+	CallbackBalance = "on_balance"
+
+	subscriptionsVar         = "subscriptions"
+	nextEventFunc            = "__gct_next_event"
+	replOutputFunc           = "__gct_repl_output"
+	replName                 = "repl"
+	eventSubscribeRetryDelay = 5 * time.Second
 )
 
+// callbacks lists the script functions which can be called with events
+var callbacks = []string{CallbackTicker, CallbackOrderbook, CallbackOrderUpdate, CallbackFill, CallbackBalance}
+
+// callbackCapabilities maps each callback to the capability a script requires
+// to receive its events
+var callbackCapabilities = map[string]gct.Capability{
+	CallbackTicker:      gct.CapabilityMarketData,
+	CallbackOrderbook:   gct.CapabilityMarketData,
+	CallbackOrderUpdate: gct.CapabilityAccount,
+	CallbackFill:        gct.CapabilityAccount,
+	CallbackBalance:     gct.CapabilityAccount,
+}
+
 type vmscount uint64
 
 var (
@@ -54,4 +93,49 @@ type VM struct {
 	S          chan struct{}
 	config     *Config
 	unregister func() error
+	// manager shares order limits between runs of the same script
+	manager *GctScriptManager
+	// permissions are the script's permissions, set on load
+	permissions *gct.Permissions
+	// tracer records module calls when the VM is debugged
+	tracer *gct.Tracer
+
+	stopOnce      sync.Once
+	callbacks     []string
+	events        chan scriptEvent
+	watchdog      *time.Timer
+	droppedEvents atomic.Uint64
+	// eventsMtx protects subscriptions which are read by event publishers
+	eventsMtx     sync.RWMutex
+	subscriptions []eventSubscription
+}
+
+// REPL evaluates tengo snippets against the live engine. Variables defined by
+// a snippet are available to later snippets
+type REPL struct {
+	mtx       sync.Mutex
+	id        string
+	config    *Config
+	modules   *tengo.ModuleMap
+	maxAllocs int64
+	fileSet   *parser.SourceFileSet
+	symbols   *tengo.SymbolTable
+	globals   []tengo.Object
+	constants []tengo.Object
+	output    []string
+	dryRun    bool
+}
+
+// scriptEvent is an event queued for a script callback
+type scriptEvent struct {
+	callback string
+	data     tengo.Object
+}
+
+// eventSubscription filters the events sent to a script. An empty pair or
+// asset matches all pairs or assets of the exchange
+type eventSubscription struct {
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
 }
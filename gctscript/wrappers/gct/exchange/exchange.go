@@ -7,16 +7,22 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -236,3 +242,99 @@ func (e Exchange) OHLCV(ctx context.Context, exch string, pair currency.Pair, it
 	ret.FormatDates()
 	return ret, nil
 }
+
+// ModifyOrder modifies an existing order through the order manager
+func (e Exchange) ModifyOrder(ctx context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	return engine.Bot.OrderManager.Modify(ctx, mod)
+}
+
+// CancelBatchOrders cancels a batch of orders on exchange
+func (e Exchange) CancelBatchOrders(ctx context.Context, exch string, cancels []order.Cancel) (*order.CancelBatchResponse, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.CancelBatchOrders(ctx, cancels)
+}
+
+// CancelAllOrders cancels all orders on exchange which match the request
+func (e Exchange) CancelAllOrders(ctx context.Context, exch string, cancel *order.Cancel) (order.CancelAllResponse, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return order.CancelAllResponse{}, err
+	}
+	return ex.CancelAllOrders(ctx, cancel)
+}
+
+// ActiveOrders returns the open orders on exchange which match the request
+func (e Exchange) ActiveOrders(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetActiveOrders(ctx, req)
+}
+
+// OrderHistory returns the historic orders on exchange which match the request
+func (e Exchange) OrderHistory(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetOrderHistory(ctx, req)
+}
+
+// HistoricTrades returns public trades for the pair and asset between start and end
+func (e Exchange) HistoricTrades(ctx context.Context, exch string, pair currency.Pair, a asset.Item, start, end time.Time) ([]trade.Data, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetHistoricTrades(ctx, pair, a, start, end)
+}
+
+// FuturesPositions returns futures positions and their orders on exchange
+func (e Exchange) FuturesPositions(ctx context.Context, exch string, req *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetFuturesPositions(ctx, req)
+}
+
+// SetLeverage sets the account's leverage for the pair and asset
+func (e Exchange) SetLeverage(ctx context.Context, exch string, a asset.Item, pair currency.Pair, marginType margin.Type, amount float64, side order.Side) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetLeverage(ctx, a, pair, marginType, amount, side)
+}
+
+// LatestFundingRates returns the latest funding rates on exchange
+func (e Exchange) LatestFundingRates(ctx context.Context, exch string, req *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetLatestFundingRates(ctx, req)
+}
+
+// OpenInterest returns open interest for the pairs and assets, or for all
+// supported pairs when none are provided
+func (e Exchange) OpenInterest(ctx context.Context, exch string, keys ...key.PairAsset) ([]futures.OpenInterest, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetOpenInterest(ctx, keys...)
+}
+
+// SubscribeAccountBalances returns a pipe which streams account balance updates
+func (e Exchange) SubscribeAccountBalances(exch string) (dispatch.Pipe, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return ex.SubscribeAccountBalances()
+}
@@ -1,6 +1,12 @@
 package gct
 
-import "github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+import (
+	"context"
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstate"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+)
 
 // Setup returns a Wrapper
 func Setup() *Wrapper {
@@ -8,3 +14,25 @@ func Setup() *Wrapper {
 		&exchange.Exchange{},
 	}
 }
+
+// GetState returns the value stored in the database for a script's key
+func (w *Wrapper) GetState(ctx context.Context, scriptName, key string) (value string, found bool, err error) {
+	value, err = scriptstate.Get(ctx, scriptName, key)
+	if errors.Is(err, scriptstate.ErrKeyNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return value, true, nil
+}
+
+// SetState stores the value in the database for a script's key
+func (w *Wrapper) SetState(ctx context.Context, scriptName, key, value string) error {
+	return scriptstate.Set(ctx, scriptName, key, value)
+}
+
+// DeleteState removes a script's key from the database
+func (w *Wrapper) DeleteState(ctx context.Context, scriptName, key string) error {
+	return scriptstate.Delete(ctx, scriptName, key)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -48,7 +49,7 @@ func TestMain(m *testing.M) {
 		log.Fatalf("Error during ExchangeManager.Add: %s", err)
 	}
 	engine.Bot.ExchangeManager = em
-	engine.Bot.WithdrawManager, err = engine.SetupWithdrawManager(em, nil, true)
+	engine.Bot.WithdrawManager, err = engine.SetupWithdrawManager(em, nil, nil, true)
 	if err != nil {
 		log.Fatalf("Error during engine.SetupWithdrawManage: %s", err)
 	}
@@ -254,3 +255,12 @@ func TestExchangeWithdrawFiat(t *testing.T) {
 	_, err = gct.ExchangeWithdrawFiat(ctx, exch, currCode, desc, amount, bankID)
 	assert.NoError(t, err)
 }
+
+func TestState(t *testing.T) {
+	t.Parallel()
+	w := Setup()
+	_, _, err := w.GetState(t.Context(), "test.gct", "key")
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+	assert.ErrorIs(t, w.SetState(t.Context(), "test.gct", "key", "1"), database.ErrDatabaseNotConnected)
+	assert.ErrorIs(t, w.DeleteState(t.Context(), "test.gct", "key"), database.ErrDatabaseNotConnected)
+}
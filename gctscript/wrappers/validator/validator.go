@@ -5,15 +5,22 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -277,3 +284,187 @@ func (w Wrapper) OHLCV(_ context.Context, exch string, p currency.Pair, a asset.
 		Candles:  candles,
 	}, nil
 }
+
+// GetState returns a stored value for the script's key, only the "exists" key
+// has a value during validation
+func (w Wrapper) GetState(_ context.Context, scriptName, key string) (value string, found bool, err error) {
+	if scriptName == exchError.String() {
+		return "", false, errTestFailed
+	}
+	if key != "exists" {
+		return "", false, nil
+	}
+	return `{"counter":1}`, true, nil
+}
+
+// SetState validates storing a value for the script's key
+func (w Wrapper) SetState(_ context.Context, scriptName, _, _ string) error {
+	if scriptName == exchError.String() {
+		return errTestFailed
+	}
+	return nil
+}
+
+// DeleteState validates removing the script's key
+func (w Wrapper) DeleteState(_ context.Context, scriptName, _ string) error {
+	if scriptName == exchError.String() {
+		return errTestFailed
+	}
+	return nil
+}
+
+// ModifyOrder validator for test execution/scripts
+func (w Wrapper) ModifyOrder(_ context.Context, mod *order.Modify) (*order.ModifyResponse, error) {
+	if mod == nil || mod.Exchange == exchError.String() {
+		return nil, errTestFailed
+	}
+	resp, err := mod.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.Status = order.Active
+	return resp, nil
+}
+
+// CancelBatchOrders validator for test execution/scripts
+func (w Wrapper) CancelBatchOrders(_ context.Context, exch string, cancels []order.Cancel) (*order.CancelBatchResponse, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	resp := &order.CancelBatchResponse{Status: make(map[string]string, len(cancels))}
+	for i := range cancels {
+		resp.Status[cancels[i].OrderID] = order.Cancelled.String()
+	}
+	return resp, nil
+}
+
+// CancelAllOrders validator for test execution/scripts
+func (w Wrapper) CancelAllOrders(_ context.Context, exch string, _ *order.Cancel) (order.CancelAllResponse, error) {
+	if exch == exchError.String() {
+		return order.CancelAllResponse{}, errTestFailed
+	}
+	return order.CancelAllResponse{Status: map[string]string{"1": order.Cancelled.String()}}, nil
+}
+
+// ActiveOrders validator for test execution/scripts
+func (w Wrapper) ActiveOrders(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if req == nil {
+		return nil, errTestFailed
+	}
+	o, err := w.QueryOrder(ctx, exch, "", currency.EMPTYPAIR, req.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	o.Status = order.Active
+	o.AssetType = req.AssetType
+	return order.FilteredOrders{*o}, nil
+}
+
+// OrderHistory validator for test execution/scripts
+func (w Wrapper) OrderHistory(ctx context.Context, exch string, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if req == nil {
+		return nil, errTestFailed
+	}
+	o, err := w.QueryOrder(ctx, exch, "", currency.EMPTYPAIR, req.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	o.Status = order.Filled
+	o.AssetType = req.AssetType
+	return order.FilteredOrders{*o}, nil
+}
+
+// HistoricTrades validator for test execution/scripts
+func (w Wrapper) HistoricTrades(_ context.Context, exch string, p currency.Pair, a asset.Item, start, _ time.Time) ([]trade.Data, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []trade.Data{
+		{
+			TID:          "1",
+			Exchange:     exch,
+			CurrencyPair: p,
+			AssetType:    a,
+			Side:         order.Buy,
+			Price:        validatorClose,
+			Amount:       validatorVol,
+			Timestamp:    start,
+		},
+	}, nil
+}
+
+// FuturesPositions validator for test execution/scripts
+func (w Wrapper) FuturesPositions(ctx context.Context, exch string, req *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	if req == nil {
+		return nil, errTestFailed
+	}
+	o, err := w.QueryOrder(ctx, exch, "", currency.EMPTYPAIR, req.Asset)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]futures.PositionDetails, len(req.Pairs))
+	for i := range req.Pairs {
+		o.Pair, o.AssetType = req.Pairs[i], req.Asset
+		resp[i] = futures.PositionDetails{
+			Exchange: exch,
+			Asset:    req.Asset,
+			Pair:     req.Pairs[i],
+			Orders:   []order.Detail{*o},
+		}
+	}
+	return resp, nil
+}
+
+// SetLeverage validator for test execution/scripts
+func (w Wrapper) SetLeverage(_ context.Context, exch string, a asset.Item, _ currency.Pair, _ margin.Type, amount float64, _ order.Side) error {
+	if exch == exchError.String() || !a.IsFutures() || amount <= 0 {
+		return errTestFailed
+	}
+	return nil
+}
+
+// LatestFundingRates validator for test execution/scripts
+func (w Wrapper) LatestFundingRates(_ context.Context, exch string, req *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	if req == nil || exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	now := time.Now()
+	return []fundingrate.LatestRateResponse{
+		{
+			Exchange:       exch,
+			Asset:          req.Asset,
+			Pair:           req.Pair,
+			LatestRate:     fundingrate.Rate{Time: now.Truncate(time.Hour), Rate: decimal.NewFromFloat(0.0001)},
+			TimeOfNextRate: now.Truncate(time.Hour).Add(time.Hour),
+			TimeChecked:    now,
+		},
+	}, nil
+}
+
+// OpenInterest validator for test execution/scripts
+func (w Wrapper) OpenInterest(_ context.Context, exch string, keys ...key.PairAsset) ([]futures.OpenInterest, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	if len(keys) == 0 {
+		p := currency.NewBTCUSD()
+		keys = []key.PairAsset{{Base: p.Base.Item, Quote: p.Quote.Item, Asset: asset.PerpetualSwap}}
+	}
+	resp := make([]futures.OpenInterest, len(keys))
+	for i := range keys {
+		resp[i] = futures.OpenInterest{
+			Key:          key.NewExchangeAssetPair(exch, keys[i].Asset, keys[i].Pair()),
+			OpenInterest: validatorVol,
+		}
+	}
+	return resp, nil
+}
+
+// SubscribeAccountBalances validator for test execution/scripts, the returned
+// pipe never receives updates
+func (w Wrapper) SubscribeAccountBalances(exch string) (dispatch.Pipe, error) {
+	if exch == exchError.String() {
+		return dispatch.Pipe{}, errTestFailed
+	}
+	return dispatch.Pipe{}, nil
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -260,3 +263,113 @@ func TestWrapper_OHLCV(t *testing.T) {
 		t.Fatal("expected OHLCV to return error with invalid name")
 	}
 }
+
+func TestWrapper_State(t *testing.T) {
+	t.Parallel()
+	_, found, err := testWrapper.GetState(t.Context(), "test.gct", "missing")
+	require.NoError(t, err, "GetState must not error")
+	assert.False(t, found, "GetState should not find a missing key")
+
+	v, found, err := testWrapper.GetState(t.Context(), "test.gct", "exists")
+	require.NoError(t, err, "GetState must not error")
+	assert.True(t, found, "GetState should find the exists key")
+	assert.NotEmpty(t, v, "GetState should return a value")
+
+	_, _, err = testWrapper.GetState(t.Context(), exchError.String(), "exists")
+	assert.ErrorIs(t, err, errTestFailed)
+
+	assert.NoError(t, testWrapper.SetState(t.Context(), "test.gct", "key", "1"), "SetState should not error")
+	assert.ErrorIs(t, testWrapper.SetState(t.Context(), exchError.String(), "key", "1"), errTestFailed)
+	assert.NoError(t, testWrapper.DeleteState(t.Context(), "test.gct", "key"), "DeleteState should not error")
+	assert.ErrorIs(t, testWrapper.DeleteState(t.Context(), exchError.String(), "key"), errTestFailed)
+}
+
+func TestWrapper_ModifyOrder(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.ModifyOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	_, err = testWrapper.ModifyOrder(t.Context(), &order.Modify{Exchange: exchError.String()})
+	assert.ErrorIs(t, err, errTestFailed)
+
+	resp, err := testWrapper.ModifyOrder(t.Context(), &order.Modify{Exchange: exchName, OrderID: orderID, Price: 2})
+	require.NoError(t, err, "ModifyOrder must not error")
+	assert.Equal(t, 2.0, resp.Price, "ModifyOrder should return the new price")
+}
+
+func TestWrapper_CancelOrders(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.CancelBatchOrders(t.Context(), exchError.String(), nil)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	batch, err := testWrapper.CancelBatchOrders(t.Context(), exchName, []order.Cancel{{OrderID: "1"}, {OrderID: "2"}})
+	require.NoError(t, err, "CancelBatchOrders must not error")
+	assert.Len(t, batch.Status, 2, "CancelBatchOrders should return a status for each order")
+
+	_, err = testWrapper.CancelAllOrders(t.Context(), exchError.String(), nil)
+	assert.ErrorIs(t, err, errTestFailed)
+
+	all, err := testWrapper.CancelAllOrders(t.Context(), exchName, &order.Cancel{})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.NotEmpty(t, all.Status, "CancelAllOrders should return statuses")
+}
+
+func TestWrapper_Orders(t *testing.T) {
+	t.Parallel()
+	req := &order.MultiOrderRequest{AssetType: assetType, Type: order.AnyType, Side: order.AnySide}
+	active, err := testWrapper.ActiveOrders(t.Context(), exchName, req)
+	require.NoError(t, err, "ActiveOrders must not error")
+	require.Len(t, active, 1, "ActiveOrders must return an order")
+	assert.Equal(t, order.Active, active[0].Status, "ActiveOrders should return active orders")
+
+	history, err := testWrapper.OrderHistory(t.Context(), exchName, req)
+	require.NoError(t, err, "OrderHistory must not error")
+	require.Len(t, history, 1, "OrderHistory must return an order")
+	assert.Equal(t, order.Filled, history[0].Status, "OrderHistory should return filled orders")
+
+	_, err = testWrapper.ActiveOrders(t.Context(), exchError.String(), req)
+	assert.ErrorIs(t, err, errTestFailed)
+	_, err = testWrapper.OrderHistory(t.Context(), exchName, nil)
+	assert.ErrorIs(t, err, errTestFailed)
+}
+
+func TestWrapper_HistoricTrades(t *testing.T) {
+	t.Parallel()
+	trades, err := testWrapper.HistoricTrades(t.Context(), exchName, currencyPair, assetType, time.Now().Add(-time.Hour), time.Now())
+	require.NoError(t, err, "HistoricTrades must not error")
+	assert.Len(t, trades, 1, "HistoricTrades should return trades")
+
+	_, err = testWrapper.HistoricTrades(t.Context(), exchError.String(), currencyPair, assetType, time.Now().Add(-time.Hour), time.Now())
+	assert.ErrorIs(t, err, errTestFailed)
+}
+
+func TestWrapper_Futures(t *testing.T) {
+	t.Parallel()
+	positions, err := testWrapper.FuturesPositions(t.Context(), exchName, &futures.PositionsRequest{Asset: asset.PerpetualSwap, Pairs: currency.Pairs{currencyPair}})
+	require.NoError(t, err, "FuturesPositions must not error")
+	require.Len(t, positions, 1, "FuturesPositions must return a position for each pair")
+	assert.Len(t, positions[0].Orders, 1, "FuturesPositions should return position orders")
+
+	assert.NoError(t, testWrapper.SetLeverage(t.Context(), exchName, asset.PerpetualSwap, currencyPair, margin.Isolated, 10, order.UnknownSide), "SetLeverage should not error")
+	assert.ErrorIs(t, testWrapper.SetLeverage(t.Context(), exchName, asset.Spot, currencyPair, margin.Isolated, 10, order.UnknownSide), errTestFailed)
+
+	rates, err := testWrapper.LatestFundingRates(t.Context(), exchName, &fundingrate.LatestRateRequest{Asset: asset.PerpetualSwap, Pair: currencyPair})
+	require.NoError(t, err, "LatestFundingRates must not error")
+	assert.Len(t, rates, 1, "LatestFundingRates should return a rate")
+
+	interest, err := testWrapper.OpenInterest(t.Context(), exchName)
+	require.NoError(t, err, "OpenInterest must not error")
+	assert.Len(t, interest, 1, "OpenInterest should return a default pair")
+
+	_, err = testWrapper.OpenInterest(t.Context(), exchError.String())
+	assert.ErrorIs(t, err, errTestFailed)
+}
+
+func TestWrapper_SubscribeAccountBalances(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.SubscribeAccountBalances(exchName)
+	assert.NoError(t, err, "SubscribeAccountBalances should not error")
+
+	_, err = testWrapper.SubscribeAccountBalances(exchError.String())
+	assert.ErrorIs(t, err, errTestFailed)
+}
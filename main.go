@@ -38,6 +38,7 @@ func main() {
 	flag.BoolVar(&settings.EnableAllPairs, "enableallpairs", false, "enables all pairs for enabled exchanges")
 	flag.BoolVar(&settings.EnablePortfolioManager, "portfoliomanager", true, "enables the portfolio manager")
 	flag.BoolVar(&settings.EnableDataHistoryManager, "datahistorymanager", false, "enables the data history manager")
+	flag.BoolVar(&settings.EnableDataQualityManager, "dataqualitymanager", false, "enables the data quality manager")
 	flag.DurationVar(&settings.PortfolioManagerDelay, "portfoliomanagerdelay", 0, "sets the portfolio managers sleep delay between updates")
 	flag.BoolVar(&settings.EnableGRPC, "grpc", true, "enables the grpc server")
 	flag.BoolVar(&settings.EnableGRPCProxy, "grpcproxy", false, "enables the grpc proxy server")
@@ -58,6 +59,11 @@ func main() {
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
 	flag.BoolVar(&settings.EnableCurrencyStateManager, "currencystatemanager", true, "enables the currency state manager")
+	flag.BoolVar(&settings.EnableFuturesRiskManager, "futuresriskmanager", false, "enables the futures risk manager")
+	flag.BoolVar(&settings.EnableTransferManager, "transfermanager", false, "enables the inter-exchange transfer manager")
+	flag.BoolVar(&settings.EnableMetricsExporter, "metricsexporter", false, "enables the Prometheus metrics exporter")
+	flag.BoolVar(&settings.EnableTracing, "tracing", false, "enables OpenTelemetry tracing of gRPC calls, orders and exchange requests")
+	flag.BoolVar(&settings.EnableHealthCheck, "healthcheck", false, "enables the HTTP liveness and readiness endpoints")
 	flag.IntVar(&settings.DispatchMaxWorkerAmount, "dispatchworkers", dispatch.DefaultMaxWorkers, "sets the dispatch package max worker generation limit")
 	flag.IntVar(&settings.DispatchJobsLimit, "dispatchjobslimit", dispatch.DefaultJobsLimit, "sets the dispatch package max jobs limit")
 
@@ -152,6 +158,7 @@ func main() {
 	}
 
 	go waitForInterrupt(settings.Shutdown)
+	go waitForReload()
 	<-settings.Shutdown
 	engine.Bot.Stop()
 }
@@ -161,3 +168,12 @@ func waitForInterrupt(waiter chan<- struct{}) {
 	gctlog.Infof(gctlog.Global, "Captured %v, shutdown requested.\n", interrupt)
 	waiter <- struct{}{}
 }
+
+func waitForReload() {
+	for sig := range signaler.WaitForReload() {
+		gctlog.Infof(gctlog.Global, "Captured %v, config reload requested.\n", sig)
+		if _, err := engine.Bot.ReloadConfig("", false); err != nil {
+			gctlog.Errorf(gctlog.Global, "Unable to reload config: %v\n", err)
+		}
+	}
+}
@@ -13,3 +13,10 @@ func WaitForInterrupt() chan os.Signal {
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	return c
 }
+
+// WaitForReload returns a channel to receive config reload (SIGHUP) signals
+func WaitForReload() chan os.Signal {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	return c
+}
@@ -35,3 +35,23 @@ func TestWaitForInterrupt(t *testing.T) {
 		}, 2*time.Second, 10*time.Millisecond, "Signal %s should be received within timeout", sig)
 	}
 }
+
+func TestWaitForReload(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("SIGHUP not supported on Windows")
+	}
+	sigC := WaitForReload()
+	proc, err := os.FindProcess(os.Getpid())
+	require.NoError(t, err, "os.FindProcess must not error")
+	require.NoError(t, proc.Signal(syscall.SIGHUP), "proc.Signal must not error")
+
+	assert.Eventually(t, func() bool {
+		select {
+		case got := <-sigC:
+			return got == syscall.SIGHUP
+		default:
+			return false
+		}
+	}, 2*time.Second, 10*time.Millisecond, "SIGHUP should be received within timeout")
+}